@@ -186,4 +186,33 @@ func TestAlarm(t *testing.T) {
 		}).
 			ExpectCalls("PutMetricAlarm", "DescribeAlarms").Run(t)
 	})
+
+	t.Run("attach already attached action", func(t *testing.T) {
+		Template("attach alarm name=my-alarm action-arn=arn:aws:sns:eu-west-1:123456789012:my-topic").Mock(&cloudwatchMock{
+			DescribeAlarmsFunc: func(param0 *cloudwatch.DescribeAlarmsInput) (*cloudwatch.DescribeAlarmsOutput, error) {
+				return &cloudwatch.DescribeAlarmsOutput{MetricAlarms: []*cloudwatch.MetricAlarm{
+					{AlarmName: String("my-alarm"), AlarmActions: []*string{String("arn:aws:sns:eu-west-1:123456789012:my-topic")}},
+				}}, nil
+			},
+		}).ExpectInput("DescribeAlarms", &cloudwatch.DescribeAlarmsInput{AlarmNames: []*string{String("my-alarm")}}).
+			ExpectCalls("DescribeAlarms").Run(t)
+	})
+
+	t.Run("check", func(t *testing.T) {
+		Template("check alarm name=my-alarm state=ok timeout=1").Mock(&cloudwatchMock{
+			DescribeAlarmsFunc: func(param0 *cloudwatch.DescribeAlarmsInput) (*cloudwatch.DescribeAlarmsOutput, error) {
+				return &cloudwatch.DescribeAlarmsOutput{MetricAlarms: []*cloudwatch.MetricAlarm{
+					{AlarmName: String("my-alarm"), StateValue: String("OK")},
+				}}, nil
+			},
+		}).ExpectInput("DescribeAlarms", &cloudwatch.DescribeAlarmsInput{AlarmNames: []*string{String("my-alarm")}}).
+			ExpectCalls("DescribeAlarms").Run(t)
+
+		Template("check alarm name=my-alarm state=not-found timeout=1").Mock(&cloudwatchMock{
+			DescribeAlarmsFunc: func(param0 *cloudwatch.DescribeAlarmsInput) (*cloudwatch.DescribeAlarmsOutput, error) {
+				return &cloudwatch.DescribeAlarmsOutput{}, nil
+			},
+		}).ExpectInput("DescribeAlarms", &cloudwatch.DescribeAlarmsInput{AlarmNames: []*string{String("my-alarm")}}).
+			ExpectCalls("DescribeAlarms").Run(t)
+	})
 }
@@ -0,0 +1,31 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+)
+
+func TestApplication(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create application name=my-app description='My web application'").Mock(&elasticbeanstalkMock{
+			CreateApplicationFunc: func(input *elasticbeanstalk.CreateApplicationInput) (*elasticbeanstalk.ApplicationDescriptionMessage, error) {
+				return &elasticbeanstalk.ApplicationDescriptionMessage{Application: &elasticbeanstalk.ApplicationDescription{ApplicationName: String("my-app")}}, nil
+			}}).
+			ExpectInput("CreateApplication", &elasticbeanstalk.CreateApplicationInput{
+				ApplicationName: String("my-app"),
+				Description:     String("My web application"),
+			}).ExpectCommandResult("my-app").ExpectCalls("CreateApplication").ExpectRevert("delete application name=my-app").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete application name=my-app force=true").Mock(&elasticbeanstalkMock{
+			DeleteApplicationFunc: func(input *elasticbeanstalk.DeleteApplicationInput) (*elasticbeanstalk.DeleteApplicationOutput, error) {
+				return nil, nil
+			}}).
+			ExpectInput("DeleteApplication", &elasticbeanstalk.DeleteApplicationInput{
+				ApplicationName:     String("my-app"),
+				TerminateEnvByForce: Bool(true),
+			}).ExpectCalls("DeleteApplication").Run(t)
+	})
+}
@@ -22,6 +22,7 @@ type ATBuilder struct {
 	expectRevert string
 	mock         mock
 	graph        *graph.Graph
+	fetcher      template.PropertyFetcher
 }
 
 func Template(template string) *ATBuilder {
@@ -76,6 +77,11 @@ func (b *ATBuilder) ExpectRevert(revert string) *ATBuilder {
 	return b
 }
 
+func (b *ATBuilder) PropertyFetcher(fetch template.PropertyFetcher) *ATBuilder {
+	b.fetcher = fetch
+	return b
+}
+
 func (b *ATBuilder) Run(t *testing.T, l ...*logger.Logger) {
 	t.Helper()
 	b.mock.SetInputs(b.expectInput)
@@ -101,7 +107,7 @@ func (b *ATBuilder) Run(t *testing.T, l ...*logger.Logger) {
 		t.Fatal(err)
 	}
 
-	ran, err := compiled.Run(template.NewRunEnv(cenv))
+	ran, err := compiled.Run(template.NewRunEnvWithPropertyFetcher(cenv, b.fetcher))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -0,0 +1,29 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func TestBackup(t *testing.T) {
+	t.Run("backup instance", func(t *testing.T) {
+		Template("backup instance id=i-1234 description='nightly backup'").Mock(&ec2Mock{
+			CreateImageFunc: func(input *ec2.CreateImageInput) (*ec2.CreateImageOutput, error) {
+				return &ec2.CreateImageOutput{ImageId: String("ami-1234")}, nil
+			},
+			DescribeImagesFunc: func(input *ec2.DescribeImagesInput) (*ec2.DescribeImagesOutput, error) {
+				return &ec2.DescribeImagesOutput{Images: []*ec2.Image{
+					{ImageId: String("ami-1234"), State: String("available"), BlockDeviceMappings: []*ec2.BlockDeviceMapping{
+						{Ebs: &ec2.EbsBlockDevice{SnapshotId: String("snap-1234")}},
+					}},
+				}}, nil
+			},
+			CreateTagsFunc: func(input *ec2.CreateTagsInput) (*ec2.CreateTagsOutput, error) {
+				return &ec2.CreateTagsOutput{}, nil
+			},
+		}).IgnoreInput("CreateImage", "CreateTags").
+			ExpectInput("DescribeImages", &ec2.DescribeImagesInput{ImageIds: []*string{String("ami-1234")}}).
+			ExpectCommandResult("ami-1234").ExpectCalls("CreateImage", "DescribeImages", "CreateTags").Run(t)
+	})
+}
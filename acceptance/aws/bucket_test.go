@@ -62,6 +62,45 @@ func TestBucket(t *testing.T) {
 			}).ExpectInput("DeleteBucketWebsite", &s3.DeleteBucketWebsiteInput{
 			Bucket: String("my-bucket-to-update"),
 		}).ExpectCalls("DeleteBucketWebsite").Run(t)
+
+		Template("update bucket name=my-bucket-to-update encryption=AES256").
+			Mock(&s3Mock{
+				PutBucketEncryptionFunc: func(param0 *s3.PutBucketEncryptionInput) (*s3.PutBucketEncryptionOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("PutBucketEncryption", &s3.PutBucketEncryptionInput{
+			Bucket: String("my-bucket-to-update"),
+			ServerSideEncryptionConfiguration: &s3.ServerSideEncryptionConfiguration{
+				Rules: []*s3.ServerSideEncryptionRule{{ApplyServerSideEncryptionByDefault: &s3.ServerSideEncryptionByDefault{SSEAlgorithm: String("AES256")}}},
+			},
+		}).ExpectCalls("PutBucketEncryption").Run(t)
+
+		Template("update bucket name=my-bucket-to-update encryption=aws:kms encryption-key=my-key-id acl=private").
+			Mock(&s3Mock{
+				PutBucketAclFunc: func(param0 *s3.PutBucketAclInput) (*s3.PutBucketAclOutput, error) {
+					return nil, nil
+				},
+				PutBucketEncryptionFunc: func(param0 *s3.PutBucketEncryptionInput) (*s3.PutBucketEncryptionOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("PutBucketAcl", &s3.PutBucketAclInput{
+			Bucket: String("my-bucket-to-update"),
+			ACL:    String("private"),
+		}).ExpectInput("PutBucketEncryption", &s3.PutBucketEncryptionInput{
+			Bucket: String("my-bucket-to-update"),
+			ServerSideEncryptionConfiguration: &s3.ServerSideEncryptionConfiguration{
+				Rules: []*s3.ServerSideEncryptionRule{{ApplyServerSideEncryptionByDefault: &s3.ServerSideEncryptionByDefault{SSEAlgorithm: String("aws:kms"), KMSMasterKeyID: String("my-key-id")}}},
+			},
+		}).ExpectCalls("PutBucketAcl", "PutBucketEncryption").Run(t)
+
+		Template("update bucket name=my-bucket-to-update encryption=none").
+			Mock(&s3Mock{
+				DeleteBucketEncryptionFunc: func(param0 *s3.DeleteBucketEncryptionInput) (*s3.DeleteBucketEncryptionOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("DeleteBucketEncryption", &s3.DeleteBucketEncryptionInput{
+			Bucket: String("my-bucket-to-update"),
+		}).ExpectCalls("DeleteBucketEncryption").Run(t)
 	})
 
 	t.Run("delete", func(t *testing.T) {
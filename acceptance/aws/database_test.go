@@ -3,6 +3,7 @@ package awsat
 import (
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/rds"
 )
 
@@ -10,7 +11,7 @@ func TestDatabase(t *testing.T) {
 	t.Run("create", func(t *testing.T) {
 		t.Run("db", func(t *testing.T) {
 			Template("create database type=my-db-type id=my-db-id engine=my-db-engine password=my-db-password username=my-db-username size=12 "+
-				"autoupgrade=true availabilityzone=my-db-availabilityzone backupretention=10 cluster=my-db-cluster "+
+				"autoupgrade=true availabilityzone=us-west-2b backupretention=10 cluster=my-db-cluster "+
 				"dbname=my-db-dbname parametergroup=my-db-parametergroup dbsecuritygroups=my-db-dbsecuritygroup-1,my-db-dbsecuritygroup-2 subnetgroup=my-db-subnetgroup "+
 				"domain=my-db-domain iamrole=my-db-iamrole version=my-db-version iops=1024 license=my-db-license multiaz=true "+
 				"optiongroup=my-db-optiongroup port=3306 backupwindow=my-db-backupwindow maintenancewindow=my-db-maintenancewindow "+
@@ -27,7 +28,7 @@ func TestDatabase(t *testing.T) {
 				MasterUsername:          String("my-db-username"),
 				AllocatedStorage:        Int64(12),
 				AutoMinorVersionUpgrade: Bool(true),
-				AvailabilityZone:        String("my-db-availabilityzone"),
+				AvailabilityZone:        String("us-west-2b"),
 				BackupRetentionPeriod:   Int64(10),
 				DBClusterIdentifier:     String("my-db-cluster"),
 				DBName:                  String("my-db-dbname"),
@@ -95,6 +96,35 @@ func TestDatabase(t *testing.T) {
 		}).ExpectCalls("DescribeDBInstances").Run(t)
 	})
 
+	t.Run("check stopped", func(t *testing.T) {
+		Template("check database id=db-1234 state=stopped timeout=1").
+			Mock(&rdsMock{
+				DescribeDBInstancesFunc: func(param0 *rds.DescribeDBInstancesInput) (*rds.DescribeDBInstancesOutput, error) {
+					return &rds.DescribeDBInstancesOutput{
+						DBInstances: []*rds.DBInstance{
+							{
+								DBInstanceIdentifier: String("db-1234"),
+								DBInstanceStatus:     String("stopped"),
+							},
+						},
+					}, nil
+				},
+			}).ExpectInput("DescribeDBInstances", &rds.DescribeDBInstancesInput{
+			DBInstanceIdentifier: String("db-1234"),
+		}).ExpectCalls("DescribeDBInstances").Run(t)
+	})
+
+	t.Run("check not found", func(t *testing.T) {
+		Template("check database id=db-1234 state=not-found timeout=1").
+			Mock(&rdsMock{
+				DescribeDBInstancesFunc: func(param0 *rds.DescribeDBInstancesInput) (*rds.DescribeDBInstancesOutput, error) {
+					return nil, awserr.New(rds.ErrCodeDBInstanceNotFoundFault, "DBInstance db-1234 not found.", nil)
+				},
+			}).ExpectInput("DescribeDBInstances", &rds.DescribeDBInstancesInput{
+			DBInstanceIdentifier: String("db-1234"),
+		}).ExpectCalls("DescribeDBInstances").Run(t)
+	})
+
 	t.Run("start", func(t *testing.T) {
 		Template("start database id=db-1234").
 			Mock(&rdsMock{
@@ -0,0 +1,62 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func TestDhcpOptions(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create dhcpoptions domain-name=corp.example.com domain-name-servers=[10.0.0.2,10.0.0.3] netbios-node-type=2 name=corp-dhcp").Mock(&ec2Mock{
+			CreateDhcpOptionsFunc: func(input *ec2.CreateDhcpOptionsInput) (*ec2.CreateDhcpOptionsOutput, error) {
+				return &ec2.CreateDhcpOptionsOutput{DhcpOptions: &ec2.DhcpOptions{DhcpOptionsId: String("new-dhcpoptions-id")}}, nil
+			},
+			CreateTagsRequestFunc: func(input *ec2.CreateTagsInput) (req *request.Request, output *ec2.CreateTagsOutput) {
+				output = &ec2.CreateTagsOutput{}
+				req = request.New(aws.Config{}, metadata.ClientInfo{}, request.Handlers{}, nil, &request.Operation{}, input, output)
+				return
+			}}).
+			ExpectInput("CreateDhcpOptions", &ec2.CreateDhcpOptionsInput{
+				DhcpConfigurations: []*ec2.NewDhcpConfiguration{
+					{Key: String("domain-name"), Values: []*string{String("corp.example.com")}},
+					{Key: String("domain-name-servers"), Values: []*string{String("10.0.0.2"), String("10.0.0.3")}},
+					{Key: String("netbios-node-type"), Values: []*string{String("2")}},
+				},
+			}).
+			ExpectInput("CreateTagsRequest", &ec2.CreateTagsInput{
+				Resources: []*string{String("new-dhcpoptions-id")},
+				Tags:      []*ec2.Tag{{Key: String("Name"), Value: String("corp-dhcp")}},
+			}).ExpectCommandResult("new-dhcpoptions-id").ExpectCalls("CreateDhcpOptions", "CreateTagsRequest").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete dhcpoptions id=dopt-1234").Mock(&ec2Mock{
+			DeleteDhcpOptionsFunc: func(input *ec2.DeleteDhcpOptionsInput) (*ec2.DeleteDhcpOptionsOutput, error) {
+				return nil, nil
+			}}).
+			ExpectInput("DeleteDhcpOptions", &ec2.DeleteDhcpOptionsInput{DhcpOptionsId: String("dopt-1234")}).
+			ExpectCalls("DeleteDhcpOptions").Run(t)
+	})
+
+	t.Run("attach", func(t *testing.T) {
+		Template("attach dhcpoptions id=dopt-1234 vpc=vpc-2345").Mock(&ec2Mock{
+			AssociateDhcpOptionsFunc: func(input *ec2.AssociateDhcpOptionsInput) (*ec2.AssociateDhcpOptionsOutput, error) {
+				return nil, nil
+			}}).
+			ExpectInput("AssociateDhcpOptions", &ec2.AssociateDhcpOptionsInput{DhcpOptionsId: String("dopt-1234"), VpcId: String("vpc-2345")}).
+			ExpectCalls("AssociateDhcpOptions").Run(t)
+	})
+
+	t.Run("detach", func(t *testing.T) {
+		Template("detach dhcpoptions id=dopt-1234 vpc=vpc-2345").Mock(&ec2Mock{
+			AssociateDhcpOptionsFunc: func(input *ec2.AssociateDhcpOptionsInput) (*ec2.AssociateDhcpOptionsOutput, error) {
+				return nil, nil
+			}}).
+			ExpectInput("AssociateDhcpOptions", &ec2.AssociateDhcpOptionsInput{DhcpOptionsId: String("default"), VpcId: String("vpc-2345")}).
+			ExpectCalls("AssociateDhcpOptions").Run(t)
+	})
+}
@@ -30,6 +30,11 @@ func TestDistribution(t *testing.T) {
 								DomainName: String("my.test.domain.com"),
 								Id:         String("orig_1"),
 								OriginPath: String("/my/custom/path"),
+								CustomOriginConfig: &cloudfront.CustomOriginConfig{
+									HTTPPort:             Int64(80),
+									HTTPSPort:            Int64(443),
+									OriginProtocolPolicy: String("http-only"),
+								},
 							},
 						},
 						Quantity: Int64(1),
@@ -94,6 +99,91 @@ func TestDistribution(t *testing.T) {
 							{
 								DomainName: String("my.test.domain.com"),
 								Id:         String("orig_1"),
+								CustomOriginConfig: &cloudfront.CustomOriginConfig{
+									HTTPPort:             Int64(80),
+									HTTPSPort:            Int64(443),
+									OriginProtocolPolicy: String("http-only"),
+								},
+							},
+						},
+						Quantity: Int64(1),
+					},
+				},
+			}).
+				ExpectCommandResult("new-distribution-id").ExpectCalls("CreateDistribution").Run(t)
+		})
+		t.Run("bucket origin", func(t *testing.T) {
+			Template("create distribution origin-bucket=my-website").
+				Mock(&cloudfrontMock{
+					CreateDistributionFunc: func(param0 *cloudfront.CreateDistributionInput) (*cloudfront.CreateDistributionOutput, error) {
+						return &cloudfront.CreateDistributionOutput{Distribution: &cloudfront.Distribution{Id: String("new-distribution-id")}}, nil
+					},
+				}).ExpectInput("CreateDistribution", &cloudfront.CreateDistributionInput{
+				DistributionConfig: &cloudfront.DistributionConfig{
+					Comment: aws.String("my-website.s3.amazonaws.com"),
+					DefaultCacheBehavior: &cloudfront.DefaultCacheBehavior{
+						MinTTL: aws.Int64(0),
+						ForwardedValues: &cloudfront.ForwardedValues{
+							Cookies:     &cloudfront.CookiePreference{Forward: aws.String("all")},
+							QueryString: aws.Bool(true),
+						},
+						TrustedSigners: &cloudfront.TrustedSigners{
+							Enabled:  aws.Bool(false),
+							Quantity: aws.Int64(0),
+						},
+						TargetOriginId:       aws.String("orig_1"),
+						ViewerProtocolPolicy: aws.String("allow-all"),
+					},
+					Enabled:         aws.Bool(true),
+					CallerReference: String("callerReference"),
+					Origins: &cloudfront.Origins{
+						Items: []*cloudfront.Origin{
+							{
+								DomainName:     String("my-website.s3.amazonaws.com"),
+								Id:             String("orig_1"),
+								S3OriginConfig: &cloudfront.S3OriginConfig{OriginAccessIdentity: String("")},
+							},
+						},
+						Quantity: Int64(1),
+					},
+				},
+			}).
+				ExpectCommandResult("new-distribution-id").ExpectCalls("CreateDistribution").Run(t)
+		})
+		t.Run("load balancer origin", func(t *testing.T) {
+			Template("create distribution origin-domain=my-lb-1234.eu-west-1.elb.amazonaws.com origin-protocol=match-viewer").
+				Mock(&cloudfrontMock{
+					CreateDistributionFunc: func(param0 *cloudfront.CreateDistributionInput) (*cloudfront.CreateDistributionOutput, error) {
+						return &cloudfront.CreateDistributionOutput{Distribution: &cloudfront.Distribution{Id: String("new-distribution-id")}}, nil
+					},
+				}).ExpectInput("CreateDistribution", &cloudfront.CreateDistributionInput{
+				DistributionConfig: &cloudfront.DistributionConfig{
+					Comment: aws.String("my-lb-1234.eu-west-1.elb.amazonaws.com"),
+					DefaultCacheBehavior: &cloudfront.DefaultCacheBehavior{
+						MinTTL: aws.Int64(0),
+						ForwardedValues: &cloudfront.ForwardedValues{
+							Cookies:     &cloudfront.CookiePreference{Forward: aws.String("all")},
+							QueryString: aws.Bool(true),
+						},
+						TrustedSigners: &cloudfront.TrustedSigners{
+							Enabled:  aws.Bool(false),
+							Quantity: aws.Int64(0),
+						},
+						TargetOriginId:       aws.String("orig_1"),
+						ViewerProtocolPolicy: aws.String("allow-all"),
+					},
+					Enabled:         aws.Bool(true),
+					CallerReference: String("callerReference"),
+					Origins: &cloudfront.Origins{
+						Items: []*cloudfront.Origin{
+							{
+								DomainName: String("my-lb-1234.eu-west-1.elb.amazonaws.com"),
+								Id:         String("orig_1"),
+								CustomOriginConfig: &cloudfront.CustomOriginConfig{
+									HTTPPort:             Int64(80),
+									HTTPSPort:            Int64(443),
+									OriginProtocolPolicy: String("match-viewer"),
+								},
 							},
 						},
 						Quantity: Int64(1),
@@ -181,6 +271,11 @@ func TestDistribution(t *testing.T) {
 								DomainName: String("my.test.domain.com"),
 								Id:         String("orig_1"),
 								OriginPath: String("/my/custom/path"),
+								CustomOriginConfig: &cloudfront.CustomOriginConfig{
+									HTTPPort:             Int64(80),
+									HTTPSPort:            Int64(443),
+									OriginProtocolPolicy: String("http-only"),
+								},
 							},
 						},
 						Quantity: Int64(1),
@@ -210,6 +305,54 @@ func TestDistribution(t *testing.T) {
 				},
 			}).ExpectCommandResult("etag-updated-distribution").ExpectCalls("GetDistribution", "UpdateDistribution").Run(t)
 		})
+
+		t.Run("origin protocol", func(t *testing.T) {
+			Template("update distribution id=my-distribution-to-update origin-protocol=https-only").Mock(&cloudfrontMock{
+				GetDistributionFunc: func(input *cloudfront.GetDistributionInput) (*cloudfront.GetDistributionOutput, error) {
+					return &cloudfront.GetDistributionOutput{
+						ETag: String("etag-id"),
+						Distribution: &cloudfront.Distribution{
+							DistributionConfig: &cloudfront.DistributionConfig{
+								Origins: &cloudfront.Origins{
+									Items: []*cloudfront.Origin{{
+										DomainName: String("my-lb-1234.eu-west-1.elb.amazonaws.com"),
+										Id:         String("orig_1"),
+										CustomOriginConfig: &cloudfront.CustomOriginConfig{
+											HTTPPort:             Int64(8080),
+											HTTPSPort:            Int64(443),
+											OriginProtocolPolicy: String("http-only"),
+										},
+									}},
+									Quantity: Int64(1),
+								},
+							},
+						},
+					}, nil
+				},
+				UpdateDistributionFunc: func(input *cloudfront.UpdateDistributionInput) (*cloudfront.UpdateDistributionOutput, error) {
+					return &cloudfront.UpdateDistributionOutput{ETag: String("etag-after-update")}, nil
+				},
+			}).ExpectInput("GetDistribution", &cloudfront.GetDistributionInput{
+				Id: String("my-distribution-to-update"),
+			}).ExpectInput("UpdateDistribution", &cloudfront.UpdateDistributionInput{
+				Id:      String("my-distribution-to-update"),
+				IfMatch: String("etag-id"),
+				DistributionConfig: &cloudfront.DistributionConfig{
+					Origins: &cloudfront.Origins{
+						Items: []*cloudfront.Origin{{
+							DomainName: String("my-lb-1234.eu-west-1.elb.amazonaws.com"),
+							Id:         String("orig_1"),
+							CustomOriginConfig: &cloudfront.CustomOriginConfig{
+								HTTPPort:             Int64(8080),
+								HTTPSPort:            Int64(443),
+								OriginProtocolPolicy: String("https-only"),
+							},
+						}},
+						Quantity: Int64(1),
+					},
+				},
+			}).ExpectCommandResult("etag-after-update").ExpectCalls("GetDistribution", "UpdateDistribution").Run(t)
+		})
 	})
 
 	t.Run("check", func(t *testing.T) {
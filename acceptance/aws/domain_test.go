@@ -0,0 +1,117 @@
+package awsat
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53/route53iface"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/wallix/awless/aws/spec"
+)
+
+func TestDomain(t *testing.T) {
+	defer func(f func() (route53iface.Route53API, error)) {
+		awsspec.Route53API = f
+	}(awsspec.Route53API)
+
+	var dnsMock *route53Mock
+	awsspec.Route53API = func() (route53iface.Route53API, error) {
+		return dnsMock, nil
+	}
+
+	sesMock := func() *sesMock {
+		return &sesMock{
+			VerifyDomainIdentityFunc: func(input *ses.VerifyDomainIdentityInput) (*ses.VerifyDomainIdentityOutput, error) {
+				return &ses.VerifyDomainIdentityOutput{VerificationToken: String("abcdef123456")}, nil
+			},
+			VerifyDomainDkimFunc: func(input *ses.VerifyDomainDkimInput) (*ses.VerifyDomainDkimOutput, error) {
+				return &ses.VerifyDomainDkimOutput{DkimTokens: []*string{String("token1"), String("token2"), String("token3")}}, nil
+			},
+		}
+	}
+
+	t.Run("verify with records in parent hosted zone", func(t *testing.T) {
+		dnsMock = &route53Mock{
+			ListHostedZonesByNameFunc: func(input *route53.ListHostedZonesByNameInput) (*route53.ListHostedZonesByNameOutput, error) {
+				if StringValue(input.DNSName) == "example.com." {
+					return &route53.ListHostedZonesByNameOutput{HostedZones: []*route53.HostedZone{
+						{Id: String("/hostedzone/PRIVATE"), Name: String("example.com."), Config: &route53.HostedZoneConfig{PrivateZone: Bool(true)}},
+						{Id: String("/hostedzone/Z1234"), Name: String("example.com."), Config: &route53.HostedZoneConfig{PrivateZone: Bool(false)}},
+					}}, nil
+				}
+				return &route53.ListHostedZonesByNameOutput{HostedZones: []*route53.HostedZone{{Id: String("/hostedzone/OTHER"), Name: String("other.org.")}}}, nil
+			},
+			ChangeResourceRecordSetsFunc: func(input *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error) {
+				return &route53.ChangeResourceRecordSetsOutput{ChangeInfo: &route53.ChangeInfo{Id: String("change-id")}}, nil
+			},
+		}
+		dnsMock.SetTesting(t)
+		dnsMock.SetIgnored(map[string]struct{}{"ListHostedZonesByName": {}})
+		dnsMock.SetInputs(map[string]interface{}{"ChangeResourceRecordSets": &route53.ChangeResourceRecordSetsInput{
+			HostedZoneId: String("/hostedzone/Z1234"),
+			ChangeBatch: &route53.ChangeBatch{
+				Comment: String("SES verification of mail.example.com"),
+				Changes: []*route53.Change{
+					{Action: String("UPSERT"), ResourceRecordSet: &route53.ResourceRecordSet{Name: String("_amazonses.mail.example.com"), Type: String("TXT"), TTL: Int64(1800), ResourceRecords: []*route53.ResourceRecord{{Value: String(`"abcdef123456"`)}}}},
+					{Action: String("UPSERT"), ResourceRecordSet: &route53.ResourceRecordSet{Name: String("token1._domainkey.mail.example.com"), Type: String("CNAME"), TTL: Int64(1800), ResourceRecords: []*route53.ResourceRecord{{Value: String("token1.dkim.amazonses.com")}}}},
+					{Action: String("UPSERT"), ResourceRecordSet: &route53.ResourceRecordSet{Name: String("token2._domainkey.mail.example.com"), Type: String("CNAME"), TTL: Int64(1800), ResourceRecords: []*route53.ResourceRecord{{Value: String("token2.dkim.amazonses.com")}}}},
+					{Action: String("UPSERT"), ResourceRecordSet: &route53.ResourceRecordSet{Name: String("token3._domainkey.mail.example.com"), Type: String("CNAME"), TTL: Int64(1800), ResourceRecords: []*route53.ResourceRecord{{Value: String("token3.dkim.amazonses.com")}}}},
+				},
+			},
+		}})
+
+		Template("verify domain name=Mail.Example.com.").Mock(sesMock()).
+			ExpectInput("VerifyDomainIdentity", &ses.VerifyDomainIdentityInput{Domain: String("mail.example.com")}).
+			ExpectInput("VerifyDomainDkim", &ses.VerifyDomainDkimInput{Domain: String("mail.example.com")}).
+			ExpectCommandResult("mail.example.com").ExpectCalls("VerifyDomainIdentity", "VerifyDomainDkim").Run(t)
+
+		if got, want := dnsMock.Calls(), map[string]int{"ListHostedZonesByName": 2, "ChangeResourceRecordSets": 1}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("verify without dkim in given zone", func(t *testing.T) {
+		dnsMock = &route53Mock{
+			ChangeResourceRecordSetsFunc: func(input *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error) {
+				return &route53.ChangeResourceRecordSetsOutput{ChangeInfo: &route53.ChangeInfo{Id: String("change-id")}}, nil
+			},
+		}
+		dnsMock.SetTesting(t)
+		dnsMock.SetInputs(map[string]interface{}{"ChangeResourceRecordSets": &route53.ChangeResourceRecordSetsInput{
+			HostedZoneId: String("/hostedzone/Z1234"),
+			ChangeBatch: &route53.ChangeBatch{
+				Comment: String("SES verification of example.com"),
+				Changes: []*route53.Change{
+					{Action: String("UPSERT"), ResourceRecordSet: &route53.ResourceRecordSet{Name: String("_amazonses.example.com"), Type: String("TXT"), TTL: Int64(1800), ResourceRecords: []*route53.ResourceRecord{{Value: String(`"abcdef123456"`)}}}},
+				},
+			},
+		}})
+
+		Template("verify domain name=example.com zone=/hostedzone/Z1234 dkim=false").Mock(sesMock()).
+			ExpectInput("VerifyDomainIdentity", &ses.VerifyDomainIdentityInput{Domain: String("example.com")}).
+			ExpectCommandResult("example.com").ExpectCalls("VerifyDomainIdentity").Run(t)
+
+		if got, want := dnsMock.Calls(), map[string]int{"ChangeResourceRecordSets": 1}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("verify without hosted zone", func(t *testing.T) {
+		dnsMock = &route53Mock{
+			ListHostedZonesByNameFunc: func(input *route53.ListHostedZonesByNameInput) (*route53.ListHostedZonesByNameOutput, error) {
+				return &route53.ListHostedZonesByNameOutput{}, nil
+			},
+		}
+		dnsMock.SetTesting(t)
+
+		Template("verify domain name=example.com").Mock(sesMock()).
+			ExpectInput("VerifyDomainIdentity", &ses.VerifyDomainIdentityInput{Domain: String("example.com")}).
+			ExpectInput("VerifyDomainDkim", &ses.VerifyDomainDkimInput{Domain: String("example.com")}).
+			ExpectCommandResult("example.com").ExpectCalls("VerifyDomainIdentity", "VerifyDomainDkim").Run(t)
+
+		if got, want := dnsMock.Calls(), map[string]int{"ListHostedZonesByName": 1}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
+}
@@ -0,0 +1,29 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func TestEgressOnlyInternetGateway(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create egressonlyinternetgateway vpc=vpc-2345").
+			Mock(&ec2Mock{
+				CreateEgressOnlyInternetGatewayFunc: func(param0 *ec2.CreateEgressOnlyInternetGatewayInput) (*ec2.CreateEgressOnlyInternetGatewayOutput, error) {
+					return &ec2.CreateEgressOnlyInternetGatewayOutput{EgressOnlyInternetGateway: &ec2.EgressOnlyInternetGateway{EgressOnlyInternetGatewayId: String("new-eigw-id")}}, nil
+				},
+			}).ExpectInput("CreateEgressOnlyInternetGateway", &ec2.CreateEgressOnlyInternetGatewayInput{VpcId: String("vpc-2345")}).
+			ExpectCommandResult("new-eigw-id").ExpectCalls("CreateEgressOnlyInternetGateway").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete egressonlyinternetgateway id=eigw-1234").
+			Mock(&ec2Mock{
+				DeleteEgressOnlyInternetGatewayFunc: func(param0 *ec2.DeleteEgressOnlyInternetGatewayInput) (*ec2.DeleteEgressOnlyInternetGatewayOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("DeleteEgressOnlyInternetGateway", &ec2.DeleteEgressOnlyInternetGatewayInput{EgressOnlyInternetGatewayId: String("eigw-1234")}).
+			ExpectCalls("DeleteEgressOnlyInternetGateway").Run(t)
+	})
+}
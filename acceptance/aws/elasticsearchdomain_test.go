@@ -0,0 +1,64 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elasticsearchservice"
+)
+
+func TestElasticsearchdomain(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		policy := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"*"},"Action":"es:*","Resource":"*"}]}`
+		Template("create elasticsearchdomain name=logs version=5.5 type=t2.small.elasticsearch count=2 ebs-size=20 ebs-type=gp2 access-policy='"+policy+"'").Mock(&elasticsearchserviceMock{
+			CreateElasticsearchDomainFunc: func(input *elasticsearchservice.CreateElasticsearchDomainInput) (*elasticsearchservice.CreateElasticsearchDomainOutput, error) {
+				return &elasticsearchservice.CreateElasticsearchDomainOutput{DomainStatus: &elasticsearchservice.ElasticsearchDomainStatus{DomainName: String("logs")}}, nil
+			}}).
+			ExpectInput("CreateElasticsearchDomain", &elasticsearchservice.CreateElasticsearchDomainInput{
+				DomainName:                 String("logs"),
+				ElasticsearchVersion:       String("5.5"),
+				ElasticsearchClusterConfig: &elasticsearchservice.ElasticsearchClusterConfig{InstanceType: String("t2.small.elasticsearch"), InstanceCount: Int64(2)},
+				EBSOptions:                 &elasticsearchservice.EBSOptions{EBSEnabled: Bool(true), VolumeSize: Int64(20), VolumeType: String("gp2")},
+				AccessPolicies:             String(policy),
+			}).ExpectCommandResult("logs").ExpectCalls("CreateElasticsearchDomain").Run(t)
+	})
+
+	t.Run("create with defaults", func(t *testing.T) {
+		Template("create elasticsearchdomain name=logs").Mock(&elasticsearchserviceMock{
+			CreateElasticsearchDomainFunc: func(input *elasticsearchservice.CreateElasticsearchDomainInput) (*elasticsearchservice.CreateElasticsearchDomainOutput, error) {
+				return &elasticsearchservice.CreateElasticsearchDomainOutput{DomainStatus: &elasticsearchservice.ElasticsearchDomainStatus{DomainName: String("logs")}}, nil
+			}}).
+			ExpectInput("CreateElasticsearchDomain", &elasticsearchservice.CreateElasticsearchDomainInput{DomainName: String("logs")}).
+			ExpectCommandResult("logs").ExpectCalls("CreateElasticsearchDomain").Run(t)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		Template("update elasticsearchdomain name=logs count=4 ebs-size=100").Mock(&elasticsearchserviceMock{
+			UpdateElasticsearchDomainConfigFunc: func(input *elasticsearchservice.UpdateElasticsearchDomainConfigInput) (*elasticsearchservice.UpdateElasticsearchDomainConfigOutput, error) {
+				return &elasticsearchservice.UpdateElasticsearchDomainConfigOutput{}, nil
+			}}).
+			ExpectInput("UpdateElasticsearchDomainConfig", &elasticsearchservice.UpdateElasticsearchDomainConfigInput{
+				DomainName:                 String("logs"),
+				ElasticsearchClusterConfig: &elasticsearchservice.ElasticsearchClusterConfig{InstanceCount: Int64(4)},
+				EBSOptions:                 &elasticsearchservice.EBSOptions{EBSEnabled: Bool(true), VolumeSize: Int64(100)},
+			}).ExpectCalls("UpdateElasticsearchDomainConfig").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete elasticsearchdomain name=logs").Mock(&elasticsearchserviceMock{
+			DeleteElasticsearchDomainFunc: func(input *elasticsearchservice.DeleteElasticsearchDomainInput) (*elasticsearchservice.DeleteElasticsearchDomainOutput, error) {
+				return nil, nil
+			}}).
+			ExpectInput("DeleteElasticsearchDomain", &elasticsearchservice.DeleteElasticsearchDomainInput{DomainName: String("logs")}).
+			ExpectCalls("DeleteElasticsearchDomain").Run(t)
+	})
+
+	t.Run("check", func(t *testing.T) {
+		Template("check elasticsearchdomain name=logs state=active timeout=1").Mock(&elasticsearchserviceMock{
+			DescribeElasticsearchDomainFunc: func(input *elasticsearchservice.DescribeElasticsearchDomainInput) (*elasticsearchservice.DescribeElasticsearchDomainOutput, error) {
+				return &elasticsearchservice.DescribeElasticsearchDomainOutput{DomainStatus: &elasticsearchservice.ElasticsearchDomainStatus{
+					DomainName: String("logs"), Created: Bool(true), Processing: Bool(false), Endpoint: String("search-logs-abcdef.eu-west-1.es.amazonaws.com"),
+				}}, nil
+			}}).ExpectInput("DescribeElasticsearchDomain", &elasticsearchservice.DescribeElasticsearchDomainInput{DomainName: String("logs")}).
+			ExpectCalls("DescribeElasticsearchDomain").Run(t)
+	})
+}
@@ -0,0 +1,18 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ses"
+)
+
+func TestEmail(t *testing.T) {
+	t.Run("verify", func(t *testing.T) {
+		Template("verify email address=john.smith@example.com").Mock(&sesMock{
+			VerifyEmailIdentityFunc: func(input *ses.VerifyEmailIdentityInput) (*ses.VerifyEmailIdentityOutput, error) {
+				return &ses.VerifyEmailIdentityOutput{}, nil
+			}}).
+			ExpectInput("VerifyEmailIdentity", &ses.VerifyEmailIdentityInput{EmailAddress: String("john.smith@example.com")}).
+			ExpectCommandResult("john.smith@example.com").ExpectCalls("VerifyEmailIdentity").Run(t)
+	})
+}
@@ -0,0 +1,96 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+)
+
+func TestEnvironment(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create environment application=my-app name=prod solution-stack='64bit Amazon Linux running Go' version=v1 cname=my-app instance-type=t2.small keypair=my-keypair").Mock(&elasticbeanstalkMock{
+			CreateEnvironmentFunc: func(input *elasticbeanstalk.CreateEnvironmentInput) (*elasticbeanstalk.EnvironmentDescription, error) {
+				return &elasticbeanstalk.EnvironmentDescription{EnvironmentId: String("e-12345")}, nil
+			}}).
+			ExpectInput("CreateEnvironment", &elasticbeanstalk.CreateEnvironmentInput{
+				ApplicationName:   String("my-app"),
+				EnvironmentName:   String("prod"),
+				SolutionStackName: String("64bit Amazon Linux running Go"),
+				VersionLabel:      String("v1"),
+				CNAMEPrefix:       String("my-app"),
+				OptionSettings: []*elasticbeanstalk.ConfigurationOptionSetting{
+					{Namespace: String("aws:autoscaling:launchconfiguration"), OptionName: String("InstanceType"), Value: String("t2.small")},
+					{Namespace: String("aws:autoscaling:launchconfiguration"), OptionName: String("EC2KeyName"), Value: String("my-keypair")},
+				},
+			}).ExpectCommandResult("e-12345").ExpectCalls("CreateEnvironment").ExpectRevert("delete environment id=e-12345").Run(t)
+	})
+
+	t.Run("create worker", func(t *testing.T) {
+		Template("create environment application=my-app name=jobs template=worker-config tier=Worker").Mock(&elasticbeanstalkMock{
+			CreateEnvironmentFunc: func(input *elasticbeanstalk.CreateEnvironmentInput) (*elasticbeanstalk.EnvironmentDescription, error) {
+				return &elasticbeanstalk.EnvironmentDescription{EnvironmentId: String("e-67890")}, nil
+			}}).
+			ExpectInput("CreateEnvironment", &elasticbeanstalk.CreateEnvironmentInput{
+				ApplicationName: String("my-app"),
+				EnvironmentName: String("jobs"),
+				TemplateName:    String("worker-config"),
+				Tier:            &elasticbeanstalk.EnvironmentTier{Name: String("Worker"), Type: String("SQS/HTTP")},
+			}).ExpectCommandResult("e-67890").ExpectCalls("CreateEnvironment").Run(t)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		Template("update environment id=e-12345 version=v2").Mock(&elasticbeanstalkMock{
+			UpdateEnvironmentFunc: func(input *elasticbeanstalk.UpdateEnvironmentInput) (*elasticbeanstalk.EnvironmentDescription, error) {
+				return &elasticbeanstalk.EnvironmentDescription{}, nil
+			}}).
+			ExpectInput("UpdateEnvironment", &elasticbeanstalk.UpdateEnvironmentInput{
+				EnvironmentId: String("e-12345"),
+				VersionLabel:  String("v2"),
+			}).ExpectCalls("UpdateEnvironment").Run(t)
+	})
+
+	t.Run("update swapping cname", func(t *testing.T) {
+		Template("update environment id=e-12345 version=v2 swap-cname=e-67890").Mock(&elasticbeanstalkMock{
+			UpdateEnvironmentFunc: func(input *elasticbeanstalk.UpdateEnvironmentInput) (*elasticbeanstalk.EnvironmentDescription, error) {
+				return &elasticbeanstalk.EnvironmentDescription{}, nil
+			},
+			SwapEnvironmentCNAMEsFunc: func(input *elasticbeanstalk.SwapEnvironmentCNAMEsInput) (*elasticbeanstalk.SwapEnvironmentCNAMEsOutput, error) {
+				return nil, nil
+			}}).
+			ExpectInput("UpdateEnvironment", &elasticbeanstalk.UpdateEnvironmentInput{
+				EnvironmentId: String("e-12345"),
+				VersionLabel:  String("v2"),
+			}).
+			ExpectInput("SwapEnvironmentCNAMEs", &elasticbeanstalk.SwapEnvironmentCNAMEsInput{
+				SourceEnvironmentId:      String("e-12345"),
+				DestinationEnvironmentId: String("e-67890"),
+			}).ExpectCalls("UpdateEnvironment", "SwapEnvironmentCNAMEs").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete environment id=e-12345").Mock(&elasticbeanstalkMock{
+			TerminateEnvironmentFunc: func(input *elasticbeanstalk.TerminateEnvironmentInput) (*elasticbeanstalk.EnvironmentDescription, error) {
+				return nil, nil
+			}}).
+			ExpectInput("TerminateEnvironment", &elasticbeanstalk.TerminateEnvironmentInput{EnvironmentId: String("e-12345")}).
+			ExpectCalls("TerminateEnvironment").Run(t)
+	})
+
+	t.Run("check", func(t *testing.T) {
+		Template("check environment id=e-12345 state=ready health=green timeout=1").Mock(&elasticbeanstalkMock{
+			DescribeEnvironmentsFunc: func(input *elasticbeanstalk.DescribeEnvironmentsInput) (*elasticbeanstalk.EnvironmentDescriptionsMessage, error) {
+				return &elasticbeanstalk.EnvironmentDescriptionsMessage{Environments: []*elasticbeanstalk.EnvironmentDescription{
+					{EnvironmentId: String("e-12345"), Status: String("Ready"), Health: String("Green")},
+				}}, nil
+			}}).
+			ExpectInput("DescribeEnvironments", &elasticbeanstalk.DescribeEnvironmentsInput{EnvironmentIds: []*string{String("e-12345")}}).
+			ExpectCalls("DescribeEnvironments").Run(t)
+
+		Template("check environment id=e-12345 state=not-found timeout=1").Mock(&elasticbeanstalkMock{
+			DescribeEnvironmentsFunc: func(input *elasticbeanstalk.DescribeEnvironmentsInput) (*elasticbeanstalk.EnvironmentDescriptionsMessage, error) {
+				return &elasticbeanstalk.EnvironmentDescriptionsMessage{}, nil
+			}}).
+			ExpectInput("DescribeEnvironments", &elasticbeanstalk.DescribeEnvironmentsInput{EnvironmentIds: []*string{String("e-12345")}}).
+			ExpectCalls("DescribeEnvironments").Run(t)
+	})
+}
@@ -0,0 +1,76 @@
+package awsat
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/wallix/awless/aws/spec"
+)
+
+func TestFailover(t *testing.T) {
+	defer func(f func(string) (elbv2iface.ELBV2API, error)) {
+		awsspec.LoadbalancerAPIForRegion = f
+	}(awsspec.LoadbalancerAPIForRegion)
+
+	awsspec.LoadbalancerAPIForRegion = func(region string) (elbv2iface.ELBV2API, error) {
+		mock := &elbv2Mock{
+			DescribeLoadBalancersFunc: func(input *elbv2.DescribeLoadBalancersInput) (*elbv2.DescribeLoadBalancersOutput, error) {
+				if len(input.Names) != 1 {
+					return nil, fmt.Errorf("unexpected input %#v", input)
+				}
+				return &elbv2.DescribeLoadBalancersOutput{LoadBalancers: []*elbv2.LoadBalancer{
+					{DNSName: String(StringValue(input.Names[0]) + "." + region + ".elb.amazonaws.com")},
+				}}, nil
+			},
+		}
+		mock.SetTesting(t)
+		return mock, nil
+	}
+
+	t.Run("create", func(t *testing.T) {
+		var healthchecks []*route53.HealthCheckConfig
+		var records []*route53.ResourceRecordSet
+		Template("create failover zone=/hostedzone/1234ABCD name=www.example.com primary=web-eu primary-region=eu-west-1 secondary=web-us secondary-region=us-east-1 path=/health").
+			Mock(&route53Mock{
+				CreateHealthCheckFunc: func(input *route53.CreateHealthCheckInput) (*route53.CreateHealthCheckOutput, error) {
+					healthchecks = append(healthchecks, input.HealthCheckConfig)
+					return &route53.CreateHealthCheckOutput{HealthCheck: &route53.HealthCheck{Id: String(fmt.Sprintf("healthcheck-%d", len(healthchecks)))}}, nil
+				},
+				ChangeResourceRecordSetsFunc: func(input *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error) {
+					records = append(records, input.ChangeBatch.Changes[0].ResourceRecordSet)
+					return &route53.ChangeResourceRecordSetsOutput{ChangeInfo: &route53.ChangeInfo{Id: String("change-id")}}, nil
+				},
+			}).IgnoreInput("CreateHealthCheck", "ChangeResourceRecordSets").
+			ExpectCommandResult("www.example.com").ExpectCalls("CreateHealthCheck", "CreateHealthCheck", "ChangeResourceRecordSets", "ChangeResourceRecordSets").Run(t)
+
+		if got, want := len(healthchecks), 2; got != want {
+			t.Fatalf("got %d healthchecks, want %d", got, want)
+		}
+		for i, host := range []string{"web-eu.eu-west-1.elb.amazonaws.com", "web-us.us-east-1.elb.amazonaws.com"} {
+			if got, want := StringValue(healthchecks[i].FullyQualifiedDomainName), host; got != want {
+				t.Fatalf("got %s, want %s", got, want)
+			}
+			if got, want := StringValue(healthchecks[i].ResourcePath), "/health"; got != want {
+				t.Fatalf("got %s, want %s", got, want)
+			}
+		}
+		for i, failover := range []string{"PRIMARY", "SECONDARY"} {
+			record := records[i]
+			if got, want := StringValue(record.Failover), failover; got != want {
+				t.Fatalf("got %s, want %s", got, want)
+			}
+			if got, want := StringValue(record.HealthCheckId), fmt.Sprintf("healthcheck-%d", i+1); got != want {
+				t.Fatalf("got %s, want %s", got, want)
+			}
+			if got, want := StringValue(record.Type), "CNAME"; got != want {
+				t.Fatalf("got %s, want %s", got, want)
+			}
+			if got, want := StringValue(record.ResourceRecords[0].Value), StringValue(healthchecks[i].FullyQualifiedDomainName); got != want {
+				t.Fatalf("got %s, want %s", got, want)
+			}
+		}
+	})
+}
@@ -1,6 +1,7 @@
 package awsat
 
 import (
+	"archive/zip"
 	"fmt"
 	"testing"
 
@@ -63,6 +64,85 @@ func TestFunction(t *testing.T) {
 		})
 	})
 
+	t.Run("update", func(t *testing.T) {
+		t.Run("code from s3 and configuration", func(t *testing.T) {
+			Template("update function id=my-function bucket=my-function-bucket object=my/function/v2.zip publish=true memory=256 timeout=30").
+				Mock(&lambdaMock{
+					UpdateFunctionCodeFunc: func(param0 *lambda.UpdateFunctionCodeInput) (*lambda.FunctionConfiguration, error) {
+						return &lambda.FunctionConfiguration{FunctionArn: String("arn:of:my-function")}, nil
+					},
+					UpdateFunctionConfigurationFunc: func(param0 *lambda.UpdateFunctionConfigurationInput) (*lambda.FunctionConfiguration, error) {
+						return &lambda.FunctionConfiguration{FunctionArn: String("arn:of:my-function")}, nil
+					},
+				}).ExpectInput("UpdateFunctionCode", &lambda.UpdateFunctionCodeInput{
+				FunctionName: String("my-function"),
+				S3Bucket:     String("my-function-bucket"),
+				S3Key:        String("my/function/v2.zip"),
+				Publish:      Bool(true),
+			}).ExpectInput("UpdateFunctionConfiguration", &lambda.UpdateFunctionConfigurationInput{
+				FunctionName: String("my-function"),
+				MemorySize:   Int64(256),
+				Timeout:      Int64(30),
+			}).ExpectCommandResult("arn:of:my-function").ExpectCalls("UpdateFunctionCode", "UpdateFunctionConfiguration").Run(t)
+		})
+		t.Run("code from zip file", func(t *testing.T) {
+			zipfile := writeTestZip(t)
+			defer os.Remove(zipfile)
+			content, err := ioutil.ReadFile(zipfile)
+			if err != nil {
+				t.Fatal(err)
+			}
+			Template(fmt.Sprintf("update function id=my-function zipfile=%s", zipfile)).
+				Mock(&lambdaMock{
+					UpdateFunctionCodeFunc: func(param0 *lambda.UpdateFunctionCodeInput) (*lambda.FunctionConfiguration, error) {
+						return &lambda.FunctionConfiguration{FunctionArn: String("arn:of:my-function")}, nil
+					},
+				}).ExpectInput("UpdateFunctionCode", &lambda.UpdateFunctionCodeInput{
+				FunctionName: String("my-function"),
+				ZipFile:      content,
+			}).ExpectCommandResult("arn:of:my-function").ExpectCalls("UpdateFunctionCode").Run(t)
+		})
+		t.Run("configuration only", func(t *testing.T) {
+			Template("update function id=my-function runtime=python3.6 handler=main.handler").
+				Mock(&lambdaMock{
+					UpdateFunctionConfigurationFunc: func(param0 *lambda.UpdateFunctionConfigurationInput) (*lambda.FunctionConfiguration, error) {
+						return &lambda.FunctionConfiguration{FunctionArn: String("arn:of:my-function")}, nil
+					},
+				}).ExpectInput("UpdateFunctionConfiguration", &lambda.UpdateFunctionConfigurationInput{
+				FunctionName: String("my-function"),
+				Runtime:      String("python3.6"),
+				Handler:      String("main.handler"),
+			}).ExpectCommandResult("arn:of:my-function").ExpectCalls("UpdateFunctionConfiguration").Run(t)
+		})
+	})
+
+	t.Run("invoke", func(t *testing.T) {
+		t.Run("sync", func(t *testing.T) {
+			Template("invoke function id=my-function payload='{\"name\": \"john\"}' version=prod").
+				Mock(&lambdaMock{
+					InvokeFunc: func(param0 *lambda.InvokeInput) (*lambda.InvokeOutput, error) {
+						return &lambda.InvokeOutput{StatusCode: Int64(200), Payload: []byte(`"hello john"`)}, nil
+					},
+				}).ExpectInput("Invoke", &lambda.InvokeInput{
+				FunctionName:   String("my-function"),
+				InvocationType: String("RequestResponse"),
+				Qualifier:      String("prod"),
+				Payload:        []byte(`{"name": "john"}`),
+			}).ExpectCommandResult(`"hello john"`).ExpectCalls("Invoke").Run(t)
+		})
+		t.Run("async", func(t *testing.T) {
+			Template("invoke function id=my-function async=true").
+				Mock(&lambdaMock{
+					InvokeFunc: func(param0 *lambda.InvokeInput) (*lambda.InvokeOutput, error) {
+						return &lambda.InvokeOutput{StatusCode: Int64(202)}, nil
+					},
+				}).ExpectInput("Invoke", &lambda.InvokeInput{
+				FunctionName:   String("my-function"),
+				InvocationType: String("Event"),
+			}).ExpectCalls("Invoke").Run(t)
+		})
+	})
+
 	t.Run("delete", func(t *testing.T) {
 		Template("delete function id=function-to-delete version=v2").
 			Mock(&lambdaMock{
@@ -74,3 +154,21 @@ func TestFunction(t *testing.T) {
 			ExpectCalls("DeleteFunction").Run(t)
 	})
 }
+
+func writeTestZip(t *testing.T) string {
+	f, err := ioutil.TempFile("", "function")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	w := zip.NewWriter(f)
+	content, err := w.Create("main.py")
+	if err != nil {
+		t.Fatal(err)
+	}
+	content.Write([]byte("def handler(event, context):\n    return 'hello'\n"))
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return f.Name()
+}
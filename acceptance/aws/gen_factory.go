@@ -24,9 +24,12 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
 	"github.com/aws/aws-sdk-go/service/cloudfront/cloudfrontiface"
 	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/ecr/ecriface"
 	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk/elasticbeanstalkiface"
+	"github.com/aws/aws-sdk-go/service/elasticsearchservice/elasticsearchserviceiface"
 	"github.com/aws/aws-sdk-go/service/elb/elbiface"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
@@ -34,6 +37,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/rds/rdsiface"
 	"github.com/aws/aws-sdk-go/service/route53/route53iface"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/ses/sesiface"
 	"github.com/aws/aws-sdk-go/service/sns/snsiface"
 	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
 	"github.com/wallix/awless/aws/spec"
@@ -75,6 +79,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ecsiface.ECSAPI))
 			return cmd
 		}
+	case "attachdhcpoptions":
+		return func() interface{} {
+			cmd := awsspec.NewAttachDhcpoptions(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
 	case "attachelasticip":
 		return func() interface{} {
 			cmd := awsspec.NewAttachElasticip(nil, f.Graph, f.Logger)
@@ -123,6 +133,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(iamiface.IAMAPI))
 			return cmd
 		}
+	case "attachqueuepolicy":
+		return func() interface{} {
+			cmd := awsspec.NewAttachQueuepolicy(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(sqsiface.SQSAPI))
+			return cmd
+		}
 	case "attachrole":
 		return func() interface{} {
 			cmd := awsspec.NewAttachRole(nil, f.Graph, f.Logger)
@@ -135,6 +151,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "attachscalinggroup":
+		return func() interface{} {
+			cmd := awsspec.NewAttachScalinggroup(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(autoscalingiface.AutoScalingAPI))
+			return cmd
+		}
 	case "attachsecuritygroup":
 		return func() interface{} {
 			cmd := awsspec.NewAttachSecuritygroup(nil, f.Graph, f.Logger)
@@ -153,12 +175,36 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "attachvpcendpoint":
+		return func() interface{} {
+			cmd := awsspec.NewAttachVpcendpoint(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
 	case "authenticateregistry":
 		return func() interface{} {
 			cmd := awsspec.NewAuthenticateRegistry(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(ecriface.ECRAPI))
 			return cmd
 		}
+	case "backupinstance":
+		return func() interface{} {
+			cmd := awsspec.NewBackupInstance(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
+	case "bootstrapinstance":
+		return func() interface{} {
+			cmd := awsspec.NewBootstrapInstance(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
+	case "checkalarm":
+		return func() interface{} {
+			cmd := awsspec.NewCheckAlarm(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(cloudwatchiface.CloudWatchAPI))
+			return cmd
+		}
 	case "checkcertificate":
 		return func() interface{} {
 			cmd := awsspec.NewCheckCertificate(nil, f.Graph, f.Logger)
@@ -177,6 +223,24 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(cloudfrontiface.CloudFrontAPI))
 			return cmd
 		}
+	case "checkelasticsearchdomain":
+		return func() interface{} {
+			cmd := awsspec.NewCheckElasticsearchdomain(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(elasticsearchserviceiface.ElasticsearchServiceAPI))
+			return cmd
+		}
+	case "checkenvironment":
+		return func() interface{} {
+			cmd := awsspec.NewCheckEnvironment(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(elasticbeanstalkiface.ElasticBeanstalkAPI))
+			return cmd
+		}
+	case "checkhealthcheck":
+		return func() interface{} {
+			cmd := awsspec.NewCheckHealthcheck(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(route53iface.Route53API))
+			return cmd
+		}
 	case "checkinstance":
 		return func() interface{} {
 			cmd := awsspec.NewCheckInstance(nil, f.Graph, f.Logger)
@@ -201,6 +265,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "checkrecord":
+		return func() interface{} {
+			cmd := awsspec.NewCheckRecord(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(route53iface.Route53API))
+			return cmd
+		}
 	case "checkscalinggroup":
 		return func() interface{} {
 			cmd := awsspec.NewCheckScalinggroup(nil, f.Graph, f.Logger)
@@ -213,6 +283,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "checktable":
+		return func() interface{} {
+			cmd := awsspec.NewCheckTable(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(dynamodbiface.DynamoDBAPI))
+			return cmd
+		}
 	case "checkvolume":
 		return func() interface{} {
 			cmd := awsspec.NewCheckVolume(nil, f.Graph, f.Logger)
@@ -243,6 +319,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(cloudwatchiface.CloudWatchAPI))
 			return cmd
 		}
+	case "createapplication":
+		return func() interface{} {
+			cmd := awsspec.NewCreateApplication(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(elasticbeanstalkiface.ElasticBeanstalkAPI))
+			return cmd
+		}
 	case "createappscalingpolicy":
 		return func() interface{} {
 			cmd := awsspec.NewCreateAppscalingpolicy(nil, f.Graph, f.Logger)
@@ -291,18 +373,48 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(rdsiface.RDSAPI))
 			return cmd
 		}
+	case "createdhcpoptions":
+		return func() interface{} {
+			cmd := awsspec.NewCreateDhcpoptions(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
 	case "createdistribution":
 		return func() interface{} {
 			cmd := awsspec.NewCreateDistribution(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(cloudfrontiface.CloudFrontAPI))
 			return cmd
 		}
+	case "createegressonlyinternetgateway":
+		return func() interface{} {
+			cmd := awsspec.NewCreateEgressonlyinternetgateway(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
 	case "createelasticip":
 		return func() interface{} {
 			cmd := awsspec.NewCreateElasticip(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "createelasticsearchdomain":
+		return func() interface{} {
+			cmd := awsspec.NewCreateElasticsearchdomain(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(elasticsearchserviceiface.ElasticsearchServiceAPI))
+			return cmd
+		}
+	case "createenvironment":
+		return func() interface{} {
+			cmd := awsspec.NewCreateEnvironment(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(elasticbeanstalkiface.ElasticBeanstalkAPI))
+			return cmd
+		}
+	case "createfailover":
+		return func() interface{} {
+			cmd := awsspec.NewCreateFailover(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(route53iface.Route53API))
+			return cmd
+		}
 	case "createfunction":
 		return func() interface{} {
 			cmd := awsspec.NewCreateFunction(nil, f.Graph, f.Logger)
@@ -315,6 +427,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(iamiface.IAMAPI))
 			return cmd
 		}
+	case "createhealthcheck":
+		return func() interface{} {
+			cmd := awsspec.NewCreateHealthcheck(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(route53iface.Route53API))
+			return cmd
+		}
 	case "createimage":
 		return func() interface{} {
 			cmd := awsspec.NewCreateImage(nil, f.Graph, f.Logger)
@@ -405,6 +523,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(route53iface.Route53API))
 			return cmd
 		}
+	case "createrecords":
+		return func() interface{} {
+			cmd := awsspec.NewCreateRecords(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(route53iface.Route53API))
+			return cmd
+		}
 	case "createrepository":
 		return func() interface{} {
 			cmd := awsspec.NewCreateRepository(nil, f.Graph, f.Logger)
@@ -477,6 +601,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(snsiface.SNSAPI))
 			return cmd
 		}
+	case "createtable":
+		return func() interface{} {
+			cmd := awsspec.NewCreateTable(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(dynamodbiface.DynamoDBAPI))
+			return cmd
+		}
 	case "createtag":
 		return func() interface{} {
 			cmd := awsspec.NewCreateTag(nil, f.Graph, f.Logger)
@@ -513,6 +643,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "createvpcendpoint":
+		return func() interface{} {
+			cmd := awsspec.NewCreateVpcendpoint(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
 	case "createzone":
 		return func() interface{} {
 			cmd := awsspec.NewCreateZone(nil, f.Graph, f.Logger)
@@ -531,6 +667,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(cloudwatchiface.CloudWatchAPI))
 			return cmd
 		}
+	case "deleteapplication":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteApplication(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(elasticbeanstalkiface.ElasticBeanstalkAPI))
+			return cmd
+		}
 	case "deleteappscalingpolicy":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteAppscalingpolicy(nil, f.Graph, f.Logger)
@@ -585,18 +727,42 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(rdsiface.RDSAPI))
 			return cmd
 		}
+	case "deletedhcpoptions":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteDhcpoptions(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
 	case "deletedistribution":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteDistribution(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(cloudfrontiface.CloudFrontAPI))
 			return cmd
 		}
+	case "deleteegressonlyinternetgateway":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteEgressonlyinternetgateway(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
 	case "deleteelasticip":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteElasticip(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "deleteelasticsearchdomain":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteElasticsearchdomain(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(elasticsearchserviceiface.ElasticsearchServiceAPI))
+			return cmd
+		}
+	case "deleteenvironment":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteEnvironment(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(elasticbeanstalkiface.ElasticBeanstalkAPI))
+			return cmd
+		}
 	case "deletefunction":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteFunction(nil, f.Graph, f.Logger)
@@ -609,6 +775,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(iamiface.IAMAPI))
 			return cmd
 		}
+	case "deletehealthcheck":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteHealthcheck(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(route53iface.Route53API))
+			return cmd
+		}
 	case "deleteimage":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteImage(nil, f.Graph, f.Logger)
@@ -699,6 +871,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(route53iface.Route53API))
 			return cmd
 		}
+	case "deleterecords":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteRecords(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(route53iface.Route53API))
+			return cmd
+		}
 	case "deleterepository":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteRepository(nil, f.Graph, f.Logger)
@@ -771,6 +949,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(snsiface.SNSAPI))
 			return cmd
 		}
+	case "deletetable":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteTable(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(dynamodbiface.DynamoDBAPI))
+			return cmd
+		}
 	case "deletetag":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteTag(nil, f.Graph, f.Logger)
@@ -807,6 +991,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "deletevpcendpoint":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteVpcendpoint(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
 	case "deletezone":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteZone(nil, f.Graph, f.Logger)
@@ -831,6 +1021,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ecsiface.ECSAPI))
 			return cmd
 		}
+	case "detachdhcpoptions":
+		return func() interface{} {
+			cmd := awsspec.NewDetachDhcpoptions(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
 	case "detachelasticip":
 		return func() interface{} {
 			cmd := awsspec.NewDetachElasticip(nil, f.Graph, f.Logger)
@@ -873,6 +1069,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(iamiface.IAMAPI))
 			return cmd
 		}
+	case "detachqueuepolicy":
+		return func() interface{} {
+			cmd := awsspec.NewDetachQueuepolicy(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(sqsiface.SQSAPI))
+			return cmd
+		}
 	case "detachrole":
 		return func() interface{} {
 			cmd := awsspec.NewDetachRole(nil, f.Graph, f.Logger)
@@ -885,6 +1087,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "detachscalinggroup":
+		return func() interface{} {
+			cmd := awsspec.NewDetachScalinggroup(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(autoscalingiface.AutoScalingAPI))
+			return cmd
+		}
 	case "detachsecuritygroup":
 		return func() interface{} {
 			cmd := awsspec.NewDetachSecuritygroup(nil, f.Graph, f.Logger)
@@ -903,12 +1111,24 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "detachvpcendpoint":
+		return func() interface{} {
+			cmd := awsspec.NewDetachVpcendpoint(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
 	case "importimage":
 		return func() interface{} {
 			cmd := awsspec.NewImportImage(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "invokefunction":
+		return func() interface{} {
+			cmd := awsspec.NewInvokeFunction(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(lambdaiface.LambdaAPI))
+			return cmd
+		}
 	case "restartdatabase":
 		return func() interface{} {
 			cmd := awsspec.NewRestartDatabase(nil, f.Graph, f.Logger)
@@ -921,6 +1141,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "restorebackup":
+		return func() interface{} {
+			cmd := awsspec.NewRestoreBackup(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
 	case "startalarm":
 		return func() interface{} {
 			cmd := awsspec.NewStartAlarm(nil, f.Graph, f.Logger)
@@ -993,6 +1219,24 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(cloudfrontiface.CloudFrontAPI))
 			return cmd
 		}
+	case "updateelasticsearchdomain":
+		return func() interface{} {
+			cmd := awsspec.NewUpdateElasticsearchdomain(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(elasticsearchserviceiface.ElasticsearchServiceAPI))
+			return cmd
+		}
+	case "updateenvironment":
+		return func() interface{} {
+			cmd := awsspec.NewUpdateEnvironment(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(elasticbeanstalkiface.ElasticBeanstalkAPI))
+			return cmd
+		}
+	case "updatefunction":
+		return func() interface{} {
+			cmd := awsspec.NewUpdateFunction(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(lambdaiface.LambdaAPI))
+			return cmd
+		}
 	case "updateimage":
 		return func() interface{} {
 			cmd := awsspec.NewUpdateImage(nil, f.Graph, f.Logger)
@@ -1017,12 +1261,24 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(iamiface.IAMAPI))
 			return cmd
 		}
+	case "updatequeue":
+		return func() interface{} {
+			cmd := awsspec.NewUpdateQueue(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(sqsiface.SQSAPI))
+			return cmd
+		}
 	case "updaterecord":
 		return func() interface{} {
 			cmd := awsspec.NewUpdateRecord(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(route53iface.Route53API))
 			return cmd
 		}
+	case "updaterecords":
+		return func() interface{} {
+			cmd := awsspec.NewUpdateRecords(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(route53iface.Route53API))
+			return cmd
+		}
 	case "updates3object":
 		return func() interface{} {
 			cmd := awsspec.NewUpdateS3object(nil, f.Graph, f.Logger)
@@ -1053,12 +1309,36 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "updatetable":
+		return func() interface{} {
+			cmd := awsspec.NewUpdateTable(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(dynamodbiface.DynamoDBAPI))
+			return cmd
+		}
 	case "updatetargetgroup":
 		return func() interface{} {
 			cmd := awsspec.NewUpdateTargetgroup(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(elbv2iface.ELBV2API))
 			return cmd
 		}
+	case "updatevpc":
+		return func() interface{} {
+			cmd := awsspec.NewUpdateVpc(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
+	case "verifydomain":
+		return func() interface{} {
+			cmd := awsspec.NewVerifyDomain(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(sesiface.SESAPI))
+			return cmd
+		}
+	case "verifyemail":
+		return func() interface{} {
+			cmd := awsspec.NewVerifyEmail(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(sesiface.SESAPI))
+			return cmd
+		}
 	}
 	return nil
 }
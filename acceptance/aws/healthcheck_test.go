@@ -0,0 +1,80 @@
+package awsat
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+)
+
+func TestHealthcheck(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		t.Run("http", func(t *testing.T) {
+			Template("create healthcheck protocol=http host=www.mysite.com port=80 path=/health interval=10 failure-threshold=2").
+				Mock(&route53Mock{
+					CreateHealthCheckFunc: func(input *route53.CreateHealthCheckInput) (*route53.CreateHealthCheckOutput, error) {
+						if StringValue(input.CallerReference) == "" {
+							t.Fatal("expected caller reference")
+						}
+						exp := &route53.HealthCheckConfig{
+							Type:                     String("HTTP"),
+							FullyQualifiedDomainName: String("www.mysite.com"),
+							Port:                     Int64(80),
+							ResourcePath:             String("/health"),
+							RequestInterval:          Int64(10),
+							FailureThreshold:         Int64(2),
+						}
+						if got := input.HealthCheckConfig; !reflect.DeepEqual(got, exp) {
+							t.Fatalf("got %#v, want %#v", got, exp)
+						}
+						return &route53.CreateHealthCheckOutput{HealthCheck: &route53.HealthCheck{Id: String("new-healthcheck-id")}}, nil
+					},
+				}).IgnoreInput("CreateHealthCheck").
+				ExpectCommandResult("new-healthcheck-id").ExpectCalls("CreateHealthCheck").Run(t)
+		})
+
+		t.Run("tcp", func(t *testing.T) {
+			Template("create healthcheck protocol=TCP ip=52.1.2.3 port=443").
+				Mock(&route53Mock{
+					CreateHealthCheckFunc: func(input *route53.CreateHealthCheckInput) (*route53.CreateHealthCheckOutput, error) {
+						exp := &route53.HealthCheckConfig{
+							Type:      String("TCP"),
+							IPAddress: String("52.1.2.3"),
+							Port:      Int64(443),
+						}
+						if got := input.HealthCheckConfig; !reflect.DeepEqual(got, exp) {
+							t.Fatalf("got %#v, want %#v", got, exp)
+						}
+						return &route53.CreateHealthCheckOutput{HealthCheck: &route53.HealthCheck{Id: String("new-healthcheck-id")}}, nil
+					},
+				}).IgnoreInput("CreateHealthCheck").
+				ExpectCommandResult("new-healthcheck-id").ExpectCalls("CreateHealthCheck").Run(t)
+		})
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete healthcheck id=my-healthcheck-id").
+			Mock(&route53Mock{
+				DeleteHealthCheckFunc: func(input *route53.DeleteHealthCheckInput) (*route53.DeleteHealthCheckOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("DeleteHealthCheck", &route53.DeleteHealthCheckInput{
+			HealthCheckId: String("my-healthcheck-id"),
+		}).ExpectCalls("DeleteHealthCheck").Run(t)
+	})
+
+	t.Run("check", func(t *testing.T) {
+		Template("check healthcheck id=my-healthcheck-id state=healthy timeout=1").
+			Mock(&route53Mock{
+				GetHealthCheckStatusFunc: func(input *route53.GetHealthCheckStatusInput) (*route53.GetHealthCheckStatusOutput, error) {
+					return &route53.GetHealthCheckStatusOutput{HealthCheckObservations: []*route53.HealthCheckObservation{
+						{StatusReport: &route53.StatusReport{Status: String("Success: HTTP Status Code 200, OK")}},
+						{StatusReport: &route53.StatusReport{Status: String("Failure: Connection timed out")}},
+						{StatusReport: &route53.StatusReport{Status: String("Failure: Connection timed out")}},
+					}}, nil
+				},
+			}).ExpectInput("GetHealthCheckStatus", &route53.GetHealthCheckStatusInput{
+			HealthCheckId: String("my-healthcheck-id"),
+		}).ExpectCalls("GetHealthCheckStatus").Run(t)
+	})
+}
@@ -37,7 +37,7 @@ func TestImage(t *testing.T) {
 	})
 
 	t.Run("copy", func(t *testing.T) {
-		Template("copy image name=my-image-name source-id=my-origin-id source-region=my-origin-region encrypted=true description='an encrypted image'").
+		Template("copy image name=my-image-name source-id=my-origin-id source-region=us-west-2 encrypted=true description='an encrypted image'").
 			Mock(&ec2Mock{
 				CopyImageFunc: func(param0 *ec2.CopyImageInput) (*ec2.CopyImageOutput, error) {
 					return &ec2.CopyImageOutput{ImageId: String("my-imagecopy-id")}, nil
@@ -45,7 +45,7 @@ func TestImage(t *testing.T) {
 			}).ExpectInput("CopyImage", &ec2.CopyImageInput{
 			Name:          String("my-image-name"),
 			SourceImageId: String("my-origin-id"),
-			SourceRegion:  String("my-origin-region"),
+			SourceRegion:  String("us-west-2"),
 			Encrypted:     Bool(true),
 			Description:   String("an encrypted image"),
 		}).ExpectCommandResult("my-imagecopy-id").ExpectCalls("CopyImage").Run(t)
@@ -44,6 +44,31 @@ func TestInstance(t *testing.T) {
 				ExpectRevert("delete instance id=new-instance-id").Run(t)
 		})
 
+		t.Run("with abstract type", func(t *testing.T) {
+			Template("create instance image=ami-1234 name=myinstance subnet=sub_1 type=general.large count=1").
+				Mock(&ec2Mock{
+					RunInstancesFunc: func(input *ec2.RunInstancesInput) (*ec2.Reservation, error) {
+						return &ec2.Reservation{Instances: []*ec2.Instance{{InstanceId: String("new-instance-id")}}}, nil
+					},
+					CreateTagsRequestFunc: func(input *ec2.CreateTagsInput) (req *request.Request, output *ec2.CreateTagsOutput) {
+						output = &ec2.CreateTagsOutput{}
+						req = request.New(aws.Config{}, metadata.ClientInfo{}, request.Handlers{}, nil, &request.Operation{}, input, output)
+						return
+					},
+				}).ExpectInput("RunInstances", &ec2.RunInstancesInput{
+				SubnetId:     String("sub_1"),
+				ImageId:      String("ami-1234"),
+				InstanceType: String("m5.large"),
+				MinCount:     Int64(1),
+				MaxCount:     Int64(1),
+			}).ExpectInput("CreateTagsRequest", &ec2.CreateTagsInput{
+				Resources: []*string{String("new-instance-id")},
+				Tags: []*ec2.Tag{
+					{Key: String("Name"), Value: String("myinstance")},
+				},
+			}).ExpectCommandResult("new-instance-id").ExpectCalls("RunInstances", "CreateTagsRequest").Run(t)
+		})
+
 		t.Run("with user data", func(t *testing.T) {
 			_, userdataFile, cleanup := generateTmpFile("this is my content with {{ .AWLESS.oneRef }} content")
 			defer cleanup()
@@ -93,6 +118,39 @@ func TestInstance(t *testing.T) {
 			DisableApiTermination: &ec2.AttributeBooleanValue{Value: Bool(true)},
 		}).
 			ExpectCalls("ModifyInstanceAttribute").Run(t)
+
+		t.Run("source/dest check", func(t *testing.T) {
+			Template("update instance id=id-1234 source-dest-check=false").Mock(&ec2Mock{
+				ModifyInstanceAttributeFunc: func(param0 *ec2.ModifyInstanceAttributeInput) (*ec2.ModifyInstanceAttributeOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("ModifyInstanceAttribute", &ec2.ModifyInstanceAttributeInput{
+				InstanceId:      String("id-1234"),
+				SourceDestCheck: &ec2.AttributeBooleanValue{Value: Bool(false)},
+			}).ExpectCalls("ModifyInstanceAttribute").Run(t)
+		})
+
+		t.Run("shutdown behavior", func(t *testing.T) {
+			Template("update instance id=id-1234 shutdown-behavior=terminate").Mock(&ec2Mock{
+				ModifyInstanceAttributeFunc: func(param0 *ec2.ModifyInstanceAttributeInput) (*ec2.ModifyInstanceAttributeOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("ModifyInstanceAttribute", &ec2.ModifyInstanceAttributeInput{
+				InstanceId:                        String("id-1234"),
+				InstanceInitiatedShutdownBehavior: &ec2.AttributeValue{Value: String("terminate")},
+			}).ExpectCalls("ModifyInstanceAttribute").Run(t)
+		})
+
+		t.Run("securitygroups", func(t *testing.T) {
+			Template("update instance id=id-1234 securitygroups=[sg-1,sg-2]").Mock(&ec2Mock{
+				ModifyInstanceAttributeFunc: func(param0 *ec2.ModifyInstanceAttributeInput) (*ec2.ModifyInstanceAttributeOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("ModifyInstanceAttribute", &ec2.ModifyInstanceAttributeInput{
+				InstanceId: String("id-1234"),
+				Groups:     []*string{String("sg-1"), String("sg-2")},
+			}).ExpectCalls("ModifyInstanceAttribute").Run(t)
+		})
 	})
 
 	t.Run("delete", func(t *testing.T) {
@@ -1,8 +1,13 @@
 package awsat
 
 import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -39,6 +44,39 @@ func TestKeypair(t *testing.T) {
 			ExpectCommandResult("my-kp").ExpectCalls("ImportKeyPair").Run(t)
 	})
 
+	t.Run("create with putty key", func(t *testing.T) {
+		tmpFolder, err := ioutil.TempDir("", "tmpfolder")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(tmpFolder)
+		key, err := rsa.GenerateKey(rand.Reader, 1024)
+		if err != nil {
+			t.Fatal(err)
+		}
+		console.GenerateSSHKeyPair = func(size int, encryptKey bool) ([]byte, []byte, error) {
+			return []byte("unencrypted keypair"), pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}), nil
+		}
+		os.Setenv("__AWLESS_KEYS_DIR", tmpFolder)
+
+		Template("create keypair name=my-kp ppk=true").
+			Mock(&ec2Mock{
+				ImportKeyPairFunc: func(param0 *ec2.ImportKeyPairInput) (*ec2.ImportKeyPairOutput, error) {
+					return &ec2.ImportKeyPairOutput{KeyName: String("my-kp")}, nil
+				},
+			}).ExpectInput("ImportKeyPair", &ec2.ImportKeyPairInput{
+			KeyName:           String("my-kp"),
+			PublicKeyMaterial: []byte("unencrypted keypair"),
+		}).
+			ExpectCommandResult("my-kp").ExpectCalls("ImportKeyPair").Run(t)
+
+		for _, file := range []string{"my-kp.pem", "my-kp.ppk"} {
+			if _, err := os.Stat(filepath.Join(tmpFolder, file)); err != nil {
+				t.Fatal(err)
+			}
+		}
+	})
+
 	t.Run("delete", func(t *testing.T) {
 		Template("delete keypair name=kp-to-delete").
 			Mock(&ec2Mock{
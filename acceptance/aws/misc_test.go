@@ -1,6 +1,8 @@
 package awsat
 
 import (
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -128,3 +130,51 @@ policy = create policy name=ManageOwnMFADevice effect=allow action=[iam:CreateVi
  ]
 }`)}).ExpectCalls("CreatePolicy").ExpectRevert("delete policy all-versions=true arn=arn:new-policy-arn").Run(t)
 }
+
+func TestInterpolationOfReferences(t *testing.T) {
+	Template(`env = prod
+igw = create internetgateway
+create tag key=Name resource=$igw value='${env}-igw-${igw} (cost $${center})'`).Mock(&ec2Mock{
+		CreateInternetGatewayFunc: func(param0 *ec2.CreateInternetGatewayInput) (*ec2.CreateInternetGatewayOutput, error) {
+			return &ec2.CreateInternetGatewayOutput{InternetGateway: &ec2.InternetGateway{InternetGatewayId: String("new-internetgateway-id")}}, nil
+		},
+		CreateTagsRequestFunc: func(input *ec2.CreateTagsInput) (req *request.Request, output *ec2.CreateTagsOutput) {
+			output = &ec2.CreateTagsOutput{}
+			req = request.New(aws.Config{}, metadata.ClientInfo{}, request.Handlers{}, nil, &request.Operation{}, input, output)
+			return
+		}}).
+		IgnoreInput("CreateInternetGateway").
+		ExpectInput("CreateTagsRequest", &ec2.CreateTagsInput{
+			Resources: []*string{String("new-internetgateway-id")},
+			Tags:      []*ec2.Tag{{Key: String("Name"), Value: String("prod-igw-new-internetgateway-id (cost ${center})")}},
+		}).ExpectCalls("CreateInternetGateway", "CreateTagsRequest").Run(t)
+}
+
+func TestPropertyReferences(t *testing.T) {
+	var fetched, tags []string
+	Template(`igw = create internetgateway
+create tag key=Vpc resource=$igw value=$igw.vpc
+create tag key=Name resource=any-vpc-id value='vpc of ${igw.vpc}'`).Mock(&ec2Mock{
+		CreateInternetGatewayFunc: func(param0 *ec2.CreateInternetGatewayInput) (*ec2.CreateInternetGatewayOutput, error) {
+			return &ec2.CreateInternetGatewayOutput{InternetGateway: &ec2.InternetGateway{InternetGatewayId: String("new-internetgateway-id")}}, nil
+		},
+		CreateTagsRequestFunc: func(input *ec2.CreateTagsInput) (req *request.Request, output *ec2.CreateTagsOutput) {
+			tags = append(tags, aws.StringValue(input.Resources[0])+" "+aws.StringValue(input.Tags[0].Value))
+			output = &ec2.CreateTagsOutput{}
+			req = request.New(aws.Config{}, metadata.ClientInfo{}, request.Handlers{}, nil, &request.Operation{}, input, output)
+			return
+		}}).
+		PropertyFetcher(func(resourceType, id, property string) (interface{}, error) {
+			fetched = append(fetched, strings.Join([]string{resourceType, id, property}, " "))
+			return "vpc-1234", nil
+		}).
+		IgnoreInput("CreateInternetGateway", "CreateTagsRequest").
+		ExpectCalls("CreateInternetGateway", "CreateTagsRequest", "CreateTagsRequest").Run(t)
+
+	if got, want := tags, []string{"new-internetgateway-id vpc-1234", "any-vpc-id vpc of vpc-1234"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := fetched, []string{"internetgateway new-internetgateway-id vpc"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
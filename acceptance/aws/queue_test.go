@@ -38,4 +38,90 @@ func TestQueue(t *testing.T) {
 			}).ExpectInput("DeleteQueue", &sqs.DeleteQueueInput{QueueUrl: String("queue-url-to-delete")}).
 			ExpectCalls("DeleteQueue").Run(t)
 	})
+
+	t.Run("update", func(t *testing.T) {
+		Template("update queue url=my-queue-url visibility-timeout=120 retention-period=86400").
+			Mock(&sqsMock{
+				SetQueueAttributesFunc: func(param0 *sqs.SetQueueAttributesInput) (*sqs.SetQueueAttributesOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("SetQueueAttributes", &sqs.SetQueueAttributesInput{
+			QueueUrl: String("my-queue-url"),
+			Attributes: map[string]*string{
+				"MessageRetentionPeriod": String("86400"),
+				"VisibilityTimeout":      String("120"),
+			},
+		}).ExpectCalls("SetQueueAttributes").Run(t)
+	})
+
+	t.Run("attach policy", func(t *testing.T) {
+		existing := `{"Version":"2012-10-17","Statement":[{"Sid":"FromTopic","Effect":"Deny"},{"Sid":"FromBucket","Effect":"Allow"}]}`
+		Template(`attach queuepolicy url=my-queue-url policy='{"Statement":{"Sid":"FromTopic","Effect":"Allow"}}'`).
+			Mock(&sqsMock{
+				GetQueueAttributesFunc: func(param0 *sqs.GetQueueAttributesInput) (*sqs.GetQueueAttributesOutput, error) {
+					return &sqs.GetQueueAttributesOutput{Attributes: map[string]*string{"Policy": String(existing)}}, nil
+				},
+				SetQueueAttributesFunc: func(param0 *sqs.SetQueueAttributesInput) (*sqs.SetQueueAttributesOutput, error) {
+					return nil, nil
+				},
+			}).
+			ExpectInput("GetQueueAttributes", &sqs.GetQueueAttributesInput{QueueUrl: String("my-queue-url"), AttributeNames: []*string{String("Policy")}}).
+			ExpectInput("SetQueueAttributes", &sqs.SetQueueAttributesInput{
+				QueueUrl:   String("my-queue-url"),
+				Attributes: map[string]*string{"Policy": String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Sid":"FromBucket"},{"Effect":"Allow","Sid":"FromTopic"}]}`)},
+			}).
+			ExpectCalls("GetQueueAttributes", "SetQueueAttributes").
+			ExpectRevert(`detach queuepolicy policy='{"Statement":{"Sid":"FromTopic","Effect":"Allow"}}' url=my-queue-url`).Run(t)
+	})
+
+	t.Run("attach policy to queue without policy", func(t *testing.T) {
+		Template(`attach queuepolicy url=my-queue-url policy='{"Version":"2012-10-17","Statement":[{"Sid":"FromTopic","Effect":"Allow"}]}'`).
+			Mock(&sqsMock{
+				GetQueueAttributesFunc: func(param0 *sqs.GetQueueAttributesInput) (*sqs.GetQueueAttributesOutput, error) {
+					return &sqs.GetQueueAttributesOutput{}, nil
+				},
+				SetQueueAttributesFunc: func(param0 *sqs.SetQueueAttributesInput) (*sqs.SetQueueAttributesOutput, error) {
+					return nil, nil
+				},
+			}).
+			ExpectInput("GetQueueAttributes", &sqs.GetQueueAttributesInput{QueueUrl: String("my-queue-url"), AttributeNames: []*string{String("Policy")}}).
+			ExpectInput("SetQueueAttributes", &sqs.SetQueueAttributesInput{
+				QueueUrl:   String("my-queue-url"),
+				Attributes: map[string]*string{"Policy": String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Sid":"FromTopic"}]}`)},
+			}).
+			ExpectCalls("GetQueueAttributes", "SetQueueAttributes").Run(t)
+	})
+
+	t.Run("detach policy", func(t *testing.T) {
+		existing := `{"Version":"2012-10-17","Statement":[{"Sid":"FromTopic","Effect":"Allow"},{"Sid":"FromBucket","Effect":"Allow"}]}`
+		Template(`detach queuepolicy url=my-queue-url policy='{"Statement":{"Sid":"FromTopic"}}'`).
+			Mock(&sqsMock{
+				GetQueueAttributesFunc: func(param0 *sqs.GetQueueAttributesInput) (*sqs.GetQueueAttributesOutput, error) {
+					return &sqs.GetQueueAttributesOutput{Attributes: map[string]*string{"Policy": String(existing)}}, nil
+				},
+				SetQueueAttributesFunc: func(param0 *sqs.SetQueueAttributesInput) (*sqs.SetQueueAttributesOutput, error) {
+					return nil, nil
+				},
+			}).
+			ExpectInput("GetQueueAttributes", &sqs.GetQueueAttributesInput{QueueUrl: String("my-queue-url"), AttributeNames: []*string{String("Policy")}}).
+			ExpectInput("SetQueueAttributes", &sqs.SetQueueAttributesInput{
+				QueueUrl:   String("my-queue-url"),
+				Attributes: map[string]*string{"Policy": String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Sid":"FromBucket"}]}`)},
+			}).
+			ExpectCalls("GetQueueAttributes", "SetQueueAttributes").Run(t)
+	})
+
+	t.Run("detach whole policy", func(t *testing.T) {
+		Template("detach queuepolicy url=my-queue-url").
+			Mock(&sqsMock{
+				SetQueueAttributesFunc: func(param0 *sqs.SetQueueAttributesInput) (*sqs.SetQueueAttributesOutput, error) {
+					return nil, nil
+				},
+			}).
+			ExpectInput("SetQueueAttributes", &sqs.SetQueueAttributesInput{
+				QueueUrl:   String("my-queue-url"),
+				Attributes: map[string]*string{"Policy": String("")},
+			}).
+			ExpectCalls("SetQueueAttributes").Run(t)
+	})
 }
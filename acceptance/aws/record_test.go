@@ -65,10 +65,130 @@ func TestRecord(t *testing.T) {
 				},
 			}).ExpectCommandResult("change-id").ExpectCalls("ChangeResourceRecordSets").Run(t)
 		})
+
+		t.Run("with failover", func(t *testing.T) {
+			Template("create record zone=/hostedzone/1234ABCD name=my.domain.com type=A value=127.0.0.1 ttl=60 failover=primary healthcheck=my-healthcheck-id").
+				Mock(&route53Mock{
+					ChangeResourceRecordSetsFunc: func(param0 *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error) {
+						return &route53.ChangeResourceRecordSetsOutput{ChangeInfo: &route53.ChangeInfo{Id: String("change-id")}}, nil
+					},
+				}).ExpectInput("ChangeResourceRecordSets", &route53.ChangeResourceRecordSetsInput{
+				HostedZoneId: String("/hostedzone/1234ABCD"),
+				ChangeBatch: &route53.ChangeBatch{
+					Changes: []*route53.Change{
+						{
+							ResourceRecordSet: &route53.ResourceRecordSet{
+								ResourceRecords: []*route53.ResourceRecord{
+									{Value: String("127.0.0.1")},
+								},
+								Name:          String("my.domain.com"),
+								Type:          String("A"),
+								TTL:           Int64(60),
+								Failover:      String("PRIMARY"),
+								SetIdentifier: String("primary"),
+								HealthCheckId: String("my-healthcheck-id"),
+							},
+							Action: String("CREATE"),
+						},
+					},
+				},
+			}).ExpectCommandResult("change-id").ExpectCalls("ChangeResourceRecordSets").Run(t)
+		})
+
+		t.Run("at zone apex with zone name", func(t *testing.T) {
+			Template("create record zone=example.com. name=@ type=MX value='10 mail.example.com' ttl=300").
+				Mock(&route53Mock{
+					ListHostedZonesByNameFunc: func(param0 *route53.ListHostedZonesByNameInput) (*route53.ListHostedZonesByNameOutput, error) {
+						return &route53.ListHostedZonesByNameOutput{HostedZones: []*route53.HostedZone{
+							{Id: String("/hostedzone/1234ABCD"), Name: String("example.com.")},
+							{Id: String("/hostedzone/5678EFGH"), Name: String("sub.example.com.")},
+						}}, nil
+					},
+					ChangeResourceRecordSetsFunc: func(param0 *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error) {
+						return &route53.ChangeResourceRecordSetsOutput{ChangeInfo: &route53.ChangeInfo{Id: String("change-id")}}, nil
+					},
+				}).ExpectInput("ListHostedZonesByName", &route53.ListHostedZonesByNameInput{
+				DNSName: String("example.com."),
+			}).ExpectInput("ChangeResourceRecordSets", &route53.ChangeResourceRecordSetsInput{
+				HostedZoneId: String("/hostedzone/1234ABCD"),
+				ChangeBatch: &route53.ChangeBatch{
+					Changes: []*route53.Change{
+						{
+							ResourceRecordSet: &route53.ResourceRecordSet{
+								ResourceRecords: []*route53.ResourceRecord{
+									{Value: String("10 mail.example.com")},
+								},
+								Name: String("example.com"),
+								Type: String("MX"),
+								TTL:  Int64(300),
+							},
+							Action: String("CREATE"),
+						},
+					},
+				},
+			}).ExpectCommandResult("change-id").ExpectCalls("ListHostedZonesByName", "ChangeResourceRecordSets").Run(t)
+		})
+
+		t.Run("at zone apex with zone id", func(t *testing.T) {
+			Template("create record zone=/hostedzone/1234ABCD name=@ type=A value=127.0.0.1 ttl=60").
+				Mock(&route53Mock{
+					GetHostedZoneFunc: func(param0 *route53.GetHostedZoneInput) (*route53.GetHostedZoneOutput, error) {
+						return &route53.GetHostedZoneOutput{HostedZone: &route53.HostedZone{Id: String("/hostedzone/1234ABCD"), Name: String("example.com.")}}, nil
+					},
+					ChangeResourceRecordSetsFunc: func(param0 *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error) {
+						return &route53.ChangeResourceRecordSetsOutput{ChangeInfo: &route53.ChangeInfo{Id: String("change-id")}}, nil
+					},
+				}).ExpectInput("GetHostedZone", &route53.GetHostedZoneInput{
+				Id: String("/hostedzone/1234ABCD"),
+			}).ExpectInput("ChangeResourceRecordSets", &route53.ChangeResourceRecordSetsInput{
+				HostedZoneId: String("/hostedzone/1234ABCD"),
+				ChangeBatch: &route53.ChangeBatch{
+					Changes: []*route53.Change{
+						{
+							ResourceRecordSet: &route53.ResourceRecordSet{
+								ResourceRecords: []*route53.ResourceRecord{
+									{Value: String("127.0.0.1")},
+								},
+								Name: String("example.com"),
+								Type: String("A"),
+								TTL:  Int64(60),
+							},
+							Action: String("CREATE"),
+						},
+					},
+				},
+			}).ExpectCommandResult("change-id").ExpectCalls("GetHostedZone", "ChangeResourceRecordSets").Run(t)
+		})
+
+		t.Run("with wildcard and trailing dot", func(t *testing.T) {
+			Template("create record zone=/hostedzone/1234ABCD name=*.example.com. type=CNAME value=www.example.com ttl=60").
+				Mock(&route53Mock{
+					ChangeResourceRecordSetsFunc: func(param0 *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error) {
+						return &route53.ChangeResourceRecordSetsOutput{ChangeInfo: &route53.ChangeInfo{Id: String("change-id")}}, nil
+					},
+				}).ExpectInput("ChangeResourceRecordSets", &route53.ChangeResourceRecordSetsInput{
+				HostedZoneId: String("/hostedzone/1234ABCD"),
+				ChangeBatch: &route53.ChangeBatch{
+					Changes: []*route53.Change{
+						{
+							ResourceRecordSet: &route53.ResourceRecordSet{
+								ResourceRecords: []*route53.ResourceRecord{
+									{Value: String("www.example.com")},
+								},
+								Name: String("*.example.com"),
+								Type: String("CNAME"),
+								TTL:  Int64(60),
+							},
+							Action: String("CREATE"),
+						},
+					},
+				},
+			}).ExpectCommandResult("change-id").ExpectCalls("ChangeResourceRecordSets").Run(t)
+		})
 	})
 
 	t.Run("update", func(t *testing.T) {
-		Template("update record zone=/hostedzone/1234ABCD name=myupdated.domain.com type=A value=127.0.0.1 ttl=60").
+		Template("update record zone=/hostedzone/1234ABCD name=myupdated.domain.com type=A value=127.0.0.1 ttl=60 comment='new value'").
 			Mock(&route53Mock{
 				ChangeResourceRecordSetsFunc: func(param0 *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error) {
 					return &route53.ChangeResourceRecordSetsOutput{ChangeInfo: &route53.ChangeInfo{Id: String("updated-id")}}, nil
@@ -89,6 +209,7 @@ func TestRecord(t *testing.T) {
 						Action: String("UPSERT"),
 					},
 				},
+				Comment: String("new value"),
 			},
 		}).ExpectCommandResult("updated-id").ExpectCalls("ChangeResourceRecordSets").Run(t)
 	})
@@ -152,3 +273,14 @@ func TestRecord(t *testing.T) {
 		})
 	})
 }
+
+func TestCheckRecord(t *testing.T) {
+	Template("check record id=/change/C2682N5HXP0BZ4 state=insync timeout=1").
+		Mock(&route53Mock{
+			GetChangeFunc: func(input *route53.GetChangeInput) (*route53.GetChangeOutput, error) {
+				return &route53.GetChangeOutput{ChangeInfo: &route53.ChangeInfo{Id: input.Id, Status: String("INSYNC")}}, nil
+			},
+		}).ExpectInput("GetChange", &route53.GetChangeInput{
+		Id: String("/change/C2682N5HXP0BZ4"),
+	}).ExpectCalls("GetChange").Run(t)
+}
@@ -0,0 +1,60 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+)
+
+func TestRecords(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create records zone=/hostedzone/1234ABCD records=['www.example.com 300 A 1.2.3.4','www.example.com 300 A 2.3.4.5','example.com 60 MX 10 mail.example.com'] comment=batch").
+			Mock(&route53Mock{
+				ChangeResourceRecordSetsFunc: func(param0 *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error) {
+					return &route53.ChangeResourceRecordSetsOutput{ChangeInfo: &route53.ChangeInfo{Id: String("change-id")}}, nil
+				},
+			}).ExpectInput("ChangeResourceRecordSets", &route53.ChangeResourceRecordSetsInput{
+			HostedZoneId: String("/hostedzone/1234ABCD"),
+			ChangeBatch: &route53.ChangeBatch{
+				Changes: []*route53.Change{
+					{
+						Action: String("CREATE"),
+						ResourceRecordSet: &route53.ResourceRecordSet{
+							ResourceRecords: []*route53.ResourceRecord{{Value: String("1.2.3.4")}, {Value: String("2.3.4.5")}},
+							Name:            String("www.example.com"),
+							Type:            String("A"),
+							TTL:             Int64(300),
+						},
+					},
+					{
+						Action: String("CREATE"),
+						ResourceRecordSet: &route53.ResourceRecordSet{
+							ResourceRecords: []*route53.ResourceRecord{{Value: String("10 mail.example.com")}},
+							Name:            String("example.com"),
+							Type:            String("MX"),
+							TTL:             Int64(60),
+						},
+					},
+				},
+				Comment: String("batch"),
+			},
+		}).ExpectCommandResult("change-id").ExpectCalls("ChangeResourceRecordSets").
+			ExpectRevert("delete records records=['www.example.com 300 A 1.2.3.4','www.example.com 300 A 2.3.4.5','example.com 60 MX 10 mail.example.com'] zone=/hostedzone/1234ABCD").Run(t)
+	})
+
+	t.Run("batch consecutive record changes", func(t *testing.T) {
+		Template("create record zone=/hostedzone/1234ABCD name=www.example.com type=A value=1.2.3.4 ttl=300\n"+
+			"create record zone=/hostedzone/1234ABCD name=api.example.com type=CNAME value=www.example.com ttl=60\n"+
+			"delete record zone=/hostedzone/1234ABCD name=old.example.com type=A values=5.6.7.8,6.7.8.9 ttl=60").
+			Mock(&route53Mock{
+				ChangeResourceRecordSetsFunc: func(param0 *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error) {
+					if len(param0.ChangeBatch.Changes) == 2 {
+						return &route53.ChangeResourceRecordSetsOutput{ChangeInfo: &route53.ChangeInfo{Id: String("batch-id")}}, nil
+					}
+					return &route53.ChangeResourceRecordSetsOutput{ChangeInfo: &route53.ChangeInfo{Id: String("single-id")}}, nil
+				},
+			}).IgnoreInput("ChangeResourceRecordSets").
+			ExpectCommandResult("batch-id").ExpectCalls("ChangeResourceRecordSets", "ChangeResourceRecordSets").
+			ExpectRevert("create record name=old.example.com ttl=60 type=A values=[5.6.7.8,6.7.8.9] zone=/hostedzone/1234ABCD\ndelete records records=['www.example.com 300 A 1.2.3.4','api.example.com 60 CNAME www.example.com'] zone=/hostedzone/1234ABCD").Run(t)
+	})
+}
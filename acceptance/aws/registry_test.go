@@ -2,6 +2,10 @@ package awsat
 
 import (
 	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/ecr"
@@ -19,4 +23,32 @@ func TestRegistry(t *testing.T) {
 				RegistryIds: []*string{String("my-registry-id-1"), String("my-registry-id-2")},
 			}).ExpectCalls("GetAuthorizationToken").Run(t)
 	})
+
+	t.Run("authenticate writing docker config", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "docker")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+		os.Setenv("DOCKER_CONFIG", dir)
+		defer os.Unsetenv("DOCKER_CONFIG")
+
+		token := base64.StdEncoding.EncodeToString([]byte("AWS:my-authorization-token"))
+		Template("authenticate registry docker-config=true").Mock(&ecrMock{
+			GetAuthorizationTokenFunc: func(input *ecr.GetAuthorizationTokenInput) (*ecr.GetAuthorizationTokenOutput, error) {
+				return &ecr.GetAuthorizationTokenOutput{
+					AuthorizationData: []*ecr.AuthorizationData{{AuthorizationToken: String(token), ProxyEndpoint: String("https://123456789012.dkr.ecr.eu-west-1.amazonaws.com")}},
+				}, nil
+			}}).
+			ExpectInput("GetAuthorizationToken", &ecr.GetAuthorizationTokenInput{}).
+			ExpectCalls("GetAuthorizationToken").Run(t)
+
+		content, err := ioutil.ReadFile(filepath.Join(dir, "config.json"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(content), `"https://123456789012.dkr.ecr.eu-west-1.amazonaws.com": {`) || !strings.Contains(string(content), token) {
+			t.Fatalf("unexpected docker config %s", content)
+		}
+	})
 }
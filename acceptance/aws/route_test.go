@@ -20,6 +20,19 @@ func TestRoute(t *testing.T) {
 		}).ExpectCalls("CreateRoute").Run(t)
 	})
 
+	t.Run("create ipv6", func(t *testing.T) {
+		Template("create route table=table-id ipv6-cidr=::/0 egressonlygateway=eigw-id").
+			Mock(&ec2Mock{
+				CreateRouteFunc: func(param0 *ec2.CreateRouteInput) (*ec2.CreateRouteOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("CreateRoute", &ec2.CreateRouteInput{
+			RouteTableId:                String("table-id"),
+			DestinationIpv6CidrBlock:    String("::/0"),
+			EgressOnlyInternetGatewayId: String("eigw-id"),
+		}).ExpectCalls("CreateRoute").Run(t)
+	})
+
 	t.Run("delete", func(t *testing.T) {
 		Template("delete route table=table-id cidr=10.0.0.0/16").
 			Mock(&ec2Mock{
@@ -32,4 +45,15 @@ func TestRoute(t *testing.T) {
 		}).ExpectCalls("DeleteRoute").Run(t)
 	})
 
+	t.Run("delete ipv6", func(t *testing.T) {
+		Template("delete route table=table-id ipv6-cidr=::/0").
+			Mock(&ec2Mock{
+				DeleteRouteFunc: func(param0 *ec2.DeleteRouteInput) (*ec2.DeleteRouteOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("DeleteRoute", &ec2.DeleteRouteInput{
+			RouteTableId:             String("table-id"),
+			DestinationIpv6CidrBlock: String("::/0"),
+		}).ExpectCalls("DeleteRoute").Run(t)
+	})
 }
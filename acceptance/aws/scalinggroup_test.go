@@ -57,6 +57,28 @@ func TestScalingGroup(t *testing.T) {
 			}).ExpectCalls("DeleteAutoScalingGroup").Run(t)
 	})
 
+	t.Run("attach", func(t *testing.T) {
+		Template("attach scalinggroup name=any-sg targetgroup=tg_1").Mock(&autoscalingMock{
+			AttachLoadBalancerTargetGroupsFunc: func(input *autoscaling.AttachLoadBalancerTargetGroupsInput) (*autoscaling.AttachLoadBalancerTargetGroupsOutput, error) {
+				return &autoscaling.AttachLoadBalancerTargetGroupsOutput{}, nil
+			}}).
+			ExpectInput("AttachLoadBalancerTargetGroups", &autoscaling.AttachLoadBalancerTargetGroupsInput{
+				AutoScalingGroupName: String("any-sg"),
+				TargetGroupARNs:      []*string{String("tg_1")},
+			}).ExpectCalls("AttachLoadBalancerTargetGroups").ExpectRevert("detach scalinggroup name=any-sg targetgroup=tg_1").Run(t)
+	})
+
+	t.Run("detach", func(t *testing.T) {
+		Template("detach scalinggroup name=any-sg targetgroup=tg_1").Mock(&autoscalingMock{
+			DetachLoadBalancerTargetGroupsFunc: func(input *autoscaling.DetachLoadBalancerTargetGroupsInput) (*autoscaling.DetachLoadBalancerTargetGroupsOutput, error) {
+				return &autoscaling.DetachLoadBalancerTargetGroupsOutput{}, nil
+			}}).
+			ExpectInput("DetachLoadBalancerTargetGroups", &autoscaling.DetachLoadBalancerTargetGroupsInput{
+				AutoScalingGroupName: String("any-sg"),
+				TargetGroupARNs:      []*string{String("tg_1")},
+			}).ExpectCalls("DetachLoadBalancerTargetGroups").Run(t)
+	})
+
 	t.Run("check", func(t *testing.T) {
 		Template("check scalinggroup name=any-sg count=1 timeout=0").Mock(&autoscalingMock{
 			DescribeAutoScalingGroupsFunc: func(input *autoscaling.DescribeAutoScalingGroupsInput) (*autoscaling.DescribeAutoScalingGroupsOutput, error) {
@@ -31,14 +31,14 @@ func TestSnapshot(t *testing.T) {
 	})
 
 	t.Run("copy", func(t *testing.T) {
-		Template("copy snapshot source-id=my-origin-id source-region=my-origin-region encrypted=true description='an encrypted snapshot'").
+		Template("copy snapshot source-id=my-origin-id source-region=us-west-2 encrypted=true description='an encrypted snapshot'").
 			Mock(&ec2Mock{
 				CopySnapshotFunc: func(param0 *ec2.CopySnapshotInput) (*ec2.CopySnapshotOutput, error) {
 					return &ec2.CopySnapshotOutput{SnapshotId: String("my-snapshotcopy-id")}, nil
 				},
 			}).ExpectInput("CopySnapshot", &ec2.CopySnapshotInput{
 			SourceSnapshotId: String("my-origin-id"),
-			SourceRegion:     String("my-origin-region"),
+			SourceRegion:     String("us-west-2"),
 			Encrypted:        Bool(true),
 			Description:      String("an encrypted snapshot"),
 		}).ExpectCommandResult("my-snapshotcopy-id").ExpectCalls("CopySnapshot").Run(t)
@@ -69,6 +69,24 @@ func TestSubnet(t *testing.T) {
 			}).ExpectCalls("ModifySubnetAttribute").Run(t)
 	})
 
+	t.Run("update ipv6", func(t *testing.T) {
+		Template("update subnet id=any-subnet-id ipv6-cidr=2600:1f18:1234:5601::/64 ipv6-on-launch=true").Mock(&ec2Mock{
+			AssociateSubnetCidrBlockFunc: func(input *ec2.AssociateSubnetCidrBlockInput) (*ec2.AssociateSubnetCidrBlockOutput, error) {
+				return &ec2.AssociateSubnetCidrBlockOutput{}, nil
+			},
+			ModifySubnetAttributeFunc: func(input *ec2.ModifySubnetAttributeInput) (*ec2.ModifySubnetAttributeOutput, error) {
+				return nil, nil
+			}}).
+			ExpectInput("AssociateSubnetCidrBlock", &ec2.AssociateSubnetCidrBlockInput{
+				Ipv6CidrBlock: String("2600:1f18:1234:5601::/64"),
+				SubnetId:      String("any-subnet-id"),
+			}).
+			ExpectInput("ModifySubnetAttribute", &ec2.ModifySubnetAttributeInput{
+				AssignIpv6AddressOnCreation: &ec2.AttributeBooleanValue{Value: Bool(true)},
+				SubnetId:                    String("any-subnet-id"),
+			}).ExpectCalls("AssociateSubnetCidrBlock", "ModifySubnetAttribute").Run(t)
+	})
+
 	t.Run("delete", func(t *testing.T) {
 		Template("delete subnet id=any-subnet-id").Mock(&ec2Mock{
 			DeleteSubnetFunc: func(input *ec2.DeleteSubnetInput) (*ec2.DeleteSubnetOutput, error) {
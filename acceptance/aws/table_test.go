@@ -0,0 +1,96 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+func TestTable(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create table name=events hash-key=user range-key=timestamp range-key-type=n read-capacity=10 write-capacity=2").Mock(&dynamodbMock{
+			CreateTableFunc: func(input *dynamodb.CreateTableInput) (*dynamodb.CreateTableOutput, error) {
+				return &dynamodb.CreateTableOutput{TableDescription: &dynamodb.TableDescription{TableName: String("events")}}, nil
+			}}).
+			ExpectInput("CreateTable", &dynamodb.CreateTableInput{
+				TableName: String("events"),
+				AttributeDefinitions: []*dynamodb.AttributeDefinition{
+					{AttributeName: String("user"), AttributeType: String("S")},
+					{AttributeName: String("timestamp"), AttributeType: String("N")},
+				},
+				KeySchema: []*dynamodb.KeySchemaElement{
+					{AttributeName: String("user"), KeyType: String("HASH")},
+					{AttributeName: String("timestamp"), KeyType: String("RANGE")},
+				},
+				ProvisionedThroughput: &dynamodb.ProvisionedThroughput{ReadCapacityUnits: Int64(10), WriteCapacityUnits: Int64(2)},
+			}).ExpectCommandResult("events").ExpectCalls("CreateTable").ExpectRevert("delete table name=events").Run(t)
+	})
+
+	t.Run("create with defaults", func(t *testing.T) {
+		Template("create table name=users hash-key=id").Mock(&dynamodbMock{
+			CreateTableFunc: func(input *dynamodb.CreateTableInput) (*dynamodb.CreateTableOutput, error) {
+				return &dynamodb.CreateTableOutput{TableDescription: &dynamodb.TableDescription{TableName: String("users")}}, nil
+			}}).
+			ExpectInput("CreateTable", &dynamodb.CreateTableInput{
+				TableName:             String("users"),
+				AttributeDefinitions:  []*dynamodb.AttributeDefinition{{AttributeName: String("id"), AttributeType: String("S")}},
+				KeySchema:             []*dynamodb.KeySchemaElement{{AttributeName: String("id"), KeyType: String("HASH")}},
+				ProvisionedThroughput: &dynamodb.ProvisionedThroughput{ReadCapacityUnits: Int64(5), WriteCapacityUnits: Int64(5)},
+			}).ExpectCommandResult("users").ExpectCalls("CreateTable").Run(t)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		Template("update table name=users read-capacity=20 write-capacity=10").Mock(&dynamodbMock{
+			UpdateTableFunc: func(input *dynamodb.UpdateTableInput) (*dynamodb.UpdateTableOutput, error) {
+				return &dynamodb.UpdateTableOutput{}, nil
+			}}).
+			ExpectInput("UpdateTable", &dynamodb.UpdateTableInput{
+				TableName:             String("users"),
+				ProvisionedThroughput: &dynamodb.ProvisionedThroughput{ReadCapacityUnits: Int64(20), WriteCapacityUnits: Int64(10)},
+			}).ExpectCalls("UpdateTable").Run(t)
+	})
+
+	t.Run("update keeping current capacity", func(t *testing.T) {
+		Template("update table name=users read-capacity=20").Mock(&dynamodbMock{
+			DescribeTableFunc: func(input *dynamodb.DescribeTableInput) (*dynamodb.DescribeTableOutput, error) {
+				return &dynamodb.DescribeTableOutput{Table: &dynamodb.TableDescription{
+					TableName:             String("users"),
+					ProvisionedThroughput: &dynamodb.ProvisionedThroughputDescription{ReadCapacityUnits: Int64(5), WriteCapacityUnits: Int64(3)},
+				}}, nil
+			},
+			UpdateTableFunc: func(input *dynamodb.UpdateTableInput) (*dynamodb.UpdateTableOutput, error) {
+				return &dynamodb.UpdateTableOutput{}, nil
+			}}).
+			ExpectInput("DescribeTable", &dynamodb.DescribeTableInput{TableName: String("users")}).
+			ExpectInput("UpdateTable", &dynamodb.UpdateTableInput{
+				TableName:             String("users"),
+				ProvisionedThroughput: &dynamodb.ProvisionedThroughput{ReadCapacityUnits: Int64(20), WriteCapacityUnits: Int64(3)},
+			}).ExpectCalls("DescribeTable", "UpdateTable").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete table name=users").Mock(&dynamodbMock{
+			DeleteTableFunc: func(input *dynamodb.DeleteTableInput) (*dynamodb.DeleteTableOutput, error) {
+				return nil, nil
+			}}).
+			ExpectInput("DeleteTable", &dynamodb.DeleteTableInput{TableName: String("users")}).
+			ExpectCalls("DeleteTable").Run(t)
+	})
+
+	t.Run("check", func(t *testing.T) {
+		Template("check table name=users state=active timeout=1").Mock(&dynamodbMock{
+			DescribeTableFunc: func(input *dynamodb.DescribeTableInput) (*dynamodb.DescribeTableOutput, error) {
+				return &dynamodb.DescribeTableOutput{Table: &dynamodb.TableDescription{TableName: String("users"), TableStatus: String("ACTIVE")}}, nil
+			}}).
+			ExpectInput("DescribeTable", &dynamodb.DescribeTableInput{TableName: String("users")}).
+			ExpectCalls("DescribeTable").Run(t)
+
+		Template("check table name=users state=not-found timeout=1").Mock(&dynamodbMock{
+			DescribeTableFunc: func(input *dynamodb.DescribeTableInput) (*dynamodb.DescribeTableOutput, error) {
+				return nil, awserr.New(dynamodb.ErrCodeResourceNotFoundException, "Requested resource not found: Table: users not found", nil)
+			}}).
+			ExpectInput("DescribeTable", &dynamodb.DescribeTableInput{TableName: String("users")}).
+			ExpectCalls("DescribeTable").Run(t)
+	})
+}
@@ -8,12 +8,12 @@ import (
 
 func TestVolume(t *testing.T) {
 	t.Run("create", func(t *testing.T) {
-		Template("create volume availabilityzone=eu-west-1 size=1").Mock(&ec2Mock{
+		Template("create volume availabilityzone=eu-west-1a size=1").Mock(&ec2Mock{
 			CreateVolumeFunc: func(input *ec2.CreateVolumeInput) (*ec2.Volume, error) {
 				return &ec2.Volume{VolumeId: String("new-volume-id")}, nil
 			}}).
 			ExpectInput("CreateVolume", &ec2.CreateVolumeInput{
-				AvailabilityZone: String("eu-west-1"),
+				AvailabilityZone: String("eu-west-1a"),
 				Size:             Int64(1),
 			}).ExpectCommandResult("new-volume-id").ExpectCalls("CreateVolume").Run(t)
 	})
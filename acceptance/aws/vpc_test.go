@@ -1,6 +1,7 @@
 package awsat
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -29,6 +30,20 @@ func TestVPC(t *testing.T) {
 			}).ExpectCommandResult("new-vpc-id").ExpectCalls("CreateVpc", "CreateTagsRequest").Run(t)
 	})
 
+	t.Run("create ipv6", func(t *testing.T) {
+		Template("create vpc name=myvpc cidr=10.0.0.0/16 ipv6=true").Mock(&ec2Mock{
+			CreateVpcFunc: func(input *ec2.CreateVpcInput) (*ec2.CreateVpcOutput, error) {
+				return &ec2.CreateVpcOutput{Vpc: &ec2.Vpc{VpcId: String("new-vpc-id")}}, nil
+			},
+			CreateTagsRequestFunc: func(input *ec2.CreateTagsInput) (req *request.Request, output *ec2.CreateTagsOutput) {
+				output = &ec2.CreateTagsOutput{}
+				req = request.New(aws.Config{}, metadata.ClientInfo{}, request.Handlers{}, nil, &request.Operation{}, input, output)
+				return
+			}}).
+			ExpectInput("CreateVpc", &ec2.CreateVpcInput{CidrBlock: String("10.0.0.0/16"), AmazonProvidedIpv6CidrBlock: Bool(true)}).
+			IgnoreInput("CreateTagsRequest").ExpectCommandResult("new-vpc-id").ExpectCalls("CreateVpc", "CreateTagsRequest").Run(t)
+	})
+
 	t.Run("delete", func(t *testing.T) {
 		Template("delete vpc id=any-vpc-id").Mock(&ec2Mock{
 			DeleteVpcFunc: func(input *ec2.DeleteVpcInput) (*ec2.DeleteVpcOutput, error) {
@@ -37,4 +52,31 @@ func TestVPC(t *testing.T) {
 		).ExpectInput("DeleteVpc", &ec2.DeleteVpcInput{VpcId: String("any-vpc-id")}).
 			ExpectCalls("DeleteVpc").Run(t)
 	})
+
+	t.Run("update", func(t *testing.T) {
+		var inputs []*ec2.ModifyVpcAttributeInput
+		Template("update vpc id=any-vpc-id dns-support=true dns-hostnames=true").Mock(&ec2Mock{
+			ModifyVpcAttributeFunc: func(input *ec2.ModifyVpcAttributeInput) (*ec2.ModifyVpcAttributeOutput, error) {
+				inputs = append(inputs, input)
+				return &ec2.ModifyVpcAttributeOutput{}, nil
+			}},
+		).IgnoreInput("ModifyVpcAttribute").ExpectCalls("ModifyVpcAttribute", "ModifyVpcAttribute").Run(t)
+
+		expected := []*ec2.ModifyVpcAttributeInput{
+			{VpcId: String("any-vpc-id"), EnableDnsSupport: &ec2.AttributeBooleanValue{Value: Bool(true)}},
+			{VpcId: String("any-vpc-id"), EnableDnsHostnames: &ec2.AttributeBooleanValue{Value: Bool(true)}},
+		}
+		if got, want := inputs, expected; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("update ipv6", func(t *testing.T) {
+		Template("update vpc id=any-vpc-id ipv6=true").Mock(&ec2Mock{
+			AssociateVpcCidrBlockFunc: func(input *ec2.AssociateVpcCidrBlockInput) (*ec2.AssociateVpcCidrBlockOutput, error) {
+				return &ec2.AssociateVpcCidrBlockOutput{}, nil
+			}},
+		).ExpectInput("AssociateVpcCidrBlock", &ec2.AssociateVpcCidrBlockInput{VpcId: String("any-vpc-id"), AmazonProvidedIpv6CidrBlock: Bool(true)}).
+			ExpectCalls("AssociateVpcCidrBlock").Run(t)
+	})
 }
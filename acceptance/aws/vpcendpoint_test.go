@@ -0,0 +1,107 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func TestVpcendpoint(t *testing.T) {
+	services := &ec2.DescribeVpcEndpointServicesOutput{ServiceDetails: []*ec2.ServiceDetail{
+		{ServiceName: String("com.amazonaws.us-west-2.dynamodb"), ServiceType: []*ec2.ServiceTypeDetail{{ServiceType: String("Gateway")}}},
+		{ServiceName: String("com.amazonaws.us-west-2.s3"), ServiceType: []*ec2.ServiceTypeDetail{{ServiceType: String("Gateway")}}},
+		{ServiceName: String("com.amazonaws.us-west-2.ssm"), ServiceType: []*ec2.ServiceTypeDetail{{ServiceType: String("Interface")}}},
+	}}
+
+	t.Run("create gateway", func(t *testing.T) {
+		Template("create vpcendpoint vpc=vpc-1234 service=s3 routetables=[rtb-1234,rtb-2345] name=s3-endpoint").Mock(&ec2Mock{
+			DescribeVpcEndpointServicesFunc: func(input *ec2.DescribeVpcEndpointServicesInput) (*ec2.DescribeVpcEndpointServicesOutput, error) {
+				return services, nil
+			},
+			CreateVpcEndpointFunc: func(input *ec2.CreateVpcEndpointInput) (*ec2.CreateVpcEndpointOutput, error) {
+				return &ec2.CreateVpcEndpointOutput{VpcEndpoint: &ec2.VpcEndpoint{VpcEndpointId: String("new-vpce-id")}}, nil
+			},
+			CreateTagsRequestFunc: func(input *ec2.CreateTagsInput) (req *request.Request, output *ec2.CreateTagsOutput) {
+				output = &ec2.CreateTagsOutput{}
+				req = request.New(aws.Config{}, metadata.ClientInfo{}, request.Handlers{}, nil, &request.Operation{}, input, output)
+				return
+			}}).
+			ExpectInput("DescribeVpcEndpointServices", &ec2.DescribeVpcEndpointServicesInput{}).
+			ExpectInput("CreateVpcEndpoint", &ec2.CreateVpcEndpointInput{
+				VpcId:           String("vpc-1234"),
+				ServiceName:     String("com.amazonaws.us-west-2.s3"),
+				VpcEndpointType: String("Gateway"),
+				RouteTableIds:   []*string{String("rtb-1234"), String("rtb-2345")},
+			}).
+			ExpectInput("CreateTagsRequest", &ec2.CreateTagsInput{
+				Resources: []*string{String("new-vpce-id")},
+				Tags:      []*ec2.Tag{{Key: String("Name"), Value: String("s3-endpoint")}},
+			}).ExpectCommandResult("new-vpce-id").ExpectCalls("DescribeVpcEndpointServices", "CreateVpcEndpoint", "CreateTagsRequest").Run(t)
+	})
+
+	t.Run("create interface", func(t *testing.T) {
+		Template("create vpcendpoint vpc=vpc-1234 service=ssm subnets=[sub-1234,sub-2345] securitygroups=sg-1234 private-dns=true").Mock(&ec2Mock{
+			DescribeVpcEndpointServicesFunc: func(input *ec2.DescribeVpcEndpointServicesInput) (*ec2.DescribeVpcEndpointServicesOutput, error) {
+				return services, nil
+			},
+			CreateVpcEndpointFunc: func(input *ec2.CreateVpcEndpointInput) (*ec2.CreateVpcEndpointOutput, error) {
+				return &ec2.CreateVpcEndpointOutput{VpcEndpoint: &ec2.VpcEndpoint{VpcEndpointId: String("new-vpce-id")}}, nil
+			}}).
+			ExpectInput("DescribeVpcEndpointServices", &ec2.DescribeVpcEndpointServicesInput{}).
+			ExpectInput("CreateVpcEndpoint", &ec2.CreateVpcEndpointInput{
+				VpcId:             String("vpc-1234"),
+				ServiceName:       String("com.amazonaws.us-west-2.ssm"),
+				VpcEndpointType:   String("Interface"),
+				SubnetIds:         []*string{String("sub-1234"), String("sub-2345")},
+				SecurityGroupIds:  []*string{String("sg-1234")},
+				PrivateDnsEnabled: Bool(true),
+			}).ExpectCommandResult("new-vpce-id").ExpectCalls("DescribeVpcEndpointServices", "CreateVpcEndpoint").Run(t)
+	})
+
+	t.Run("create with full service name and type", func(t *testing.T) {
+		Template("create vpcendpoint vpc=vpc-1234 service=com.amazonaws.eu-west-1.dynamodb type=gateway routetables=rtb-1234").Mock(&ec2Mock{
+			CreateVpcEndpointFunc: func(input *ec2.CreateVpcEndpointInput) (*ec2.CreateVpcEndpointOutput, error) {
+				return &ec2.CreateVpcEndpointOutput{VpcEndpoint: &ec2.VpcEndpoint{VpcEndpointId: String("new-vpce-id")}}, nil
+			}}).
+			ExpectInput("CreateVpcEndpoint", &ec2.CreateVpcEndpointInput{
+				VpcId:           String("vpc-1234"),
+				ServiceName:     String("com.amazonaws.eu-west-1.dynamodb"),
+				VpcEndpointType: String("Gateway"),
+				RouteTableIds:   []*string{String("rtb-1234")},
+			}).ExpectCommandResult("new-vpce-id").ExpectCalls("CreateVpcEndpoint").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete vpcendpoint id=vpce-1234").Mock(&ec2Mock{
+			DeleteVpcEndpointsFunc: func(input *ec2.DeleteVpcEndpointsInput) (*ec2.DeleteVpcEndpointsOutput, error) {
+				return nil, nil
+			}}).
+			ExpectInput("DeleteVpcEndpoints", &ec2.DeleteVpcEndpointsInput{VpcEndpointIds: []*string{String("vpce-1234")}}).
+			ExpectCalls("DeleteVpcEndpoints").Run(t)
+	})
+
+	t.Run("attach", func(t *testing.T) {
+		Template("attach vpcendpoint id=vpce-1234 routetables=rtb-1234").Mock(&ec2Mock{
+			ModifyVpcEndpointFunc: func(input *ec2.ModifyVpcEndpointInput) (*ec2.ModifyVpcEndpointOutput, error) {
+				return nil, nil
+			}}).
+			ExpectInput("ModifyVpcEndpoint", &ec2.ModifyVpcEndpointInput{VpcEndpointId: String("vpce-1234"), AddRouteTableIds: []*string{String("rtb-1234")}}).
+			ExpectCalls("ModifyVpcEndpoint").Run(t)
+	})
+
+	t.Run("detach", func(t *testing.T) {
+		Template("detach vpcendpoint id=vpce-1234 subnets=[sub-1234,sub-2345] securitygroups=sg-1234").Mock(&ec2Mock{
+			ModifyVpcEndpointFunc: func(input *ec2.ModifyVpcEndpointInput) (*ec2.ModifyVpcEndpointOutput, error) {
+				return nil, nil
+			}}).
+			ExpectInput("ModifyVpcEndpoint", &ec2.ModifyVpcEndpointInput{
+				VpcEndpointId:          String("vpce-1234"),
+				RemoveSubnetIds:        []*string{String("sub-1234"), String("sub-2345")},
+				RemoveSecurityGroupIds: []*string{String("sg-1234")},
+			}).
+			ExpectCalls("ModifyVpcEndpoint").Run(t)
+	})
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package audit ships records of awless runs and syncs to a central
+// append-only store (S3 bucket or CloudWatch Logs group) as JSON lines
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+)
+
+const (
+	RunRecord  = "run"
+	SyncRecord = "sync"
+)
+
+type Record struct {
+	Type     string      `json:"type"`
+	ID       string      `json:"id"`
+	Date     time.Time   `json:"date"`
+	Host     string      `json:"host,omitempty"`
+	Author   string      `json:"author,omitempty"`
+	Profile  string      `json:"profile,omitempty"`
+	Region   string      `json:"region,omitempty"`
+	Duration string      `json:"duration,omitempty"`
+	Data     interface{} `json:"data"`
+}
+
+func (r *Record) line() ([]byte, error) {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '\n'), nil
+}
+
+type Shipper interface {
+	Ship(*Record) error
+}
+
+type s3Shipper struct {
+	api            s3iface.S3API
+	bucket, prefix string
+}
+
+// NewS3Shipper returns a shipper writing each record as a new object
+// (never overwritten) under the given bucket and key prefix
+func NewS3Shipper(api s3iface.S3API, bucket, prefix string) Shipper {
+	return &s3Shipper{api: api, bucket: bucket, prefix: prefix}
+}
+
+func (s *s3Shipper) Ship(r *Record) error {
+	b, err := r.line()
+	if err != nil {
+		return err
+	}
+	key := path.Join(s.prefix, r.Date.UTC().Format("2006/01/02"), fmt.Sprintf("%s-%s.json", r.Type, r.ID))
+	_, err = s.api.PutObject(&s3.PutObjectInput{
+		Bucket:      aws.String(s.bucket),
+		Key:         aws.String(key),
+		Body:        bytes.NewReader(b),
+		ContentType: aws.String("application/json"),
+	})
+	return err
+}
+
+type cloudwatchLogsShipper struct {
+	api           cloudwatchlogsiface.CloudWatchLogsAPI
+	group, stream string
+}
+
+// NewCloudWatchLogsShipper returns a shipper appending records as log events
+// to the given log group and stream. The stream is created if needed.
+func NewCloudWatchLogsShipper(api cloudwatchlogsiface.CloudWatchLogsAPI, group, stream string) Shipper {
+	return &cloudwatchLogsShipper{api: api, group: group, stream: stream}
+}
+
+func (s *cloudwatchLogsShipper) Ship(r *Record) error {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	token, err := s.sequenceToken()
+	if err != nil {
+		return err
+	}
+	_, err = s.api.PutLogEvents(&cloudwatchlogs.PutLogEventsInput{
+		LogGroupName:  aws.String(s.group),
+		LogStreamName: aws.String(s.stream),
+		SequenceToken: token,
+		LogEvents: []*cloudwatchlogs.InputLogEvent{
+			{Message: aws.String(string(b)), Timestamp: aws.Int64(r.Date.UnixNano() / int64(time.Millisecond))},
+		},
+	})
+	return err
+}
+
+func (s *cloudwatchLogsShipper) sequenceToken() (*string, error) {
+	out, err := s.api.DescribeLogStreams(&cloudwatchlogs.DescribeLogStreamsInput{
+		LogGroupName:        aws.String(s.group),
+		LogStreamNamePrefix: aws.String(s.stream),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, st := range out.LogStreams {
+		if aws.StringValue(st.LogStreamName) == s.stream {
+			return st.UploadSequenceToken, nil
+		}
+	}
+	_, err = s.api.CreateLogStream(&cloudwatchlogs.CreateLogStreamInput{
+		LogGroupName:  aws.String(s.group),
+		LogStreamName: aws.String(s.stream),
+	})
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == cloudwatchlogs.ErrCodeResourceAlreadyExistsException {
+		return nil, nil
+	}
+	return nil, err
+}
@@ -0,0 +1,118 @@
+package audit
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+)
+
+var testRecord = &Record{
+	Type:   RunRecord,
+	ID:     "01BX",
+	Date:   time.Date(2017, 10, 2, 12, 0, 0, 0, time.UTC),
+	Author: "john",
+	Data:   map[string]string{"source": "create vpc cidr=10.0.0.0/16"},
+}
+
+func TestS3Shipper(t *testing.T) {
+	mock := &mockS3{}
+	if err := NewS3Shipper(mock, "mybucket", "awless-audit").Ship(testRecord); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := aws.StringValue(mock.input.Bucket), "mybucket"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := aws.StringValue(mock.input.Key), "awless-audit/2017/10/02/run-01BX.json"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	body, err := ioutil.ReadAll(mock.input.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(body), `{"type":"run","id":"01BX","date":"2017-10-02T12:00:00Z","author":"john","data":{"source":"create vpc cidr=10.0.0.0/16"}}`+"\n"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
+
+func TestCloudWatchLogsShipper(t *testing.T) {
+	t.Run("existing stream", func(t *testing.T) {
+		mock := &mockCloudWatchLogs{streams: map[string]string{"mystream": "token-1"}}
+		if err := NewCloudWatchLogsShipper(mock, "mygroup", "mystream").Ship(testRecord); err != nil {
+			t.Fatal(err)
+		}
+		if mock.created {
+			t.Fatal("stream should not have been created")
+		}
+		if got, want := aws.StringValue(mock.put.SequenceToken), "token-1"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		var r Record
+		if err := json.Unmarshal([]byte(aws.StringValue(mock.put.LogEvents[0].Message)), &r); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := r.ID, "01BX"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if got, want := aws.Int64Value(mock.put.LogEvents[0].Timestamp), int64(1506945600000); got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+	})
+	t.Run("new stream", func(t *testing.T) {
+		mock := &mockCloudWatchLogs{streams: map[string]string{"mystream-other": "token-1"}}
+		if err := NewCloudWatchLogsShipper(mock, "mygroup", "mystream").Ship(testRecord); err != nil {
+			t.Fatal(err)
+		}
+		if !mock.created {
+			t.Fatal("stream should have been created")
+		}
+		if mock.put.SequenceToken != nil {
+			t.Fatalf("got %s, want nil token", aws.StringValue(mock.put.SequenceToken))
+		}
+	})
+}
+
+type mockS3 struct {
+	s3iface.S3API
+	input *s3.PutObjectInput
+}
+
+func (m *mockS3) PutObject(input *s3.PutObjectInput) (*s3.PutObjectOutput, error) {
+	m.input = input
+	return &s3.PutObjectOutput{}, nil
+}
+
+type mockCloudWatchLogs struct {
+	cloudwatchlogsiface.CloudWatchLogsAPI
+	streams map[string]string
+	created bool
+	put     *cloudwatchlogs.PutLogEventsInput
+}
+
+func (m *mockCloudWatchLogs) DescribeLogStreams(input *cloudwatchlogs.DescribeLogStreamsInput) (*cloudwatchlogs.DescribeLogStreamsOutput, error) {
+	out := &cloudwatchlogs.DescribeLogStreamsOutput{}
+	for name, token := range m.streams {
+		out.LogStreams = append(out.LogStreams, &cloudwatchlogs.LogStream{LogStreamName: aws.String(name), UploadSequenceToken: aws.String(token)})
+	}
+	return out, nil
+}
+
+func (m *mockCloudWatchLogs) CreateLogStream(input *cloudwatchlogs.CreateLogStreamInput) (*cloudwatchlogs.CreateLogStreamOutput, error) {
+	if _, ok := m.streams[aws.StringValue(input.LogStreamName)]; ok {
+		return nil, awserr.New(cloudwatchlogs.ErrCodeResourceAlreadyExistsException, "exists", nil)
+	}
+	m.created = true
+	return &cloudwatchlogs.CreateLogStreamOutput{}, nil
+}
+
+func (m *mockCloudWatchLogs) PutLogEvents(input *cloudwatchlogs.PutLogEventsInput) (*cloudwatchlogs.PutLogEventsOutput, error) {
+	m.put = input
+	return &cloudwatchlogs.PutLogEventsOutput{}, nil
+}
@@ -9,11 +9,13 @@ import (
 	"regexp"
 	"runtime"
 	"sort"
+	"strconv"
 	"strings"
 	"text/tabwriter"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/chzyer/readline"
+	"github.com/wallix/awless/prompt"
 )
 
 var AWSHomeDir = func() string {
@@ -40,9 +42,50 @@ func ParseInstanceType(i string) (interface{}, error) {
 	return i, nil
 }
 
+// ParseAPIRateLimits parses comma separated service=calls per second pairs (ex: ec2=5,iam=0.5)
+func ParseAPIRateLimits(s string) (map[string]float64, error) {
+	limits := make(map[string]float64)
+	for _, pair := range strings.Split(s, ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+		splits := strings.SplitN(pair, "=", 2)
+		if len(splits) != 2 || strings.TrimSpace(splits[0]) == "" {
+			return limits, fmt.Errorf("invalid rate limit '%s', expected service=calls per second", pair)
+		}
+		perSecond, err := strconv.ParseFloat(strings.TrimSpace(splits[1]), 64)
+		if err != nil || perSecond <= 0 {
+			return limits, fmt.Errorf("invalid rate limit '%s', expected a positive number of calls per second", pair)
+		}
+		limits[strings.ToLower(strings.TrimSpace(splits[0]))] = perSecond
+	}
+	return limits, nil
+}
+
+// ParseAPIConcurrency parses comma separated service=number pairs (ex: ec2=5,iam=2)
+func ParseAPIConcurrency(s string) (map[string]int, error) {
+	maxima := make(map[string]int)
+	for _, pair := range strings.Split(s, ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+		splits := strings.SplitN(pair, "=", 2)
+		if len(splits) != 2 || strings.TrimSpace(splits[0]) == "" {
+			return maxima, fmt.Errorf("invalid concurrency '%s', expected service=number of concurrent commands", pair)
+		}
+		max, err := strconv.Atoi(strings.TrimSpace(splits[1]))
+		if err != nil || max <= 0 {
+			return maxima, fmt.Errorf("invalid concurrency '%s', expected a positive number of concurrent commands", pair)
+		}
+		maxima[strings.ToLower(strings.TrimSpace(splits[0]))] = max
+	}
+	return maxima, nil
+}
+
 func StdinRegionSelector() string {
+	prompt.ExitIfDisabled(prompt.Region, "no AWS region found: set it with --aws-region or the AWS_DEFAULT_REGION variable")
 	var regionItems []readline.PrefixCompleterInterface
-	for _, r := range allRegions() {
+	for _, r := range AllRegions() {
 		regionItems = append(regionItems, readline.PcItem(r))
 	}
 	var regionCompleter = readline.NewPrefixCompleter(regionItems...)
@@ -122,7 +165,8 @@ func isValidInstanceType(given string) bool {
 	return regexp.MustCompile("\\w+\\.\\w+").MatchString(given)
 }
 
-func allRegions() []string {
+// AllRegions returns the regions known by the AWS SDK, sorted
+func AllRegions() []string {
 	var regions sort.StringSlice
 	partitions := endpoints.DefaultResolver().(endpoints.EnumPartitions).Partitions()
 	for _, p := range partitions {
@@ -4,23 +4,24 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"reflect"
 	"testing"
 )
 
 func TestRegionsValid(t *testing.T) {
-	if got, want := stringInSlice("eu-west-1", allRegions()), true; got != want {
+	if got, want := stringInSlice("eu-west-1", AllRegions()), true; got != want {
 		t.Errorf("got %t, want %t", got, want)
 	}
-	if got, want := stringInSlice("us-east-1", allRegions()), true; got != want {
+	if got, want := stringInSlice("us-east-1", AllRegions()), true; got != want {
 		t.Errorf("got %t, want %t", got, want)
 	}
-	if got, want := stringInSlice("us-west-1", allRegions()), true; got != want {
+	if got, want := stringInSlice("us-west-1", AllRegions()), true; got != want {
 		t.Errorf("got %t, want %t", got, want)
 	}
-	if got, want := stringInSlice("eu-test-1", allRegions()), false; got != want {
+	if got, want := stringInSlice("eu-test-1", AllRegions()), false; got != want {
 		t.Errorf("got %t, want %t", got, want)
 	}
-	for _, k := range allRegions() {
+	for _, k := range AllRegions() {
 		if got, want := IsValidRegion(k), true; got != want {
 			t.Errorf("got %t, want %t", got, want)
 		}
@@ -96,3 +97,33 @@ func TestInstanceTypeValid(t *testing.T) {
 		}
 	}
 }
+
+func TestParseAPIRateLimits(t *testing.T) {
+	limits, err := ParseAPIRateLimits("ec2=5, IAM=0.5,")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := limits, map[string]float64{"ec2": 5, "iam": 0.5}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for _, invalid := range []string{"ec2", "ec2=", "=5", "ec2=fast", "ec2=0", "ec2=-1"} {
+		if _, err := ParseAPIRateLimits(invalid); err == nil {
+			t.Fatalf("%s: expected error got none", invalid)
+		}
+	}
+}
+
+func TestParseAPIConcurrency(t *testing.T) {
+	maxima, err := ParseAPIConcurrency("ec2=5, IAM=2,")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := maxima, map[string]int{"ec2": 5, "iam": 2}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for _, invalid := range []string{"ec2", "ec2=", "=5", "ec2=many", "ec2=0", "ec2=-1", "ec2=1.5"} {
+		if _, err := ParseAPIConcurrency(invalid); err == nil {
+			t.Fatalf("%s: expected error got none", invalid)
+		}
+	}
+}
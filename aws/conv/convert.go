@@ -26,6 +26,7 @@ import (
 	"net"
 	"net/url"
 	"reflect"
+	"strings"
 	"sync"
 	"time"
 
@@ -38,6 +39,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/elasticsearchservice"
 	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/iam"
@@ -115,6 +117,8 @@ func InitResource(source interface{}) (*graph.Resource, error) {
 		res = graph.InitResource(cloud.Repository, awssdk.StringValue(ss.RepositoryArn))
 	case *ecs.Cluster:
 		res = graph.InitResource(cloud.ContainerCluster, awssdk.StringValue(ss.ClusterArn))
+	case *ecs.Service:
+		res = graph.InitResource(cloud.ContainerService, awssdk.StringValue(ss.ServiceArn))
 	case *ecs.TaskDefinition:
 		res = graph.InitResource(cloud.ContainerTask, awssdk.StringValue(ss.TaskDefinitionArn))
 	case *ecs.Container:
@@ -124,6 +128,9 @@ func InitResource(source interface{}) (*graph.Resource, error) {
 		// ACM
 	case *acm.CertificateSummary:
 		res = graph.InitResource(cloud.Certificate, awssdk.StringValue(ss.CertificateArn))
+		// ElasticSearch
+	case *elasticsearchservice.ElasticsearchDomainStatus:
+		res = graph.InitResource(cloud.ElasticsearchDomain, awssdk.StringValue(ss.DomainName))
 	// IAM
 	case *iam.User:
 		res = graph.InitResource(cloud.User, awssdk.StringValue(ss.UserId))
@@ -284,6 +291,19 @@ var extractValueAsStringFn = func(i interface{}) (interface{}, error) {
 	return fmt.Sprint(val), err
 }
 
+// Extract the resource name ending an ARN (ex: the topic name in arn:aws:sns:eu-west-1:123456789012:my-topic)
+var extractArnResourceNameFn = func(i interface{}) (interface{}, error) {
+	s, ok := i.(*string)
+	if !ok {
+		return nil, fmt.Errorf("extract arn resource name: not a string pointer but a %T", i)
+	}
+	arn := awssdk.StringValue(s)
+	if arn == "" {
+		return nil, nil
+	}
+	return arn[strings.LastIndex(arn, ":")+1:], nil
+}
+
 // Extract time forcing timezone to UTC (friendlier when running test in different timezones i.e. travis)
 var extractTimeFn = func(i interface{}) (interface{}, error) {
 	t, ok := i.(*time.Time)
@@ -634,6 +654,17 @@ var extractStackParametersFn = func(i interface{}) (interface{}, error) {
 	return keyVals, nil
 }
 
+var extractElasticsearchDomainStateFn = func(i interface{}) (interface{}, error) {
+	processing, ok := i.(*bool)
+	if !ok {
+		return nil, fmt.Errorf("extract elasticsearch domain state: not a bool pointer but a %T", i)
+	}
+	if awssdk.BoolValue(processing) {
+		return "processing", nil
+	}
+	return "active", nil
+}
+
 var extractContainersImagesFn = func(i interface{}) (interface{}, error) {
 	if _, ok := i.([]*ecs.ContainerDefinition); !ok {
 		return nil, fmt.Errorf("extract containers images, not a container definition slice but a %T", i)
@@ -71,6 +71,21 @@ func TestTransformFunctions(t *testing.T) {
 		}
 	})
 
+	t.Run("extractArnResourceName", func(t *testing.T) {
+		t.Parallel()
+		val, _ := extractArnResourceNameFn(awssdk.String("arn:aws:sns:eu-west-1:123456789012:my-topic"))
+		if got, want := val.(string), "my-topic"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		val, _ = extractArnResourceNameFn(awssdk.String(""))
+		if val != nil {
+			t.Fatalf("got %v, want nil", val)
+		}
+		if _, err := extractArnResourceNameFn("my-topic"); err == nil {
+			t.Fatal("expected error")
+		}
+	})
+
 	t.Run("extractField", func(t *testing.T) {
 		t.Parallel()
 		data := &ec2.InstanceState{Code: awssdk.Int64(12), Name: awssdk.String("running")}
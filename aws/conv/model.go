@@ -339,6 +339,18 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 		properties.RunningTasksCount:                 {name: "RunningTasksCount", transform: extractValueFn},
 		properties.State:                             {name: "Status", transform: extractValueFn},
 	},
+	cloud.ContainerService: {
+		properties.Name:              {name: "ServiceName", transform: extractValueFn},
+		properties.Arn:               {name: "ServiceArn", transform: extractValueFn},
+		properties.ContainerTask:     {name: "TaskDefinition", transform: extractValueFn},
+		properties.DesiredTasksCount: {name: "DesiredCount", transform: extractValueFn},
+		properties.RunningTasksCount: {name: "RunningCount", transform: extractValueFn},
+		properties.PendingTasksCount: {name: "PendingCount", transform: extractValueFn},
+		properties.Type:              {name: "LaunchType", transform: extractValueFn},
+		properties.Role:              {name: "RoleArn", transform: extractValueFn},
+		properties.Created:           {name: "CreatedAt", transform: extractValueFn},
+		properties.State:             {name: "Status", transform: extractValueFn},
+	},
 	cloud.ContainerTask: {
 		properties.Name:             {name: "Family", transform: extractValueFn},
 		properties.Arn:              {name: "TaskDefinitionArn", transform: extractValueFn},
@@ -372,6 +384,17 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 		properties.Arn:  {name: "CertificateArn", transform: extractValueFn},
 		properties.Name: {name: "DomainName", transform: extractValueFn},
 	},
+	//ElasticSearch
+	cloud.ElasticsearchDomain: {
+		properties.Name:        {name: "DomainName", transform: extractValueFn},
+		properties.Arn:         {name: "ARN", transform: extractValueFn},
+		properties.Endpoint:    {name: "Endpoint", transform: extractValueFn},
+		properties.Version:     {name: "ElasticsearchVersion", transform: extractValueFn},
+		properties.State:       {name: "Processing", transform: extractElasticsearchDomainStateFn},
+		properties.Type:        {name: "ElasticsearchClusterConfig", transform: extractFieldFn("InstanceType")},
+		properties.Storage:     {name: "EBSOptions", transform: extractFieldFn("VolumeSize")},
+		properties.StorageType: {name: "EBSOptions", transform: extractFieldFn("VolumeType")},
+	},
 	//IAM
 	cloud.User: {
 		properties.Name:             {name: "UserName", transform: extractValueFn},
@@ -382,12 +405,13 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 		properties.InlinePolicies:   {name: "UserPolicyList", transform: extractStringSliceValues("PolicyName")},
 	},
 	cloud.Role: {
-		properties.Name:           {name: "RoleName", transform: extractValueFn},
-		properties.Arn:            {name: "Arn", transform: extractValueFn},
-		properties.Created:        {name: "CreateDate", transform: extractTimeFn},
-		properties.Path:           {name: "Path", transform: extractValueFn},
-		properties.InlinePolicies: {name: "RolePolicyList", transform: extractStringSliceValues("PolicyName")},
-		properties.TrustPolicy:    {name: "AssumeRolePolicyDocument", transform: extractURLEncodedJson},
+		properties.Name:             {name: "RoleName", transform: extractValueFn},
+		properties.Arn:              {name: "Arn", transform: extractValueFn},
+		properties.Created:          {name: "CreateDate", transform: extractTimeFn},
+		properties.Path:             {name: "Path", transform: extractValueFn},
+		properties.InlinePolicies:   {name: "RolePolicyList", transform: extractStringSliceValues("PolicyName")},
+		properties.TrustPolicy:      {name: "AssumeRolePolicyDocument", transform: extractURLEncodedJson},
+		properties.InstanceProfiles: {name: "InstanceProfileList", transform: extractStringSliceValues("InstanceProfileId")},
 	},
 	cloud.Group: {
 		properties.Name:           {name: "GroupName", transform: extractValueFn},
@@ -397,14 +421,15 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 		properties.InlinePolicies: {name: "GroupPolicyList", transform: extractStringSliceValues("PolicyName")},
 	},
 	cloud.Policy: {
-		properties.Name:        {name: "PolicyName", transform: extractValueFn},
-		properties.Arn:         {name: "Arn", transform: extractValueFn},
-		properties.Created:     {name: "CreateDate", transform: extractTimeFn},
-		properties.Updated:     {name: "UpdateDate", transform: extractTimeFn},
-		properties.Description: {name: "Description", transform: extractValueFn},
-		properties.Attachable:  {name: "IsAttachable", transform: extractValueFn},
-		properties.Path:        {name: "Path", transform: extractValueFn},
-		properties.Document:    {name: "PolicyVersionList", transform: extractDocumentDefaultVersion},
+		properties.Name:            {name: "PolicyName", transform: extractValueFn},
+		properties.Arn:             {name: "Arn", transform: extractValueFn},
+		properties.Created:         {name: "CreateDate", transform: extractTimeFn},
+		properties.Updated:         {name: "UpdateDate", transform: extractTimeFn},
+		properties.Description:     {name: "Description", transform: extractValueFn},
+		properties.Attachable:      {name: "IsAttachable", transform: extractValueFn},
+		properties.AttachmentCount: {name: "AttachmentCount", transform: extractValueFn},
+		properties.Path:            {name: "Path", transform: extractValueFn},
+		properties.Document:        {name: "PolicyVersionList", transform: extractDocumentDefaultVersion},
 	},
 	cloud.AccessKey: {
 		properties.Username: {name: "UserName", transform: extractValueFn},
@@ -434,7 +459,8 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 		properties.Topic:    {name: "TopicArn", transform: extractValueFn},
 	},
 	cloud.Topic: {
-		properties.Arn: {name: "TopicArn", transform: extractValueFn},
+		properties.Name: {name: "TopicArn", transform: extractArnResourceNameFn},
+		properties.Arn:  {name: "TopicArn", transform: extractValueFn},
 	},
 	// DNS
 	cloud.Zone: {
@@ -532,4 +558,6 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 	},
 	//Queue
 	cloud.Queue: {}, //Manually set
+	//Email
+	cloud.EmailIdentity: {}, //Manually set
 }
@@ -13,8 +13,15 @@ func AwlessCommandDefinitionsDoc(action, entity, fallbackDef string) string {
 }
 
 var CommandDefinitionsDoc = map[string]string{
+	"backup.instance":            "Backup an EC2 instance as an image (and its volumes snapshots) tagged with the instance and backup time.\n\nList backups with `awless list backups`. Schedule a backup with `--run-in`, or periodic backups with `--every` and `--times` (through the scheduler). The scheduler does not repeat tasks: periodic backups stop after `--times` backups.",
+	"restore.backup":             "Launch a new EC2 instance from a backup image (see `awless backup instance -h`).\n\nThe instance type, subnet, keypair and securitygroups default to the ones of the backed up instance.",
+	"bootstrap.instance":         "Wait for SSH on an EC2 instance then copy and run a local script on it, streaming its output.\n\nIn templates, use the bootstrap statement after the instance creation (ex: `bootstrap instance id=$inst script=./setup.sh`).",
+	"check.record":               "Wait for a Route53 change (returned by record actions) to be propagated to all Route53 DNS servers, so that following statements (ex: certificate DNS validation) do not race the propagation",
 	"copy.image":                 "Copy an EC2 image from given source region to current awless region",
 	"create.classicloadbalancer": "Create a ELB Classic Loadbalancer (recommended only for EC2 Classic instances).\n\nYou should favor newer AWS load balancers. See `awless create loadbalancer -h`.",
+	"create.failover":            "Route a DNS name to a load balancer, failing over to a load balancer of another region when unhealthy.\n\nCreates a Route53 healthcheck per load balancer and the primary and secondary failover CNAME records.",
+	"create.records":             "Create several Route53 records in a single change batch, applied atomically by AWS.\n\nConsecutive record changes of the same kind on the same zone in a template are batched the same way.",
+	"update.record":              "Create or update a Route53 record (UPSERT) in a single change: the record is never missing, unlike with a delete then create.",
 }
 
 func AwlessExamplesDoc(action, entity string) string {
@@ -37,11 +44,16 @@ func exampleDoc(key string) string {
 }
 
 var cliExamplesDoc = map[string][]string{
-	"attach.alarm":         {},
+	"attach.alarm": {
+		"awless attach alarm name=cpu-alarm action-arn=@my-topic",
+	},
 	"attach.containertask": {},
 	"attach.elasticip": {
 		"awless attach elasticip id=eipalloc-1c517b26 instance=@redis",
 	},
+	"attach.dhcpoptions": {
+		"awless attach dhcpoptions id=dopt-1a2b3c4d vpc=@my-vpc",
+	},
 	"attach.instance": {},
 	"attach.instanceprofile": {
 		"awless attach instanceprofile instance=@redis name=MyProfile replace=true",
@@ -57,12 +69,18 @@ var cliExamplesDoc = map[string][]string{
 		"awless attach policy role=MyNewRole service=ec2 access=readonly",
 		"awless attach policy user=jsmith service=s3 access=readonly",
 	},
+	"attach.queuepolicy": {
+		`awless attach queuepolicy url=@my-queue policy='{"Statement":{"Sid":"FromTopic","Effect":"Allow","Principal":{"Service":"sns.amazonaws.com"},"Action":"sqs:SendMessage","Resource":"arn:aws:sqs:eu-west-1:123456789012:my-queue","Condition":{"ArnEquals":{"aws:SourceArn":"arn:aws:sns:eu-west-1:123456789012:my-topic"}}}}'`,
+	},
 	"attach.role": {
 		"awless attach role instanceprofile=MyProfile name=MyRole",
 	},
 	"attach.routetable": {
 		"awless attach routetable id=rtb-306da254 subnet=@my-subnet",
 	},
+	"attach.scalinggroup": {
+		"awless attach scalinggroup name=my-scalinggroup targetgroup=@my-targetgroup",
+	},
 	"attach.securitygroup": {
 		"awless attach securitygroup id=sg-0714247d instance=@redis",
 	},
@@ -72,15 +90,47 @@ var cliExamplesDoc = map[string][]string{
 	"attach.volume": {
 		"awless attach volume id=vol-123oefwejf device=/dev/sdh instance=@redis",
 	},
+	"attach.vpcendpoint": {
+		"awless attach vpcendpoint id=vpce-1a2b3c4d routetables=@private-routetable",
+		"awless attach vpcendpoint id=vpce-2b3c4d5e subnets=@my-second-subnet securitygroups=@endpoints-sg",
+	},
 	"authenticate.registry": {
 		"awless authenticate registry",
+		"awless authenticate registry docker-config=true",
+		"awless authenticate registry accounts=123456789012 no-confirm=true",
+	},
+	"backup.instance": {
+		"awless backup instance id=@redis",
+		"awless backup i-0123",
+		"awless backup instance id=@redis reboot=true description='before upgrade'",
+		"awless backup instance id=@redis --run-in 24h",
+		"awless backup instance id=@redis --every 24h --times 7",
+	},
+	"bootstrap.instance": {
+		"awless bootstrap instance id=@redis script=./install-redis.sh",
+		"awless bootstrap i-0123 ./install-redis.sh",
+		"awless bootstrap instance id=@redis script=./setup.sh user=ubuntu timeout=300",
+	},
+	"check.alarm": {
+		"awless check alarm name=cpu-alarm state=OK timeout=600",
 	},
 	"check.database": {
 		"awless check database id=@mydb state=available timeout=180",
+		"awless check database id=@mydb state=stopped timeout=600",
+		"awless check database id=@mydb state=not-found timeout=900",
 	},
 	"check.distribution": {
 		"awless check distribution id=@mydistr state=Deployed timeout=180",
 	},
+	"check.elasticsearchdomain": {
+		"awless check elasticsearchdomain name=logs state=active timeout=900",
+	},
+	"check.environment": {
+		"awless check environment id=e-rpqsewtp2j state=Ready health=Green timeout=900",
+	},
+	"check.healthcheck": {
+		"awless check healthcheck id=0123-4567 state=healthy timeout=180",
+	},
 	"check.instance": {
 		"awless check instance id=@redis state=running timeout=180",
 	},
@@ -90,12 +140,18 @@ var cliExamplesDoc = map[string][]string{
 	"check.natgateway": {
 		"awless check natgateway id=@mynat state=active timeout=180",
 	},
+	"check.record": {
+		"awless check record id=/change/C2682N5HXP0BZ4 state=INSYNC timeout=300",
+	},
 	"check.scalinggroup": {
 		"awless check scalinggroup name=MyAutoScalingGroup count=3 timeout=180",
 	},
 	"check.securitygroup": {
 		"awless check securitygroup id=@mysshsecgroup state=unused timeout=180",
 	},
+	"check.table": {
+		"awless check table name=users state=active timeout=180",
+	},
 	"check.volume": {
 		"awless check volume id=vol-12r1o3rp state=available timeout=180",
 	},
@@ -111,6 +167,9 @@ var cliExamplesDoc = map[string][]string{
 	"create.alarm": {
 		" awless create alarm namespace=AWS/EC2 dimensions=AutoScalingGroupName:instancesScalingGroup evaluation-periods=2 metric=CPUUtilization name=scaleinAlarm operator=GreaterThanOrEqualToThreshold period=300 statistic-function=Average threshold=75",
 	},
+	"create.application": {
+		"awless create application name=my-app description='My web application'",
+	},
 	"create.appscalingpolicy": {
 		" awless create appscalingpolicy dimension=ecs:service:DesiredCount name=ScaleOutPolicy resource=service/my-ecs-cluster/my-service-deployment-name service-namespace=ecs stepscaling-adjustment-type=ChangeInCapacity stepscaling-adjustments=0::+1 type=StepScaling stepscaling-aggregation-type=Average stepscaling-cooldown=60",
 	},
@@ -134,16 +193,41 @@ var cliExamplesDoc = map[string][]string{
 	"create.dbsubnetgroup": {
 		"awless create dbsubnetgroup name=mydbsubnetgroup description=\"subnets for peps db\" subnets=[@my-firstsubnet, @my-secondsubnet]",
 	},
+	"create.dhcpoptions": {
+		"awless create dhcpoptions domain-name=corp.example.com domain-name-servers=AmazonProvidedDNS name=corp-dhcp",
+		"awless create dhcpoptions domain-name-servers=[10.0.0.2,10.0.0.3] ntp-servers=169.254.169.123",
+	},
 	"create.distribution": {
 		"awless create distribution origin-domain=mybucket.s3.amazonaws.com",
+		"awless create distribution origin-bucket=mybucket default-file=index.html",
+		"awless create distribution origin-domain=my-lb-1234.eu-west-1.elb.amazonaws.com origin-protocol=match-viewer certificate=arn:aws:acm:us-east-1:123456789012:certificate/12345678-1234-1234-1234-123456789012 domain-aliases=www.example.com",
+	},
+	"create.egressonlyinternetgateway": {
+		"awless create egressonlyinternetgateway vpc=@my-vpc",
 	},
 	"create.elasticip": {
 		"awless create elasticip domain=vpc",
 	},
+	"create.elasticsearchdomain": {
+		"awless create elasticsearchdomain name=logs version=5.5 type=t2.small.elasticsearch count=2 ebs-size=20",
+		"awless create elasticsearchdomain name=logs type=m4.large.elasticsearch ebs-size=100 ebs-type=gp2",
+	},
+	"create.environment": {
+		"awless create environment application=my-app name=my-app-prod solution-stack='64bit Amazon Linux 2018.03 v2.7.1 running Go 1.10' cname=my-app",
+		"awless create environment application=my-app name=my-app-worker template=worker-config tier=worker instance-type=t2.small keypair=@my-keypair",
+	},
+	"create.failover": {
+		"awless create failover zone=example.com name=www primary=my-lb primary-region=eu-west-1 secondary=my-lb secondary-region=us-east-1",
+		"awless create failover zone=example.com name=api primary=api-lb primary-region=eu-west-1 secondary=api-lb secondary-region=eu-central-1 protocol=HTTPS port=443 path=/health",
+	},
 	"create.function": {},
 	"create.group": {
 		"awless create name=admins",
 	},
+	"create.healthcheck": {
+		"awless create healthcheck protocol=HTTP host=www.mysite.com port=80 path=/health",
+		"awless create healthcheck protocol=TCP ip=52.1.2.3 port=443 interval=10 failure-threshold=2",
+	},
 	"create.image": {
 		"awless create image instance=@my-instance-name name=redis-image description='redis prod image'",
 		"awless create image instance=i-0ee436a45561c04df name=redis-image reboot=true",
@@ -154,6 +238,7 @@ var cliExamplesDoc = map[string][]string{
 		"awless create instance keypair=jsmith type=t2.micro subnet=@my-subnet",
 		"awless create instance image=ami-123456 keypair=jsmith",
 		"awless create instance name=redis type=t2.nano keypair=jsmith userdata=/home/jsmith/data.sh",
+		"awless create instance name=api type=general.large distro=amazonlinux # Latest generation general purpose type in region",
 		"", // create empty line for clarity
 		"awless create instance distro=redhat type=t2.micro",
 		"awless create instance distro=coreos name=redis-prod",
@@ -164,112 +249,211 @@ var cliExamplesDoc = map[string][]string{
 		"awless create instance distro=amazonlinux:::::instance-store",
 		"awless create instance distro=amazonlinux:amzn2",
 	},
-	"create.instanceprofile":     {},
-	"create.internetgateway":     {},
-	"create.keypair":             {},
+	"create.instanceprofile": {},
+	"create.internetgateway": {},
+	"create.keypair": {
+		"awless create keypair name=my-key encrypted=true",
+		"awless create keypair name=my-key ppk=true # also save ~/.awless/keys/my-key.ppk for PuTTY",
+	},
 	"create.launchconfiguration": {},
 	"create.listener":            {},
 	"create.loadbalancer":        {},
-	"create.loginprofile":        {},
-	"create.natgateway":          {},
-	"create.policy":              {},
-	"create.queue":               {},
-	"create.record":              {},
-	"create.repository":          {},
-	"create.role":                {},
-	"create.route":               {},
-	"create.routetable":          {},
-	"create.s3object":            {},
-	"create.scalinggroup":        {},
-	"create.scalingpolicy":       {},
+	"create.loginprofile": {
+		"awless create loginprofile username=jsmith password=auto(24) password-reset=true # generate a random password",
+	},
+	"create.natgateway": {},
+	"create.policy":     {},
+	"create.queue":      {},
+	"create.record": {
+		"awless create record zone=Z1KO5I0IS5OBV6 name=www.mysite.com type=A value=52.1.2.3 ttl=60",
+		"awless create record zone=Z1KO5I0IS5OBV6 name=www.mysite.com type=A value=52.1.2.3 ttl=60 failover=primary healthcheck=0123-4567",
+		"awless create record zone=Z1KO5I0IS5OBV6 name=www.mysite.com type=A value=34.5.6.7 ttl=60 failover=secondary",
+		"awless create record zone=mysite.com. name=@ type=MX value='10 mail.mysite.com' ttl=300 # record at the zone apex",
+		"awless create record zone=mysite.com. name=*.mysite.com type=CNAME value=www.mysite.com ttl=300",
+	},
+	"create.records": {
+		"awless create records zone=mysite.com. records=['www.mysite.com 300 A 52.1.2.3','www.mysite.com 300 A 52.4.5.6','api.mysite.com 300 CNAME www.mysite.com']",
+	},
+	"create.repository": {},
+	"create.role":       {},
+	"create.route": {
+		"awless create route table=@my-table cidr=0.0.0.0/0 gateway=@my-igw",
+		"awless create route table=@my-table ipv6-cidr=::/0 egressonlygateway=eigw-0123456789abcdef0",
+	},
+	"create.routetable": {},
+	"create.s3object":   {},
+	"create.scalinggroup": {
+		"awless create scalinggroup name=my-scalinggroup launchconfiguration=my-launchconfiguration subnets=[@my-subnet-1,@my-subnet-2] min-size=1 max-size=4 desired-capacity=2 targetgroups=@my-targetgroup",
+	},
+	"create.scalingpolicy": {},
 	"create.securitygroup": {
 		"awless create securitygroup vpc=@myvpc name=ssh-only description=ssh-access",
 		"(... see more params at `awless update securitygroup -h`)",
 	},
-	"create.snapshot":            {},
-	"create.stack":               {},
-	"create.subnet":              {},
-	"create.subscription":        {},
-	"create.tag":                 {},
-	"create.targetgroup":         {},
-	"create.topic":               {},
-	"create.user":                {},
-	"create.volume":              {},
-	"create.vpc":                 {},
-	"create.zone":                {},
-	"delete.accesskey":           {},
-	"delete.alarm":               {},
-	"delete.appscalingpolicy":    {},
-	"delete.appscalingtarget":    {},
-	"delete.bucket":              {},
-	"delete.containercluster":    {},
-	"delete.containertask":       {},
-	"delete.database":            {},
-	"delete.dbsubnetgroup":       {},
-	"delete.distribution":        {},
-	"delete.elasticip":           {},
-	"delete.function":            {},
-	"delete.group":               {},
-	"delete.image":               {},
-	"delete.instance":            {},
-	"delete.instanceprofile":     {},
-	"delete.internetgateway":     {},
-	"delete.keypair":             {},
-	"delete.launchconfiguration": {},
-	"delete.listener":            {},
-	"delete.loadbalancer":        {},
-	"delete.loginprofile":        {},
-	"delete.natgateway":          {},
-	"delete.policy":              {},
-	"delete.queue":               {},
-	"delete.record":              {},
-	"delete.repository":          {},
-	"delete.role":                {},
-	"delete.route":               {},
-	"delete.routetable":          {},
-	"delete.s3object":            {},
-	"delete.scalinggroup":        {},
-	"delete.scalingpolicy":       {},
-	"delete.securitygroup":       {},
-	"delete.snapshot":            {},
-	"delete.stack":               {},
-	"delete.subnet":              {},
-	"delete.subscription":        {},
-	"delete.tag":                 {},
-	"delete.targetgroup":         {},
-	"delete.topic":               {},
+	"create.snapshot": {},
+	"create.stack":    {},
+	"create.subnet": {
+		"awless create subnet vpc=@my-vpc cidr=10.0.1.0/24 ipv6-cidr=2600:1f18:1234:5600::/64 name=my-subnet",
+	},
+	"create.subscription": {
+		"awless create subscription topic=@my-topic protocol=sqs endpoint=arn:aws:sqs:eu-west-1:123456789012:my-queue",
+		"awless create subscription topic=@my-topic protocol=email endpoint=john@example.com",
+	},
+	"create.table": {
+		"awless create table name=users hash-key=id",
+		"awless create table name=events hash-key=user hash-key-type=S range-key=timestamp range-key-type=N read-capacity=10 write-capacity=10",
+	},
+	"create.tag":         {},
+	"create.targetgroup": {},
+	"create.topic": {
+		"awless create topic name=my-topic",
+	},
+	"create.user":   {},
+	"create.volume": {},
+	"create.vpc": {
+		"awless create vpc cidr=10.0.0.0/16 ipv6=true name=my-vpc",
+	},
+	"create.vpcendpoint": {
+		"awless create vpcendpoint vpc=@my-vpc service=s3 routetables=[@private-routetable,@other-routetable] name=s3-endpoint",
+		"awless create vpcendpoint vpc=@my-vpc service=dynamodb routetables=@private-routetable",
+		"awless create vpcendpoint vpc=@my-vpc service=ssm subnets=[@private-subnet-a,@private-subnet-b] securitygroups=@endpoints-sg private-dns=true",
+	},
+	"create.zone":                      {},
+	"delete.accesskey":                 {},
+	"delete.alarm":                     {},
+	"delete.application":               {},
+	"delete.appscalingpolicy":          {},
+	"delete.appscalingtarget":          {},
+	"delete.bucket":                    {},
+	"delete.containercluster":          {},
+	"delete.containertask":             {},
+	"delete.database":                  {},
+	"delete.dbsubnetgroup":             {},
+	"delete.dhcpoptions":               {},
+	"delete.distribution":              {},
+	"delete.egressonlyinternetgateway": {},
+	"delete.elasticip":                 {},
+	"delete.elasticsearchdomain":       {},
+	"delete.environment":               {},
+	"delete.function":                  {},
+	"delete.group":                     {},
+	"delete.healthcheck":               {},
+	"delete.image":                     {},
+	"delete.instance":                  {},
+	"delete.instanceprofile":           {},
+	"delete.internetgateway":           {},
+	"delete.keypair":                   {},
+	"delete.launchconfiguration":       {},
+	"delete.listener":                  {},
+	"delete.loadbalancer":              {},
+	"delete.loginprofile":              {},
+	"delete.natgateway":                {},
+	"delete.policy":                    {},
+	"delete.queue":                     {},
+	"delete.record": {
+		"awless delete record zone=mysite.com. name=@ type=MX value='10 mail.mysite.com' ttl=300",
+	},
+	"delete.records": {
+		"awless delete records zone=mysite.com. records=['api.mysite.com 300 CNAME www.mysite.com','old.mysite.com 60 A 34.5.6.7']",
+	},
+	"delete.repository":    {},
+	"delete.role":          {},
+	"delete.route":         {},
+	"delete.routetable":    {},
+	"delete.s3object":      {},
+	"delete.scalinggroup":  {},
+	"delete.scalingpolicy": {},
+	"delete.securitygroup": {},
+	"delete.snapshot":      {},
+	"delete.stack":         {},
+	"delete.subnet":        {},
+	"delete.subscription": {
+		"awless delete subscription id=arn:aws:sns:eu-west-1:123456789012:my-topic:1d2c3f4e-5a6b-7c8d-9e0f-123456789abc",
+	},
+	"delete.table":       {},
+	"delete.tag":         {},
+	"delete.targetgroup": {},
+	"delete.topic": {
+		"awless delete topic id=@my-topic",
+	},
 	"delete.user": {
 		"awless delete user name=john",
 	},
-	"delete.volume":          {},
-	"delete.vpc":             {},
-	"delete.zone":            {},
-	"detach.alarm":           {},
-	"detach.containertask":   {},
+	"delete.volume": {},
+	"delete.vpc":    {},
+	"delete.vpcendpoint": {
+		"awless delete vpcendpoint id=vpce-1a2b3c4d",
+	},
+	"delete.zone":          {},
+	"detach.alarm":         {},
+	"detach.containertask": {},
+	"detach.dhcpoptions": {
+		"awless detach dhcpoptions id=dopt-1a2b3c4d vpc=@my-vpc",
+	},
 	"detach.elasticip":       {},
 	"detach.instance":        {},
 	"detach.instanceprofile": {},
 	"detach.internetgateway": {},
 	"detach.policy":          {},
-	"detach.role":            {},
-	"detach.routetable":      {},
-	"detach.securitygroup":   {},
-	"detach.user":            {},
-	"detach.volume":          {},
-	"import.image":           {},
-	"start.alarm":            {},
-	"start.containertask":    {},
-	"start.instance":         {},
-	"stop.alarm":             {},
-	"stop.containertask":     {},
-	"stop.instance":          {},
-	"update.bucket":          {},
+	"detach.queuepolicy": {
+		"awless detach queuepolicy url=@my-queue # remove the whole policy of the queue",
+	},
+	"detach.role":          {},
+	"detach.routetable":    {},
+	"detach.scalinggroup":  {},
+	"detach.securitygroup": {},
+	"detach.user":          {},
+	"detach.volume":        {},
+	"detach.vpcendpoint": {
+		"awless detach vpcendpoint id=vpce-1a2b3c4d routetables=@private-routetable",
+	},
+	"import.image": {},
+	"invoke.function": {
+		"awless invoke function id=my-function payload='{\"name\": \"john\"}'",
+		"awless invoke function id=my-function version=prod async=true",
+	},
+	"restore.backup": {
+		"awless restore backup id=ami-0123",
+		"awless restore backup id=ami-0123 type=t2.small subnet=@my-subnet name=redis-restored",
+	},
+	"start.alarm":         {},
+	"start.containertask": {},
+	"start.instance":      {},
+	"stop.alarm":          {},
+	"stop.containertask":  {},
+	"stop.instance":       {},
+	"update.bucket": {
+		"awless update bucket name=my-bucket-name encryption=AES256 block-public-access=true",
+		"awless update bucket name=my-bucket-name encryption=aws:kms encryption-key=arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+	},
 	"update.classicloadbalancer": {
 		"awless update classicloadbalancer name=my-loadb health-target=HTTP:80/health health-interval=30 health-timeout=5 healthy-threshold=10 unhealthy-threshold=2",
 	},
 	"update.containertask": {},
-	"update.distribution":  {},
-	"update.instance":      {},
+	"update.distribution": {
+		"awless update distribution id=E2QWRUHAPOMQZL enable=false",
+		"awless update distribution id=E2QWRUHAPOMQZL origin-domain=my-lb-1234.eu-west-1.elb.amazonaws.com origin-protocol=https-only",
+	},
+	"update.elasticsearchdomain": {
+		"awless update elasticsearchdomain name=logs count=4",
+		"awless update elasticsearchdomain name=logs type=m4.large.elasticsearch ebs-size=200",
+	},
+	"update.environment": {
+		"awless update environment id=e-rpqsewtp2j version=v1.2.0",
+		"awless update environment id=e-rpqsewtp2j swap-cname=e-ftvsjmv2wn",
+	},
+	"update.function": {
+		"awless update function id=my-function zipfile=./build/function.zip publish=true",
+		"awless update function id=my-function bucket=my-deploy-bucket object=functions/v2.zip",
+		"awless update function id=my-function memory=512 timeout=30 runtime=python3.6",
+	},
+	"update.instance": {
+		"awless update instance id=@my-instance lock=true # Enable termination protection",
+		"awless update instance id=@my-nat-instance source-dest-check=false",
+		"awless update instance id=@my-instance shutdown-behavior=terminate",
+		"awless update instance id=@my-instance securitygroups=[@web-sg,@ssh-sg] # Replace all the securitygroups of the instance",
+		"awless update instance id=@my-instance type=t2.large",
+	},
 	"update.image": {
 		"awless update image id=@my-image description=new-description",
 		"awless update image id=ami-bd6bb2c5 groups=all operation=add # Make an AMI public",
@@ -279,14 +463,42 @@ var cliExamplesDoc = map[string][]string{
 	},
 	"update.loginprofile": {},
 	"update.policy":       {},
-	"update.record":       {},
-	"update.s3object":     {},
-	"update.scalinggroup": {},
+	"update.queue": {
+		"awless update queue url=@my-queue visibility-timeout=120 retention-period=86400",
+		`awless update queue url=@my-queue redrive-policy='{"deadLetterTargetArn":"arn:aws:sqs:eu-west-1:123456789012:my-dead-letters","maxReceiveCount":"5"}'`,
+	},
+	"update.record": {
+		"awless update record zone=Z1KO5I0IS5OBV6 name=www.mysite.com type=A value=52.1.2.3 ttl=60 # creates the record if missing",
+		"awless update record zone=mysite.com. name=@ type=A values=52.1.2.3,52.4.5.6 ttl=300 comment='new frontends'",
+	},
+	"update.records": {
+		"awless update records zone=mysite.com. records=['www.mysite.com 60 A 52.1.2.3','@ 300 MX 10 mail.mysite.com']",
+	},
+	"update.s3object": {},
+	"update.scalinggroup": {
+		"awless update scalinggroup name=my-scalinggroup min-size=2 max-size=8 desired-capacity=4",
+	},
 	"update.securitygroup": {
 		"awless update securitygroup id=@ssh-only inbound=authorize protocol=tcp cidr=0.0.0.0/0 portrange=26257",
 		"awless update securitygroup id=@ssh-only inbound=authorize protocol=tcp securitygroup=sg-123457 portrange=8080",
 	},
-	"update.stack":       {},
-	"update.subnet":      {},
+	"update.stack": {},
+	"update.subnet": {
+		"awless update subnet id=@my-subnet ipv6-cidr=2600:1f18:1234:5601::/64 ipv6-on-launch=true",
+	},
+	"update.table": {
+		"awless update table name=users read-capacity=20 write-capacity=10",
+	},
 	"update.targetgroup": {},
+	"update.vpc": {
+		"awless update vpc id=@my-vpc dns-support=true dns-hostnames=true",
+		"awless update vpc id=@my-vpc ipv6=true",
+	},
+	"verify.domain": {
+		"awless verify domain name=example.com",
+		"awless verify domain name=mail.example.com zone=/hostedzone/Z1234ABCD dkim=false",
+	},
+	"verify.email": {
+		"awless verify email address=john.smith@example.com",
+	},
 }
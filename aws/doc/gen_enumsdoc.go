@@ -0,0 +1,202 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// DO NOT EDIT
+// This file was automatically generated with go generate
+package awsdoc
+
+var EnumDoc = map[string][]string{
+	"attach.mfadevice.mfa-code-1":                          {""},
+	"attach.mfadevice.mfa-code-2":                          {""},
+	"attach.mfadevice.no-prompt":                           {"true", "false"},
+	"attach.policy.access":                                 {"readonly", "full"},
+	"attach.policy.service":                                {"iam", "ec2", "s3", "route53", "elbv2", "rds", "autoscaling", "lambda", "sns", "sqs", "cloudwatch", "cloudfront", "ecr", "ecs", "applicationautoscaling", "acm", "sts", "cloudformation"},
+	"check.alarm.state":                                    {"OK", "ALARM", "INSUFFICIENT_DATA", "not-found"},
+	"check.alarm.timeout":                                  {"10", "60", "180", "300", "600", "900"},
+	"check.certificate.state":                              {"issued", "pending_validation", "not-found"},
+	"check.certificate.timeout":                            {"10", "60", "180", "300", "600", "900"},
+	"check.database.state":                                 {"available", "backing-up", "creating", "deleting", "failed", "maintenance", "modifying", "rebooting", "renaming", "resetting-master-credentials", "restore-error", "starting", "stopped", "stopping", "storage-full", "upgrading", "not-found"},
+	"check.database.timeout":                               {"10", "60", "180", "300", "600", "900"},
+	"check.distribution.state":                             {"Deployed", "InProgress", "not-found"},
+	"check.distribution.timeout":                           {"300", "900", "1800"},
+	"check.elasticsearchdomain.state":                      {"active", "processing", "deleting", "not-found"},
+	"check.elasticsearchdomain.timeout":                    {"10", "60", "180", "300", "600", "900"},
+	"check.environment.health":                             {"Green", "Yellow", "Red", "Grey"},
+	"check.environment.state":                              {"Launching", "Updating", "Ready", "Terminating", "Terminated", "not-found"},
+	"check.environment.timeout":                            {"10", "60", "180", "300", "600", "900"},
+	"check.healthcheck.state":                              {"healthy", "unhealthy", "not-found"},
+	"check.healthcheck.timeout":                            {"10", "60", "180", "300", "600", "900"},
+	"check.instance.state":                                 {"pending", "running", "shutting-down", "terminated", "stopping", "stopped", "not-found"},
+	"check.instance.timeout":                               {"10", "60", "180", "300", "600", "900"},
+	"check.loadbalancer.state":                             {"provisioning", "active", "failed", "not-found"},
+	"check.loadbalancer.timeout":                           {"10", "60", "180", "300", "600", "900"},
+	"check.natgateway.state":                               {"pending", "failed", "available", "deleting", "deleted", "not-found"},
+	"check.natgateway.timeout":                             {"10", "60", "180", "300", "600", "900"},
+	"check.networkinterface.state":                         {"available", "attaching", "detaching", "in-use", "not-found"},
+	"check.networkinterface.timeout":                       {"10", "60", "180", "300", "600", "900"},
+	"check.record.state":                                   {"PENDING", "INSYNC", "not-found"},
+	"check.record.timeout":                                 {"10", "60", "180", "300", "600", "900"},
+	"check.scalinggroup.count":                             {"0"},
+	"check.scalinggroup.timeout":                           {"10", "60", "180", "300", "600", "900"},
+	"check.securitygroup.state":                            {"unused"},
+	"check.securitygroup.timeout":                          {"10", "60", "180", "300", "600", "900"},
+	"check.table.state":                                    {"ACTIVE", "CREATING", "UPDATING", "DELETING", "not-found"},
+	"check.table.timeout":                                  {"10", "60", "180", "300", "600", "900"},
+	"check.volume.state":                                   {"available", "in-use", "not-found"},
+	"check.volume.timeout":                                 {"10", "60", "180", "300", "600", "900"},
+	"copy.image.source-id":                                 {""},
+	"copy.image.source-region":                             {"us-east-1", "us-east-2", "us-west-1", "us-west-2", "eu-west-1", "eu-west-2", "eu-west-3", "eu-central-1", "ca-central-1", "ap-northeast-1", "ap-northeast-2", "ap-southeast-1", "ap-southeast-2", "ap-south-1", "sa-east-1"},
+	"create.accesskey.save":                                {"true", "false"},
+	"create.alarm.operator":                                {"GreaterThanThreshold", "LessThanThreshold", "LessThanOrEqualToThreshold", "GreaterThanOrEqualToThreshold"},
+	"create.alarm.statistic-function":                      {"Minimum", "Maximum", "Sum", "Average", "SampleCount", "pNN.NN"},
+	"create.alarm.unit":                                    {"Seconds", "Microseconds", "Milliseconds", "Bytes", "Kilobytes", "Megabytes", "Gigabytes", "Terabytes", "Bits", "Kilobits", "Megabits", "Gigabits", "Terabits", "Percent", "Count", "Bytes/Second", "Kilobytes/Second", "Megabytes/Second", "Gigabytes/Second", "Terabytes/Second", "Bits/Second", "Kilobits/Second", "Megabits/Second", "Gigabits/Second", "Terabits/Second", "Count/Second", "None"},
+	"create.appscalingpolicy.dimension":                    {"ecs:service:DesiredCount", "ec2:spot-fleet-request:TargetCapacity", "elasticmapreduce:instancegroup:InstanceCount", "appstream:fleet:DesiredCapacity", "dynamodb:table:ReadCapacityUnits", "dynamodb:table:WriteCapacityUnits", "dynamodb:index:ReadCapacityUnits", "dynamodb:index:WriteCapacityUnits"},
+	"create.appscalingpolicy.service-namespace":            {"ecs", "ec2", "elasticmapreduce", "appstream", "dynamodb"},
+	"create.appscalingpolicy.stepscaling-adjustment-type":  {"ChangeInCapacity", "ExactCapacity", "PercentChangeInCapacity"},
+	"create.appscalingpolicy.stepscaling-adjustments":      {"0::+1", ":0:-1", "75::+1"},
+	"create.appscalingpolicy.stepscaling-aggregation-type": {"Minimum", "Maximum", "Average"},
+	"create.appscalingpolicy.type":                         {"StepScaling"},
+	"create.appscalingtarget.dimension":                    {"ecs:service:DesiredCount", "ec2:spot-fleet-request:TargetCapacity", "elasticmapreduce:instancegroup:InstanceCount", "appstream:fleet:DesiredCapacity", "dynamodb:table:ReadCapacityUnits", "dynamodb:table:WriteCapacityUnits", "dynamodb:index:ReadCapacityUnits", "dynamodb:index:WriteCapacityUnits"},
+	"create.appscalingtarget.service-namespace":            {"ecs", "ec2", "elasticmapreduce", "appstream", "dynamodb"},
+	"create.bucket.acl":                                    {"private", "public-read", "public-read-write", "aws-exec-read", "authenticated-read", "bucket-owner-read", "bucket-owner-full-control", "log-delivery-write"},
+	"create.database.copytagstosnapshot":                   {"true", "false"},
+	"create.database.encrypted":                            {"true", "false"},
+	"create.database.engine":                               {"mysql", "mariadb", "postgres", "aurora", "oracle-se1", "oracle-se2", "oracle-se", "oracle-ee", "sqlserver-ee", "sqlserver-se", "sqlserver-ex", "sqlserver-web"},
+	"create.database.license":                              {"license-included", "bring-your-own-license", "general-public-license"},
+	"create.database.multiaz":                              {"true", "false"},
+	"create.database.public":                               {"true", "false"},
+	"create.database.storagetype":                          {"standard", "gp2", "io1"},
+	"create.database.type":                                 {"db.t1.micro", "db.m1.small", "db.m1.medium", "db.m1.large", "db.m1.xlarge", "db.m2.xlarge", "db.m2.2xlarge", "db.m2.4xlarge", "db.m3.medium", "db.m3.large", "db.m3.xlarge", "db.m3.2xlarge", "db.m4.large", "db.m4.xlarge", "db.m4.2xlarge", "db.m4.4xlarge", "db.m4.10xlarge", "db.r3.large", "db.r3.xlarge", "db.r3.2xlarge", "db.r3.4xlarge", "db.r3.8xlarge", "db.t2.micro", "db.t2.small", "db.t2.medium", "db.t2.large"},
+	"create.dhcpoptions.domain-name-servers":               {"AmazonProvidedDNS"},
+	"create.dhcpoptions.netbios-node-type":                 {"2", "1", "4", "8"},
+	"create.distribution.default-file":                     {"index.html"},
+	"create.distribution.enable":                           {"true", "false"},
+	"create.distribution.forward-cookies":                  {"all", "none", "whitelist"},
+	"create.distribution.forward-queries":                  {"true", "false"},
+	"create.distribution.https-behaviour":                  {"allow-all", "redirect-to-https", "https-only"},
+	"create.distribution.origin-protocol":                  {"http-only", "https-only", "match-viewer"},
+	"create.distribution.price-class":                      {"PriceClass_All", "PriceClass_100", "PriceClass_200"},
+	"create.elasticip.domain":                              {"vpc", "ec2-classic"},
+	"create.elasticsearchdomain.ebs-type":                  {"standard", "gp2", "io1"},
+	"create.elasticsearchdomain.type":                      {"t2.small.elasticsearch", "t2.medium.elasticsearch", "m4.large.elasticsearch", "m4.xlarge.elasticsearch", "c4.large.elasticsearch", "r4.large.elasticsearch", "i3.large.elasticsearch"},
+	"create.elasticsearchdomain.version":                   {"1.5", "2.3", "5.1", "5.3", "5.5", "6.0"},
+	"create.environment.instance-type":                     {"t2.nano", "t2.micro", "t2.small", "t2.medium", "t2.large", "t2.xlarge", "t2.2xlarge", "m4.large", "m4.xlarge", "c4.large", "c4.xlarge"},
+	"create.environment.tier":                              {"webserver", "worker"},
+	"create.function.runtime":                              {"nodejs", "nodejs4.3", "nodejs6.10", "nodejs8.10", "java8", "python2.7", "python3.6", "dotnetcore1.0", "dotnetcore2.0", "go1.x", "nodejs4.3-edge"},
+	"create.healthcheck.interval":                          {"10", "30"},
+	"create.healthcheck.protocol":                          {"HTTP", "HTTPS", "TCP"},
+	"create.image.reboot":                                  {"true", "false"},
+	"create.instance.distro":                               {"amazonlinux", "canonical:ubuntu", "redhat:rhel", "debian:debian", "centos:centos", "coreos:coreos", "suselinux", "windows:server"},
+	"create.instance.lock":                                 {"true", "false"},
+	"create.instance.type":                                 {"t2.nano", "t2.micro", "t2.small", "t2.medium", "t2.large", "t2.xlarge", "t2.2xlarge", "m4.large", "m4.xlarge", "c4.large", "c4.xlarge"},
+	"create.instance.userdata":                             {""},
+	"create.keypair.encrypted":                             {"true", "false"},
+	"create.launchconfiguration.distro":                    {"amazonlinux", "canonical:ubuntu", "redhat:rhel", "debian:debian", "centos:centos", "coreos:coreos", "suselinux", "windows:server"},
+	"create.launchconfiguration.public":                    {"true", "false"},
+	"create.launchconfiguration.type":                      {"t2.nano", "t2.micro", "t2.small", "t2.medium", "t2.large", "t2.xlarge", "t2.2xlarge", "m4.large", "m4.xlarge", "c4.large", "c4.xlarge"},
+	"create.launchconfiguration.userdata":                  {""},
+	"create.listener.actiontype":                           {"forward"},
+	"create.listener.protocol":                             {"HTTP", "HTTPS"},
+	"create.listener.sslpolicy":                            {"ELBSecurityPolicy-2016-08", "ELBSecurityPolicy-TLS-1-2-2017-01", "ELBSecurityPolicy-TLS-1-1-2017-01", "ELBSecurityPolicy-2015-05", "ELBSecurityPolicy-TLS-1-0-2015-04"},
+	"create.policy.action":                                 {""},
+	"create.policy.effect":                                 {"Allow", "Deny"},
+	"create.policy.resource":                               {"*"},
+	"create.record.failover":                               {"PRIMARY", "SECONDARY"},
+	"create.record.type":                                   {"A", "AAAA", "CNAME", "MX", "NAPTR", "NS", "PTR", "SOA", "SPF", "SRV", "TXT"},
+	"create.s3object.acl":                                  {"private", "public-read", "public-read-write", "aws-exec-read", "authenticated-read", "bucket-owner-read", "bucket-owner-full-control", "log-delivery-write"},
+	"create.scalinggroup.healthcheck-type":                 {"EC2", "ELB"},
+	"create.scalingpolicy.adjustment-type":                 {"ChangeInCapacity", "ExactCapacity", "PercentChangeInCapacity"},
+	"create.stack.capabilities":                            {"CAPABILITY_IAM", "CAPABILITY_NAMED_IAM"},
+	"create.stack.on-failure":                              {"DO_NOTHING", "ROLLBACK", "DELETE"},
+	"create.subnet.public":                                 {"true", "false"},
+	"create.subscription.protocol":                         {"http", "https", "email", "email-json", "sms", "sqs", "application", "lambda"},
+	"create.table.hash-key-type":                           {"S", "N", "B"},
+	"create.table.range-key-type":                          {"S", "N", "B"},
+	"create.vpc.ipv6":                                      {"true", "false"},
+	"create.vpcendpoint.private-dns":                       {"true", "false"},
+	"create.vpcendpoint.service":                           {"s3", "dynamodb", "ec2", "ecr.api", "ecr.dkr", "kms", "logs", "sns", "sqs", "ssm"},
+	"create.vpcendpoint.type":                              {"gateway", "interface"},
+	"create.zone.isprivate":                                {"true", "false"},
+	"delete.containertask.all-versions":                    {"true", "false"},
+	"delete.database.skip-snapshot":                        {"true", "false"},
+	"delete.image.delete-snapshots":                        {"true", "false"},
+	"delete.policy.all-versions":                           {"true", "false"},
+	"delete.record.type":                                   {"A", "AAAA", "CNAME", "MX", "NAPTR", "NS", "PTR", "SOA", "SPF", "SRV", "TXT"},
+	"detach.networkinterface.force":                        {"true", "false"},
+	"import.image.architecture":                            {"i386", "x86_64"},
+	"import.image.license":                                 {"AWS", "BYOL"},
+	"import.image.platform":                                {"Windows", "Linux"},
+	"invoke.function.async":                                {"true", "false"},
+	"restart.database.with-failover":                       {"true", "false"},
+	"start.containertask.type":                             {"task", "service"},
+	"stop.containertask.type":                              {"task", "service"},
+	"update.bucket.acl":                                    {"private", "public-read", "public-read-write", "aws-exec-read", "authenticated-read", "bucket-owner-read", "bucket-owner-full-control", "log-delivery-write"},
+	"update.bucket.block-public-access":                    {"true", "false"},
+	"update.bucket.encryption":                             {"AES256", "aws:kms", "none"},
+	"update.bucket.index-suffix":                           {"index.html"},
+	"update.bucket.public-website":                         {"true", "false"},
+	"update.distribution.default-file":                     {"index.html"},
+	"update.distribution.enable":                           {"true", "false"},
+	"update.distribution.forward-cookies":                  {"all", "none", "whitelist"},
+	"update.distribution.forward-queries":                  {"true", "false"},
+	"update.distribution.https-behaviour":                  {"allow-all", "redirect-to-https", "https-only"},
+	"update.distribution.origin-protocol":                  {"http-only", "https-only", "match-viewer"},
+	"update.distribution.price-class":                      {"PriceClass_All", "PriceClass_100", "PriceClass_200"},
+	"update.elasticsearchdomain.ebs-type":                  {"standard", "gp2", "io1"},
+	"update.function.publish":                              {"true", "false"},
+	"update.function.runtime":                              {"nodejs", "nodejs4.3", "nodejs6.10", "nodejs8.10", "java8", "python2.7", "python3.6", "dotnetcore1.0", "dotnetcore2.0", "go1.x", "nodejs4.3-edge"},
+	"update.image.operation":                               {"add", "remove"},
+	"update.instance.lock":                                 {"true", "false"},
+	"update.instance.shutdown-behavior":                    {"stop", "terminate"},
+	"update.instance.source-dest-check":                    {"true", "false"},
+	"update.instance.type":                                 {"t2.nano", "t2.micro", "t2.small", "t2.medium", "t2.large", "t2.xlarge", "t2.2xlarge", "m4.large", "m4.xlarge", "c4.large", "c4.xlarge"},
+	"update.policy.effect":                                 {"Allow", "Deny"},
+	"update.record.type":                                   {"A", "AAAA", "CNAME", "MX", "NAPTR", "NS", "PTR", "SOA", "SPF", "SRV", "TXT"},
+	"update.s3object.acl":                                  {"private", "public-read", "public-read-write", "aws-exec-read", "authenticated-read", "bucket-owner-read", "bucket-owner-full-control", "log-delivery-write"},
+	"update.securitygroup.inbound":                         {"revoke", "authorize"},
+	"update.securitygroup.outbound":                        {"revoke", "authorize"},
+	"update.securitygroup.portrange":                       {""},
+	"update.securitygroup.protocol":                        {"tcp", "udp", "icmp", "any"},
+	"update.stack.capabilities":                            {"CAPABILITY_IAM", "CAPABILITY_NAMED_IAM"},
+	"update.subnet.ipv6-on-launch":                         {"true", "false"},
+	"update.subnet.public":                                 {"true", "false"},
+	"update.targetgroup.stickiness":                        {"true", "false"},
+	"update.vpc.dns-hostnames":                             {"true", "false"},
+	"update.vpc.dns-support":                               {"true", "false"},
+	"update.vpc.ipv6":                                      {"true"},
+	"verify.domain.dkim":                                   {"true", "false"},
+}
+
+var ParamTypeDoc = map[string]*ParamType{
+	"attach.mfadevice.user":       {ResourceType: "user", PropertyName: "Name"},
+	"attach.policy.arn":           {ResourceType: "policy", PropertyName: "Arn"},
+	"attach.policy.group":         {ResourceType: "group", PropertyName: "Name"},
+	"attach.policy.role":          {ResourceType: "role", PropertyName: "Name"},
+	"attach.policy.user":          {ResourceType: "user", PropertyName: "Name"},
+	"attach.role.instanceprofile": {ResourceType: "instanceprofile", PropertyName: "Name"},
+	"attach.user.group":           {ResourceType: "group", PropertyName: "Name"},
+	"attach.user.name":            {ResourceType: "user", PropertyName: "Name"},
+	"create.accesskey.user":       {ResourceType: "user", PropertyName: "Name"},
+	"create.instance.role":        {ResourceType: "role", PropertyName: "Name"},
+	"create.record.values":        {ResourceType: "record", PropertyName: "Records"},
+	"delete.policy.arn":           {ResourceType: "policy", PropertyName: "Arn"},
+	"detach.policy.arn":           {ResourceType: "policy", PropertyName: "Arn"},
+	"detach.policy.group":         {ResourceType: "group", PropertyName: "Name"},
+	"detach.policy.role":          {ResourceType: "role", PropertyName: "Name"},
+	"detach.policy.user":          {ResourceType: "user", PropertyName: "Name"},
+	"detach.role.instanceprofile": {ResourceType: "instanceprofile", PropertyName: "Name"},
+	"update.policy.arn":           {ResourceType: "policy", PropertyName: "Arn"},
+	"update.securitygroup.cidr":   {ResourceType: "subnet", PropertyName: "CIDR"},
+}
@@ -21,6 +21,10 @@ var generatedParamsDoc = map[string]map[string]string{
 	"attach.alarm":               {},
 	"attach.classicloadbalancer": {},
 	"attach.containertask":       {},
+	"attach.dhcpoptions": {
+		"id":  "The ID of the DHCP options set",
+		"vpc": "The ID of the VPC",
+	},
 	"attach.elasticip": {
 		"allow-reassociation": "For a VPC in an EC2-Classic account, specify true to allow an Elastic IP address that is already associated with an instance or network interface to be reassociated with the specified instance or network interface",
 		"id":               "The allocation ID",
@@ -50,7 +54,8 @@ var generatedParamsDoc = map[string]map[string]string{
 		"id":           "The ID of the network interface",
 		"instance":     "The ID of the instance",
 	},
-	"attach.policy": {},
+	"attach.policy":      {},
+	"attach.queuepolicy": {},
 	"attach.role": {
 		"instanceprofile": "The name of the instance profile to update",
 		"name":            "The name of the role to add",
@@ -59,6 +64,7 @@ var generatedParamsDoc = map[string]map[string]string{
 		"id":     "The ID of the route table",
 		"subnet": "The ID of the subnet",
 	},
+	"attach.scalinggroup":  {},
 	"attach.securitygroup": {},
 	"attach.user": {
 		"group": "The name of the group to update",
@@ -69,16 +75,30 @@ var generatedParamsDoc = map[string]map[string]string{
 		"id":       "The ID of the EBS volume",
 		"instance": "The ID of the instance",
 	},
+	"attach.vpcendpoint": {
+		"id":             "The ID of the endpoint",
+		"routetables":    "(Gateway endpoint) One or more route tables IDs to associate with the endpoint",
+		"securitygroups": "(Interface endpoint) One or more security group IDs to associate with the network interface",
+		"subnets":        "(Interface endpoint) One or more subnet IDs in which to serve the endpoint",
+	},
 	"authenticate.registry":  {},
+	"backup.instance":        {},
+	"bootstrap.instance":     {},
+	"check.alarm":            {},
 	"check.certificate":      {},
 	"check.database":         {},
 	"check.distribution":     {},
+	"check.elasticsearchdomain": {},
+	"check.environment":      {},
+	"check.healthcheck":      {},
 	"check.instance":         {},
 	"check.loadbalancer":     {},
 	"check.natgateway":       {},
 	"check.networkinterface": {},
+	"check.record":           {},
 	"check.scalinggroup":     {},
 	"check.securitygroup":    {},
+	"check.table":           {},
 	"check.volume":           {},
 	"copy.image": {
 		"description":   "A description for the new AMI in the destination region",
@@ -113,6 +133,7 @@ var generatedParamsDoc = map[string]map[string]string{
 		"threshold":                "The value against which the specified statistic is compared",
 		"unit":                     "The unit of measure for the statistic",
 	},
+	"create.application": {},
 	"create.appscalingpolicy": {
 		"dimension":         "The scalable dimension",
 		"name":              "The name of the scaling policy",
@@ -144,10 +165,17 @@ var generatedParamsDoc = map[string]map[string]string{
 	},
 	"create.database":      {},
 	"create.dbsubnetgroup": {},
+	"create.dhcpoptions":   {},
 	"create.distribution":  {},
+	"create.egressonlyinternetgateway": {
+		"vpc": "The ID of the VPC for which to create the egress-only internet gateway",
+	},
 	"create.elasticip": {
 		"domain": "Set to vpc to allocate the address for use with instances in a VPC",
 	},
+	"create.elasticsearchdomain": {},
+	"create.environment": {},
+	"create.failover": {},
 	"create.function": {
 		"description": "A short, user-defined function description",
 		"handler":     "The function within your code that Lambda calls to begin execution",
@@ -161,6 +189,7 @@ var generatedParamsDoc = map[string]map[string]string{
 	"create.group": {
 		"name": "The name of the group to create",
 	},
+	"create.healthcheck": {},
 	"create.image": {
 		"description": "A description for the new image",
 		"instance":    "The ID of the instance",
@@ -170,6 +199,7 @@ var generatedParamsDoc = map[string]map[string]string{
 	"create.instance": {
 		"image":         "The ID of the AMI, which you can get by calling DescribeImages",
 		"ip":            "The primary IPv4 address",
+		"ipv6-count":    "[EC2-VPC] A number of IPv6 addresses to associate with the primary network interface",
 		"keypair":       "The name of the key pair",
 		"lock":          "If you set this parameter to true, you can't terminate the instance using the Amazon EC2 console, CLI, or API; otherwise, you can",
 		"securitygroup": "One or more security group IDs",
@@ -234,14 +264,17 @@ var generatedParamsDoc = map[string]map[string]string{
 		"name": "The name of the new queue",
 	},
 	"create.record": {},
+	"create.records": {},
 	"create.repository": {
 		"name": "The name to use for the repository",
 	},
 	"create.role": {},
 	"create.route": {
-		"cidr":    "The IPv4 CIDR address block used for the destination match",
-		"gateway": "The ID of an Internet gateway or virtual private gateway attached to your VPC",
-		"table":   "The ID of the route table for the route",
+		"cidr":              "The IPv4 CIDR address block used for the destination match",
+		"egressonlygateway": "[IPv6 traffic only] The ID of an egress-only Internet gateway",
+		"gateway":           "The ID of an Internet gateway or virtual private gateway attached to your VPC",
+		"ipv6-cidr":         "The IPv6 CIDR block used for the destination match",
+		"table":             "The ID of the route table for the route",
 	},
 	"create.routetable": {
 		"vpc": "The ID of the VPC",
@@ -294,6 +327,7 @@ var generatedParamsDoc = map[string]map[string]string{
 	"create.subnet": {
 		"availabilityzone": "The Availability Zone for the subnet",
 		"cidr":             "The IPv4 network range for the subnet, in CIDR notation",
+		"ipv6-cidr":        "The IPv6 network range for the subnet, in CIDR notation",
 		"vpc":              "The ID of the VPC",
 	},
 	"create.subscription": {
@@ -301,6 +335,7 @@ var generatedParamsDoc = map[string]map[string]string{
 		"protocol": "The protocol you want to use",
 		"topic":    "The ARN of the topic you want to subscribe to",
 	},
+	"create.table": {},
 	"create.tag": {},
 	"create.targetgroup": {
 		"healthcheckinterval": "The approximate amount of time, in seconds, between health checks of an individual target",
@@ -327,7 +362,9 @@ var generatedParamsDoc = map[string]map[string]string{
 	},
 	"create.vpc": {
 		"cidr": "The IPv4 network range for the VPC, in CIDR notation",
+		"ipv6": "Requests an Amazon-provided IPv6 CIDR block with a /56 prefix length for the VPC",
 	},
+	"create.vpcendpoint": {},
 	"create.zone": {
 		"callerreference": "A unique string that identifies the request and that allows failed CreateHostedZone requests to be retried without the risk of executing the operation twice",
 		"delegationsetid": "If you want to associate a reusable delegation set with this hosted zone, the ID that Amazon Route 53 assigned to the reusable delegation set when you created it",
@@ -340,6 +377,7 @@ var generatedParamsDoc = map[string]map[string]string{
 	"delete.alarm": {
 		"name": "The alarms to be deleted",
 	},
+	"delete.application": {},
 	"delete.appscalingpolicy": {
 		"dimension":         "The scalable dimension",
 		"name":              "The name of the scaling policy",
@@ -366,11 +404,19 @@ var generatedParamsDoc = map[string]map[string]string{
 		"id": "Contains a user-supplied database identifier",
 	},
 	"delete.dbsubnetgroup": {},
-	"delete.distribution":  {},
+	"delete.dhcpoptions": {
+		"id": "The ID of the DHCP options set",
+	},
+	"delete.distribution": {},
+	"delete.egressonlyinternetgateway": {
+		"id": "The ID of the egress-only internet gateway",
+	},
 	"delete.elasticip": {
 		"id": "The allocation ID",
 		"ip": "The Elastic IP address",
 	},
+	"delete.elasticsearchdomain": {},
+	"delete.environment": {},
 	"delete.function": {
 		"id":      "The Lambda function to delete",
 		"version": "Using this optional parameter you can specify a function version (but not the $LATEST version) to direct AWS Lambda to delete a specific function version",
@@ -378,6 +424,7 @@ var generatedParamsDoc = map[string]map[string]string{
 	"delete.group": {
 		"name": "The name of the IAM group to delete",
 	},
+	"delete.healthcheck": {},
 	"delete.image": {},
 	"delete.instance": {
 		"ids": "One or more instance IDs",
@@ -417,6 +464,7 @@ var generatedParamsDoc = map[string]map[string]string{
 		"url": "The URL of the Amazon SQS queue to delete",
 	},
 	"delete.record": {},
+	"delete.records": {},
 	"delete.repository": {
 		"account": "The AWS account ID associated with the registry that contains the repository to delete",
 		"force":   "If a repository contains images, forces the deletion",
@@ -424,8 +472,9 @@ var generatedParamsDoc = map[string]map[string]string{
 	},
 	"delete.role": {},
 	"delete.route": {
-		"cidr":  "The IPv4 CIDR range for the route",
-		"table": "The ID of the route table",
+		"cidr":      "The IPv4 CIDR range for the route",
+		"ipv6-cidr": "The IPv6 CIDR range for the route",
+		"table":     "The ID of the route table",
 	},
 	"delete.routetable": {
 		"id": "The ID of the route table",
@@ -457,6 +506,7 @@ var generatedParamsDoc = map[string]map[string]string{
 	"delete.subscription": {
 		"id": "The ARN of the subscription to be deleted",
 	},
+	"delete.table": {},
 	"delete.tag": {},
 	"delete.targetgroup": {
 		"id": "The Amazon Resource Name (ARN) of the target group",
@@ -473,12 +523,16 @@ var generatedParamsDoc = map[string]map[string]string{
 	"delete.vpc": {
 		"id": "The ID of the VPC",
 	},
+	"delete.vpcendpoint": {
+		"ids": "One or more VPC endpoint IDs",
+	},
 	"delete.zone": {
 		"id": "The ID of the hosted zone you want to delete",
 	},
 	"detach.alarm":               {},
 	"detach.classicloadbalancer": {},
 	"detach.containertask":       {},
+	"detach.dhcpoptions":         {},
 	"detach.elasticip": {
 		"association": "The association ID",
 	},
@@ -496,6 +550,7 @@ var generatedParamsDoc = map[string]map[string]string{
 	},
 	"detach.networkinterface": {},
 	"detach.policy":           {},
+	"detach.queuepolicy":      {},
 	"detach.role": {
 		"instanceprofile": "The name of the instance profile to update",
 		"name":            "The name of the role to remove",
@@ -503,6 +558,7 @@ var generatedParamsDoc = map[string]map[string]string{
 	"detach.routetable": {
 		"association": "The association ID representing the current association between the route table and subnet",
 	},
+	"detach.scalinggroup":  {},
 	"detach.securitygroup": {},
 	"detach.user": {
 		"group": "The name of the group to update",
@@ -514,6 +570,12 @@ var generatedParamsDoc = map[string]map[string]string{
 		"id":       "The ID of the volume",
 		"instance": "The ID of the instance",
 	},
+	"detach.vpcendpoint": {
+		"id":             "The ID of the endpoint",
+		"routetables":    "(Gateway endpoint) One or more route table IDs to disassociate from the endpoint",
+		"securitygroups": "(Interface endpoint) One or more security group IDs to disassociate from the network interface",
+		"subnets":        "(Interface endpoint) One or more subnets IDs in which to remove the endpoint",
+	},
 	"import.image": {
 		"architecture": "The architecture of the virtual machine",
 		"description":  "A description string for the import image task",
@@ -521,12 +583,14 @@ var generatedParamsDoc = map[string]map[string]string{
 		"platform":     "The operating system of the virtual machine",
 		"role":         "The name of the role to use when not using the default role, 'vmimport'",
 	},
+	"invoke.function": {},
 	"restart.database": {
 		"id": "Contains a user-supplied database identifier",
 	},
 	"restart.instance": {
 		"ids": "One or more instance IDs",
 	},
+	"restore.backup": {},
 	"start.alarm": {
 		"names": "The names of the alarms",
 	},
@@ -558,6 +622,9 @@ var generatedParamsDoc = map[string]map[string]string{
 		"name":            "The family and revision (family:revision) or full ARN of the task definition to run in your service",
 	},
 	"update.distribution": {},
+	"update.elasticsearchdomain": {},
+	"update.environment":  {},
+	"update.function":     {},
 	"update.image":        {},
 	"update.instance": {
 		"id":   "The ID of the instance",
@@ -571,7 +638,11 @@ var generatedParamsDoc = map[string]map[string]string{
 	"update.policy": {
 		"arn": "The Amazon Resource Name (ARN) of the IAM policy to which you want to add a new version",
 	},
+	"update.queue": {
+		"url": "The URL of the Amazon SQS queue whose attributes are set",
+	},
 	"update.record": {},
+	"update.records": {},
 	"update.s3object": {
 		"acl":     "The canned ACL to apply to the object",
 		"bucket":  "",
@@ -604,9 +675,10 @@ var generatedParamsDoc = map[string]map[string]string{
 		"template-file":         "Structure containing the template body with a minimum length of 1 byte and a maximum length of 51,200 bytes",
 		"use-previous-template": "Reuse the existing template that is associated with the stack that you are updating",
 	},
-	"update.subnet": {
-		"id":     "The ID of the subnet",
-		"public": "Specify true to indicate that network interfaces created in the specified subnet should be assigned a public IPv4 address",
-	},
+	"update.subnet":      {},
+	"update.table":       {},
 	"update.targetgroup": {},
+	"update.vpc":         {},
+	"verify.domain":      {},
+	"verify.email":       {},
 }
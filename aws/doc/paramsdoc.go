@@ -65,15 +65,43 @@ var manualParamsDoc = map[string]map[string]string{
 		"group":   "The name (friendly name, not ARN) of the IAM group to attach the policy to",
 		"role":    "The name (friendly name, not ARN) of the IAM role to attach the policy to",
 	},
+	"attach.queuepolicy": {
+		"url":    "The URL of the Amazon SQS queue",
+		"policy": "The JSON policy whose statements are added to the queue policy, replacing the statements with the same Sid",
+	},
+	"attach.scalinggroup": {
+		"name":        "The name of the scaling group whose instances are registered in the target group",
+		"targetgroup": "The Amazon Resource Name (ARN) of the target group",
+	},
 	"attach.securitygroup": {
 		"id":       "The ID of the Security Group to add to the instance",
 		"instance": "The ID of the Instance",
 	},
 	"authenticate.registry": {
 		"accounts":        "A list of AWS account IDs that are associated with the registries for which to authenticate",
+		"docker-config":   "Set to 'true' to write the registries credentials in the Docker config file ($DOCKER_CONFIG/config.json, default to ~/.docker/config.json) instead of running `docker login`",
 		"no-confirm":      "Do not ask confirmation before effectively running `docker login` command",
 		"no-docker-login": "Set to 'true' to disable the prompt and automatic execution of `docker login` command",
 	},
+	"backup.instance": {
+		"description": "The description of the backup image (default to the instance and backup time)",
+		"id":          "The ID of the EC2 Instance to backup",
+		"reboot":      "Set to 'true' to reboot the instance before the backup, ensuring file systems consistency",
+	},
+	"bootstrap.instance": {
+		"id":      "The ID of the EC2 Instance to bootstrap",
+		"keypair": "The name or path of the SSH key to connect with (default to the instance keypair)",
+		"port":    "The SSH port of the instance (default to 22)",
+		"private": "Set to 'true' to connect through the private IP of the instance",
+		"script":  "The path of the local script to copy and execute on the instance",
+		"timeout": "The time (in seconds) to wait for SSH to be available on the instance (default to 180)",
+		"user":    "The SSH user to connect with (default to usual AMI users)",
+	},
+	"check.alarm": {
+		"name":    "The name of the alarm to check",
+		"state":   "The state of the alarm to reach",
+		"timeout": "The time (in seconds) after which the check is failed",
+	},
 	"check.certificate": {
 		"arn":     "The Amazon Resource Name (ARN) of the certificate to check",
 		"state":   "The state of the certificate to reach",
@@ -89,6 +117,22 @@ var manualParamsDoc = map[string]map[string]string{
 		"state":   "The state of the CloudFront Distribution to reach",
 		"timeout": "The time (in seconds) after which the check is failed",
 	},
+	"check.elasticsearchdomain": {
+		"name":    "The name of the ElasticSearch domain to check",
+		"state":   "The state of the ElasticSearch domain to reach: processing while created or reconfigured, active once its endpoint is available",
+		"timeout": "The time (in seconds) after which the check is failed",
+	},
+	"check.environment": {
+		"id":      "The ID of the Elastic Beanstalk environment to check",
+		"state":   "The status of the Elastic Beanstalk environment to reach: Ready once launched or updated",
+		"health":  "The health color of the Elastic Beanstalk environment to reach: Green when healthy",
+		"timeout": "The time (in seconds) after which the check is failed",
+	},
+	"check.healthcheck": {
+		"id":      "The ID of the Route53 healthcheck to check",
+		"state":   "The status of the Route53 healthcheck to reach",
+		"timeout": "The time (in seconds) after which the check is failed",
+	},
 	"check.instance": {
 		"id":      "The ID of the EC2 Instance to check",
 		"state":   "The state of the EC2 Instance to reach",
@@ -109,6 +153,11 @@ var manualParamsDoc = map[string]map[string]string{
 		"state":   "The state of the Network Interface to reach",
 		"timeout": "The time (in seconds) after which the check is failed",
 	},
+	"check.record": {
+		"id":      "The ID of the change returned when creating, updating or deleting records",
+		"state":   "The state of the change to reach: INSYNC once propagated to all Route53 DNS servers",
+		"timeout": "The time (in seconds) after which the check is failed",
+	},
 	"check.scalinggroup": {
 		"name":    "The name of the AutoScaling Group to check",
 		"count":   "The number of Instances + Loadbalancers + TargetGroups in the AutoScaling Group to reach",
@@ -119,6 +168,11 @@ var manualParamsDoc = map[string]map[string]string{
 		"state":   "The state of the EC2 Security Group to reach",
 		"timeout": "The time (in seconds) after which the check is failed",
 	},
+	"check.table": {
+		"name":    "The name of the DynamoDB table to check",
+		"state":   "The status of the DynamoDB table to reach: active once created or updated, deleting while being deleted",
+		"timeout": "The time (in seconds) after which the check is failed",
+	},
 	"check.volume": {
 		"id":      "The ID of the EC2 Volume to check",
 		"state":   "The state of the EC2 Volume to reach",
@@ -134,6 +188,10 @@ var manualParamsDoc = map[string]map[string]string{
 		"statistic-function": "The statistic for the metric associated with the alarm, other than percentile",
 		"unit":               "The unit of measure for the statistic",
 	},
+	"create.application": {
+		"name":        "The name of the Elastic Beanstalk application",
+		"description": "The description of the application",
+	},
 	"create.appscalingtarget": {
 		"dimension":         "The scalable dimension associated with the scalable target",
 		"resource":          "The identifier of the resource associated with the scalable target (eg. for ECS: service/cluster-name/service-deployment-name, for EC2 spot-fleet: spot-fleet-request/sfr-73fbd2ce-aa30-494c-8788-1cee4EXAMPLE, for EMR cluster: instancegroup/j-2EEZNYKUA1NTV/ig-1791Y4E1L8YI0, for AppStream 2.0 fleet: fleet/sample-fleet, for DynamoDB table: table/my-table, for DynamoDB global secondary index: table/my-table/index/my-table-index)",
@@ -184,7 +242,7 @@ var manualParamsDoc = map[string]map[string]string{
 		"maintenancewindow":  "Specifies the weekly time range during which system maintenance can occur, in Universal Coordinated Time (UTC)",
 		"multiaz":            "Specifies if the DB instance is a Multi-AZ deployment",
 		"optiongroup":        "Indicates that the DB instance should be associated with the specified option group",
-		"password":           "The password for the master database user",
+		"password":           "The password for the master database user. Use auto(24) to generate a random password, displayed once after creation",
 		"public":             "'true' specifies an Internet-facing instance with a publicly resolvable DNS name, which resolves to a public IP address. 'false' specifies an internal instance with a DNS name that resolves to a private IP address",
 		"parametergroup":     "The name of the DB parameter group to associate with this DB instance",
 		"port":               "The port number on which the database accepts connections",
@@ -204,8 +262,17 @@ var manualParamsDoc = map[string]map[string]string{
 		"name":        "The name for the DB subnet group",
 		"subnets":     "The EC2 Subnet IDs for the DB subnet group",
 	},
+	"create.dhcpoptions": {
+		"domain-name":          "The domain name given to instances through DHCP (ex: corp.example.com). Needed for instances to resolve private hosted zones by short names",
+		"domain-name-servers":  "Up to 4 IP addresses of domain name servers, or AmazonProvidedDNS",
+		"ntp-servers":          "Up to 4 IP addresses of Network Time Protocol (NTP) servers",
+		"netbios-name-servers": "Up to 4 IP addresses of NetBIOS name servers",
+		"netbios-node-type":    "The NetBIOS node type: 1, 2, 4 or 8 (2 recommended, as broadcast and multicast are not supported)",
+		"name":                 "The name tag of the DHCP options set",
+	},
 	"create.distribution": {
-		"origin-domain":   "The DNS name of the Amazon S3 bucket from which you want CloudFront to get objects for this origin, for example, myawsbucket.s3.amazonaws.com",
+		"origin-domain":   "The DNS name of the origin: an Amazon S3 bucket (ex: myawsbucket.s3.amazonaws.com) or a custom origin such as a load balancer (ex: my-lb-1234.eu-west-1.elb.amazonaws.com) or an S3 website endpoint",
+		"origin-bucket":   "The name of the Amazon S3 bucket to use as origin, instead of its DNS name",
 		"certificate":     "The Amazon Resource Name (ARN) of the AWS Certificate Manager (ACM) certificate you want to use for TSL connection",
 		"comment":         "Any comments you want to include about the distribution",
 		"default-file":    "The object that you want CloudFront to request from your origin when a viewer requests the root URL for your distribution (http://www.example.com)",
@@ -214,6 +281,7 @@ var manualParamsDoc = map[string]map[string]string{
 		"forward-cookies": "Specifies which cookies to forward to the origin for this cache behavior",
 		"forward-queries": "Indicates whether you want CloudFront to forward query strings to the origin that is associated with this cache behavior and cache based on the query string parameters",
 		"https-behaviour": "The protocol (HTTP or HTTPS) that viewers can use to access the files",
+		"origin-protocol": "The protocol CloudFront uses to connect to a custom origin: http-only (default), https-only or match-viewer",
 		"origin-path":     "An optional element that causes CloudFront to request your content from a directory in your Amazon S3 bucket or your custom origin. When you include this element, specify the directory name, beginning with a /",
 		"price-class":     "The price class that corresponds with the maximum price that you want to pay for CloudFront service. If you specify PriceClass_All, CloudFront responds to requests for your objects from all CloudFront edge locations",
 		"min-ttl":         "The minimum amount of time that you want objects to stay in CloudFront caches before CloudFront forwards another request to your origin to determine whether the object has been updated",
@@ -221,6 +289,40 @@ var manualParamsDoc = map[string]map[string]string{
 	"create.elasticip": {
 		"domain": "Set to vpc to allocate the address for use with instances in a VPC else the address is for use with instances in EC2-Classic",
 	},
+	"create.elasticsearchdomain": {
+		"name":          "The name of the ElasticSearch domain (3 to 28 lowercase letters, numbers or hyphens, starting with a letter)",
+		"version":       "The version of ElasticSearch (ex: 5.5, 2.3). Defaults to 1.5",
+		"type":          "The instance type of the data nodes (ex: t2.small.elasticsearch, m4.large.elasticsearch)",
+		"count":         "The number of data nodes in the domain",
+		"ebs-size":      "The size (in GiB) of the EBS volume attached to each data node. Required for instance types without instance storage",
+		"ebs-type":      "The type of the EBS volumes attached to the data nodes",
+		"access-policy": "The IAM access policy (JSON format) of the domain",
+	},
+	"create.environment": {
+		"application":    "The name of the Elastic Beanstalk application of the environment",
+		"name":           "The name of the environment, unique in the region",
+		"solution-stack": "The platform of the environment (ex: 64bit Amazon Linux 2018.03 v2.7.1 running Go 1.10)",
+		"template":       "The name of a saved configuration template to create the environment from, instead of a solution stack",
+		"version":        "The label of the application version to deploy. Defaults to the sample application",
+		"cname":          "The prefix of the CNAME of the environment (ex: myapp for myapp.eu-west-1.elasticbeanstalk.com)",
+		"description":    "The description of the environment",
+		"tier":           "The tier of the environment: webserver (handling HTTP requests) or worker (processing messages of an SQS queue). Defaults to webserver",
+		"instance-type":  "The instance type of the EC2 instances of the environment",
+		"role":           "The instance profile of the EC2 instances of the environment",
+		"keypair":        "The EC2 keypair to access the instances of the environment",
+	},
+	"create.failover": {
+		"zone":             "The hosted zone (ID or name) in which to create the failover records",
+		"name":             "The DNS name routed to the primary load balancer, or to the secondary one when the primary is unhealthy (not the zone apex)",
+		"primary":          "The name or ARN of the load balancer serving the traffic when healthy",
+		"primary-region":   "The region of the primary load balancer",
+		"secondary":        "The name or ARN of the load balancer serving the traffic when the primary is unhealthy",
+		"secondary-region": "The region of the secondary load balancer",
+		"protocol":         "The protocol used to check the health of the load balancers (default to HTTP)",
+		"port":             "The port used to check the health of the load balancers (default to 80)",
+		"path":             "[HTTP/HTTPS] The path requested to check the health of the load balancers (default to /)",
+		"ttl":              "The time to live of the failover records in seconds (default to 60)",
+	},
 	"create.function": {
 		"bucket":        "Amazon S3 bucket name where the .zip file containing your deployment package is stored. This bucket must reside in the same AWS region where you are creating the Lambda function",
 		"object":        "The Amazon S3 object (the deployment package) key name you want to upload",
@@ -237,17 +339,31 @@ var manualParamsDoc = map[string]map[string]string{
 		"role":   "The name of the instance profile (role) to launch the instance with",
 		"image":  "The ID of an AMI for the instance to be launched",
 		"distro": "The distro query to resolve official community free bare distro AMI from current region. See above description from this help for specific queries. Default choices:",
+		"type":   "The instance type. Abstract types (ex: general.large) resolve to the current generation family in the region: burstable, general, compute, memory, storage, gpu",
+	},
+	"create.healthcheck": {
+		"protocol":          "The protocol used to check the health of the endpoint",
+		"port":              "The port of the endpoint to check",
+		"host":              "The domain name of the endpoint to check. Used as Host header when an ip is also given",
+		"ip":                "The IPv4 or IPv6 address of the endpoint to check",
+		"path":              "[HTTP/HTTPS] The path requested to check the endpoint (default to /)",
+		"interval":          "The number of seconds between two checks: 10 or 30 (default)",
+		"failure-threshold": "The number of consecutive checks for the endpoint to change from healthy to unhealthy, or the other way round (default to 3)",
 	},
 	"create.image": {
 		"reboot": "True to shut down and reboot the instance before creating the image, otherwise no reboot and file system integrity on the created image cannot be guaranteed",
 	},
 	"create.keypair": {
 		"name":      "The name of the keypair to create (it will also be the name of the file stored in ~/.awless/keys)",
-		"encrypted": "Set to 'true' if you want to encrypt the keypair"},
+		"encrypted": "Set to 'true' if you want to encrypt the keypair",
+		"ppk":       "Set to 'true' to also save the key in the PuTTY format (.ppk) for unencrypted keypairs (default to 'true' on Windows)"},
 	"create.launchconfiguration": {
 		"distro": "The distro query to resolve official community bare distro AMI from current region. See `awless search images -h`",
 		"public": "Used for groups that launch instances into a virtual private cloud (VPC). Specifies whether to assign a public IP address to each instance",
 	},
+	"create.loginprofile": {
+		"password": "The new password for the user. Use auto(24) to generate a random password, displayed once after creation",
+	},
 	"create.listener": {
 		"actiontype":  "The type of action",
 		"targetgroup": "The Amazon Resource Name (ARN) of the target group",
@@ -276,13 +392,21 @@ var manualParamsDoc = map[string]map[string]string{
 		"visibility-timeout": "The visibility timeout for the queue. Valid values: An integer from 0 to 43200 (12 hours). The default is 30",
 	},
 	"create.record": {
-		"zone":    "The ID of the hosted zone that contains the resource record sets that you want to change",
-		"name":    "The name of the domain you want to perform the action on. Enter a fully qualified domain name, for example, www.example.com. You can optionally include a trailing dot",
+		"zone":    "The ID or the domain name (ex: example.com.) of the hosted zone that contains the resource record sets that you want to change",
+		"name":    "The name of the domain you want to perform the action on. Enter a fully qualified domain name, for example, www.example.com (trailing dot optional), a wildcard name (*.example.com) or @ for the zone apex",
 		"type":    "The DNS record type",
 		"value":   "The new DNS record value",
 		"values":  "The new DNS record value(s)",
 		"ttl":     "The resource record cache time to live (TTL), in seconds",
 		"comment": "Any comments you want to include about a change batch request",
+		"failover":       "Make it a failover record: PRIMARY or SECONDARY. Route53 answers with the SECONDARY record when the PRIMARY one is unhealthy",
+		"healthcheck":    "The ID of the Route53 healthcheck telling whether this record is healthy (see `awless create healthcheck -h`)",
+		"set-identifier": "The identifier differentiating records with the same name and type (default to the failover value)",
+	},
+	"create.records": {
+		"zone":    "The ID or the domain name (ex: example.com.) of the hosted zone that contains the records",
+		"records": "The records given as 'name ttl type value' (ex: 'www.example.com 300 A 52.1.2.3'). Values of records with the same name and type form a single record set",
+		"comment": "Any comments you want to include about the change batch",
 	},
 	"create.role": {
 		"conditions":        "List of conditions necessary for the policy to be in effect (e.g. [aws:UserAgent!=My user agent,s3:prefix=~home/,aws:CurrentTime>=2013-06-30T00:00:00Z,aws:SourceIp!=203.0.113.0/24,aws:SourceArn==arn:aws:sns:eu-west-1:*:*])",
@@ -324,6 +448,15 @@ var manualParamsDoc = map[string]map[string]string{
 		"protocol": "The protocol you want to use",
 		"topic":    "The ARN of the topic you want to subscribe to",
 	},
+	"create.table": {
+		"name":           "The name of the DynamoDB table",
+		"hash-key":       "The name of the partition (hash) key attribute of the table",
+		"hash-key-type":  "The type of the partition key attribute: S (string), N (number) or B (binary). Defaults to S",
+		"range-key":      "The name of the sort (range) key attribute of the table, for a composite primary key",
+		"range-key-type": "The type of the sort key attribute: S (string), N (number) or B (binary). Defaults to S",
+		"read-capacity":  "The provisioned read capacity units of the table. Defaults to 5",
+		"write-capacity": "The provisioned write capacity units of the table. Defaults to 5",
+	},
 	"create.tag": {
 		"resource": "The ID of the resource on which you want to add a tag",
 		"key":      "The Tag key",
@@ -335,6 +468,17 @@ var manualParamsDoc = map[string]map[string]string{
 	"create.vpc": {
 		"name": "The 'Name' Tag for the VPC to create",
 	},
+	"create.vpcendpoint": {
+		"vpc":            "The ID of the VPC in which the endpoint will be used",
+		"service":        "The AWS service to reach privately: short name (ex: s3, dynamodb, ssm) or full service name (ex: com.amazonaws.us-west-2.s3)",
+		"type":           "The type of endpoint: gateway (S3 and DynamoDB, through route tables) or interface (network interfaces in subnets). Defaults to the type supported by the service",
+		"routetables":    "(Gateway endpoint) One or more route table IDs routing traffic to the service through the endpoint",
+		"subnets":        "(Interface endpoint) One or more subnet IDs, one per availability zone, in which to create the endpoint network interfaces",
+		"securitygroups": "(Interface endpoint) One or more security group IDs to associate with the endpoint network interfaces",
+		"private-dns":    "(Interface endpoint) Associate a private hosted zone with the VPC so that the default DNS name of the service resolves to the endpoint",
+		"policy":         "A policy (JSON format) to control access to the service through the endpoint. Defaults to full access",
+		"name":           "The 'Name' Tag for the endpoint to create",
+	},
 	"create.zone": {
 		"comment":   "Any comments that you want to include about the hosted zone",
 		"isprivate": "A value that indicates whether this is a private hosted zone",
@@ -344,6 +488,10 @@ var manualParamsDoc = map[string]map[string]string{
 	"delete.accesskey": {
 		"id": "The ID of the access key and secret access key you want to delete",
 	},
+	"delete.application": {
+		"name":  "The name of the Elastic Beanstalk application to delete",
+		"force": "Terminate the running environments of the application before deleting it",
+	},
 	"delete.alarm": {
 		"name": "The name of the alarm(s) to be deleted",
 	},
@@ -365,9 +513,19 @@ var manualParamsDoc = map[string]map[string]string{
 	"delete.distribution": {
 		"id": "The ID of the distribution to be deleted",
 	},
+	"delete.elasticsearchdomain": {
+		"name": "The name of the ElasticSearch domain to delete",
+	},
+	"delete.environment": {
+		"id":    "The ID of the Elastic Beanstalk environment to terminate",
+		"force": "Terminate the environment even if its configuration template fails to delete",
+	},
 	"delete.function": {
 		"id": "The ID of the Lambda function to be deleted",
 	},
+	"delete.healthcheck": {
+		"id": "The ID of the Route53 healthcheck to delete",
+	},
 	"delete.image": {
 		"id":               "The ID of the AMI to be deleted",
 		"delete-snapshots": "Set to 'true' to also delete the snapshots created from this image",
@@ -393,12 +551,19 @@ var manualParamsDoc = map[string]map[string]string{
 	},
 	"delete.record": {
 		"id":     "The awless id (cf `awless list records`) of the record to delete",
-		"zone":   "The ID of the hosted zone that contains the resource record sets that you want to delete",
-		"name":   "The name of the domain you want to perform the action on. Enter a fully qualified domain name, for example, www.example.com. You can optionally include a trailing dot",
+		"zone":   "The ID or the domain name (ex: example.com.) of the hosted zone that contains the resource record sets that you want to delete",
+		"name":   "The name of the domain you want to perform the action on. Enter a fully qualified domain name, for example, www.example.com (trailing dot optional), a wildcard name (*.example.com) or @ for the zone apex",
 		"type":   "The DNS record type",
 		"value":  "The DNS record value to delete",
 		"values": "The DNS record value(s) to delete",
 		"ttl":    "The resource record cache time to live (TTL), in seconds",
+		"failover":       "Make it a failover record: PRIMARY or SECONDARY. Route53 answers with the SECONDARY record when the PRIMARY one is unhealthy",
+		"healthcheck":    "The ID of the Route53 healthcheck telling whether this record is healthy (see `awless create healthcheck -h`)",
+		"set-identifier": "The identifier differentiating records with the same name and type (default to the failover value)",
+	},
+	"delete.records": {
+		"zone":    "The ID or the domain name (ex: example.com.) of the hosted zone that contains the records",
+		"records": "The records given as 'name ttl type value' (ex: 'www.example.com 300 A 52.1.2.3'). Values of records with the same name and type form a single record set",
 	},
 	"delete.role": {
 		"name": "The name of the role to be deleted",
@@ -407,11 +572,18 @@ var manualParamsDoc = map[string]map[string]string{
 		"bucket": "The name of the bucket containing the object to be deleted",
 		"name":   "The name (i.e. key) of the object to be deleted",
 	},
+	"delete.table": {
+		"name": "The name of the DynamoDB table to delete",
+	},
 	"delete.tag": {
 		"resource": "The ID of the resource on which you want to remove a tag",
 		"key":      "The Tag key",
 		"value":    "The Tag value",
 	},
+	"delete.vpcendpoint": {
+		"ids": "The ID(s) of the VPC endpoint(s) to be deleted",
+		"id":  "The ID of the VPC endpoint to be deleted",
+	},
 	"detach.alarm": {
 		"name":       "The name of the alarm",
 		"action-arn": "The Amazon Resource Name (ARN) to be detached of the ALARM actions",
@@ -424,6 +596,10 @@ var manualParamsDoc = map[string]map[string]string{
 		"container-name": "The name of the container to detach",
 		"name":           "The name of the existing container task containing the container to detach",
 	},
+	"detach.dhcpoptions": {
+		"id":  "The ID of the DHCP options set currently associated to the VPC",
+		"vpc": "The ID of the VPC to reset to the default DHCP options",
+	},
 	"detach.instance": {
 		"id": "The ID of the instance to be detached from target group",
 	},
@@ -446,6 +622,14 @@ var manualParamsDoc = map[string]map[string]string{
 		"group":   "The name (friendly name, not ARN) of the IAM group to detach the policy to",
 		"role":    "The name (friendly name, not ARN) of the IAM role to detach the policy to",
 	},
+	"detach.queuepolicy": {
+		"url":    "The URL of the Amazon SQS queue",
+		"policy": "The JSON policy whose statements are removed from the queue policy (by Sid). The whole queue policy is removed when omitted",
+	},
+	"detach.scalinggroup": {
+		"name":        "The name of the scaling group whose instances are deregistered from the target group",
+		"targetgroup": "The Amazon Resource Name (ARN) of the target group",
+	},
 	"detach.securitygroup": {
 		"id":       "The ID of the security group",
 		"instance": "The ID of the instance to be detached",
@@ -459,12 +643,26 @@ var manualParamsDoc = map[string]map[string]string{
 		"license":      "The license type to be used for the Amazon Machine Image (AMI) after importing",
 		"platform":     "The operating system of the virtual machine",
 	},
+	"invoke.function": {
+		"id":      "The name or ARN of the function to invoke",
+		"payload": "The JSON input of the function (ex: '{\"key\": \"value\"}'). The JSON output of the function is the result of the command",
+		"async":   "Queue the event for an asynchronous execution instead of waiting for the function output (default to false)",
+		"version": "The version or alias of the function to invoke",
+	},
 	"restart.instance": {
 		"id": "The ID of the instance to be restarted",
 	},
 	"restart.database": {
 		"with-failover": "When true, the reboot is conducted through a MultiAZ failover",
 	},
+	"restore.backup": {
+		"id":            "The ID of the backup image to restore",
+		"keypair":       "The keypair of the new instance (default to the one of the backed up instance)",
+		"name":          "The name of the new instance (default to the one of the backed up instance suffixed with -restored)",
+		"securitygroup": "The securitygroups of the new instance (default to the ones of the backed up instance)",
+		"subnet":        "The subnet of the new instance (default to the one of the backed up instance)",
+		"type":          "The type of the new instance (default to the one of the backed up instance)",
+	},
 	"start.containertask": {
 		"cluster":                     "The short name or full Amazon Resource Name (ARN) of the cluster on which to run your task",
 		"type":                        "The type of task to launch",
@@ -489,12 +687,15 @@ var manualParamsDoc = map[string]map[string]string{
 		"id": "The ID of the instance to be stopped",
 	},
 	"update.bucket": {
-		"name":              "The name of the bucket to update",
-		"acl":               "The canned ACL to apply to the bucket",
-		"public-website":    "Set to 'true' if you want to publish the content of the bucket as a public HTTP website",
-		"redirect-hostname": "Hostname where HTTP requests will be redirected when publishing website",
-		"index-suffix":      "A suffix that is appended to a request that is for a directory on the website endpoint",
-		"enforce-https":     "Use HTTPS rather than HTTP when redirecting requests",
+		"name":                "The name of the bucket to update",
+		"acl":                 "The canned ACL to apply to the bucket",
+		"public-website":      "Set to 'true' if you want to publish the content of the bucket as a public HTTP website",
+		"redirect-hostname":   "Hostname where HTTP requests will be redirected when publishing website",
+		"index-suffix":        "A suffix that is appended to a request that is for a directory on the website endpoint",
+		"enforce-https":       "Use HTTPS rather than HTTP when redirecting requests",
+		"encryption":          "The default server-side encryption of new objects: AES256 (S3 managed keys), aws:kms (KMS managed keys) or none to remove it",
+		"encryption-key":      "The KMS key ID or ARN used with aws:kms encryption (default to the AWS managed key of S3)",
+		"block-public-access": "Set to 'true' to block public ACLs and policies on the bucket, 'false' to remove the public access block",
 	},
 	"update.classicloadbalancer": {
 		"health-interval":     "The approximate interval, in seconds, between health checks of an individual instance",
@@ -505,7 +706,7 @@ var manualParamsDoc = map[string]map[string]string{
 	},
 	"update.distribution": {
 		"id":              "The ID of the distribution to update",
-		"origin-domain":   "The DNS name of the Amazon S3 bucket from which you want CloudFront to get objects for this origin, for example, myawsbucket.s3.amazonaws.com",
+		"origin-domain":   "The DNS name of the origin: an Amazon S3 bucket (ex: myawsbucket.s3.amazonaws.com) or a custom origin such as a load balancer (ex: my-lb-1234.eu-west-1.elb.amazonaws.com) or an S3 website endpoint",
 		"certificate":     "The Amazon Resource Name (ARN) of the AWS Certificate Manager (ACM) certificate you want to use for TSL connection",
 		"comment":         "Any comments you want to include about the distribution",
 		"default-file":    "The object that you want CloudFront to request from your origin when a viewer requests the root URL for your distribution (http://www.example.com)",
@@ -513,11 +714,41 @@ var manualParamsDoc = map[string]map[string]string{
 		"forward-cookies": "Specifies which cookies to forward to the origin for this cache behavior",
 		"forward-queries": "Indicates whether you want CloudFront to forward query strings to the origin that is associated with this cache behavior and cache based on the query string parameters (true | false)",
 		"https-behaviour": "The protocol (HTTP or HTTPS) that viewers can use to access the files",
+		"origin-protocol": "The protocol CloudFront uses to connect to a custom origin: http-only (default), https-only or match-viewer",
 		"origin-path":     "An optional element that causes CloudFront to request your content from a directory in your Amazon S3 bucket or your custom origin. When you include this element, specify the directory name, beginning with a /",
 		"price-class":     "The price class that corresponds with the maximum price that you want to pay for CloudFront service. If you specify PriceClass_All, CloudFront responds to requests for your objects from all CloudFront edge locations",
 		"min-ttl":         "The minimum amount of time that you want objects to stay in CloudFront caches before CloudFront forwards another request to your origin to determine whether the object has been updated",
 		"enable":          "Enable/Disable the distribution",
 	},
+	"update.elasticsearchdomain": {
+		"name":          "The name of the ElasticSearch domain to update",
+		"type":          "The new instance type of the data nodes",
+		"count":         "The new number of data nodes in the domain",
+		"ebs-size":      "The new size (in GiB) of the EBS volume attached to each data node",
+		"ebs-type":      "The new type of the EBS volumes attached to the data nodes",
+		"access-policy": "The new IAM access policy (JSON format) of the domain",
+	},
+	"update.environment": {
+		"id":             "The ID of the Elastic Beanstalk environment to update",
+		"version":        "The label of the application version to deploy",
+		"solution-stack": "The platform to upgrade the environment to",
+		"description":    "The new description of the environment",
+		"swap-cname":     "The ID of an environment to swap CNAMEs with, once updated (ex: blue/green deployments)",
+	},
+	"update.function": {
+		"id":            "The name or ARN of the function to update",
+		"zipfile":       "The path toward the zip file containing the new deployment package",
+		"bucket":        "Amazon S3 bucket name where the .zip file containing the new deployment package is stored",
+		"object":        "The Amazon S3 object (the deployment package) key name you want to upload",
+		"objectversion": "The Amazon S3 object (the deployment package) version you want to upload",
+		"publish":       "Publish a new version of the function after updating its code",
+		"handler":       "The function within your code that Lambda calls to begin execution",
+		"role":          "The Amazon Resource Name (ARN) of the IAM role that Lambda assumes when it executes your function",
+		"runtime":       "The runtime environment for the Lambda function",
+		"description":   "A short, user-defined function description",
+		"memory":        "The amount of memory, in MB, your Lambda function is given",
+		"timeout":       "The function execution time (in seconds) at which Lambda should terminate the function",
+	},
 	"update.image": {
 		"accounts":      "List (one or more) AWS account IDs",
 		"description":   "A new description for the AMI",
@@ -527,7 +758,10 @@ var manualParamsDoc = map[string]map[string]string{
 		"product-codes": "One or more DevPay product codes. After adding a product code, it cannot be removed",
 	},
 	"update.instance": {
-		"type": "Changes the instance type to the specified value",
+		"type":              "Changes the instance type to the specified value. Abstract types (ex: general.large) resolve to the current generation family in the region",
+		"source-dest-check": "Set to 'false' to disable the source/destination check of the instance traffic (ex: for NAT instances)",
+		"shutdown-behavior": "Whether the instance stops or terminates when shut down from the instance (stop | terminate)",
+		"securitygroups":    "Replaces the full set of securitygroups of the instance (in a VPC) with the given list",
 	},
 	"update.policy": {
 		"arn":        "The Amazon Resource Name (ARN) of the IAM policy you want to attach",
@@ -536,14 +770,31 @@ var manualParamsDoc = map[string]map[string]string{
 		"resource":   "The Amazon Resource Name (ARN) of the Resource element which specifies the object or objects that the policy covers",
 		"conditions": "List of conditions necessary for the policy to be in effect (e.g. [aws:UserAgent!=My user agent,s3:prefix=~home/,aws:CurrentTime>=2013-06-30T00:00:00Z,aws:SourceIp!=203.0.113.0/24,aws:SourceArn==arn:aws:sns:eu-west-1:*:*])",
 	},
+	"update.queue": {
+		"delay":              "The length of time, in seconds, for which the delivery of all messages in the queue is delayed. Valid values: An integer from 0 to 900 seconds (15 minutes)",
+		"max-msg-size":       "The limit of how many bytes a message can contain before Amazon SQS rejects it. Valid values: An integer from 1024 bytes (1 KiB) to 262144 bytes (256 KiB)",
+		"retention-period":   "The length of time, in seconds, for which Amazon SQS retains a message. Valid values: An integer from 60 seconds (1 minute) to 1209600 seconds (14 days)",
+		"policy":             "The queue's policy, replacing the current one (see `awless attach queuepolicy` to add statements)",
+		"msg-wait":           "The length of time, in seconds, for which a ReceiveMessage action waits for a message to arrive. Valid values: An integer from 0 to 20 (seconds)",
+		"redrive-policy":     "The parameters for the dead letter queue functionality of the source queue",
+		"visibility-timeout": "The visibility timeout for the queue. Valid values: An integer from 0 to 43200 (12 hours)",
+	},
 	"update.record": {
-		"zone":    "The ID of the hosted zone that contains the resource record sets that you want to change",
-		"name":    "The name of the domain you want to perform the action on. Enter a fully qualified domain name, for example, www.example.com. You can optionally include a trailing dot",
+		"zone":    "The ID or the domain name (ex: example.com.) of the hosted zone that contains the resource record sets that you want to change",
+		"name":    "The name of the domain you want to perform the action on. Enter a fully qualified domain name, for example, www.example.com (trailing dot optional), a wildcard name (*.example.com) or @ for the zone apex",
 		"type":    "The DNS record type",
 		"value":   "The current or new DNS record value",
 		"values":  "The current or new DNS record value(s)",
 		"ttl":     "The resource record cache time to live (TTL), in seconds",
 		"comment": "Any comments you want to include about a change batch request",
+		"failover":       "Make it a failover record: PRIMARY or SECONDARY. Route53 answers with the SECONDARY record when the PRIMARY one is unhealthy",
+		"healthcheck":    "The ID of the Route53 healthcheck telling whether this record is healthy (see `awless create healthcheck -h`)",
+		"set-identifier": "The identifier differentiating records with the same name and type (default to the failover value)",
+	},
+	"update.records": {
+		"zone":    "The ID or the domain name (ex: example.com.) of the hosted zone that contains the records",
+		"records": "The records given as 'name ttl type value' (ex: 'www.example.com 300 A 52.1.2.3'). Values of records with the same name and type form a single record set",
+		"comment": "Any comments you want to include about the change batch",
 	},
 	"update.s3object": {
 		"acl":     "The canned ACL to apply to the bucket",
@@ -570,6 +821,17 @@ var manualParamsDoc = map[string]map[string]string{
 		"rollback-triggers":       "List of CloudWatch Alarm ARNs to monitor during and after update",
 		"rollback-monitoring-min": "Time to monitor rollback-triggers during and after update",
 	},
+	"update.subnet": {
+		"id":             "The ID of the subnet to be updated",
+		"public":         "Specify true to indicate that network interfaces created in the specified subnet should be assigned a public IPv4 address",
+		"ipv6-cidr":      "The IPv6 CIDR block (/64) to associate to the subnet, taken from the IPv6 CIDR block of its VPC",
+		"ipv6-on-launch": "Specify true to indicate that network interfaces created in the specified subnet should be assigned an IPv6 address",
+	},
+	"update.table": {
+		"name":           "The name of the DynamoDB table to update",
+		"read-capacity":  "The new provisioned read capacity units of the table (current value kept if omitted)",
+		"write-capacity": "The new provisioned write capacity units of the table (current value kept if omitted)",
+	},
 	"update.targetgroup": {
 		"id": "The Amazon Resource Name (ARN) of the target group",
 		"deregistrationdelay": "The amount time for Elastic Load Balancing to wait before changing the state of a deregistering target from draining to unused. The range is 0-3600 seconds. The default value is 300 seconds",
@@ -587,4 +849,18 @@ var manualParamsDoc = map[string]map[string]string{
 		"stickiness":          "Indicates whether sticky sessions (of type load balancer cookies) are enabled",
 		"stickinessduration":  "The time period, in seconds, during which requests from a client should be routed to the same target. After this time period expires, the load balancer-generated cookie is considered stale. The range is 1 second to 1 week (604800 seconds). The default value is 1 day (86400 seconds)",
 	},
+	"update.vpc": {
+		"id":            "The ID of the VPC to be updated",
+		"dns-support":   "Whether DNS resolution through the Amazon provided DNS server is enabled for the VPC",
+		"dns-hostnames": "Whether instances launched in the VPC get public DNS hostnames. Requires dns-support. Both are needed to use private hosted zones",
+		"ipv6":          "Set to true to associate an Amazon provided IPv6 CIDR block (/56) to the VPC",
+	},
+	"verify.domain": {
+		"name": "The domain to verify for sending emails with SES",
+		"dkim": "Whether to also generate the DKIM tokens signing the emails sent from the domain (default to true)",
+		"zone": "The ID or the domain name of the Route53 hosted zone receiving the verification records (default to the hosted zone of the domain or of its closest parent domain)",
+	},
+	"verify.email": {
+		"address": "The email address to verify for sending emails with SES",
+	},
 }
@@ -0,0 +1,187 @@
+package awsfetch
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/fetch"
+	"github.com/wallix/awless/graph"
+)
+
+// Access Advisor operations are missing from the vendored IAM SDK:
+// their inputs and outputs are declared here for the IAM query protocol.
+
+type generateServiceLastAccessedDetailsInput struct {
+	_   struct{} `type:"structure"`
+	Arn *string  `min:"20" type:"string" required:"true"`
+}
+
+type generateServiceLastAccessedDetailsOutput struct {
+	_     struct{} `type:"structure"`
+	JobId *string  `min:"36" type:"string"`
+}
+
+type getServiceLastAccessedDetailsInput struct {
+	_      struct{} `type:"structure"`
+	JobId  *string  `min:"36" type:"string" required:"true"`
+	Marker *string  `min:"1" type:"string"`
+}
+
+type getServiceLastAccessedDetailsOutput struct {
+	_                    struct{}               `type:"structure"`
+	JobStatus            *string                `type:"string" required:"true" enum:"jobStatusType"`
+	ServicesLastAccessed []*serviceLastAccessed `type:"list" required:"true"`
+	IsTruncated          *bool                  `type:"boolean"`
+	Marker               *string                `min:"1" type:"string"`
+}
+
+type serviceLastAccessed struct {
+	_                 struct{}   `type:"structure"`
+	ServiceName       *string    `type:"string" required:"true"`
+	ServiceNamespace  *string    `min:"1" type:"string" required:"true"`
+	LastAuthenticated *time.Time `type:"timestamp" timestampFormat:"iso8601"`
+}
+
+const (
+	jobStatusInProgress = "IN_PROGRESS"
+	jobStatusCompleted  = "COMPLETED"
+
+	// maxConcurrentAccessAdvisorJobs caps the report jobs run at once to spare IAM API limits
+	maxConcurrentAccessAdvisorJobs = 4
+)
+
+var lastAccessedPollInterval = 2 * time.Second
+
+type accessAdvisor interface {
+	GenerateServiceLastAccessedDetails(*generateServiceLastAccessedDetailsInput) (*generateServiceLastAccessedDetailsOutput, error)
+	GetServiceLastAccessedDetails(*getServiceLastAccessedDetailsInput) (*getServiceLastAccessedDetailsOutput, error)
+}
+
+type iamAccessAdvisor struct {
+	*iam.IAM
+}
+
+func (a *iamAccessAdvisor) GenerateServiceLastAccessedDetails(input *generateServiceLastAccessedDetailsInput) (*generateServiceLastAccessedDetailsOutput, error) {
+	output := new(generateServiceLastAccessedDetailsOutput)
+	req := a.NewRequest(&request.Operation{Name: "GenerateServiceLastAccessedDetails", HTTPMethod: "POST", HTTPPath: "/"}, input, output)
+	return output, req.Send()
+}
+
+func (a *iamAccessAdvisor) GetServiceLastAccessedDetails(input *getServiceLastAccessedDetailsInput) (*getServiceLastAccessedDetailsOutput, error) {
+	output := new(getServiceLastAccessedDetailsOutput)
+	req := a.NewRequest(&request.Operation{Name: "GetServiceLastAccessedDetails", HTTPMethod: "POST", HTTPPath: "/"}, input, output)
+	return output, req.Send()
+}
+
+func newAccessAdvisor(api iamiface.IAMAPI) accessAdvisor {
+	switch a := api.(type) {
+	case accessAdvisor:
+		return a
+	case *iam.IAM:
+		return &iamAccessAdvisor{a}
+	default:
+		return nil
+	}
+}
+
+// withLastAccessed decorates an IAM fetch func to set on the fetched resources
+// the date they were last used to access an AWS service (Access Advisor),
+// when enabled through config or context, as it needs a report job per resource.
+// Resources never used are left without date.
+func withLastAccessed(conf *Config, fetchFn fetch.Func) fetch.Func {
+	return func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		resources, objects, err := fetchFn(ctx, cache)
+		if err != nil || len(resources) == 0 {
+			return resources, objects, err
+		}
+		if !conf.getBoolDefaultFalse("aws.access.lastaccessed.sync") && !getBoolFromContext(ctx, "lastaccessed") {
+			return resources, objects, err
+		}
+		advisor := newAccessAdvisor(conf.APIs.Iam)
+		if advisor == nil {
+			conf.Log.Verbose("sync: no access advisor available, skipping last accessed dates")
+			return resources, objects, err
+		}
+
+		byArn := make(map[string][]*graph.Resource)
+		for _, res := range resources {
+			if res.Properties()[properties.Type] == "AWS Managed" {
+				continue
+			}
+			if arn, ok := res.Properties()[properties.Arn].(string); ok && arn != "" {
+				byArn[arn] = append(byArn[arn], res)
+			}
+		}
+
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		sem := make(chan struct{}, maxConcurrentAccessAdvisorJobs)
+		for arn, same := range byArn {
+			wg.Add(1)
+			go func(arn string, same []*graph.Resource) {
+				defer wg.Done()
+				sem <- struct{}{}
+				defer func() { <-sem }()
+
+				last, err := lastAccessed(advisor, arn)
+				if err != nil {
+					conf.Log.Warningf("sync: cannot get last accessed date of %s: %s", arn, err)
+					return
+				}
+				if last.IsZero() {
+					return
+				}
+				mu.Lock()
+				for _, res := range same {
+					res.Properties()[properties.LastAccessed] = last
+				}
+				mu.Unlock()
+			}(arn, same)
+		}
+		wg.Wait()
+
+		return resources, objects, nil
+	}
+}
+
+// lastAccessed returns the most recent date any service was accessed by the IAM entity, zero if never
+func lastAccessed(advisor accessAdvisor, arn string) (time.Time, error) {
+	var last time.Time
+
+	job, err := advisor.GenerateServiceLastAccessedDetails(&generateServiceLastAccessedDetailsInput{Arn: awssdk.String(arn)})
+	if err != nil {
+		return last, err
+	}
+
+	input := &getServiceLastAccessedDetailsInput{JobId: job.JobId}
+	for {
+		out, err := advisor.GetServiceLastAccessedDetails(input)
+		if err != nil {
+			return last, err
+		}
+		switch status := awssdk.StringValue(out.JobStatus); status {
+		case jobStatusInProgress:
+			time.Sleep(lastAccessedPollInterval)
+			continue
+		case jobStatusCompleted:
+		default:
+			return last, fmt.Errorf("access advisor job %s: %s", awssdk.StringValue(job.JobId), status)
+		}
+		for _, service := range out.ServicesLastAccessed {
+			if t := awssdk.TimeValue(service.LastAuthenticated); t.After(last) {
+				last = t
+			}
+		}
+		if !awssdk.BoolValue(out.IsTruncated) {
+			return last, nil
+		}
+		input.Marker = out.Marker
+	}
+}
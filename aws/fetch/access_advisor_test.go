@@ -0,0 +1,150 @@
+package awsfetch
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/fetch"
+	"github.com/wallix/awless/graph"
+)
+
+func TestWithLastAccessed(t *testing.T) {
+	defer func(d time.Duration) { lastAccessedPollInterval = d }(lastAccessedPollInterval)
+	lastAccessedPollInterval = 0
+
+	used := time.Date(2017, 3, 1, 10, 0, 0, 0, time.UTC)
+	advisor := &mockAccessAdvisor{lastAccessed: map[string][]*time.Time{
+		"arn:aws:iam::0123456789:role/used":           {nil, awssdk.Time(used.Add(-time.Hour)), awssdk.Time(used)},
+		"arn:aws:iam::0123456789:role/never":          {nil},
+		"arn:aws:iam::0123456789:policy/custom":       {awssdk.Time(used)},
+		"arn:aws:iam::aws:policy/AdministratorAccess": {awssdk.Time(used)},
+	}}
+
+	fetchFn := func(context.Context, fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		used := graph.InitResource("role", "used")
+		used.Properties()[properties.Arn] = "arn:aws:iam::0123456789:role/used"
+		never := graph.InitResource("role", "never")
+		never.Properties()[properties.Arn] = "arn:aws:iam::0123456789:role/never"
+		custom := graph.InitResource("policy", "custom")
+		custom.Properties()[properties.Arn] = "arn:aws:iam::0123456789:policy/custom"
+		managed := graph.InitResource("policy", "admin")
+		managed.Properties()[properties.Arn] = "arn:aws:iam::aws:policy/AdministratorAccess"
+		managed.Properties()[properties.Type] = "AWS Managed"
+		return []*graph.Resource{used, never, custom, managed}, nil, nil
+	}
+
+	conf := NewConfig(advisor)
+
+	resources, _, err := withLastAccessed(conf, fetchFn)(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, res := range resources {
+		if _, ok := res.Properties()[properties.LastAccessed]; ok {
+			t.Fatalf("%s: expected no last accessed date when disabled", res.Id())
+		}
+	}
+	if advisor.jobs != 0 {
+		t.Fatalf("got %d jobs, want 0", advisor.jobs)
+	}
+
+	resources, _, err = withLastAccessed(conf, fetchFn)(context.WithValue(context.Background(), "lastaccessed", true), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{"used": used, "never": nil, "custom": used, "admin": nil}
+	for _, res := range resources {
+		if got, want := res.Properties()[properties.LastAccessed], expected[res.Id()]; got != want {
+			t.Fatalf("%s: got %v, want %v", res.Id(), got, want)
+		}
+	}
+	if got, want := advisor.jobs, 3; got != want {
+		t.Fatalf("got %d jobs, want %d", got, want)
+	}
+}
+
+func TestIAMAccessAdvisor(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		switch action := r.Form.Get("Action"); action {
+		case "GenerateServiceLastAccessedDetails":
+			if got, want := r.Form.Get("Arn"), "arn:aws:iam::0123456789:role/used"; got != want {
+				t.Errorf("got %s, want %s", got, want)
+			}
+			fmt.Fprint(w, `<GenerateServiceLastAccessedDetailsResponse><GenerateServiceLastAccessedDetailsResult><JobId>98a765b4-3cde-2101-2345-example678f9</JobId></GenerateServiceLastAccessedDetailsResult></GenerateServiceLastAccessedDetailsResponse>`)
+		case "GetServiceLastAccessedDetails":
+			fmt.Fprint(w, `<GetServiceLastAccessedDetailsResponse><GetServiceLastAccessedDetailsResult>
+<JobStatus>COMPLETED</JobStatus>
+<IsTruncated>false</IsTruncated>
+<ServicesLastAccessed>
+  <member><ServiceName>Amazon S3</ServiceName><ServiceNamespace>s3</ServiceNamespace><LastAuthenticated>2017-03-01T10:00:00Z</LastAuthenticated></member>
+  <member><ServiceName>Amazon EC2</ServiceName><ServiceNamespace>ec2</ServiceNamespace></member>
+</ServicesLastAccessed>
+</GetServiceLastAccessedDetailsResult></GetServiceLastAccessedDetailsResponse>`)
+		default:
+			t.Errorf("unexpected action %s", action)
+		}
+	}))
+	defer ts.Close()
+
+	sess := session.Must(session.NewSession(&awssdk.Config{
+		Endpoint:    awssdk.String(ts.URL),
+		Region:      awssdk.String("us-east-1"),
+		Credentials: credentials.NewStaticCredentials("id", "secret", ""),
+	}))
+	advisor := newAccessAdvisor(iam.New(sess))
+	if advisor == nil {
+		t.Fatal("expected access advisor for IAM client")
+	}
+	last, err := lastAccessed(advisor, "arn:aws:iam::0123456789:role/used")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := last, time.Date(2017, 3, 1, 10, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+type mockAccessAdvisor struct {
+	iamiface.IAMAPI
+	mu           sync.Mutex
+	lastAccessed map[string][]*time.Time
+	jobs         int
+	polled       map[string]bool
+}
+
+func (m *mockAccessAdvisor) GenerateServiceLastAccessedDetails(input *generateServiceLastAccessedDetailsInput) (*generateServiceLastAccessedDetailsOutput, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.jobs++
+	return &generateServiceLastAccessedDetailsOutput{JobId: input.Arn}, nil
+}
+
+func (m *mockAccessAdvisor) GetServiceLastAccessedDetails(input *getServiceLastAccessedDetailsInput) (*getServiceLastAccessedDetailsOutput, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	arn := awssdk.StringValue(input.JobId)
+	if !m.polled[arn] {
+		if m.polled == nil {
+			m.polled = make(map[string]bool)
+		}
+		m.polled[arn] = true
+		return &getServiceLastAccessedDetailsOutput{JobStatus: awssdk.String(jobStatusInProgress)}, nil
+	}
+	var services []*serviceLastAccessed
+	for _, t := range m.lastAccessed[arn] {
+		services = append(services, &serviceLastAccessed{LastAuthenticated: t})
+	}
+	return &getServiceLastAccessedDetailsOutput{JobStatus: awssdk.String(jobStatusCompleted), ServicesLastAccessed: services}, nil
+}
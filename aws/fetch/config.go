@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/ecr/ecriface"
 	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/aws/aws-sdk-go/service/elasticsearchservice/elasticsearchserviceiface"
 	"github.com/aws/aws-sdk-go/service/elb/elbiface"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
@@ -19,6 +20,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/rds/rdsiface"
 	"github.com/aws/aws-sdk-go/service/route53/route53iface"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/ses/sesiface"
 	"github.com/aws/aws-sdk-go/service/sns/snsiface"
 	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
 	"github.com/aws/aws-sdk-go/service/sts/stsiface"
@@ -40,12 +42,14 @@ type AWSAPI struct {
 	S3                     s3iface.S3API
 	Sns                    snsiface.SNSAPI
 	Sqs                    sqsiface.SQSAPI
+	Ses                    sesiface.SESAPI
 	Route53                route53iface.Route53API
 	Lambda                 lambdaiface.LambdaAPI
 	Cloudwatch             cloudwatchiface.CloudWatchAPI
 	Cloudfront             cloudfrontiface.CloudFrontAPI
 	Cloudformation         cloudformationiface.CloudFormationAPI
 	Acm                    acmiface.ACMAPI
+	Elasticsearchservice   elasticsearchserviceiface.ElasticsearchServiceAPI
 }
 
 type Config struct {
@@ -75,6 +79,15 @@ func (c *Config) getBoolDefaultTrue(key string) bool {
 	return true
 }
 
+func (c *Config) getBoolDefaultFalse(key string) bool {
+	if c.Extra == nil {
+		return false
+	}
+
+	b, _ := c.Extra[key].(bool)
+	return b
+}
+
 func assignAPIs(c *Config, apis ...interface{}) {
 	c.APIs = new(AWSAPI)
 	val := reflect.ValueOf(c.APIs).Elem()
@@ -10,11 +10,14 @@ import (
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/elasticsearchservice"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/ses"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/wallix/awless/aws/conv"
 	"github.com/wallix/awless/cloud"
@@ -301,6 +304,57 @@ func addManualInfraFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
 		return resources, objects, nil
 	}
 
+	funcs["containerservice"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var objects []*ecs.Service
+		var resources []*graph.Resource
+
+		if !conf.getBoolDefaultTrue("aws.infra.containerservice.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[containerservice]")
+			return resources, objects, nil
+		}
+
+		clusterArns, err := getClusterArns(ctx, cache, conf.APIs.Ecs)
+		if err != nil {
+			return resources, objects, err
+		}
+
+		for _, cluster := range clusterArns {
+			var badResErr error
+			err := conf.APIs.Ecs.ListServicesPages(&ecs.ListServicesInput{Cluster: &cluster}, func(out *ecs.ListServicesOutput, lastPage bool) (shouldContinue bool) {
+				var servicesOut *ecs.DescribeServicesOutput
+				if len(out.ServiceArns) == 0 {
+					return out.NextToken != nil
+				}
+
+				if servicesOut, badResErr = conf.APIs.Ecs.DescribeServices(&ecs.DescribeServicesInput{Cluster: &cluster, Services: out.ServiceArns}); badResErr != nil {
+					return false
+				}
+
+				for _, service := range servicesOut.Services {
+					objects = append(objects, service)
+					var res *graph.Resource
+					if res, badResErr = awsconv.NewResource(service); badResErr != nil {
+						return false
+					}
+					res.Properties()[properties.Cluster] = cluster
+					res.AddRelation(rdf.ChildrenOfRel, graph.InitResource(cloud.ContainerCluster, cluster))
+					if service.TaskDefinition != nil {
+						res.AddRelation(rdf.DependingOnRel, graph.InitResource(cloud.ContainerTask, awssdk.StringValue(service.TaskDefinition)))
+					}
+					resources = append(resources, res)
+				}
+				return out.NextToken != nil
+			})
+			if err != nil {
+				return resources, objects, err
+			}
+			if badResErr != nil {
+				return resources, objects, badResErr
+			}
+		}
+		return resources, objects, nil
+	}
+
 	funcs["listener"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
 		var objects []*elbv2.Listener
 		var resources []*graph.Resource
@@ -362,6 +416,48 @@ func addManualInfraFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
 			}
 		}
 	}
+
+	funcs["elasticsearchdomain"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var objects []*elasticsearchservice.ElasticsearchDomainStatus
+		var resources []*graph.Resource
+
+		if !conf.getBoolDefaultTrue("aws.infra.elasticsearchdomain.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[elasticsearchdomain]")
+			return resources, objects, nil
+		}
+
+		out, err := conf.APIs.Elasticsearchservice.ListDomainNames(&elasticsearchservice.ListDomainNamesInput{})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		var names []*string
+		for _, domain := range out.DomainNames {
+			names = append(names, domain.DomainName)
+		}
+
+		// DescribeElasticsearchDomains accepts at most 5 domain names per call
+		for i := 0; i < len(names); i += 5 {
+			end := i + 5
+			if end > len(names) {
+				end = len(names)
+			}
+			domainsOut, err := conf.APIs.Elasticsearchservice.DescribeElasticsearchDomains(&elasticsearchservice.DescribeElasticsearchDomainsInput{DomainNames: names[i:end]})
+			if err != nil {
+				return resources, objects, err
+			}
+			for _, domain := range domainsOut.DomainStatusList {
+				objects = append(objects, domain)
+				res, err := awsconv.NewResource(domain)
+				if err != nil {
+					return resources, objects, err
+				}
+				resources = append(resources, res)
+			}
+		}
+
+		return resources, objects, nil
+	}
 }
 
 func addManualAccessFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
@@ -650,6 +746,10 @@ func addManualAccessFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
 			}
 		}
 	}
+
+	for _, name := range []string{"user", "role", "policy"} {
+		funcs[name] = withLastAccessed(conf, funcs[name])
+	}
 }
 func addManualStorageFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
 	funcs["bucket"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
@@ -676,6 +776,11 @@ func addManualStorageFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
 				return fmt.Errorf("fetching grants for bucket %s: %s", awssdk.StringValue(b.Name), err)
 			}
 			res.Properties()[properties.Grants] = grants
+			if encrypted, err := fetchBucketEncryptionFn(ctx, conf.APIs.S3, awssdk.StringValue(b.Name)); err != nil {
+				conf.Log.Verbosef("sync: cannot fetch encryption of bucket %s: %s", awssdk.StringValue(b.Name), err)
+			} else {
+				res.Properties()[properties.Encrypted] = encrypted
+			}
 			bucketM.Lock()
 			resources = append(resources, res)
 			bucketM.Unlock()
@@ -817,7 +922,74 @@ func addManualMessagingFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
 			}
 		}
 	}
+	funcs["emailidentity"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var objects []*string
+		var resources []*graph.Resource
+
+		if !conf.getBoolDefaultTrue("aws.messaging.emailidentity.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource messaging[emailidentity]")
+			return resources, objects, nil
+		}
+
+		// SES is only available in a few regions
+		if region, ok := ctx.Value("region").(string); ok && !isServiceInRegion(ses.EndpointsID, region) {
+			conf.Log.Verbosef("sync: no SES endpoint in region %s", region)
+			return resources, objects, nil
+		}
+
+		err := conf.APIs.Ses.ListIdentitiesPages(&ses.ListIdentitiesInput{}, func(out *ses.ListIdentitiesOutput, lastPage bool) bool {
+			objects = append(objects, out.Identities...)
+			return out.NextToken != nil
+		})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		// GetIdentityVerificationAttributes accepts at most 100 identities per call
+		for i := 0; i < len(objects); i += 100 {
+			end := i + 100
+			if end > len(objects) {
+				end = len(objects)
+			}
+			out, err := conf.APIs.Ses.GetIdentityVerificationAttributes(&ses.GetIdentityVerificationAttributesInput{Identities: objects[i:end]})
+			if err != nil {
+				return resources, objects, err
+			}
+			for _, identity := range objects[i:end] {
+				id := awssdk.StringValue(identity)
+				res := graph.InitResource(cloud.EmailIdentity, id)
+				res.Properties()[properties.ID] = id
+				res.Properties()[properties.Name] = id
+				if strings.Contains(id, "@") {
+					res.Properties()[properties.Type] = "email"
+				} else {
+					res.Properties()[properties.Type] = "domain"
+				}
+				if attrs, ok := out.VerificationAttributes[id]; ok {
+					res.Properties()[properties.State] = strings.ToLower(awssdk.StringValue(attrs.VerificationStatus))
+				}
+				resources = append(resources, res)
+			}
+		}
+
+		return resources, objects, nil
+	}
+}
+
+func isServiceInRegion(service, region string) bool {
+	partitions := endpoints.DefaultResolver().(endpoints.EnumPartitions).Partitions()
+	p, ok := endpoints.PartitionForRegion(partitions, region)
+	if !ok {
+		return false
+	}
+	regions, ok := endpoints.RegionsForService([]endpoints.Partition{p}, p.ID(), service)
+	if !ok {
+		return false
+	}
+	_, ok = regions[region]
+	return ok
 }
+
 func addManualDnsFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
 	funcs["record"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
 		var objects []*route53.ResourceRecordSet
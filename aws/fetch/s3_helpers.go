@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 	"github.com/wallix/awless/aws/conv"
@@ -211,3 +212,17 @@ func fetchAndExtractGrantsFn(ctx context.Context, api s3iface.S3API, bucketName
 	}
 	return grants, nil
 }
+
+// Buckets without default encryption return a not found error
+const bucketEncryptionNotFound = "ServerSideEncryptionConfigurationNotFoundError"
+
+func fetchBucketEncryptionFn(ctx context.Context, api s3iface.S3API, bucketName string) (bool, error) {
+	out, err := api.GetBucketEncryption(&s3.GetBucketEncryptionInput{Bucket: awssdk.String(bucketName)})
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == bucketEncryptionNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return out.ServerSideEncryptionConfiguration != nil && len(out.ServerSideEncryptionConfiguration.Rules) > 0, nil
+}
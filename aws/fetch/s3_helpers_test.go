@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
@@ -61,6 +62,18 @@ func TestFetchFunctions(t *testing.T) {
 			}
 		}
 	})
+	t.Run("fetchBucketEncryption", func(t *testing.T) {
+		mock := &mockS3{encryptions: map[string]string{"bucket_1": "AES256", "bucket_2": "aws:kms"}}
+		for bucket, want := range map[string]bool{"bucket_1": true, "bucket_2": true, "bucket_3": false} {
+			got, err := fetchBucketEncryptionFn(context.Background(), mock, bucket)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != want {
+				t.Fatalf("%s: got %t, want %t", bucket, got, want)
+			}
+		}
+	})
 }
 
 type mockS3 struct {
@@ -68,12 +81,24 @@ type mockS3 struct {
 	buckets map[string][]*s3.Bucket
 	objects map[string][]*s3.Object
 	grants  map[string][]*s3.Grant
+
+	encryptions map[string]string
 }
 
 func (m *mockS3) GetBucketAcl(input *s3.GetBucketAclInput) (*s3.GetBucketAclOutput, error) {
 	return &s3.GetBucketAclOutput{Grants: m.grants[awssdk.StringValue(input.Bucket)]}, nil
 }
 
+func (m *mockS3) GetBucketEncryption(input *s3.GetBucketEncryptionInput) (*s3.GetBucketEncryptionOutput, error) {
+	algo, ok := m.encryptions[awssdk.StringValue(input.Bucket)]
+	if !ok {
+		return nil, awserr.New("ServerSideEncryptionConfigurationNotFoundError", "no encryption", nil)
+	}
+	return &s3.GetBucketEncryptionOutput{ServerSideEncryptionConfiguration: &s3.ServerSideEncryptionConfiguration{
+		Rules: []*s3.ServerSideEncryptionRule{{ApplyServerSideEncryptionByDefault: &s3.ServerSideEncryptionByDefault{SSEAlgorithm: awssdk.String(algo)}}},
+	}}, nil
+}
+
 func (m *mockS3) ListBuckets(input *s3.ListBucketsInput) (*s3.ListBucketsOutput, error) {
 	var buckets []*s3.Bucket
 	for _, b := range m.buckets {
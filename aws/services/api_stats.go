@@ -0,0 +1,149 @@
+package awsservices
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"text/tabwriter"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+// DefaultAPIStats counts the AWS API calls made by each cloud service and by the commands
+var DefaultAPIStats = NewAPIStats()
+
+// CommandsAPIStatsName is the name under which the API calls of the commands (template runs) are counted
+const CommandsAPIStatsName = "commands"
+
+// apiOperation identifies an AWS API operation (ex: ec2 DescribeInstances) called by a service
+type apiOperation struct {
+	service, api, operation string
+}
+
+type APIStats struct {
+	mu        sync.Mutex
+	calls     map[apiOperation]int
+	retries   map[apiOperation]int
+	throttled map[string]int
+}
+
+func NewAPIStats() *APIStats {
+	return &APIStats{
+		calls:     make(map[apiOperation]int),
+		retries:   make(map[apiOperation]int),
+		throttled: make(map[string]int),
+	}
+}
+
+// APICall sums up the calls made to an operation of an AWS API (ec2, iam, etc.)
+type APICall struct {
+	Service, Operation string
+	Count, Retries     int
+}
+
+// All returns the calls made to each AWS API operation by all services, sorted by AWS API and operation
+func (s *APIStats) All() (all []APICall) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	indexes := make(map[[2]string]int)
+	for op, c := range s.calls {
+		key := [2]string{op.api, op.operation}
+		i, ok := indexes[key]
+		if !ok {
+			i = len(all)
+			indexes[key] = i
+			all = append(all, APICall{Service: op.api, Operation: op.operation})
+		}
+		all[i].Count += c
+		all[i].Retries += s.retries[op]
+	}
+	sort.Slice(all, func(i, j int) bool {
+		if all[i].Service == all[j].Service {
+			return all[i].Operation < all[j].Operation
+		}
+		return all[i].Service < all[j].Service
+	})
+	return
+}
+
+// Display writes a table of the calls made to each AWS API operation
+func (s *APIStats) Display(w io.Writer) {
+	all := s.All()
+	var total int
+	for _, c := range all {
+		total += c.Count
+	}
+	fmt.Fprintf(w, "\n%d AWS API calls:\n", total)
+	if total == 0 {
+		return
+	}
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "SERVICE\tOPERATION\tCALLS\tRETRIES")
+	for _, c := range all {
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%d\n", c.Service, c.Operation, c.Count, c.Retries)
+	}
+	tw.Flush()
+}
+
+// Calls returns the number of API calls made by the given service
+func (s *APIStats) Calls(service string) (count int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for op, c := range s.calls {
+		if op.service == service {
+			count += c
+		}
+	}
+	return
+}
+
+// Operations returns the number of calls per API operation made by the given service
+func (s *APIStats) Operations(service string) map[string]int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make(map[string]int)
+	for op, c := range s.calls {
+		if op.service == service {
+			out[op.operation] += c
+		}
+	}
+	return out
+}
+
+// Throttled returns the number of throttled API calls made by the given service
+func (s *APIStats) Throttled(service string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.throttled[service]
+}
+
+func (s *APIStats) addCall(op apiOperation, retries int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls[op]++
+	if retries > 0 {
+		s.retries[op] += retries
+	}
+}
+
+func (s *APIStats) addThrottled(service string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.throttled[service]++
+}
+
+// sessionWithAPIStats returns a copy of the session accounting its API calls (with their retries) to the given service
+func sessionWithAPIStats(sess *session.Session, service string, stats *APIStats) *session.Session {
+	copied := sess.Copy()
+	copied.Handlers.Complete.PushBack(func(r *request.Request) {
+		stats.addCall(apiOperation{service: service, api: r.ClientInfo.ServiceName, operation: r.Operation.Name}, r.RetryCount)
+	})
+	copied.Handlers.Retry.PushBack(func(r *request.Request) {
+		if r.IsErrorThrottle() {
+			stats.addThrottled(service)
+		}
+	})
+	return copied
+}
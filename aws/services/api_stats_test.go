@@ -0,0 +1,149 @@
+package awsservices
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+func TestSessionWithAPIStats(t *testing.T) {
+	var count int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count++
+		if count == 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`<ErrorResponse><Error><Code>Throttling</Code><Message>Rate exceeded</Message></Error></ErrorResponse>`))
+			return
+		}
+		w.Write([]byte(`<GetCallerIdentityResponse><GetCallerIdentityResult><Account>123456789012</Account></GetCallerIdentityResult></GetCallerIdentityResponse>`))
+	}))
+	defer server.Close()
+
+	sess := session.Must(session.NewSession(&awssdk.Config{
+		Region:      awssdk.String("us-east-1"),
+		Endpoint:    awssdk.String(server.URL),
+		Credentials: credentials.NewStaticCredentials("id", "secret", ""),
+	}))
+	stats := NewAPIStats()
+
+	if _, err := sts.New(sessionWithAPIStats(sess, "access", stats)).GetCallerIdentity(&sts.GetCallerIdentityInput{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sts.New(sess).GetCallerIdentity(&sts.GetCallerIdentityInput{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := stats.Calls("access"), 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := stats.Throttled("access"), 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := stats.Operations("access"), map[string]int{"GetCallerIdentity": 1}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := stats.Calls("infra"), 0; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+}
+
+func TestAPIStatsDisplayAndRateLimits(t *testing.T) {
+	var count int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count++
+		if count == 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`<ErrorResponse><Error><Code>Throttling</Code><Message>Rate exceeded</Message></Error></ErrorResponse>`))
+			return
+		}
+		w.Write([]byte(`<GetCallerIdentityResponse><GetCallerIdentityResult><Account>123456789012</Account></GetCallerIdentityResult></GetCallerIdentityResponse>`))
+	}))
+	defer server.Close()
+
+	sess := session.Must(session.NewSession(&awssdk.Config{
+		Region:      awssdk.String("us-east-1"),
+		Endpoint:    awssdk.String(server.URL),
+		Credentials: credentials.NewStaticCredentials("id", "secret", ""),
+	}))
+	stats := NewAPIStats()
+	now := time.Now()
+	limiter := newRateLimiter(20)
+	limiter.now = func() time.Time { return now }
+	var delays []time.Duration
+	limiter.sleep = func(d time.Duration) {
+		delays = append(delays, d)
+		now = now.Add(d)
+	}
+	withRateLimiters(sess, map[string]*rateLimiter{"sts": limiter})
+
+	if _, err := sts.New(sessionWithAPIStats(sess, "access", stats)).GetCallerIdentity(&sts.GetCallerIdentityInput{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sts.New(sessionWithAPIStats(sess, CommandsAPIStatsName, stats)).GetCallerIdentity(&sts.GetCallerIdentityInput{}); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := delays, []time.Duration{0, 50 * time.Millisecond, 50 * time.Millisecond}; !reflect.DeepEqual(got, want) { // 3 requests sent at 20 per second
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	if got, want := stats.All(), []APICall{{Service: "sts", Operation: "GetCallerIdentity", Count: 2, Retries: 1}}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := stats.Calls(CommandsAPIStatsName), 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	var buf bytes.Buffer
+	stats.Display(&buf)
+	if got, want := buf.String(), "2 AWS API calls:"; !strings.Contains(got, want) {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := buf.String(), "sts      GetCallerIdentity  2      1"; !strings.Contains(got, want) {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
+
+func TestAPIStatsCountRetriedCallOnce(t *testing.T) {
+	var count int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count++
+		if count == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`<GetCallerIdentityResponse><GetCallerIdentityResult><Account>123456789012</Account></GetCallerIdentityResult></GetCallerIdentityResponse>`))
+	}))
+	defer server.Close()
+
+	sess := session.Must(session.NewSession(&awssdk.Config{
+		Region:      awssdk.String("us-east-1"),
+		Endpoint:    awssdk.String(server.URL),
+		Credentials: credentials.NewStaticCredentials("id", "secret", ""),
+	}))
+	stats := NewAPIStats()
+
+	if _, err := sts.New(sessionWithAPIStats(sess, CommandsAPIStatsName, stats)).GetCallerIdentity(&sts.GetCallerIdentityInput{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := count, 2; got != want {
+		t.Fatalf("got %d requests sent, want %d", got, want)
+	}
+	if got, want := stats.All(), []APICall{{Service: "sts", Operation: "GetCallerIdentity", Count: 1, Retries: 1}}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := stats.Calls(CommandsAPIStatsName), 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := stats.Throttled(CommandsAPIStatsName), 0; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+}
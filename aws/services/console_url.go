@@ -0,0 +1,158 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsservices
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+)
+
+// consoleURLPaths are the AWS console paths per resource type.
+// {region}, {id} and {name} are replaced with the resource values.
+var consoleURLPaths = map[string]string{
+	cloud.Instance:      "/ec2/v2/home?region={region}#Instances:instanceId={id}",
+	cloud.Volume:        "/ec2/v2/home?region={region}#Volumes:volumeId={id}",
+	cloud.Image:         "/ec2/v2/home?region={region}#Images:imageId={id}",
+	cloud.Snapshot:      "/ec2/v2/home?region={region}#Snapshots:snapshotId={id}",
+	cloud.SecurityGroup: "/ec2/v2/home?region={region}#SecurityGroups:groupId={id}",
+	cloud.Keypair:       "/ec2/v2/home?region={region}#KeyPairs:keyName={id}",
+	cloud.Vpc:           "/vpc/home?region={region}#vpcs:VpcId={id}",
+	cloud.Subnet:        "/vpc/home?region={region}#subnets:SubnetId={id}",
+	cloud.ScalingGroup:  "/ec2/autoscaling/home?region={region}#AutoScalingGroups:id={name}",
+	cloud.Database:      "/rds/home?region={region}#database:id={id}",
+	cloud.Function:      "/lambda/home?region={region}#/functions/{name}",
+	cloud.Stack:         "/cloudformation/home?region={region}#/stacks/stackinfo?stackId={id}",
+	cloud.Bucket:        "/s3/buckets/{id}?region={region}",
+	cloud.User:          "/iam/home#/users/{name}",
+	cloud.Role:          "/iam/home#/roles/{name}",
+	cloud.Group:         "/iam/home#/groups/{name}",
+	cloud.Policy:        "/iam/home#/policies/{arn}",
+	cloud.Zone:          "/route53/home#resource-record-sets:{id}",
+	cloud.Distribution:  "/cloudfront/home#distribution-settings:{id}",
+}
+
+// ConsoleURL returns the AWS console URL displaying the given resource
+func ConsoleURL(res cloud.Resource, region string) (string, error) {
+	path, ok := consoleURLPaths[res.Type()]
+	if !ok {
+		return "", fmt.Errorf("no AWS console page known for resource type %s", res.Type())
+	}
+	name, _ := res.Properties()[properties.Name].(string)
+	if name == "" {
+		name = res.Id()
+	}
+	arn, _ := res.Properties()[properties.Arn].(string)
+	if arn == "" {
+		arn = res.Id()
+	}
+	replacer := strings.NewReplacer(
+		"{region}", region,
+		"{id}", url.PathEscape(strings.TrimPrefix(res.Id(), "/hostedzone/")),
+		"{name}", url.PathEscape(name),
+		"{arn}", url.PathEscape(arn),
+	)
+	return "https://" + consoleDomain(region) + replacer.Replace(path), nil
+}
+
+// ConsoleSigninURL returns a console sign-in URL redirecting to destination
+// when the current credentials are temporary (i.e. assumed role, MFA session).
+// Otherwise destination is returned untouched since users sign in with their own login.
+func (s *Access) ConsoleSigninURL(destination, region string) (string, error) {
+	client, ok := s.STSAPI.(*sts.STS)
+	if !ok || client.Config.Credentials == nil {
+		return destination, nil
+	}
+	creds, err := client.Config.Credentials.Get()
+	if err != nil {
+		return "", err
+	}
+	if creds.SessionToken == "" {
+		return destination, nil
+	}
+	return federatedSigninURL(&http.Client{Timeout: 10 * time.Second}, "https://"+signinDomain(region)+"/federation", creds, destination)
+}
+
+func federatedSigninURL(client *http.Client, endpoint string, creds credentials.Value, destination string) (string, error) {
+	session, err := json.Marshal(map[string]string{
+		"sessionId":    creds.AccessKeyID,
+		"sessionKey":   creds.SecretAccessKey,
+		"sessionToken": creds.SessionToken,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	query := url.Values{}
+	query.Set("Action", "getSigninToken")
+	query.Set("Session", string(session))
+	resp, err := client.Get(endpoint + "?" + query.Encode())
+	if err != nil {
+		return "", fmt.Errorf("federation sign-in token: %s", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("federation sign-in token: unexpected status %s", resp.Status)
+	}
+	var token struct {
+		SigninToken string
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", fmt.Errorf("federation sign-in token: %s", err)
+	}
+	if token.SigninToken == "" {
+		return "", errors.New("federation sign-in token: empty token")
+	}
+
+	login := url.Values{}
+	login.Set("Action", "login")
+	login.Set("Issuer", "awless")
+	login.Set("Destination", destination)
+	login.Set("SigninToken", token.SigninToken)
+	return endpoint + "?" + login.Encode(), nil
+}
+
+func consoleDomain(region string) string {
+	switch {
+	case strings.HasPrefix(region, "cn-"):
+		return "console.amazonaws.cn"
+	case strings.HasPrefix(region, "us-gov-"):
+		return "console.amazonaws-us-gov.com"
+	default:
+		return "console.aws.amazon.com"
+	}
+}
+
+func signinDomain(region string) string {
+	switch {
+	case strings.HasPrefix(region, "cn-"):
+		return "signin.amazonaws.cn"
+	case strings.HasPrefix(region, "us-gov-"):
+		return "signin.amazonaws-us-gov.com"
+	default:
+		return "signin.aws.amazon.com"
+	}
+}
@@ -0,0 +1,82 @@
+package awsservices
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/wallix/awless/cloud"
+	p "github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph/resourcetest"
+)
+
+func TestConsoleURL(t *testing.T) {
+	tcases := []struct {
+		res    cloud.Resource
+		region string
+		exp    string
+		expErr bool
+	}{
+		{res: resourcetest.Instance("i-1234").Build(), region: "eu-west-1", exp: "https://console.aws.amazon.com/ec2/v2/home?region=eu-west-1#Instances:instanceId=i-1234"},
+		{res: resourcetest.User("AIDA1234").Prop(p.Name, "jsmith").Build(), region: "eu-west-1", exp: "https://console.aws.amazon.com/iam/home#/users/jsmith"},
+		{res: resourcetest.Bucket("my-bucket").Build(), region: "us-east-1", exp: "https://console.aws.amazon.com/s3/buckets/my-bucket?region=us-east-1"},
+		{res: resourcetest.Zone("/hostedzone/Z1234").Build(), region: "us-east-1", exp: "https://console.aws.amazon.com/route53/home#resource-record-sets:Z1234"},
+		{res: resourcetest.Subnet("subnet-1234").Build(), region: "cn-north-1", exp: "https://console.amazonaws.cn/vpc/home?region=cn-north-1#subnets:SubnetId=subnet-1234"},
+		{res: resourcetest.Record("rec-1234").Build(), region: "us-east-1", expErr: true},
+	}
+	for i, tcase := range tcases {
+		got, err := ConsoleURL(tcase.res, tcase.region)
+		if tcase.expErr {
+			if err == nil {
+				t.Fatalf("%d: expected error", i+1)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%d: %s", i+1, err)
+		}
+		if got != tcase.exp {
+			t.Fatalf("%d: got %s, want %s", i+1, got, tcase.exp)
+		}
+	}
+}
+
+func TestFederatedSigninURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.Query().Get("Action"), "getSigninToken"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		session := make(map[string]string)
+		if err := json.Unmarshal([]byte(r.URL.Query().Get("Session")), &session); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := session["sessionToken"], "token"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		w.Write([]byte(`{"SigninToken":"signin-token"}`))
+	}))
+	defer server.Close()
+
+	creds := credentials.Value{AccessKeyID: "ASIA1234", SecretAccessKey: "secret", SessionToken: "token"}
+	destination := "https://console.aws.amazon.com/ec2/v2/home?region=eu-west-1#Instances:instanceId=i-1234"
+	signin, err := federatedSigninURL(server.Client(), server.URL, creds, destination)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err := url.Parse(signin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := u.Query().Get("Action"), "login"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := u.Query().Get("SigninToken"), "signin-token"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := u.Query().Get("Destination"), destination; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
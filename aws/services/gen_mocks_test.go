@@ -39,6 +39,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/ecr/ecriface"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/aws/aws-sdk-go/service/elasticsearchservice/elasticsearchserviceiface"
 	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/elb/elbiface"
 	"github.com/aws/aws-sdk-go/service/elbv2"
@@ -53,6 +55,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/route53/route53iface"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/aws/aws-sdk-go/service/ses/sesiface"
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/aws/aws-sdk-go/service/sns/snsiface"
 	"github.com/aws/aws-sdk-go/service/sqs"
@@ -550,6 +554,47 @@ func (m *mockAcm) ListCertificatesPages(input *acm.ListCertificatesInput, fn fun
 	return nil
 }
 
+type mockElasticsearchservice struct {
+	elasticsearchserviceiface.ElasticsearchServiceAPI
+	elasticsearchdomainstatuss []*elasticsearchservice.ElasticsearchDomainStatus
+}
+
+func (m *mockElasticsearchservice) Name() string {
+	return ""
+}
+
+func (m *mockElasticsearchservice) Region() string {
+	return ""
+}
+
+func (m *mockElasticsearchservice) Profile() string {
+	return ""
+}
+
+func (m *mockElasticsearchservice) Provider() string {
+	return ""
+}
+
+func (m *mockElasticsearchservice) ProviderAPI() string {
+	return ""
+}
+
+func (m *mockElasticsearchservice) ResourceTypes() []string {
+	return []string{}
+}
+
+func (m *mockElasticsearchservice) Fetch(context.Context) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
+func (m *mockElasticsearchservice) IsSyncDisabled() bool {
+	return false
+}
+
+func (m *mockElasticsearchservice) FetchByType(context.Context, string) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
 type mockIam struct {
 	iamiface.IAMAPI
 	userdetails          []*iam.UserDetail
@@ -815,6 +860,48 @@ func (m *mockSqs) ListQueues(input *sqs.ListQueuesInput) (*sqs.ListQueuesOutput,
 	return &sqs.ListQueuesOutput{QueueUrls: m.strings}, nil
 }
 
+type mockSes struct {
+	sesiface.SESAPI
+	strings    []*string
+	attributes map[string]*ses.IdentityVerificationAttributes
+}
+
+func (m *mockSes) Name() string {
+	return ""
+}
+
+func (m *mockSes) Region() string {
+	return ""
+}
+
+func (m *mockSes) Profile() string {
+	return ""
+}
+
+func (m *mockSes) Provider() string {
+	return ""
+}
+
+func (m *mockSes) ProviderAPI() string {
+	return ""
+}
+
+func (m *mockSes) ResourceTypes() []string {
+	return []string{}
+}
+
+func (m *mockSes) Fetch(context.Context) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
+func (m *mockSes) IsSyncDisabled() bool {
+	return false
+}
+
+func (m *mockSes) FetchByType(context.Context, string) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
 type mockRoute53 struct {
 	route53iface.Route53API
 	hostedzones        []*route53.HostedZone
@@ -1175,6 +1262,8 @@ type mockEcs struct {
 	tasksNames              map[string][]*string
 	containerinstancesNames map[string][]*string
 	containerinstances      map[string][]*ecs.ContainerInstance
+	servicesNames           map[string][]*string
+	services                map[string][]*ecs.Service
 }
 
 func (m *mockEcs) Name() string {
@@ -46,6 +46,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/ecr/ecriface"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/aws/aws-sdk-go/service/elasticsearchservice/elasticsearchserviceiface"
 	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/elb/elbiface"
 	"github.com/aws/aws-sdk-go/service/elbv2"
@@ -60,6 +62,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/route53/route53iface"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/aws/aws-sdk-go/service/ses/sesiface"
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/aws/aws-sdk-go/service/sns/snsiface"
 	"github.com/aws/aws-sdk-go/service/sqs"
@@ -115,10 +119,12 @@ var ResourceTypes = []string{
 	"scalingpolicy",
 	"repository",
 	"containercluster",
+	"containerservice",
 	"containertask",
 	"container",
 	"containerinstance",
 	"certificate",
+	"elasticsearchdomain",
 	"user",
 	"group",
 	"role",
@@ -131,6 +137,7 @@ var ResourceTypes = []string{
 	"subscription",
 	"topic",
 	"queue",
+	"emailidentity",
 	"zone",
 	"record",
 	"function",
@@ -141,25 +148,27 @@ var ResourceTypes = []string{
 }
 
 var ServicePerAPI = map[string]string{
-	"ec2":         "infra",
-	"elbv2":       "infra",
-	"elb":         "infra",
-	"rds":         "infra",
-	"autoscaling": "infra",
-	"ecr":         "infra",
-	"ecs":         "infra",
+	"ec2":                    "infra",
+	"elbv2":                  "infra",
+	"elb":                    "infra",
+	"rds":                    "infra",
+	"autoscaling":            "infra",
+	"ecr":                    "infra",
+	"ecs":                    "infra",
 	"applicationautoscaling": "infra",
-	"acm":            "infra",
-	"iam":            "access",
-	"sts":            "access",
-	"s3":             "storage",
-	"sns":            "messaging",
-	"sqs":            "messaging",
-	"route53":        "dns",
-	"lambda":         "lambda",
-	"cloudwatch":     "monitoring",
-	"cloudfront":     "cdn",
-	"cloudformation": "cloudformation",
+	"acm":                    "infra",
+	"elasticsearchservice":   "infra",
+	"iam":                    "access",
+	"sts":                    "access",
+	"s3":                     "storage",
+	"sns":                    "messaging",
+	"sqs":                    "messaging",
+	"ses":                    "messaging",
+	"route53":                "dns",
+	"lambda":                 "lambda",
+	"cloudwatch":             "monitoring",
+	"cloudfront":             "cdn",
+	"cloudformation":         "cloudformation",
 }
 
 var ServicePerResourceType = map[string]string{
@@ -189,10 +198,12 @@ var ServicePerResourceType = map[string]string{
 	"scalingpolicy":       "infra",
 	"repository":          "infra",
 	"containercluster":    "infra",
+	"containerservice":    "infra",
 	"containertask":       "infra",
 	"container":           "infra",
 	"containerinstance":   "infra",
 	"certificate":         "infra",
+	"elasticsearchdomain": "infra",
 	"user":                "access",
 	"group":               "access",
 	"role":                "access",
@@ -205,6 +216,7 @@ var ServicePerResourceType = map[string]string{
 	"subscription":        "messaging",
 	"topic":               "messaging",
 	"queue":               "messaging",
+	"emailidentity":       "messaging",
 	"zone":                "dns",
 	"record":              "dns",
 	"function":            "lambda",
@@ -241,10 +253,12 @@ var APIPerResourceType = map[string]string{
 	"scalingpolicy":       "autoscaling",
 	"repository":          "ecr",
 	"containercluster":    "ecs",
+	"containerservice":    "ecs",
 	"containertask":       "ecs",
 	"container":           "ecs",
 	"containerinstance":   "ecs",
 	"certificate":         "acm",
+	"elasticsearchdomain": "elasticsearchservice",
 	"user":                "iam",
 	"group":               "iam",
 	"role":                "iam",
@@ -257,6 +271,7 @@ var APIPerResourceType = map[string]string{
 	"subscription":        "sns",
 	"topic":               "sns",
 	"queue":               "sqs",
+	"emailidentity":       "ses",
 	"zone":                "route53",
 	"record":              "route53",
 	"function":            "lambda",
@@ -280,6 +295,7 @@ type Infra struct {
 	ecsiface.ECSAPI
 	applicationautoscalingiface.ApplicationAutoScalingAPI
 	acmiface.ACMAPI
+	elasticsearchserviceiface.ElasticsearchServiceAPI
 }
 
 func NewInfra(sess *session.Session, profile string, extraConf map[string]interface{}, log *logger.Logger) cloud.Service {
@@ -293,6 +309,7 @@ func NewInfra(sess *session.Session, profile string, extraConf map[string]interf
 	ecsAPI := ecs.New(sess)
 	applicationautoscalingAPI := applicationautoscaling.New(sess)
 	acmAPI := acm.New(sess)
+	elasticsearchserviceAPI := elasticsearchservice.New(sess)
 
 	fetchConfig := awsfetch.NewConfig(
 		ec2API,
@@ -304,25 +321,27 @@ func NewInfra(sess *session.Session, profile string, extraConf map[string]interf
 		ecsAPI,
 		applicationautoscalingAPI,
 		acmAPI,
+		elasticsearchserviceAPI,
 	)
 	fetchConfig.Extra = extraConf
 	fetchConfig.Log = log
 
 	return &Infra{
-		EC2API:         ec2API,
-		ELBV2API:       elbv2API,
-		ELBAPI:         elbAPI,
-		RDSAPI:         rdsAPI,
-		AutoScalingAPI: autoscalingAPI,
-		ECRAPI:         ecrAPI,
-		ECSAPI:         ecsAPI,
+		EC2API:                    ec2API,
+		ELBV2API:                  elbv2API,
+		ELBAPI:                    elbAPI,
+		RDSAPI:                    rdsAPI,
+		AutoScalingAPI:            autoscalingAPI,
+		ECRAPI:                    ecrAPI,
+		ECSAPI:                    ecsAPI,
 		ApplicationAutoScalingAPI: applicationautoscalingAPI,
-		ACMAPI:  acmAPI,
-		fetcher: fetch.NewFetcher(awsfetch.BuildInfraFetchFuncs(fetchConfig)),
-		config:  extraConf,
-		region:  region,
-		profile: profile,
-		log:     log,
+		ACMAPI:                    acmAPI,
+		ElasticsearchServiceAPI:   elasticsearchserviceAPI,
+		fetcher:                   fetch.NewFetcher(awsfetch.BuildInfraFetchFuncs(fetchConfig)),
+		config:                    extraConf,
+		region:                    region,
+		profile:                   profile,
+		log:                       log,
 	}
 }
 
@@ -366,10 +385,12 @@ func (s *Infra) ResourceTypes() []string {
 		"scalingpolicy",
 		"repository",
 		"containercluster",
+		"containerservice",
 		"containertask",
 		"container",
 		"containerinstance",
 		"certificate",
+		"elasticsearchdomain",
 	}
 }
 
@@ -979,6 +1000,28 @@ func (s *Infra) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 			}
 		}
 	}
+	if getBool(s.config, "aws.infra.containerservice.sync", true) {
+		list, err := s.fetcher.Get("containerservice_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*ecs.Service); !ok {
+			return gph, errors.New("cannot cast to '[]*ecs.Service' type from fetch context")
+		}
+		for _, r := range list.([]*ecs.Service) {
+			for _, fn := range addParentsFns["containerservice"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *ecs.Service) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
 	if getBool(s.config, "aws.infra.containertask.sync", true) {
 		list, err := s.fetcher.Get("containertask_objects")
 		if err != nil {
@@ -1067,6 +1110,28 @@ func (s *Infra) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 			}
 		}
 	}
+	if getBool(s.config, "aws.infra.elasticsearchdomain.sync", true) {
+		list, err := s.fetcher.Get("elasticsearchdomain_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*elasticsearchservice.ElasticsearchDomainStatus); !ok {
+			return gph, errors.New("cannot cast to '[]*elasticsearchservice.ElasticsearchDomainStatus' type from fetch context")
+		}
+		for _, r := range list.([]*elasticsearchservice.ElasticsearchDomainStatus) {
+			for _, fn := range addParentsFns["elasticsearchdomain"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *elasticsearchservice.ElasticsearchDomainStatus) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
 
 	go func() {
 		wg.Wait()
@@ -1527,16 +1592,19 @@ type Messaging struct {
 	log             *logger.Logger
 	snsiface.SNSAPI
 	sqsiface.SQSAPI
+	sesiface.SESAPI
 }
 
 func NewMessaging(sess *session.Session, profile string, extraConf map[string]interface{}, log *logger.Logger) cloud.Service {
 	region := awssdk.StringValue(sess.Config.Region)
 	snsAPI := sns.New(sess)
 	sqsAPI := sqs.New(sess)
+	sesAPI := ses.New(sess)
 
 	fetchConfig := awsfetch.NewConfig(
 		snsAPI,
 		sqsAPI,
+		sesAPI,
 	)
 	fetchConfig.Extra = extraConf
 	fetchConfig.Log = log
@@ -1544,6 +1612,7 @@ func NewMessaging(sess *session.Session, profile string, extraConf map[string]in
 	return &Messaging{
 		SNSAPI:  snsAPI,
 		SQSAPI:  sqsAPI,
+		SESAPI:  sesAPI,
 		fetcher: fetch.NewFetcher(awsfetch.BuildMessagingFetchFuncs(fetchConfig)),
 		config:  extraConf,
 		region:  region,
@@ -1569,6 +1638,7 @@ func (s *Messaging) ResourceTypes() []string {
 		"subscription",
 		"topic",
 		"queue",
+		"emailidentity",
 	}
 }
 
@@ -1672,6 +1742,28 @@ func (s *Messaging) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 			}
 		}
 	}
+	if getBool(s.config, "aws.messaging.emailidentity.sync", true) {
+		list, err := s.fetcher.Get("emailidentity_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*string); !ok {
+			return gph, errors.New("cannot cast to '[]*string' type from fetch context")
+		}
+		for _, r := range list.([]*string) {
+			for _, fn := range addParentsFns["emailidentity"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *string) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
 
 	go func() {
 		wg.Wait()
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/iam/iamiface"
 	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/aws/aws-sdk-go/service/sts/stsiface"
 )
@@ -46,3 +48,31 @@ func TestGetIdentityParseAllTypesOfUsername(t *testing.T) {
 		}
 	}
 }
+
+type mockIAMAliases struct {
+	iamiface.IAMAPI
+	aliases []*string
+}
+
+func (m *mockIAMAliases) ListAccountAliases(in *iam.ListAccountAliasesInput) (*iam.ListAccountAliasesOutput, error) {
+	return &iam.ListAccountAliasesOutput{AccountAliases: m.aliases}, nil
+}
+
+func TestGetAccountAlias(t *testing.T) {
+	access := Access{IAMAPI: &mockIAMAliases{aliases: []*string{awssdk.String("my-company-prod")}}}
+	alias, err := access.GetAccountAlias()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := alias, "my-company-prod"; got != want {
+		t.Fatalf("got '%s', want '%s'", got, want)
+	}
+
+	access = Access{IAMAPI: &mockIAMAliases{}}
+	if alias, err = access.GetAccountAlias(); err != nil {
+		t.Fatal(err)
+	}
+	if alias != "" {
+		t.Fatalf("got '%s', want no alias", alias)
+	}
+}
@@ -17,8 +17,13 @@ limitations under the License.
 package awsservices
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	stdsync "sync"
 
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/wallix/awless/aws/config"
 	"github.com/wallix/awless/aws/spec"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/graph"
@@ -37,35 +42,43 @@ func Init(profile, region string, extraConf map[string]interface{}, log *logger.
 
 	sb := newSessionResolver().withRegion(region).withProfile(profile).withNetworkMonitor(enableNetworkMonitor)
 	sb = sb.withProfileSetter(profileSetterCallback).withLogger(log).withCredentialResolvers()
+	sb = sb.withRoleCredentials(getBool(extraConf, "aws.credentials.preferrole", true))
 
 	sess, err := sb.resolve()
 	if err != nil {
 		return err
 	}
 
-	AccessService = NewAccess(sess, profile, extraConf, log)
-	InfraService = NewInfra(sess, profile, extraConf, log)
-	StorageService = NewStorage(sess, profile, extraConf, log)
-	MessagingService = NewMessaging(sess, profile, extraConf, log)
-	DnsService = NewDns(sess, profile, extraConf, log)
-	LambdaService = NewLambda(sess, profile, extraConf, log)
-	MonitoringService = NewMonitoring(sess, profile, extraConf, log)
-	CdnService = NewCdn(sess, profile, extraConf, log)
-	CloudformationService = NewCloudformation(sess, profile, extraConf, log)
-
-	cloud.ServiceRegistry[InfraService.Name()] = InfraService
-	cloud.ServiceRegistry[AccessService.Name()] = AccessService
-	cloud.ServiceRegistry[StorageService.Name()] = StorageService
-	cloud.ServiceRegistry[MessagingService.Name()] = MessagingService
-	cloud.ServiceRegistry[DnsService.Name()] = DnsService
-	cloud.ServiceRegistry[LambdaService.Name()] = LambdaService
-	cloud.ServiceRegistry[MonitoringService.Name()] = MonitoringService
-	cloud.ServiceRegistry[CdnService.Name()] = CdnService
-	cloud.ServiceRegistry[CloudformationService.Name()] = CloudformationService
+	if limits, ok := extraConf["aws.api.ratelimits"].(string); ok {
+		parsed, err := awsconfig.ParseAPIRateLimits(limits)
+		if err != nil {
+			return fmt.Errorf("aws.api.ratelimits: %s", err)
+		}
+		withRateLimits(sess, parsed)
+	}
+
+	newService := func(unset cloud.Service, newFn func(*session.Session, string, map[string]interface{}, *logger.Logger) cloud.Service) cloud.Service {
+		return newLazyService(unset, region, profile, func() cloud.Service {
+			return newFn(sessionWithAPIStats(sess, unset.Name(), DefaultAPIStats), profile, extraConf, log)
+		})
+	}
+	AccessService = newService(new(Access), NewAccess)
+	InfraService = newService(new(Infra), NewInfra)
+	StorageService = newService(new(Storage), NewStorage)
+	MessagingService = newService(new(Messaging), NewMessaging)
+	DnsService = newService(new(Dns), NewDns)
+	LambdaService = newService(new(Lambda), NewLambda)
+	MonitoringService = newService(new(Monitoring), NewMonitoring)
+	CdnService = newService(new(Cdn), NewCdn)
+	CloudformationService = newService(new(Cloudformation), NewCloudformation)
+
+	for _, srv := range []cloud.Service{InfraService, AccessService, StorageService, MessagingService, DnsService, LambdaService, MonitoringService, CdnService, CloudformationService} {
+		cloud.ServiceRegistry[srv.Name()] = srv
+	}
 
 	awsspec.CommandFactory = &awsspec.AWSFactory{
 		Log:  log,
-		Sess: sess,
+		Sess: sessionWithAPIStats(sess, CommandsAPIStatsName, DefaultAPIStats),
 		Graph: &cloud.LazyGraph{LoadingFunc: func() cloud.GraphAPI {
 			g, err := sync.LoadLocalGraphs(profile, region)
 			if err != nil || g == nil {
@@ -78,6 +91,54 @@ func Init(profile, region string, extraConf map[string]interface{}, log *logger.
 	return nil
 }
 
+// lazyService builds the service, with its own session and AWS clients, on first use only:
+// a command sets up the clients of the services it calls and not the ones of all the services.
+type lazyService struct {
+	name, region, profile string
+	resourceTypes         []string
+	once                  stdsync.Once
+	build                 func() cloud.Service
+	srv                   cloud.Service
+}
+
+// newLazyService takes the name and resource types of the service from its unset value
+func newLazyService(unset cloud.Service, region, profile string, build func() cloud.Service) *lazyService {
+	return &lazyService{name: unset.Name(), resourceTypes: unset.ResourceTypes(), region: region, profile: profile, build: build}
+}
+
+func (s *lazyService) get() cloud.Service {
+	s.once.Do(func() {
+		s.srv = s.build()
+	})
+	return s.srv
+}
+
+func (s *lazyService) Name() string            { return s.name }
+func (s *lazyService) Region() string          { return s.region }
+func (s *lazyService) Profile() string         { return s.profile }
+func (s *lazyService) ResourceTypes() []string { return s.resourceTypes }
+
+func (s *lazyService) IsSyncDisabled() bool {
+	return s.get().IsSyncDisabled()
+}
+
+func (s *lazyService) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
+	return s.get().Fetch(ctx)
+}
+
+func (s *lazyService) FetchByType(ctx context.Context, t string) (cloud.GraphAPI, error) {
+	return s.get().FetchByType(ctx, t)
+}
+
+// Resolve returns the service built on first use, to call its AWS APIs (ex: Resolve(InfraService).(*Infra)).
+// The services not built by Init (ex: mocks) are returned as is.
+func Resolve(srv cloud.Service) cloud.Service {
+	if lazy, ok := srv.(*lazyService); ok {
+		return lazy.get()
+	}
+	return srv
+}
+
 func getBool(m map[string]interface{}, key string, def bool) bool {
 	if b, ok := m[key].(bool); ok {
 		return b
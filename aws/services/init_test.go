@@ -0,0 +1,47 @@
+package awsservices
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/wallix/awless/cloud"
+)
+
+func TestLazyService(t *testing.T) {
+	var built int
+	infra := &Infra{region: "eu-west-1", profile: "default"}
+	lazy := newLazyService(new(Infra), "eu-west-1", "default", func() cloud.Service {
+		built++
+		return infra
+	})
+
+	if got, want := lazy.Name(), "infra"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := lazy.ResourceTypes(), new(Infra).ResourceTypes(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := lazy.Region()+" "+lazy.Profile(), "eu-west-1 default"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if built != 0 {
+		t.Fatalf("service built %d times, want none before use", built)
+	}
+
+	if got, ok := Resolve(lazy).(*Infra); !ok || got != infra {
+		t.Fatalf("got %#v, want built infra service", got)
+	}
+	if lazy.IsSyncDisabled() {
+		t.Fatal("expected sync enabled")
+	}
+	if built != 1 {
+		t.Fatalf("service built %d times, want once", built)
+	}
+
+	if got := Resolve(infra); got != infra {
+		t.Fatalf("got %#v, want service as is", got)
+	}
+	if got := Resolve(nil); got != nil {
+		t.Fatalf("got %#v, want nil", got)
+	}
+}
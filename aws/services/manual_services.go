@@ -17,11 +17,14 @@ limitations under the License.
 package awsservices
 
 import (
+	"encoding/base64"
+	"fmt"
 	"regexp"
 	"strings"
 	"sync"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/wallix/awless/cloud"
 )
@@ -108,6 +111,18 @@ func (s *Access) GetIdentity() (*Identity, error) {
 	return ident, nil
 }
 
+// GetAccountAlias returns the alias of the account (empty when it has none)
+func (s *Access) GetAccountAlias() (string, error) {
+	resp, err := s.IAMAPI.ListAccountAliases(&iam.ListAccountAliasesInput{})
+	if err != nil {
+		return "", err
+	}
+	if len(resp.AccountAliases) > 0 {
+		return awssdk.StringValue(resp.AccountAliases[0]), nil
+	}
+	return "", nil
+}
+
 type UserPolicies struct {
 	Username string
 	Inlined  []string
@@ -212,3 +227,22 @@ func (s *Access) GetUserPolicies(username string) (*UserPolicies, error) {
 
 	return all, nil
 }
+
+// GetUserData returns the decoded userdata of the given instance (empty when the instance has none)
+func (s *Infra) GetUserData(instanceID string) (string, error) {
+	out, err := s.DescribeInstanceAttribute(&ec2.DescribeInstanceAttributeInput{
+		InstanceId: awssdk.String(instanceID),
+		Attribute:  awssdk.String(ec2.InstanceAttributeNameUserData),
+	})
+	if err != nil {
+		return "", err
+	}
+	if out.UserData == nil || out.UserData.Value == nil {
+		return "", nil
+	}
+	decoded, err := base64.StdEncoding.DecodeString(awssdk.StringValue(out.UserData.Value))
+	if err != nil {
+		return "", fmt.Errorf("decoding userdata of %s: %s", instanceID, err)
+	}
+	return string(decoded), nil
+}
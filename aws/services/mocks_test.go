@@ -5,13 +5,16 @@ import (
 	"strconv"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/elasticsearchservice"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/ses"
 	"github.com/aws/aws-sdk-go/service/sqs"
 )
 
@@ -70,6 +73,10 @@ func (m *mockS3) GetBucketAcl(input *s3.GetBucketAclInput) (*s3.GetBucketAclOutp
 	return &s3.GetBucketAclOutput{Grants: m.grants[awssdk.StringValue(input.Bucket)]}, nil
 }
 
+func (m *mockS3) GetBucketEncryption(input *s3.GetBucketEncryptionInput) (*s3.GetBucketEncryptionOutput, error) {
+	return nil, awserr.New("ServerSideEncryptionConfigurationNotFoundError", "no encryption", nil)
+}
+
 func (m *mockS3) ListBuckets(input *s3.ListBucketsInput) (*s3.ListBucketsOutput, error) {
 	var buckets []*s3.Bucket
 	for _, b := range m.buckets {
@@ -96,6 +103,21 @@ func (m *mockSqs) GetQueueAttributes(input *sqs.GetQueueAttributesInput) (*sqs.G
 	return &sqs.GetQueueAttributesOutput{Attributes: m.attributes[awssdk.StringValue(input.QueueUrl)]}, nil
 }
 
+func (m *mockSes) ListIdentitiesPages(input *ses.ListIdentitiesInput, fn func(p *ses.ListIdentitiesOutput, lastPage bool) (shouldContinue bool)) error {
+	fn(&ses.ListIdentitiesOutput{Identities: m.strings}, true)
+	return nil
+}
+
+func (m *mockSes) GetIdentityVerificationAttributes(input *ses.GetIdentityVerificationAttributesInput) (*ses.GetIdentityVerificationAttributesOutput, error) {
+	attributes := make(map[string]*ses.IdentityVerificationAttributes)
+	for _, identity := range input.Identities {
+		if attrs, ok := m.attributes[awssdk.StringValue(identity)]; ok {
+			attributes[awssdk.StringValue(identity)] = attrs
+		}
+	}
+	return &ses.GetIdentityVerificationAttributesOutput{VerificationAttributes: attributes}, nil
+}
+
 func (m *mockCloudfront) ListDistributionsPages(input *cloudfront.ListDistributionsInput, fn func(p *cloudfront.ListDistributionsOutput, lastPage bool) (shouldContinue bool)) error {
 	var pages [][]*cloudfront.DistributionSummary
 	for i := 0; i < len(m.distributionsummarys); i += 2 {
@@ -188,3 +210,55 @@ func (m *mockEcs) ListContainerInstancesPages(input *ecs.ListContainerInstancesI
 func (m *mockEcs) DescribeContainerInstances(input *ecs.DescribeContainerInstancesInput) (*ecs.DescribeContainerInstancesOutput, error) {
 	return &ecs.DescribeContainerInstancesOutput{ContainerInstances: m.containerinstances[awssdk.StringValue(input.Cluster)]}, nil
 }
+
+func (m *mockEcs) ListServicesPages(input *ecs.ListServicesInput, fn func(p *ecs.ListServicesOutput, lastPage bool) (shouldContinue bool)) error {
+	var pages [][]*string
+	for i := 0; i < len(m.servicesNames[awssdk.StringValue(input.Cluster)]); i += 2 {
+		page := []*string{m.servicesNames[awssdk.StringValue(input.Cluster)][i]}
+		if i+1 < len(m.servicesNames[awssdk.StringValue(input.Cluster)]) {
+			page = append(page, m.servicesNames[awssdk.StringValue(input.Cluster)][i+1])
+		}
+		pages = append(pages, page)
+	}
+	for i, page := range pages {
+		fn(&ecs.ListServicesOutput{ServiceArns: page, NextToken: awssdk.String(strconv.Itoa(i + 1))},
+			i < len(pages),
+		)
+	}
+	return nil
+}
+
+func (m *mockEcs) DescribeServices(input *ecs.DescribeServicesInput) (*ecs.DescribeServicesOutput, error) {
+	var services []*ecs.Service
+	for _, service := range m.services[awssdk.StringValue(input.Cluster)] {
+		for _, arn := range input.Services {
+			if awssdk.StringValue(service.ServiceArn) == awssdk.StringValue(arn) {
+				services = append(services, service)
+			}
+		}
+	}
+	return &ecs.DescribeServicesOutput{Services: services}, nil
+}
+
+func (m *mockElasticsearchservice) ListDomainNames(input *elasticsearchservice.ListDomainNamesInput) (*elasticsearchservice.ListDomainNamesOutput, error) {
+	var infos []*elasticsearchservice.DomainInfo
+	for _, d := range m.elasticsearchdomainstatuss {
+		infos = append(infos, &elasticsearchservice.DomainInfo{DomainName: d.DomainName})
+	}
+	return &elasticsearchservice.ListDomainNamesOutput{DomainNames: infos}, nil
+}
+
+func (m *mockElasticsearchservice) DescribeElasticsearchDomains(input *elasticsearchservice.DescribeElasticsearchDomainsInput) (*elasticsearchservice.DescribeElasticsearchDomainsOutput, error) {
+	if len(input.DomainNames) > 5 {
+		return nil, fmt.Errorf("cannot describe more than 5 domains, got %d", len(input.DomainNames))
+	}
+	var list []*elasticsearchservice.ElasticsearchDomainStatus
+	for _, name := range input.DomainNames {
+		for _, d := range m.elasticsearchdomainstatuss {
+			if awssdk.StringValue(d.DomainName) == awssdk.StringValue(name) {
+				list = append(list, d)
+			}
+		}
+	}
+	return &elasticsearchservice.DescribeElasticsearchDomainsOutput{DomainStatusList: list}, nil
+}
@@ -0,0 +1,61 @@
+package awsservices
+
+import (
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+// rateLimiter spaces out events so that at most a given number of them happen per second
+type rateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+	now      func() time.Time
+	sleep    func(time.Duration)
+}
+
+func newRateLimiter(perSecond float64) *rateLimiter {
+	return &rateLimiter{
+		interval: time.Duration(float64(time.Second) / perSecond),
+		now:      time.Now,
+		sleep:    time.Sleep,
+	}
+}
+
+func (l *rateLimiter) wait() {
+	l.mu.Lock()
+	now := l.now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	delay := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+	l.mu.Unlock()
+
+	l.sleep(delay)
+}
+
+// withRateLimits caps the API calls (including retries) per second made with the session,
+// given limits keyed by AWS API (ec2, iam, etc.)
+func withRateLimits(sess *session.Session, limits map[string]float64) *session.Session {
+	if len(limits) == 0 {
+		return sess
+	}
+	limiters := make(map[string]*rateLimiter)
+	for service, perSecond := range limits {
+		limiters[service] = newRateLimiter(perSecond)
+	}
+	return withRateLimiters(sess, limiters)
+}
+
+func withRateLimiters(sess *session.Session, limiters map[string]*rateLimiter) *session.Session {
+	sess.Handlers.Send.PushFront(func(r *request.Request) {
+		if l, ok := limiters[r.ClientInfo.ServiceName]; ok {
+			l.wait()
+		}
+	})
+	return sess
+}
@@ -137,26 +137,30 @@ var addParentsFns = map[string][]addParentFn{
 	cloud.Subscription: {
 		funcBuilder{parent: cloud.Topic, fieldName: "TopicArn"}.build(),
 	},
-	cloud.Vpc:              {addRegionParent},
-	cloud.AvailabilityZone: {addRegionParent},
-	cloud.Keypair:          {addRegionParent},
-	cloud.Image:            {addRegionParent},
-	cloud.Repository:       {addRegionParent},
-	cloud.ContainerCluster: {addRegionParent},
-	cloud.ContainerTask:    {addRegionParent},
-	cloud.Certificate:      {addRegionParent},
-	cloud.User:             {userAddGroupsRelations, addManagedPoliciesRelations},
-	cloud.Role:             {addManagedPoliciesRelations},
-	cloud.Group:            {addManagedPoliciesRelations},
-	cloud.Bucket:           {addRegionParent},
-	cloud.Function:         {addRegionParent},
-	cloud.Topic:            {addRegionParent},
-	cloud.Alarm:            {addRegionParent, addAlarmMetric},
-	cloud.Metric:           {addRegionParent},
-	cloud.Stack:            {addRegionParent},
+	cloud.Vpc:                 {addRegionParent},
+	cloud.AvailabilityZone:    {addRegionParent},
+	cloud.Keypair:             {addRegionParent},
+	cloud.Image:               {addRegionParent},
+	cloud.Repository:          {addRegionParent},
+	cloud.ContainerCluster:    {addRegionParent},
+	cloud.ContainerTask:       {addRegionParent},
+	cloud.Certificate:         {addRegionParent},
+	cloud.ElasticsearchDomain: {addRegionParent},
+	cloud.User:                {userAddGroupsRelations, addManagedPoliciesRelations},
+	cloud.Role:                {addManagedPoliciesRelations},
+	cloud.Group:               {addManagedPoliciesRelations},
+	cloud.Bucket:              {addRegionParent},
+	cloud.Function:            {addRegionParent},
+	cloud.Topic:               {addRegionParent},
+	cloud.Alarm:               {addRegionParent, addAlarmMetric},
+	cloud.Metric:              {addRegionParent},
+	cloud.Stack:               {addRegionParent},
 	cloud.MFADevice: {
 		funcBuilder{parent: cloud.User, fieldName: "User.UserId", relation: DEPENDING_ON}.build(),
 	},
+	cloud.InstanceProfile: {
+		funcBuilder{parent: cloud.Role, fieldName: "RoleId", listName: "Roles", relation: DEPENDING_ON}.build(),
+	},
 }
 
 func (fb funcBuilder) build() addParentFn {
@@ -356,7 +360,7 @@ func addManagedPoliciesRelations(g *graph.Graph, snap tstore.RDFGraph, region st
 		}
 		if len(policies) != 1 {
 			fmt.Fprintf(os.Stderr, "add parent to '%s/%s': unknown policy named '%s'. Ignoring it.\n", res.Type(), res.Id(), awssdk.StringValue(policy.PolicyName))
-			return nil
+			continue
 		}
 		g.AddAppliesOnRelation(policies[0], res)
 	}
@@ -401,7 +405,7 @@ func fetchTargetsAndAddRelations(g *graph.Graph, snap tstore.RDFGraph, region st
 		return err
 	}
 
-	targets, err := InfraService.(*Infra).DescribeTargetHealth(&elbv2.DescribeTargetHealthInput{TargetGroupArn: group.TargetGroupArn})
+	targets, err := Resolve(InfraService).(*Infra).DescribeTargetHealth(&elbv2.DescribeTargetHealthInput{TargetGroupArn: group.TargetGroupArn})
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,97 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsservices
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/endpointcreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/ec2metadata"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+const (
+	ecsRoleEnvironment = "ECS task role"
+	ec2RoleEnvironment = "EC2 instance profile"
+)
+
+var (
+	getenvFn   = os.Getenv
+	readFileFn = ioutil.ReadFile
+	// ec2 hypervisor and DMI hints, cheap to read before querying the instance metadata
+	ec2HintFiles = []string{"/sys/hypervisor/uuid", "/sys/devices/virtual/dmi/id/product_uuid", "/sys/devices/virtual/dmi/id/board_vendor"}
+)
+
+// detectRoleEnvironment returns the kind of role credentials available when running on AWS
+// (i.e. in an ECS task or on an EC2 instance), or an empty string
+func detectRoleEnvironment(sess *session.Session) string {
+	if getenvFn("AWS_CONTAINER_CREDENTIALS_RELATIVE_URI") != "" || getenvFn("AWS_CONTAINER_CREDENTIALS_FULL_URI") != "" {
+		return ecsRoleEnvironment
+	}
+	if looksLikeEC2() && ec2metadata.New(sess).Available() {
+		return ec2RoleEnvironment
+	}
+	return ""
+}
+
+func looksLikeEC2() bool {
+	for _, path := range ec2HintFiles {
+		content, err := readFileFn(path)
+		if err != nil {
+			continue
+		}
+		hint := strings.ToLower(strings.TrimSpace(string(content)))
+		if strings.HasPrefix(hint, "ec2") || hint == "amazon ec2" {
+			return true
+		}
+	}
+	return false
+}
+
+// preferRoleCredentials returns true when the role credentials should come before the ones of the profile:
+// no credentials explicitly given in the environment and no explicit profile other than the default one
+func preferRoleCredentials(profile string) bool {
+	if getenvFn("AWS_ACCESS_KEY_ID") != "" || getenvFn("AWS_ACCESS_KEY") != "" {
+		return false
+	}
+	return profile == "" || profile == "default"
+}
+
+// CredentialsSource returns a human readable origin of resolved credentials
+func CredentialsSource(creds credentials.Value) string {
+	switch name := creds.ProviderName; {
+	case name == ec2rolecreds.ProviderName:
+		return ec2RoleEnvironment
+	case name == endpointcreds.ProviderName:
+		return ecsRoleEnvironment
+	case name == stscreds.ProviderName:
+		return "assumed role"
+	case name == credentials.EnvProviderName, name == session.EnvProviderName:
+		return "environment"
+	case strings.HasPrefix(name, "SharedConfigCredentials"), name == credentials.SharedCredsProviderName:
+		return "shared credentials file"
+	case name == "":
+		return "unknown"
+	default:
+		return name
+	}
+}
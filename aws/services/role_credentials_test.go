@@ -0,0 +1,81 @@
+package awsservices
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/endpointcreds"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+func TestRoleCredentials(t *testing.T) {
+	defer func(getenv func(string) string, readFile func(string) ([]byte, error)) {
+		getenvFn, readFileFn = getenv, readFile
+	}(getenvFn, readFileFn)
+	env := make(map[string]string)
+	getenvFn = func(k string) string { return env[k] }
+	files := make(map[string]string)
+	readFileFn = func(path string) ([]byte, error) {
+		if content, ok := files[path]; ok {
+			return []byte(content), nil
+		}
+		return nil, errors.New("not found")
+	}
+
+	t.Run("ecs detection", func(t *testing.T) {
+		if got := detectRoleEnvironment(nil); got != "" {
+			t.Fatalf("got %q, want none", got)
+		}
+		env["AWS_CONTAINER_CREDENTIALS_RELATIVE_URI"] = "/v2/credentials/1234"
+		defer delete(env, "AWS_CONTAINER_CREDENTIALS_RELATIVE_URI")
+		if got, want := detectRoleEnvironment(nil), ecsRoleEnvironment; got != want {
+			t.Fatalf("got %q, want %q", got, want)
+		}
+	})
+
+	t.Run("ec2 hints", func(t *testing.T) {
+		if looksLikeEC2() {
+			t.Fatal("expected not to look like EC2")
+		}
+		files["/sys/hypervisor/uuid"] = "ec2e1916-9099-7caf-fd21-012345abcdef\n"
+		if !looksLikeEC2() {
+			t.Fatal("expected to look like EC2 from hypervisor uuid")
+		}
+		delete(files, "/sys/hypervisor/uuid")
+		files["/sys/devices/virtual/dmi/id/board_vendor"] = "Amazon EC2\n"
+		if !looksLikeEC2() {
+			t.Fatal("expected to look like EC2 from board vendor")
+		}
+	})
+
+	t.Run("preference", func(t *testing.T) {
+		if !preferRoleCredentials("default") || !preferRoleCredentials("") {
+			t.Fatal("expected to prefer role credentials with default profile")
+		}
+		if preferRoleCredentials("prod") {
+			t.Fatal("expected not to prefer role credentials with explicit profile")
+		}
+		env["AWS_ACCESS_KEY_ID"] = "AKIA"
+		defer delete(env, "AWS_ACCESS_KEY_ID")
+		if preferRoleCredentials("default") {
+			t.Fatal("expected not to prefer role credentials with credentials in env")
+		}
+	})
+
+	t.Run("credentials source", func(t *testing.T) {
+		tcases := map[string]string{
+			ec2rolecreds.ProviderName:                              ec2RoleEnvironment,
+			endpointcreds.ProviderName:                             ecsRoleEnvironment,
+			session.EnvProviderName:                                "environment",
+			"SharedConfigCredentials: /home/john/.aws/credentials": "shared credentials file",
+			"": "unknown",
+		}
+		for name, want := range tcases {
+			if got := CredentialsSource(credentials.Value{ProviderName: name}); got != want {
+				t.Fatalf("%q: got %q, want %q", name, got, want)
+			}
+		}
+	})
+}
@@ -35,12 +35,14 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/elasticsearchservice"
 	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/ses"
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/wallix/awless/aws/fetch"
 	"github.com/wallix/awless/cloud"
@@ -71,7 +73,7 @@ func TestBuildAccessRdfGraph(t *testing.T) {
 	}
 
 	roles := []*iam.RoleDetail{
-		{RoleId: awssdk.String("role_1"), RolePolicyList: []*iam.PolicyDetail{{PolicyName: awssdk.String("npolicy_1")}}, AttachedManagedPolicies: []*iam.AttachedPolicy{{PolicyName: awssdk.String("nmanaged_policy_1")}}, AssumeRolePolicyDocument: awssdk.String(url.QueryEscape(assumeRoleDoc))},
+		{RoleId: awssdk.String("role_1"), RolePolicyList: []*iam.PolicyDetail{{PolicyName: awssdk.String("npolicy_1")}}, AttachedManagedPolicies: []*iam.AttachedPolicy{{PolicyName: awssdk.String("nmanaged_policy_1")}}, AssumeRolePolicyDocument: awssdk.String(url.QueryEscape(assumeRoleDoc)), InstanceProfileList: []*iam.InstanceProfile{{InstanceProfileId: awssdk.String("profile_1")}}},
 		{RoleId: awssdk.String("role_2"), RolePolicyList: []*iam.PolicyDetail{{PolicyName: awssdk.String("npolicy_1")}}},
 		{RoleId: awssdk.String("role_3"), RolePolicyList: []*iam.PolicyDetail{{PolicyName: awssdk.String("npolicy_2")}}, AttachedManagedPolicies: []*iam.AttachedPolicy{{PolicyName: awssdk.String("nmanaged_policy_2")}}},
 		{RoleId: awssdk.String("role_4"), RolePolicyList: []*iam.PolicyDetail{{PolicyName: awssdk.String("npolicy_4")}}},
@@ -176,7 +178,11 @@ func TestBuildAccessRdfGraph(t *testing.T) {
 		{SerialNumber: awssdk.String("mfa-device-2")},
 	}
 
-	mock := &mockIam{groupdetails: groups, userdetails: usersDetails, roledetails: roles, managedpolicydetails: managedPolicies, users: users, virtualmfadevices: mfaDevices}
+	instanceProfiles := []*iam.InstanceProfile{
+		{InstanceProfileId: awssdk.String("profile_1"), InstanceProfileName: awssdk.String("nprofile_1"), Roles: []*iam.Role{{RoleId: awssdk.String("role_1")}}},
+	}
+
+	mock := &mockIam{groupdetails: groups, userdetails: usersDetails, roledetails: roles, managedpolicydetails: managedPolicies, users: users, virtualmfadevices: mfaDevices, instanceprofiles: instanceProfiles}
 	access := Access{
 		IAMAPI:  mock,
 		region:  "eu-west-1",
@@ -189,7 +195,7 @@ func TestBuildAccessRdfGraph(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	resources, err := g.Find(cloud.NewQuery("policy", "group", "role", "user", cloud.MFADevice))
+	resources, err := g.Find(cloud.NewQuery("policy", "group", "role", "user", cloud.MFADevice, cloud.InstanceProfile))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -202,14 +208,14 @@ func TestBuildAccessRdfGraph(t *testing.T) {
 	}
 
 	expected := map[string]cloud.Resource{
-		"managed_policy_1": resourcetest.Policy("managed_policy_1").Prop(p.Name, "nmanaged_policy_1").Prop(p.Type, "Customer Managed").Prop(p.Attached, true).Build(),
-		"managed_policy_2": resourcetest.Policy("managed_policy_2").Prop(p.Name, "nmanaged_policy_2").Prop(p.Type, "Customer Managed").Prop(p.Attached, false).Prop(p.Document, policyDoc).Build(),
-		"managed_policy_3": resourcetest.Policy("managed_policy_3").Prop(p.Name, "nmanaged_policy_3").Prop(p.Arn, "arn:aws:iam::aws:policy/managed_policy_3").Prop(p.Type, "AWS Managed").Prop(p.Attached, true).Build(),
+		"managed_policy_1": resourcetest.Policy("managed_policy_1").Prop(p.Name, "nmanaged_policy_1").Prop(p.Type, "Customer Managed").Prop(p.Attached, true).Prop(p.AttachmentCount, 3).Build(),
+		"managed_policy_2": resourcetest.Policy("managed_policy_2").Prop(p.Name, "nmanaged_policy_2").Prop(p.Type, "Customer Managed").Prop(p.Attached, false).Prop(p.AttachmentCount, 0).Prop(p.Document, policyDoc).Build(),
+		"managed_policy_3": resourcetest.Policy("managed_policy_3").Prop(p.Name, "nmanaged_policy_3").Prop(p.Arn, "arn:aws:iam::aws:policy/managed_policy_3").Prop(p.Type, "AWS Managed").Prop(p.Attached, true).Prop(p.AttachmentCount, 1).Build(),
 		"group_1":          resourcetest.Group("group_1").Prop(p.Name, "ngroup_1").Prop(p.InlinePolicies, []string{"npolicy_1"}).Build(),
 		"group_2":          resourcetest.Group("group_2").Prop(p.Name, "ngroup_2").Prop(p.InlinePolicies, []string{"npolicy_1"}).Build(),
 		"group_3":          resourcetest.Group("group_3").Prop(p.Name, "ngroup_3").Prop(p.InlinePolicies, []string{"npolicy_2"}).Build(),
 		"group_4":          resourcetest.Group("group_4").Prop(p.Name, "ngroup_4").Prop(p.InlinePolicies, []string{"npolicy_4"}).Build(),
-		"role_1":           resourcetest.Role("role_1").Prop(p.InlinePolicies, []string{"npolicy_1"}).Prop(p.TrustPolicy, assumeRoleDoc).Build(),
+		"role_1":           resourcetest.Role("role_1").Prop(p.InlinePolicies, []string{"npolicy_1"}).Prop(p.TrustPolicy, assumeRoleDoc).Prop(p.InstanceProfiles, []string{"profile_1"}).Build(),
 		"role_2":           resourcetest.Role("role_2").Prop(p.InlinePolicies, []string{"npolicy_1"}).Build(),
 		"role_3":           resourcetest.Role("role_3").Prop(p.InlinePolicies, []string{"npolicy_2"}).Build(),
 		"role_4":           resourcetest.Role("role_4").Prop(p.InlinePolicies, []string{"npolicy_4"}).Build(),
@@ -226,6 +232,7 @@ func TestBuildAccessRdfGraph(t *testing.T) {
 		"usr_11":           resourcetest.User("usr_11").Build(),
 		"mfa-device-1":     resourcetest.MfaDevice("mfa-device-1").Prop(p.AttachedAt, now).Build(),
 		"mfa-device-2":     resourcetest.MfaDevice("mfa-device-2").Build(),
+		"profile_1":        resourcetest.InstanceProfile("profile_1").Prop(p.Name, "nprofile_1").Prop(p.Roles, []string{"role_1"}).Build(),
 	}
 
 	expectedChildren := map[string][]string{}
@@ -238,6 +245,7 @@ func TestBuildAccessRdfGraph(t *testing.T) {
 		"managed_policy_2": {"group_2", "role_3", "usr_3"},
 		"managed_policy_3": {"group_3", "usr_6"},
 		"mfa-device-1":     {"usr_1"},
+		"profile_1":        {"role_1"},
 	}
 
 	compareResources(t, g, resources, expected, expectedChildren, expectedAppliedOn)
@@ -549,6 +557,37 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 		},
 	}
 
+	servicesNames := map[string][]*string{
+		"clust_1": {awssdk.String("svc_1")},
+		"clust_2": {awssdk.String("svc_2")},
+	}
+	services := map[string][]*ecs.Service{
+		"clust_1": {
+			{
+				ServiceArn:     awssdk.String("svc_1"),
+				ServiceName:    awssdk.String("container-service-1"),
+				ClusterArn:     awssdk.String("clust_1"),
+				TaskDefinition: awssdk.String("cs_2:1"),
+				DesiredCount:   awssdk.Int64(3),
+				RunningCount:   awssdk.Int64(2),
+				PendingCount:   awssdk.Int64(1),
+				LaunchType:     awssdk.String("EC2"),
+				RoleArn:        awssdk.String("role:arn"),
+				CreatedAt:      awssdk.Time(now.Add(-3 * time.Hour)),
+				Status:         awssdk.String("ACTIVE"),
+			},
+		},
+		"clust_2": {
+			{
+				ServiceArn:     awssdk.String("svc_2"),
+				ServiceName:    awssdk.String("container-service-2"),
+				TaskDefinition: awssdk.String("cs_2:2"),
+				DesiredCount:   awssdk.Int64(0),
+				Status:         awssdk.String("DRAINING"),
+			},
+		},
+	}
+
 	//ACM
 	certificates := []*acm.CertificateSummary{
 		{CertificateArn: awssdk.String("arn:certif_1234"), DomainName: awssdk.String("domain-name.1")},
@@ -556,31 +595,51 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 		{CertificateArn: awssdk.String("arn:certif_3456"), DomainName: awssdk.String("domain-name.3")},
 	}
 
+	//ElasticSearch
+	domains := []*elasticsearchservice.ElasticsearchDomainStatus{
+		{
+			DomainName:                 awssdk.String("logs-domain"),
+			ARN:                        awssdk.String("arn:aws:es:eu-west-1:123456789012:domain/logs-domain"),
+			Endpoint:                   awssdk.String("search-logs-domain-abcdef.eu-west-1.es.amazonaws.com"),
+			ElasticsearchVersion:       awssdk.String("5.5"),
+			Processing:                 awssdk.Bool(false),
+			ElasticsearchClusterConfig: &elasticsearchservice.ElasticsearchClusterConfig{InstanceType: awssdk.String("t2.small.elasticsearch"), InstanceCount: awssdk.Int64(2)},
+			EBSOptions:                 &elasticsearchservice.EBSOptions{EBSEnabled: awssdk.Bool(true), VolumeSize: awssdk.Int64(20), VolumeType: awssdk.String("gp2")},
+		},
+		{
+			DomainName: awssdk.String("search-domain"),
+			ARN:        awssdk.String("arn:aws:es:eu-west-1:123456789012:domain/search-domain"),
+			Processing: awssdk.Bool(true),
+		},
+	}
+
 	mock := &mockEc2{vpcs: vpcs, securitygroups: securityGroups, subnets: subnets, instances: instances, keypairinfos: keypairs, internetgateways: igws, routetables: routeTables, images: images, availabilityzones: availabilityZones, natgateways: natgws, networkinterfaces: networkInterfaces}
 	mockLb := &mockElbv2{loadbalancers: lbPages, targetgroups: targetGroups, listeners: listeners, targethealthdescriptions: targetHealths}
 	mockClassicLb := &mockElb{loadbalancerdescriptions: classicLbPages}
 	mockEcr := &mockEcr{repositorys: repositories}
-	mockEcs := &mockEcs{clusterNames: clusterNames, clusters: clusters, taskdefinitionNames: defNames, taskdefinitions: tasksDef, tasksNames: tasksNames, tasks: tasks, containerinstancesNames: containerInstancesNames, containerinstances: containerInstances}
+	mockEcs := &mockEcs{clusterNames: clusterNames, clusters: clusters, taskdefinitionNames: defNames, taskdefinitions: tasksDef, tasksNames: tasksNames, tasks: tasks, containerinstancesNames: containerInstancesNames, containerinstances: containerInstances, servicesNames: servicesNames, services: services}
 	mockRds := &mockRds{}
 	mockAcm := &mockAcm{certificatesummarys: certificates}
+	mockEs := &mockElasticsearchservice{elasticsearchdomainstatuss: domains}
 	mockAutoscaling := &mockAutoscaling{launchconfigurations: launchConfigs, groups: scalingGroups}
 	InfraService = &Infra{
-		EC2API:         mock,
-		ECRAPI:         mockEcr,
-		ECSAPI:         mockEcs,
-		ELBAPI:         mockClassicLb,
-		ELBV2API:       mockLb,
-		RDSAPI:         mockRds,
-		ACMAPI:         mockAcm,
-		AutoScalingAPI: mockAutoscaling,
-		region:         "eu-west-1",
-		fetcher:        fetch.NewFetcher(awsfetch.BuildInfraFetchFuncs(awsfetch.NewConfig(mock, mockEcr, mockEcs, mockClassicLb, mockLb, mockRds, mockAutoscaling, mockAcm))),
+		EC2API:                  mock,
+		ECRAPI:                  mockEcr,
+		ECSAPI:                  mockEcs,
+		ELBAPI:                  mockClassicLb,
+		ELBV2API:                mockLb,
+		RDSAPI:                  mockRds,
+		ACMAPI:                  mockAcm,
+		AutoScalingAPI:          mockAutoscaling,
+		ElasticsearchServiceAPI: mockEs,
+		region:                  "eu-west-1",
+		fetcher:                 fetch.NewFetcher(awsfetch.BuildInfraFetchFuncs(awsfetch.NewConfig(mock, mockEcr, mockEcs, mockClassicLb, mockLb, mockRds, mockAutoscaling, mockAcm, mockEs))),
 	}
 	g, err := InfraService.Fetch(context.Background())
 	if err != nil {
 		t.Fatal(err)
 	}
-	resources, err := g.Find(cloud.NewQuery("region", "instance", "vpc", "securitygroup", "subnet", "keypair", "internetgateway", cloud.NatGateway, "routetable", "classicloadbalancer", "loadbalancer", "targetgroup", "listener", "launchconfiguration", "scalinggroup", "image", "availabilityzone", "repository", cloud.ContainerCluster, cloud.ContainerTask, cloud.Container, cloud.ContainerInstance, cloud.NetworkInterface, cloud.Certificate))
+	resources, err := g.Find(cloud.NewQuery("region", "instance", "vpc", "securitygroup", "subnet", "keypair", "internetgateway", cloud.NatGateway, "routetable", "classicloadbalancer", "loadbalancer", "targetgroup", "listener", "launchconfiguration", "scalinggroup", "image", "availabilityzone", "repository", cloud.ContainerCluster, cloud.ContainerService, cloud.ContainerTask, cloud.Container, cloud.ContainerInstance, cloud.NetworkInterface, cloud.Certificate, cloud.ElasticsearchDomain))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -680,6 +739,9 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 		"clust_1":          resourcetest.ContainerCluster("clust_1").Prop(p.Arn, "clust_1").Prop(p.Name, "my_cust_1").Prop(p.PendingTasksCount, 1).Prop(p.ActiveServicesCount, 3).Prop(p.RegisteredContainerInstancesCount, 3).Prop(p.RunningTasksCount, 2).Prop(p.State, "ACTIVE").Build(),
 		"clust_2":          resourcetest.ContainerCluster("clust_2").Prop(p.Arn, "clust_2").Build(),
 		"clust_3":          resourcetest.ContainerCluster("clust_3").Prop(p.Arn, "clust_3").Prop(p.Name, "my_cust_3").Build(),
+		"svc_1": resourcetest.ContainerService("svc_1").Prop(p.Arn, "svc_1").Prop(p.Name, "container-service-1").Prop(p.Cluster, "clust_1").Prop(p.ContainerTask, "cs_2:1").Prop(p.DesiredTasksCount, 3).
+			Prop(p.RunningTasksCount, 2).Prop(p.PendingTasksCount, 1).Prop(p.Type, "EC2").Prop(p.Role, "role:arn").Prop(p.Created, now.Add(-3*time.Hour)).Prop(p.State, "ACTIVE").Build(),
+		"svc_2": resourcetest.ContainerService("svc_2").Prop(p.Arn, "svc_2").Prop(p.Name, "container-service-2").Prop(p.Cluster, "clust_2").Prop(p.ContainerTask, "cs_2:2").Prop(p.DesiredTasksCount, 0).Prop(p.State, "DRAINING").Build(),
 		"cs_1:1": resourcetest.ContainerTask("cs_1:1").Prop(p.Arn, "cs_1:1").Prop(p.ContainersImages, []*graph.KeyValue{{"cont_name_1", "image_1"}, {"cont_name_2", "image_2"}, {"cont_name_3", "image_3"}}).Prop(p.Name, "cs_1").Prop(p.Version, "1").
 			Prop(p.State, "1 task running").Prop(p.Role, "role:arn").Prop(p.Deployments, []*graph.KeyValue{{"clust_2", "cs_1 (running task)"}}).Build(),
 		"cs_2:1": resourcetest.ContainerTask("cs_2:1").Prop(p.Arn, "cs_2:1").Prop(p.Name, "cs_2").Prop(p.State, "1 service running").Prop(p.Version, "1").Prop(p.Deployments, []*graph.KeyValue{{"clust_1", "container-service-1 (running service)"}}).Build(),
@@ -702,10 +764,13 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 		"arn:certif_1234": resourcetest.Certificate("arn:certif_1234").Prop(p.Arn, "arn:certif_1234").Prop(p.Name, "domain-name.1").Build(),
 		"arn:certif_2345": resourcetest.Certificate("arn:certif_2345").Prop(p.Arn, "arn:certif_2345").Prop(p.Name, "domain-name.2").Build(),
 		"arn:certif_3456": resourcetest.Certificate("arn:certif_3456").Prop(p.Arn, "arn:certif_3456").Prop(p.Name, "domain-name.3").Build(),
+		"logs-domain":     resourcetest.ElasticsearchDomain("logs-domain").Prop(p.Name, "logs-domain").Prop(p.Arn, "arn:aws:es:eu-west-1:123456789012:domain/logs-domain").
+			Prop(p.Endpoint, "search-logs-domain-abcdef.eu-west-1.es.amazonaws.com").Prop(p.Version, "5.5").Prop(p.State, "active").Prop(p.Type, "t2.small.elasticsearch").Prop(p.Storage, 20).Prop(p.StorageType, "gp2").Build(),
+		"search-domain":   resourcetest.ElasticsearchDomain("search-domain").Prop(p.Name, "search-domain").Prop(p.Arn, "arn:aws:es:eu-west-1:123456789012:domain/search-domain").Prop(p.State, "processing").Build(),
 	}
 
 	expectedChildren := map[string][]string{
-		"eu-west-1": {"arn:certif_1234", "arn:certif_2345", "arn:certif_3456", "asg_arn_1", "asg_arn_2", "clust_1", "clust_2", "clust_3", "cs_1:1", "cs_2:1", "cs_2:2", "cs_3:1", "igw_1", "img_1", "img_2", "launchconfig_arn", "my_key", "natgw_1", "repo_1", "repo_2", "repo_3", "us-west-1a", "us-west-1b", "vpc_1", "vpc_2"},
+		"eu-west-1": {"arn:certif_1234", "arn:certif_2345", "arn:certif_3456", "asg_arn_1", "asg_arn_2", "clust_1", "clust_2", "clust_3", "cs_1:1", "cs_2:1", "cs_2:2", "cs_3:1", "igw_1", "img_1", "img_2", "launchconfig_arn", "logs-domain", "my_key", "natgw_1", "repo_1", "repo_2", "repo_3", "search-domain", "us-west-1a", "us-west-1b", "vpc_1", "vpc_2"},
 		"lb_1":      {"list_1", "list_1.2"},
 		"lb_2":      {"list_2"},
 		"lb_3":      {"list_3"},
@@ -714,8 +779,8 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 		"sub_3":     {"eni-2", "inst_3", "inst_4", "inst_6"},
 		"vpc_1":     {"lb_1", "lb_3", "my_classic_loadbalancer_1", "my_classic_loadbalancer_3", "natgw_1", "rt_1", "securitygroup_1", "securitygroup_2", "sub_1", "sub_2", "tg_1"},
 		"vpc_2":     {"lb_2", "my_classic_loadbalancer_2", "sub_3", "tg_2"},
-		"clust_1":   {"cont_inst_1", "cont_inst_2", "container_1", "container_2", "container_3"},
-		"clust_2":   {"cont_inst_3", "container_4", "container_5"},
+		"clust_1":   {"cont_inst_1", "cont_inst_2", "container_1", "container_2", "container_3", "svc_1"},
+		"clust_2":   {"cont_inst_3", "container_4", "container_5", "svc_2"},
 	}
 
 	expectedAppliedOn := map[string][]string{
@@ -733,8 +798,8 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 		"asg_arn_1":       {"inst_1", "inst_3", "sub_1", "sub_2"},
 		"asg_arn_2":       {"tg_1", "tg_2"},
 		"cs_1:1":          {"container_5"},
-		"cs_2:1":          {"container_1", "container_2", "container_3"},
-		"cs_2:2":          {"container_4"},
+		"cs_2:1":          {"container_1", "container_2", "container_3", "svc_1"},
+		"cs_2:2":          {"container_4", "svc_2"},
 		"inst_1":          {"cont_inst_3"},
 		"inst_2":          {"cont_inst_1"},
 		"inst_3":          {"cont_inst_2"},
@@ -810,8 +875,8 @@ func TestBuildStorageRdfGraph(t *testing.T) {
 
 	expected := map[string]cloud.Resource{
 		"eu-west-1":   resourcetest.Region("eu-west-1").Build(),
-		"bucket_eu_1": resourcetest.Bucket("bucket_eu_1").Prop(p.Grants, []*graph.Grant{{Grantee: graph.Grantee{GranteeID: "usr_2"}, Permission: "Write"}}).Build(),
-		"bucket_eu_2": resourcetest.Bucket("bucket_eu_2").Prop(p.Grants, []*graph.Grant{{Grantee: graph.Grantee{GranteeID: "usr_1"}, Permission: "Write"}}).Build(),
+		"bucket_eu_1": resourcetest.Bucket("bucket_eu_1").Prop(p.Encrypted, false).Prop(p.Grants, []*graph.Grant{{Grantee: graph.Grantee{GranteeID: "usr_2"}, Permission: "Write"}}).Build(),
+		"bucket_eu_2": resourcetest.Bucket("bucket_eu_2").Prop(p.Encrypted, false).Prop(p.Grants, []*graph.Grant{{Grantee: graph.Grantee{GranteeID: "usr_1"}, Permission: "Write"}}).Build(),
 	}
 	expectedChildren := map[string][]string{
 		"eu-west-1":   {"bucket_eu_1", "bucket_eu_2"},
@@ -886,7 +951,7 @@ func TestBuildNotificationGraph(t *testing.T) {
 	topics := []*sns.Topic{
 		{TopicArn: awssdk.String("topic_arn_1")},
 		{TopicArn: awssdk.String("topic_arn_2")},
-		{TopicArn: awssdk.String("topic_arn_3")},
+		{TopicArn: awssdk.String("arn:aws:sns:eu-west-1:123456789012:topic_3")},
 	}
 
 	subscriptions := []*sns.Subscription{
@@ -910,10 +975,16 @@ func TestBuildNotificationGraph(t *testing.T) {
 
 	sqs := &mockSqs{strings: queues, attributes: attributes}
 	sns := &mockSns{subscriptions: subscriptions, topics: topics}
+	identities := []*string{awssdk.String("example.com"), awssdk.String("john@example.com"), awssdk.String("other.org")}
+	verifications := map[string]*ses.IdentityVerificationAttributes{
+		"example.com":      {VerificationStatus: awssdk.String("Success"), VerificationToken: awssdk.String("token_1")},
+		"john@example.com": {VerificationStatus: awssdk.String("Pending")},
+	}
+	ses := &mockSes{strings: identities, attributes: verifications}
 
 	service := Messaging{
-		SNSAPI: sns, SQSAPI: sqs, region: "eu-west-1",
-		fetcher: fetch.NewFetcher(awsfetch.BuildMessagingFetchFuncs(awsfetch.NewConfig(sqs, sns))),
+		SNSAPI: sns, SQSAPI: sqs, SESAPI: ses, region: "eu-west-1",
+		fetcher: fetch.NewFetcher(awsfetch.BuildMessagingFetchFuncs(awsfetch.NewConfig(sqs, sns, ses))),
 	}
 
 	g, err := service.Fetch(context.Background())
@@ -930,12 +1001,12 @@ func TestBuildNotificationGraph(t *testing.T) {
 		"endpoint_1":  resourcetest.Subscription("endpoint_1").Prop(p.Endpoint, "endpoint_1").Build(),
 		"endpoint_2":  resourcetest.Subscription("endpoint_2").Prop(p.Endpoint, "endpoint_2").Prop(p.Owner, "subscr_owner").Prop(p.Protocol, "subscr_prot").Prop(p.Arn, "subscr_arn").Prop(p.Topic, "topic_arn_2").Build(),
 		"endpoint_3":  resourcetest.Subscription("endpoint_3").Prop(p.Endpoint, "endpoint_3").Prop(p.Topic, "topic_arn_2").Build(),
-		"topic_arn_1": resourcetest.Topic("topic_arn_1").Prop(p.Arn, "topic_arn_1").Build(),
-		"topic_arn_2": resourcetest.Topic("topic_arn_2").Prop(p.Arn, "topic_arn_2").Build(),
-		"topic_arn_3": resourcetest.Topic("topic_arn_3").Prop(p.Arn, "topic_arn_3").Build(),
+		"topic_arn_1": resourcetest.Topic("topic_arn_1").Prop(p.Arn, "topic_arn_1").Prop(p.Name, "topic_arn_1").Build(),
+		"topic_arn_2": resourcetest.Topic("topic_arn_2").Prop(p.Arn, "topic_arn_2").Prop(p.Name, "topic_arn_2").Build(),
+		"arn:aws:sns:eu-west-1:123456789012:topic_3": resourcetest.Topic("arn:aws:sns:eu-west-1:123456789012:topic_3").Prop(p.Arn, "arn:aws:sns:eu-west-1:123456789012:topic_3").Prop(p.Name, "topic_3").Build(),
 	}
 	expectedChildren := map[string][]string{
-		"eu-west-1":   {"topic_arn_1", "topic_arn_2", "topic_arn_3"},
+		"eu-west-1":   {"arn:aws:sns:eu-west-1:123456789012:topic_3", "topic_arn_1", "topic_arn_2"},
 		"topic_arn_2": {"endpoint_2", "endpoint_3"},
 	}
 	expectedAppliedOn := map[string][]string{}
@@ -957,6 +1028,19 @@ func TestBuildNotificationGraph(t *testing.T) {
 
 	compareResources(t, g, resources, expected, expectedChildren, expectedAppliedOn)
 
+	resources, err = g.Find(cloud.NewQuery("emailidentity"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected = map[string]cloud.Resource{
+		"example.com":      resourcetest.EmailIdentity("example.com").Prop(p.Name, "example.com").Prop(p.Type, "domain").Prop(p.State, "success").Build(),
+		"john@example.com": resourcetest.EmailIdentity("john@example.com").Prop(p.Name, "john@example.com").Prop(p.Type, "email").Prop(p.State, "pending").Build(),
+		"other.org":        resourcetest.EmailIdentity("other.org").Prop(p.Name, "other.org").Prop(p.Type, "domain").Build(),
+	}
+
+	compareResources(t, g, resources, expected, expectedChildren, expectedAppliedOn)
+
 }
 
 func TestBuildLambdaGraph(t *testing.T) {
@@ -1335,17 +1419,18 @@ func TestBuildEmptyRdfGraphWhenNoData(t *testing.T) {
 	compareResources(t, g, resources, expected, expectedChildren, expectedAppliedOn)
 
 	infra := Infra{
-		EC2API:         &mockEc2{},
-		ELBAPI:         &mockElb{},
-		ELBV2API:       &mockElbv2{},
-		RDSAPI:         &mockRds{},
-		AutoScalingAPI: &mockAutoscaling{},
-		ECRAPI:         &mockEcr{},
-		ECSAPI:         &mockEcs{},
-		ACMAPI:         &mockAcm{},
-		region:         "eu-west-1",
+		EC2API:                  &mockEc2{},
+		ELBAPI:                  &mockElb{},
+		ELBV2API:                &mockElbv2{},
+		RDSAPI:                  &mockRds{},
+		AutoScalingAPI:          &mockAutoscaling{},
+		ECRAPI:                  &mockEcr{},
+		ECSAPI:                  &mockEcs{},
+		ACMAPI:                  &mockAcm{},
+		ElasticsearchServiceAPI: &mockElasticsearchservice{},
+		region:                  "eu-west-1",
 		fetcher: fetch.NewFetcher(awsfetch.BuildInfraFetchFuncs(awsfetch.NewConfig(
-			&mockEc2{}, &mockElb{}, &mockElbv2{}, &mockRds{}, &mockEcr{}, &mockEcs{}, &mockAutoscaling{}, &mockAcm{},
+			&mockEc2{}, &mockElb{}, &mockElbv2{}, &mockRds{}, &mockEcr{}, &mockEcs{}, &mockAutoscaling{}, &mockAcm{}, &mockElasticsearchservice{},
 		))),
 	}
 
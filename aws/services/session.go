@@ -26,11 +26,13 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/defaults"
 	"github.com/aws/aws-sdk-go/aws/ec2metadata"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/wallix/awless/aws/config"
 	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/prompt"
 )
 
 func ResolveRegionFromEnv() (region string) {
@@ -67,6 +69,7 @@ type sessionResolver struct {
 	enableRequestsFullLogging            bool
 	enableNetworkMonitorRequestsHandlers bool
 	enableCredentialResolvers            bool
+	enableRoleCredentials                bool
 }
 
 func newSessionResolver() *sessionResolver {
@@ -93,6 +96,12 @@ func (s *sessionResolver) withCredentialResolvers() *sessionResolver {
 	return s
 }
 
+// withRoleCredentials prefers the credentials of the ECS task role or EC2 instance profile when running on AWS
+func (s *sessionResolver) withRoleCredentials(enable bool) *sessionResolver {
+	s.enableRoleCredentials = enable
+	return s
+}
+
 func (s *sessionResolver) withProfileSetter(f func(val string) error) *sessionResolver {
 	s.profileSetterCallback = f
 	return s
@@ -108,6 +117,14 @@ func (s *sessionResolver) withNetworkMonitor(enableNetworkMonitor bool) *session
 	return s
 }
 
+// StdinTokenProvider prompts on stdin for the MFA token code of the role to assume
+func StdinTokenProvider() (string, error) {
+	if err := prompt.Check(prompt.MFAToken, "assuming a role with MFA requires a token code: use temporary credentials instead"); err != nil {
+		return "", err
+	}
+	return stscreds.StdinTokenProvider()
+}
+
 func (s *sessionResolver) resolve() (*session.Session, error) {
 	session, err := session.NewSessionWithOptions(session.Options{
 		Config: awssdk.Config{
@@ -116,7 +133,7 @@ func (s *sessionResolver) resolve() (*session.Session, error) {
 			CredentialsChainVerboseErrors: awssdk.Bool(true),
 		},
 		SharedConfigState:       session.SharedConfigEnable,
-		AssumeRoleTokenProvider: stscreds.StdinTokenProvider,
+		AssumeRoleTokenProvider: StdinTokenProvider,
 		Profile:                 s.profile,
 	})
 	if err != nil {
@@ -143,21 +160,29 @@ func (s *sessionResolver) resolve() (*session.Session, error) {
 	}
 
 	if s.enableCredentialResolvers {
+		var providers []credentials.Provider
+		if s.enableRoleCredentials && preferRoleCredentials(s.profile) {
+			if env := detectRoleEnvironment(session); env != "" {
+				s.logger.ExtraVerbosef("running with an %s: preferring its credentials", env)
+				providers = append(providers, defaults.RemoteCredProvider(*session.Config, session.Handlers))
+			}
+		}
+		providers = append(providers,
+			&fileCacheProvider{
+				creds:   session.Config.Credentials,
+				profile: s.profile,
+				log:     s.logger,
+			},
+			&credentialsPrompterProvider{
+				profile:               s.profile,
+				out:                   os.Stderr,
+				profileSetterCallback: s.profileSetterCallback,
+			},
+		)
 		session.Config.Credentials = credentials.NewCredentials(
 			&credentials.ChainProvider{
 				VerboseErrors: true,
-				Providers: []credentials.Provider{
-					&fileCacheProvider{
-						creds:   session.Config.Credentials,
-						profile: s.profile,
-						log:     s.logger,
-					},
-					&credentialsPrompterProvider{
-						profile: s.profile,
-						out:     os.Stderr,
-						profileSetterCallback: s.profileSetterCallback,
-					},
-				},
+				Providers:     providers,
 			})
 
 		if _, err = session.Config.Credentials.Get(); err != nil {
@@ -35,15 +35,16 @@ import (
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/cloud/properties"
 	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/prompt"
 )
 
 type CreateAccesskey struct {
-	_      string `action:"create" entity:"accesskey" awsAPI:"iam" awsCall:"CreateAccessKey" awsInput:"iam.CreateAccessKeyInput" awsOutput:"iam.CreateAccessKeyOutput"`
+	_      string `action:"create" entity:"accesskey" awsAPI:"iam" awsCall:"CreateAccessKey" awsInput:"iam.CreateAccessKeyInput" awsOutput:"iam.CreateAccessKeyOutput" awsOutputExtract:"AccessKey.AccessKeyId"`
 	logger *logger.Logger
 	graph  cloud.GraphAPI
 	api    iamiface.IAMAPI
-	User   *string `awsName:"UserName" awsType:"awsstr" templateName:"user"`
-	Save   *bool   `templateName:"save"`
+	User   *string `awsName:"UserName" awsType:"awsstr" templateName:"user" ref:"user.Name"`
+	Save   *bool   `templateName:"save" enum:"true,false"`
 }
 
 func (cmd *CreateAccesskey) ParamsSpec() params.Spec {
@@ -87,6 +88,9 @@ func (cmd *CreateAccesskey) AfterRun(renv env.Running, output interface{}) error
 	}
 	profile := StringValue(cmd.User)
 	if !BoolValue(cmd.Save) {
+		if err := prompt.Check(prompt.Confirmation, "saving the access keys cannot be confirmed: set save=true or no-prompt=true"); err != nil {
+			return err
+		}
 		if !promptConfirm("Do you want to save these access keys in %s?", AWSCredFilepath) {
 			return nil
 		}
@@ -109,10 +113,6 @@ func (cmd *CreateAccesskey) AfterRun(renv env.Running, output interface{}) error
 	return nil
 }
 
-func (cmd *CreateAccesskey) ExtractResult(i interface{}) string {
-	return StringValue(i.(*iam.CreateAccessKeyOutput).AccessKey.AccessKeyId)
-}
-
 type DeleteAccesskey struct {
 	_      string `action:"delete" entity:"accesskey" awsAPI:"iam" awsCall:"DeleteAccessKey" awsInput:"iam.DeleteAccessKeyInput" awsOutput:"iam.DeleteAccessKeyOutput"`
 	logger *logger.Logger
@@ -179,6 +179,9 @@ func NewCredsPrompter(profile string) *credentialsPrompter {
 }
 
 func (c *credentialsPrompter) Prompt() error {
+	if err := prompt.Check(prompt.Credentials, "no AWS credentials found: set them in the environment or in "+AWSCredFilepath); err != nil {
+		return err
+	}
 	token := "and choose a profile name"
 	if c.HasProfile() {
 		token = fmt.Sprintf("for profile '%s'", c.Profile)
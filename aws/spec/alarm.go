@@ -18,6 +18,7 @@ package awsspec
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/template/env"
@@ -35,12 +36,12 @@ type CreateAlarm struct {
 	graph                   cloud.GraphAPI
 	api                     cloudwatchiface.CloudWatchAPI
 	Name                    *string   `awsName:"AlarmName" awsType:"awsstr" templateName:"name"`
-	Operator                *string   `awsName:"ComparisonOperator" awsType:"awsstr" templateName:"operator"`
+	Operator                *string   `awsName:"ComparisonOperator" awsType:"awsstr" templateName:"operator" enum:"GreaterThanThreshold,LessThanThreshold,LessThanOrEqualToThreshold,GreaterThanOrEqualToThreshold"`
 	Metric                  *string   `awsName:"MetricName" awsType:"awsstr" templateName:"metric"`
 	Namespace               *string   `awsName:"Namespace" awsType:"awsstr" templateName:"namespace"`
 	EvaluationPeriods       *int64    `awsName:"EvaluationPeriods" awsType:"awsint64" templateName:"evaluation-periods"`
 	Period                  *int64    `awsName:"Period" awsType:"awsint64" templateName:"period"`
-	StatisticFunction       *string   `awsName:"Statistic" awsType:"awsstr" templateName:"statistic-function"`
+	StatisticFunction       *string   `awsName:"Statistic" awsType:"awsstr" templateName:"statistic-function" enum:"Minimum,Maximum,Sum,Average,SampleCount,pNN.NN"`
 	Threshold               *float64  `awsName:"Threshold" awsType:"awsfloat" templateName:"threshold"`
 	Enabled                 *bool     `awsName:"ActionsEnabled" awsType:"awsbool" templateName:"enabled"`
 	AlarmActions            []*string `awsName:"AlarmActions" awsType:"awsstringslice" templateName:"alarm-actions"`
@@ -48,7 +49,7 @@ type CreateAlarm struct {
 	OkActions               []*string `awsName:"OKActions" awsType:"awsstringslice" templateName:"ok-actions"`
 	Description             *string   `awsName:"AlarmDescription" awsType:"awsstr" templateName:"description"`
 	Dimensions              []*string `awsName:"Dimensions" awsType:"awsdimensionslice" templateName:"dimensions"`
-	Unit                    *string   `awsName:"Unit" awsType:"awsstr" templateName:"unit"`
+	Unit                    *string   `awsName:"Unit" awsType:"awsstr" templateName:"unit" enum:"Seconds,Microseconds,Milliseconds,Bytes,Kilobytes,Megabytes,Gigabytes,Terabytes,Bits,Kilobits,Megabits,Gigabits,Terabits,Percent,Count,Bytes/Second,Kilobytes/Second,Megabytes/Second,Gigabytes/Second,Terabytes/Second,Bits/Second,Kilobits/Second,Megabits/Second,Gigabits/Second,Terabits/Second,Count/Second,None"`
 }
 
 func (cmd *CreateAlarm) ParamsSpec() params.Spec {
@@ -119,6 +120,12 @@ func (cmd *AttachAlarm) ManualRun(renv env.Running) (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
+	for _, action := range alarm.AlarmActions {
+		if aws.StringValue(action) == aws.StringValue(cmd.ActionArn) {
+			cmd.logger.Infof("action '%s' already attached to alarm %s", aws.StringValue(cmd.ActionArn), aws.StringValue(alarm.AlarmName))
+			return nil, nil
+		}
+	}
 	alarm.AlarmActions = append(alarm.AlarmActions, cmd.ActionArn)
 
 	return cmd.api.PutMetricAlarm(&cloudwatch.PutMetricAlarmInput{
@@ -197,6 +204,52 @@ func (cmd *DetachAlarm) ManualRun(renv env.Running) (interface{}, error) {
 	})
 }
 
+type CheckAlarm struct {
+	_       string `action:"check" entity:"alarm" awsAPI:"cloudwatch"`
+	logger  *logger.Logger
+	graph   cloud.GraphAPI
+	api     cloudwatchiface.CloudWatchAPI
+	Name    *string `templateName:"name"`
+	State   *string `templateName:"state" enum:"OK,ALARM,INSUFFICIENT_DATA,not-found"`
+	Timeout *int64  `templateName:"timeout" enum:"10,60,180,300,600,900"`
+}
+
+func (cmd *CheckAlarm) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("name"), params.Key("state"), params.Key("timeout")),
+		params.Validators{
+			"state": params.IsInEnumIgnoreCase(cloudwatch.StateValueOk, cloudwatch.StateValueAlarm, cloudwatch.StateValueInsufficientData, notFoundState),
+		})
+}
+
+func (cmd *CheckAlarm) ManualRun(renv env.Running) (interface{}, error) {
+	input := &cloudwatch.DescribeAlarmsInput{
+		AlarmNames: []*string{cmd.Name},
+	}
+
+	c := &checker{
+		description: fmt.Sprintf("alarm %s", StringValue(cmd.Name)),
+		timeout:     time.Duration(Int64AsIntValue(cmd.Timeout)) * time.Second,
+		frequency:   5 * time.Second,
+		fetchFunc: func() (string, error) {
+			output, err := cmd.api.DescribeAlarms(input)
+			if err != nil {
+				return "", err
+			}
+			for _, alarm := range output.MetricAlarms {
+				if StringValue(alarm.AlarmName) == StringValue(cmd.Name) {
+					return StringValue(alarm.StateValue), nil
+				}
+			}
+			return notFoundState, nil
+		},
+		expect:    StringValue(cmd.State),
+		logger:    cmd.logger,
+		checkName: "state",
+	}
+	return nil, c.check()
+}
+
 func getAlarm(api cloudwatchiface.CloudWatchAPI, name *string) (*cloudwatch.MetricAlarm, error) {
 	if name == nil {
 		return nil, errors.New("missing required params 'name'")
@@ -16,8 +16,6 @@ limitations under the License.
 package awsspec
 
 import (
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
 	"github.com/aws/aws-sdk-go/service/applicationautoscaling/applicationautoscalingiface"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/logger"
@@ -25,19 +23,19 @@ import (
 )
 
 type CreateAppscalingpolicy struct {
-	_                                 string `action:"create" entity:"appscalingpolicy" awsAPI:"applicationautoscaling" awsCall:"PutScalingPolicy" awsInput:"applicationautoscaling.PutScalingPolicyInput" awsOutput:"applicationautoscaling.PutScalingPolicyOutput"`
+	_                                 string `action:"create" entity:"appscalingpolicy" awsAPI:"applicationautoscaling" awsCall:"PutScalingPolicy" awsInput:"applicationautoscaling.PutScalingPolicyInput" awsOutput:"applicationautoscaling.PutScalingPolicyOutput" awsOutputExtract:"PolicyARN"`
 	logger                            *logger.Logger
 	graph                             cloud.GraphAPI
 	api                               applicationautoscalingiface.ApplicationAutoScalingAPI
 	Name                              *string   `awsName:"PolicyName" awsType:"awsstr" templateName:"name"`
-	Type                              *string   `awsName:"PolicyType" awsType:"awsstr" templateName:"type"`
+	Type                              *string   `awsName:"PolicyType" awsType:"awsstr" templateName:"type" enum:"StepScaling"`
 	Resource                          *string   `awsName:"ResourceId" awsType:"awsstr" templateName:"resource"`
-	Dimension                         *string   `awsName:"ScalableDimension" awsType:"awsstr" templateName:"dimension"`
-	ServiceNamespace                  *string   `awsName:"ServiceNamespace" awsType:"awsstr" templateName:"service-namespace"`
-	StepscalingAdjustmentType         *string   `awsName:"StepScalingPolicyConfiguration.AdjustmentType" awsType:"awsstr" templateName:"stepscaling-adjustment-type"`
-	StepscalingAdjustments            []*string `awsName:"StepScalingPolicyConfiguration.StepAdjustments" awsType:"awsstepadjustments" templateName:"stepscaling-adjustments"`
+	Dimension                         *string   `awsName:"ScalableDimension" awsType:"awsstr" templateName:"dimension" enum:"ecs:service:DesiredCount,ec2:spot-fleet-request:TargetCapacity,elasticmapreduce:instancegroup:InstanceCount,appstream:fleet:DesiredCapacity,dynamodb:table:ReadCapacityUnits,dynamodb:table:WriteCapacityUnits,dynamodb:index:ReadCapacityUnits,dynamodb:index:WriteCapacityUnits"`
+	ServiceNamespace                  *string   `awsName:"ServiceNamespace" awsType:"awsstr" templateName:"service-namespace" enum:"ecs,ec2,elasticmapreduce,appstream,dynamodb"`
+	StepscalingAdjustmentType         *string   `awsName:"StepScalingPolicyConfiguration.AdjustmentType" awsType:"awsstr" templateName:"stepscaling-adjustment-type" enum:"ChangeInCapacity,ExactCapacity,PercentChangeInCapacity"`
+	StepscalingAdjustments            []*string `awsName:"StepScalingPolicyConfiguration.StepAdjustments" awsType:"awsstepadjustments" templateName:"stepscaling-adjustments" enum:"0::+1,:0:-1,75::+1"`
 	StepscalingCooldown               *int64    `awsName:"StepScalingPolicyConfiguration.Cooldown" awsType:"awsint64" templateName:"stepscaling-cooldown"`
-	StepscalingAggregationType        *string   `awsName:"StepScalingPolicyConfiguration.MetricAggregationType" awsType:"awsstr" templateName:"stepscaling-aggregation-type"`
+	StepscalingAggregationType        *string   `awsName:"StepScalingPolicyConfiguration.MetricAggregationType" awsType:"awsstr" templateName:"stepscaling-aggregation-type" enum:"Minimum,Maximum,Average"`
 	StepscalingMinAdjustmentMagnitude *int64    `awsName:"StepScalingPolicyConfiguration.MinAdjustmentMagnitude" awsType:"awsint64" templateName:"stepscaling-min-adjustment-magnitude"`
 }
 
@@ -47,10 +45,6 @@ func (cmd *CreateAppscalingpolicy) ParamsSpec() params.Spec {
 	))
 }
 
-func (cmd *CreateAppscalingpolicy) ExtractResult(i interface{}) string {
-	return awssdk.StringValue(i.(*applicationautoscaling.PutScalingPolicyOutput).PolicyARN)
-}
-
 type DeleteAppscalingpolicy struct {
 	_                string `action:"delete" entity:"appscalingpolicy" awsAPI:"applicationautoscaling" awsCall:"DeleteScalingPolicy" awsInput:"applicationautoscaling.DeleteScalingPolicyInput" awsOutput:"applicationautoscaling.DeleteScalingPolicyOutput"`
 	logger           *logger.Logger
@@ -31,8 +31,8 @@ type CreateAppscalingtarget struct {
 	MinCapacity      *int64  `awsName:"MinCapacity" awsType:"awsint64" templateName:"min-capacity"`
 	Resource         *string `awsName:"ResourceId" awsType:"awsstr" templateName:"resource"`
 	Role             *string `awsName:"RoleARN" awsType:"awsstr" templateName:"role"`
-	Dimension        *string `awsName:"ScalableDimension" awsType:"awsstr" templateName:"dimension"`
-	ServiceNamespace *string `awsName:"ServiceNamespace" awsType:"awsstr" templateName:"service-namespace"`
+	Dimension        *string `awsName:"ScalableDimension" awsType:"awsstr" templateName:"dimension" enum:"ecs:service:DesiredCount,ec2:spot-fleet-request:TargetCapacity,elasticmapreduce:instancegroup:InstanceCount,appstream:fleet:DesiredCapacity,dynamodb:table:ReadCapacityUnits,dynamodb:table:WriteCapacityUnits,dynamodb:index:ReadCapacityUnits,dynamodb:index:WriteCapacityUnits"`
+	ServiceNamespace *string `awsName:"ServiceNamespace" awsType:"awsstr" templateName:"service-namespace" enum:"ecs,ec2,elasticmapreduce,appstream,dynamodb"`
 }
 
 func (cmd *CreateAppscalingtarget) ParamsSpec() params.Spec {
@@ -0,0 +1,255 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/wallix/awless/logger"
+)
+
+const (
+	// BackupInstanceTag tags the images (and their snapshots) backing up an instance, with the instance id
+	BackupInstanceTag = "awless:backup:instance"
+	// BackupTimeTag tags the images (and their snapshots) backing up an instance, with the backup time
+	BackupTimeTag = "awless:backup:time"
+)
+
+var (
+	// backupImageTimeout is the maximum time waited for the image of a backup to be available
+	backupImageTimeout        = 30 * time.Minute
+	backupImageCheckFrequency = 15 * time.Second
+)
+
+type BackupInstance struct {
+	_           string `action:"backup" entity:"instance" awsAPI:"ec2"`
+	logger      *logger.Logger
+	graph       cloud.GraphAPI
+	api         ec2iface.EC2API
+	Id          *string `templateName:"id"`
+	Description *string `templateName:"description"`
+	Reboot      *bool   `templateName:"reboot"`
+}
+
+func (cmd *BackupInstance) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id"), params.Opt("description", "reboot")))
+}
+
+func (cmd *BackupInstance) ManualRun(renv env.Running) (interface{}, error) {
+	instance := StringValue(cmd.Id)
+	now := time.Now().UTC()
+
+	createImage := CommandFactory.Build("createimage")().(*CreateImage)
+	entries := map[string]interface{}{
+		"instance":    instance,
+		"name":        fmt.Sprintf("awless-backup-%s-%s", instance, now.Format("20060102-150405")),
+		"description": fmt.Sprintf("awless backup of instance %s at %s", instance, now.Format(time.RFC3339)),
+	}
+	if cmd.Description != nil {
+		entries["description"] = StringValue(cmd.Description)
+	}
+	if cmd.Reboot != nil {
+		entries["reboot"] = BoolValue(cmd.Reboot)
+	}
+	output, err := createImage.Run(renv, entries)
+	if err != nil {
+		return nil, fmt.Errorf("create image: %s", err)
+	}
+	imageID := fmt.Sprint(output)
+
+	// the snapshots of the image are only known once it is available
+	resources := []*string{String(imageID)}
+	c := &checker{
+		description: fmt.Sprintf("backup image %s", imageID),
+		timeout:     backupImageTimeout,
+		frequency:   backupImageCheckFrequency,
+		fetchFunc: func() (string, error) {
+			images, err := cmd.api.DescribeImages(&ec2.DescribeImagesInput{ImageIds: []*string{String(imageID)}})
+			if err != nil {
+				return "", err
+			}
+			if len(images.Images) == 0 {
+				return notFoundState, nil
+			}
+			img := images.Images[0]
+			state := StringValue(img.State)
+			switch state {
+			case ec2.ImageStateAvailable:
+				for _, dev := range img.BlockDeviceMappings {
+					if dev.Ebs != nil && StringValue(dev.Ebs.SnapshotId) != "" {
+						resources = append(resources, dev.Ebs.SnapshotId)
+					}
+				}
+			case ec2.ImageStateFailed, ec2.ImageStateError, ec2.ImageStateInvalid, ec2.ImageStateDeregistered:
+				if img.StateReason != nil {
+					return "", fmt.Errorf("image %s: %s", state, StringValue(img.StateReason.Message))
+				}
+				return "", fmt.Errorf("image %s", state)
+			}
+			return state, nil
+		},
+		expect: ec2.ImageStateAvailable,
+		logger: cmd.logger,
+	}
+	if err := c.check(); err != nil {
+		return nil, err
+	}
+
+	if _, err = cmd.api.CreateTags(&ec2.CreateTagsInput{
+		Resources: resources,
+		Tags: []*ec2.Tag{
+			{Key: String(BackupInstanceTag), Value: String(instance)},
+			{Key: String(BackupTimeTag), Value: String(now.Format(time.RFC3339))},
+		},
+	}); err != nil {
+		return nil, fmt.Errorf("tag backup %s: %s", imageID, err)
+	}
+	return imageID, nil
+}
+
+func (cmd *BackupInstance) ExtractResult(i interface{}) string {
+	return i.(string)
+}
+
+type RestoreBackup struct {
+	_              string `action:"restore" entity:"backup" awsAPI:"ec2"`
+	logger         *logger.Logger
+	graph          cloud.GraphAPI
+	api            ec2iface.EC2API
+	Id             *string   `templateName:"id"`
+	Name           *string   `templateName:"name"`
+	Type           *string   `templateName:"type"`
+	Subnet         *string   `templateName:"subnet"`
+	Keypair        *string   `templateName:"keypair"`
+	SecurityGroups []*string `templateName:"securitygroup"`
+}
+
+func (cmd *RestoreBackup) ParamsSpec() params.Spec {
+	builder := params.SpecBuilder(
+		params.AllOf(params.Key("id"), params.Opt("keypair", "name", "securitygroup", "subnet", "type")),
+		params.Validators{"type": params.IsInstanceType},
+	)
+	builder.AddReducer(func(values map[string]interface{}) (map[string]interface{}, error) {
+		fn := CommandFactory.Build("createinstance")().(*CreateInstance).convertInstanceTypeAlias
+		return fn(values)
+	}, "type")
+	return builder.Done()
+}
+
+// ManualRun launches a new instance from the backup image, with the settings
+// of the backed up instance when still existing, unless overridden by params
+func (cmd *RestoreBackup) ManualRun(renv env.Running) (interface{}, error) {
+	imageID := StringValue(cmd.Id)
+	images, err := cmd.api.DescribeImages(&ec2.DescribeImagesInput{ImageIds: []*string{String(imageID)}})
+	if err != nil {
+		return nil, err
+	}
+	if len(images.Images) != 1 {
+		return nil, fmt.Errorf("no backup found with id '%s'", imageID)
+	}
+	var source string
+	for _, t := range images.Images[0].Tags {
+		if StringValue(t.Key) == BackupInstanceTag {
+			source = StringValue(t.Value)
+		}
+	}
+	if source == "" {
+		return nil, fmt.Errorf("image '%s' is not an awless backup (missing tag %s)", imageID, BackupInstanceTag)
+	}
+
+	entries := map[string]interface{}{
+		"image": imageID,
+		"count": 1,
+		"name":  fmt.Sprintf("%s-restored", source),
+	}
+	if instance, err := cmd.backedUpInstance(source); err != nil {
+		renv.Log().Warningf("cannot get settings of backed up instance %s: %s", source, err)
+	} else if instance != nil {
+		entries["type"] = StringValue(instance.InstanceType)
+		if instance.SubnetId != nil {
+			entries["subnet"] = StringValue(instance.SubnetId)
+		}
+		if instance.KeyName != nil {
+			entries["keypair"] = StringValue(instance.KeyName)
+		}
+		var groups []string
+		for _, g := range instance.SecurityGroups {
+			groups = append(groups, StringValue(g.GroupId))
+		}
+		if len(groups) > 0 {
+			entries["securitygroup"] = groups
+		}
+		for _, t := range instance.Tags {
+			if StringValue(t.Key) == "Name" && StringValue(t.Value) != "" {
+				entries["name"] = fmt.Sprintf("%s-restored", StringValue(t.Value))
+			}
+		}
+	}
+	if cmd.Name != nil {
+		entries["name"] = StringValue(cmd.Name)
+	}
+	if cmd.Type != nil {
+		entries["type"] = StringValue(cmd.Type)
+	}
+	if cmd.Subnet != nil {
+		entries["subnet"] = StringValue(cmd.Subnet)
+	}
+	if cmd.Keypair != nil {
+		entries["keypair"] = StringValue(cmd.Keypair)
+	}
+	if len(cmd.SecurityGroups) > 0 {
+		entries["securitygroup"] = castStringSlice(cmd.SecurityGroups)
+	}
+	for _, required := range []string{"type", "subnet"} {
+		if _, ok := entries[required]; !ok {
+			return nil, fmt.Errorf("backed up instance %s not found: missing param '%s' to restore backup", source, required)
+		}
+	}
+
+	createInstance := CommandFactory.Build("createinstance")().(*CreateInstance)
+	output, err := createInstance.Run(renv, entries)
+	if err != nil {
+		return nil, fmt.Errorf("create instance: %s", err)
+	}
+	return output, nil
+}
+
+func (cmd *RestoreBackup) backedUpInstance(id string) (*ec2.Instance, error) {
+	out, err := cmd.api.DescribeInstances(&ec2.DescribeInstancesInput{InstanceIds: []*string{String(id)}})
+	if err != nil {
+		return nil, err
+	}
+	for _, res := range out.Reservations {
+		for _, inst := range res.Instances {
+			if inst.State != nil && StringValue(inst.State.Name) == ec2.InstanceStateNameTerminated {
+				return nil, nil
+			}
+			return inst, nil
+		}
+	}
+	return nil, nil
+}
+
+func (cmd *RestoreBackup) ExtractResult(i interface{}) string {
+	return i.(string)
+}
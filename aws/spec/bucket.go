@@ -16,6 +16,12 @@ limitations under the License.
 package awsspec
 
 import (
+	"crypto/md5"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
 	"time"
 
 	"github.com/wallix/awless/cloud"
@@ -23,6 +29,9 @@ import (
 	"github.com/wallix/awless/template/params"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/private/protocol"
+	"github.com/aws/aws-sdk-go/private/protocol/restxml"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 	"github.com/wallix/awless/logger"
@@ -34,7 +43,7 @@ type CreateBucket struct {
 	graph  cloud.GraphAPI
 	api    s3iface.S3API
 	Name   *string `awsName:"Bucket" awsType:"awsstr" templateName:"name"`
-	Acl    *string `awsName:"ACL" awsType:"awsstr" templateName:"acl"`
+	Acl    *string `awsName:"ACL" awsType:"awsstr" templateName:"acl" enum:"private,public-read,public-read-write,aws-exec-read,authenticated-read,bucket-owner-read,bucket-owner-full-control,log-delivery-write"`
 }
 
 func (cmd *CreateBucket) ParamsSpec() params.Spec {
@@ -48,22 +57,33 @@ func (cmd *CreateBucket) ExtractResult(i interface{}) string {
 }
 
 type UpdateBucket struct {
-	_                string `action:"update" entity:"bucket" awsAPI:"s3"`
-	logger           *logger.Logger
-	graph            cloud.GraphAPI
-	api              s3iface.S3API
-	Name             *string `templateName:"name"`
-	Acl              *string `templateName:"acl"`
-	PublicWebsite    *bool   `templateName:"public-website"`
-	RedirectHostname *string `templateName:"redirect-hostname"`
-	IndexSuffix      *string `templateName:"index-suffix"`
-	EnforceHttps     *bool   `templateName:"enforce-https"`
+	_                 string `action:"update" entity:"bucket" awsAPI:"s3"`
+	logger            *logger.Logger
+	graph             cloud.GraphAPI
+	api               s3iface.S3API
+	Name              *string `templateName:"name"`
+	Acl               *string `templateName:"acl" enum:"private,public-read,public-read-write,aws-exec-read,authenticated-read,bucket-owner-read,bucket-owner-full-control,log-delivery-write"`
+	PublicWebsite     *bool   `templateName:"public-website" enum:"true,false"`
+	RedirectHostname  *string `templateName:"redirect-hostname"`
+	IndexSuffix       *string `templateName:"index-suffix" enum:"index.html"`
+	EnforceHttps      *bool   `templateName:"enforce-https"`
+	Encryption        *string `templateName:"encryption" enum:"AES256,aws:kms,none"`
+	EncryptionKey     *string `templateName:"encryption-key"`
+	BlockPublicAccess *bool   `templateName:"block-public-access" enum:"true,false"`
 }
 
 func (cmd *UpdateBucket) ParamsSpec() params.Spec {
 	return params.NewSpec(params.AllOf(params.Key("name"),
-		params.Opt("acl", "enforce-https", "index-suffix", "public-website", "redirect-hostname"),
-	))
+		params.Opt("acl", "block-public-access", "encryption", "encryption-key", "enforce-https", "index-suffix", "public-website", "redirect-hostname"),
+	), params.Validators{
+		"encryption": params.IsInEnumIgnoreCase(s3.ServerSideEncryptionAes256, s3.ServerSideEncryptionAwsKms, "none"),
+		"encryption-key": func(i interface{}, others map[string]interface{}) error {
+			if enc, _ := others["encryption"].(string); !strings.EqualFold(enc, s3.ServerSideEncryptionAwsKms) {
+				return fmt.Errorf("encryption-key requires encryption=%s", s3.ServerSideEncryptionAwsKms)
+			}
+			return nil
+		},
+	})
 }
 
 func (cmd *UpdateBucket) ManualRun(renv env.Running) (interface{}, error) {
@@ -83,7 +103,6 @@ func (cmd *UpdateBucket) ManualRun(renv env.Running) (interface{}, error) {
 		}
 
 		cmd.logger.ExtraVerbosef("s3.PutBucketAcl call took %s", time.Since(start))
-		return nil, nil
 	}
 
 	if cmd.PublicWebsite != nil { // Set/Unset this bucket as a public website
@@ -113,9 +132,109 @@ func (cmd *UpdateBucket) ManualRun(renv env.Running) (interface{}, error) {
 		}
 		cmd.logger.ExtraVerbosef("s3.PutBucketWebsite call took %s", time.Since(start))
 	}
+
+	if cmd.Encryption != nil { // Set/Unset the default server-side encryption of new objects
+		start = time.Now()
+		if strings.EqualFold(StringValue(cmd.Encryption), "none") {
+			if _, err := cmd.api.DeleteBucketEncryption(&s3.DeleteBucketEncryptionInput{Bucket: cmd.Name}); err != nil {
+				return nil, err
+			}
+			cmd.logger.ExtraVerbosef("s3.DeleteBucketEncryption call took %s", time.Since(start))
+		} else {
+			rule := &s3.ServerSideEncryptionByDefault{SSEAlgorithm: aws.String(s3.ServerSideEncryptionAes256)}
+			if strings.EqualFold(StringValue(cmd.Encryption), s3.ServerSideEncryptionAwsKms) {
+				rule.SSEAlgorithm = aws.String(s3.ServerSideEncryptionAwsKms)
+				rule.KMSMasterKeyID = cmd.EncryptionKey
+			}
+			input := &s3.PutBucketEncryptionInput{
+				Bucket: cmd.Name,
+				ServerSideEncryptionConfiguration: &s3.ServerSideEncryptionConfiguration{
+					Rules: []*s3.ServerSideEncryptionRule{{ApplyServerSideEncryptionByDefault: rule}},
+				},
+			}
+			if _, err := cmd.api.PutBucketEncryption(input); err != nil {
+				return nil, err
+			}
+			cmd.logger.ExtraVerbosef("s3.PutBucketEncryption call took %s", time.Since(start))
+		}
+	}
+
+	if cmd.BlockPublicAccess != nil { // Enable/Disable all the public access block settings
+		start = time.Now()
+		if err := updateBucketPublicAccessBlock(cmd.api, cmd.Name, BoolValue(cmd.BlockPublicAccess)); err != nil {
+			return nil, err
+		}
+		cmd.logger.ExtraVerbosef("s3 public access block call took %s", time.Since(start))
+	}
 	return nil, nil
 }
 
+// The vendored SDK predates the S3 public access block API:
+// the requests are built on the S3 client with the REST-XML shapes of the API.
+type s3RequestBuilder interface {
+	NewRequest(*request.Operation, interface{}, interface{}) *request.Request
+}
+
+type publicAccessBlockConfiguration struct {
+	_                     struct{} `type:"structure"`
+	BlockPublicAcls       *bool    `locationName:"BlockPublicAcls" type:"boolean"`
+	IgnorePublicAcls      *bool    `locationName:"IgnorePublicAcls" type:"boolean"`
+	BlockPublicPolicy     *bool    `locationName:"BlockPublicPolicy" type:"boolean"`
+	RestrictPublicBuckets *bool    `locationName:"RestrictPublicBuckets" type:"boolean"`
+}
+
+type putPublicAccessBlockInput struct {
+	_                              struct{}                        `type:"structure" payload:"PublicAccessBlockConfiguration"`
+	Bucket                         *string                         `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+	PublicAccessBlockConfiguration *publicAccessBlockConfiguration `locationName:"PublicAccessBlockConfiguration" type:"structure" required:"true" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+}
+
+type deletePublicAccessBlockInput struct {
+	_      struct{} `type:"structure"`
+	Bucket *string  `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+}
+
+func updateBucketPublicAccessBlock(api s3iface.S3API, bucket *string, block bool) error {
+	builder, ok := api.(s3RequestBuilder)
+	if !ok {
+		return errors.New("public access block: unsupported S3 client")
+	}
+	var req *request.Request
+	if block {
+		req = builder.NewRequest(&request.Operation{Name: "PutPublicAccessBlock", HTTPMethod: "PUT", HTTPPath: "/{Bucket}?publicAccessBlock"},
+			&putPublicAccessBlockInput{
+				Bucket: bucket,
+				PublicAccessBlockConfiguration: &publicAccessBlockConfiguration{
+					BlockPublicAcls:       aws.Bool(true),
+					IgnorePublicAcls:      aws.Bool(true),
+					BlockPublicPolicy:     aws.Bool(true),
+					RestrictPublicBuckets: aws.Bool(true),
+				},
+			}, &struct{}{})
+		req.Handlers.Build.PushBack(contentMD5)
+	} else {
+		req = builder.NewRequest(&request.Operation{Name: "DeletePublicAccessBlock", HTTPMethod: "DELETE", HTTPPath: "/{Bucket}?publicAccessBlock"},
+			&deletePublicAccessBlockInput{Bucket: bucket}, &struct{}{})
+	}
+	req.Handlers.Unmarshal.Remove(restxml.UnmarshalHandler)
+	req.Handlers.Unmarshal.PushBackNamed(protocol.UnmarshalDiscardBodyHandler)
+	return req.Send()
+}
+
+// S3 requires a Content-MD5 header when putting the public access block configuration
+func contentMD5(r *request.Request) {
+	h := md5.New()
+	if _, err := io.Copy(h, r.Body); err != nil {
+		r.Error = fmt.Errorf("content md5: cannot read body: %s", err)
+		return
+	}
+	if _, err := r.Body.Seek(0, io.SeekStart); err != nil {
+		r.Error = fmt.Errorf("content md5: cannot seek body: %s", err)
+		return
+	}
+	r.HTTPRequest.Header.Set("Content-MD5", base64.StdEncoding.EncodeToString(h.Sum(nil)))
+}
+
 type DeleteBucket struct {
 	_      string `action:"delete" entity:"bucket" awsAPI:"s3" awsCall:"DeleteBucket" awsInput:"s3.DeleteBucketInput" awsOutput:"s3.DeleteBucketOutput"`
 	logger *logger.Logger
@@ -0,0 +1,58 @@
+package awsspec
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+)
+
+func TestUpdateBucketPublicAccessBlock(t *testing.T) {
+	var method, uri, md5, body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, uri, md5 = r.Method, r.URL.RequestURI(), r.Header.Get("Content-MD5")
+		b, _ := ioutil.ReadAll(r.Body)
+		body = string(b)
+	}))
+	defer server.Close()
+
+	api := s3.New(session.Must(session.NewSession()), &aws.Config{
+		Endpoint:         aws.String(server.URL),
+		Region:           aws.String("us-west-2"),
+		Credentials:      credentials.NewStaticCredentials("id", "secret", ""),
+		S3ForcePathStyle: aws.Bool(true),
+	})
+
+	if err := updateBucketPublicAccessBlock(api, String("my-bucket"), true); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := method+" "+uri, "PUT /my-bucket?publicAccessBlock="; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if md5 == "" {
+		t.Fatal("expected Content-MD5 header")
+	}
+	for _, setting := range []string{"BlockPublicAcls", "IgnorePublicAcls", "BlockPublicPolicy", "RestrictPublicBuckets"} {
+		if !strings.Contains(body, "<"+setting+">true</"+setting+">") {
+			t.Fatalf("expected %s in %s", setting, body)
+		}
+	}
+
+	if err := updateBucketPublicAccessBlock(api, String("my-bucket"), false); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := method+" "+uri, "DELETE /my-bucket?publicAccessBlock="; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	if err := updateBucketPublicAccessBlock(struct{ s3iface.S3API }{}, String("my-bucket"), true); err == nil {
+		t.Fatal("expected error")
+	}
+}
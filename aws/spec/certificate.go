@@ -124,8 +124,8 @@ type CheckCertificate struct {
 	graph   cloud.GraphAPI
 	api     acmiface.ACMAPI
 	Arn     *string `templateName:"arn"`
-	State   *string `templateName:"state"`
-	Timeout *int64  `templateName:"timeout"`
+	State   *string `templateName:"state" enum:"issued,pending_validation,not-found"`
+	Timeout *int64  `templateName:"timeout" enum:"10,60,180,300,600,900"`
 }
 
 func (cmd *CheckCertificate) ParamsSpec() params.Spec {
@@ -1,13 +1,43 @@
 package awsspec
 
-import "github.com/wallix/awless/template/params"
+import (
+	"sync"
+
+	"github.com/wallix/awless/template/params"
+)
 
 type Definition struct {
 	Action, Entity, Api string
 	Params              params.Rule
 }
 
+// definitionsCache holds the definitions looked up so far: a definition
+// compiles its params rule on first lookup only, and not on the CLI startup
+var definitionsCache = struct {
+	sync.Mutex
+	defs map[string]Definition
+}{defs: make(map[string]Definition)}
+
 func AWSLookupDefinitions(key string) (t Definition, ok bool) {
-	t, ok = AWSTemplatesDefinitions[key]
+	definitionsCache.Lock()
+	defer definitionsCache.Unlock()
+	if t, ok = definitionsCache.defs[key]; ok {
+		return
+	}
+	build, ok := awsTemplatesDefinitions[key]
+	if !ok {
+		return
+	}
+	t = build()
+	definitionsCache.defs[key] = t
 	return
 }
+
+// AWSTemplatesDefinitions returns all the definitions indexed by key (ex: 'createinstance')
+func AWSTemplatesDefinitions() map[string]Definition {
+	all := make(map[string]Definition)
+	for key := range awsTemplatesDefinitions {
+		all[key], _ = AWSLookupDefinitions(key)
+	}
+	return all
+}
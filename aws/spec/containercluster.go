@@ -16,8 +16,6 @@ limitations under the License.
 package awsspec
 
 import (
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/logger"
@@ -25,7 +23,7 @@ import (
 )
 
 type CreateContainercluster struct {
-	_      string `action:"create" entity:"containercluster" awsAPI:"ecs" awsCall:"CreateCluster" awsInput:"ecs.CreateClusterInput" awsOutput:"ecs.CreateClusterOutput"`
+	_      string `action:"create" entity:"containercluster" awsAPI:"ecs" awsCall:"CreateCluster" awsInput:"ecs.CreateClusterInput" awsOutput:"ecs.CreateClusterOutput" awsOutputExtract:"Cluster.ClusterArn"`
 	logger *logger.Logger
 	graph  cloud.GraphAPI
 	api    ecsiface.ECSAPI
@@ -36,10 +34,6 @@ func (cmd *CreateContainercluster) ParamsSpec() params.Spec {
 	return params.NewSpec(params.AllOf(params.Key("name")))
 }
 
-func (cmd *CreateContainercluster) ExtractResult(i interface{}) string {
-	return awssdk.StringValue(i.(*ecs.CreateClusterOutput).Cluster.ClusterArn)
-}
-
 type DeleteContainercluster struct {
 	_      string `action:"delete" entity:"containercluster" awsAPI:"ecs" awsCall:"DeleteCluster" awsInput:"ecs.DeleteClusterInput" awsOutput:"ecs.DeleteClusterOutput"`
 	logger *logger.Logger
@@ -16,7 +16,6 @@ limitations under the License.
 package awsspec
 
 import (
-	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -40,7 +39,7 @@ type StartContainertask struct {
 	Cluster                   *string `templateName:"cluster"`
 	DesiredCount              *int64  `templateName:"desired-count"`
 	Name                      *string `templateName:"name"`
-	Type                      *string `templateName:"type"`
+	Type                      *string `templateName:"type" enum:"task,service"`
 	Role                      *string `templateName:"role"`
 	DeploymentName            *string `templateName:"deployment-name"`
 	LoadBalancerContainerName *string `templateName:"loadbalancer.container-name"`
@@ -52,17 +51,7 @@ func (cmd *StartContainertask) ParamsSpec() params.Spec {
 	return params.NewSpec(
 		params.AllOf(params.Key("cluster"), params.Key("desired-count"), params.Key("name"), params.Key("type"), params.Opt("deployment-name", "loadbalancer.container-name", "loadbalancer.container-port", "loadbalancer.targetgroup", "role")),
 		params.Validators{
-			"type": func(i interface{}, others map[string]interface{}) error {
-				typ := fmt.Sprint(i)
-				if typ != "task" && typ != "service" {
-					return fmt.Errorf("expected any of [task service] but got %s", typ)
-				}
-				_, hasDepName := others["deployment-name"]
-				if typ == "service" && !hasDepName {
-					return errors.New("missing required param 'deployment-name' when type=service")
-				}
-				return nil
-			},
+			"type": params.All(params.IsInEnumIgnoreCase("task", "service"), params.RequiresWhen("service", "deployment-name")),
 		})
 }
 
@@ -140,7 +129,7 @@ type StopContainertask struct {
 	graph          cloud.GraphAPI
 	api            ecsiface.ECSAPI
 	Cluster        *string `templateName:"cluster"`
-	Type           *string `templateName:"type"`
+	Type           *string `templateName:"type" enum:"task,service"`
 	DeploymentName *string `templateName:"deployment-name"`
 	RunArn         *string `templateName:"run-arn"`
 }
@@ -149,21 +138,11 @@ func (cmd *StopContainertask) ParamsSpec() params.Spec {
 	return params.NewSpec(
 		params.AllOf(params.Key("cluster"), params.Key("type"), params.Opt("deployment-name", "run-arn")),
 		params.Validators{
-			"type": func(i interface{}, others map[string]interface{}) error {
-				typ := fmt.Sprint(i)
-				if typ != "task" && typ != "service" {
-					return fmt.Errorf("expected any of [task service] but got %s", typ)
-				}
-				_, hasDepName := others["deployment-name"]
-				if typ == "service" && !hasDepName {
-					return errors.New("missing required param 'deployment-name' when type=service")
-				}
-				_, hasRunARN := others["run-arn"]
-				if typ == "task" && !hasRunARN {
-					return errors.New("missing required param 'run-arn' when type=task")
-				}
-				return nil
-			},
+			"type": params.All(
+				params.IsInEnumIgnoreCase("task", "service"),
+				params.RequiresWhen("service", "deployment-name"),
+				params.RequiresWhen("task", "run-arn"),
+			),
 		})
 }
 
@@ -398,7 +377,7 @@ type DeleteContainertask struct {
 	graph       cloud.GraphAPI
 	api         ecsiface.ECSAPI
 	Name        *string `templateName:"name"`
-	AllVersions *bool   `templateName:"all-versions"`
+	AllVersions *bool   `templateName:"all-versions" enum:"true,false"`
 }
 
 func (cmd *DeleteContainertask) ParamsSpec() params.Spec {
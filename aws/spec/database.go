@@ -37,9 +37,9 @@ type CreateDatabase struct {
 	api    rdsiface.RDSAPI
 
 	// Required for DB
-	Type     *string `awsName:"DBInstanceClass" awsType:"awsstr" templateName:"type"`
+	Type     *string `awsName:"DBInstanceClass" awsType:"awsstr" templateName:"type" enum:"db.t1.micro,db.m1.small,db.m1.medium,db.m1.large,db.m1.xlarge,db.m2.xlarge,db.m2.2xlarge,db.m2.4xlarge,db.m3.medium,db.m3.large,db.m3.xlarge,db.m3.2xlarge,db.m4.large,db.m4.xlarge,db.m4.2xlarge,db.m4.4xlarge,db.m4.10xlarge,db.r3.large,db.r3.xlarge,db.r3.2xlarge,db.r3.4xlarge,db.r3.8xlarge,db.t2.micro,db.t2.small,db.t2.medium,db.t2.large"`
 	Id       *string `awsName:"DBInstanceIdentifier" awsType:"awsstr" templateName:"id"`
-	Engine   *string `awsName:"Engine" awsType:"awsstr" templateName:"engine"`
+	Engine   *string `awsName:"Engine" awsType:"awsstr" templateName:"engine" enum:"mysql,mariadb,postgres,aurora,oracle-se1,oracle-se2,oracle-se,oracle-ee,sqlserver-ee,sqlserver-se,sqlserver-ex,sqlserver-web"`
 	Password *string `awsName:"MasterUserPassword" awsType:"awsstr" templateName:"password"`
 	Username *string `awsName:"MasterUsername" awsType:"awsstr" templateName:"username"`
 	Size     *int64  `awsName:"AllocatedStorage" awsType:"awsint64" templateName:"size"`
@@ -55,8 +55,8 @@ type CreateDatabase struct {
 	Iops             *int64  `awsName:"Iops" awsType:"awsint64" templateName:"iops"`
 	Optiongroup      *string `awsName:"OptionGroupName" awsType:"awsstr" templateName:"optiongroup"`
 	Port             *int64  `awsName:"Port" awsType:"awsint64" templateName:"port"`
-	Public           *bool   `awsName:"PubliclyAccessible" awsType:"awsbool" templateName:"public"`
-	Storagetype      *string `awsName:"StorageType" awsType:"awsstr" templateName:"storagetype"`
+	Public           *bool   `awsName:"PubliclyAccessible" awsType:"awsbool" templateName:"public" enum:"true,false"`
+	Storagetype      *string `awsName:"StorageType" awsType:"awsstr" templateName:"storagetype" enum:"standard,gp2,io1"`
 
 	// Extra only for DB
 	Backupretention   *int64    `awsName:"BackupRetentionPeriod" awsType:"awsint64" templateName:"backupretention"`
@@ -65,18 +65,18 @@ type CreateDatabase struct {
 	Dbname            *string   `awsName:"DBName" awsType:"awsstr" templateName:"dbname"`
 	Dbsecuritygroups  []*string `awsName:"DBSecurityGroups" awsType:"awsstringslice" templateName:"dbsecuritygroups"`
 	Domain            *string   `awsName:"Domain" awsType:"awsstr" templateName:"domain"`
-	Encrypted         *bool     `awsName:"StorageEncrypted" awsType:"awsbool" templateName:"encrypted"`
+	Encrypted         *bool     `awsName:"StorageEncrypted" awsType:"awsbool" templateName:"encrypted" enum:"true,false"`
 	Iamrole           *string   `awsName:"DomainIAMRoleName" awsType:"awsstr" templateName:"iamrole"`
-	License           *string   `awsName:"LicenseModel" awsType:"awsstr" templateName:"license"`
+	License           *string   `awsName:"LicenseModel" awsType:"awsstr" templateName:"license" enum:"license-included,bring-your-own-license,general-public-license"`
 	Maintenancewindow *string   `awsName:"PreferredMaintenanceWindow" awsType:"awsstr" templateName:"maintenancewindow"`
-	Multiaz           *bool     `awsName:"MultiAZ" awsType:"awsbool" templateName:"multiaz"`
+	Multiaz           *bool     `awsName:"MultiAZ" awsType:"awsbool" templateName:"multiaz" enum:"true,false"`
 	Parametergroup    *string   `awsName:"DBParameterGroupName" awsType:"awsstr" templateName:"parametergroup"`
 	Timezone          *string   `awsName:"Timezone" awsType:"awsstr" templateName:"timezone"`
 	Vpcsecuritygroups []*string `awsName:"VpcSecurityGroupIds" awsType:"awsstringslice" templateName:"vpcsecuritygroups"`
 	Version           *string   `awsName:"EngineVersion" awsType:"awsstr" templateName:"version"`
 
 	// Extra only for replica DB
-	CopyTagsToSnapshot *string `awsName:"CopyTagsToSnapshot" awsType:"awsbool" templateName:"copytagstosnapshot"`
+	CopyTagsToSnapshot *string `awsName:"CopyTagsToSnapshot" awsType:"awsbool" templateName:"copytagstosnapshot" enum:"true,false"`
 }
 
 func (cmd *CreateDatabase) ParamsSpec() params.Spec {
@@ -87,7 +87,8 @@ func (cmd *CreateDatabase) ParamsSpec() params.Spec {
 			"dbsecuritygroups", "subnetgroup", "domain", "iamrole", "version", "iops", "license", "multiaz", "optiongroup",
 			"port", "backupwindow", "maintenancewindow", "public", "encrypted", "storagetype", "timezone", "vpcsecuritygroups")),
 		params.Validators{
-			"password": params.MinLengthOf(8),
+			"availabilityzone": isAvailabilityZone(cmd.graph),
+			"password":         params.MinLengthOf(8),
 			"replica": func(i interface{}, others map[string]interface{}) error {
 				msg := "param not allowed in replica (either not applicable or directly inherited from the source DB)"
 				if _, ok := others["backupretention"]; ok {
@@ -183,7 +184,7 @@ type DeleteDatabase struct {
 	graph        cloud.GraphAPI
 	api          rdsiface.RDSAPI
 	Id           *string `awsName:"DBInstanceIdentifier" awsType:"awsstr" templateName:"id"`
-	SkipSnapshot *bool   `awsName:"SkipFinalSnapshot" awsType:"awsbool" templateName:"skip-snapshot"`
+	SkipSnapshot *bool   `awsName:"SkipFinalSnapshot" awsType:"awsbool" templateName:"skip-snapshot" enum:"true,false"`
 	Snapshot     *string `awsName:"FinalDBSnapshotIdentifier" awsType:"awsstr" templateName:"snapshot"`
 }
 
@@ -199,8 +200,8 @@ type CheckDatabase struct {
 	graph   cloud.GraphAPI
 	api     rdsiface.RDSAPI
 	Id      *string `templateName:"id"`
-	State   *string `templateName:"state"`
-	Timeout *int64  `templateName:"timeout"`
+	State   *string `templateName:"state" enum:"available,backing-up,creating,deleting,failed,maintenance,modifying,rebooting,renaming,resetting-master-credentials,restore-error,starting,stopped,stopping,storage-full,upgrading,not-found"`
+	Timeout *int64  `templateName:"timeout" enum:"10,60,180,300,600,900"`
 }
 
 func (cmd *CheckDatabase) ParamsSpec() params.Spec {
@@ -210,7 +211,7 @@ func (cmd *CheckDatabase) ParamsSpec() params.Spec {
 			"state": params.IsInEnumIgnoreCase("available",
 				"backing-up", "creating", "deleting", "failed", "maintenance", "modifying",
 				"rebooting", "renaming", "resetting-master-credentials", "restore-error",
-				"storage-full", "upgrading", notFoundState),
+				"starting", "stopped", "stopping", "storage-full", "upgrading", notFoundState),
 		},
 	)
 }
@@ -227,20 +228,14 @@ func (cmd *CheckDatabase) ManualRun(renv env.Running) (interface{}, error) {
 		fetchFunc: func() (string, error) {
 			output, err := cmd.api.DescribeDBInstances(input)
 			if err != nil {
-				if awserr, ok := err.(awserr.Error); ok {
-					if awserr.Code() == "DatabaseNotFound" {
-						return notFoundState, nil
-					}
-				} else {
-					return "", err
+				if awserr, ok := err.(awserr.Error); ok && awserr.Code() == rds.ErrCodeDBInstanceNotFoundFault {
+					return notFoundState, nil
 				}
-			} else {
-				if res := output.DBInstances; len(res) > 0 {
-					for _, dbinst := range res {
-						if StringValue(dbinst.DBInstanceIdentifier) == StringValue(cmd.Id) {
-							return StringValue(dbinst.DBInstanceStatus), nil
-						}
-					}
+				return "", err
+			}
+			for _, dbinst := range output.DBInstances {
+				if StringValue(dbinst.DBInstanceIdentifier) == StringValue(cmd.Id) {
+					return StringValue(dbinst.DBInstanceStatus), nil
 				}
 			}
 			return notFoundState, nil
@@ -281,7 +276,7 @@ type RestartDatabase struct {
 	graph        cloud.GraphAPI
 	api          rdsiface.RDSAPI
 	Id           *string `awsName:"DBInstanceIdentifier" awsType:"awsstr" templateName:"id"`
-	WithFailover *bool   `awsName:"ForceFailover" awsType:"awsbool" templateName:"with-failover"`
+	WithFailover *bool   `awsName:"ForceFailover" awsType:"awsbool" templateName:"with-failover" enum:"true,false"`
 }
 
 func (cmd *RestartDatabase) ParamsSpec() params.Spec {
@@ -16,8 +16,6 @@ limitations under the License.
 package awsspec
 
 import (
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/aws/aws-sdk-go/service/rds/rdsiface"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/logger"
@@ -25,7 +23,7 @@ import (
 )
 
 type CreateDbsubnetgroup struct {
-	_           string `action:"create" entity:"dbsubnetgroup" awsAPI:"rds" awsCall:"CreateDBSubnetGroup" awsInput:"rds.CreateDBSubnetGroupInput" awsOutput:"rds.CreateDBSubnetGroupOutput"`
+	_           string `action:"create" entity:"dbsubnetgroup" awsAPI:"rds" awsCall:"CreateDBSubnetGroup" awsInput:"rds.CreateDBSubnetGroupInput" awsOutput:"rds.CreateDBSubnetGroupOutput" awsOutputExtract:"DBSubnetGroup.DBSubnetGroupName"`
 	logger      *logger.Logger
 	graph       cloud.GraphAPI
 	api         rdsiface.RDSAPI
@@ -38,10 +36,6 @@ func (cmd *CreateDbsubnetgroup) ParamsSpec() params.Spec {
 	return params.NewSpec(params.AllOf(params.Key("description"), params.Key("name"), params.Key("subnets")))
 }
 
-func (cmd *CreateDbsubnetgroup) ExtractResult(i interface{}) string {
-	return awssdk.StringValue(i.(*rds.CreateDBSubnetGroupOutput).DBSubnetGroup.DBSubnetGroupName)
-}
-
 type DeleteDbsubnetgroup struct {
 	_      string `action:"delete" entity:"dbsubnetgroup" awsAPI:"rds" awsCall:"DeleteDBSubnetGroup" awsInput:"rds.DeleteDBSubnetGroupInput" awsOutput:"rds.DeleteDBSubnetGroupOutput"`
 	logger *logger.Logger
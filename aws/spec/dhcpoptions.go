@@ -0,0 +1,165 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+type CreateDhcpoptions struct {
+	_                  string `action:"create" entity:"dhcpoptions" awsAPI:"ec2" awsDryRun:"manual"`
+	logger             *logger.Logger
+	graph              cloud.GraphAPI
+	api                ec2iface.EC2API
+	DomainName         *string   `templateName:"domain-name"`
+	DomainNameServers  []*string `templateName:"domain-name-servers" enum:"AmazonProvidedDNS"`
+	NtpServers         []*string `templateName:"ntp-servers"`
+	NetbiosNameServers []*string `templateName:"netbios-name-servers"`
+	NetbiosNodeType    *int64    `templateName:"netbios-node-type" enum:"2,1,4,8"`
+	Name               *string   `templateName:"name"`
+}
+
+func (cmd *CreateDhcpoptions) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(
+			params.AtLeastOneOf(params.Key("domain-name"), params.Key("domain-name-servers"), params.Key("ntp-servers"), params.Key("netbios-name-servers"), params.Key("netbios-node-type")),
+			params.Opt(params.Suggested("name")),
+		),
+		params.Validators{
+			"netbios-node-type": func(i interface{}, others map[string]interface{}) error {
+				switch v := fmt.Sprint(i); v {
+				case "1", "2", "4", "8":
+					return nil
+				default:
+					return fmt.Errorf("expected 1, 2, 4 or 8 (2 recommended), got %s", v)
+				}
+			},
+		},
+	)
+}
+
+func (cmd *CreateDhcpoptions) prepareDhcpOptionsInput() *ec2.CreateDhcpOptionsInput {
+	input := &ec2.CreateDhcpOptionsInput{}
+	addConfiguration := func(key string, values ...*string) {
+		if len(values) > 0 {
+			input.DhcpConfigurations = append(input.DhcpConfigurations, &ec2.NewDhcpConfiguration{Key: String(key), Values: values})
+		}
+	}
+	if cmd.DomainName != nil {
+		addConfiguration("domain-name", cmd.DomainName)
+	}
+	addConfiguration("domain-name-servers", cmd.DomainNameServers...)
+	addConfiguration("ntp-servers", cmd.NtpServers...)
+	addConfiguration("netbios-name-servers", cmd.NetbiosNameServers...)
+	if cmd.NetbiosNodeType != nil {
+		addConfiguration("netbios-node-type", String(fmt.Sprint(Int64AsIntValue(cmd.NetbiosNodeType))))
+	}
+	return input
+}
+
+func (cmd *CreateDhcpoptions) ManualRun(renv env.Running) (interface{}, error) {
+	input := cmd.prepareDhcpOptionsInput()
+	start := time.Now()
+	output, err := cmd.api.CreateDhcpOptions(input)
+	cmd.logger.ExtraVerbosef("ec2.CreateDhcpOptions call took %s", time.Since(start))
+	return output, err
+}
+
+func (cmd *CreateDhcpoptions) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("dry run: cannot set params on command struct: %s", err)
+	}
+	input := cmd.prepareDhcpOptionsInput()
+	input.SetDryRun(true)
+
+	start := time.Now()
+	_, err := cmd.api.CreateDhcpOptions(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound):
+			cmd.logger.ExtraVerbosef("dry run: ec2.CreateDhcpOptions call took %s", time.Since(start))
+			cmd.logger.Verbose("dry run: create dhcpoptions ok")
+			return fakeDryRunId("dhcpoptions"), nil
+		}
+	}
+
+	return nil, fmt.Errorf("dry run: %s", err)
+}
+
+func (cmd *CreateDhcpoptions) ExtractResult(i interface{}) string {
+	return StringValue(i.(*ec2.CreateDhcpOptionsOutput).DhcpOptions.DhcpOptionsId)
+}
+
+func (cmd *CreateDhcpoptions) AfterRun(renv env.Running, output interface{}) error {
+	return createNameTag(String(cmd.ExtractResult(output)), cmd.Name, renv)
+}
+
+type DeleteDhcpoptions struct {
+	_      string `action:"delete" entity:"dhcpoptions" awsAPI:"ec2" awsCall:"DeleteDhcpOptions" awsInput:"ec2.DeleteDhcpOptionsInput" awsOutput:"ec2.DeleteDhcpOptionsOutput" awsDryRun:""`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    ec2iface.EC2API
+	Id     *string `awsName:"DhcpOptionsId" awsType:"awsstr" templateName:"id"`
+}
+
+func (cmd *DeleteDhcpoptions) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id")))
+}
+
+type AttachDhcpoptions struct {
+	_      string `action:"attach" entity:"dhcpoptions" awsAPI:"ec2" awsCall:"AssociateDhcpOptions" awsInput:"ec2.AssociateDhcpOptionsInput" awsOutput:"ec2.AssociateDhcpOptionsOutput" awsDryRun:""`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    ec2iface.EC2API
+	Id     *string `awsName:"DhcpOptionsId" awsType:"awsstr" templateName:"id"`
+	Vpc    *string `awsName:"VpcId" awsType:"awsstr" templateName:"vpc"`
+}
+
+func (cmd *AttachDhcpoptions) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id"), params.Key("vpc")))
+}
+
+// Detaching a DHCP options set from a VPC is done by associating the default options to it
+type DetachDhcpoptions struct {
+	_      string `action:"detach" entity:"dhcpoptions" awsAPI:"ec2"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    ec2iface.EC2API
+	Id     *string `templateName:"id"`
+	Vpc    *string `templateName:"vpc"`
+}
+
+func (cmd *DetachDhcpoptions) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("vpc"), params.Opt("id")))
+}
+
+func (cmd *DetachDhcpoptions) ManualRun(renv env.Running) (interface{}, error) {
+	input := &ec2.AssociateDhcpOptionsInput{DhcpOptionsId: String("default"), VpcId: cmd.Vpc}
+	start := time.Now()
+	output, err := cmd.api.AssociateDhcpOptions(input)
+	cmd.logger.ExtraVerbosef("ec2.AssociateDhcpOptions call took %s", time.Since(start))
+	return output, err
+}
@@ -17,6 +17,7 @@ package awsspec
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"time"
 
@@ -41,31 +42,40 @@ type CreateDistribution struct {
 	graph          cloud.GraphAPI
 	api            cloudfrontiface.CloudFrontAPI
 	OriginDomain   *string   `templateName:"origin-domain"`
+	OriginBucket   *string   `templateName:"origin-bucket"`
+	OriginProtocol *string   `templateName:"origin-protocol" enum:"http-only,https-only,match-viewer"`
 	Certificate    *string   `templateName:"certificate"`
 	Comment        *string   `templateName:"comment"`
-	DefaultFile    *string   `templateName:"default-file"`
+	DefaultFile    *string   `templateName:"default-file" enum:"index.html"`
 	DomainAliases  []*string `templateName:"domain-aliases"`
-	Enable         *bool     `templateName:"enable"`
-	ForwardCookies *string   `templateName:"forward-cookies"`
-	ForwardQueries *bool     `templateName:"forward-queries"`
-	HttpsBehaviour *string   `templateName:"https-behaviour"`
+	Enable         *bool     `templateName:"enable" enum:"true,false"`
+	ForwardCookies *string   `templateName:"forward-cookies" enum:"all,none,whitelist"`
+	ForwardQueries *bool     `templateName:"forward-queries" enum:"true,false"`
+	HttpsBehaviour *string   `templateName:"https-behaviour" enum:"allow-all,redirect-to-https,https-only"`
 	OriginPath     *string   `templateName:"origin-path"`
-	PriceClass     *string   `templateName:"price-class"`
+	PriceClass     *string   `templateName:"price-class" enum:"PriceClass_All,PriceClass_100,PriceClass_200"`
 	MinTtl         *int64    `templateName:"min-ttl"`
 }
 
 func (cmd *CreateDistribution) ParamsSpec() params.Spec {
-	return params.NewSpec(params.AllOf(params.Key("origin-domain"),
-		params.Opt("certificate", "comment", "default-file", "domain-aliases", "enable", "forward-cookies", "forward-queries", "https-behaviour", "min-ttl", "origin-path", "price-class"),
-	))
+	return params.NewSpec(params.AllOf(params.OnlyOneOf(params.Key("origin-domain"), params.Key("origin-bucket")),
+		params.Opt("certificate", "comment", "default-file", "domain-aliases", "enable", "forward-cookies", "forward-queries", "https-behaviour", "min-ttl", "origin-path", "origin-protocol", "price-class"),
+	),
+		params.Validators{
+			"origin-protocol": params.IsInEnumIgnoreCase(originProtocolPolicies...),
+		})
 }
 
 func (cmd *CreateDistribution) ManualRun(renv env.Running) (interface{}, error) {
 	originId := "orig_1"
+	domain := cmd.OriginDomain
+	if cmd.OriginBucket != nil {
+		domain = aws.String(StringValue(cmd.OriginBucket) + ".s3.amazonaws.com")
+	}
 	input := &cloudfront.CreateDistributionInput{
 		DistributionConfig: &cloudfront.DistributionConfig{
 			CallerReference: aws.String(CallerReferenceFunc()),
-			Comment:         cmd.OriginDomain,
+			Comment:         domain,
 			DefaultCacheBehavior: &cloudfront.DefaultCacheBehavior{
 				MinTTL: aws.Int64(0),
 				ForwardedValues: &cloudfront.ForwardedValues{
@@ -83,23 +93,17 @@ func (cmd *CreateDistribution) ManualRun(renv env.Running) (interface{}, error)
 			Origins: &cloudfront.Origins{
 				Quantity: aws.Int64(1),
 				Items: []*cloudfront.Origin{
-					{Id: aws.String(originId)},
+					{Id: aws.String(originId), DomainName: aws.String(StringValue(domain))},
 				},
 			},
 		},
 	}
-
-	if domain := StringValue(cmd.OriginDomain); strings.HasSuffix(domain, ".s3.amazonaws.com") || (strings.HasSuffix(domain, ".amazonaws.com") && strings.Contains(domain, ".s3-website-")) {
-		input.DistributionConfig.Origins.Items[0].S3OriginConfig = &cloudfront.S3OriginConfig{OriginAccessIdentity: aws.String("")}
-	}
+	setOriginConfig(input.DistributionConfig.Origins.Items[0], cmd.OriginProtocol)
 
 	call := &awsCall{
 		fnName: "cloudfront.CreateDistribution",
 		fn:     cmd.api.CreateDistribution,
 		logger: cmd.logger,
-		setters: []setter{
-			{val: cmd.OriginDomain, fieldPath: "DistributionConfig.Origins.Items[0].DomainName", fieldType: awsstr},
-		},
 	}
 
 	if cmd.Certificate != nil {
@@ -152,8 +156,8 @@ type CheckDistribution struct {
 	graph   cloud.GraphAPI
 	api     cloudfrontiface.CloudFrontAPI
 	Id      *string `templateName:"id"`
-	State   *string `templateName:"state"`
-	Timeout *int64  `templateName:"timeout"`
+	State   *string `templateName:"state" enum:"Deployed,InProgress,not-found"`
+	Timeout *int64  `templateName:"timeout" enum:"300,900,1800"`
 }
 
 func (cmd *CheckDistribution) ParamsSpec() params.Spec {
@@ -201,23 +205,27 @@ type UpdateDistribution struct {
 	api            cloudfrontiface.CloudFrontAPI
 	Id             *string   `awsName:"Id" awsType:"awsstr" templateName:"id"`
 	OriginDomain   *string   `templateName:"origin-domain"`
+	OriginProtocol *string   `templateName:"origin-protocol" enum:"http-only,https-only,match-viewer"`
 	Certificate    *string   `templateName:"certificate"`
 	Comment        *string   `templateName:"comment"`
-	DefaultFile    *string   `templateName:"default-file"`
+	DefaultFile    *string   `templateName:"default-file" enum:"index.html"`
 	DomainAliases  []*string `templateName:"domain-aliases"`
-	Enable         *bool     `templateName:"enable"`
-	ForwardCookies *string   `templateName:"forward-cookies"`
-	ForwardQueries *bool     `templateName:"forward-queries"`
-	HttpsBehaviour *string   `templateName:"https-behaviour"`
+	Enable         *bool     `templateName:"enable" enum:"true,false"`
+	ForwardCookies *string   `templateName:"forward-cookies" enum:"all,none,whitelist"`
+	ForwardQueries *bool     `templateName:"forward-queries" enum:"true,false"`
+	HttpsBehaviour *string   `templateName:"https-behaviour" enum:"allow-all,redirect-to-https,https-only"`
 	OriginPath     *string   `templateName:"origin-path"`
-	PriceClass     *string   `templateName:"price-class"`
+	PriceClass     *string   `templateName:"price-class" enum:"PriceClass_All,PriceClass_100,PriceClass_200"`
 	MinTtl         *int64    `templateName:"min-ttl"`
 }
 
 func (cmd *UpdateDistribution) ParamsSpec() params.Spec {
 	return params.NewSpec(params.AllOf(params.Key("id"),
-		params.Opt("certificate", "comment", "default-file", "domain-aliases", "enable", "forward-cookies", "forward-queries", "https-behaviour", "min-ttl", "origin-domain", "origin-path", "price-class"),
-	))
+		params.Opt("certificate", "comment", "default-file", "domain-aliases", "enable", "forward-cookies", "forward-queries", "https-behaviour", "min-ttl", "origin-domain", "origin-path", "origin-protocol", "price-class"),
+	),
+		params.Validators{
+			"origin-protocol": params.IsInEnumIgnoreCase(originProtocolPolicies...),
+		})
 }
 
 func (cmd *UpdateDistribution) ManualRun(renv env.Running) (interface{}, error) {
@@ -245,7 +253,7 @@ func (cmd *UpdateDistribution) ManualRun(renv env.Running) (interface{}, error)
 			return nil, err
 		}
 	}
-	if cmd.OriginDomain != nil || cmd.OriginPath != nil {
+	if cmd.OriginDomain != nil || cmd.OriginPath != nil || cmd.OriginProtocol != nil {
 		if configToUpdate.Origins == nil || len(configToUpdate.Origins.Items) == 0 {
 			configToUpdate.Origins = &cloudfront.Origins{
 				Quantity: aws.Int64(1),
@@ -258,10 +266,8 @@ func (cmd *UpdateDistribution) ManualRun(renv env.Running) (interface{}, error)
 			if err = setFieldWithType(cmd.OriginDomain, input, "DistributionConfig.Origins.Items[0].DomainName", awsstr); err != nil {
 				return nil, err
 			}
-			if domain := aws.StringValue(input.DistributionConfig.Origins.Items[0].DomainName); strings.HasSuffix(domain, ".s3.amazonaws.com") || (strings.HasSuffix(domain, ".amazonaws.com") && strings.Contains(domain, ".s3-website-")) {
-				input.DistributionConfig.Origins.Items[0].S3OriginConfig = &cloudfront.S3OriginConfig{OriginAccessIdentity: aws.String("")}
-			}
 		}
+		setOriginConfig(input.DistributionConfig.Origins.Items[0], cmd.OriginProtocol)
 
 		if cmd.OriginPath != nil {
 			if err = setFieldWithType(cmd.OriginPath, input, "DistributionConfig.Origins.Items[0].OriginPath", awsstr); err != nil {
@@ -407,3 +413,33 @@ func (cmd *DeleteDistribution) ManualRun(renv env.Running) (interface{}, error)
 	cmd.logger.ExtraVerbosef("cloudfront.DeleteDistribution call took %s", time.Since(start))
 	return output, err
 }
+
+var (
+	originProtocolPolicies = []string{cloudfront.OriginProtocolPolicyHttpOnly, cloudfront.OriginProtocolPolicyHttpsOnly, cloudfront.OriginProtocolPolicyMatchViewer}
+
+	// S3 REST endpoints of buckets (ex: my-bucket.s3.amazonaws.com, my-bucket.s3.eu-west-1.amazonaws.com), website endpoints excluded
+	s3BucketDomainRegex = regexp.MustCompile(`\.s3([.-][a-z0-9-]+)?\.amazonaws\.com(\.cn)?$`)
+)
+
+// setOriginConfig configures the origin as an S3 bucket origin, or as a custom origin (ex: load balancer, S3 website
+// endpoint or any web server) reached with the given protocol policy, defaulting to http-only
+func setOriginConfig(origin *cloudfront.Origin, protocol *string) {
+	if domain := aws.StringValue(origin.DomainName); s3BucketDomainRegex.MatchString(domain) && !strings.Contains(domain, ".s3-website") {
+		if origin.S3OriginConfig == nil {
+			origin.S3OriginConfig = &cloudfront.S3OriginConfig{OriginAccessIdentity: aws.String("")}
+		}
+		origin.CustomOriginConfig = nil
+		return
+	}
+	origin.S3OriginConfig = nil
+	if origin.CustomOriginConfig == nil {
+		origin.CustomOriginConfig = &cloudfront.CustomOriginConfig{
+			HTTPPort:             aws.Int64(80),
+			HTTPSPort:            aws.Int64(443),
+			OriginProtocolPolicy: aws.String(cloudfront.OriginProtocolPolicyHttpOnly),
+		}
+	}
+	if protocol != nil {
+		origin.CustomOriginConfig.OriginProtocolPolicy = aws.String(strings.ToLower(StringValue(protocol)))
+	}
+}
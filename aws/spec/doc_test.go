@@ -1,6 +1,8 @@
 package awsspec
 
 import (
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/wallix/awless/aws/doc"
@@ -9,14 +11,14 @@ import (
 
 func TestDocForEachCommand(t *testing.T) {
 	t.Skip()
-	for name, def := range AWSTemplatesDefinitions {
+	for name, def := range AWSTemplatesDefinitions() {
 		if doc := awsdoc.AwlessExamplesDoc(def.Action, def.Entity); len(doc) == 0 {
 			t.Errorf("missing awless CLI examples for template '%s'", name)
 		}
 	}
 }
 func TestDocForEachParam(t *testing.T) {
-	for name, def := range AWSTemplatesDefinitions {
+	for name, def := range AWSTemplatesDefinitions() {
 		params, opts, _ := params.List(def.Params)
 		for _, param := range append(params, opts...) {
 			if doc, ok := awsdoc.TemplateParamsDoc(def.Action, def.Entity, param); !ok || doc == "" {
@@ -25,3 +27,37 @@ func TestDocForEachParam(t *testing.T) {
 		}
 	}
 }
+
+func TestEnumsAndParamTypesDocFromSpec(t *testing.T) {
+	var enums, types int
+	for name, def := range AWSTemplatesDefinitions() {
+		cmd := reflect.ValueOf(MockAWSSessionFactory.Build(name)()).Elem().Type()
+		for i := 0; i < cmd.NumField(); i++ {
+			field := cmd.Field(i)
+			param, ok := field.Tag.Lookup("templateName")
+			if !ok {
+				continue
+			}
+			key := strings.Join([]string{def.Action, def.Entity, param}, ".")
+			if enum, ok := field.Tag.Lookup("enum"); ok {
+				enums++
+				if got, want := strings.Join(awsdoc.EnumDoc[key], ","), enum; got != want {
+					t.Errorf("%s: enum doc: got %q, want %q (regenerate the doc)", key, got, want)
+				}
+			}
+			if ref, ok := field.Tag.Lookup("ref"); ok {
+				types++
+				typ, ok := awsdoc.ParamTypeDoc[key]
+				if !ok || typ.ResourceType+"."+typ.PropertyName != ref {
+					t.Errorf("%s: param type doc: got %v, want %s (regenerate the doc)", key, typ, ref)
+				}
+			}
+		}
+	}
+	if got, want := len(awsdoc.EnumDoc), enums; got != want {
+		t.Errorf("enum doc: got %d, want %d", got, want)
+	}
+	if got, want := len(awsdoc.ParamTypeDoc), types; got != want {
+		t.Errorf("param type doc: got %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53/route53iface"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/aws/aws-sdk-go/service/ses/sesiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+const sesVerificationRecordTTL = 1800
+
+// Route53API returns the DNS API used to publish the records
+// needed by commands of other services (ex: SES domain verification)
+var Route53API = func() (route53iface.Route53API, error) {
+	factory, ok := CommandFactory.(*AWSFactory)
+	if !ok || factory.Sess == nil {
+		return nil, errors.New("no AWS session available")
+	}
+	return route53.New(factory.Sess), nil
+}
+
+// Verifying a domain requires a TXT record (and CNAME records for DKIM) in the domain DNS zone.
+// They are upserted when the zone is hosted in Route53, otherwise they are only displayed.
+type VerifyDomain struct {
+	_      string `action:"verify" entity:"domain" awsAPI:"ses"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    sesiface.SESAPI
+	Name   *string `templateName:"name"`
+	Dkim   *bool   `templateName:"dkim" enum:"true,false"`
+	Zone   *string `templateName:"zone"`
+}
+
+func (cmd *VerifyDomain) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name"), params.Opt("dkim", "zone")))
+}
+
+func (cmd *VerifyDomain) ManualRun(renv env.Running) (interface{}, error) {
+	domain := strings.ToLower(strings.TrimRight(StringValue(cmd.Name), "."))
+
+	start := time.Now()
+	output, err := cmd.api.VerifyDomainIdentity(&ses.VerifyDomainIdentityInput{Domain: String(domain)})
+	cmd.logger.ExtraVerbosef("ses.VerifyDomainIdentity call took %s", time.Since(start))
+	if err != nil {
+		return nil, err
+	}
+	records := []*route53.ResourceRecordSet{
+		sesVerificationRecord("_amazonses."+domain, route53.RRTypeTxt, strconv.Quote(StringValue(output.VerificationToken))),
+	}
+
+	if cmd.Dkim == nil || BoolValue(cmd.Dkim) {
+		start = time.Now()
+		dkim, err := cmd.api.VerifyDomainDkim(&ses.VerifyDomainDkimInput{Domain: String(domain)})
+		cmd.logger.ExtraVerbosef("ses.VerifyDomainDkim call took %s", time.Since(start))
+		if err != nil {
+			return nil, err
+		}
+		for _, token := range dkim.DkimTokens {
+			records = append(records, sesVerificationRecord(StringValue(token)+"._domainkey."+domain, route53.RRTypeCname, StringValue(token)+".dkim.amazonses.com"))
+		}
+	}
+
+	api, err := Route53API()
+	if err != nil {
+		return nil, err
+	}
+	zone, err := cmd.hostedZone(api, domain)
+	if err != nil {
+		return nil, err
+	}
+	if zone == "" {
+		cmd.logger.Warningf("no hosted zone found for '%s': add the following records to the domain DNS zone to complete its verification", domain)
+		for _, r := range records {
+			cmd.logger.Warningf("\t%s %s %s", StringValue(r.Name), StringValue(r.Type), StringValue(r.ResourceRecords[0].Value))
+		}
+		return output, nil
+	}
+
+	var changes []*route53.Change
+	for _, r := range records {
+		changes = append(changes, &route53.Change{Action: String(route53.ChangeActionUpsert), ResourceRecordSet: r})
+	}
+	start = time.Now()
+	_, err = api.ChangeResourceRecordSets(&route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: String(zone),
+		ChangeBatch:  &route53.ChangeBatch{Changes: changes, Comment: String(fmt.Sprintf("SES verification of %s", domain))},
+	})
+	cmd.logger.ExtraVerbosef("route53.ChangeResourceRecordSets call took %s", time.Since(start))
+	if err != nil {
+		return nil, err
+	}
+	cmd.logger.Infof("%d verification records of '%s' upserted in hosted zone %s", len(records), domain, zone)
+
+	return output, nil
+}
+
+func (cmd *VerifyDomain) ExtractResult(i interface{}) string {
+	return strings.ToLower(strings.TrimRight(StringValue(cmd.Name), "."))
+}
+
+// hostedZone returns the given zone or the public hosted zone of the account
+// closest to the domain (i.e. the domain itself or its nearest parent). It returns an empty ID when none is found
+func (cmd *VerifyDomain) hostedZone(api route53iface.Route53API, domain string) (string, error) {
+	if cmd.Zone != nil {
+		zone, _, err := resolveRecordZone(api, StringValue(cmd.Zone))
+		return zone, err
+	}
+	labels := strings.Split(domain, ".")
+	for i := 0; i < len(labels)-1; i++ {
+		zoneName := strings.Join(labels[i:], ".") + "."
+		out, err := api.ListHostedZonesByName(&route53.ListHostedZonesByNameInput{DNSName: String(zoneName)})
+		if err != nil {
+			return "", err
+		}
+		for _, z := range out.HostedZones {
+			if strings.ToLower(StringValue(z.Name)) != zoneName || (z.Config != nil && BoolValue(z.Config.PrivateZone)) {
+				continue
+			}
+			return StringValue(z.Id), nil
+		}
+	}
+	return "", nil
+}
+
+func sesVerificationRecord(name, recordType, value string) *route53.ResourceRecordSet {
+	return &route53.ResourceRecordSet{
+		Name:            String(name),
+		Type:            String(recordType),
+		TTL:             Int64(sesVerificationRecordTTL),
+		ResourceRecords: []*route53.ResourceRecord{{Value: String(value)}},
+	}
+}
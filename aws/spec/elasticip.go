@@ -16,8 +16,6 @@ limitations under the License.
 package awsspec
 
 import (
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/logger"
@@ -25,21 +23,17 @@ import (
 )
 
 type CreateElasticip struct {
-	_      string `action:"create" entity:"elasticip" awsAPI:"ec2" awsCall:"AllocateAddress" awsInput:"ec2.AllocateAddressInput" awsOutput:"ec2.AllocateAddressOutput" awsDryRun:""`
+	_      string `action:"create" entity:"elasticip" awsAPI:"ec2" awsCall:"AllocateAddress" awsInput:"ec2.AllocateAddressInput" awsOutput:"ec2.AllocateAddressOutput" awsDryRun:"" awsOutputExtract:"AllocationId"`
 	logger *logger.Logger
 	graph  cloud.GraphAPI
 	api    ec2iface.EC2API
-	Domain *string `awsName:"Domain" awsType:"awsstr" templateName:"domain"`
+	Domain *string `awsName:"Domain" awsType:"awsstr" templateName:"domain" enum:"vpc,ec2-classic"`
 }
 
 func (cmd *CreateElasticip) ParamsSpec() params.Spec {
 	return params.NewSpec(params.AllOf(params.Key("domain")))
 }
 
-func (cmd *CreateElasticip) ExtractResult(i interface{}) string {
-	return awssdk.StringValue(i.(*ec2.AllocateAddressOutput).AllocationId)
-}
-
 type DeleteElasticip struct {
 	_      string `action:"delete" entity:"elasticip" awsAPI:"ec2" awsCall:"ReleaseAddress" awsInput:"ec2.ReleaseAddressInput" awsOutput:"ec2.ReleaseAddressOutput" awsDryRun:""`
 	logger *logger.Logger
@@ -57,7 +51,7 @@ func (cmd *DeleteElasticip) ParamsSpec() params.Spec {
 }
 
 type AttachElasticip struct {
-	_                  string `action:"attach" entity:"elasticip" awsAPI:"ec2" awsCall:"AssociateAddress" awsInput:"ec2.AssociateAddressInput" awsOutput:"ec2.AssociateAddressOutput" awsDryRun:""`
+	_                  string `action:"attach" entity:"elasticip" awsAPI:"ec2" awsCall:"AssociateAddress" awsInput:"ec2.AssociateAddressInput" awsOutput:"ec2.AssociateAddressOutput" awsDryRun:"" awsOutputExtract:"AssociationId"`
 	logger             *logger.Logger
 	graph              cloud.GraphAPI
 	api                ec2iface.EC2API
@@ -75,10 +69,6 @@ func (cmd *AttachElasticip) ParamsSpec() params.Spec {
 	)
 }
 
-func (cmd *AttachElasticip) ExtractResult(i interface{}) string {
-	return awssdk.StringValue(i.(*ec2.AssociateAddressOutput).AssociationId)
-}
-
 type DetachElasticip struct {
 	_           string `action:"detach" entity:"elasticip" awsAPI:"ec2" awsCall:"DisassociateAddress" awsInput:"ec2.DisassociateAddressInput" awsOutput:"ec2.DisassociateAddressOutput" awsDryRun:""`
 	logger      *logger.Logger
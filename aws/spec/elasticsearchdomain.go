@@ -0,0 +1,212 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/aws/aws-sdk-go/service/elasticsearchservice/elasticsearchserviceiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+var elasticsearchVolumeTypes = []string{"standard", "gp2", "io1"}
+
+type CreateElasticsearchdomain struct {
+	_            string `action:"create" entity:"elasticsearchdomain" awsAPI:"elasticsearchservice"`
+	logger       *logger.Logger
+	graph        cloud.GraphAPI
+	api          elasticsearchserviceiface.ElasticsearchServiceAPI
+	Name         *string `templateName:"name"`
+	Version      *string `templateName:"version" enum:"1.5,2.3,5.1,5.3,5.5,6.0"`
+	Type         *string `templateName:"type" enum:"t2.small.elasticsearch,t2.medium.elasticsearch,m4.large.elasticsearch,m4.xlarge.elasticsearch,c4.large.elasticsearch,r4.large.elasticsearch,i3.large.elasticsearch"`
+	Count        *int64  `templateName:"count"`
+	EbsSize      *int64  `templateName:"ebs-size"`
+	EbsType      *string `templateName:"ebs-type" enum:"standard,gp2,io1"`
+	AccessPolicy *string `templateName:"access-policy"`
+}
+
+func (cmd *CreateElasticsearchdomain) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("name"),
+			params.Opt(params.Suggested("type", "count", "ebs-size"), "access-policy", "ebs-type", "version"),
+		),
+		params.Validators{
+			"ebs-type":      params.IsInEnumIgnoreCase(elasticsearchVolumeTypes...),
+			"access-policy": isJSONPolicy,
+		},
+	)
+}
+
+func (cmd *CreateElasticsearchdomain) ManualRun(renv env.Running) (interface{}, error) {
+	input := &elasticsearchservice.CreateElasticsearchDomainInput{
+		DomainName:           cmd.Name,
+		ElasticsearchVersion: cmd.Version,
+		AccessPolicies:       cmd.AccessPolicy,
+	}
+	input.ElasticsearchClusterConfig = elasticsearchClusterConfig(cmd.Type, cmd.Count)
+	input.EBSOptions = elasticsearchEBSOptions(cmd.EbsSize, cmd.EbsType)
+
+	start := time.Now()
+	output, err := cmd.api.CreateElasticsearchDomain(input)
+	cmd.logger.ExtraVerbosef("elasticsearchservice.CreateElasticsearchDomain call took %s", time.Since(start))
+	return output, err
+}
+
+func (cmd *CreateElasticsearchdomain) ExtractResult(i interface{}) string {
+	return StringValue(i.(*elasticsearchservice.CreateElasticsearchDomainOutput).DomainStatus.DomainName)
+}
+
+type UpdateElasticsearchdomain struct {
+	_            string `action:"update" entity:"elasticsearchdomain" awsAPI:"elasticsearchservice"`
+	logger       *logger.Logger
+	graph        cloud.GraphAPI
+	api          elasticsearchserviceiface.ElasticsearchServiceAPI
+	Name         *string `templateName:"name"`
+	Type         *string `templateName:"type"`
+	Count        *int64  `templateName:"count"`
+	EbsSize      *int64  `templateName:"ebs-size"`
+	EbsType      *string `templateName:"ebs-type" enum:"standard,gp2,io1"`
+	AccessPolicy *string `templateName:"access-policy"`
+}
+
+func (cmd *UpdateElasticsearchdomain) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("name"),
+			params.AtLeastOneOf(params.Key("access-policy"), params.Key("count"), params.Key("ebs-size"), params.Key("ebs-type"), params.Key("type")),
+		),
+		params.Validators{
+			"ebs-type":      params.IsInEnumIgnoreCase(elasticsearchVolumeTypes...),
+			"access-policy": isJSONPolicy,
+		},
+	)
+}
+
+func (cmd *UpdateElasticsearchdomain) ManualRun(renv env.Running) (interface{}, error) {
+	input := &elasticsearchservice.UpdateElasticsearchDomainConfigInput{
+		DomainName:     cmd.Name,
+		AccessPolicies: cmd.AccessPolicy,
+	}
+	input.ElasticsearchClusterConfig = elasticsearchClusterConfig(cmd.Type, cmd.Count)
+	input.EBSOptions = elasticsearchEBSOptions(cmd.EbsSize, cmd.EbsType)
+
+	start := time.Now()
+	output, err := cmd.api.UpdateElasticsearchDomainConfig(input)
+	cmd.logger.ExtraVerbosef("elasticsearchservice.UpdateElasticsearchDomainConfig call took %s", time.Since(start))
+	return output, err
+}
+
+type DeleteElasticsearchdomain struct {
+	_      string `action:"delete" entity:"elasticsearchdomain" awsAPI:"elasticsearchservice" awsCall:"DeleteElasticsearchDomain" awsInput:"elasticsearchservice.DeleteElasticsearchDomainInput" awsOutput:"elasticsearchservice.DeleteElasticsearchDomainOutput"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    elasticsearchserviceiface.ElasticsearchServiceAPI
+	Name   *string `awsName:"DomainName" awsType:"awsstr" templateName:"name"`
+}
+
+func (cmd *DeleteElasticsearchdomain) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name")))
+}
+
+// A domain stays in processing state while it is created or its configuration changes,
+// its endpoint being only available once active
+type CheckElasticsearchdomain struct {
+	_       string `action:"check" entity:"elasticsearchdomain" awsAPI:"elasticsearchservice"`
+	logger  *logger.Logger
+	graph   cloud.GraphAPI
+	api     elasticsearchserviceiface.ElasticsearchServiceAPI
+	Name    *string `templateName:"name"`
+	State   *string `templateName:"state" enum:"active,processing,deleting,not-found"`
+	Timeout *int64  `templateName:"timeout" enum:"10,60,180,300,600,900"`
+}
+
+func (cmd *CheckElasticsearchdomain) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("name"), params.Key("state"), params.Key("timeout")),
+		params.Validators{
+			"state": params.IsInEnumIgnoreCase("active", "processing", "deleting", notFoundState),
+		},
+	)
+}
+
+func (cmd *CheckElasticsearchdomain) ManualRun(renv env.Running) (interface{}, error) {
+	input := &elasticsearchservice.DescribeElasticsearchDomainInput{
+		DomainName: cmd.Name,
+	}
+
+	c := &checker{
+		description: fmt.Sprintf("elasticsearchdomain %s", StringValue(cmd.Name)),
+		timeout:     time.Duration(Int64AsIntValue(cmd.Timeout)) * time.Second,
+		frequency:   10 * time.Second,
+		fetchFunc: func() (string, error) {
+			output, err := cmd.api.DescribeElasticsearchDomain(input)
+			if err != nil {
+				if awserr, ok := err.(awserr.Error); ok {
+					if awserr.Code() == elasticsearchservice.ErrCodeResourceNotFoundException {
+						return notFoundState, nil
+					}
+				}
+				return "", err
+			}
+			return elasticsearchDomainState(output.DomainStatus), nil
+		},
+		expect: StringValue(cmd.State),
+		logger: cmd.logger,
+	}
+	return nil, c.check()
+}
+
+func elasticsearchDomainState(status *elasticsearchservice.ElasticsearchDomainStatus) string {
+	switch {
+	case status == nil:
+		return notFoundState
+	case BoolValue(status.Deleted):
+		return "deleting"
+	case BoolValue(status.Processing) || !BoolValue(status.Created):
+		return "processing"
+	default:
+		return "active"
+	}
+}
+
+func elasticsearchClusterConfig(instanceType *string, count *int64) *elasticsearchservice.ElasticsearchClusterConfig {
+	if instanceType == nil && count == nil {
+		return nil
+	}
+	return &elasticsearchservice.ElasticsearchClusterConfig{InstanceType: instanceType, InstanceCount: count}
+}
+
+// EBS storage is mandatory for instance types without instance storage (ex: t2, m4, c4, r4)
+func elasticsearchEBSOptions(size *int64, volumeType *string) *elasticsearchservice.EBSOptions {
+	if size == nil && volumeType == nil {
+		return nil
+	}
+	return &elasticsearchservice.EBSOptions{EBSEnabled: Bool(true), VolumeSize: size, VolumeType: volumeType}
+}
+
+func isJSONPolicy(i interface{}, others map[string]interface{}) error {
+	if !json.Valid([]byte(fmt.Sprint(i))) {
+		return fmt.Errorf("invalid JSON policy: %s", i)
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"github.com/aws/aws-sdk-go/service/ses/sesiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/params"
+)
+
+// SES sends a confirmation link to the email address, which stays pending until clicked
+type VerifyEmail struct {
+	_       string `action:"verify" entity:"email" awsAPI:"ses" awsCall:"VerifyEmailIdentity" awsInput:"ses.VerifyEmailIdentityInput" awsOutput:"ses.VerifyEmailIdentityOutput"`
+	logger  *logger.Logger
+	graph   cloud.GraphAPI
+	api     sesiface.SESAPI
+	Address *string `awsName:"EmailAddress" awsType:"awsstr" templateName:"address"`
+}
+
+func (cmd *VerifyEmail) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("address")))
+}
+
+func (cmd *VerifyEmail) ExtractResult(i interface{}) string {
+	return StringValue(cmd.Address)
+}
@@ -0,0 +1,233 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk/elasticbeanstalkiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+var environmentTiers = map[string]*elasticbeanstalk.EnvironmentTier{
+	"webserver": {Name: String("WebServer"), Type: String("Standard")},
+	"worker":    {Name: String("Worker"), Type: String("SQS/HTTP")},
+}
+
+const environmentLaunchNamespace = "aws:autoscaling:launchconfiguration"
+
+type CreateEnvironment struct {
+	_             string `action:"create" entity:"environment" awsAPI:"elasticbeanstalk"`
+	logger        *logger.Logger
+	graph         cloud.GraphAPI
+	api           elasticbeanstalkiface.ElasticBeanstalkAPI
+	Application   *string `templateName:"application"`
+	Name          *string `templateName:"name"`
+	SolutionStack *string `templateName:"solution-stack"`
+	Template      *string `templateName:"template"`
+	Version       *string `templateName:"version"`
+	Cname         *string `templateName:"cname"`
+	Description   *string `templateName:"description"`
+	Tier          *string `templateName:"tier" enum:"webserver,worker"`
+	InstanceType  *string `templateName:"instance-type" enum:"t2.nano,t2.micro,t2.small,t2.medium,t2.large,t2.xlarge,t2.2xlarge,m4.large,m4.xlarge,c4.large,c4.xlarge"`
+	Role          *string `templateName:"role"`
+	Keypair       *string `templateName:"keypair"`
+}
+
+func (cmd *CreateEnvironment) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("application"), params.Key("name"),
+			params.OnlyOneOf(params.Key("solution-stack"), params.Key("template")),
+			params.Opt(params.Suggested("version", "cname"), "description", "tier", "instance-type", "role", "keypair"),
+		),
+		params.Validators{
+			"tier": params.IsInEnumIgnoreCase("webserver", "worker"),
+		},
+	)
+}
+
+func (cmd *CreateEnvironment) ManualRun(renv env.Running) (interface{}, error) {
+	input := &elasticbeanstalk.CreateEnvironmentInput{
+		ApplicationName:   cmd.Application,
+		EnvironmentName:   cmd.Name,
+		SolutionStackName: cmd.SolutionStack,
+		TemplateName:      cmd.Template,
+		VersionLabel:      cmd.Version,
+		CNAMEPrefix:       cmd.Cname,
+		Description:       cmd.Description,
+	}
+	if cmd.Tier != nil {
+		input.Tier = environmentTiers[strings.ToLower(StringValue(cmd.Tier))]
+	}
+	launchOptions := []struct {
+		name  string
+		value *string
+	}{{"InstanceType", cmd.InstanceType}, {"IamInstanceProfile", cmd.Role}, {"EC2KeyName", cmd.Keypair}}
+	for _, opt := range launchOptions {
+		if opt.value != nil {
+			input.OptionSettings = append(input.OptionSettings, &elasticbeanstalk.ConfigurationOptionSetting{
+				Namespace: String(environmentLaunchNamespace), OptionName: String(opt.name), Value: opt.value,
+			})
+		}
+	}
+
+	start := time.Now()
+	output, err := cmd.api.CreateEnvironment(input)
+	cmd.logger.ExtraVerbosef("elasticbeanstalk.CreateEnvironment call took %s", time.Since(start))
+	return output, err
+}
+
+func (cmd *CreateEnvironment) ExtractResult(i interface{}) string {
+	return StringValue(i.(*elasticbeanstalk.EnvironmentDescription).EnvironmentId)
+}
+
+// Deploys a version or changes the platform of the environment, and/or swaps its CNAME
+// with the one of another environment (blue/green deployments)
+type UpdateEnvironment struct {
+	_             string `action:"update" entity:"environment" awsAPI:"elasticbeanstalk"`
+	logger        *logger.Logger
+	graph         cloud.GraphAPI
+	api           elasticbeanstalkiface.ElasticBeanstalkAPI
+	Id            *string `templateName:"id"`
+	Version       *string `templateName:"version"`
+	SolutionStack *string `templateName:"solution-stack"`
+	Description   *string `templateName:"description"`
+	SwapCname     *string `templateName:"swap-cname"`
+}
+
+func (cmd *UpdateEnvironment) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id"),
+		params.AtLeastOneOf(params.Key("version"), params.Key("solution-stack"), params.Key("description"), params.Key("swap-cname")),
+	))
+}
+
+func (cmd *UpdateEnvironment) ManualRun(renv env.Running) (interface{}, error) {
+	if cmd.Version != nil || cmd.SolutionStack != nil || cmd.Description != nil {
+		start := time.Now()
+		_, err := cmd.api.UpdateEnvironment(&elasticbeanstalk.UpdateEnvironmentInput{
+			EnvironmentId:     cmd.Id,
+			VersionLabel:      cmd.Version,
+			SolutionStackName: cmd.SolutionStack,
+			Description:       cmd.Description,
+		})
+		cmd.logger.ExtraVerbosef("elasticbeanstalk.UpdateEnvironment call took %s", time.Since(start))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if cmd.SwapCname != nil {
+		start := time.Now()
+		_, err := cmd.api.SwapEnvironmentCNAMEs(&elasticbeanstalk.SwapEnvironmentCNAMEsInput{
+			SourceEnvironmentId:      cmd.Id,
+			DestinationEnvironmentId: cmd.SwapCname,
+		})
+		cmd.logger.ExtraVerbosef("elasticbeanstalk.SwapEnvironmentCNAMEs call took %s", time.Since(start))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return cmd.Id, nil
+}
+
+func (cmd *UpdateEnvironment) ExtractResult(i interface{}) string {
+	return StringValue(i.(*string))
+}
+
+type DeleteEnvironment struct {
+	_      string `action:"delete" entity:"environment" awsAPI:"elasticbeanstalk" awsCall:"TerminateEnvironment" awsInput:"elasticbeanstalk.TerminateEnvironmentInput" awsOutput:"elasticbeanstalk.EnvironmentDescription"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    elasticbeanstalkiface.ElasticBeanstalkAPI
+	Id     *string `awsName:"EnvironmentId" awsType:"awsstr" templateName:"id"`
+	Force  *bool   `awsName:"ForceTerminate" awsType:"awsbool" templateName:"force"`
+}
+
+func (cmd *DeleteEnvironment) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id"), params.Opt("force")))
+}
+
+// Waits for the status (ex: ready) and/or the health color (ex: green) of an environment
+type CheckEnvironment struct {
+	_       string `action:"check" entity:"environment" awsAPI:"elasticbeanstalk"`
+	logger  *logger.Logger
+	graph   cloud.GraphAPI
+	api     elasticbeanstalkiface.ElasticBeanstalkAPI
+	Id      *string `templateName:"id"`
+	State   *string `templateName:"state" enum:"Launching,Updating,Ready,Terminating,Terminated,not-found"`
+	Health  *string `templateName:"health" enum:"Green,Yellow,Red,Grey"`
+	Timeout *int64  `templateName:"timeout" enum:"10,60,180,300,600,900"`
+}
+
+func (cmd *CheckEnvironment) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("id"), params.Key("timeout"), params.AtLeastOneOf(params.Key("state"), params.Key("health"))),
+		params.Validators{
+			"state": params.IsInEnumIgnoreCase(elasticbeanstalk.EnvironmentStatusLaunching, elasticbeanstalk.EnvironmentStatusUpdating,
+				elasticbeanstalk.EnvironmentStatusReady, elasticbeanstalk.EnvironmentStatusTerminating, elasticbeanstalk.EnvironmentStatusTerminated, notFoundState),
+			"health": params.IsInEnumIgnoreCase(elasticbeanstalk.EnvironmentHealthGreen, elasticbeanstalk.EnvironmentHealthYellow,
+				elasticbeanstalk.EnvironmentHealthRed, elasticbeanstalk.EnvironmentHealthGrey),
+		},
+	)
+}
+
+func (cmd *CheckEnvironment) ManualRun(renv env.Running) (interface{}, error) {
+	input := &elasticbeanstalk.DescribeEnvironmentsInput{
+		EnvironmentIds: []*string{cmd.Id},
+	}
+
+	var expected, checkName []string
+	if cmd.State != nil {
+		expected, checkName = append(expected, StringValue(cmd.State)), append(checkName, "status")
+	}
+	if cmd.Health != nil {
+		expected, checkName = append(expected, StringValue(cmd.Health)), append(checkName, "health")
+	}
+
+	c := &checker{
+		description: fmt.Sprintf("environment %s", StringValue(cmd.Id)),
+		timeout:     time.Duration(Int64AsIntValue(cmd.Timeout)) * time.Second,
+		frequency:   10 * time.Second,
+		fetchFunc: func() (string, error) {
+			output, err := cmd.api.DescribeEnvironments(input)
+			if err != nil {
+				return "", err
+			}
+			if len(output.Environments) == 0 {
+				return notFoundState, nil
+			}
+			environment := output.Environments[0]
+			var got []string
+			if cmd.State != nil {
+				got = append(got, StringValue(environment.Status))
+			}
+			if cmd.Health != nil {
+				got = append(got, StringValue(environment.Health))
+			}
+			return strings.Join(got, "/"), nil
+		},
+		expect:    strings.Join(expected, "/"),
+		logger:    cmd.logger,
+		checkName: strings.Join(checkName, "/"),
+	}
+	return nil, c.check()
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// iamServicePrefixes maps the awless API names to their IAM action prefix, when they differ
+var iamServicePrefixes = map[string]string{
+	"elbv2":                  "elasticloadbalancing",
+	"elb":                    "elasticloadbalancing",
+	"applicationautoscaling": "application-autoscaling",
+}
+
+var notAuthorizedActionRegex = regexp.MustCompile(`not authorized to perform:? ([a-zA-Z0-9-]+:[a-zA-Z0-9]+)`)
+
+type errorHint func(call string, aerr awserr.Error) string
+
+var awsErrorHints = []errorHint{
+	func(call string, aerr awserr.Error) string {
+		switch aerr.Code() {
+		case "UnauthorizedOperation", "AccessDenied", "AccessDeniedException", "AuthorizationError":
+			action := iamAction(call)
+			if matches := notAuthorizedActionRegex.FindStringSubmatch(aerr.Message()); len(matches) > 1 {
+				action = matches[1]
+			}
+			if action == "" {
+				return "your credentials lack the IAM permission to perform this operation"
+			}
+			return fmt.Sprintf("your credentials lack the IAM permission '%s'", action)
+		}
+		return ""
+	},
+	func(call string, aerr awserr.Error) string {
+		msg := strings.ToLower(aerr.Message())
+		switch {
+		case strings.HasPrefix(aerr.Code(), "InvalidAMIID"),
+			aerr.Code() == "InvalidParameterValue" && (strings.Contains(msg, "ami") || strings.Contains(msg, "image")):
+			return "AMIs are specific to a region: check the image exists in the current region (see `awless ls images` or `awless search images`)"
+		case aerr.Code() == "InvalidKeyPair.NotFound":
+			return "keypairs are specific to a region: check the keypair exists in the current region (see `awless ls keypairs`)"
+		}
+		return ""
+	},
+	func(call string, aerr awserr.Error) string {
+		switch aerr.Code() {
+		case "AuthFailure", "InvalidClientTokenId", "UnrecognizedClientException", "SignatureDoesNotMatch":
+			return "check your AWS credentials are valid (see `awless whoami`)"
+		case "RequestExpired":
+			return "check the clock of your machine is synchronized"
+		case "OptInRequired":
+			return "your account is not subscribed to this service or region"
+		case "DependencyViolation":
+			return "the resource is still used by other resources: delete or detach them first (see `awless show` on the resource)"
+		case "InstanceLimitExceeded", "LimitExceeded", "VpcLimitExceeded", "AddressLimitExceeded":
+			return "you have reached a limit of your AWS account: release unused resources or ask AWS for a limit increase"
+		}
+		if strings.HasSuffix(aerr.Code(), ".NotFound") {
+			return "check the resource exists in the current region and has not been deleted"
+		}
+		return ""
+	},
+}
+
+// decorateAWSError formats an AWS error, adding a hint on how to fix it when one is known.
+// The call is the awless name of the failing API call (ex: ec2.RunInstances).
+func decorateAWSError(err error, call string) error {
+	aerr, ok := err.(awserr.Error)
+	if !ok {
+		return err
+	}
+	for _, hint := range awsErrorHints {
+		if h := hint(call, aerr); h != "" {
+			return fmt.Errorf("%s: %s\n\thint: %s", aerr.Code(), aerr.Message(), h)
+		}
+	}
+	return fmt.Errorf("%s: %s", aerr.Code(), aerr.Message())
+}
+
+// iamAction returns the IAM action of an awless API call (ex: elbv2.CreateListener -> elasticloadbalancing:CreateListener)
+func iamAction(call string) string {
+	splits := strings.SplitN(call, ".", 2)
+	if len(splits) != 2 {
+		return ""
+	}
+	api := splits[0]
+	if prefix, ok := iamServicePrefixes[api]; ok {
+		api = prefix
+	}
+	return api + ":" + splits[1]
+}
+
+// ErrorKind classifies errors returned by AWS, to report them distinctly (ex: exit codes)
+type ErrorKind int
+
+const (
+	OtherError ErrorKind = iota
+	PermissionDeniedError
+	ThrottlingError
+	TimeoutError
+)
+
+var (
+	permissionDeniedCodes = []string{"UnauthorizedOperation", "AccessDenied", "AccessDeniedException", "AuthorizationError"}
+	throttlingCodes       = []string{
+		"Throttling", "ThrottlingException", "ThrottledException", "RequestThrottledException", "TooManyRequestsException",
+		"ProvisionedThroughputExceededException", "RequestThrottled", "RequestLimitExceeded", "BandwidthLimitExceeded",
+		"SlowDown", "PriorRequestNotComplete", "EC2ThrottledException",
+	}
+	timeoutCodes = []string{"RequestTimeout", "RequestTimeoutException"}
+
+	// messages of the timeouts of awless itself (ex: check commands, command timeout= param)
+	timeoutMessageRegex = regexp.MustCompile(`timeout of .+ expired|timed out after|deadline exceeded|Client.Timeout exceeded`)
+)
+
+// ClassifyError returns the kind of an AWS error, given as is or
+// formatted with its code by decorateAWSError (ex: "AccessDenied: ...")
+func ClassifyError(err error) ErrorKind {
+	if err == nil {
+		return OtherError
+	}
+	msg := err.Error()
+	code := msg
+	if aerr, ok := err.(awserr.Error); ok {
+		code = aerr.Code()
+	}
+	hasCode := func(codes []string) bool {
+		for _, c := range codes {
+			if code == c || strings.HasPrefix(code, c+":") || strings.Contains(msg, " "+c+": ") {
+				return true
+			}
+		}
+		return false
+	}
+	switch {
+	case hasCode(permissionDeniedCodes):
+		return PermissionDeniedError
+	case hasCode(throttlingCodes):
+		return ThrottlingError
+	case hasCode(timeoutCodes), timeoutMessageRegex.MatchString(msg):
+		return TimeoutError
+	}
+	return OtherError
+}
@@ -0,0 +1,74 @@
+package awsspec
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+func TestDecorateAWSError(t *testing.T) {
+	tcases := []struct {
+		err  error
+		call string
+		exp  string
+	}{
+		{
+			err: errors.New("not an aws error"), call: "ec2.RunInstances",
+			exp: "not an aws error",
+		},
+		{
+			err: awserr.New("Throttling", "Rate exceeded", nil), call: "ec2.RunInstances",
+			exp: "Throttling: Rate exceeded",
+		},
+		{
+			err: awserr.New("UnauthorizedOperation", "You are not authorized to perform this operation.", nil), call: "ec2.RunInstances",
+			exp: "UnauthorizedOperation: You are not authorized to perform this operation.\n\thint: your credentials lack the IAM permission 'ec2:RunInstances'",
+		},
+		{
+			err: awserr.New("UnauthorizedOperation", "You are not authorized to perform this operation.", nil), call: "elbv2.CreateListener",
+			exp: "UnauthorizedOperation: You are not authorized to perform this operation.\n\thint: your credentials lack the IAM permission 'elasticloadbalancing:CreateListener'",
+		},
+		{
+			err: awserr.New("AccessDenied", "User: arn:aws:iam::0123:user/jdoe is not authorized to perform: iam:CreateUser on resource: arn:aws:iam::0123:user/john", nil), call: "iam.CreateUser",
+			exp: "AccessDenied: User: arn:aws:iam::0123:user/jdoe is not authorized to perform: iam:CreateUser on resource: arn:aws:iam::0123:user/john\n\thint: your credentials lack the IAM permission 'iam:CreateUser'",
+		},
+		{
+			err: awserr.New("InvalidParameterValue", "Invalid AMI ID: ami-1234", nil), call: "ec2.RunInstances",
+			exp: "InvalidParameterValue: Invalid AMI ID: ami-1234\n\thint: AMIs are specific to a region: check the image exists in the current region (see `awless ls images` or `awless search images`)",
+		},
+		{
+			err: awserr.New("DependencyViolation", "resource sg-1234 has a dependent object", nil), call: "ec2.DeleteSecurityGroup",
+			exp: "DependencyViolation: resource sg-1234 has a dependent object\n\thint: the resource is still used by other resources: delete or detach them first (see `awless show` on the resource)",
+		},
+	}
+	for i, tcase := range tcases {
+		if got, want := decorateAWSError(tcase.err, tcase.call).Error(), tcase.exp; got != want {
+			t.Fatalf("%d: got\n%s\nwant\n%s", i+1, got, want)
+		}
+	}
+}
+
+func TestClassifyError(t *testing.T) {
+	tcases := []struct {
+		err error
+		exp ErrorKind
+	}{
+		{err: nil, exp: OtherError},
+		{err: errors.New("not an aws error"), exp: OtherError},
+		{err: awserr.New("AccessDenied", "not authorized", nil), exp: PermissionDeniedError},
+		{err: decorateAWSError(awserr.New("UnauthorizedOperation", "not authorized", nil), "ec2.RunInstances"), exp: PermissionDeniedError},
+		{err: errors.New("create instance: UnauthorizedOperation: not authorized\n\tfailing statement: create instance"), exp: PermissionDeniedError},
+		{err: awserr.New("Throttling", "Rate exceeded", nil), exp: ThrottlingError},
+		{err: errors.New("delete subnet: RequestLimitExceeded: Request limit exceeded."), exp: ThrottlingError},
+		{err: awserr.New("RequestTimeout", "timeout", nil), exp: TimeoutError},
+		{err: errors.New("check instance: timeout of 3m0s expired"), exp: TimeoutError},
+		{err: errors.New("create instance: timed out after 30s"), exp: TimeoutError},
+		{err: decorateAWSError(awserr.New("DependencyViolation", "in use", nil), "ec2.DeleteVpc"), exp: OtherError},
+	}
+	for i, tcase := range tcases {
+		if got, want := ClassifyError(tcase.err), tcase.exp; got != want {
+			t.Fatalf("%d: got %d, want %d", i+1, got, want)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
+	"github.com/aws/aws-sdk-go/service/route53/route53iface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+// LoadbalancerAPIForRegion returns the load balancing API of the given region,
+// to look up load balancers living outside of the current region
+var LoadbalancerAPIForRegion = func(region string) (elbv2iface.ELBV2API, error) {
+	factory, ok := CommandFactory.(*AWSFactory)
+	if !ok || factory.Sess == nil {
+		return nil, errors.New("no AWS session available")
+	}
+	return elbv2.New(factory.Sess.Copy(&aws.Config{Region: String(region)})), nil
+}
+
+type CreateFailover struct {
+	_               string `action:"create" entity:"failover" awsAPI:"route53"`
+	logger          *logger.Logger
+	graph           cloud.GraphAPI
+	api             route53iface.Route53API
+	Zone            *string `templateName:"zone"`
+	Name            *string `templateName:"name"`
+	Primary         *string `templateName:"primary"`
+	PrimaryRegion   *string `templateName:"primary-region"`
+	Secondary       *string `templateName:"secondary"`
+	SecondaryRegion *string `templateName:"secondary-region"`
+	Protocol        *string `templateName:"protocol"`
+	Port            *int64  `templateName:"port"`
+	Path            *string `templateName:"path"`
+	Ttl             *int64  `templateName:"ttl"`
+}
+
+func (cmd *CreateFailover) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("zone"), params.Key("name"),
+			params.Key("primary"), params.Key("primary-region"),
+			params.Key("secondary"), params.Key("secondary-region"),
+			params.Opt("path", "port", "protocol", "ttl"),
+		),
+		params.Validators{
+			"name":             isRecordName,
+			"primary-region":   isRegion,
+			"secondary-region": isRegion,
+			"protocol":         params.IsInEnumIgnoreCase("HTTP", "HTTPS", "TCP"),
+		},
+	)
+}
+
+// ManualRun checks the health of the load balancers of both regions
+// and routes the DNS name to the secondary one when the primary is unhealthy,
+// through failover CNAME records (hence not available at the zone apex)
+func (cmd *CreateFailover) ManualRun(renv env.Running) (interface{}, error) {
+	if StringValue(cmd.Name) == zoneApex {
+		return nil, errors.New("failover records cannot be created at the zone apex: use a subdomain name")
+	}
+
+	protocol, port, ttl := "HTTP", int64(80), int64(60)
+	if cmd.Protocol != nil {
+		protocol = strings.ToUpper(StringValue(cmd.Protocol))
+	}
+	if cmd.Port != nil {
+		port = aws.Int64Value(cmd.Port)
+	}
+	if cmd.Ttl != nil {
+		ttl = aws.Int64Value(cmd.Ttl)
+	}
+
+	sites := []struct {
+		failover, loadbalancer, region string
+	}{
+		{"PRIMARY", StringValue(cmd.Primary), StringValue(cmd.PrimaryRegion)},
+		{"SECONDARY", StringValue(cmd.Secondary), StringValue(cmd.SecondaryRegion)},
+	}
+	for _, site := range sites {
+		dnsName, err := loadbalancerDNSName(site.loadbalancer, site.region)
+		if err != nil {
+			return nil, fmt.Errorf("%s load balancer: %s", strings.ToLower(site.failover), err)
+		}
+
+		healthcheckParams := map[string]interface{}{"protocol": protocol, "port": port, "host": dnsName}
+		if cmd.Path != nil {
+			healthcheckParams["path"] = StringValue(cmd.Path)
+		}
+		createHealthcheck := CommandFactory.Build("createhealthcheck")().(*CreateHealthcheck)
+		output, err := createHealthcheck.Run(renv, healthcheckParams)
+		if err != nil {
+			return nil, fmt.Errorf("create healthcheck: %s", err)
+		}
+		healthcheck := fmt.Sprint(output)
+		renv.Log().Infof("healthcheck %s created for %s load balancer %s (%s)", healthcheck, strings.ToLower(site.failover), site.loadbalancer, site.region)
+
+		createRecord := CommandFactory.Build("createrecord")().(*CreateRecord)
+		if _, err = createRecord.Run(renv, map[string]interface{}{
+			"zone":        StringValue(cmd.Zone),
+			"name":        StringValue(cmd.Name),
+			"type":        "CNAME",
+			"values":      []string{dnsName},
+			"ttl":         ttl,
+			"failover":    site.failover,
+			"healthcheck": healthcheck,
+		}); err != nil {
+			return nil, fmt.Errorf("create %s record: %s", strings.ToLower(site.failover), err)
+		}
+	}
+
+	return StringValue(cmd.Name), nil
+}
+
+func (cmd *CreateFailover) ExtractResult(i interface{}) string {
+	return i.(string)
+}
+
+func loadbalancerDNSName(loadbalancer, region string) (string, error) {
+	api, err := LoadbalancerAPIForRegion(region)
+	if err != nil {
+		return "", err
+	}
+	input := &elbv2.DescribeLoadBalancersInput{}
+	if strings.HasPrefix(loadbalancer, "arn:") {
+		input.LoadBalancerArns = []*string{String(loadbalancer)}
+	} else {
+		input.Names = []*string{String(loadbalancer)}
+	}
+	out, err := api.DescribeLoadBalancers(input)
+	if err != nil {
+		return "", err
+	}
+	if len(out.LoadBalancers) != 1 {
+		return "", fmt.Errorf("'%s' not found in region %s", loadbalancer, region)
+	}
+	return StringValue(out.LoadBalancers[0].DNSName), nil
+}
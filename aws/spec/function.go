@@ -16,23 +16,35 @@ limitations under the License.
 package awsspec
 
 import (
-	awssdk "github.com/aws/aws-sdk-go/aws"
+	"archive/zip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"time"
+
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
 	"github.com/wallix/awless/template/params"
 )
 
+var lambdaRuntimes = []string{
+	"nodejs", "nodejs4.3", "nodejs6.10", "nodejs8.10", "nodejs4.3-edge",
+	"java8", "python2.7", "python3.6", "dotnetcore1.0", "dotnetcore2.0", "go1.x",
+}
+
 type CreateFunction struct {
-	_             string `action:"create" entity:"function" awsAPI:"lambda" awsCall:"CreateFunction" awsInput:"lambda.CreateFunctionInput" awsOutput:"lambda.FunctionConfiguration"`
+	_             string `action:"create" entity:"function" awsAPI:"lambda" awsCall:"CreateFunction" awsInput:"lambda.CreateFunctionInput" awsOutput:"lambda.FunctionConfiguration" awsOutputExtract:"FunctionArn" awsDryRun:"manual"`
 	logger        *logger.Logger
 	graph         cloud.GraphAPI
 	api           lambdaiface.LambdaAPI
 	Name          *string `awsName:"FunctionName" awsType:"awsstr" templateName:"name"`
 	Handler       *string `awsName:"Handler" awsType:"awsstr" templateName:"handler"`
 	Role          *string `awsName:"Role" awsType:"awsstr" templateName:"role"`
-	Runtime       *string `awsName:"Runtime" awsType:"awsstr" templateName:"runtime"`
+	Runtime       *string `awsName:"Runtime" awsType:"awsstr" templateName:"runtime" enum:"nodejs,nodejs4.3,nodejs6.10,nodejs8.10,java8,python2.7,python3.6,dotnetcore1.0,dotnetcore2.0,go1.x,nodejs4.3-edge"`
 	Bucket        *string `awsName:"Code.S3Bucket" awsType:"awsstr" templateName:"bucket"`
 	Object        *string `awsName:"Code.S3Key" awsType:"awsstr" templateName:"object"`
 	Objectversion *string `awsName:"Code.S3ObjectVersion" awsType:"awsstr" templateName:"objectversion"`
@@ -46,11 +58,23 @@ type CreateFunction struct {
 func (cmd *CreateFunction) ParamsSpec() params.Spec {
 	return params.NewSpec(params.AllOf(params.Key("handler"), params.Key("name"), params.Key("role"), params.Key("runtime"),
 		params.Opt("bucket", "description", "memory", "object", "objectversion", "publish", "timeout", "zipfile"),
-	))
+	),
+		params.Validators{
+			"runtime": params.IsInEnumIgnoreCase(lambdaRuntimes...),
+			"zipfile": params.IsFilepath,
+		})
 }
 
-func (cmd *CreateFunction) ExtractResult(i interface{}) string {
-	return awssdk.StringValue(i.(*lambda.FunctionConfiguration).FunctionArn)
+// Lambda has no dry run on function creation: validate the deployment package locally
+func (cmd *CreateFunction) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("dry run: cannot set params on command struct: %s", err)
+	}
+	if err := checkFunctionCode(cmd.Zipfile, cmd.Bucket, cmd.Object); err != nil {
+		return nil, fmt.Errorf("dry run: %s", err)
+	}
+	renv.Log().Verbose("dry run: create function ok")
+	return fakeDryRunId("function"), nil
 }
 
 type DeleteFunction struct {
@@ -67,3 +91,213 @@ func (cmd *DeleteFunction) ParamsSpec() params.Spec {
 		params.Opt("version"),
 	))
 }
+
+// UpdateFunction updates the code (from a zip file or S3) and/or the configuration of a function
+type UpdateFunction struct {
+	_             string `action:"update" entity:"function" awsAPI:"lambda" awsDryRun:"manual"`
+	logger        *logger.Logger
+	graph         cloud.GraphAPI
+	api           lambdaiface.LambdaAPI
+	Id            *string `templateName:"id"`
+	Zipfile       *string `templateName:"zipfile"`
+	Bucket        *string `templateName:"bucket"`
+	Object        *string `templateName:"object"`
+	Objectversion *string `templateName:"objectversion"`
+	Publish       *bool   `templateName:"publish" enum:"true,false"`
+	Handler       *string `templateName:"handler"`
+	Role          *string `templateName:"role"`
+	Runtime       *string `templateName:"runtime" enum:"nodejs,nodejs4.3,nodejs6.10,nodejs8.10,java8,python2.7,python3.6,dotnetcore1.0,dotnetcore2.0,go1.x,nodejs4.3-edge"`
+	Description   *string `templateName:"description"`
+	Memory        *int64  `templateName:"memory"`
+	Timeout       *int64  `templateName:"timeout"`
+}
+
+func (cmd *UpdateFunction) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id"),
+		params.AtLeastOneOf(params.Key("bucket"), params.Key("description"), params.Key("handler"), params.Key("memory"), params.Key("role"), params.Key("runtime"), params.Key("timeout"), params.Key("zipfile")),
+		params.Opt("object", "objectversion", "publish"),
+	),
+		params.Validators{
+			"runtime": params.IsInEnumIgnoreCase(lambdaRuntimes...),
+			"zipfile": params.IsFilepath,
+		})
+}
+
+func (cmd *UpdateFunction) updatesCode() bool {
+	return cmd.Zipfile != nil || cmd.Bucket != nil
+}
+
+func (cmd *UpdateFunction) updatesConfiguration() bool {
+	return cmd.Handler != nil || cmd.Role != nil || cmd.Runtime != nil || cmd.Description != nil || cmd.Memory != nil || cmd.Timeout != nil
+}
+
+func (cmd *UpdateFunction) prepareCodeInput() (*lambda.UpdateFunctionCodeInput, error) {
+	if err := checkFunctionCode(cmd.Zipfile, cmd.Bucket, cmd.Object); err != nil {
+		return nil, err
+	}
+	input := &lambda.UpdateFunctionCodeInput{
+		FunctionName:    cmd.Id,
+		S3Bucket:        cmd.Bucket,
+		S3Key:           cmd.Object,
+		S3ObjectVersion: cmd.Objectversion,
+		Publish:         cmd.Publish,
+	}
+	if cmd.Zipfile != nil {
+		content, err := ioutil.ReadFile(StringValue(cmd.Zipfile))
+		if err != nil {
+			return nil, err
+		}
+		input.ZipFile = content
+	}
+	return input, nil
+}
+
+func (cmd *UpdateFunction) ManualRun(renv env.Running) (interface{}, error) {
+	var output *lambda.FunctionConfiguration
+	if cmd.updatesCode() {
+		input, err := cmd.prepareCodeInput()
+		if err != nil {
+			return nil, err
+		}
+		start := time.Now()
+		if output, err = cmd.api.UpdateFunctionCode(input); err != nil {
+			return nil, err
+		}
+		cmd.logger.ExtraVerbosef("lambda.UpdateFunctionCode call took %s", time.Since(start))
+	}
+	if cmd.updatesConfiguration() {
+		input := &lambda.UpdateFunctionConfigurationInput{
+			FunctionName: cmd.Id,
+			Handler:      cmd.Handler,
+			Role:         cmd.Role,
+			Runtime:      cmd.Runtime,
+			Description:  cmd.Description,
+			MemorySize:   cmd.Memory,
+			Timeout:      cmd.Timeout,
+		}
+		start := time.Now()
+		var err error
+		if output, err = cmd.api.UpdateFunctionConfiguration(input); err != nil {
+			return nil, err
+		}
+		cmd.logger.ExtraVerbosef("lambda.UpdateFunctionConfiguration call took %s", time.Since(start))
+	}
+	return output, nil
+}
+
+func (cmd *UpdateFunction) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("dry run: cannot set params on command struct: %s", err)
+	}
+	if cmd.updatesCode() {
+		input, err := cmd.prepareCodeInput()
+		if err != nil {
+			return nil, fmt.Errorf("dry run: %s", err)
+		}
+		input.SetDryRun(true)
+		start := time.Now()
+		if _, err = cmd.api.UpdateFunctionCode(input); err != nil {
+			return nil, fmt.Errorf("dry run: %s", err)
+		}
+		cmd.logger.ExtraVerbosef("dry run: lambda.UpdateFunctionCode call took %s", time.Since(start))
+	}
+	cmd.logger.Verbose("dry run: update function ok")
+	return fakeDryRunId("function"), nil
+}
+
+func (cmd *UpdateFunction) ExtractResult(i interface{}) string {
+	return StringValue(i.(*lambda.FunctionConfiguration).FunctionArn)
+}
+
+// InvokeFunction synchronously invokes a function (or queues the event with async=true),
+// the response payload of the function being the result of the command
+type InvokeFunction struct {
+	_       string `action:"invoke" entity:"function" awsAPI:"lambda" awsDryRun:"manual"`
+	logger  *logger.Logger
+	graph   cloud.GraphAPI
+	api     lambdaiface.LambdaAPI
+	Id      *string `templateName:"id"`
+	Payload *string `templateName:"payload"`
+	Async   *bool   `templateName:"async" enum:"true,false"`
+	Version *string `templateName:"version"`
+}
+
+func (cmd *InvokeFunction) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id"),
+		params.Opt("async", "payload", "version"),
+	),
+		params.Validators{
+			"payload": func(i interface{}, others map[string]interface{}) error {
+				if !json.Valid([]byte(fmt.Sprint(i))) {
+					return fmt.Errorf("invalid JSON payload: %s", i)
+				}
+				return nil
+			},
+		})
+}
+
+func (cmd *InvokeFunction) prepareInvokeInput() *lambda.InvokeInput {
+	input := &lambda.InvokeInput{
+		FunctionName:   cmd.Id,
+		InvocationType: String(lambda.InvocationTypeRequestResponse),
+		Qualifier:      cmd.Version,
+	}
+	if BoolValue(cmd.Async) {
+		input.InvocationType = String(lambda.InvocationTypeEvent)
+	}
+	if cmd.Payload != nil {
+		input.Payload = []byte(StringValue(cmd.Payload))
+	}
+	return input
+}
+
+func (cmd *InvokeFunction) ManualRun(renv env.Running) (interface{}, error) {
+	start := time.Now()
+	output, err := cmd.api.Invoke(cmd.prepareInvokeInput())
+	cmd.logger.ExtraVerbosef("lambda.Invoke call took %s", time.Since(start))
+	if err != nil {
+		return nil, err
+	}
+	if output.FunctionError != nil {
+		return nil, fmt.Errorf("function error (%s): %s", StringValue(output.FunctionError), output.Payload)
+	}
+	return output, nil
+}
+
+func (cmd *InvokeFunction) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("dry run: cannot set params on command struct: %s", err)
+	}
+	input := cmd.prepareInvokeInput()
+	input.InvocationType = String(lambda.InvocationTypeDryRun)
+
+	start := time.Now()
+	if _, err := cmd.api.Invoke(input); err != nil {
+		return nil, fmt.Errorf("dry run: %s", err)
+	}
+	cmd.logger.ExtraVerbosef("dry run: lambda.Invoke call took %s", time.Since(start))
+	cmd.logger.Verbose("dry run: invoke function ok")
+	return fakeDryRunId("function"), nil
+}
+
+func (cmd *InvokeFunction) ExtractResult(i interface{}) string {
+	return string(i.(*lambda.InvokeOutput).Payload)
+}
+
+// checkFunctionCode validates the deployment package of a function is either
+// a readable zip archive or an object in a S3 bucket
+func checkFunctionCode(zipfile, bucket, object *string) error {
+	switch {
+	case zipfile != nil && bucket != nil:
+		return errors.New("deployment package: either zipfile or bucket, not both")
+	case bucket != nil && object == nil:
+		return errors.New("deployment package: missing object of bucket")
+	case zipfile != nil:
+		r, err := zip.OpenReader(StringValue(zipfile))
+		if err != nil {
+			return fmt.Errorf("deployment package '%s': %s", StringValue(zipfile), err)
+		}
+		return r.Close()
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package awsspec
+
+import (
+	"archive/zip"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestCheckFunctionCode(t *testing.T) {
+	notZip, err := ioutil.TempFile("", "function")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(notZip.Name())
+	notZip.WriteString("this is not a zip")
+	notZip.Close()
+
+	validZip, err := ioutil.TempFile("", "function")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(validZip.Name())
+	w := zip.NewWriter(validZip)
+	if _, err = w.Create("index.js"); err != nil {
+		t.Fatal(err)
+	}
+	w.Close()
+	validZip.Close()
+
+	tcases := []struct {
+		zipfile, bucket, object *string
+		expErr                  string
+	}{
+		{zipfile: String(validZip.Name())},
+		{bucket: String("my-bucket"), object: String("function.zip")},
+		{},
+		{zipfile: String(notZip.Name()), expErr: "not a valid zip file"},
+		{zipfile: String(validZip.Name()), bucket: String("my-bucket"), expErr: "either zipfile or bucket"},
+		{bucket: String("my-bucket"), expErr: "missing object"},
+	}
+	for i, tcase := range tcases {
+		err := checkFunctionCode(tcase.zipfile, tcase.bucket, tcase.object)
+		switch {
+		case tcase.expErr == "" && err != nil:
+			t.Fatalf("%d: unexpected error: %s", i+1, err)
+		case tcase.expErr != "" && (err == nil || !strings.Contains(err.Error(), tcase.expErr)):
+			t.Fatalf("%d: got %v, want error containing %q", i+1, err, tcase.expErr)
+		}
+	}
+}
@@ -18,1191 +18,1944 @@ limitations under the License.
 package awsspec
 
 var APIPerTemplateDefName = map[string]string{
-	"attachalarm":               "cloudwatch",
-	"attachclassicloadbalancer": "elb",
-	"attachcontainertask":       "ecs",
-	"attachelasticip":           "ec2",
-	"attachinstance":            "elbv2",
-	"attachinstanceprofile":     "ec2",
-	"attachinternetgateway":     "ec2",
-	"attachlistener":            "elbv2",
-	"attachmfadevice":           "iam",
-	"attachnetworkinterface":    "ec2",
-	"attachpolicy":              "iam",
-	"attachrole":                "iam",
-	"attachroutetable":          "ec2",
-	"attachsecuritygroup":       "ec2",
-	"attachuser":                "iam",
-	"attachvolume":              "ec2",
-	"authenticateregistry":      "ecr",
-	"checkcertificate":          "acm",
-	"checkdatabase":             "rds",
-	"checkdistribution":         "cloudfront",
-	"checkinstance":             "ec2",
-	"checkloadbalancer":         "elbv2",
-	"checknatgateway":           "ec2",
-	"checknetworkinterface":     "ec2",
-	"checkscalinggroup":         "autoscaling",
-	"checksecuritygroup":        "ec2",
-	"checkvolume":               "ec2",
-	"copyimage":                 "ec2",
-	"copysnapshot":              "ec2",
-	"createaccesskey":           "iam",
-	"createalarm":               "cloudwatch",
-	"createappscalingpolicy":    "applicationautoscaling",
-	"createappscalingtarget":    "applicationautoscaling",
-	"createbucket":              "s3",
-	"createcertificate":         "acm",
-	"createclassicloadbalancer": "elb",
-	"createcontainercluster":    "ecs",
-	"createdatabase":            "rds",
-	"createdbsubnetgroup":       "rds",
-	"createdistribution":        "cloudfront",
-	"createelasticip":           "ec2",
-	"createfunction":            "lambda",
-	"creategroup":               "iam",
-	"createimage":               "ec2",
-	"createinstance":            "ec2",
-	"createinstanceprofile":     "iam",
-	"createinternetgateway":     "ec2",
-	"createkeypair":             "ec2",
-	"createlaunchconfiguration": "autoscaling",
-	"createlistener":            "elbv2",
-	"createloadbalancer":        "elbv2",
-	"createloginprofile":        "iam",
-	"createmfadevice":           "iam",
-	"createnatgateway":          "ec2",
-	"createnetworkinterface":    "ec2",
-	"createpolicy":              "iam",
-	"createqueue":               "sqs",
-	"createrecord":              "route53",
-	"createrepository":          "ecr",
-	"createrole":                "iam",
-	"createroute":               "ec2",
-	"createroutetable":          "ec2",
-	"creates3object":            "s3",
-	"createscalinggroup":        "autoscaling",
-	"createscalingpolicy":       "autoscaling",
-	"createsecuritygroup":       "ec2",
-	"createsnapshot":            "ec2",
-	"createstack":               "cloudformation",
-	"createsubnet":              "ec2",
-	"createsubscription":        "sns",
-	"createtag":                 "ec2",
-	"createtargetgroup":         "elbv2",
-	"createtopic":               "sns",
-	"createuser":                "iam",
-	"createvolume":              "ec2",
-	"createvpc":                 "ec2",
-	"createzone":                "route53",
-	"deleteaccesskey":           "iam",
-	"deletealarm":               "cloudwatch",
-	"deleteappscalingpolicy":    "applicationautoscaling",
-	"deleteappscalingtarget":    "applicationautoscaling",
-	"deletebucket":              "s3",
-	"deletecertificate":         "acm",
-	"deleteclassicloadbalancer": "elb",
-	"deletecontainercluster":    "ecs",
-	"deletecontainertask":       "ecs",
-	"deletedatabase":            "rds",
-	"deletedbsubnetgroup":       "rds",
-	"deletedistribution":        "cloudfront",
-	"deleteelasticip":           "ec2",
-	"deletefunction":            "lambda",
-	"deletegroup":               "iam",
-	"deleteimage":               "ec2",
-	"deleteinstance":            "ec2",
-	"deleteinstanceprofile":     "iam",
-	"deleteinternetgateway":     "ec2",
-	"deletekeypair":             "ec2",
-	"deletelaunchconfiguration": "autoscaling",
-	"deletelistener":            "elbv2",
-	"deleteloadbalancer":        "elbv2",
-	"deleteloginprofile":        "iam",
-	"deletemfadevice":           "iam",
-	"deletenatgateway":          "ec2",
-	"deletenetworkinterface":    "ec2",
-	"deletepolicy":              "iam",
-	"deletequeue":               "sqs",
-	"deleterecord":              "route53",
-	"deleterepository":          "ecr",
-	"deleterole":                "iam",
-	"deleteroute":               "ec2",
-	"deleteroutetable":          "ec2",
-	"deletes3object":            "s3",
-	"deletescalinggroup":        "autoscaling",
-	"deletescalingpolicy":       "autoscaling",
-	"deletesecuritygroup":       "ec2",
-	"deletesnapshot":            "ec2",
-	"deletestack":               "cloudformation",
-	"deletesubnet":              "ec2",
-	"deletesubscription":        "sns",
-	"deletetag":                 "ec2",
-	"deletetargetgroup":         "elbv2",
-	"deletetopic":               "sns",
-	"deleteuser":                "iam",
-	"deletevolume":              "ec2",
-	"deletevpc":                 "ec2",
-	"deletezone":                "route53",
-	"detachalarm":               "cloudwatch",
-	"detachclassicloadbalancer": "elb",
-	"detachcontainertask":       "ecs",
-	"detachelasticip":           "ec2",
-	"detachinstance":            "elbv2",
-	"detachinstanceprofile":     "ec2",
-	"detachinternetgateway":     "ec2",
-	"detachmfadevice":           "iam",
-	"detachnetworkinterface":    "ec2",
-	"detachpolicy":              "iam",
-	"detachrole":                "iam",
-	"detachroutetable":          "ec2",
-	"detachsecuritygroup":       "ec2",
-	"detachuser":                "iam",
-	"detachvolume":              "ec2",
-	"importimage":               "ec2",
-	"restartdatabase":           "rds",
-	"restartinstance":           "ec2",
-	"startalarm":                "cloudwatch",
-	"startcontainertask":        "ecs",
-	"startdatabase":             "rds",
-	"startinstance":             "ec2",
-	"stopalarm":                 "cloudwatch",
-	"stopcontainertask":         "ecs",
-	"stopdatabase":              "rds",
-	"stopinstance":              "ec2",
-	"updatebucket":              "s3",
-	"updateclassicloadbalancer": "elb",
-	"updatecontainertask":       "ecs",
-	"updatedistribution":        "cloudfront",
-	"updateimage":               "ec2",
-	"updateinstance":            "ec2",
-	"updateloginprofile":        "iam",
-	"updatepolicy":              "iam",
-	"updaterecord":              "route53",
-	"updates3object":            "s3",
-	"updatescalinggroup":        "autoscaling",
-	"updatesecuritygroup":       "ec2",
-	"updatestack":               "cloudformation",
-	"updatesubnet":              "ec2",
-	"updatetargetgroup":         "elbv2",
+	"attachalarm":                     "cloudwatch",
+	"attachclassicloadbalancer":       "elb",
+	"attachcontainertask":             "ecs",
+	"attachdhcpoptions":               "ec2",
+	"attachelasticip":                 "ec2",
+	"attachinstance":                  "elbv2",
+	"attachinstanceprofile":           "ec2",
+	"attachinternetgateway":           "ec2",
+	"attachlistener":                  "elbv2",
+	"attachmfadevice":                 "iam",
+	"attachnetworkinterface":          "ec2",
+	"attachpolicy":                    "iam",
+	"attachqueuepolicy":               "sqs",
+	"attachrole":                      "iam",
+	"attachroutetable":                "ec2",
+	"attachscalinggroup":              "autoscaling",
+	"attachsecuritygroup":             "ec2",
+	"attachuser":                      "iam",
+	"attachvolume":                    "ec2",
+	"attachvpcendpoint":               "ec2",
+	"authenticateregistry":            "ecr",
+	"backupinstance":                  "ec2",
+	"bootstrapinstance":               "ec2",
+	"checkalarm":                      "cloudwatch",
+	"checkcertificate":                "acm",
+	"checkdatabase":                   "rds",
+	"checkdistribution":               "cloudfront",
+	"checkelasticsearchdomain":        "elasticsearchservice",
+	"checkenvironment":                "elasticbeanstalk",
+	"checkhealthcheck":                "route53",
+	"checkinstance":                   "ec2",
+	"checkloadbalancer":               "elbv2",
+	"checknatgateway":                 "ec2",
+	"checknetworkinterface":           "ec2",
+	"checkrecord":                     "route53",
+	"checkscalinggroup":               "autoscaling",
+	"checksecuritygroup":              "ec2",
+	"checktable":                      "dynamodb",
+	"checkvolume":                     "ec2",
+	"copyimage":                       "ec2",
+	"copysnapshot":                    "ec2",
+	"createaccesskey":                 "iam",
+	"createalarm":                     "cloudwatch",
+	"createapplication":               "elasticbeanstalk",
+	"createappscalingpolicy":          "applicationautoscaling",
+	"createappscalingtarget":          "applicationautoscaling",
+	"createbucket":                    "s3",
+	"createcertificate":               "acm",
+	"createclassicloadbalancer":       "elb",
+	"createcontainercluster":          "ecs",
+	"createdatabase":                  "rds",
+	"createdbsubnetgroup":             "rds",
+	"createdhcpoptions":               "ec2",
+	"createdistribution":              "cloudfront",
+	"createegressonlyinternetgateway": "ec2",
+	"createelasticip":                 "ec2",
+	"createelasticsearchdomain":       "elasticsearchservice",
+	"createenvironment":               "elasticbeanstalk",
+	"createfailover":                  "route53",
+	"createfunction":                  "lambda",
+	"creategroup":                     "iam",
+	"createhealthcheck":               "route53",
+	"createimage":                     "ec2",
+	"createinstance":                  "ec2",
+	"createinstanceprofile":           "iam",
+	"createinternetgateway":           "ec2",
+	"createkeypair":                   "ec2",
+	"createlaunchconfiguration":       "autoscaling",
+	"createlistener":                  "elbv2",
+	"createloadbalancer":              "elbv2",
+	"createloginprofile":              "iam",
+	"createmfadevice":                 "iam",
+	"createnatgateway":                "ec2",
+	"createnetworkinterface":          "ec2",
+	"createpolicy":                    "iam",
+	"createqueue":                     "sqs",
+	"createrecord":                    "route53",
+	"createrecords":                   "route53",
+	"createrepository":                "ecr",
+	"createrole":                      "iam",
+	"createroute":                     "ec2",
+	"createroutetable":                "ec2",
+	"creates3object":                  "s3",
+	"createscalinggroup":              "autoscaling",
+	"createscalingpolicy":             "autoscaling",
+	"createsecuritygroup":             "ec2",
+	"createsnapshot":                  "ec2",
+	"createstack":                     "cloudformation",
+	"createsubnet":                    "ec2",
+	"createsubscription":              "sns",
+	"createtable":                     "dynamodb",
+	"createtag":                       "ec2",
+	"createtargetgroup":               "elbv2",
+	"createtopic":                     "sns",
+	"createuser":                      "iam",
+	"createvolume":                    "ec2",
+	"createvpc":                       "ec2",
+	"createvpcendpoint":               "ec2",
+	"createzone":                      "route53",
+	"deleteaccesskey":                 "iam",
+	"deletealarm":                     "cloudwatch",
+	"deleteapplication":               "elasticbeanstalk",
+	"deleteappscalingpolicy":          "applicationautoscaling",
+	"deleteappscalingtarget":          "applicationautoscaling",
+	"deletebucket":                    "s3",
+	"deletecertificate":               "acm",
+	"deleteclassicloadbalancer":       "elb",
+	"deletecontainercluster":          "ecs",
+	"deletecontainertask":             "ecs",
+	"deletedatabase":                  "rds",
+	"deletedbsubnetgroup":             "rds",
+	"deletedhcpoptions":               "ec2",
+	"deletedistribution":              "cloudfront",
+	"deleteegressonlyinternetgateway": "ec2",
+	"deleteelasticip":                 "ec2",
+	"deleteelasticsearchdomain":       "elasticsearchservice",
+	"deleteenvironment":               "elasticbeanstalk",
+	"deletefunction":                  "lambda",
+	"deletegroup":                     "iam",
+	"deletehealthcheck":               "route53",
+	"deleteimage":                     "ec2",
+	"deleteinstance":                  "ec2",
+	"deleteinstanceprofile":           "iam",
+	"deleteinternetgateway":           "ec2",
+	"deletekeypair":                   "ec2",
+	"deletelaunchconfiguration":       "autoscaling",
+	"deletelistener":                  "elbv2",
+	"deleteloadbalancer":              "elbv2",
+	"deleteloginprofile":              "iam",
+	"deletemfadevice":                 "iam",
+	"deletenatgateway":                "ec2",
+	"deletenetworkinterface":          "ec2",
+	"deletepolicy":                    "iam",
+	"deletequeue":                     "sqs",
+	"deleterecord":                    "route53",
+	"deleterecords":                   "route53",
+	"deleterepository":                "ecr",
+	"deleterole":                      "iam",
+	"deleteroute":                     "ec2",
+	"deleteroutetable":                "ec2",
+	"deletes3object":                  "s3",
+	"deletescalinggroup":              "autoscaling",
+	"deletescalingpolicy":             "autoscaling",
+	"deletesecuritygroup":             "ec2",
+	"deletesnapshot":                  "ec2",
+	"deletestack":                     "cloudformation",
+	"deletesubnet":                    "ec2",
+	"deletesubscription":              "sns",
+	"deletetable":                     "dynamodb",
+	"deletetag":                       "ec2",
+	"deletetargetgroup":               "elbv2",
+	"deletetopic":                     "sns",
+	"deleteuser":                      "iam",
+	"deletevolume":                    "ec2",
+	"deletevpc":                       "ec2",
+	"deletevpcendpoint":               "ec2",
+	"deletezone":                      "route53",
+	"detachalarm":                     "cloudwatch",
+	"detachclassicloadbalancer":       "elb",
+	"detachcontainertask":             "ecs",
+	"detachdhcpoptions":               "ec2",
+	"detachelasticip":                 "ec2",
+	"detachinstance":                  "elbv2",
+	"detachinstanceprofile":           "ec2",
+	"detachinternetgateway":           "ec2",
+	"detachmfadevice":                 "iam",
+	"detachnetworkinterface":          "ec2",
+	"detachpolicy":                    "iam",
+	"detachqueuepolicy":               "sqs",
+	"detachrole":                      "iam",
+	"detachroutetable":                "ec2",
+	"detachscalinggroup":              "autoscaling",
+	"detachsecuritygroup":             "ec2",
+	"detachuser":                      "iam",
+	"detachvolume":                    "ec2",
+	"detachvpcendpoint":               "ec2",
+	"importimage":                     "ec2",
+	"invokefunction":                  "lambda",
+	"restartdatabase":                 "rds",
+	"restartinstance":                 "ec2",
+	"restorebackup":                   "ec2",
+	"startalarm":                      "cloudwatch",
+	"startcontainertask":              "ecs",
+	"startdatabase":                   "rds",
+	"startinstance":                   "ec2",
+	"stopalarm":                       "cloudwatch",
+	"stopcontainertask":               "ecs",
+	"stopdatabase":                    "rds",
+	"stopinstance":                    "ec2",
+	"updatebucket":                    "s3",
+	"updateclassicloadbalancer":       "elb",
+	"updatecontainertask":             "ecs",
+	"updatedistribution":              "cloudfront",
+	"updateelasticsearchdomain":       "elasticsearchservice",
+	"updateenvironment":               "elasticbeanstalk",
+	"updatefunction":                  "lambda",
+	"updateimage":                     "ec2",
+	"updateinstance":                  "ec2",
+	"updateloginprofile":              "iam",
+	"updatepolicy":                    "iam",
+	"updatequeue":                     "sqs",
+	"updaterecord":                    "route53",
+	"updaterecords":                   "route53",
+	"updates3object":                  "s3",
+	"updatescalinggroup":              "autoscaling",
+	"updatesecuritygroup":             "ec2",
+	"updatestack":                     "cloudformation",
+	"updatesubnet":                    "ec2",
+	"updatetable":                     "dynamodb",
+	"updatetargetgroup":               "elbv2",
+	"updatevpc":                       "ec2",
+	"verifydomain":                    "ses",
+	"verifyemail":                     "ses",
 }
 
-var AWSTemplatesDefinitions = map[string]Definition{
-	"attachalarm": {
-		Action: "attach",
-		Entity: "alarm",
-		Api:    "cloudwatch",
-		Params: new(AttachAlarm).ParamsSpec().Rule(),
-	},
-	"attachclassicloadbalancer": {
-		Action: "attach",
-		Entity: "classicloadbalancer",
-		Api:    "elb",
-		Params: new(AttachClassicLoadbalancer).ParamsSpec().Rule(),
-	},
-	"attachcontainertask": {
-		Action: "attach",
-		Entity: "containertask",
-		Api:    "ecs",
-		Params: new(AttachContainertask).ParamsSpec().Rule(),
-	},
-	"attachelasticip": {
-		Action: "attach",
-		Entity: "elasticip",
-		Api:    "ec2",
-		Params: new(AttachElasticip).ParamsSpec().Rule(),
-	},
-	"attachinstance": {
-		Action: "attach",
-		Entity: "instance",
-		Api:    "elbv2",
-		Params: new(AttachInstance).ParamsSpec().Rule(),
-	},
-	"attachinstanceprofile": {
-		Action: "attach",
-		Entity: "instanceprofile",
-		Api:    "ec2",
-		Params: new(AttachInstanceprofile).ParamsSpec().Rule(),
-	},
-	"attachinternetgateway": {
-		Action: "attach",
-		Entity: "internetgateway",
-		Api:    "ec2",
-		Params: new(AttachInternetgateway).ParamsSpec().Rule(),
-	},
-	"attachlistener": {
-		Action: "attach",
-		Entity: "listener",
-		Api:    "elbv2",
-		Params: new(AttachListener).ParamsSpec().Rule(),
-	},
-	"attachmfadevice": {
-		Action: "attach",
-		Entity: "mfadevice",
-		Api:    "iam",
-		Params: new(AttachMfadevice).ParamsSpec().Rule(),
-	},
-	"attachnetworkinterface": {
-		Action: "attach",
-		Entity: "networkinterface",
-		Api:    "ec2",
-		Params: new(AttachNetworkinterface).ParamsSpec().Rule(),
-	},
-	"attachpolicy": {
-		Action: "attach",
-		Entity: "policy",
-		Api:    "iam",
-		Params: new(AttachPolicy).ParamsSpec().Rule(),
-	},
-	"attachrole": {
-		Action: "attach",
-		Entity: "role",
-		Api:    "iam",
-		Params: new(AttachRole).ParamsSpec().Rule(),
-	},
-	"attachroutetable": {
-		Action: "attach",
-		Entity: "routetable",
-		Api:    "ec2",
-		Params: new(AttachRoutetable).ParamsSpec().Rule(),
-	},
-	"attachsecuritygroup": {
-		Action: "attach",
-		Entity: "securitygroup",
-		Api:    "ec2",
-		Params: new(AttachSecuritygroup).ParamsSpec().Rule(),
-	},
-	"attachuser": {
-		Action: "attach",
-		Entity: "user",
-		Api:    "iam",
-		Params: new(AttachUser).ParamsSpec().Rule(),
-	},
-	"attachvolume": {
-		Action: "attach",
-		Entity: "volume",
-		Api:    "ec2",
-		Params: new(AttachVolume).ParamsSpec().Rule(),
-	},
-	"authenticateregistry": {
-		Action: "authenticate",
-		Entity: "registry",
-		Api:    "ecr",
-		Params: new(AuthenticateRegistry).ParamsSpec().Rule(),
-	},
-	"checkcertificate": {
-		Action: "check",
-		Entity: "certificate",
-		Api:    "acm",
-		Params: new(CheckCertificate).ParamsSpec().Rule(),
-	},
-	"checkdatabase": {
-		Action: "check",
-		Entity: "database",
-		Api:    "rds",
-		Params: new(CheckDatabase).ParamsSpec().Rule(),
-	},
-	"checkdistribution": {
-		Action: "check",
-		Entity: "distribution",
-		Api:    "cloudfront",
-		Params: new(CheckDistribution).ParamsSpec().Rule(),
-	},
-	"checkinstance": {
-		Action: "check",
-		Entity: "instance",
-		Api:    "ec2",
-		Params: new(CheckInstance).ParamsSpec().Rule(),
-	},
-	"checkloadbalancer": {
-		Action: "check",
-		Entity: "loadbalancer",
-		Api:    "elbv2",
-		Params: new(CheckLoadbalancer).ParamsSpec().Rule(),
-	},
-	"checknatgateway": {
-		Action: "check",
-		Entity: "natgateway",
-		Api:    "ec2",
-		Params: new(CheckNatgateway).ParamsSpec().Rule(),
-	},
-	"checknetworkinterface": {
-		Action: "check",
-		Entity: "networkinterface",
-		Api:    "ec2",
-		Params: new(CheckNetworkinterface).ParamsSpec().Rule(),
-	},
-	"checkscalinggroup": {
-		Action: "check",
-		Entity: "scalinggroup",
-		Api:    "autoscaling",
-		Params: new(CheckScalinggroup).ParamsSpec().Rule(),
-	},
-	"checksecuritygroup": {
-		Action: "check",
-		Entity: "securitygroup",
-		Api:    "ec2",
-		Params: new(CheckSecuritygroup).ParamsSpec().Rule(),
-	},
-	"checkvolume": {
-		Action: "check",
-		Entity: "volume",
-		Api:    "ec2",
-		Params: new(CheckVolume).ParamsSpec().Rule(),
-	},
-	"copyimage": {
-		Action: "copy",
-		Entity: "image",
-		Api:    "ec2",
-		Params: new(CopyImage).ParamsSpec().Rule(),
-	},
-	"copysnapshot": {
-		Action: "copy",
-		Entity: "snapshot",
-		Api:    "ec2",
-		Params: new(CopySnapshot).ParamsSpec().Rule(),
-	},
-	"createaccesskey": {
-		Action: "create",
-		Entity: "accesskey",
-		Api:    "iam",
-		Params: new(CreateAccesskey).ParamsSpec().Rule(),
-	},
-	"createalarm": {
-		Action: "create",
-		Entity: "alarm",
-		Api:    "cloudwatch",
-		Params: new(CreateAlarm).ParamsSpec().Rule(),
-	},
-	"createappscalingpolicy": {
-		Action: "create",
-		Entity: "appscalingpolicy",
-		Api:    "applicationautoscaling",
-		Params: new(CreateAppscalingpolicy).ParamsSpec().Rule(),
-	},
-	"createappscalingtarget": {
-		Action: "create",
-		Entity: "appscalingtarget",
-		Api:    "applicationautoscaling",
-		Params: new(CreateAppscalingtarget).ParamsSpec().Rule(),
-	},
-	"createbucket": {
-		Action: "create",
-		Entity: "bucket",
-		Api:    "s3",
-		Params: new(CreateBucket).ParamsSpec().Rule(),
-	},
-	"createcertificate": {
-		Action: "create",
-		Entity: "certificate",
-		Api:    "acm",
-		Params: new(CreateCertificate).ParamsSpec().Rule(),
-	},
-	"createclassicloadbalancer": {
-		Action: "create",
-		Entity: "classicloadbalancer",
-		Api:    "elb",
-		Params: new(CreateClassicLoadbalancer).ParamsSpec().Rule(),
-	},
-	"createcontainercluster": {
-		Action: "create",
-		Entity: "containercluster",
-		Api:    "ecs",
-		Params: new(CreateContainercluster).ParamsSpec().Rule(),
-	},
-	"createdatabase": {
-		Action: "create",
-		Entity: "database",
-		Api:    "rds",
-		Params: new(CreateDatabase).ParamsSpec().Rule(),
-	},
-	"createdbsubnetgroup": {
-		Action: "create",
-		Entity: "dbsubnetgroup",
-		Api:    "rds",
-		Params: new(CreateDbsubnetgroup).ParamsSpec().Rule(),
-	},
-	"createdistribution": {
-		Action: "create",
-		Entity: "distribution",
-		Api:    "cloudfront",
-		Params: new(CreateDistribution).ParamsSpec().Rule(),
-	},
-	"createelasticip": {
-		Action: "create",
-		Entity: "elasticip",
-		Api:    "ec2",
-		Params: new(CreateElasticip).ParamsSpec().Rule(),
-	},
-	"createfunction": {
-		Action: "create",
-		Entity: "function",
-		Api:    "lambda",
-		Params: new(CreateFunction).ParamsSpec().Rule(),
-	},
-	"creategroup": {
-		Action: "create",
-		Entity: "group",
-		Api:    "iam",
-		Params: new(CreateGroup).ParamsSpec().Rule(),
-	},
-	"createimage": {
-		Action: "create",
-		Entity: "image",
-		Api:    "ec2",
-		Params: new(CreateImage).ParamsSpec().Rule(),
-	},
-	"createinstance": {
-		Action: "create",
-		Entity: "instance",
-		Api:    "ec2",
-		Params: new(CreateInstance).ParamsSpec().Rule(),
-	},
-	"createinstanceprofile": {
-		Action: "create",
-		Entity: "instanceprofile",
-		Api:    "iam",
-		Params: new(CreateInstanceprofile).ParamsSpec().Rule(),
-	},
-	"createinternetgateway": {
-		Action: "create",
-		Entity: "internetgateway",
-		Api:    "ec2",
-		Params: new(CreateInternetgateway).ParamsSpec().Rule(),
-	},
-	"createkeypair": {
-		Action: "create",
-		Entity: "keypair",
-		Api:    "ec2",
-		Params: new(CreateKeypair).ParamsSpec().Rule(),
-	},
-	"createlaunchconfiguration": {
-		Action: "create",
-		Entity: "launchconfiguration",
-		Api:    "autoscaling",
-		Params: new(CreateLaunchconfiguration).ParamsSpec().Rule(),
-	},
-	"createlistener": {
-		Action: "create",
-		Entity: "listener",
-		Api:    "elbv2",
-		Params: new(CreateListener).ParamsSpec().Rule(),
-	},
-	"createloadbalancer": {
-		Action: "create",
-		Entity: "loadbalancer",
-		Api:    "elbv2",
-		Params: new(CreateLoadbalancer).ParamsSpec().Rule(),
-	},
-	"createloginprofile": {
-		Action: "create",
-		Entity: "loginprofile",
-		Api:    "iam",
-		Params: new(CreateLoginprofile).ParamsSpec().Rule(),
-	},
-	"createmfadevice": {
-		Action: "create",
-		Entity: "mfadevice",
-		Api:    "iam",
-		Params: new(CreateMfadevice).ParamsSpec().Rule(),
-	},
-	"createnatgateway": {
-		Action: "create",
-		Entity: "natgateway",
-		Api:    "ec2",
-		Params: new(CreateNatgateway).ParamsSpec().Rule(),
-	},
-	"createnetworkinterface": {
-		Action: "create",
-		Entity: "networkinterface",
-		Api:    "ec2",
-		Params: new(CreateNetworkinterface).ParamsSpec().Rule(),
-	},
-	"createpolicy": {
-		Action: "create",
-		Entity: "policy",
-		Api:    "iam",
-		Params: new(CreatePolicy).ParamsSpec().Rule(),
-	},
-	"createqueue": {
-		Action: "create",
-		Entity: "queue",
-		Api:    "sqs",
-		Params: new(CreateQueue).ParamsSpec().Rule(),
-	},
-	"createrecord": {
-		Action: "create",
-		Entity: "record",
-		Api:    "route53",
-		Params: new(CreateRecord).ParamsSpec().Rule(),
-	},
-	"createrepository": {
-		Action: "create",
-		Entity: "repository",
-		Api:    "ecr",
-		Params: new(CreateRepository).ParamsSpec().Rule(),
-	},
-	"createrole": {
-		Action: "create",
-		Entity: "role",
-		Api:    "iam",
-		Params: new(CreateRole).ParamsSpec().Rule(),
-	},
-	"createroute": {
-		Action: "create",
-		Entity: "route",
-		Api:    "ec2",
-		Params: new(CreateRoute).ParamsSpec().Rule(),
-	},
-	"createroutetable": {
-		Action: "create",
-		Entity: "routetable",
-		Api:    "ec2",
-		Params: new(CreateRoutetable).ParamsSpec().Rule(),
-	},
-	"creates3object": {
-		Action: "create",
-		Entity: "s3object",
-		Api:    "s3",
-		Params: new(CreateS3object).ParamsSpec().Rule(),
-	},
-	"createscalinggroup": {
-		Action: "create",
-		Entity: "scalinggroup",
-		Api:    "autoscaling",
-		Params: new(CreateScalinggroup).ParamsSpec().Rule(),
-	},
-	"createscalingpolicy": {
-		Action: "create",
-		Entity: "scalingpolicy",
-		Api:    "autoscaling",
-		Params: new(CreateScalingpolicy).ParamsSpec().Rule(),
-	},
-	"createsecuritygroup": {
-		Action: "create",
-		Entity: "securitygroup",
-		Api:    "ec2",
-		Params: new(CreateSecuritygroup).ParamsSpec().Rule(),
-	},
-	"createsnapshot": {
-		Action: "create",
-		Entity: "snapshot",
-		Api:    "ec2",
-		Params: new(CreateSnapshot).ParamsSpec().Rule(),
-	},
-	"createstack": {
-		Action: "create",
-		Entity: "stack",
-		Api:    "cloudformation",
-		Params: new(CreateStack).ParamsSpec().Rule(),
-	},
-	"createsubnet": {
-		Action: "create",
-		Entity: "subnet",
-		Api:    "ec2",
-		Params: new(CreateSubnet).ParamsSpec().Rule(),
-	},
-	"createsubscription": {
-		Action: "create",
-		Entity: "subscription",
-		Api:    "sns",
-		Params: new(CreateSubscription).ParamsSpec().Rule(),
-	},
-	"createtag": {
-		Action: "create",
-		Entity: "tag",
-		Api:    "ec2",
-		Params: new(CreateTag).ParamsSpec().Rule(),
-	},
-	"createtargetgroup": {
-		Action: "create",
-		Entity: "targetgroup",
-		Api:    "elbv2",
-		Params: new(CreateTargetgroup).ParamsSpec().Rule(),
-	},
-	"createtopic": {
-		Action: "create",
-		Entity: "topic",
-		Api:    "sns",
-		Params: new(CreateTopic).ParamsSpec().Rule(),
-	},
-	"createuser": {
-		Action: "create",
-		Entity: "user",
-		Api:    "iam",
-		Params: new(CreateUser).ParamsSpec().Rule(),
-	},
-	"createvolume": {
-		Action: "create",
-		Entity: "volume",
-		Api:    "ec2",
-		Params: new(CreateVolume).ParamsSpec().Rule(),
-	},
-	"createvpc": {
-		Action: "create",
-		Entity: "vpc",
-		Api:    "ec2",
-		Params: new(CreateVpc).ParamsSpec().Rule(),
-	},
-	"createzone": {
-		Action: "create",
-		Entity: "zone",
-		Api:    "route53",
-		Params: new(CreateZone).ParamsSpec().Rule(),
-	},
-	"deleteaccesskey": {
-		Action: "delete",
-		Entity: "accesskey",
-		Api:    "iam",
-		Params: new(DeleteAccesskey).ParamsSpec().Rule(),
-	},
-	"deletealarm": {
-		Action: "delete",
-		Entity: "alarm",
-		Api:    "cloudwatch",
-		Params: new(DeleteAlarm).ParamsSpec().Rule(),
-	},
-	"deleteappscalingpolicy": {
-		Action: "delete",
-		Entity: "appscalingpolicy",
-		Api:    "applicationautoscaling",
-		Params: new(DeleteAppscalingpolicy).ParamsSpec().Rule(),
-	},
-	"deleteappscalingtarget": {
-		Action: "delete",
-		Entity: "appscalingtarget",
-		Api:    "applicationautoscaling",
-		Params: new(DeleteAppscalingtarget).ParamsSpec().Rule(),
-	},
-	"deletebucket": {
-		Action: "delete",
-		Entity: "bucket",
-		Api:    "s3",
-		Params: new(DeleteBucket).ParamsSpec().Rule(),
-	},
-	"deletecertificate": {
-		Action: "delete",
-		Entity: "certificate",
-		Api:    "acm",
-		Params: new(DeleteCertificate).ParamsSpec().Rule(),
-	},
-	"deleteclassicloadbalancer": {
-		Action: "delete",
-		Entity: "classicloadbalancer",
-		Api:    "elb",
-		Params: new(DeleteClassicLoadbalancer).ParamsSpec().Rule(),
-	},
-	"deletecontainercluster": {
-		Action: "delete",
-		Entity: "containercluster",
-		Api:    "ecs",
-		Params: new(DeleteContainercluster).ParamsSpec().Rule(),
-	},
-	"deletecontainertask": {
-		Action: "delete",
-		Entity: "containertask",
-		Api:    "ecs",
-		Params: new(DeleteContainertask).ParamsSpec().Rule(),
-	},
-	"deletedatabase": {
-		Action: "delete",
-		Entity: "database",
-		Api:    "rds",
-		Params: new(DeleteDatabase).ParamsSpec().Rule(),
-	},
-	"deletedbsubnetgroup": {
-		Action: "delete",
-		Entity: "dbsubnetgroup",
-		Api:    "rds",
-		Params: new(DeleteDbsubnetgroup).ParamsSpec().Rule(),
-	},
-	"deletedistribution": {
-		Action: "delete",
-		Entity: "distribution",
-		Api:    "cloudfront",
-		Params: new(DeleteDistribution).ParamsSpec().Rule(),
-	},
-	"deleteelasticip": {
-		Action: "delete",
-		Entity: "elasticip",
-		Api:    "ec2",
-		Params: new(DeleteElasticip).ParamsSpec().Rule(),
-	},
-	"deletefunction": {
-		Action: "delete",
-		Entity: "function",
-		Api:    "lambda",
-		Params: new(DeleteFunction).ParamsSpec().Rule(),
-	},
-	"deletegroup": {
-		Action: "delete",
-		Entity: "group",
-		Api:    "iam",
-		Params: new(DeleteGroup).ParamsSpec().Rule(),
-	},
-	"deleteimage": {
-		Action: "delete",
-		Entity: "image",
-		Api:    "ec2",
-		Params: new(DeleteImage).ParamsSpec().Rule(),
-	},
-	"deleteinstance": {
-		Action: "delete",
-		Entity: "instance",
-		Api:    "ec2",
-		Params: new(DeleteInstance).ParamsSpec().Rule(),
-	},
-	"deleteinstanceprofile": {
-		Action: "delete",
-		Entity: "instanceprofile",
-		Api:    "iam",
-		Params: new(DeleteInstanceprofile).ParamsSpec().Rule(),
-	},
-	"deleteinternetgateway": {
-		Action: "delete",
-		Entity: "internetgateway",
-		Api:    "ec2",
-		Params: new(DeleteInternetgateway).ParamsSpec().Rule(),
-	},
-	"deletekeypair": {
-		Action: "delete",
-		Entity: "keypair",
-		Api:    "ec2",
-		Params: new(DeleteKeypair).ParamsSpec().Rule(),
-	},
-	"deletelaunchconfiguration": {
-		Action: "delete",
-		Entity: "launchconfiguration",
-		Api:    "autoscaling",
-		Params: new(DeleteLaunchconfiguration).ParamsSpec().Rule(),
-	},
-	"deletelistener": {
-		Action: "delete",
-		Entity: "listener",
-		Api:    "elbv2",
-		Params: new(DeleteListener).ParamsSpec().Rule(),
-	},
-	"deleteloadbalancer": {
-		Action: "delete",
-		Entity: "loadbalancer",
-		Api:    "elbv2",
-		Params: new(DeleteLoadbalancer).ParamsSpec().Rule(),
-	},
-	"deleteloginprofile": {
-		Action: "delete",
-		Entity: "loginprofile",
-		Api:    "iam",
-		Params: new(DeleteLoginprofile).ParamsSpec().Rule(),
-	},
-	"deletemfadevice": {
-		Action: "delete",
-		Entity: "mfadevice",
-		Api:    "iam",
-		Params: new(DeleteMfadevice).ParamsSpec().Rule(),
-	},
-	"deletenatgateway": {
-		Action: "delete",
-		Entity: "natgateway",
-		Api:    "ec2",
-		Params: new(DeleteNatgateway).ParamsSpec().Rule(),
-	},
-	"deletenetworkinterface": {
-		Action: "delete",
-		Entity: "networkinterface",
-		Api:    "ec2",
-		Params: new(DeleteNetworkinterface).ParamsSpec().Rule(),
-	},
-	"deletepolicy": {
-		Action: "delete",
-		Entity: "policy",
-		Api:    "iam",
-		Params: new(DeletePolicy).ParamsSpec().Rule(),
-	},
-	"deletequeue": {
-		Action: "delete",
-		Entity: "queue",
-		Api:    "sqs",
-		Params: new(DeleteQueue).ParamsSpec().Rule(),
-	},
-	"deleterecord": {
-		Action: "delete",
-		Entity: "record",
-		Api:    "route53",
-		Params: new(DeleteRecord).ParamsSpec().Rule(),
-	},
-	"deleterepository": {
-		Action: "delete",
-		Entity: "repository",
-		Api:    "ecr",
-		Params: new(DeleteRepository).ParamsSpec().Rule(),
-	},
-	"deleterole": {
-		Action: "delete",
-		Entity: "role",
-		Api:    "iam",
-		Params: new(DeleteRole).ParamsSpec().Rule(),
-	},
-	"deleteroute": {
-		Action: "delete",
-		Entity: "route",
-		Api:    "ec2",
-		Params: new(DeleteRoute).ParamsSpec().Rule(),
-	},
-	"deleteroutetable": {
-		Action: "delete",
-		Entity: "routetable",
-		Api:    "ec2",
-		Params: new(DeleteRoutetable).ParamsSpec().Rule(),
-	},
-	"deletes3object": {
-		Action: "delete",
-		Entity: "s3object",
-		Api:    "s3",
-		Params: new(DeleteS3object).ParamsSpec().Rule(),
-	},
-	"deletescalinggroup": {
-		Action: "delete",
-		Entity: "scalinggroup",
-		Api:    "autoscaling",
-		Params: new(DeleteScalinggroup).ParamsSpec().Rule(),
-	},
-	"deletescalingpolicy": {
-		Action: "delete",
-		Entity: "scalingpolicy",
-		Api:    "autoscaling",
-		Params: new(DeleteScalingpolicy).ParamsSpec().Rule(),
-	},
-	"deletesecuritygroup": {
-		Action: "delete",
-		Entity: "securitygroup",
-		Api:    "ec2",
-		Params: new(DeleteSecuritygroup).ParamsSpec().Rule(),
-	},
-	"deletesnapshot": {
-		Action: "delete",
-		Entity: "snapshot",
-		Api:    "ec2",
-		Params: new(DeleteSnapshot).ParamsSpec().Rule(),
-	},
-	"deletestack": {
-		Action: "delete",
-		Entity: "stack",
-		Api:    "cloudformation",
-		Params: new(DeleteStack).ParamsSpec().Rule(),
-	},
-	"deletesubnet": {
-		Action: "delete",
-		Entity: "subnet",
-		Api:    "ec2",
-		Params: new(DeleteSubnet).ParamsSpec().Rule(),
-	},
-	"deletesubscription": {
-		Action: "delete",
-		Entity: "subscription",
-		Api:    "sns",
-		Params: new(DeleteSubscription).ParamsSpec().Rule(),
-	},
-	"deletetag": {
-		Action: "delete",
-		Entity: "tag",
-		Api:    "ec2",
-		Params: new(DeleteTag).ParamsSpec().Rule(),
-	},
-	"deletetargetgroup": {
-		Action: "delete",
-		Entity: "targetgroup",
-		Api:    "elbv2",
-		Params: new(DeleteTargetgroup).ParamsSpec().Rule(),
-	},
-	"deletetopic": {
-		Action: "delete",
-		Entity: "topic",
-		Api:    "sns",
-		Params: new(DeleteTopic).ParamsSpec().Rule(),
-	},
-	"deleteuser": {
-		Action: "delete",
-		Entity: "user",
-		Api:    "iam",
-		Params: new(DeleteUser).ParamsSpec().Rule(),
-	},
-	"deletevolume": {
-		Action: "delete",
-		Entity: "volume",
-		Api:    "ec2",
-		Params: new(DeleteVolume).ParamsSpec().Rule(),
-	},
-	"deletevpc": {
-		Action: "delete",
-		Entity: "vpc",
-		Api:    "ec2",
-		Params: new(DeleteVpc).ParamsSpec().Rule(),
-	},
-	"deletezone": {
-		Action: "delete",
-		Entity: "zone",
-		Api:    "route53",
-		Params: new(DeleteZone).ParamsSpec().Rule(),
-	},
-	"detachalarm": {
-		Action: "detach",
-		Entity: "alarm",
-		Api:    "cloudwatch",
-		Params: new(DetachAlarm).ParamsSpec().Rule(),
-	},
-	"detachclassicloadbalancer": {
-		Action: "detach",
-		Entity: "classicloadbalancer",
-		Api:    "elb",
-		Params: new(DetachClassicLoadbalancer).ParamsSpec().Rule(),
-	},
-	"detachcontainertask": {
-		Action: "detach",
-		Entity: "containertask",
-		Api:    "ecs",
-		Params: new(DetachContainertask).ParamsSpec().Rule(),
-	},
-	"detachelasticip": {
-		Action: "detach",
-		Entity: "elasticip",
-		Api:    "ec2",
-		Params: new(DetachElasticip).ParamsSpec().Rule(),
-	},
-	"detachinstance": {
-		Action: "detach",
-		Entity: "instance",
-		Api:    "elbv2",
-		Params: new(DetachInstance).ParamsSpec().Rule(),
-	},
-	"detachinstanceprofile": {
-		Action: "detach",
-		Entity: "instanceprofile",
-		Api:    "ec2",
-		Params: new(DetachInstanceprofile).ParamsSpec().Rule(),
-	},
-	"detachinternetgateway": {
-		Action: "detach",
-		Entity: "internetgateway",
-		Api:    "ec2",
-		Params: new(DetachInternetgateway).ParamsSpec().Rule(),
-	},
-	"detachmfadevice": {
-		Action: "detach",
-		Entity: "mfadevice",
-		Api:    "iam",
-		Params: new(DetachMfadevice).ParamsSpec().Rule(),
-	},
-	"detachnetworkinterface": {
-		Action: "detach",
-		Entity: "networkinterface",
-		Api:    "ec2",
-		Params: new(DetachNetworkinterface).ParamsSpec().Rule(),
-	},
-	"detachpolicy": {
-		Action: "detach",
-		Entity: "policy",
-		Api:    "iam",
-		Params: new(DetachPolicy).ParamsSpec().Rule(),
-	},
-	"detachrole": {
-		Action: "detach",
-		Entity: "role",
-		Api:    "iam",
-		Params: new(DetachRole).ParamsSpec().Rule(),
-	},
-	"detachroutetable": {
-		Action: "detach",
-		Entity: "routetable",
-		Api:    "ec2",
-		Params: new(DetachRoutetable).ParamsSpec().Rule(),
-	},
-	"detachsecuritygroup": {
-		Action: "detach",
-		Entity: "securitygroup",
-		Api:    "ec2",
-		Params: new(DetachSecuritygroup).ParamsSpec().Rule(),
-	},
-	"detachuser": {
-		Action: "detach",
-		Entity: "user",
-		Api:    "iam",
-		Params: new(DetachUser).ParamsSpec().Rule(),
-	},
-	"detachvolume": {
-		Action: "detach",
-		Entity: "volume",
-		Api:    "ec2",
-		Params: new(DetachVolume).ParamsSpec().Rule(),
-	},
-	"importimage": {
-		Action: "import",
-		Entity: "image",
-		Api:    "ec2",
-		Params: new(ImportImage).ParamsSpec().Rule(),
-	},
-	"restartdatabase": {
-		Action: "restart",
-		Entity: "database",
-		Api:    "rds",
-		Params: new(RestartDatabase).ParamsSpec().Rule(),
-	},
-	"restartinstance": {
-		Action: "restart",
-		Entity: "instance",
-		Api:    "ec2",
-		Params: new(RestartInstance).ParamsSpec().Rule(),
-	},
-	"startalarm": {
-		Action: "start",
-		Entity: "alarm",
-		Api:    "cloudwatch",
-		Params: new(StartAlarm).ParamsSpec().Rule(),
-	},
-	"startcontainertask": {
-		Action: "start",
-		Entity: "containertask",
-		Api:    "ecs",
-		Params: new(StartContainertask).ParamsSpec().Rule(),
-	},
-	"startdatabase": {
-		Action: "start",
-		Entity: "database",
-		Api:    "rds",
-		Params: new(StartDatabase).ParamsSpec().Rule(),
-	},
-	"startinstance": {
-		Action: "start",
-		Entity: "instance",
-		Api:    "ec2",
-		Params: new(StartInstance).ParamsSpec().Rule(),
-	},
-	"stopalarm": {
-		Action: "stop",
-		Entity: "alarm",
-		Api:    "cloudwatch",
-		Params: new(StopAlarm).ParamsSpec().Rule(),
-	},
-	"stopcontainertask": {
-		Action: "stop",
-		Entity: "containertask",
-		Api:    "ecs",
-		Params: new(StopContainertask).ParamsSpec().Rule(),
-	},
-	"stopdatabase": {
-		Action: "stop",
-		Entity: "database",
-		Api:    "rds",
-		Params: new(StopDatabase).ParamsSpec().Rule(),
-	},
-	"stopinstance": {
-		Action: "stop",
-		Entity: "instance",
-		Api:    "ec2",
-		Params: new(StopInstance).ParamsSpec().Rule(),
-	},
-	"updatebucket": {
-		Action: "update",
-		Entity: "bucket",
-		Api:    "s3",
-		Params: new(UpdateBucket).ParamsSpec().Rule(),
-	},
-	"updateclassicloadbalancer": {
-		Action: "update",
-		Entity: "classicloadbalancer",
-		Api:    "elb",
-		Params: new(UpdateClassicLoadbalancer).ParamsSpec().Rule(),
-	},
-	"updatecontainertask": {
-		Action: "update",
-		Entity: "containertask",
-		Api:    "ecs",
-		Params: new(UpdateContainertask).ParamsSpec().Rule(),
-	},
-	"updatedistribution": {
-		Action: "update",
-		Entity: "distribution",
-		Api:    "cloudfront",
-		Params: new(UpdateDistribution).ParamsSpec().Rule(),
-	},
-	"updateimage": {
-		Action: "update",
-		Entity: "image",
-		Api:    "ec2",
-		Params: new(UpdateImage).ParamsSpec().Rule(),
-	},
-	"updateinstance": {
-		Action: "update",
-		Entity: "instance",
-		Api:    "ec2",
-		Params: new(UpdateInstance).ParamsSpec().Rule(),
-	},
-	"updateloginprofile": {
-		Action: "update",
-		Entity: "loginprofile",
-		Api:    "iam",
-		Params: new(UpdateLoginprofile).ParamsSpec().Rule(),
-	},
-	"updatepolicy": {
-		Action: "update",
-		Entity: "policy",
-		Api:    "iam",
-		Params: new(UpdatePolicy).ParamsSpec().Rule(),
-	},
-	"updaterecord": {
-		Action: "update",
-		Entity: "record",
-		Api:    "route53",
-		Params: new(UpdateRecord).ParamsSpec().Rule(),
-	},
-	"updates3object": {
-		Action: "update",
-		Entity: "s3object",
-		Api:    "s3",
-		Params: new(UpdateS3object).ParamsSpec().Rule(),
-	},
-	"updatescalinggroup": {
-		Action: "update",
-		Entity: "scalinggroup",
-		Api:    "autoscaling",
-		Params: new(UpdateScalinggroup).ParamsSpec().Rule(),
-	},
-	"updatesecuritygroup": {
-		Action: "update",
-		Entity: "securitygroup",
-		Api:    "ec2",
-		Params: new(UpdateSecuritygroup).ParamsSpec().Rule(),
-	},
-	"updatestack": {
-		Action: "update",
-		Entity: "stack",
-		Api:    "cloudformation",
-		Params: new(UpdateStack).ParamsSpec().Rule(),
-	},
-	"updatesubnet": {
-		Action: "update",
-		Entity: "subnet",
-		Api:    "ec2",
-		Params: new(UpdateSubnet).ParamsSpec().Rule(),
-	},
-	"updatetargetgroup": {
-		Action: "update",
-		Entity: "targetgroup",
-		Api:    "elbv2",
-		Params: new(UpdateTargetgroup).ParamsSpec().Rule(),
+var awsTemplatesDefinitions = map[string]func() Definition{
+	"attachalarm": func() Definition {
+		return Definition{
+			Action: "attach",
+			Entity: "alarm",
+			Api:    "cloudwatch",
+			Params: new(AttachAlarm).ParamsSpec().Rule(),
+		}
+	},
+	"attachclassicloadbalancer": func() Definition {
+		return Definition{
+			Action: "attach",
+			Entity: "classicloadbalancer",
+			Api:    "elb",
+			Params: new(AttachClassicLoadbalancer).ParamsSpec().Rule(),
+		}
+	},
+	"attachcontainertask": func() Definition {
+		return Definition{
+			Action: "attach",
+			Entity: "containertask",
+			Api:    "ecs",
+			Params: new(AttachContainertask).ParamsSpec().Rule(),
+		}
+	},
+	"attachdhcpoptions": func() Definition {
+		return Definition{
+			Action: "attach",
+			Entity: "dhcpoptions",
+			Api:    "ec2",
+			Params: new(AttachDhcpoptions).ParamsSpec().Rule(),
+		}
+	},
+	"attachelasticip": func() Definition {
+		return Definition{
+			Action: "attach",
+			Entity: "elasticip",
+			Api:    "ec2",
+			Params: new(AttachElasticip).ParamsSpec().Rule(),
+		}
+	},
+	"attachinstance": func() Definition {
+		return Definition{
+			Action: "attach",
+			Entity: "instance",
+			Api:    "elbv2",
+			Params: new(AttachInstance).ParamsSpec().Rule(),
+		}
+	},
+	"attachinstanceprofile": func() Definition {
+		return Definition{
+			Action: "attach",
+			Entity: "instanceprofile",
+			Api:    "ec2",
+			Params: new(AttachInstanceprofile).ParamsSpec().Rule(),
+		}
+	},
+	"attachinternetgateway": func() Definition {
+		return Definition{
+			Action: "attach",
+			Entity: "internetgateway",
+			Api:    "ec2",
+			Params: new(AttachInternetgateway).ParamsSpec().Rule(),
+		}
+	},
+	"attachlistener": func() Definition {
+		return Definition{
+			Action: "attach",
+			Entity: "listener",
+			Api:    "elbv2",
+			Params: new(AttachListener).ParamsSpec().Rule(),
+		}
+	},
+	"attachmfadevice": func() Definition {
+		return Definition{
+			Action: "attach",
+			Entity: "mfadevice",
+			Api:    "iam",
+			Params: new(AttachMfadevice).ParamsSpec().Rule(),
+		}
+	},
+	"attachnetworkinterface": func() Definition {
+		return Definition{
+			Action: "attach",
+			Entity: "networkinterface",
+			Api:    "ec2",
+			Params: new(AttachNetworkinterface).ParamsSpec().Rule(),
+		}
+	},
+	"attachpolicy": func() Definition {
+		return Definition{
+			Action: "attach",
+			Entity: "policy",
+			Api:    "iam",
+			Params: new(AttachPolicy).ParamsSpec().Rule(),
+		}
+	},
+	"attachqueuepolicy": func() Definition {
+		return Definition{
+			Action: "attach",
+			Entity: "queuepolicy",
+			Api:    "sqs",
+			Params: new(AttachQueuepolicy).ParamsSpec().Rule(),
+		}
+	},
+	"attachrole": func() Definition {
+		return Definition{
+			Action: "attach",
+			Entity: "role",
+			Api:    "iam",
+			Params: new(AttachRole).ParamsSpec().Rule(),
+		}
+	},
+	"attachroutetable": func() Definition {
+		return Definition{
+			Action: "attach",
+			Entity: "routetable",
+			Api:    "ec2",
+			Params: new(AttachRoutetable).ParamsSpec().Rule(),
+		}
+	},
+	"attachscalinggroup": func() Definition {
+		return Definition{
+			Action: "attach",
+			Entity: "scalinggroup",
+			Api:    "autoscaling",
+			Params: new(AttachScalinggroup).ParamsSpec().Rule(),
+		}
+	},
+	"attachsecuritygroup": func() Definition {
+		return Definition{
+			Action: "attach",
+			Entity: "securitygroup",
+			Api:    "ec2",
+			Params: new(AttachSecuritygroup).ParamsSpec().Rule(),
+		}
+	},
+	"attachuser": func() Definition {
+		return Definition{
+			Action: "attach",
+			Entity: "user",
+			Api:    "iam",
+			Params: new(AttachUser).ParamsSpec().Rule(),
+		}
+	},
+	"attachvolume": func() Definition {
+		return Definition{
+			Action: "attach",
+			Entity: "volume",
+			Api:    "ec2",
+			Params: new(AttachVolume).ParamsSpec().Rule(),
+		}
+	},
+	"attachvpcendpoint": func() Definition {
+		return Definition{
+			Action: "attach",
+			Entity: "vpcendpoint",
+			Api:    "ec2",
+			Params: new(AttachVpcendpoint).ParamsSpec().Rule(),
+		}
+	},
+	"authenticateregistry": func() Definition {
+		return Definition{
+			Action: "authenticate",
+			Entity: "registry",
+			Api:    "ecr",
+			Params: new(AuthenticateRegistry).ParamsSpec().Rule(),
+		}
+	},
+	"backupinstance": func() Definition {
+		return Definition{
+			Action: "backup",
+			Entity: "instance",
+			Api:    "ec2",
+			Params: new(BackupInstance).ParamsSpec().Rule(),
+		}
+	},
+	"bootstrapinstance": func() Definition {
+		return Definition{
+			Action: "bootstrap",
+			Entity: "instance",
+			Api:    "ec2",
+			Params: new(BootstrapInstance).ParamsSpec().Rule(),
+		}
+	},
+	"checkalarm": func() Definition {
+		return Definition{
+			Action: "check",
+			Entity: "alarm",
+			Api:    "cloudwatch",
+			Params: new(CheckAlarm).ParamsSpec().Rule(),
+		}
+	},
+	"checkcertificate": func() Definition {
+		return Definition{
+			Action: "check",
+			Entity: "certificate",
+			Api:    "acm",
+			Params: new(CheckCertificate).ParamsSpec().Rule(),
+		}
+	},
+	"checkdatabase": func() Definition {
+		return Definition{
+			Action: "check",
+			Entity: "database",
+			Api:    "rds",
+			Params: new(CheckDatabase).ParamsSpec().Rule(),
+		}
+	},
+	"checkdistribution": func() Definition {
+		return Definition{
+			Action: "check",
+			Entity: "distribution",
+			Api:    "cloudfront",
+			Params: new(CheckDistribution).ParamsSpec().Rule(),
+		}
+	},
+	"checkelasticsearchdomain": func() Definition {
+		return Definition{
+			Action: "check",
+			Entity: "elasticsearchdomain",
+			Api:    "elasticsearchservice",
+			Params: new(CheckElasticsearchdomain).ParamsSpec().Rule(),
+		}
+	},
+	"checkenvironment": func() Definition {
+		return Definition{
+			Action: "check",
+			Entity: "environment",
+			Api:    "elasticbeanstalk",
+			Params: new(CheckEnvironment).ParamsSpec().Rule(),
+		}
+	},
+	"checkhealthcheck": func() Definition {
+		return Definition{
+			Action: "check",
+			Entity: "healthcheck",
+			Api:    "route53",
+			Params: new(CheckHealthcheck).ParamsSpec().Rule(),
+		}
+	},
+	"checkinstance": func() Definition {
+		return Definition{
+			Action: "check",
+			Entity: "instance",
+			Api:    "ec2",
+			Params: new(CheckInstance).ParamsSpec().Rule(),
+		}
+	},
+	"checkloadbalancer": func() Definition {
+		return Definition{
+			Action: "check",
+			Entity: "loadbalancer",
+			Api:    "elbv2",
+			Params: new(CheckLoadbalancer).ParamsSpec().Rule(),
+		}
+	},
+	"checknatgateway": func() Definition {
+		return Definition{
+			Action: "check",
+			Entity: "natgateway",
+			Api:    "ec2",
+			Params: new(CheckNatgateway).ParamsSpec().Rule(),
+		}
+	},
+	"checknetworkinterface": func() Definition {
+		return Definition{
+			Action: "check",
+			Entity: "networkinterface",
+			Api:    "ec2",
+			Params: new(CheckNetworkinterface).ParamsSpec().Rule(),
+		}
+	},
+	"checkrecord": func() Definition {
+		return Definition{
+			Action: "check",
+			Entity: "record",
+			Api:    "route53",
+			Params: new(CheckRecord).ParamsSpec().Rule(),
+		}
+	},
+	"checkscalinggroup": func() Definition {
+		return Definition{
+			Action: "check",
+			Entity: "scalinggroup",
+			Api:    "autoscaling",
+			Params: new(CheckScalinggroup).ParamsSpec().Rule(),
+		}
+	},
+	"checksecuritygroup": func() Definition {
+		return Definition{
+			Action: "check",
+			Entity: "securitygroup",
+			Api:    "ec2",
+			Params: new(CheckSecuritygroup).ParamsSpec().Rule(),
+		}
+	},
+	"checktable": func() Definition {
+		return Definition{
+			Action: "check",
+			Entity: "table",
+			Api:    "dynamodb",
+			Params: new(CheckTable).ParamsSpec().Rule(),
+		}
+	},
+	"checkvolume": func() Definition {
+		return Definition{
+			Action: "check",
+			Entity: "volume",
+			Api:    "ec2",
+			Params: new(CheckVolume).ParamsSpec().Rule(),
+		}
+	},
+	"copyimage": func() Definition {
+		return Definition{
+			Action: "copy",
+			Entity: "image",
+			Api:    "ec2",
+			Params: new(CopyImage).ParamsSpec().Rule(),
+		}
+	},
+	"copysnapshot": func() Definition {
+		return Definition{
+			Action: "copy",
+			Entity: "snapshot",
+			Api:    "ec2",
+			Params: new(CopySnapshot).ParamsSpec().Rule(),
+		}
+	},
+	"createaccesskey": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "accesskey",
+			Api:    "iam",
+			Params: new(CreateAccesskey).ParamsSpec().Rule(),
+		}
+	},
+	"createalarm": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "alarm",
+			Api:    "cloudwatch",
+			Params: new(CreateAlarm).ParamsSpec().Rule(),
+		}
+	},
+	"createapplication": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "application",
+			Api:    "elasticbeanstalk",
+			Params: new(CreateApplication).ParamsSpec().Rule(),
+		}
+	},
+	"createappscalingpolicy": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "appscalingpolicy",
+			Api:    "applicationautoscaling",
+			Params: new(CreateAppscalingpolicy).ParamsSpec().Rule(),
+		}
+	},
+	"createappscalingtarget": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "appscalingtarget",
+			Api:    "applicationautoscaling",
+			Params: new(CreateAppscalingtarget).ParamsSpec().Rule(),
+		}
+	},
+	"createbucket": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "bucket",
+			Api:    "s3",
+			Params: new(CreateBucket).ParamsSpec().Rule(),
+		}
+	},
+	"createcertificate": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "certificate",
+			Api:    "acm",
+			Params: new(CreateCertificate).ParamsSpec().Rule(),
+		}
+	},
+	"createclassicloadbalancer": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "classicloadbalancer",
+			Api:    "elb",
+			Params: new(CreateClassicLoadbalancer).ParamsSpec().Rule(),
+		}
+	},
+	"createcontainercluster": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "containercluster",
+			Api:    "ecs",
+			Params: new(CreateContainercluster).ParamsSpec().Rule(),
+		}
+	},
+	"createdatabase": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "database",
+			Api:    "rds",
+			Params: new(CreateDatabase).ParamsSpec().Rule(),
+		}
+	},
+	"createdbsubnetgroup": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "dbsubnetgroup",
+			Api:    "rds",
+			Params: new(CreateDbsubnetgroup).ParamsSpec().Rule(),
+		}
+	},
+	"createdhcpoptions": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "dhcpoptions",
+			Api:    "ec2",
+			Params: new(CreateDhcpoptions).ParamsSpec().Rule(),
+		}
+	},
+	"createdistribution": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "distribution",
+			Api:    "cloudfront",
+			Params: new(CreateDistribution).ParamsSpec().Rule(),
+		}
+	},
+	"createegressonlyinternetgateway": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "egressonlyinternetgateway",
+			Api:    "ec2",
+			Params: new(CreateEgressonlyinternetgateway).ParamsSpec().Rule(),
+		}
+	},
+	"createelasticip": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "elasticip",
+			Api:    "ec2",
+			Params: new(CreateElasticip).ParamsSpec().Rule(),
+		}
+	},
+	"createelasticsearchdomain": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "elasticsearchdomain",
+			Api:    "elasticsearchservice",
+			Params: new(CreateElasticsearchdomain).ParamsSpec().Rule(),
+		}
+	},
+	"createenvironment": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "environment",
+			Api:    "elasticbeanstalk",
+			Params: new(CreateEnvironment).ParamsSpec().Rule(),
+		}
+	},
+	"createfailover": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "failover",
+			Api:    "route53",
+			Params: new(CreateFailover).ParamsSpec().Rule(),
+		}
+	},
+	"createfunction": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "function",
+			Api:    "lambda",
+			Params: new(CreateFunction).ParamsSpec().Rule(),
+		}
+	},
+	"creategroup": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "group",
+			Api:    "iam",
+			Params: new(CreateGroup).ParamsSpec().Rule(),
+		}
+	},
+	"createhealthcheck": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "healthcheck",
+			Api:    "route53",
+			Params: new(CreateHealthcheck).ParamsSpec().Rule(),
+		}
+	},
+	"createimage": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "image",
+			Api:    "ec2",
+			Params: new(CreateImage).ParamsSpec().Rule(),
+		}
+	},
+	"createinstance": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "instance",
+			Api:    "ec2",
+			Params: new(CreateInstance).ParamsSpec().Rule(),
+		}
+	},
+	"createinstanceprofile": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "instanceprofile",
+			Api:    "iam",
+			Params: new(CreateInstanceprofile).ParamsSpec().Rule(),
+		}
+	},
+	"createinternetgateway": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "internetgateway",
+			Api:    "ec2",
+			Params: new(CreateInternetgateway).ParamsSpec().Rule(),
+		}
+	},
+	"createkeypair": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "keypair",
+			Api:    "ec2",
+			Params: new(CreateKeypair).ParamsSpec().Rule(),
+		}
+	},
+	"createlaunchconfiguration": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "launchconfiguration",
+			Api:    "autoscaling",
+			Params: new(CreateLaunchconfiguration).ParamsSpec().Rule(),
+		}
+	},
+	"createlistener": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "listener",
+			Api:    "elbv2",
+			Params: new(CreateListener).ParamsSpec().Rule(),
+		}
+	},
+	"createloadbalancer": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "loadbalancer",
+			Api:    "elbv2",
+			Params: new(CreateLoadbalancer).ParamsSpec().Rule(),
+		}
+	},
+	"createloginprofile": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "loginprofile",
+			Api:    "iam",
+			Params: new(CreateLoginprofile).ParamsSpec().Rule(),
+		}
+	},
+	"createmfadevice": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "mfadevice",
+			Api:    "iam",
+			Params: new(CreateMfadevice).ParamsSpec().Rule(),
+		}
+	},
+	"createnatgateway": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "natgateway",
+			Api:    "ec2",
+			Params: new(CreateNatgateway).ParamsSpec().Rule(),
+		}
+	},
+	"createnetworkinterface": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "networkinterface",
+			Api:    "ec2",
+			Params: new(CreateNetworkinterface).ParamsSpec().Rule(),
+		}
+	},
+	"createpolicy": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "policy",
+			Api:    "iam",
+			Params: new(CreatePolicy).ParamsSpec().Rule(),
+		}
+	},
+	"createqueue": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "queue",
+			Api:    "sqs",
+			Params: new(CreateQueue).ParamsSpec().Rule(),
+		}
+	},
+	"createrecord": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "record",
+			Api:    "route53",
+			Params: new(CreateRecord).ParamsSpec().Rule(),
+		}
+	},
+	"createrecords": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "records",
+			Api:    "route53",
+			Params: new(CreateRecords).ParamsSpec().Rule(),
+		}
+	},
+	"createrepository": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "repository",
+			Api:    "ecr",
+			Params: new(CreateRepository).ParamsSpec().Rule(),
+		}
+	},
+	"createrole": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "role",
+			Api:    "iam",
+			Params: new(CreateRole).ParamsSpec().Rule(),
+		}
+	},
+	"createroute": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "route",
+			Api:    "ec2",
+			Params: new(CreateRoute).ParamsSpec().Rule(),
+		}
+	},
+	"createroutetable": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "routetable",
+			Api:    "ec2",
+			Params: new(CreateRoutetable).ParamsSpec().Rule(),
+		}
+	},
+	"creates3object": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "s3object",
+			Api:    "s3",
+			Params: new(CreateS3object).ParamsSpec().Rule(),
+		}
+	},
+	"createscalinggroup": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "scalinggroup",
+			Api:    "autoscaling",
+			Params: new(CreateScalinggroup).ParamsSpec().Rule(),
+		}
+	},
+	"createscalingpolicy": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "scalingpolicy",
+			Api:    "autoscaling",
+			Params: new(CreateScalingpolicy).ParamsSpec().Rule(),
+		}
+	},
+	"createsecuritygroup": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "securitygroup",
+			Api:    "ec2",
+			Params: new(CreateSecuritygroup).ParamsSpec().Rule(),
+		}
+	},
+	"createsnapshot": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "snapshot",
+			Api:    "ec2",
+			Params: new(CreateSnapshot).ParamsSpec().Rule(),
+		}
+	},
+	"createstack": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "stack",
+			Api:    "cloudformation",
+			Params: new(CreateStack).ParamsSpec().Rule(),
+		}
+	},
+	"createsubnet": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "subnet",
+			Api:    "ec2",
+			Params: new(CreateSubnet).ParamsSpec().Rule(),
+		}
+	},
+	"createsubscription": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "subscription",
+			Api:    "sns",
+			Params: new(CreateSubscription).ParamsSpec().Rule(),
+		}
+	},
+	"createtable": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "table",
+			Api:    "dynamodb",
+			Params: new(CreateTable).ParamsSpec().Rule(),
+		}
+	},
+	"createtag": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "tag",
+			Api:    "ec2",
+			Params: new(CreateTag).ParamsSpec().Rule(),
+		}
+	},
+	"createtargetgroup": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "targetgroup",
+			Api:    "elbv2",
+			Params: new(CreateTargetgroup).ParamsSpec().Rule(),
+		}
+	},
+	"createtopic": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "topic",
+			Api:    "sns",
+			Params: new(CreateTopic).ParamsSpec().Rule(),
+		}
+	},
+	"createuser": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "user",
+			Api:    "iam",
+			Params: new(CreateUser).ParamsSpec().Rule(),
+		}
+	},
+	"createvolume": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "volume",
+			Api:    "ec2",
+			Params: new(CreateVolume).ParamsSpec().Rule(),
+		}
+	},
+	"createvpc": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "vpc",
+			Api:    "ec2",
+			Params: new(CreateVpc).ParamsSpec().Rule(),
+		}
+	},
+	"createvpcendpoint": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "vpcendpoint",
+			Api:    "ec2",
+			Params: new(CreateVpcendpoint).ParamsSpec().Rule(),
+		}
+	},
+	"createzone": func() Definition {
+		return Definition{
+			Action: "create",
+			Entity: "zone",
+			Api:    "route53",
+			Params: new(CreateZone).ParamsSpec().Rule(),
+		}
+	},
+	"deleteaccesskey": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "accesskey",
+			Api:    "iam",
+			Params: new(DeleteAccesskey).ParamsSpec().Rule(),
+		}
+	},
+	"deletealarm": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "alarm",
+			Api:    "cloudwatch",
+			Params: new(DeleteAlarm).ParamsSpec().Rule(),
+		}
+	},
+	"deleteapplication": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "application",
+			Api:    "elasticbeanstalk",
+			Params: new(DeleteApplication).ParamsSpec().Rule(),
+		}
+	},
+	"deleteappscalingpolicy": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "appscalingpolicy",
+			Api:    "applicationautoscaling",
+			Params: new(DeleteAppscalingpolicy).ParamsSpec().Rule(),
+		}
+	},
+	"deleteappscalingtarget": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "appscalingtarget",
+			Api:    "applicationautoscaling",
+			Params: new(DeleteAppscalingtarget).ParamsSpec().Rule(),
+		}
+	},
+	"deletebucket": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "bucket",
+			Api:    "s3",
+			Params: new(DeleteBucket).ParamsSpec().Rule(),
+		}
+	},
+	"deletecertificate": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "certificate",
+			Api:    "acm",
+			Params: new(DeleteCertificate).ParamsSpec().Rule(),
+		}
+	},
+	"deleteclassicloadbalancer": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "classicloadbalancer",
+			Api:    "elb",
+			Params: new(DeleteClassicLoadbalancer).ParamsSpec().Rule(),
+		}
+	},
+	"deletecontainercluster": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "containercluster",
+			Api:    "ecs",
+			Params: new(DeleteContainercluster).ParamsSpec().Rule(),
+		}
+	},
+	"deletecontainertask": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "containertask",
+			Api:    "ecs",
+			Params: new(DeleteContainertask).ParamsSpec().Rule(),
+		}
+	},
+	"deletedatabase": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "database",
+			Api:    "rds",
+			Params: new(DeleteDatabase).ParamsSpec().Rule(),
+		}
+	},
+	"deletedbsubnetgroup": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "dbsubnetgroup",
+			Api:    "rds",
+			Params: new(DeleteDbsubnetgroup).ParamsSpec().Rule(),
+		}
+	},
+	"deletedhcpoptions": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "dhcpoptions",
+			Api:    "ec2",
+			Params: new(DeleteDhcpoptions).ParamsSpec().Rule(),
+		}
+	},
+	"deletedistribution": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "distribution",
+			Api:    "cloudfront",
+			Params: new(DeleteDistribution).ParamsSpec().Rule(),
+		}
+	},
+	"deleteegressonlyinternetgateway": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "egressonlyinternetgateway",
+			Api:    "ec2",
+			Params: new(DeleteEgressonlyinternetgateway).ParamsSpec().Rule(),
+		}
+	},
+	"deleteelasticip": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "elasticip",
+			Api:    "ec2",
+			Params: new(DeleteElasticip).ParamsSpec().Rule(),
+		}
+	},
+	"deleteelasticsearchdomain": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "elasticsearchdomain",
+			Api:    "elasticsearchservice",
+			Params: new(DeleteElasticsearchdomain).ParamsSpec().Rule(),
+		}
+	},
+	"deleteenvironment": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "environment",
+			Api:    "elasticbeanstalk",
+			Params: new(DeleteEnvironment).ParamsSpec().Rule(),
+		}
+	},
+	"deletefunction": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "function",
+			Api:    "lambda",
+			Params: new(DeleteFunction).ParamsSpec().Rule(),
+		}
+	},
+	"deletegroup": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "group",
+			Api:    "iam",
+			Params: new(DeleteGroup).ParamsSpec().Rule(),
+		}
+	},
+	"deletehealthcheck": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "healthcheck",
+			Api:    "route53",
+			Params: new(DeleteHealthcheck).ParamsSpec().Rule(),
+		}
+	},
+	"deleteimage": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "image",
+			Api:    "ec2",
+			Params: new(DeleteImage).ParamsSpec().Rule(),
+		}
+	},
+	"deleteinstance": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "instance",
+			Api:    "ec2",
+			Params: new(DeleteInstance).ParamsSpec().Rule(),
+		}
+	},
+	"deleteinstanceprofile": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "instanceprofile",
+			Api:    "iam",
+			Params: new(DeleteInstanceprofile).ParamsSpec().Rule(),
+		}
+	},
+	"deleteinternetgateway": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "internetgateway",
+			Api:    "ec2",
+			Params: new(DeleteInternetgateway).ParamsSpec().Rule(),
+		}
+	},
+	"deletekeypair": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "keypair",
+			Api:    "ec2",
+			Params: new(DeleteKeypair).ParamsSpec().Rule(),
+		}
+	},
+	"deletelaunchconfiguration": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "launchconfiguration",
+			Api:    "autoscaling",
+			Params: new(DeleteLaunchconfiguration).ParamsSpec().Rule(),
+		}
+	},
+	"deletelistener": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "listener",
+			Api:    "elbv2",
+			Params: new(DeleteListener).ParamsSpec().Rule(),
+		}
+	},
+	"deleteloadbalancer": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "loadbalancer",
+			Api:    "elbv2",
+			Params: new(DeleteLoadbalancer).ParamsSpec().Rule(),
+		}
+	},
+	"deleteloginprofile": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "loginprofile",
+			Api:    "iam",
+			Params: new(DeleteLoginprofile).ParamsSpec().Rule(),
+		}
+	},
+	"deletemfadevice": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "mfadevice",
+			Api:    "iam",
+			Params: new(DeleteMfadevice).ParamsSpec().Rule(),
+		}
+	},
+	"deletenatgateway": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "natgateway",
+			Api:    "ec2",
+			Params: new(DeleteNatgateway).ParamsSpec().Rule(),
+		}
+	},
+	"deletenetworkinterface": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "networkinterface",
+			Api:    "ec2",
+			Params: new(DeleteNetworkinterface).ParamsSpec().Rule(),
+		}
+	},
+	"deletepolicy": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "policy",
+			Api:    "iam",
+			Params: new(DeletePolicy).ParamsSpec().Rule(),
+		}
+	},
+	"deletequeue": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "queue",
+			Api:    "sqs",
+			Params: new(DeleteQueue).ParamsSpec().Rule(),
+		}
+	},
+	"deleterecord": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "record",
+			Api:    "route53",
+			Params: new(DeleteRecord).ParamsSpec().Rule(),
+		}
+	},
+	"deleterecords": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "records",
+			Api:    "route53",
+			Params: new(DeleteRecords).ParamsSpec().Rule(),
+		}
+	},
+	"deleterepository": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "repository",
+			Api:    "ecr",
+			Params: new(DeleteRepository).ParamsSpec().Rule(),
+		}
+	},
+	"deleterole": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "role",
+			Api:    "iam",
+			Params: new(DeleteRole).ParamsSpec().Rule(),
+		}
+	},
+	"deleteroute": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "route",
+			Api:    "ec2",
+			Params: new(DeleteRoute).ParamsSpec().Rule(),
+		}
+	},
+	"deleteroutetable": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "routetable",
+			Api:    "ec2",
+			Params: new(DeleteRoutetable).ParamsSpec().Rule(),
+		}
+	},
+	"deletes3object": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "s3object",
+			Api:    "s3",
+			Params: new(DeleteS3object).ParamsSpec().Rule(),
+		}
+	},
+	"deletescalinggroup": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "scalinggroup",
+			Api:    "autoscaling",
+			Params: new(DeleteScalinggroup).ParamsSpec().Rule(),
+		}
+	},
+	"deletescalingpolicy": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "scalingpolicy",
+			Api:    "autoscaling",
+			Params: new(DeleteScalingpolicy).ParamsSpec().Rule(),
+		}
+	},
+	"deletesecuritygroup": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "securitygroup",
+			Api:    "ec2",
+			Params: new(DeleteSecuritygroup).ParamsSpec().Rule(),
+		}
+	},
+	"deletesnapshot": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "snapshot",
+			Api:    "ec2",
+			Params: new(DeleteSnapshot).ParamsSpec().Rule(),
+		}
+	},
+	"deletestack": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "stack",
+			Api:    "cloudformation",
+			Params: new(DeleteStack).ParamsSpec().Rule(),
+		}
+	},
+	"deletesubnet": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "subnet",
+			Api:    "ec2",
+			Params: new(DeleteSubnet).ParamsSpec().Rule(),
+		}
+	},
+	"deletesubscription": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "subscription",
+			Api:    "sns",
+			Params: new(DeleteSubscription).ParamsSpec().Rule(),
+		}
+	},
+	"deletetable": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "table",
+			Api:    "dynamodb",
+			Params: new(DeleteTable).ParamsSpec().Rule(),
+		}
+	},
+	"deletetag": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "tag",
+			Api:    "ec2",
+			Params: new(DeleteTag).ParamsSpec().Rule(),
+		}
+	},
+	"deletetargetgroup": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "targetgroup",
+			Api:    "elbv2",
+			Params: new(DeleteTargetgroup).ParamsSpec().Rule(),
+		}
+	},
+	"deletetopic": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "topic",
+			Api:    "sns",
+			Params: new(DeleteTopic).ParamsSpec().Rule(),
+		}
+	},
+	"deleteuser": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "user",
+			Api:    "iam",
+			Params: new(DeleteUser).ParamsSpec().Rule(),
+		}
+	},
+	"deletevolume": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "volume",
+			Api:    "ec2",
+			Params: new(DeleteVolume).ParamsSpec().Rule(),
+		}
+	},
+	"deletevpc": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "vpc",
+			Api:    "ec2",
+			Params: new(DeleteVpc).ParamsSpec().Rule(),
+		}
+	},
+	"deletevpcendpoint": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "vpcendpoint",
+			Api:    "ec2",
+			Params: new(DeleteVpcendpoint).ParamsSpec().Rule(),
+		}
+	},
+	"deletezone": func() Definition {
+		return Definition{
+			Action: "delete",
+			Entity: "zone",
+			Api:    "route53",
+			Params: new(DeleteZone).ParamsSpec().Rule(),
+		}
+	},
+	"detachalarm": func() Definition {
+		return Definition{
+			Action: "detach",
+			Entity: "alarm",
+			Api:    "cloudwatch",
+			Params: new(DetachAlarm).ParamsSpec().Rule(),
+		}
+	},
+	"detachclassicloadbalancer": func() Definition {
+		return Definition{
+			Action: "detach",
+			Entity: "classicloadbalancer",
+			Api:    "elb",
+			Params: new(DetachClassicLoadbalancer).ParamsSpec().Rule(),
+		}
+	},
+	"detachcontainertask": func() Definition {
+		return Definition{
+			Action: "detach",
+			Entity: "containertask",
+			Api:    "ecs",
+			Params: new(DetachContainertask).ParamsSpec().Rule(),
+		}
+	},
+	"detachdhcpoptions": func() Definition {
+		return Definition{
+			Action: "detach",
+			Entity: "dhcpoptions",
+			Api:    "ec2",
+			Params: new(DetachDhcpoptions).ParamsSpec().Rule(),
+		}
+	},
+	"detachelasticip": func() Definition {
+		return Definition{
+			Action: "detach",
+			Entity: "elasticip",
+			Api:    "ec2",
+			Params: new(DetachElasticip).ParamsSpec().Rule(),
+		}
+	},
+	"detachinstance": func() Definition {
+		return Definition{
+			Action: "detach",
+			Entity: "instance",
+			Api:    "elbv2",
+			Params: new(DetachInstance).ParamsSpec().Rule(),
+		}
+	},
+	"detachinstanceprofile": func() Definition {
+		return Definition{
+			Action: "detach",
+			Entity: "instanceprofile",
+			Api:    "ec2",
+			Params: new(DetachInstanceprofile).ParamsSpec().Rule(),
+		}
+	},
+	"detachinternetgateway": func() Definition {
+		return Definition{
+			Action: "detach",
+			Entity: "internetgateway",
+			Api:    "ec2",
+			Params: new(DetachInternetgateway).ParamsSpec().Rule(),
+		}
+	},
+	"detachmfadevice": func() Definition {
+		return Definition{
+			Action: "detach",
+			Entity: "mfadevice",
+			Api:    "iam",
+			Params: new(DetachMfadevice).ParamsSpec().Rule(),
+		}
+	},
+	"detachnetworkinterface": func() Definition {
+		return Definition{
+			Action: "detach",
+			Entity: "networkinterface",
+			Api:    "ec2",
+			Params: new(DetachNetworkinterface).ParamsSpec().Rule(),
+		}
+	},
+	"detachpolicy": func() Definition {
+		return Definition{
+			Action: "detach",
+			Entity: "policy",
+			Api:    "iam",
+			Params: new(DetachPolicy).ParamsSpec().Rule(),
+		}
+	},
+	"detachqueuepolicy": func() Definition {
+		return Definition{
+			Action: "detach",
+			Entity: "queuepolicy",
+			Api:    "sqs",
+			Params: new(DetachQueuepolicy).ParamsSpec().Rule(),
+		}
+	},
+	"detachrole": func() Definition {
+		return Definition{
+			Action: "detach",
+			Entity: "role",
+			Api:    "iam",
+			Params: new(DetachRole).ParamsSpec().Rule(),
+		}
+	},
+	"detachroutetable": func() Definition {
+		return Definition{
+			Action: "detach",
+			Entity: "routetable",
+			Api:    "ec2",
+			Params: new(DetachRoutetable).ParamsSpec().Rule(),
+		}
+	},
+	"detachscalinggroup": func() Definition {
+		return Definition{
+			Action: "detach",
+			Entity: "scalinggroup",
+			Api:    "autoscaling",
+			Params: new(DetachScalinggroup).ParamsSpec().Rule(),
+		}
+	},
+	"detachsecuritygroup": func() Definition {
+		return Definition{
+			Action: "detach",
+			Entity: "securitygroup",
+			Api:    "ec2",
+			Params: new(DetachSecuritygroup).ParamsSpec().Rule(),
+		}
+	},
+	"detachuser": func() Definition {
+		return Definition{
+			Action: "detach",
+			Entity: "user",
+			Api:    "iam",
+			Params: new(DetachUser).ParamsSpec().Rule(),
+		}
+	},
+	"detachvolume": func() Definition {
+		return Definition{
+			Action: "detach",
+			Entity: "volume",
+			Api:    "ec2",
+			Params: new(DetachVolume).ParamsSpec().Rule(),
+		}
+	},
+	"detachvpcendpoint": func() Definition {
+		return Definition{
+			Action: "detach",
+			Entity: "vpcendpoint",
+			Api:    "ec2",
+			Params: new(DetachVpcendpoint).ParamsSpec().Rule(),
+		}
+	},
+	"importimage": func() Definition {
+		return Definition{
+			Action: "import",
+			Entity: "image",
+			Api:    "ec2",
+			Params: new(ImportImage).ParamsSpec().Rule(),
+		}
+	},
+	"invokefunction": func() Definition {
+		return Definition{
+			Action: "invoke",
+			Entity: "function",
+			Api:    "lambda",
+			Params: new(InvokeFunction).ParamsSpec().Rule(),
+		}
+	},
+	"restartdatabase": func() Definition {
+		return Definition{
+			Action: "restart",
+			Entity: "database",
+			Api:    "rds",
+			Params: new(RestartDatabase).ParamsSpec().Rule(),
+		}
+	},
+	"restartinstance": func() Definition {
+		return Definition{
+			Action: "restart",
+			Entity: "instance",
+			Api:    "ec2",
+			Params: new(RestartInstance).ParamsSpec().Rule(),
+		}
+	},
+	"restorebackup": func() Definition {
+		return Definition{
+			Action: "restore",
+			Entity: "backup",
+			Api:    "ec2",
+			Params: new(RestoreBackup).ParamsSpec().Rule(),
+		}
+	},
+	"startalarm": func() Definition {
+		return Definition{
+			Action: "start",
+			Entity: "alarm",
+			Api:    "cloudwatch",
+			Params: new(StartAlarm).ParamsSpec().Rule(),
+		}
+	},
+	"startcontainertask": func() Definition {
+		return Definition{
+			Action: "start",
+			Entity: "containertask",
+			Api:    "ecs",
+			Params: new(StartContainertask).ParamsSpec().Rule(),
+		}
+	},
+	"startdatabase": func() Definition {
+		return Definition{
+			Action: "start",
+			Entity: "database",
+			Api:    "rds",
+			Params: new(StartDatabase).ParamsSpec().Rule(),
+		}
+	},
+	"startinstance": func() Definition {
+		return Definition{
+			Action: "start",
+			Entity: "instance",
+			Api:    "ec2",
+			Params: new(StartInstance).ParamsSpec().Rule(),
+		}
+	},
+	"stopalarm": func() Definition {
+		return Definition{
+			Action: "stop",
+			Entity: "alarm",
+			Api:    "cloudwatch",
+			Params: new(StopAlarm).ParamsSpec().Rule(),
+		}
+	},
+	"stopcontainertask": func() Definition {
+		return Definition{
+			Action: "stop",
+			Entity: "containertask",
+			Api:    "ecs",
+			Params: new(StopContainertask).ParamsSpec().Rule(),
+		}
+	},
+	"stopdatabase": func() Definition {
+		return Definition{
+			Action: "stop",
+			Entity: "database",
+			Api:    "rds",
+			Params: new(StopDatabase).ParamsSpec().Rule(),
+		}
+	},
+	"stopinstance": func() Definition {
+		return Definition{
+			Action: "stop",
+			Entity: "instance",
+			Api:    "ec2",
+			Params: new(StopInstance).ParamsSpec().Rule(),
+		}
+	},
+	"updatebucket": func() Definition {
+		return Definition{
+			Action: "update",
+			Entity: "bucket",
+			Api:    "s3",
+			Params: new(UpdateBucket).ParamsSpec().Rule(),
+		}
+	},
+	"updateclassicloadbalancer": func() Definition {
+		return Definition{
+			Action: "update",
+			Entity: "classicloadbalancer",
+			Api:    "elb",
+			Params: new(UpdateClassicLoadbalancer).ParamsSpec().Rule(),
+		}
+	},
+	"updatecontainertask": func() Definition {
+		return Definition{
+			Action: "update",
+			Entity: "containertask",
+			Api:    "ecs",
+			Params: new(UpdateContainertask).ParamsSpec().Rule(),
+		}
+	},
+	"updatedistribution": func() Definition {
+		return Definition{
+			Action: "update",
+			Entity: "distribution",
+			Api:    "cloudfront",
+			Params: new(UpdateDistribution).ParamsSpec().Rule(),
+		}
+	},
+	"updateelasticsearchdomain": func() Definition {
+		return Definition{
+			Action: "update",
+			Entity: "elasticsearchdomain",
+			Api:    "elasticsearchservice",
+			Params: new(UpdateElasticsearchdomain).ParamsSpec().Rule(),
+		}
+	},
+	"updateenvironment": func() Definition {
+		return Definition{
+			Action: "update",
+			Entity: "environment",
+			Api:    "elasticbeanstalk",
+			Params: new(UpdateEnvironment).ParamsSpec().Rule(),
+		}
+	},
+	"updatefunction": func() Definition {
+		return Definition{
+			Action: "update",
+			Entity: "function",
+			Api:    "lambda",
+			Params: new(UpdateFunction).ParamsSpec().Rule(),
+		}
+	},
+	"updateimage": func() Definition {
+		return Definition{
+			Action: "update",
+			Entity: "image",
+			Api:    "ec2",
+			Params: new(UpdateImage).ParamsSpec().Rule(),
+		}
+	},
+	"updateinstance": func() Definition {
+		return Definition{
+			Action: "update",
+			Entity: "instance",
+			Api:    "ec2",
+			Params: new(UpdateInstance).ParamsSpec().Rule(),
+		}
+	},
+	"updateloginprofile": func() Definition {
+		return Definition{
+			Action: "update",
+			Entity: "loginprofile",
+			Api:    "iam",
+			Params: new(UpdateLoginprofile).ParamsSpec().Rule(),
+		}
+	},
+	"updatepolicy": func() Definition {
+		return Definition{
+			Action: "update",
+			Entity: "policy",
+			Api:    "iam",
+			Params: new(UpdatePolicy).ParamsSpec().Rule(),
+		}
+	},
+	"updatequeue": func() Definition {
+		return Definition{
+			Action: "update",
+			Entity: "queue",
+			Api:    "sqs",
+			Params: new(UpdateQueue).ParamsSpec().Rule(),
+		}
+	},
+	"updaterecord": func() Definition {
+		return Definition{
+			Action: "update",
+			Entity: "record",
+			Api:    "route53",
+			Params: new(UpdateRecord).ParamsSpec().Rule(),
+		}
+	},
+	"updaterecords": func() Definition {
+		return Definition{
+			Action: "update",
+			Entity: "records",
+			Api:    "route53",
+			Params: new(UpdateRecords).ParamsSpec().Rule(),
+		}
+	},
+	"updates3object": func() Definition {
+		return Definition{
+			Action: "update",
+			Entity: "s3object",
+			Api:    "s3",
+			Params: new(UpdateS3object).ParamsSpec().Rule(),
+		}
+	},
+	"updatescalinggroup": func() Definition {
+		return Definition{
+			Action: "update",
+			Entity: "scalinggroup",
+			Api:    "autoscaling",
+			Params: new(UpdateScalinggroup).ParamsSpec().Rule(),
+		}
+	},
+	"updatesecuritygroup": func() Definition {
+		return Definition{
+			Action: "update",
+			Entity: "securitygroup",
+			Api:    "ec2",
+			Params: new(UpdateSecuritygroup).ParamsSpec().Rule(),
+		}
+	},
+	"updatestack": func() Definition {
+		return Definition{
+			Action: "update",
+			Entity: "stack",
+			Api:    "cloudformation",
+			Params: new(UpdateStack).ParamsSpec().Rule(),
+		}
+	},
+	"updatesubnet": func() Definition {
+		return Definition{
+			Action: "update",
+			Entity: "subnet",
+			Api:    "ec2",
+			Params: new(UpdateSubnet).ParamsSpec().Rule(),
+		}
+	},
+	"updatetable": func() Definition {
+		return Definition{
+			Action: "update",
+			Entity: "table",
+			Api:    "dynamodb",
+			Params: new(UpdateTable).ParamsSpec().Rule(),
+		}
+	},
+	"updatetargetgroup": func() Definition {
+		return Definition{
+			Action: "update",
+			Entity: "targetgroup",
+			Api:    "elbv2",
+			Params: new(UpdateTargetgroup).ParamsSpec().Rule(),
+		}
+	},
+	"updatevpc": func() Definition {
+		return Definition{
+			Action: "update",
+			Entity: "vpc",
+			Api:    "ec2",
+			Params: new(UpdateVpc).ParamsSpec().Rule(),
+		}
+	},
+	"verifydomain": func() Definition {
+		return Definition{
+			Action: "verify",
+			Entity: "domain",
+			Api:    "ses",
+			Params: new(VerifyDomain).ParamsSpec().Rule(),
+		}
+	},
+	"verifyemail": func() Definition {
+		return Definition{
+			Action: "verify",
+			Entity: "email",
+			Api:    "ses",
+			Params: new(VerifyEmail).ParamsSpec().Rule(),
+		}
 	},
 }
 
 var DriverSupportedActions = map[string][]string{
-	"attach":       {"alarm", "classicloadbalancer", "containertask", "elasticip", "instance", "instanceprofile", "internetgateway", "listener", "mfadevice", "networkinterface", "policy", "role", "routetable", "securitygroup", "user", "volume"},
+	"attach":       {"alarm", "classicloadbalancer", "containertask", "dhcpoptions", "elasticip", "instance", "instanceprofile", "internetgateway", "listener", "mfadevice", "networkinterface", "policy", "queuepolicy", "role", "routetable", "scalinggroup", "securitygroup", "user", "volume", "vpcendpoint"},
 	"authenticate": {"registry"},
-	"check":        {"certificate", "database", "distribution", "instance", "loadbalancer", "natgateway", "networkinterface", "scalinggroup", "securitygroup", "volume"},
+	"backup":       {"instance"},
+	"bootstrap":    {"instance"},
+	"check":        {"alarm", "certificate", "database", "distribution", "elasticsearchdomain", "environment", "healthcheck", "instance", "loadbalancer", "natgateway", "networkinterface", "record", "scalinggroup", "securitygroup", "table", "volume"},
 	"copy":         {"image", "snapshot"},
-	"create":       {"accesskey", "alarm", "appscalingpolicy", "appscalingtarget", "bucket", "certificate", "classicloadbalancer", "containercluster", "database", "dbsubnetgroup", "distribution", "elasticip", "function", "group", "image", "instance", "instanceprofile", "internetgateway", "keypair", "launchconfiguration", "listener", "loadbalancer", "loginprofile", "mfadevice", "natgateway", "networkinterface", "policy", "queue", "record", "repository", "role", "route", "routetable", "s3object", "scalinggroup", "scalingpolicy", "securitygroup", "snapshot", "stack", "subnet", "subscription", "tag", "targetgroup", "topic", "user", "volume", "vpc", "zone"},
-	"delete":       {"accesskey", "alarm", "appscalingpolicy", "appscalingtarget", "bucket", "certificate", "classicloadbalancer", "containercluster", "containertask", "database", "dbsubnetgroup", "distribution", "elasticip", "function", "group", "image", "instance", "instanceprofile", "internetgateway", "keypair", "launchconfiguration", "listener", "loadbalancer", "loginprofile", "mfadevice", "natgateway", "networkinterface", "policy", "queue", "record", "repository", "role", "route", "routetable", "s3object", "scalinggroup", "scalingpolicy", "securitygroup", "snapshot", "stack", "subnet", "subscription", "tag", "targetgroup", "topic", "user", "volume", "vpc", "zone"},
-	"detach":       {"alarm", "classicloadbalancer", "containertask", "elasticip", "instance", "instanceprofile", "internetgateway", "mfadevice", "networkinterface", "policy", "role", "routetable", "securitygroup", "user", "volume"},
+	"create":       {"accesskey", "alarm", "application", "appscalingpolicy", "appscalingtarget", "bucket", "certificate", "classicloadbalancer", "containercluster", "database", "dbsubnetgroup", "dhcpoptions", "distribution", "egressonlyinternetgateway", "elasticip", "elasticsearchdomain", "environment", "failover", "function", "group", "healthcheck", "image", "instance", "instanceprofile", "internetgateway", "keypair", "launchconfiguration", "listener", "loadbalancer", "loginprofile", "mfadevice", "natgateway", "networkinterface", "policy", "queue", "record", "records", "repository", "role", "route", "routetable", "s3object", "scalinggroup", "scalingpolicy", "securitygroup", "snapshot", "stack", "subnet", "subscription", "table", "tag", "targetgroup", "topic", "user", "volume", "vpc", "vpcendpoint", "zone"},
+	"delete":       {"accesskey", "alarm", "application", "appscalingpolicy", "appscalingtarget", "bucket", "certificate", "classicloadbalancer", "containercluster", "containertask", "database", "dbsubnetgroup", "dhcpoptions", "distribution", "egressonlyinternetgateway", "elasticip", "elasticsearchdomain", "environment", "function", "group", "healthcheck", "image", "instance", "instanceprofile", "internetgateway", "keypair", "launchconfiguration", "listener", "loadbalancer", "loginprofile", "mfadevice", "natgateway", "networkinterface", "policy", "queue", "record", "records", "repository", "role", "route", "routetable", "s3object", "scalinggroup", "scalingpolicy", "securitygroup", "snapshot", "stack", "subnet", "subscription", "table", "tag", "targetgroup", "topic", "user", "volume", "vpc", "vpcendpoint", "zone"},
+	"detach":       {"alarm", "classicloadbalancer", "containertask", "dhcpoptions", "elasticip", "instance", "instanceprofile", "internetgateway", "mfadevice", "networkinterface", "policy", "queuepolicy", "role", "routetable", "scalinggroup", "securitygroup", "user", "volume", "vpcendpoint"},
 	"import":       {"image"},
+	"invoke":       {"function"},
 	"restart":      {"database", "instance"},
+	"restore":      {"backup"},
 	"start":        {"alarm", "containertask", "database", "instance"},
 	"stop":         {"alarm", "containertask", "database", "instance"},
-	"update":       {"bucket", "classicloadbalancer", "containertask", "distribution", "image", "instance", "loginprofile", "policy", "record", "s3object", "scalinggroup", "securitygroup", "stack", "subnet", "targetgroup"},
+	"update":       {"bucket", "classicloadbalancer", "containertask", "distribution", "elasticsearchdomain", "environment", "function", "image", "instance", "loginprofile", "policy", "queue", "record", "records", "s3object", "scalinggroup", "securitygroup", "stack", "subnet", "table", "targetgroup", "vpc"},
+	"verify":       {"domain", "email"},
 }
@@ -19,7 +19,6 @@ package awsspec
 
 import (
 	"github.com/aws/aws-sdk-go/aws/session"
-	"github.com/aws/aws-sdk-go/awstesting/mock"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/logger"
 )
@@ -30,11 +29,6 @@ type Factory interface {
 
 var CommandFactory Factory
 
-var MockAWSSessionFactory = &AWSFactory{
-	Log:  logger.DiscardLogger,
-	Sess: mock.Session,
-}
-
 type AWSFactory struct {
 	Log   *logger.Logger
 	Sess  *session.Session
@@ -49,6 +43,8 @@ func (f *AWSFactory) Build(key string) func() interface{} {
 		return func() interface{} { return NewAttachClassicLoadbalancer(f.Sess, f.Graph, f.Log) }
 	case "attachcontainertask":
 		return func() interface{} { return NewAttachContainertask(f.Sess, f.Graph, f.Log) }
+	case "attachdhcpoptions":
+		return func() interface{} { return NewAttachDhcpoptions(f.Sess, f.Graph, f.Log) }
 	case "attachelasticip":
 		return func() interface{} { return NewAttachElasticip(f.Sess, f.Graph, f.Log) }
 	case "attachinstance":
@@ -65,24 +61,42 @@ func (f *AWSFactory) Build(key string) func() interface{} {
 		return func() interface{} { return NewAttachNetworkinterface(f.Sess, f.Graph, f.Log) }
 	case "attachpolicy":
 		return func() interface{} { return NewAttachPolicy(f.Sess, f.Graph, f.Log) }
+	case "attachqueuepolicy":
+		return func() interface{} { return NewAttachQueuepolicy(f.Sess, f.Graph, f.Log) }
 	case "attachrole":
 		return func() interface{} { return NewAttachRole(f.Sess, f.Graph, f.Log) }
 	case "attachroutetable":
 		return func() interface{} { return NewAttachRoutetable(f.Sess, f.Graph, f.Log) }
+	case "attachscalinggroup":
+		return func() interface{} { return NewAttachScalinggroup(f.Sess, f.Graph, f.Log) }
 	case "attachsecuritygroup":
 		return func() interface{} { return NewAttachSecuritygroup(f.Sess, f.Graph, f.Log) }
 	case "attachuser":
 		return func() interface{} { return NewAttachUser(f.Sess, f.Graph, f.Log) }
 	case "attachvolume":
 		return func() interface{} { return NewAttachVolume(f.Sess, f.Graph, f.Log) }
+	case "attachvpcendpoint":
+		return func() interface{} { return NewAttachVpcendpoint(f.Sess, f.Graph, f.Log) }
 	case "authenticateregistry":
 		return func() interface{} { return NewAuthenticateRegistry(f.Sess, f.Graph, f.Log) }
+	case "backupinstance":
+		return func() interface{} { return NewBackupInstance(f.Sess, f.Graph, f.Log) }
+	case "bootstrapinstance":
+		return func() interface{} { return NewBootstrapInstance(f.Sess, f.Graph, f.Log) }
+	case "checkalarm":
+		return func() interface{} { return NewCheckAlarm(f.Sess, f.Graph, f.Log) }
 	case "checkcertificate":
 		return func() interface{} { return NewCheckCertificate(f.Sess, f.Graph, f.Log) }
 	case "checkdatabase":
 		return func() interface{} { return NewCheckDatabase(f.Sess, f.Graph, f.Log) }
 	case "checkdistribution":
 		return func() interface{} { return NewCheckDistribution(f.Sess, f.Graph, f.Log) }
+	case "checkelasticsearchdomain":
+		return func() interface{} { return NewCheckElasticsearchdomain(f.Sess, f.Graph, f.Log) }
+	case "checkenvironment":
+		return func() interface{} { return NewCheckEnvironment(f.Sess, f.Graph, f.Log) }
+	case "checkhealthcheck":
+		return func() interface{} { return NewCheckHealthcheck(f.Sess, f.Graph, f.Log) }
 	case "checkinstance":
 		return func() interface{} { return NewCheckInstance(f.Sess, f.Graph, f.Log) }
 	case "checkloadbalancer":
@@ -91,10 +105,14 @@ func (f *AWSFactory) Build(key string) func() interface{} {
 		return func() interface{} { return NewCheckNatgateway(f.Sess, f.Graph, f.Log) }
 	case "checknetworkinterface":
 		return func() interface{} { return NewCheckNetworkinterface(f.Sess, f.Graph, f.Log) }
+	case "checkrecord":
+		return func() interface{} { return NewCheckRecord(f.Sess, f.Graph, f.Log) }
 	case "checkscalinggroup":
 		return func() interface{} { return NewCheckScalinggroup(f.Sess, f.Graph, f.Log) }
 	case "checksecuritygroup":
 		return func() interface{} { return NewCheckSecuritygroup(f.Sess, f.Graph, f.Log) }
+	case "checktable":
+		return func() interface{} { return NewCheckTable(f.Sess, f.Graph, f.Log) }
 	case "checkvolume":
 		return func() interface{} { return NewCheckVolume(f.Sess, f.Graph, f.Log) }
 	case "copyimage":
@@ -105,6 +123,8 @@ func (f *AWSFactory) Build(key string) func() interface{} {
 		return func() interface{} { return NewCreateAccesskey(f.Sess, f.Graph, f.Log) }
 	case "createalarm":
 		return func() interface{} { return NewCreateAlarm(f.Sess, f.Graph, f.Log) }
+	case "createapplication":
+		return func() interface{} { return NewCreateApplication(f.Sess, f.Graph, f.Log) }
 	case "createappscalingpolicy":
 		return func() interface{} { return NewCreateAppscalingpolicy(f.Sess, f.Graph, f.Log) }
 	case "createappscalingtarget":
@@ -121,14 +141,26 @@ func (f *AWSFactory) Build(key string) func() interface{} {
 		return func() interface{} { return NewCreateDatabase(f.Sess, f.Graph, f.Log) }
 	case "createdbsubnetgroup":
 		return func() interface{} { return NewCreateDbsubnetgroup(f.Sess, f.Graph, f.Log) }
+	case "createdhcpoptions":
+		return func() interface{} { return NewCreateDhcpoptions(f.Sess, f.Graph, f.Log) }
 	case "createdistribution":
 		return func() interface{} { return NewCreateDistribution(f.Sess, f.Graph, f.Log) }
+	case "createegressonlyinternetgateway":
+		return func() interface{} { return NewCreateEgressonlyinternetgateway(f.Sess, f.Graph, f.Log) }
 	case "createelasticip":
 		return func() interface{} { return NewCreateElasticip(f.Sess, f.Graph, f.Log) }
+	case "createelasticsearchdomain":
+		return func() interface{} { return NewCreateElasticsearchdomain(f.Sess, f.Graph, f.Log) }
+	case "createenvironment":
+		return func() interface{} { return NewCreateEnvironment(f.Sess, f.Graph, f.Log) }
+	case "createfailover":
+		return func() interface{} { return NewCreateFailover(f.Sess, f.Graph, f.Log) }
 	case "createfunction":
 		return func() interface{} { return NewCreateFunction(f.Sess, f.Graph, f.Log) }
 	case "creategroup":
 		return func() interface{} { return NewCreateGroup(f.Sess, f.Graph, f.Log) }
+	case "createhealthcheck":
+		return func() interface{} { return NewCreateHealthcheck(f.Sess, f.Graph, f.Log) }
 	case "createimage":
 		return func() interface{} { return NewCreateImage(f.Sess, f.Graph, f.Log) }
 	case "createinstance":
@@ -159,6 +191,8 @@ func (f *AWSFactory) Build(key string) func() interface{} {
 		return func() interface{} { return NewCreateQueue(f.Sess, f.Graph, f.Log) }
 	case "createrecord":
 		return func() interface{} { return NewCreateRecord(f.Sess, f.Graph, f.Log) }
+	case "createrecords":
+		return func() interface{} { return NewCreateRecords(f.Sess, f.Graph, f.Log) }
 	case "createrepository":
 		return func() interface{} { return NewCreateRepository(f.Sess, f.Graph, f.Log) }
 	case "createrole":
@@ -183,6 +217,8 @@ func (f *AWSFactory) Build(key string) func() interface{} {
 		return func() interface{} { return NewCreateSubnet(f.Sess, f.Graph, f.Log) }
 	case "createsubscription":
 		return func() interface{} { return NewCreateSubscription(f.Sess, f.Graph, f.Log) }
+	case "createtable":
+		return func() interface{} { return NewCreateTable(f.Sess, f.Graph, f.Log) }
 	case "createtag":
 		return func() interface{} { return NewCreateTag(f.Sess, f.Graph, f.Log) }
 	case "createtargetgroup":
@@ -195,12 +231,16 @@ func (f *AWSFactory) Build(key string) func() interface{} {
 		return func() interface{} { return NewCreateVolume(f.Sess, f.Graph, f.Log) }
 	case "createvpc":
 		return func() interface{} { return NewCreateVpc(f.Sess, f.Graph, f.Log) }
+	case "createvpcendpoint":
+		return func() interface{} { return NewCreateVpcendpoint(f.Sess, f.Graph, f.Log) }
 	case "createzone":
 		return func() interface{} { return NewCreateZone(f.Sess, f.Graph, f.Log) }
 	case "deleteaccesskey":
 		return func() interface{} { return NewDeleteAccesskey(f.Sess, f.Graph, f.Log) }
 	case "deletealarm":
 		return func() interface{} { return NewDeleteAlarm(f.Sess, f.Graph, f.Log) }
+	case "deleteapplication":
+		return func() interface{} { return NewDeleteApplication(f.Sess, f.Graph, f.Log) }
 	case "deleteappscalingpolicy":
 		return func() interface{} { return NewDeleteAppscalingpolicy(f.Sess, f.Graph, f.Log) }
 	case "deleteappscalingtarget":
@@ -219,14 +259,24 @@ func (f *AWSFactory) Build(key string) func() interface{} {
 		return func() interface{} { return NewDeleteDatabase(f.Sess, f.Graph, f.Log) }
 	case "deletedbsubnetgroup":
 		return func() interface{} { return NewDeleteDbsubnetgroup(f.Sess, f.Graph, f.Log) }
+	case "deletedhcpoptions":
+		return func() interface{} { return NewDeleteDhcpoptions(f.Sess, f.Graph, f.Log) }
 	case "deletedistribution":
 		return func() interface{} { return NewDeleteDistribution(f.Sess, f.Graph, f.Log) }
+	case "deleteegressonlyinternetgateway":
+		return func() interface{} { return NewDeleteEgressonlyinternetgateway(f.Sess, f.Graph, f.Log) }
 	case "deleteelasticip":
 		return func() interface{} { return NewDeleteElasticip(f.Sess, f.Graph, f.Log) }
+	case "deleteelasticsearchdomain":
+		return func() interface{} { return NewDeleteElasticsearchdomain(f.Sess, f.Graph, f.Log) }
+	case "deleteenvironment":
+		return func() interface{} { return NewDeleteEnvironment(f.Sess, f.Graph, f.Log) }
 	case "deletefunction":
 		return func() interface{} { return NewDeleteFunction(f.Sess, f.Graph, f.Log) }
 	case "deletegroup":
 		return func() interface{} { return NewDeleteGroup(f.Sess, f.Graph, f.Log) }
+	case "deletehealthcheck":
+		return func() interface{} { return NewDeleteHealthcheck(f.Sess, f.Graph, f.Log) }
 	case "deleteimage":
 		return func() interface{} { return NewDeleteImage(f.Sess, f.Graph, f.Log) }
 	case "deleteinstance":
@@ -257,6 +307,8 @@ func (f *AWSFactory) Build(key string) func() interface{} {
 		return func() interface{} { return NewDeleteQueue(f.Sess, f.Graph, f.Log) }
 	case "deleterecord":
 		return func() interface{} { return NewDeleteRecord(f.Sess, f.Graph, f.Log) }
+	case "deleterecords":
+		return func() interface{} { return NewDeleteRecords(f.Sess, f.Graph, f.Log) }
 	case "deleterepository":
 		return func() interface{} { return NewDeleteRepository(f.Sess, f.Graph, f.Log) }
 	case "deleterole":
@@ -281,6 +333,8 @@ func (f *AWSFactory) Build(key string) func() interface{} {
 		return func() interface{} { return NewDeleteSubnet(f.Sess, f.Graph, f.Log) }
 	case "deletesubscription":
 		return func() interface{} { return NewDeleteSubscription(f.Sess, f.Graph, f.Log) }
+	case "deletetable":
+		return func() interface{} { return NewDeleteTable(f.Sess, f.Graph, f.Log) }
 	case "deletetag":
 		return func() interface{} { return NewDeleteTag(f.Sess, f.Graph, f.Log) }
 	case "deletetargetgroup":
@@ -293,6 +347,8 @@ func (f *AWSFactory) Build(key string) func() interface{} {
 		return func() interface{} { return NewDeleteVolume(f.Sess, f.Graph, f.Log) }
 	case "deletevpc":
 		return func() interface{} { return NewDeleteVpc(f.Sess, f.Graph, f.Log) }
+	case "deletevpcendpoint":
+		return func() interface{} { return NewDeleteVpcendpoint(f.Sess, f.Graph, f.Log) }
 	case "deletezone":
 		return func() interface{} { return NewDeleteZone(f.Sess, f.Graph, f.Log) }
 	case "detachalarm":
@@ -301,6 +357,8 @@ func (f *AWSFactory) Build(key string) func() interface{} {
 		return func() interface{} { return NewDetachClassicLoadbalancer(f.Sess, f.Graph, f.Log) }
 	case "detachcontainertask":
 		return func() interface{} { return NewDetachContainertask(f.Sess, f.Graph, f.Log) }
+	case "detachdhcpoptions":
+		return func() interface{} { return NewDetachDhcpoptions(f.Sess, f.Graph, f.Log) }
 	case "detachelasticip":
 		return func() interface{} { return NewDetachElasticip(f.Sess, f.Graph, f.Log) }
 	case "detachinstance":
@@ -315,22 +373,32 @@ func (f *AWSFactory) Build(key string) func() interface{} {
 		return func() interface{} { return NewDetachNetworkinterface(f.Sess, f.Graph, f.Log) }
 	case "detachpolicy":
 		return func() interface{} { return NewDetachPolicy(f.Sess, f.Graph, f.Log) }
+	case "detachqueuepolicy":
+		return func() interface{} { return NewDetachQueuepolicy(f.Sess, f.Graph, f.Log) }
 	case "detachrole":
 		return func() interface{} { return NewDetachRole(f.Sess, f.Graph, f.Log) }
 	case "detachroutetable":
 		return func() interface{} { return NewDetachRoutetable(f.Sess, f.Graph, f.Log) }
+	case "detachscalinggroup":
+		return func() interface{} { return NewDetachScalinggroup(f.Sess, f.Graph, f.Log) }
 	case "detachsecuritygroup":
 		return func() interface{} { return NewDetachSecuritygroup(f.Sess, f.Graph, f.Log) }
 	case "detachuser":
 		return func() interface{} { return NewDetachUser(f.Sess, f.Graph, f.Log) }
 	case "detachvolume":
 		return func() interface{} { return NewDetachVolume(f.Sess, f.Graph, f.Log) }
+	case "detachvpcendpoint":
+		return func() interface{} { return NewDetachVpcendpoint(f.Sess, f.Graph, f.Log) }
 	case "importimage":
 		return func() interface{} { return NewImportImage(f.Sess, f.Graph, f.Log) }
+	case "invokefunction":
+		return func() interface{} { return NewInvokeFunction(f.Sess, f.Graph, f.Log) }
 	case "restartdatabase":
 		return func() interface{} { return NewRestartDatabase(f.Sess, f.Graph, f.Log) }
 	case "restartinstance":
 		return func() interface{} { return NewRestartInstance(f.Sess, f.Graph, f.Log) }
+	case "restorebackup":
+		return func() interface{} { return NewRestoreBackup(f.Sess, f.Graph, f.Log) }
 	case "startalarm":
 		return func() interface{} { return NewStartAlarm(f.Sess, f.Graph, f.Log) }
 	case "startcontainertask":
@@ -355,6 +423,12 @@ func (f *AWSFactory) Build(key string) func() interface{} {
 		return func() interface{} { return NewUpdateContainertask(f.Sess, f.Graph, f.Log) }
 	case "updatedistribution":
 		return func() interface{} { return NewUpdateDistribution(f.Sess, f.Graph, f.Log) }
+	case "updateelasticsearchdomain":
+		return func() interface{} { return NewUpdateElasticsearchdomain(f.Sess, f.Graph, f.Log) }
+	case "updateenvironment":
+		return func() interface{} { return NewUpdateEnvironment(f.Sess, f.Graph, f.Log) }
+	case "updatefunction":
+		return func() interface{} { return NewUpdateFunction(f.Sess, f.Graph, f.Log) }
 	case "updateimage":
 		return func() interface{} { return NewUpdateImage(f.Sess, f.Graph, f.Log) }
 	case "updateinstance":
@@ -363,8 +437,12 @@ func (f *AWSFactory) Build(key string) func() interface{} {
 		return func() interface{} { return NewUpdateLoginprofile(f.Sess, f.Graph, f.Log) }
 	case "updatepolicy":
 		return func() interface{} { return NewUpdatePolicy(f.Sess, f.Graph, f.Log) }
+	case "updatequeue":
+		return func() interface{} { return NewUpdateQueue(f.Sess, f.Graph, f.Log) }
 	case "updaterecord":
 		return func() interface{} { return NewUpdateRecord(f.Sess, f.Graph, f.Log) }
+	case "updaterecords":
+		return func() interface{} { return NewUpdateRecords(f.Sess, f.Graph, f.Log) }
 	case "updates3object":
 		return func() interface{} { return NewUpdateS3object(f.Sess, f.Graph, f.Log) }
 	case "updatescalinggroup":
@@ -375,8 +453,16 @@ func (f *AWSFactory) Build(key string) func() interface{} {
 		return func() interface{} { return NewUpdateStack(f.Sess, f.Graph, f.Log) }
 	case "updatesubnet":
 		return func() interface{} { return NewUpdateSubnet(f.Sess, f.Graph, f.Log) }
+	case "updatetable":
+		return func() interface{} { return NewUpdateTable(f.Sess, f.Graph, f.Log) }
 	case "updatetargetgroup":
 		return func() interface{} { return NewUpdateTargetgroup(f.Sess, f.Graph, f.Log) }
+	case "updatevpc":
+		return func() interface{} { return NewUpdateVpc(f.Sess, f.Graph, f.Log) }
+	case "verifydomain":
+		return func() interface{} { return NewVerifyDomain(f.Sess, f.Graph, f.Log) }
+	case "verifyemail":
+		return func() interface{} { return NewVerifyEmail(f.Sess, f.Graph, f.Log) }
 	}
 	return nil
 }
@@ -385,6 +471,7 @@ var (
 	_ command = &AttachAlarm{}
 	_ command = &AttachClassicLoadbalancer{}
 	_ command = &AttachContainertask{}
+	_ command = &AttachDhcpoptions{}
 	_ command = &AttachElasticip{}
 	_ command = &AttachInstance{}
 	_ command = &AttachInstanceprofile{}
@@ -393,26 +480,38 @@ var (
 	_ command = &AttachMfadevice{}
 	_ command = &AttachNetworkinterface{}
 	_ command = &AttachPolicy{}
+	_ command = &AttachQueuepolicy{}
 	_ command = &AttachRole{}
 	_ command = &AttachRoutetable{}
+	_ command = &AttachScalinggroup{}
 	_ command = &AttachSecuritygroup{}
 	_ command = &AttachUser{}
 	_ command = &AttachVolume{}
+	_ command = &AttachVpcendpoint{}
 	_ command = &AuthenticateRegistry{}
+	_ command = &BackupInstance{}
+	_ command = &BootstrapInstance{}
+	_ command = &CheckAlarm{}
 	_ command = &CheckCertificate{}
 	_ command = &CheckDatabase{}
 	_ command = &CheckDistribution{}
+	_ command = &CheckElasticsearchdomain{}
+	_ command = &CheckEnvironment{}
+	_ command = &CheckHealthcheck{}
 	_ command = &CheckInstance{}
 	_ command = &CheckLoadbalancer{}
 	_ command = &CheckNatgateway{}
 	_ command = &CheckNetworkinterface{}
+	_ command = &CheckRecord{}
 	_ command = &CheckScalinggroup{}
 	_ command = &CheckSecuritygroup{}
+	_ command = &CheckTable{}
 	_ command = &CheckVolume{}
 	_ command = &CopyImage{}
 	_ command = &CopySnapshot{}
 	_ command = &CreateAccesskey{}
 	_ command = &CreateAlarm{}
+	_ command = &CreateApplication{}
 	_ command = &CreateAppscalingpolicy{}
 	_ command = &CreateAppscalingtarget{}
 	_ command = &CreateBucket{}
@@ -421,10 +520,16 @@ var (
 	_ command = &CreateContainercluster{}
 	_ command = &CreateDatabase{}
 	_ command = &CreateDbsubnetgroup{}
+	_ command = &CreateDhcpoptions{}
 	_ command = &CreateDistribution{}
+	_ command = &CreateEgressonlyinternetgateway{}
 	_ command = &CreateElasticip{}
+	_ command = &CreateElasticsearchdomain{}
+	_ command = &CreateEnvironment{}
+	_ command = &CreateFailover{}
 	_ command = &CreateFunction{}
 	_ command = &CreateGroup{}
+	_ command = &CreateHealthcheck{}
 	_ command = &CreateImage{}
 	_ command = &CreateInstance{}
 	_ command = &CreateInstanceprofile{}
@@ -440,6 +545,7 @@ var (
 	_ command = &CreatePolicy{}
 	_ command = &CreateQueue{}
 	_ command = &CreateRecord{}
+	_ command = &CreateRecords{}
 	_ command = &CreateRepository{}
 	_ command = &CreateRole{}
 	_ command = &CreateRoute{}
@@ -452,15 +558,18 @@ var (
 	_ command = &CreateStack{}
 	_ command = &CreateSubnet{}
 	_ command = &CreateSubscription{}
+	_ command = &CreateTable{}
 	_ command = &CreateTag{}
 	_ command = &CreateTargetgroup{}
 	_ command = &CreateTopic{}
 	_ command = &CreateUser{}
 	_ command = &CreateVolume{}
 	_ command = &CreateVpc{}
+	_ command = &CreateVpcendpoint{}
 	_ command = &CreateZone{}
 	_ command = &DeleteAccesskey{}
 	_ command = &DeleteAlarm{}
+	_ command = &DeleteApplication{}
 	_ command = &DeleteAppscalingpolicy{}
 	_ command = &DeleteAppscalingtarget{}
 	_ command = &DeleteBucket{}
@@ -470,10 +579,15 @@ var (
 	_ command = &DeleteContainertask{}
 	_ command = &DeleteDatabase{}
 	_ command = &DeleteDbsubnetgroup{}
+	_ command = &DeleteDhcpoptions{}
 	_ command = &DeleteDistribution{}
+	_ command = &DeleteEgressonlyinternetgateway{}
 	_ command = &DeleteElasticip{}
+	_ command = &DeleteElasticsearchdomain{}
+	_ command = &DeleteEnvironment{}
 	_ command = &DeleteFunction{}
 	_ command = &DeleteGroup{}
+	_ command = &DeleteHealthcheck{}
 	_ command = &DeleteImage{}
 	_ command = &DeleteInstance{}
 	_ command = &DeleteInstanceprofile{}
@@ -489,6 +603,7 @@ var (
 	_ command = &DeletePolicy{}
 	_ command = &DeleteQueue{}
 	_ command = &DeleteRecord{}
+	_ command = &DeleteRecords{}
 	_ command = &DeleteRepository{}
 	_ command = &DeleteRole{}
 	_ command = &DeleteRoute{}
@@ -501,16 +616,19 @@ var (
 	_ command = &DeleteStack{}
 	_ command = &DeleteSubnet{}
 	_ command = &DeleteSubscription{}
+	_ command = &DeleteTable{}
 	_ command = &DeleteTag{}
 	_ command = &DeleteTargetgroup{}
 	_ command = &DeleteTopic{}
 	_ command = &DeleteUser{}
 	_ command = &DeleteVolume{}
 	_ command = &DeleteVpc{}
+	_ command = &DeleteVpcendpoint{}
 	_ command = &DeleteZone{}
 	_ command = &DetachAlarm{}
 	_ command = &DetachClassicLoadbalancer{}
 	_ command = &DetachContainertask{}
+	_ command = &DetachDhcpoptions{}
 	_ command = &DetachElasticip{}
 	_ command = &DetachInstance{}
 	_ command = &DetachInstanceprofile{}
@@ -518,14 +636,19 @@ var (
 	_ command = &DetachMfadevice{}
 	_ command = &DetachNetworkinterface{}
 	_ command = &DetachPolicy{}
+	_ command = &DetachQueuepolicy{}
 	_ command = &DetachRole{}
 	_ command = &DetachRoutetable{}
+	_ command = &DetachScalinggroup{}
 	_ command = &DetachSecuritygroup{}
 	_ command = &DetachUser{}
 	_ command = &DetachVolume{}
+	_ command = &DetachVpcendpoint{}
 	_ command = &ImportImage{}
+	_ command = &InvokeFunction{}
 	_ command = &RestartDatabase{}
 	_ command = &RestartInstance{}
+	_ command = &RestoreBackup{}
 	_ command = &StartAlarm{}
 	_ command = &StartContainertask{}
 	_ command = &StartDatabase{}
@@ -538,15 +661,24 @@ var (
 	_ command = &UpdateClassicLoadbalancer{}
 	_ command = &UpdateContainertask{}
 	_ command = &UpdateDistribution{}
+	_ command = &UpdateElasticsearchdomain{}
+	_ command = &UpdateEnvironment{}
+	_ command = &UpdateFunction{}
 	_ command = &UpdateImage{}
 	_ command = &UpdateInstance{}
 	_ command = &UpdateLoginprofile{}
 	_ command = &UpdatePolicy{}
+	_ command = &UpdateQueue{}
 	_ command = &UpdateRecord{}
+	_ command = &UpdateRecords{}
 	_ command = &UpdateS3object{}
 	_ command = &UpdateScalinggroup{}
 	_ command = &UpdateSecuritygroup{}
 	_ command = &UpdateStack{}
 	_ command = &UpdateSubnet{}
+	_ command = &UpdateTable{}
 	_ command = &UpdateTargetgroup{}
+	_ command = &UpdateVpc{}
+	_ command = &VerifyDomain{}
+	_ command = &VerifyEmail{}
 )
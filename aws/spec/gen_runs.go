@@ -36,12 +36,18 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudfront/cloudfrontiface"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/aws/aws-sdk-go/service/ecr/ecriface"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk/elasticbeanstalkiface"
+	"github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/aws/aws-sdk-go/service/elasticsearchservice/elasticsearchserviceiface"
 	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/elb/elbiface"
 	"github.com/aws/aws-sdk-go/service/elbv2"
@@ -56,6 +62,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/route53/route53iface"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/aws/aws-sdk-go/service/ses/sesiface"
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/aws/aws-sdk-go/service/sns/snsiface"
 	"github.com/aws/aws-sdk-go/service/sqs"
@@ -84,6 +92,9 @@ func (cmd *AttachAlarm) SetApi(api cloudwatchiface.CloudWatchAPI) {
 }
 
 func (cmd *AttachAlarm) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
@@ -103,7 +114,7 @@ func (cmd *AttachAlarm) run(renv env.Running, params map[string]interface{}) (in
 
 	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "cloudwatch.")
 	}
 
 	var extracted interface{}
@@ -157,6 +168,9 @@ func (cmd *AttachClassicLoadbalancer) SetApi(api elbiface.ELBAPI) {
 }
 
 func (cmd *AttachClassicLoadbalancer) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
@@ -182,7 +196,7 @@ func (cmd *AttachClassicLoadbalancer) run(renv env.Running, params map[string]in
 	output, err := cmd.api.RegisterInstancesWithLoadBalancer(input)
 	renv.Log().ExtraVerbosef("elb.RegisterInstancesWithLoadBalancer call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "elb.RegisterInstancesWithLoadBalancer")
 	}
 
 	var extracted interface{}
@@ -236,6 +250,9 @@ func (cmd *AttachContainertask) SetApi(api ecsiface.ECSAPI) {
 }
 
 func (cmd *AttachContainertask) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
@@ -255,7 +272,7 @@ func (cmd *AttachContainertask) run(renv env.Running, params map[string]interfac
 
 	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ecs.")
 	}
 
 	var extracted interface{}
@@ -290,6 +307,109 @@ func (cmd *AttachContainertask) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
+func NewAttachDhcpoptions(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *AttachDhcpoptions {
+	cmd := new(AttachDhcpoptions)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *AttachDhcpoptions) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *AttachDhcpoptions) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *AttachDhcpoptions) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.AssociateDhcpOptionsInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.AssociateDhcpOptionsInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.AssociateDhcpOptions(input)
+	renv.Log().ExtraVerbosef("ec2.AssociateDhcpOptions call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err, "ec2.AssociateDhcpOptions")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("attach dhcpoptions: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("attach dhcpoptions '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("attach dhcpoptions done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *AttachDhcpoptions) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.AssociateDhcpOptionsInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.AssociateDhcpOptionsInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.AssociateDhcpOptions(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.AssociateDhcpOptions call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: attach dhcpoptions ok")
+			return fakeDryRunId("dhcpoptions"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *AttachDhcpoptions) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
 func NewAttachElasticip(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *AttachElasticip {
 	cmd := new(AttachElasticip)
 	if len(l) > 0 {
@@ -309,6 +429,9 @@ func (cmd *AttachElasticip) SetApi(api ec2iface.EC2API) {
 }
 
 func (cmd *AttachElasticip) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
@@ -334,7 +457,7 @@ func (cmd *AttachElasticip) run(renv env.Running, params map[string]interface{})
 	output, err := cmd.api.AssociateAddress(input)
 	renv.Log().ExtraVerbosef("ec2.AssociateAddress call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.AssociateAddress")
 	}
 
 	var extracted interface{}
@@ -390,6 +513,10 @@ func (cmd *AttachElasticip) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
+func (cmd *AttachElasticip) ExtractResult(i interface{}) string {
+	return StringValue(i.(*ec2.AssociateAddressOutput).AssociationId)
+}
+
 func NewAttachInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *AttachInstance {
 	cmd := new(AttachInstance)
 	if len(l) > 0 {
@@ -409,6 +536,9 @@ func (cmd *AttachInstance) SetApi(api elbv2iface.ELBV2API) {
 }
 
 func (cmd *AttachInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
@@ -434,7 +564,7 @@ func (cmd *AttachInstance) run(renv env.Running, params map[string]interface{})
 	output, err := cmd.api.RegisterTargets(input)
 	renv.Log().ExtraVerbosef("elbv2.RegisterTargets call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "elbv2.RegisterTargets")
 	}
 
 	var extracted interface{}
@@ -488,6 +618,9 @@ func (cmd *AttachInstanceprofile) SetApi(api ec2iface.EC2API) {
 }
 
 func (cmd *AttachInstanceprofile) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
@@ -507,7 +640,7 @@ func (cmd *AttachInstanceprofile) run(renv env.Running, params map[string]interf
 
 	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.")
 	}
 
 	var extracted interface{}
@@ -557,6 +690,9 @@ func (cmd *AttachInternetgateway) SetApi(api ec2iface.EC2API) {
 }
 
 func (cmd *AttachInternetgateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
@@ -582,7 +718,7 @@ func (cmd *AttachInternetgateway) run(renv env.Running, params map[string]interf
 	output, err := cmd.api.AttachInternetGateway(input)
 	renv.Log().ExtraVerbosef("ec2.AttachInternetGateway call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.AttachInternetGateway")
 	}
 
 	var extracted interface{}
@@ -657,6 +793,9 @@ func (cmd *AttachListener) SetApi(api elbv2iface.ELBV2API) {
 }
 
 func (cmd *AttachListener) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
@@ -682,7 +821,7 @@ func (cmd *AttachListener) run(renv env.Running, params map[string]interface{})
 	output, err := cmd.api.AddListenerCertificates(input)
 	renv.Log().ExtraVerbosef("elbv2.AddListenerCertificates call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "elbv2.AddListenerCertificates")
 	}
 
 	var extracted interface{}
@@ -736,6 +875,9 @@ func (cmd *AttachMfadevice) SetApi(api iamiface.IAMAPI) {
 }
 
 func (cmd *AttachMfadevice) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
@@ -761,7 +903,7 @@ func (cmd *AttachMfadevice) run(renv env.Running, params map[string]interface{})
 	output, err := cmd.api.EnableMFADevice(input)
 	renv.Log().ExtraVerbosef("iam.EnableMFADevice call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "iam.EnableMFADevice")
 	}
 
 	var extracted interface{}
@@ -815,6 +957,9 @@ func (cmd *AttachNetworkinterface) SetApi(api ec2iface.EC2API) {
 }
 
 func (cmd *AttachNetworkinterface) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
@@ -840,7 +985,7 @@ func (cmd *AttachNetworkinterface) run(renv env.Running, params map[string]inter
 	output, err := cmd.api.AttachNetworkInterface(input)
 	renv.Log().ExtraVerbosef("ec2.AttachNetworkInterface call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.AttachNetworkInterface")
 	}
 
 	var extracted interface{}
@@ -896,6 +1041,10 @@ func (cmd *AttachNetworkinterface) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
+func (cmd *AttachNetworkinterface) ExtractResult(i interface{}) string {
+	return StringValue(i.(*ec2.AttachNetworkInterfaceOutput).AttachmentId)
+}
+
 func NewAttachPolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *AttachPolicy {
 	cmd := new(AttachPolicy)
 	if len(l) > 0 {
@@ -915,6 +1064,9 @@ func (cmd *AttachPolicy) SetApi(api iamiface.IAMAPI) {
 }
 
 func (cmd *AttachPolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
@@ -934,7 +1086,7 @@ func (cmd *AttachPolicy) run(renv env.Running, params map[string]interface{}) (i
 
 	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "iam.")
 	}
 
 	var extracted interface{}
@@ -969,6 +1121,82 @@ func (cmd *AttachPolicy) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
+func NewAttachQueuepolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *AttachQueuepolicy {
+	cmd := new(AttachQueuepolicy)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = sqs.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *AttachQueuepolicy) SetApi(api sqsiface.SQSAPI) {
+	cmd.api = api
+}
+
+func (cmd *AttachQueuepolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *AttachQueuepolicy) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err, "sqs.")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("attach queuepolicy: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("attach queuepolicy '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("attach queuepolicy done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *AttachQueuepolicy) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("queuepolicy"), nil
+}
+
+func (cmd *AttachQueuepolicy) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
 func NewAttachRole(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *AttachRole {
 	cmd := new(AttachRole)
 	if len(l) > 0 {
@@ -988,6 +1216,9 @@ func (cmd *AttachRole) SetApi(api iamiface.IAMAPI) {
 }
 
 func (cmd *AttachRole) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
@@ -1013,7 +1244,7 @@ func (cmd *AttachRole) run(renv env.Running, params map[string]interface{}) (int
 	output, err := cmd.api.AddRoleToInstanceProfile(input)
 	renv.Log().ExtraVerbosef("iam.AddRoleToInstanceProfile call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "iam.AddRoleToInstanceProfile")
 	}
 
 	var extracted interface{}
@@ -1067,6 +1298,9 @@ func (cmd *AttachRoutetable) SetApi(api ec2iface.EC2API) {
 }
 
 func (cmd *AttachRoutetable) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
@@ -1092,7 +1326,7 @@ func (cmd *AttachRoutetable) run(renv env.Running, params map[string]interface{}
 	output, err := cmd.api.AssociateRouteTable(input)
 	renv.Log().ExtraVerbosef("ec2.AssociateRouteTable call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.AssociateRouteTable")
 	}
 
 	var extracted interface{}
@@ -1148,6 +1382,92 @@ func (cmd *AttachRoutetable) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
+func (cmd *AttachRoutetable) ExtractResult(i interface{}) string {
+	return StringValue(i.(*ec2.AssociateRouteTableOutput).AssociationId)
+}
+
+func NewAttachScalinggroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *AttachScalinggroup {
+	cmd := new(AttachScalinggroup)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = autoscaling.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *AttachScalinggroup) SetApi(api autoscalingiface.AutoScalingAPI) {
+	cmd.api = api
+}
+
+func (cmd *AttachScalinggroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *AttachScalinggroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &autoscaling.AttachLoadBalancerTargetGroupsInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in autoscaling.AttachLoadBalancerTargetGroupsInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.AttachLoadBalancerTargetGroups(input)
+	renv.Log().ExtraVerbosef("autoscaling.AttachLoadBalancerTargetGroups call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err, "autoscaling.AttachLoadBalancerTargetGroups")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("attach scalinggroup: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("attach scalinggroup '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("attach scalinggroup done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *AttachScalinggroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("scalinggroup"), nil
+}
+
+func (cmd *AttachScalinggroup) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
 func NewAttachSecuritygroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *AttachSecuritygroup {
 	cmd := new(AttachSecuritygroup)
 	if len(l) > 0 {
@@ -1167,6 +1487,9 @@ func (cmd *AttachSecuritygroup) SetApi(api ec2iface.EC2API) {
 }
 
 func (cmd *AttachSecuritygroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
@@ -1186,7 +1509,7 @@ func (cmd *AttachSecuritygroup) run(renv env.Running, params map[string]interfac
 
 	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.")
 	}
 
 	var extracted interface{}
@@ -1240,6 +1563,9 @@ func (cmd *AttachUser) SetApi(api iamiface.IAMAPI) {
 }
 
 func (cmd *AttachUser) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
@@ -1265,7 +1591,7 @@ func (cmd *AttachUser) run(renv env.Running, params map[string]interface{}) (int
 	output, err := cmd.api.AddUserToGroup(input)
 	renv.Log().ExtraVerbosef("iam.AddUserToGroup call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "iam.AddUserToGroup")
 	}
 
 	var extracted interface{}
@@ -1319,6 +1645,9 @@ func (cmd *AttachVolume) SetApi(api ec2iface.EC2API) {
 }
 
 func (cmd *AttachVolume) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
@@ -1344,7 +1673,7 @@ func (cmd *AttachVolume) run(renv env.Running, params map[string]interface{}) (i
 	output, err := cmd.api.AttachVolume(input)
 	renv.Log().ExtraVerbosef("ec2.AttachVolume call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.AttachVolume")
 	}
 
 	var extracted interface{}
@@ -1400,32 +1729,39 @@ func (cmd *AttachVolume) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewAuthenticateRegistry(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *AuthenticateRegistry {
-	cmd := new(AuthenticateRegistry)
+func (cmd *AttachVolume) ExtractResult(i interface{}) string {
+	return StringValue(i.(*ec2.VolumeAttachment).VolumeId)
+}
+
+func NewAttachVpcendpoint(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *AttachVpcendpoint {
+	cmd := new(AttachVpcendpoint)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ecr.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *AuthenticateRegistry) SetApi(api ecriface.ECRAPI) {
+func (cmd *AttachVpcendpoint) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *AuthenticateRegistry) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *AttachVpcendpoint) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *AuthenticateRegistry) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *AttachVpcendpoint) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -1436,9 +1772,15 @@ func (cmd *AuthenticateRegistry) run(renv env.Running, params map[string]interfa
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &ec2.ModifyVpcEndpointInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.ModifyVpcEndpointInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.ModifyVpcEndpoint(input)
+	renv.Log().ExtraVerbosef("ec2.ModifyVpcEndpoint call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.ModifyVpcEndpoint")
 	}
 
 	var extracted interface{}
@@ -1446,14 +1788,14 @@ func (cmd *AuthenticateRegistry) run(renv env.Running, params map[string]interfa
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("authenticate registry: AWS command returned nil output")
+			renv.Log().Warning("attach vpcendpoint: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("authenticate registry '%s' done", extracted)
+		renv.Log().Verbosef("attach vpcendpoint '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("authenticate registry done")
+		renv.Log().Verbose("attach vpcendpoint done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -1465,40 +1807,64 @@ func (cmd *AuthenticateRegistry) run(renv env.Running, params map[string]interfa
 	return extracted, nil
 }
 
-func (cmd *AuthenticateRegistry) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("registry"), nil
-}
-
-func (cmd *AuthenticateRegistry) inject(params map[string]interface{}) error {
-	return structSetter(cmd, params)
-}
+func (cmd *AttachVpcendpoint) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
 
-func NewCheckCertificate(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckCertificate {
-	cmd := new(CheckCertificate)
+	input := &ec2.ModifyVpcEndpointInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.ModifyVpcEndpointInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.ModifyVpcEndpoint(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.ModifyVpcEndpoint call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: attach vpcendpoint ok")
+			return fakeDryRunId("vpcendpoint"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *AttachVpcendpoint) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewAuthenticateRegistry(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *AuthenticateRegistry {
+	cmd := new(AuthenticateRegistry)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = acm.New(sess)
+		cmd.api = ecr.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CheckCertificate) SetApi(api acmiface.ACMAPI) {
+func (cmd *AuthenticateRegistry) SetApi(api ecriface.ECRAPI) {
 	cmd.api = api
 }
 
-func (cmd *CheckCertificate) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *AuthenticateRegistry) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CheckCertificate) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *AuthenticateRegistry) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -1511,7 +1877,7 @@ func (cmd *CheckCertificate) run(renv env.Running, params map[string]interface{}
 
 	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ecr.")
 	}
 
 	var extracted interface{}
@@ -1519,14 +1885,14 @@ func (cmd *CheckCertificate) run(renv env.Running, params map[string]interface{}
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("check certificate: AWS command returned nil output")
+			renv.Log().Warning("authenticate registry: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("check certificate '%s' done", extracted)
+		renv.Log().Verbosef("authenticate registry '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("check certificate done")
+		renv.Log().Verbose("authenticate registry done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -1538,40 +1904,43 @@ func (cmd *CheckCertificate) run(renv env.Running, params map[string]interface{}
 	return extracted, nil
 }
 
-func (cmd *CheckCertificate) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("certificate"), nil
+func (cmd *AuthenticateRegistry) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("registry"), nil
 }
 
-func (cmd *CheckCertificate) inject(params map[string]interface{}) error {
+func (cmd *AuthenticateRegistry) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCheckDatabase(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckDatabase {
-	cmd := new(CheckDatabase)
+func NewBackupInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *BackupInstance {
+	cmd := new(BackupInstance)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = rds.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CheckDatabase) SetApi(api rdsiface.RDSAPI) {
+func (cmd *BackupInstance) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CheckDatabase) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *BackupInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CheckDatabase) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *BackupInstance) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -1584,7 +1953,7 @@ func (cmd *CheckDatabase) run(renv env.Running, params map[string]interface{}) (
 
 	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.")
 	}
 
 	var extracted interface{}
@@ -1592,14 +1961,14 @@ func (cmd *CheckDatabase) run(renv env.Running, params map[string]interface{}) (
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("check database: AWS command returned nil output")
+			renv.Log().Warning("backup instance: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("check database '%s' done", extracted)
+		renv.Log().Verbosef("backup instance '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("check database done")
+		renv.Log().Verbose("backup instance done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -1611,40 +1980,43 @@ func (cmd *CheckDatabase) run(renv env.Running, params map[string]interface{}) (
 	return extracted, nil
 }
 
-func (cmd *CheckDatabase) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("database"), nil
+func (cmd *BackupInstance) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("instance"), nil
 }
 
-func (cmd *CheckDatabase) inject(params map[string]interface{}) error {
+func (cmd *BackupInstance) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCheckDistribution(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckDistribution {
-	cmd := new(CheckDistribution)
+func NewBootstrapInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *BootstrapInstance {
+	cmd := new(BootstrapInstance)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = cloudfront.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CheckDistribution) SetApi(api cloudfrontiface.CloudFrontAPI) {
+func (cmd *BootstrapInstance) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CheckDistribution) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *BootstrapInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CheckDistribution) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *BootstrapInstance) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -1657,7 +2029,7 @@ func (cmd *CheckDistribution) run(renv env.Running, params map[string]interface{
 
 	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.")
 	}
 
 	var extracted interface{}
@@ -1665,14 +2037,14 @@ func (cmd *CheckDistribution) run(renv env.Running, params map[string]interface{
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("check distribution: AWS command returned nil output")
+			renv.Log().Warning("bootstrap instance: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("check distribution '%s' done", extracted)
+		renv.Log().Verbosef("bootstrap instance '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("check distribution done")
+		renv.Log().Verbose("bootstrap instance done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -1684,40 +2056,43 @@ func (cmd *CheckDistribution) run(renv env.Running, params map[string]interface{
 	return extracted, nil
 }
 
-func (cmd *CheckDistribution) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("distribution"), nil
+func (cmd *BootstrapInstance) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("instance"), nil
 }
 
-func (cmd *CheckDistribution) inject(params map[string]interface{}) error {
+func (cmd *BootstrapInstance) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCheckInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckInstance {
-	cmd := new(CheckInstance)
+func NewCheckAlarm(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckAlarm {
+	cmd := new(CheckAlarm)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = cloudwatch.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CheckInstance) SetApi(api ec2iface.EC2API) {
+func (cmd *CheckAlarm) SetApi(api cloudwatchiface.CloudWatchAPI) {
 	cmd.api = api
 }
 
-func (cmd *CheckInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckAlarm) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CheckInstance) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckAlarm) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -1730,7 +2105,7 @@ func (cmd *CheckInstance) run(renv env.Running, params map[string]interface{}) (
 
 	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "cloudwatch.")
 	}
 
 	var extracted interface{}
@@ -1738,14 +2113,14 @@ func (cmd *CheckInstance) run(renv env.Running, params map[string]interface{}) (
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("check instance: AWS command returned nil output")
+			renv.Log().Warning("check alarm: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("check instance '%s' done", extracted)
+		renv.Log().Verbosef("check alarm '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("check instance done")
+		renv.Log().Verbose("check alarm done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -1757,40 +2132,43 @@ func (cmd *CheckInstance) run(renv env.Running, params map[string]interface{}) (
 	return extracted, nil
 }
 
-func (cmd *CheckInstance) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("instance"), nil
+func (cmd *CheckAlarm) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("alarm"), nil
 }
 
-func (cmd *CheckInstance) inject(params map[string]interface{}) error {
+func (cmd *CheckAlarm) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCheckLoadbalancer(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckLoadbalancer {
-	cmd := new(CheckLoadbalancer)
+func NewCheckCertificate(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckCertificate {
+	cmd := new(CheckCertificate)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = elbv2.New(sess)
+		cmd.api = acm.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CheckLoadbalancer) SetApi(api elbv2iface.ELBV2API) {
+func (cmd *CheckCertificate) SetApi(api acmiface.ACMAPI) {
 	cmd.api = api
 }
 
-func (cmd *CheckLoadbalancer) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckCertificate) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CheckLoadbalancer) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckCertificate) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -1803,7 +2181,7 @@ func (cmd *CheckLoadbalancer) run(renv env.Running, params map[string]interface{
 
 	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "acm.")
 	}
 
 	var extracted interface{}
@@ -1811,14 +2189,14 @@ func (cmd *CheckLoadbalancer) run(renv env.Running, params map[string]interface{
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("check loadbalancer: AWS command returned nil output")
+			renv.Log().Warning("check certificate: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("check loadbalancer '%s' done", extracted)
+		renv.Log().Verbosef("check certificate '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("check loadbalancer done")
+		renv.Log().Verbose("check certificate done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -1830,40 +2208,43 @@ func (cmd *CheckLoadbalancer) run(renv env.Running, params map[string]interface{
 	return extracted, nil
 }
 
-func (cmd *CheckLoadbalancer) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("loadbalancer"), nil
+func (cmd *CheckCertificate) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("certificate"), nil
 }
 
-func (cmd *CheckLoadbalancer) inject(params map[string]interface{}) error {
+func (cmd *CheckCertificate) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCheckNatgateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckNatgateway {
-	cmd := new(CheckNatgateway)
+func NewCheckDatabase(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckDatabase {
+	cmd := new(CheckDatabase)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = rds.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CheckNatgateway) SetApi(api ec2iface.EC2API) {
+func (cmd *CheckDatabase) SetApi(api rdsiface.RDSAPI) {
 	cmd.api = api
 }
 
-func (cmd *CheckNatgateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckDatabase) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CheckNatgateway) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckDatabase) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -1876,7 +2257,7 @@ func (cmd *CheckNatgateway) run(renv env.Running, params map[string]interface{})
 
 	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "rds.")
 	}
 
 	var extracted interface{}
@@ -1884,14 +2265,14 @@ func (cmd *CheckNatgateway) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("check natgateway: AWS command returned nil output")
+			renv.Log().Warning("check database: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("check natgateway '%s' done", extracted)
+		renv.Log().Verbosef("check database '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("check natgateway done")
+		renv.Log().Verbose("check database done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -1903,40 +2284,43 @@ func (cmd *CheckNatgateway) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *CheckNatgateway) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("natgateway"), nil
+func (cmd *CheckDatabase) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("database"), nil
 }
 
-func (cmd *CheckNatgateway) inject(params map[string]interface{}) error {
+func (cmd *CheckDatabase) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCheckNetworkinterface(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckNetworkinterface {
-	cmd := new(CheckNetworkinterface)
+func NewCheckDistribution(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckDistribution {
+	cmd := new(CheckDistribution)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = cloudfront.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CheckNetworkinterface) SetApi(api ec2iface.EC2API) {
+func (cmd *CheckDistribution) SetApi(api cloudfrontiface.CloudFrontAPI) {
 	cmd.api = api
 }
 
-func (cmd *CheckNetworkinterface) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckDistribution) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CheckNetworkinterface) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckDistribution) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -1949,7 +2333,7 @@ func (cmd *CheckNetworkinterface) run(renv env.Running, params map[string]interf
 
 	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "cloudfront.")
 	}
 
 	var extracted interface{}
@@ -1957,14 +2341,14 @@ func (cmd *CheckNetworkinterface) run(renv env.Running, params map[string]interf
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("check networkinterface: AWS command returned nil output")
+			renv.Log().Warning("check distribution: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("check networkinterface '%s' done", extracted)
+		renv.Log().Verbosef("check distribution '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("check networkinterface done")
+		renv.Log().Verbose("check distribution done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -1976,40 +2360,43 @@ func (cmd *CheckNetworkinterface) run(renv env.Running, params map[string]interf
 	return extracted, nil
 }
 
-func (cmd *CheckNetworkinterface) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("networkinterface"), nil
+func (cmd *CheckDistribution) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("distribution"), nil
 }
 
-func (cmd *CheckNetworkinterface) inject(params map[string]interface{}) error {
+func (cmd *CheckDistribution) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCheckScalinggroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckScalinggroup {
-	cmd := new(CheckScalinggroup)
+func NewCheckElasticsearchdomain(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckElasticsearchdomain {
+	cmd := new(CheckElasticsearchdomain)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = autoscaling.New(sess)
+		cmd.api = elasticsearchservice.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CheckScalinggroup) SetApi(api autoscalingiface.AutoScalingAPI) {
+func (cmd *CheckElasticsearchdomain) SetApi(api elasticsearchserviceiface.ElasticsearchServiceAPI) {
 	cmd.api = api
 }
 
-func (cmd *CheckScalinggroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckElasticsearchdomain) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CheckScalinggroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckElasticsearchdomain) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -2022,7 +2409,7 @@ func (cmd *CheckScalinggroup) run(renv env.Running, params map[string]interface{
 
 	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "elasticsearchservice.")
 	}
 
 	var extracted interface{}
@@ -2030,14 +2417,14 @@ func (cmd *CheckScalinggroup) run(renv env.Running, params map[string]interface{
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("check scalinggroup: AWS command returned nil output")
+			renv.Log().Warning("check elasticsearchdomain: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("check scalinggroup '%s' done", extracted)
+		renv.Log().Verbosef("check elasticsearchdomain '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("check scalinggroup done")
+		renv.Log().Verbose("check elasticsearchdomain done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -2049,40 +2436,43 @@ func (cmd *CheckScalinggroup) run(renv env.Running, params map[string]interface{
 	return extracted, nil
 }
 
-func (cmd *CheckScalinggroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("scalinggroup"), nil
+func (cmd *CheckElasticsearchdomain) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("elasticsearchdomain"), nil
 }
 
-func (cmd *CheckScalinggroup) inject(params map[string]interface{}) error {
+func (cmd *CheckElasticsearchdomain) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCheckSecuritygroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckSecuritygroup {
-	cmd := new(CheckSecuritygroup)
+func NewCheckEnvironment(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckEnvironment {
+	cmd := new(CheckEnvironment)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = elasticbeanstalk.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CheckSecuritygroup) SetApi(api ec2iface.EC2API) {
+func (cmd *CheckEnvironment) SetApi(api elasticbeanstalkiface.ElasticBeanstalkAPI) {
 	cmd.api = api
 }
 
-func (cmd *CheckSecuritygroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckEnvironment) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CheckSecuritygroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckEnvironment) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -2095,7 +2485,7 @@ func (cmd *CheckSecuritygroup) run(renv env.Running, params map[string]interface
 
 	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "elasticbeanstalk.")
 	}
 
 	var extracted interface{}
@@ -2103,14 +2493,14 @@ func (cmd *CheckSecuritygroup) run(renv env.Running, params map[string]interface
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("check securitygroup: AWS command returned nil output")
+			renv.Log().Warning("check environment: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("check securitygroup '%s' done", extracted)
+		renv.Log().Verbosef("check environment '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("check securitygroup done")
+		renv.Log().Verbose("check environment done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -2122,40 +2512,43 @@ func (cmd *CheckSecuritygroup) run(renv env.Running, params map[string]interface
 	return extracted, nil
 }
 
-func (cmd *CheckSecuritygroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("securitygroup"), nil
+func (cmd *CheckEnvironment) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("environment"), nil
 }
 
-func (cmd *CheckSecuritygroup) inject(params map[string]interface{}) error {
+func (cmd *CheckEnvironment) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCheckVolume(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckVolume {
-	cmd := new(CheckVolume)
+func NewCheckHealthcheck(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckHealthcheck {
+	cmd := new(CheckHealthcheck)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = route53.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CheckVolume) SetApi(api ec2iface.EC2API) {
+func (cmd *CheckHealthcheck) SetApi(api route53iface.Route53API) {
 	cmd.api = api
 }
 
-func (cmd *CheckVolume) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckHealthcheck) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CheckVolume) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckHealthcheck) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -2168,7 +2561,7 @@ func (cmd *CheckVolume) run(renv env.Running, params map[string]interface{}) (in
 
 	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "route53.")
 	}
 
 	var extracted interface{}
@@ -2176,14 +2569,14 @@ func (cmd *CheckVolume) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("check volume: AWS command returned nil output")
+			renv.Log().Warning("check healthcheck: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("check volume '%s' done", extracted)
+		renv.Log().Verbosef("check healthcheck '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("check volume done")
+		renv.Log().Verbose("check healthcheck done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -2195,16 +2588,16 @@ func (cmd *CheckVolume) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *CheckVolume) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("volume"), nil
+func (cmd *CheckHealthcheck) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("healthcheck"), nil
 }
 
-func (cmd *CheckVolume) inject(params map[string]interface{}) error {
+func (cmd *CheckHealthcheck) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCopyImage(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CopyImage {
-	cmd := new(CopyImage)
+func NewCheckInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckInstance {
+	cmd := new(CheckInstance)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -2217,18 +2610,21 @@ func NewCopyImage(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger)
 	return cmd
 }
 
-func (cmd *CopyImage) SetApi(api ec2iface.EC2API) {
+func (cmd *CheckInstance) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CopyImage) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CopyImage) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckInstance) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -2239,15 +2635,9 @@ func (cmd *CopyImage) run(renv env.Running, params map[string]interface{}) (inte
 		}
 	}
 
-	input := &ec2.CopyImageInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CopyImageInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.CopyImage(input)
-	renv.Log().ExtraVerbosef("ec2.CopyImage call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.")
 	}
 
 	var extracted interface{}
@@ -2255,14 +2645,14 @@ func (cmd *CopyImage) run(renv env.Running, params map[string]interface{}) (inte
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("copy image: AWS command returned nil output")
+			renv.Log().Warning("check instance: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("copy image '%s' done", extracted)
+		renv.Log().Verbosef("check instance '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("copy image done")
+		renv.Log().Verbose("check instance done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -2274,61 +2664,43 @@ func (cmd *CopyImage) run(renv env.Running, params map[string]interface{}) (inte
 	return extracted, nil
 }
 
-func (cmd *CopyImage) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.CopyImageInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CopyImageInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.CopyImage(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.CopyImage call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: copy image ok")
-			return fakeDryRunId("image"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *CheckInstance) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("instance"), nil
 }
 
-func (cmd *CopyImage) inject(params map[string]interface{}) error {
+func (cmd *CheckInstance) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCopySnapshot(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CopySnapshot {
-	cmd := new(CopySnapshot)
+func NewCheckLoadbalancer(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckLoadbalancer {
+	cmd := new(CheckLoadbalancer)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = elbv2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CopySnapshot) SetApi(api ec2iface.EC2API) {
+func (cmd *CheckLoadbalancer) SetApi(api elbv2iface.ELBV2API) {
 	cmd.api = api
 }
 
-func (cmd *CopySnapshot) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckLoadbalancer) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CopySnapshot) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckLoadbalancer) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -2339,15 +2711,9 @@ func (cmd *CopySnapshot) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	input := &ec2.CopySnapshotInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CopySnapshotInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.CopySnapshot(input)
-	renv.Log().ExtraVerbosef("ec2.CopySnapshot call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "elbv2.")
 	}
 
 	var extracted interface{}
@@ -2355,14 +2721,14 @@ func (cmd *CopySnapshot) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("copy snapshot: AWS command returned nil output")
+			renv.Log().Warning("check loadbalancer: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("copy snapshot '%s' done", extracted)
+		renv.Log().Verbosef("check loadbalancer '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("copy snapshot done")
+		renv.Log().Verbose("check loadbalancer done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -2374,61 +2740,43 @@ func (cmd *CopySnapshot) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *CopySnapshot) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.CopySnapshotInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CopySnapshotInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.CopySnapshot(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.CopySnapshot call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: copy snapshot ok")
-			return fakeDryRunId("snapshot"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *CheckLoadbalancer) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("loadbalancer"), nil
 }
 
-func (cmd *CopySnapshot) inject(params map[string]interface{}) error {
+func (cmd *CheckLoadbalancer) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateAccesskey(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateAccesskey {
-	cmd := new(CreateAccesskey)
+func NewCheckNatgateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckNatgateway {
+	cmd := new(CheckNatgateway)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateAccesskey) SetApi(api iamiface.IAMAPI) {
+func (cmd *CheckNatgateway) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CreateAccesskey) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckNatgateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateAccesskey) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckNatgateway) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -2439,15 +2787,9 @@ func (cmd *CreateAccesskey) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &iam.CreateAccessKeyInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.CreateAccessKeyInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.CreateAccessKey(input)
-	renv.Log().ExtraVerbosef("iam.CreateAccessKey call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.")
 	}
 
 	var extracted interface{}
@@ -2455,14 +2797,14 @@ func (cmd *CreateAccesskey) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create accesskey: AWS command returned nil output")
+			renv.Log().Warning("check natgateway: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create accesskey '%s' done", extracted)
+		renv.Log().Verbosef("check natgateway '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create accesskey done")
+		renv.Log().Verbose("check natgateway done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -2474,40 +2816,43 @@ func (cmd *CreateAccesskey) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *CreateAccesskey) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("accesskey"), nil
+func (cmd *CheckNatgateway) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("natgateway"), nil
 }
 
-func (cmd *CreateAccesskey) inject(params map[string]interface{}) error {
+func (cmd *CheckNatgateway) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateAlarm(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateAlarm {
-	cmd := new(CreateAlarm)
+func NewCheckNetworkinterface(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckNetworkinterface {
+	cmd := new(CheckNetworkinterface)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = cloudwatch.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateAlarm) SetApi(api cloudwatchiface.CloudWatchAPI) {
+func (cmd *CheckNetworkinterface) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CreateAlarm) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckNetworkinterface) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateAlarm) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckNetworkinterface) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -2518,15 +2863,9 @@ func (cmd *CreateAlarm) run(renv env.Running, params map[string]interface{}) (in
 		}
 	}
 
-	input := &cloudwatch.PutMetricAlarmInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in cloudwatch.PutMetricAlarmInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.PutMetricAlarm(input)
-	renv.Log().ExtraVerbosef("cloudwatch.PutMetricAlarm call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.")
 	}
 
 	var extracted interface{}
@@ -2534,14 +2873,14 @@ func (cmd *CreateAlarm) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create alarm: AWS command returned nil output")
+			renv.Log().Warning("check networkinterface: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create alarm '%s' done", extracted)
+		renv.Log().Verbosef("check networkinterface '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create alarm done")
+		renv.Log().Verbose("check networkinterface done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -2553,40 +2892,43 @@ func (cmd *CreateAlarm) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *CreateAlarm) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("alarm"), nil
+func (cmd *CheckNetworkinterface) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("networkinterface"), nil
 }
 
-func (cmd *CreateAlarm) inject(params map[string]interface{}) error {
+func (cmd *CheckNetworkinterface) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateAppscalingpolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateAppscalingpolicy {
-	cmd := new(CreateAppscalingpolicy)
+func NewCheckRecord(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckRecord {
+	cmd := new(CheckRecord)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = applicationautoscaling.New(sess)
+		cmd.api = route53.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateAppscalingpolicy) SetApi(api applicationautoscalingiface.ApplicationAutoScalingAPI) {
+func (cmd *CheckRecord) SetApi(api route53iface.Route53API) {
 	cmd.api = api
 }
 
-func (cmd *CreateAppscalingpolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckRecord) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateAppscalingpolicy) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckRecord) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -2597,15 +2939,9 @@ func (cmd *CreateAppscalingpolicy) run(renv env.Running, params map[string]inter
 		}
 	}
 
-	input := &applicationautoscaling.PutScalingPolicyInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in applicationautoscaling.PutScalingPolicyInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.PutScalingPolicy(input)
-	renv.Log().ExtraVerbosef("applicationautoscaling.PutScalingPolicy call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "route53.")
 	}
 
 	var extracted interface{}
@@ -2613,14 +2949,14 @@ func (cmd *CreateAppscalingpolicy) run(renv env.Running, params map[string]inter
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create appscalingpolicy: AWS command returned nil output")
+			renv.Log().Warning("check record: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create appscalingpolicy '%s' done", extracted)
+		renv.Log().Verbosef("check record '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create appscalingpolicy done")
+		renv.Log().Verbose("check record done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -2632,40 +2968,43 @@ func (cmd *CreateAppscalingpolicy) run(renv env.Running, params map[string]inter
 	return extracted, nil
 }
 
-func (cmd *CreateAppscalingpolicy) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("appscalingpolicy"), nil
+func (cmd *CheckRecord) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("record"), nil
 }
 
-func (cmd *CreateAppscalingpolicy) inject(params map[string]interface{}) error {
+func (cmd *CheckRecord) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateAppscalingtarget(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateAppscalingtarget {
-	cmd := new(CreateAppscalingtarget)
+func NewCheckScalinggroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckScalinggroup {
+	cmd := new(CheckScalinggroup)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = applicationautoscaling.New(sess)
+		cmd.api = autoscaling.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateAppscalingtarget) SetApi(api applicationautoscalingiface.ApplicationAutoScalingAPI) {
+func (cmd *CheckScalinggroup) SetApi(api autoscalingiface.AutoScalingAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateAppscalingtarget) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckScalinggroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateAppscalingtarget) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckScalinggroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -2676,15 +3015,9 @@ func (cmd *CreateAppscalingtarget) run(renv env.Running, params map[string]inter
 		}
 	}
 
-	input := &applicationautoscaling.RegisterScalableTargetInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in applicationautoscaling.RegisterScalableTargetInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.RegisterScalableTarget(input)
-	renv.Log().ExtraVerbosef("applicationautoscaling.RegisterScalableTarget call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "autoscaling.")
 	}
 
 	var extracted interface{}
@@ -2692,14 +3025,14 @@ func (cmd *CreateAppscalingtarget) run(renv env.Running, params map[string]inter
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create appscalingtarget: AWS command returned nil output")
+			renv.Log().Warning("check scalinggroup: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create appscalingtarget '%s' done", extracted)
+		renv.Log().Verbosef("check scalinggroup '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create appscalingtarget done")
+		renv.Log().Verbose("check scalinggroup done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -2711,40 +3044,43 @@ func (cmd *CreateAppscalingtarget) run(renv env.Running, params map[string]inter
 	return extracted, nil
 }
 
-func (cmd *CreateAppscalingtarget) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("appscalingtarget"), nil
+func (cmd *CheckScalinggroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("scalinggroup"), nil
 }
 
-func (cmd *CreateAppscalingtarget) inject(params map[string]interface{}) error {
+func (cmd *CheckScalinggroup) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateBucket(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateBucket {
-	cmd := new(CreateBucket)
+func NewCheckSecuritygroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckSecuritygroup {
+	cmd := new(CheckSecuritygroup)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = s3.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateBucket) SetApi(api s3iface.S3API) {
+func (cmd *CheckSecuritygroup) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CreateBucket) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckSecuritygroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateBucket) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckSecuritygroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -2755,15 +3091,9 @@ func (cmd *CreateBucket) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	input := &s3.CreateBucketInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in s3.CreateBucketInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.CreateBucket(input)
-	renv.Log().ExtraVerbosef("s3.CreateBucket call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.")
 	}
 
 	var extracted interface{}
@@ -2771,14 +3101,14 @@ func (cmd *CreateBucket) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create bucket: AWS command returned nil output")
+			renv.Log().Warning("check securitygroup: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create bucket '%s' done", extracted)
+		renv.Log().Verbosef("check securitygroup '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create bucket done")
+		renv.Log().Verbose("check securitygroup done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -2790,40 +3120,43 @@ func (cmd *CreateBucket) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *CreateBucket) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("bucket"), nil
+func (cmd *CheckSecuritygroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("securitygroup"), nil
 }
 
-func (cmd *CreateBucket) inject(params map[string]interface{}) error {
+func (cmd *CheckSecuritygroup) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateCertificate(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateCertificate {
-	cmd := new(CreateCertificate)
+func NewCheckTable(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckTable {
+	cmd := new(CheckTable)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = acm.New(sess)
+		cmd.api = dynamodb.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateCertificate) SetApi(api acmiface.ACMAPI) {
+func (cmd *CheckTable) SetApi(api dynamodbiface.DynamoDBAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateCertificate) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckTable) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateCertificate) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckTable) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -2836,7 +3169,7 @@ func (cmd *CreateCertificate) run(renv env.Running, params map[string]interface{
 
 	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "dynamodb.")
 	}
 
 	var extracted interface{}
@@ -2844,14 +3177,14 @@ func (cmd *CreateCertificate) run(renv env.Running, params map[string]interface{
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create certificate: AWS command returned nil output")
+			renv.Log().Warning("check table: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create certificate '%s' done", extracted)
+		renv.Log().Verbosef("check table '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create certificate done")
+		renv.Log().Verbose("check table done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -2863,40 +3196,43 @@ func (cmd *CreateCertificate) run(renv env.Running, params map[string]interface{
 	return extracted, nil
 }
 
-func (cmd *CreateCertificate) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("certificate"), nil
+func (cmd *CheckTable) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("table"), nil
 }
 
-func (cmd *CreateCertificate) inject(params map[string]interface{}) error {
+func (cmd *CheckTable) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateClassicLoadbalancer(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateClassicLoadbalancer {
-	cmd := new(CreateClassicLoadbalancer)
+func NewCheckVolume(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckVolume {
+	cmd := new(CheckVolume)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = elb.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateClassicLoadbalancer) SetApi(api elbiface.ELBAPI) {
+func (cmd *CheckVolume) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CreateClassicLoadbalancer) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckVolume) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateClassicLoadbalancer) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckVolume) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -2907,15 +3243,9 @@ func (cmd *CreateClassicLoadbalancer) run(renv env.Running, params map[string]in
 		}
 	}
 
-	input := &elb.CreateLoadBalancerInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in elb.CreateLoadBalancerInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.CreateLoadBalancer(input)
-	renv.Log().ExtraVerbosef("elb.CreateLoadBalancer call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.")
 	}
 
 	var extracted interface{}
@@ -2923,14 +3253,14 @@ func (cmd *CreateClassicLoadbalancer) run(renv env.Running, params map[string]in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create classicloadbalancer: AWS command returned nil output")
+			renv.Log().Warning("check volume: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create classicloadbalancer '%s' done", extracted)
+		renv.Log().Verbosef("check volume '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create classicloadbalancer done")
+		renv.Log().Verbose("check volume done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -2942,40 +3272,43 @@ func (cmd *CreateClassicLoadbalancer) run(renv env.Running, params map[string]in
 	return extracted, nil
 }
 
-func (cmd *CreateClassicLoadbalancer) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("classicloadbalancer"), nil
+func (cmd *CheckVolume) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("volume"), nil
 }
 
-func (cmd *CreateClassicLoadbalancer) inject(params map[string]interface{}) error {
+func (cmd *CheckVolume) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateContainercluster(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateContainercluster {
-	cmd := new(CreateContainercluster)
-	if len(l) > 0 {
+func NewCopyImage(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CopyImage {
+	cmd := new(CopyImage)
+	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ecs.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateContainercluster) SetApi(api ecsiface.ECSAPI) {
+func (cmd *CopyImage) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CreateContainercluster) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CopyImage) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateContainercluster) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CopyImage) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -2986,15 +3319,15 @@ func (cmd *CreateContainercluster) run(renv env.Running, params map[string]inter
 		}
 	}
 
-	input := &ecs.CreateClusterInput{}
+	input := &ec2.CopyImageInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ecs.CreateClusterInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.CopyImageInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateCluster(input)
-	renv.Log().ExtraVerbosef("ecs.CreateCluster call took %s", time.Since(start))
+	output, err := cmd.api.CopyImage(input)
+	renv.Log().ExtraVerbosef("ec2.CopyImage call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.CopyImage")
 	}
 
 	var extracted interface{}
@@ -3002,14 +3335,14 @@ func (cmd *CreateContainercluster) run(renv env.Running, params map[string]inter
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create containercluster: AWS command returned nil output")
+			renv.Log().Warning("copy image: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create containercluster '%s' done", extracted)
+		renv.Log().Verbosef("copy image '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create containercluster done")
+		renv.Log().Verbose("copy image done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -3021,40 +3354,68 @@ func (cmd *CreateContainercluster) run(renv env.Running, params map[string]inter
 	return extracted, nil
 }
 
-func (cmd *CreateContainercluster) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("containercluster"), nil
+func (cmd *CopyImage) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.CopyImageInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CopyImageInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.CopyImage(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.CopyImage call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: copy image ok")
+			return fakeDryRunId("image"), nil
+		}
+	}
+
+	return nil, err
 }
 
-func (cmd *CreateContainercluster) inject(params map[string]interface{}) error {
+func (cmd *CopyImage) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateDatabase(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateDatabase {
-	cmd := new(CreateDatabase)
+func (cmd *CopyImage) ExtractResult(i interface{}) string {
+	return StringValue(i.(*ec2.CopyImageOutput).ImageId)
+}
+
+func NewCopySnapshot(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CopySnapshot {
+	cmd := new(CopySnapshot)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = rds.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateDatabase) SetApi(api rdsiface.RDSAPI) {
+func (cmd *CopySnapshot) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CreateDatabase) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CopySnapshot) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateDatabase) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CopySnapshot) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -3065,9 +3426,15 @@ func (cmd *CreateDatabase) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &ec2.CopySnapshotInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CopySnapshotInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CopySnapshot(input)
+	renv.Log().ExtraVerbosef("ec2.CopySnapshot call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.CopySnapshot")
 	}
 
 	var extracted interface{}
@@ -3075,14 +3442,14 @@ func (cmd *CreateDatabase) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create database: AWS command returned nil output")
+			renv.Log().Warning("copy snapshot: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create database '%s' done", extracted)
+		renv.Log().Verbosef("copy snapshot '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create database done")
+		renv.Log().Verbose("copy snapshot done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -3094,40 +3461,68 @@ func (cmd *CreateDatabase) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *CreateDatabase) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("database"), nil
+func (cmd *CopySnapshot) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.CopySnapshotInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CopySnapshotInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.CopySnapshot(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.CopySnapshot call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: copy snapshot ok")
+			return fakeDryRunId("snapshot"), nil
+		}
+	}
+
+	return nil, err
 }
 
-func (cmd *CreateDatabase) inject(params map[string]interface{}) error {
+func (cmd *CopySnapshot) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateDbsubnetgroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateDbsubnetgroup {
-	cmd := new(CreateDbsubnetgroup)
+func (cmd *CopySnapshot) ExtractResult(i interface{}) string {
+	return StringValue(i.(*ec2.CopySnapshotOutput).SnapshotId)
+}
+
+func NewCreateAccesskey(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateAccesskey {
+	cmd := new(CreateAccesskey)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = rds.New(sess)
+		cmd.api = iam.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateDbsubnetgroup) SetApi(api rdsiface.RDSAPI) {
+func (cmd *CreateAccesskey) SetApi(api iamiface.IAMAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateDbsubnetgroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateAccesskey) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateDbsubnetgroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateAccesskey) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -3138,15 +3533,15 @@ func (cmd *CreateDbsubnetgroup) run(renv env.Running, params map[string]interfac
 		}
 	}
 
-	input := &rds.CreateDBSubnetGroupInput{}
+	input := &iam.CreateAccessKeyInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in rds.CreateDBSubnetGroupInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in iam.CreateAccessKeyInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateDBSubnetGroup(input)
-	renv.Log().ExtraVerbosef("rds.CreateDBSubnetGroup call took %s", time.Since(start))
+	output, err := cmd.api.CreateAccessKey(input)
+	renv.Log().ExtraVerbosef("iam.CreateAccessKey call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "iam.CreateAccessKey")
 	}
 
 	var extracted interface{}
@@ -3154,14 +3549,14 @@ func (cmd *CreateDbsubnetgroup) run(renv env.Running, params map[string]interfac
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create dbsubnetgroup: AWS command returned nil output")
+			renv.Log().Warning("create accesskey: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create dbsubnetgroup '%s' done", extracted)
+		renv.Log().Verbosef("create accesskey '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create dbsubnetgroup done")
+		renv.Log().Verbose("create accesskey done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -3173,40 +3568,47 @@ func (cmd *CreateDbsubnetgroup) run(renv env.Running, params map[string]interfac
 	return extracted, nil
 }
 
-func (cmd *CreateDbsubnetgroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("dbsubnetgroup"), nil
+func (cmd *CreateAccesskey) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("accesskey"), nil
 }
 
-func (cmd *CreateDbsubnetgroup) inject(params map[string]interface{}) error {
+func (cmd *CreateAccesskey) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateDistribution(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateDistribution {
-	cmd := new(CreateDistribution)
+func (cmd *CreateAccesskey) ExtractResult(i interface{}) string {
+	return StringValue(i.(*iam.CreateAccessKeyOutput).AccessKey.AccessKeyId)
+}
+
+func NewCreateAlarm(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateAlarm {
+	cmd := new(CreateAlarm)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = cloudfront.New(sess)
+		cmd.api = cloudwatch.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateDistribution) SetApi(api cloudfrontiface.CloudFrontAPI) {
+func (cmd *CreateAlarm) SetApi(api cloudwatchiface.CloudWatchAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateDistribution) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateAlarm) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateDistribution) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateAlarm) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -3217,9 +3619,15 @@ func (cmd *CreateDistribution) run(renv env.Running, params map[string]interface
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &cloudwatch.PutMetricAlarmInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in cloudwatch.PutMetricAlarmInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.PutMetricAlarm(input)
+	renv.Log().ExtraVerbosef("cloudwatch.PutMetricAlarm call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "cloudwatch.PutMetricAlarm")
 	}
 
 	var extracted interface{}
@@ -3227,14 +3635,14 @@ func (cmd *CreateDistribution) run(renv env.Running, params map[string]interface
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create distribution: AWS command returned nil output")
+			renv.Log().Warning("create alarm: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create distribution '%s' done", extracted)
+		renv.Log().Verbosef("create alarm '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create distribution done")
+		renv.Log().Verbose("create alarm done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -3246,40 +3654,43 @@ func (cmd *CreateDistribution) run(renv env.Running, params map[string]interface
 	return extracted, nil
 }
 
-func (cmd *CreateDistribution) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("distribution"), nil
+func (cmd *CreateAlarm) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("alarm"), nil
 }
 
-func (cmd *CreateDistribution) inject(params map[string]interface{}) error {
+func (cmd *CreateAlarm) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateElasticip(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateElasticip {
-	cmd := new(CreateElasticip)
+func NewCreateApplication(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateApplication {
+	cmd := new(CreateApplication)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = elasticbeanstalk.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateElasticip) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateApplication) SetApi(api elasticbeanstalkiface.ElasticBeanstalkAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateElasticip) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateApplication) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateElasticip) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateApplication) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -3290,15 +3701,15 @@ func (cmd *CreateElasticip) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &ec2.AllocateAddressInput{}
+	input := &elasticbeanstalk.CreateApplicationInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.AllocateAddressInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in elasticbeanstalk.CreateApplicationInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.AllocateAddress(input)
-	renv.Log().ExtraVerbosef("ec2.AllocateAddress call took %s", time.Since(start))
+	output, err := cmd.api.CreateApplication(input)
+	renv.Log().ExtraVerbosef("elasticbeanstalk.CreateApplication call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "elasticbeanstalk.CreateApplication")
 	}
 
 	var extracted interface{}
@@ -3306,14 +3717,14 @@ func (cmd *CreateElasticip) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create elasticip: AWS command returned nil output")
+			renv.Log().Warning("create application: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create elasticip '%s' done", extracted)
+		renv.Log().Verbosef("create application '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create elasticip done")
+		renv.Log().Verbose("create application done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -3325,61 +3736,47 @@ func (cmd *CreateElasticip) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *CreateElasticip) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.AllocateAddressInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.AllocateAddressInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.AllocateAddress(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.AllocateAddress call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: create elasticip ok")
-			return fakeDryRunId("elasticip"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *CreateApplication) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("application"), nil
 }
 
-func (cmd *CreateElasticip) inject(params map[string]interface{}) error {
+func (cmd *CreateApplication) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateFunction(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateFunction {
-	cmd := new(CreateFunction)
+func (cmd *CreateApplication) ExtractResult(i interface{}) string {
+	return StringValue(i.(*elasticbeanstalk.ApplicationDescriptionMessage).Application.ApplicationName)
+}
+
+func NewCreateAppscalingpolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateAppscalingpolicy {
+	cmd := new(CreateAppscalingpolicy)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = lambda.New(sess)
+		cmd.api = applicationautoscaling.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateFunction) SetApi(api lambdaiface.LambdaAPI) {
+func (cmd *CreateAppscalingpolicy) SetApi(api applicationautoscalingiface.ApplicationAutoScalingAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateFunction) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateAppscalingpolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateFunction) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateAppscalingpolicy) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -3390,15 +3787,15 @@ func (cmd *CreateFunction) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &lambda.CreateFunctionInput{}
+	input := &applicationautoscaling.PutScalingPolicyInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in lambda.CreateFunctionInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in applicationautoscaling.PutScalingPolicyInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateFunction(input)
-	renv.Log().ExtraVerbosef("lambda.CreateFunction call took %s", time.Since(start))
+	output, err := cmd.api.PutScalingPolicy(input)
+	renv.Log().ExtraVerbosef("applicationautoscaling.PutScalingPolicy call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "applicationautoscaling.PutScalingPolicy")
 	}
 
 	var extracted interface{}
@@ -3406,14 +3803,14 @@ func (cmd *CreateFunction) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create function: AWS command returned nil output")
+			renv.Log().Warning("create appscalingpolicy: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create function '%s' done", extracted)
+		renv.Log().Verbosef("create appscalingpolicy '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create function done")
+		renv.Log().Verbose("create appscalingpolicy done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -3425,40 +3822,47 @@ func (cmd *CreateFunction) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *CreateFunction) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("function"), nil
+func (cmd *CreateAppscalingpolicy) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("appscalingpolicy"), nil
 }
 
-func (cmd *CreateFunction) inject(params map[string]interface{}) error {
+func (cmd *CreateAppscalingpolicy) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateGroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateGroup {
-	cmd := new(CreateGroup)
-	if len(l) > 0 {
-		cmd.logger = l[0]
-	} else {
+func (cmd *CreateAppscalingpolicy) ExtractResult(i interface{}) string {
+	return StringValue(i.(*applicationautoscaling.PutScalingPolicyOutput).PolicyARN)
+}
+
+func NewCreateAppscalingtarget(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateAppscalingtarget {
+	cmd := new(CreateAppscalingtarget)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = applicationautoscaling.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateGroup) SetApi(api iamiface.IAMAPI) {
+func (cmd *CreateAppscalingtarget) SetApi(api applicationautoscalingiface.ApplicationAutoScalingAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateGroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateAppscalingtarget) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateGroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateAppscalingtarget) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -3469,15 +3873,15 @@ func (cmd *CreateGroup) run(renv env.Running, params map[string]interface{}) (in
 		}
 	}
 
-	input := &iam.CreateGroupInput{}
+	input := &applicationautoscaling.RegisterScalableTargetInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.CreateGroupInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in applicationautoscaling.RegisterScalableTargetInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateGroup(input)
-	renv.Log().ExtraVerbosef("iam.CreateGroup call took %s", time.Since(start))
+	output, err := cmd.api.RegisterScalableTarget(input)
+	renv.Log().ExtraVerbosef("applicationautoscaling.RegisterScalableTarget call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "applicationautoscaling.RegisterScalableTarget")
 	}
 
 	var extracted interface{}
@@ -3485,14 +3889,14 @@ func (cmd *CreateGroup) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create group: AWS command returned nil output")
+			renv.Log().Warning("create appscalingtarget: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create group '%s' done", extracted)
+		renv.Log().Verbosef("create appscalingtarget '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create group done")
+		renv.Log().Verbose("create appscalingtarget done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -3504,40 +3908,43 @@ func (cmd *CreateGroup) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *CreateGroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("group"), nil
+func (cmd *CreateAppscalingtarget) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("appscalingtarget"), nil
 }
 
-func (cmd *CreateGroup) inject(params map[string]interface{}) error {
+func (cmd *CreateAppscalingtarget) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateImage(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateImage {
-	cmd := new(CreateImage)
+func NewCreateBucket(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateBucket {
+	cmd := new(CreateBucket)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = s3.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateImage) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateBucket) SetApi(api s3iface.S3API) {
 	cmd.api = api
 }
 
-func (cmd *CreateImage) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateBucket) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateImage) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateBucket) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -3548,15 +3955,15 @@ func (cmd *CreateImage) run(renv env.Running, params map[string]interface{}) (in
 		}
 	}
 
-	input := &ec2.CreateImageInput{}
+	input := &s3.CreateBucketInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateImageInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in s3.CreateBucketInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateImage(input)
-	renv.Log().ExtraVerbosef("ec2.CreateImage call took %s", time.Since(start))
+	output, err := cmd.api.CreateBucket(input)
+	renv.Log().ExtraVerbosef("s3.CreateBucket call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "s3.CreateBucket")
 	}
 
 	var extracted interface{}
@@ -3564,14 +3971,14 @@ func (cmd *CreateImage) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create image: AWS command returned nil output")
+			renv.Log().Warning("create bucket: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create image '%s' done", extracted)
+		renv.Log().Verbosef("create bucket '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create image done")
+		renv.Log().Verbose("create bucket done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -3583,61 +3990,43 @@ func (cmd *CreateImage) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *CreateImage) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.CreateImageInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateImageInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.CreateImage(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.CreateImage call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: create image ok")
-			return fakeDryRunId("image"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *CreateBucket) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("bucket"), nil
 }
 
-func (cmd *CreateImage) inject(params map[string]interface{}) error {
+func (cmd *CreateBucket) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateInstance {
-	cmd := new(CreateInstance)
+func NewCreateCertificate(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateCertificate {
+	cmd := new(CreateCertificate)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = acm.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateInstance) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateCertificate) SetApi(api acmiface.ACMAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateCertificate) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateInstance) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateCertificate) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -3648,15 +4037,9 @@ func (cmd *CreateInstance) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &ec2.RunInstancesInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.RunInstancesInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.RunInstances(input)
-	renv.Log().ExtraVerbosef("ec2.RunInstances call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "acm.")
 	}
 
 	var extracted interface{}
@@ -3664,14 +4047,14 @@ func (cmd *CreateInstance) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create instance: AWS command returned nil output")
+			renv.Log().Warning("create certificate: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create instance '%s' done", extracted)
+		renv.Log().Verbosef("create certificate '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create instance done")
+		renv.Log().Verbose("create certificate done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -3683,61 +4066,43 @@ func (cmd *CreateInstance) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *CreateInstance) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.RunInstancesInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.RunInstancesInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.RunInstances(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.RunInstances call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: create instance ok")
-			return fakeDryRunId("instance"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *CreateCertificate) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("certificate"), nil
 }
 
-func (cmd *CreateInstance) inject(params map[string]interface{}) error {
+func (cmd *CreateCertificate) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateInstanceprofile(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateInstanceprofile {
-	cmd := new(CreateInstanceprofile)
+func NewCreateClassicLoadbalancer(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateClassicLoadbalancer {
+	cmd := new(CreateClassicLoadbalancer)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = elb.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateInstanceprofile) SetApi(api iamiface.IAMAPI) {
+func (cmd *CreateClassicLoadbalancer) SetApi(api elbiface.ELBAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateInstanceprofile) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateClassicLoadbalancer) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateInstanceprofile) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateClassicLoadbalancer) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -3748,15 +4113,15 @@ func (cmd *CreateInstanceprofile) run(renv env.Running, params map[string]interf
 		}
 	}
 
-	input := &iam.CreateInstanceProfileInput{}
+	input := &elb.CreateLoadBalancerInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.CreateInstanceProfileInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in elb.CreateLoadBalancerInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateInstanceProfile(input)
-	renv.Log().ExtraVerbosef("iam.CreateInstanceProfile call took %s", time.Since(start))
+	output, err := cmd.api.CreateLoadBalancer(input)
+	renv.Log().ExtraVerbosef("elb.CreateLoadBalancer call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "elb.CreateLoadBalancer")
 	}
 
 	var extracted interface{}
@@ -3764,14 +4129,14 @@ func (cmd *CreateInstanceprofile) run(renv env.Running, params map[string]interf
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create instanceprofile: AWS command returned nil output")
+			renv.Log().Warning("create classicloadbalancer: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create instanceprofile '%s' done", extracted)
+		renv.Log().Verbosef("create classicloadbalancer '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create instanceprofile done")
+		renv.Log().Verbose("create classicloadbalancer done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -3783,40 +4148,43 @@ func (cmd *CreateInstanceprofile) run(renv env.Running, params map[string]interf
 	return extracted, nil
 }
 
-func (cmd *CreateInstanceprofile) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("instanceprofile"), nil
+func (cmd *CreateClassicLoadbalancer) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("classicloadbalancer"), nil
 }
 
-func (cmd *CreateInstanceprofile) inject(params map[string]interface{}) error {
+func (cmd *CreateClassicLoadbalancer) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateInternetgateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateInternetgateway {
-	cmd := new(CreateInternetgateway)
+func NewCreateContainercluster(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateContainercluster {
+	cmd := new(CreateContainercluster)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = ecs.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateInternetgateway) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateContainercluster) SetApi(api ecsiface.ECSAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateInternetgateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateContainercluster) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateInternetgateway) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateContainercluster) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -3827,15 +4195,15 @@ func (cmd *CreateInternetgateway) run(renv env.Running, params map[string]interf
 		}
 	}
 
-	input := &ec2.CreateInternetGatewayInput{}
+	input := &ecs.CreateClusterInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateInternetGatewayInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ecs.CreateClusterInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateInternetGateway(input)
-	renv.Log().ExtraVerbosef("ec2.CreateInternetGateway call took %s", time.Since(start))
+	output, err := cmd.api.CreateCluster(input)
+	renv.Log().ExtraVerbosef("ecs.CreateCluster call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ecs.CreateCluster")
 	}
 
 	var extracted interface{}
@@ -3843,14 +4211,14 @@ func (cmd *CreateInternetgateway) run(renv env.Running, params map[string]interf
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create internetgateway: AWS command returned nil output")
+			renv.Log().Warning("create containercluster: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create internetgateway '%s' done", extracted)
+		renv.Log().Verbosef("create containercluster '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create internetgateway done")
+		renv.Log().Verbose("create containercluster done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -3862,61 +4230,47 @@ func (cmd *CreateInternetgateway) run(renv env.Running, params map[string]interf
 	return extracted, nil
 }
 
-func (cmd *CreateInternetgateway) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.CreateInternetGatewayInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateInternetGatewayInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.CreateInternetGateway(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.CreateInternetGateway call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: create internetgateway ok")
-			return fakeDryRunId("internetgateway"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *CreateContainercluster) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("containercluster"), nil
 }
 
-func (cmd *CreateInternetgateway) inject(params map[string]interface{}) error {
+func (cmd *CreateContainercluster) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateKeypair(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateKeypair {
-	cmd := new(CreateKeypair)
+func (cmd *CreateContainercluster) ExtractResult(i interface{}) string {
+	return StringValue(i.(*ecs.CreateClusterOutput).Cluster.ClusterArn)
+}
+
+func NewCreateDatabase(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateDatabase {
+	cmd := new(CreateDatabase)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = rds.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateKeypair) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateDatabase) SetApi(api rdsiface.RDSAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateKeypair) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateDatabase) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateKeypair) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateDatabase) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -3927,15 +4281,9 @@ func (cmd *CreateKeypair) run(renv env.Running, params map[string]interface{}) (
 		}
 	}
 
-	input := &ec2.ImportKeyPairInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.ImportKeyPairInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.ImportKeyPair(input)
-	renv.Log().ExtraVerbosef("ec2.ImportKeyPair call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "rds.")
 	}
 
 	var extracted interface{}
@@ -3943,14 +4291,14 @@ func (cmd *CreateKeypair) run(renv env.Running, params map[string]interface{}) (
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create keypair: AWS command returned nil output")
+			renv.Log().Warning("create database: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create keypair '%s' done", extracted)
+		renv.Log().Verbosef("create database '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create keypair done")
+		renv.Log().Verbose("create database done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -3962,40 +4310,43 @@ func (cmd *CreateKeypair) run(renv env.Running, params map[string]interface{}) (
 	return extracted, nil
 }
 
-func (cmd *CreateKeypair) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("keypair"), nil
+func (cmd *CreateDatabase) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("database"), nil
 }
 
-func (cmd *CreateKeypair) inject(params map[string]interface{}) error {
+func (cmd *CreateDatabase) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateLaunchconfiguration(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateLaunchconfiguration {
-	cmd := new(CreateLaunchconfiguration)
+func NewCreateDbsubnetgroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateDbsubnetgroup {
+	cmd := new(CreateDbsubnetgroup)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = autoscaling.New(sess)
+		cmd.api = rds.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateLaunchconfiguration) SetApi(api autoscalingiface.AutoScalingAPI) {
+func (cmd *CreateDbsubnetgroup) SetApi(api rdsiface.RDSAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateLaunchconfiguration) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateDbsubnetgroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateLaunchconfiguration) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateDbsubnetgroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -4006,15 +4357,15 @@ func (cmd *CreateLaunchconfiguration) run(renv env.Running, params map[string]in
 		}
 	}
 
-	input := &autoscaling.CreateLaunchConfigurationInput{}
+	input := &rds.CreateDBSubnetGroupInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in autoscaling.CreateLaunchConfigurationInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in rds.CreateDBSubnetGroupInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateLaunchConfiguration(input)
-	renv.Log().ExtraVerbosef("autoscaling.CreateLaunchConfiguration call took %s", time.Since(start))
+	output, err := cmd.api.CreateDBSubnetGroup(input)
+	renv.Log().ExtraVerbosef("rds.CreateDBSubnetGroup call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "rds.CreateDBSubnetGroup")
 	}
 
 	var extracted interface{}
@@ -4022,14 +4373,14 @@ func (cmd *CreateLaunchconfiguration) run(renv env.Running, params map[string]in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create launchconfiguration: AWS command returned nil output")
+			renv.Log().Warning("create dbsubnetgroup: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create launchconfiguration '%s' done", extracted)
+		renv.Log().Verbosef("create dbsubnetgroup '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create launchconfiguration done")
+		renv.Log().Verbose("create dbsubnetgroup done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -4041,40 +4392,47 @@ func (cmd *CreateLaunchconfiguration) run(renv env.Running, params map[string]in
 	return extracted, nil
 }
 
-func (cmd *CreateLaunchconfiguration) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("launchconfiguration"), nil
+func (cmd *CreateDbsubnetgroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("dbsubnetgroup"), nil
 }
 
-func (cmd *CreateLaunchconfiguration) inject(params map[string]interface{}) error {
+func (cmd *CreateDbsubnetgroup) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateListener(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateListener {
-	cmd := new(CreateListener)
+func (cmd *CreateDbsubnetgroup) ExtractResult(i interface{}) string {
+	return StringValue(i.(*rds.CreateDBSubnetGroupOutput).DBSubnetGroup.DBSubnetGroupName)
+}
+
+func NewCreateDhcpoptions(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateDhcpoptions {
+	cmd := new(CreateDhcpoptions)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = elbv2.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateListener) SetApi(api elbv2iface.ELBV2API) {
+func (cmd *CreateDhcpoptions) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CreateListener) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateDhcpoptions) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateListener) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateDhcpoptions) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -4085,15 +4443,9 @@ func (cmd *CreateListener) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &elbv2.CreateListenerInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in elbv2.CreateListenerInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.CreateListener(input)
-	renv.Log().ExtraVerbosef("elbv2.CreateListener call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.")
 	}
 
 	var extracted interface{}
@@ -4101,14 +4453,14 @@ func (cmd *CreateListener) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create listener: AWS command returned nil output")
+			renv.Log().Warning("create dhcpoptions: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create listener '%s' done", extracted)
+		renv.Log().Verbosef("create dhcpoptions '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create listener done")
+		renv.Log().Verbose("create dhcpoptions done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -4120,40 +4472,39 @@ func (cmd *CreateListener) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *CreateListener) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("listener"), nil
-}
-
-func (cmd *CreateListener) inject(params map[string]interface{}) error {
+func (cmd *CreateDhcpoptions) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateLoadbalancer(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateLoadbalancer {
-	cmd := new(CreateLoadbalancer)
+func NewCreateDistribution(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateDistribution {
+	cmd := new(CreateDistribution)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = elbv2.New(sess)
+		cmd.api = cloudfront.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateLoadbalancer) SetApi(api elbv2iface.ELBV2API) {
+func (cmd *CreateDistribution) SetApi(api cloudfrontiface.CloudFrontAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateLoadbalancer) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateDistribution) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateLoadbalancer) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateDistribution) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -4164,15 +4515,9 @@ func (cmd *CreateLoadbalancer) run(renv env.Running, params map[string]interface
 		}
 	}
 
-	input := &elbv2.CreateLoadBalancerInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in elbv2.CreateLoadBalancerInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.CreateLoadBalancer(input)
-	renv.Log().ExtraVerbosef("elbv2.CreateLoadBalancer call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "cloudfront.")
 	}
 
 	var extracted interface{}
@@ -4180,14 +4525,14 @@ func (cmd *CreateLoadbalancer) run(renv env.Running, params map[string]interface
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create loadbalancer: AWS command returned nil output")
+			renv.Log().Warning("create distribution: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create loadbalancer '%s' done", extracted)
+		renv.Log().Verbosef("create distribution '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create loadbalancer done")
+		renv.Log().Verbose("create distribution done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -4199,40 +4544,43 @@ func (cmd *CreateLoadbalancer) run(renv env.Running, params map[string]interface
 	return extracted, nil
 }
 
-func (cmd *CreateLoadbalancer) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("loadbalancer"), nil
+func (cmd *CreateDistribution) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("distribution"), nil
 }
 
-func (cmd *CreateLoadbalancer) inject(params map[string]interface{}) error {
+func (cmd *CreateDistribution) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateLoginprofile(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateLoginprofile {
-	cmd := new(CreateLoginprofile)
+func NewCreateEgressonlyinternetgateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateEgressonlyinternetgateway {
+	cmd := new(CreateEgressonlyinternetgateway)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateLoginprofile) SetApi(api iamiface.IAMAPI) {
+func (cmd *CreateEgressonlyinternetgateway) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CreateLoginprofile) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateEgressonlyinternetgateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateLoginprofile) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateEgressonlyinternetgateway) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -4243,15 +4591,15 @@ func (cmd *CreateLoginprofile) run(renv env.Running, params map[string]interface
 		}
 	}
 
-	input := &iam.CreateLoginProfileInput{}
+	input := &ec2.CreateEgressOnlyInternetGatewayInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.CreateLoginProfileInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.CreateEgressOnlyInternetGatewayInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateLoginProfile(input)
-	renv.Log().ExtraVerbosef("iam.CreateLoginProfile call took %s", time.Since(start))
+	output, err := cmd.api.CreateEgressOnlyInternetGateway(input)
+	renv.Log().ExtraVerbosef("ec2.CreateEgressOnlyInternetGateway call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.CreateEgressOnlyInternetGateway")
 	}
 
 	var extracted interface{}
@@ -4259,14 +4607,14 @@ func (cmd *CreateLoginprofile) run(renv env.Running, params map[string]interface
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create loginprofile: AWS command returned nil output")
+			renv.Log().Warning("create egressonlyinternetgateway: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create loginprofile '%s' done", extracted)
+		renv.Log().Verbosef("create egressonlyinternetgateway '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create loginprofile done")
+		renv.Log().Verbose("create egressonlyinternetgateway done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -4278,40 +4626,68 @@ func (cmd *CreateLoginprofile) run(renv env.Running, params map[string]interface
 	return extracted, nil
 }
 
-func (cmd *CreateLoginprofile) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("loginprofile"), nil
+func (cmd *CreateEgressonlyinternetgateway) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.CreateEgressOnlyInternetGatewayInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateEgressOnlyInternetGatewayInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.CreateEgressOnlyInternetGateway(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.CreateEgressOnlyInternetGateway call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: create egressonlyinternetgateway ok")
+			return fakeDryRunId("egressonlyinternetgateway"), nil
+		}
+	}
+
+	return nil, err
 }
 
-func (cmd *CreateLoginprofile) inject(params map[string]interface{}) error {
+func (cmd *CreateEgressonlyinternetgateway) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateMfadevice(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateMfadevice {
-	cmd := new(CreateMfadevice)
+func (cmd *CreateEgressonlyinternetgateway) ExtractResult(i interface{}) string {
+	return StringValue(i.(*ec2.CreateEgressOnlyInternetGatewayOutput).EgressOnlyInternetGateway.EgressOnlyInternetGatewayId)
+}
+
+func NewCreateElasticip(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateElasticip {
+	cmd := new(CreateElasticip)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateMfadevice) SetApi(api iamiface.IAMAPI) {
+func (cmd *CreateElasticip) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CreateMfadevice) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateElasticip) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateMfadevice) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateElasticip) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -4322,9 +4698,15 @@ func (cmd *CreateMfadevice) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &ec2.AllocateAddressInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.AllocateAddressInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.AllocateAddress(input)
+	renv.Log().ExtraVerbosef("ec2.AllocateAddress call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.AllocateAddress")
 	}
 
 	var extracted interface{}
@@ -4332,14 +4714,14 @@ func (cmd *CreateMfadevice) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create mfadevice: AWS command returned nil output")
+			renv.Log().Warning("create elasticip: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create mfadevice '%s' done", extracted)
+		renv.Log().Verbosef("create elasticip '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create mfadevice done")
+		renv.Log().Verbose("create elasticip done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -4351,40 +4733,68 @@ func (cmd *CreateMfadevice) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *CreateMfadevice) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("mfadevice"), nil
+func (cmd *CreateElasticip) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.AllocateAddressInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.AllocateAddressInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.AllocateAddress(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.AllocateAddress call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: create elasticip ok")
+			return fakeDryRunId("elasticip"), nil
+		}
+	}
+
+	return nil, err
 }
 
-func (cmd *CreateMfadevice) inject(params map[string]interface{}) error {
+func (cmd *CreateElasticip) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateNatgateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateNatgateway {
-	cmd := new(CreateNatgateway)
+func (cmd *CreateElasticip) ExtractResult(i interface{}) string {
+	return StringValue(i.(*ec2.AllocateAddressOutput).AllocationId)
+}
+
+func NewCreateElasticsearchdomain(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateElasticsearchdomain {
+	cmd := new(CreateElasticsearchdomain)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = elasticsearchservice.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateNatgateway) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateElasticsearchdomain) SetApi(api elasticsearchserviceiface.ElasticsearchServiceAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateNatgateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateElasticsearchdomain) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateNatgateway) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateElasticsearchdomain) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -4395,15 +4805,9 @@ func (cmd *CreateNatgateway) run(renv env.Running, params map[string]interface{}
 		}
 	}
 
-	input := &ec2.CreateNatGatewayInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateNatGatewayInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.CreateNatGateway(input)
-	renv.Log().ExtraVerbosef("ec2.CreateNatGateway call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "elasticsearchservice.")
 	}
 
 	var extracted interface{}
@@ -4411,14 +4815,14 @@ func (cmd *CreateNatgateway) run(renv env.Running, params map[string]interface{}
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create natgateway: AWS command returned nil output")
+			renv.Log().Warning("create elasticsearchdomain: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create natgateway '%s' done", extracted)
+		renv.Log().Verbosef("create elasticsearchdomain '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create natgateway done")
+		renv.Log().Verbose("create elasticsearchdomain done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -4430,40 +4834,43 @@ func (cmd *CreateNatgateway) run(renv env.Running, params map[string]interface{}
 	return extracted, nil
 }
 
-func (cmd *CreateNatgateway) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("natgateway"), nil
+func (cmd *CreateElasticsearchdomain) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("elasticsearchdomain"), nil
 }
 
-func (cmd *CreateNatgateway) inject(params map[string]interface{}) error {
+func (cmd *CreateElasticsearchdomain) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateNetworkinterface(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateNetworkinterface {
-	cmd := new(CreateNetworkinterface)
+func NewCreateEnvironment(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateEnvironment {
+	cmd := new(CreateEnvironment)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = elasticbeanstalk.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateNetworkinterface) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateEnvironment) SetApi(api elasticbeanstalkiface.ElasticBeanstalkAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateNetworkinterface) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateEnvironment) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateNetworkinterface) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateEnvironment) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -4474,15 +4881,9 @@ func (cmd *CreateNetworkinterface) run(renv env.Running, params map[string]inter
 		}
 	}
 
-	input := &ec2.CreateNetworkInterfaceInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateNetworkInterfaceInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.CreateNetworkInterface(input)
-	renv.Log().ExtraVerbosef("ec2.CreateNetworkInterface call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "elasticbeanstalk.")
 	}
 
 	var extracted interface{}
@@ -4490,14 +4891,14 @@ func (cmd *CreateNetworkinterface) run(renv env.Running, params map[string]inter
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create networkinterface: AWS command returned nil output")
+			renv.Log().Warning("create environment: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create networkinterface '%s' done", extracted)
+		renv.Log().Verbosef("create environment '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create networkinterface done")
+		renv.Log().Verbose("create environment done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -4509,61 +4910,43 @@ func (cmd *CreateNetworkinterface) run(renv env.Running, params map[string]inter
 	return extracted, nil
 }
 
-func (cmd *CreateNetworkinterface) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.CreateNetworkInterfaceInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateNetworkInterfaceInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.CreateNetworkInterface(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.CreateNetworkInterface call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: create networkinterface ok")
-			return fakeDryRunId("networkinterface"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *CreateEnvironment) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("environment"), nil
 }
 
-func (cmd *CreateNetworkinterface) inject(params map[string]interface{}) error {
+func (cmd *CreateEnvironment) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreatePolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreatePolicy {
-	cmd := new(CreatePolicy)
+func NewCreateFailover(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateFailover {
+	cmd := new(CreateFailover)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = route53.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreatePolicy) SetApi(api iamiface.IAMAPI) {
+func (cmd *CreateFailover) SetApi(api route53iface.Route53API) {
 	cmd.api = api
 }
 
-func (cmd *CreatePolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateFailover) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreatePolicy) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateFailover) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -4574,15 +4957,9 @@ func (cmd *CreatePolicy) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	input := &iam.CreatePolicyInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.CreatePolicyInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.CreatePolicy(input)
-	renv.Log().ExtraVerbosef("iam.CreatePolicy call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "route53.")
 	}
 
 	var extracted interface{}
@@ -4590,14 +4967,14 @@ func (cmd *CreatePolicy) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create policy: AWS command returned nil output")
+			renv.Log().Warning("create failover: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create policy '%s' done", extracted)
+		renv.Log().Verbosef("create failover '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create policy done")
+		renv.Log().Verbose("create failover done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -4609,40 +4986,43 @@ func (cmd *CreatePolicy) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *CreatePolicy) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("policy"), nil
+func (cmd *CreateFailover) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("failover"), nil
 }
 
-func (cmd *CreatePolicy) inject(params map[string]interface{}) error {
+func (cmd *CreateFailover) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateQueue(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateQueue {
-	cmd := new(CreateQueue)
+func NewCreateFunction(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateFunction {
+	cmd := new(CreateFunction)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = sqs.New(sess)
+		cmd.api = lambda.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateQueue) SetApi(api sqsiface.SQSAPI) {
+func (cmd *CreateFunction) SetApi(api lambdaiface.LambdaAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateQueue) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateFunction) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateQueue) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateFunction) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -4653,15 +5033,15 @@ func (cmd *CreateQueue) run(renv env.Running, params map[string]interface{}) (in
 		}
 	}
 
-	input := &sqs.CreateQueueInput{}
+	input := &lambda.CreateFunctionInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in sqs.CreateQueueInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in lambda.CreateFunctionInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateQueue(input)
-	renv.Log().ExtraVerbosef("sqs.CreateQueue call took %s", time.Since(start))
+	output, err := cmd.api.CreateFunction(input)
+	renv.Log().ExtraVerbosef("lambda.CreateFunction call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "lambda.CreateFunction")
 	}
 
 	var extracted interface{}
@@ -4669,14 +5049,14 @@ func (cmd *CreateQueue) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create queue: AWS command returned nil output")
+			renv.Log().Warning("create function: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create queue '%s' done", extracted)
+		renv.Log().Verbosef("create function '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create queue done")
+		renv.Log().Verbose("create function done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -4688,40 +5068,43 @@ func (cmd *CreateQueue) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *CreateQueue) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("queue"), nil
+func (cmd *CreateFunction) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
 }
 
-func (cmd *CreateQueue) inject(params map[string]interface{}) error {
-	return structSetter(cmd, params)
+func (cmd *CreateFunction) ExtractResult(i interface{}) string {
+	return StringValue(i.(*lambda.FunctionConfiguration).FunctionArn)
 }
 
-func NewCreateRecord(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateRecord {
-	cmd := new(CreateRecord)
+func NewCreateGroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateGroup {
+	cmd := new(CreateGroup)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = route53.New(sess)
+		cmd.api = iam.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateRecord) SetApi(api route53iface.Route53API) {
+func (cmd *CreateGroup) SetApi(api iamiface.IAMAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateRecord) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateGroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateRecord) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateGroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -4732,9 +5115,15 @@ func (cmd *CreateRecord) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &iam.CreateGroupInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in iam.CreateGroupInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateGroup(input)
+	renv.Log().ExtraVerbosef("iam.CreateGroup call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "iam.CreateGroup")
 	}
 
 	var extracted interface{}
@@ -4742,14 +5131,14 @@ func (cmd *CreateRecord) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create record: AWS command returned nil output")
+			renv.Log().Warning("create group: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create record '%s' done", extracted)
+		renv.Log().Verbosef("create group '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create record done")
+		renv.Log().Verbose("create group done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -4761,40 +5150,47 @@ func (cmd *CreateRecord) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *CreateRecord) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("record"), nil
+func (cmd *CreateGroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("group"), nil
 }
 
-func (cmd *CreateRecord) inject(params map[string]interface{}) error {
+func (cmd *CreateGroup) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateRepository(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateRepository {
-	cmd := new(CreateRepository)
+func (cmd *CreateGroup) ExtractResult(i interface{}) string {
+	return StringValue(i.(*iam.CreateGroupOutput).Group.GroupId)
+}
+
+func NewCreateHealthcheck(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateHealthcheck {
+	cmd := new(CreateHealthcheck)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ecr.New(sess)
+		cmd.api = route53.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateRepository) SetApi(api ecriface.ECRAPI) {
+func (cmd *CreateHealthcheck) SetApi(api route53iface.Route53API) {
 	cmd.api = api
 }
 
-func (cmd *CreateRepository) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateHealthcheck) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateRepository) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateHealthcheck) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -4805,15 +5201,9 @@ func (cmd *CreateRepository) run(renv env.Running, params map[string]interface{}
 		}
 	}
 
-	input := &ecr.CreateRepositoryInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ecr.CreateRepositoryInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.CreateRepository(input)
-	renv.Log().ExtraVerbosef("ecr.CreateRepository call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "route53.")
 	}
 
 	var extracted interface{}
@@ -4821,14 +5211,14 @@ func (cmd *CreateRepository) run(renv env.Running, params map[string]interface{}
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create repository: AWS command returned nil output")
+			renv.Log().Warning("create healthcheck: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create repository '%s' done", extracted)
+		renv.Log().Verbosef("create healthcheck '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create repository done")
+		renv.Log().Verbose("create healthcheck done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -4840,40 +5230,43 @@ func (cmd *CreateRepository) run(renv env.Running, params map[string]interface{}
 	return extracted, nil
 }
 
-func (cmd *CreateRepository) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("repository"), nil
+func (cmd *CreateHealthcheck) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("healthcheck"), nil
 }
 
-func (cmd *CreateRepository) inject(params map[string]interface{}) error {
+func (cmd *CreateHealthcheck) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateRole(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateRole {
-	cmd := new(CreateRole)
+func NewCreateImage(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateImage {
+	cmd := new(CreateImage)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateRole) SetApi(api iamiface.IAMAPI) {
+func (cmd *CreateImage) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CreateRole) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateImage) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateRole) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateImage) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -4884,9 +5277,15 @@ func (cmd *CreateRole) run(renv env.Running, params map[string]interface{}) (int
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &ec2.CreateImageInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateImageInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateImage(input)
+	renv.Log().ExtraVerbosef("ec2.CreateImage call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.CreateImage")
 	}
 
 	var extracted interface{}
@@ -4894,14 +5293,14 @@ func (cmd *CreateRole) run(renv env.Running, params map[string]interface{}) (int
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create role: AWS command returned nil output")
+			renv.Log().Warning("create image: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create role '%s' done", extracted)
+		renv.Log().Verbosef("create image '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create role done")
+		renv.Log().Verbose("create image done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -4913,16 +5312,41 @@ func (cmd *CreateRole) run(renv env.Running, params map[string]interface{}) (int
 	return extracted, nil
 }
 
-func (cmd *CreateRole) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("role"), nil
+func (cmd *CreateImage) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.CreateImageInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateImageInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.CreateImage(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.CreateImage call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: create image ok")
+			return fakeDryRunId("image"), nil
+		}
+	}
+
+	return nil, err
 }
 
-func (cmd *CreateRole) inject(params map[string]interface{}) error {
+func (cmd *CreateImage) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateRoute(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateRoute {
-	cmd := new(CreateRoute)
+func (cmd *CreateImage) ExtractResult(i interface{}) string {
+	return StringValue(i.(*ec2.CreateImageOutput).ImageId)
+}
+
+func NewCreateInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateInstance {
+	cmd := new(CreateInstance)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -4935,18 +5359,21 @@ func NewCreateRoute(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger
 	return cmd
 }
 
-func (cmd *CreateRoute) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateInstance) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CreateRoute) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateRoute) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateInstance) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -4957,15 +5384,15 @@ func (cmd *CreateRoute) run(renv env.Running, params map[string]interface{}) (in
 		}
 	}
 
-	input := &ec2.CreateRouteInput{}
+	input := &ec2.RunInstancesInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateRouteInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.RunInstancesInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateRoute(input)
-	renv.Log().ExtraVerbosef("ec2.CreateRoute call took %s", time.Since(start))
+	output, err := cmd.api.RunInstances(input)
+	renv.Log().ExtraVerbosef("ec2.RunInstances call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.RunInstances")
 	}
 
 	var extracted interface{}
@@ -4973,14 +5400,14 @@ func (cmd *CreateRoute) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create route: AWS command returned nil output")
+			renv.Log().Warning("create instance: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create route '%s' done", extracted)
+		renv.Log().Verbosef("create instance '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create route done")
+		renv.Log().Verbose("create instance done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -4992,61 +5419,43 @@ func (cmd *CreateRoute) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *CreateRoute) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.CreateRouteInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateRouteInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.CreateRoute(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.CreateRoute call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: create route ok")
-			return fakeDryRunId("route"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *CreateInstance) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
 }
 
-func (cmd *CreateRoute) inject(params map[string]interface{}) error {
-	return structSetter(cmd, params)
+func (cmd *CreateInstance) ExtractResult(i interface{}) string {
+	return StringValue(i.(*ec2.Reservation).Instances[0].InstanceId)
 }
 
-func NewCreateRoutetable(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateRoutetable {
-	cmd := new(CreateRoutetable)
+func NewCreateInstanceprofile(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateInstanceprofile {
+	cmd := new(CreateInstanceprofile)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = iam.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateRoutetable) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateInstanceprofile) SetApi(api iamiface.IAMAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateRoutetable) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateInstanceprofile) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateRoutetable) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateInstanceprofile) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -5057,15 +5466,15 @@ func (cmd *CreateRoutetable) run(renv env.Running, params map[string]interface{}
 		}
 	}
 
-	input := &ec2.CreateRouteTableInput{}
+	input := &iam.CreateInstanceProfileInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateRouteTableInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in iam.CreateInstanceProfileInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateRouteTable(input)
-	renv.Log().ExtraVerbosef("ec2.CreateRouteTable call took %s", time.Since(start))
+	output, err := cmd.api.CreateInstanceProfile(input)
+	renv.Log().ExtraVerbosef("iam.CreateInstanceProfile call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "iam.CreateInstanceProfile")
 	}
 
 	var extracted interface{}
@@ -5073,14 +5482,14 @@ func (cmd *CreateRoutetable) run(renv env.Running, params map[string]interface{}
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create routetable: AWS command returned nil output")
+			renv.Log().Warning("create instanceprofile: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create routetable '%s' done", extracted)
+		renv.Log().Verbosef("create instanceprofile '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create routetable done")
+		renv.Log().Verbose("create instanceprofile done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -5092,61 +5501,3794 @@ func (cmd *CreateRoutetable) run(renv env.Running, params map[string]interface{}
 	return extracted, nil
 }
 
-func (cmd *CreateRoutetable) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
+func (cmd *CreateInstanceprofile) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("instanceprofile"), nil
+}
 
-	input := &ec2.CreateRouteTableInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateRouteTableInput: %s", err)
-	}
+func (cmd *CreateInstanceprofile) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewCreateInternetgateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateInternetgateway {
+	cmd := new(CreateInternetgateway)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateInternetgateway) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *CreateInternetgateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateInternetgateway) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
 
+	input := &ec2.CreateInternetGatewayInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateInternetGatewayInput: %s", err)
+	}
 	start := time.Now()
-	_, err := cmd.api.CreateRouteTable(input)
+	output, err := cmd.api.CreateInternetGateway(input)
+	renv.Log().ExtraVerbosef("ec2.CreateInternetGateway call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err, "ec2.CreateInternetGateway")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create internetgateway: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create internetgateway '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create internetgateway done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateInternetgateway) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.CreateInternetGatewayInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateInternetGatewayInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.CreateInternetGateway(input)
 	if awsErr, ok := err.(awserr.Error); ok {
 		switch code := awsErr.Code(); {
 		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.CreateRouteTable call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: create routetable ok")
-			return fakeDryRunId("routetable"), nil
+			renv.Log().ExtraVerbosef("dry run: ec2.CreateInternetGateway call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: create internetgateway ok")
+			return fakeDryRunId("internetgateway"), nil
 		}
 	}
 
 	return nil, err
 }
 
-func (cmd *CreateRoutetable) inject(params map[string]interface{}) error {
+func (cmd *CreateInternetgateway) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func (cmd *CreateInternetgateway) ExtractResult(i interface{}) string {
+	return StringValue(i.(*ec2.CreateInternetGatewayOutput).InternetGateway.InternetGatewayId)
+}
+
+func NewCreateKeypair(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateKeypair {
+	cmd := new(CreateKeypair)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateKeypair) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *CreateKeypair) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateKeypair) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.ImportKeyPairInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.ImportKeyPairInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.ImportKeyPair(input)
+	renv.Log().ExtraVerbosef("ec2.ImportKeyPair call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err, "ec2.ImportKeyPair")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create keypair: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create keypair '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create keypair done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateKeypair) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("keypair"), nil
+}
+
+func (cmd *CreateKeypair) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func (cmd *CreateKeypair) ExtractResult(i interface{}) string {
+	return StringValue(i.(*ec2.ImportKeyPairOutput).KeyName)
+}
+
+func NewCreateLaunchconfiguration(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateLaunchconfiguration {
+	cmd := new(CreateLaunchconfiguration)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = autoscaling.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateLaunchconfiguration) SetApi(api autoscalingiface.AutoScalingAPI) {
+	cmd.api = api
+}
+
+func (cmd *CreateLaunchconfiguration) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateLaunchconfiguration) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &autoscaling.CreateLaunchConfigurationInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in autoscaling.CreateLaunchConfigurationInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateLaunchConfiguration(input)
+	renv.Log().ExtraVerbosef("autoscaling.CreateLaunchConfiguration call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err, "autoscaling.CreateLaunchConfiguration")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create launchconfiguration: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create launchconfiguration '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create launchconfiguration done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateLaunchconfiguration) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("launchconfiguration"), nil
+}
+
+func (cmd *CreateLaunchconfiguration) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewCreateListener(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateListener {
+	cmd := new(CreateListener)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = elbv2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateListener) SetApi(api elbv2iface.ELBV2API) {
+	cmd.api = api
+}
+
+func (cmd *CreateListener) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateListener) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &elbv2.CreateListenerInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in elbv2.CreateListenerInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateListener(input)
+	renv.Log().ExtraVerbosef("elbv2.CreateListener call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err, "elbv2.CreateListener")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create listener: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create listener '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create listener done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateListener) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("listener"), nil
+}
+
+func (cmd *CreateListener) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func (cmd *CreateListener) ExtractResult(i interface{}) string {
+	return StringValue(i.(*elbv2.CreateListenerOutput).Listeners[0].ListenerArn)
+}
+
+func NewCreateLoadbalancer(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateLoadbalancer {
+	cmd := new(CreateLoadbalancer)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = elbv2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateLoadbalancer) SetApi(api elbv2iface.ELBV2API) {
+	cmd.api = api
+}
+
+func (cmd *CreateLoadbalancer) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateLoadbalancer) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &elbv2.CreateLoadBalancerInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in elbv2.CreateLoadBalancerInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateLoadBalancer(input)
+	renv.Log().ExtraVerbosef("elbv2.CreateLoadBalancer call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err, "elbv2.CreateLoadBalancer")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create loadbalancer: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create loadbalancer '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create loadbalancer done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateLoadbalancer) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("loadbalancer"), nil
+}
+
+func (cmd *CreateLoadbalancer) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func (cmd *CreateLoadbalancer) ExtractResult(i interface{}) string {
+	return StringValue(i.(*elbv2.CreateLoadBalancerOutput).LoadBalancers[0].LoadBalancerArn)
+}
+
+func NewCreateLoginprofile(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateLoginprofile {
+	cmd := new(CreateLoginprofile)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = iam.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateLoginprofile) SetApi(api iamiface.IAMAPI) {
+	cmd.api = api
+}
+
+func (cmd *CreateLoginprofile) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateLoginprofile) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &iam.CreateLoginProfileInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in iam.CreateLoginProfileInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateLoginProfile(input)
+	renv.Log().ExtraVerbosef("iam.CreateLoginProfile call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err, "iam.CreateLoginProfile")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create loginprofile: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create loginprofile '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create loginprofile done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateLoginprofile) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("loginprofile"), nil
+}
+
+func (cmd *CreateLoginprofile) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func (cmd *CreateLoginprofile) ExtractResult(i interface{}) string {
+	return StringValue(i.(*iam.CreateLoginProfileOutput).LoginProfile.UserName)
+}
+
+func NewCreateMfadevice(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateMfadevice {
+	cmd := new(CreateMfadevice)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = iam.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateMfadevice) SetApi(api iamiface.IAMAPI) {
+	cmd.api = api
+}
+
+func (cmd *CreateMfadevice) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateMfadevice) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err, "iam.")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create mfadevice: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create mfadevice '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create mfadevice done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateMfadevice) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("mfadevice"), nil
+}
+
+func (cmd *CreateMfadevice) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewCreateNatgateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateNatgateway {
+	cmd := new(CreateNatgateway)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateNatgateway) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *CreateNatgateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateNatgateway) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.CreateNatGatewayInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateNatGatewayInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateNatGateway(input)
+	renv.Log().ExtraVerbosef("ec2.CreateNatGateway call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err, "ec2.CreateNatGateway")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create natgateway: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create natgateway '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create natgateway done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateNatgateway) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("natgateway"), nil
+}
+
+func (cmd *CreateNatgateway) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func (cmd *CreateNatgateway) ExtractResult(i interface{}) string {
+	return StringValue(i.(*ec2.CreateNatGatewayOutput).NatGateway.NatGatewayId)
+}
+
+func NewCreateNetworkinterface(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateNetworkinterface {
+	cmd := new(CreateNetworkinterface)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateNetworkinterface) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *CreateNetworkinterface) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateNetworkinterface) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.CreateNetworkInterfaceInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateNetworkInterfaceInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateNetworkInterface(input)
+	renv.Log().ExtraVerbosef("ec2.CreateNetworkInterface call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err, "ec2.CreateNetworkInterface")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create networkinterface: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create networkinterface '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create networkinterface done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateNetworkinterface) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.CreateNetworkInterfaceInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateNetworkInterfaceInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.CreateNetworkInterface(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.CreateNetworkInterface call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: create networkinterface ok")
+			return fakeDryRunId("networkinterface"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *CreateNetworkinterface) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func (cmd *CreateNetworkinterface) ExtractResult(i interface{}) string {
+	return StringValue(i.(*ec2.CreateNetworkInterfaceOutput).NetworkInterface.NetworkInterfaceId)
+}
+
+func NewCreatePolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreatePolicy {
+	cmd := new(CreatePolicy)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = iam.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreatePolicy) SetApi(api iamiface.IAMAPI) {
+	cmd.api = api
+}
+
+func (cmd *CreatePolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreatePolicy) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &iam.CreatePolicyInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in iam.CreatePolicyInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreatePolicy(input)
+	renv.Log().ExtraVerbosef("iam.CreatePolicy call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err, "iam.CreatePolicy")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create policy: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create policy '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create policy done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreatePolicy) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("policy"), nil
+}
+
+func (cmd *CreatePolicy) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func (cmd *CreatePolicy) ExtractResult(i interface{}) string {
+	return StringValue(i.(*iam.CreatePolicyOutput).Policy.Arn)
+}
+
+func NewCreateQueue(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateQueue {
+	cmd := new(CreateQueue)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = sqs.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateQueue) SetApi(api sqsiface.SQSAPI) {
+	cmd.api = api
+}
+
+func (cmd *CreateQueue) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateQueue) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &sqs.CreateQueueInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in sqs.CreateQueueInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateQueue(input)
+	renv.Log().ExtraVerbosef("sqs.CreateQueue call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err, "sqs.CreateQueue")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create queue: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create queue '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create queue done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateQueue) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("queue"), nil
+}
+
+func (cmd *CreateQueue) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func (cmd *CreateQueue) ExtractResult(i interface{}) string {
+	return StringValue(i.(*sqs.CreateQueueOutput).QueueUrl)
+}
+
+func NewCreateRecord(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateRecord {
+	cmd := new(CreateRecord)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = route53.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateRecord) SetApi(api route53iface.Route53API) {
+	cmd.api = api
+}
+
+func (cmd *CreateRecord) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateRecord) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err, "route53.")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create record: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create record '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create record done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateRecord) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("record"), nil
+}
+
+func (cmd *CreateRecord) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewCreateRecords(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateRecords {
+	cmd := new(CreateRecords)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = route53.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateRecords) SetApi(api route53iface.Route53API) {
+	cmd.api = api
+}
+
+func (cmd *CreateRecords) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateRecords) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err, "route53.")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create records: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create records '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create records done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateRecords) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("records"), nil
+}
+
+func (cmd *CreateRecords) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewCreateRepository(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateRepository {
+	cmd := new(CreateRepository)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ecr.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateRepository) SetApi(api ecriface.ECRAPI) {
+	cmd.api = api
+}
+
+func (cmd *CreateRepository) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateRepository) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ecr.CreateRepositoryInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ecr.CreateRepositoryInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateRepository(input)
+	renv.Log().ExtraVerbosef("ecr.CreateRepository call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err, "ecr.CreateRepository")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create repository: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create repository '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create repository done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateRepository) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("repository"), nil
+}
+
+func (cmd *CreateRepository) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func (cmd *CreateRepository) ExtractResult(i interface{}) string {
+	return StringValue(i.(*ecr.CreateRepositoryOutput).Repository.RepositoryArn)
+}
+
+func NewCreateRole(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateRole {
+	cmd := new(CreateRole)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = iam.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateRole) SetApi(api iamiface.IAMAPI) {
+	cmd.api = api
+}
+
+func (cmd *CreateRole) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateRole) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err, "iam.")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create role: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create role '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create role done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateRole) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("role"), nil
+}
+
+func (cmd *CreateRole) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewCreateRoute(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateRoute {
+	cmd := new(CreateRoute)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateRoute) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *CreateRoute) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateRoute) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.CreateRouteInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateRouteInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateRoute(input)
+	renv.Log().ExtraVerbosef("ec2.CreateRoute call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err, "ec2.CreateRoute")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create route: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create route '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create route done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateRoute) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.CreateRouteInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateRouteInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.CreateRoute(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.CreateRoute call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: create route ok")
+			return fakeDryRunId("route"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *CreateRoute) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewCreateRoutetable(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateRoutetable {
+	cmd := new(CreateRoutetable)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateRoutetable) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *CreateRoutetable) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateRoutetable) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.CreateRouteTableInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateRouteTableInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateRouteTable(input)
+	renv.Log().ExtraVerbosef("ec2.CreateRouteTable call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err, "ec2.CreateRouteTable")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create routetable: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create routetable '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create routetable done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateRoutetable) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.CreateRouteTableInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateRouteTableInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.CreateRouteTable(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.CreateRouteTable call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: create routetable ok")
+			return fakeDryRunId("routetable"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *CreateRoutetable) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func (cmd *CreateRoutetable) ExtractResult(i interface{}) string {
+	return StringValue(i.(*ec2.CreateRouteTableOutput).RouteTable.RouteTableId)
+}
+
+func NewCreateS3object(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateS3object {
+	cmd := new(CreateS3object)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = s3.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateS3object) SetApi(api s3iface.S3API) {
+	cmd.api = api
+}
+
+func (cmd *CreateS3object) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateS3object) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err, "s3.")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create s3object: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create s3object '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create s3object done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateS3object) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("s3object"), nil
+}
+
+func (cmd *CreateS3object) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewCreateScalinggroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateScalinggroup {
+	cmd := new(CreateScalinggroup)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = autoscaling.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateScalinggroup) SetApi(api autoscalingiface.AutoScalingAPI) {
+	cmd.api = api
+}
+
+func (cmd *CreateScalinggroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateScalinggroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &autoscaling.CreateAutoScalingGroupInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in autoscaling.CreateAutoScalingGroupInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateAutoScalingGroup(input)
+	renv.Log().ExtraVerbosef("autoscaling.CreateAutoScalingGroup call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err, "autoscaling.CreateAutoScalingGroup")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create scalinggroup: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create scalinggroup '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create scalinggroup done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateScalinggroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("scalinggroup"), nil
+}
+
+func (cmd *CreateScalinggroup) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewCreateScalingpolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateScalingpolicy {
+	cmd := new(CreateScalingpolicy)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = autoscaling.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateScalingpolicy) SetApi(api autoscalingiface.AutoScalingAPI) {
+	cmd.api = api
+}
+
+func (cmd *CreateScalingpolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateScalingpolicy) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &autoscaling.PutScalingPolicyInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in autoscaling.PutScalingPolicyInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.PutScalingPolicy(input)
+	renv.Log().ExtraVerbosef("autoscaling.PutScalingPolicy call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err, "autoscaling.PutScalingPolicy")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create scalingpolicy: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create scalingpolicy '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create scalingpolicy done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateScalingpolicy) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("scalingpolicy"), nil
+}
+
+func (cmd *CreateScalingpolicy) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func (cmd *CreateScalingpolicy) ExtractResult(i interface{}) string {
+	return StringValue(i.(*autoscaling.PutScalingPolicyOutput).PolicyARN)
+}
+
+func NewCreateSecuritygroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateSecuritygroup {
+	cmd := new(CreateSecuritygroup)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateSecuritygroup) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *CreateSecuritygroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateSecuritygroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.CreateSecurityGroupInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateSecurityGroupInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateSecurityGroup(input)
+	renv.Log().ExtraVerbosef("ec2.CreateSecurityGroup call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err, "ec2.CreateSecurityGroup")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create securitygroup: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create securitygroup '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create securitygroup done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateSecuritygroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.CreateSecurityGroupInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateSecurityGroupInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.CreateSecurityGroup(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.CreateSecurityGroup call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: create securitygroup ok")
+			return fakeDryRunId("securitygroup"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *CreateSecuritygroup) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func (cmd *CreateSecuritygroup) ExtractResult(i interface{}) string {
+	return StringValue(i.(*ec2.CreateSecurityGroupOutput).GroupId)
+}
+
+func NewCreateSnapshot(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateSnapshot {
+	cmd := new(CreateSnapshot)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateSnapshot) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *CreateSnapshot) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateSnapshot) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.CreateSnapshotInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateSnapshotInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateSnapshot(input)
+	renv.Log().ExtraVerbosef("ec2.CreateSnapshot call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err, "ec2.CreateSnapshot")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create snapshot: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create snapshot '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create snapshot done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateSnapshot) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.CreateSnapshotInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateSnapshotInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.CreateSnapshot(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.CreateSnapshot call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: create snapshot ok")
+			return fakeDryRunId("snapshot"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *CreateSnapshot) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func (cmd *CreateSnapshot) ExtractResult(i interface{}) string {
+	return StringValue(i.(*ec2.Snapshot).SnapshotId)
+}
+
+func NewCreateStack(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateStack {
+	cmd := new(CreateStack)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = cloudformation.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateStack) SetApi(api cloudformationiface.CloudFormationAPI) {
+	cmd.api = api
+}
+
+func (cmd *CreateStack) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateStack) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &cloudformation.CreateStackInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in cloudformation.CreateStackInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateStack(input)
+	renv.Log().ExtraVerbosef("cloudformation.CreateStack call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err, "cloudformation.CreateStack")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create stack: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create stack '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create stack done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateStack) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("stack"), nil
+}
+
+func (cmd *CreateStack) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func (cmd *CreateStack) ExtractResult(i interface{}) string {
+	return StringValue(i.(*cloudformation.CreateStackOutput).StackId)
+}
+
+func NewCreateSubnet(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateSubnet {
+	cmd := new(CreateSubnet)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateSubnet) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *CreateSubnet) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateSubnet) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.CreateSubnetInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateSubnetInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateSubnet(input)
+	renv.Log().ExtraVerbosef("ec2.CreateSubnet call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err, "ec2.CreateSubnet")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create subnet: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create subnet '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create subnet done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateSubnet) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.CreateSubnetInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateSubnetInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.CreateSubnet(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.CreateSubnet call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: create subnet ok")
+			return fakeDryRunId("subnet"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *CreateSubnet) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func (cmd *CreateSubnet) ExtractResult(i interface{}) string {
+	return StringValue(i.(*ec2.CreateSubnetOutput).Subnet.SubnetId)
+}
+
+func NewCreateSubscription(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateSubscription {
+	cmd := new(CreateSubscription)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = sns.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateSubscription) SetApi(api snsiface.SNSAPI) {
+	cmd.api = api
+}
+
+func (cmd *CreateSubscription) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateSubscription) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &sns.SubscribeInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in sns.SubscribeInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.Subscribe(input)
+	renv.Log().ExtraVerbosef("sns.Subscribe call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err, "sns.Subscribe")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create subscription: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create subscription '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create subscription done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateSubscription) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("subscription"), nil
+}
+
+func (cmd *CreateSubscription) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func (cmd *CreateSubscription) ExtractResult(i interface{}) string {
+	return StringValue(i.(*sns.SubscribeOutput).SubscriptionArn)
+}
+
+func NewCreateTable(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateTable {
+	cmd := new(CreateTable)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = dynamodb.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateTable) SetApi(api dynamodbiface.DynamoDBAPI) {
+	cmd.api = api
+}
+
+func (cmd *CreateTable) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateTable) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err, "dynamodb.")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create table: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create table '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create table done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateTable) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("table"), nil
+}
+
+func (cmd *CreateTable) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewCreateTag(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateTag {
+	cmd := new(CreateTag)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateTag) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *CreateTag) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateTag) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err, "ec2.")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create tag: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create tag '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create tag done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateTag) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewCreateTargetgroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateTargetgroup {
+	cmd := new(CreateTargetgroup)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = elbv2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateTargetgroup) SetApi(api elbv2iface.ELBV2API) {
+	cmd.api = api
+}
+
+func (cmd *CreateTargetgroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateTargetgroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &elbv2.CreateTargetGroupInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in elbv2.CreateTargetGroupInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateTargetGroup(input)
+	renv.Log().ExtraVerbosef("elbv2.CreateTargetGroup call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err, "elbv2.CreateTargetGroup")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create targetgroup: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create targetgroup '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create targetgroup done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateTargetgroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("targetgroup"), nil
+}
+
+func (cmd *CreateTargetgroup) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func (cmd *CreateTargetgroup) ExtractResult(i interface{}) string {
+	return StringValue(i.(*elbv2.CreateTargetGroupOutput).TargetGroups[0].TargetGroupArn)
+}
+
+func NewCreateTopic(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateTopic {
+	cmd := new(CreateTopic)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = sns.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateTopic) SetApi(api snsiface.SNSAPI) {
+	cmd.api = api
+}
+
+func (cmd *CreateTopic) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateTopic) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &sns.CreateTopicInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in sns.CreateTopicInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateTopic(input)
+	renv.Log().ExtraVerbosef("sns.CreateTopic call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err, "sns.CreateTopic")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create topic: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create topic '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create topic done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateTopic) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("topic"), nil
+}
+
+func (cmd *CreateTopic) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func (cmd *CreateTopic) ExtractResult(i interface{}) string {
+	return StringValue(i.(*sns.CreateTopicOutput).TopicArn)
+}
+
+func NewCreateUser(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateUser {
+	cmd := new(CreateUser)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = iam.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateUser) SetApi(api iamiface.IAMAPI) {
+	cmd.api = api
+}
+
+func (cmd *CreateUser) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateUser) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &iam.CreateUserInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in iam.CreateUserInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateUser(input)
+	renv.Log().ExtraVerbosef("iam.CreateUser call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err, "iam.CreateUser")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create user: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create user '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create user done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateUser) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("user"), nil
+}
+
+func (cmd *CreateUser) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func (cmd *CreateUser) ExtractResult(i interface{}) string {
+	return StringValue(i.(*iam.CreateUserOutput).User.UserId)
+}
+
+func NewCreateVolume(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateVolume {
+	cmd := new(CreateVolume)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateVolume) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *CreateVolume) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateVolume) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.CreateVolumeInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateVolumeInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateVolume(input)
+	renv.Log().ExtraVerbosef("ec2.CreateVolume call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err, "ec2.CreateVolume")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create volume: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create volume '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create volume done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateVolume) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.CreateVolumeInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateVolumeInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.CreateVolume(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.CreateVolume call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: create volume ok")
+			return fakeDryRunId("volume"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *CreateVolume) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func (cmd *CreateVolume) ExtractResult(i interface{}) string {
+	return StringValue(i.(*ec2.Volume).VolumeId)
+}
+
+func NewCreateVpc(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateVpc {
+	cmd := new(CreateVpc)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateVpc) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *CreateVpc) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateVpc) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.CreateVpcInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateVpcInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateVpc(input)
+	renv.Log().ExtraVerbosef("ec2.CreateVpc call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err, "ec2.CreateVpc")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create vpc: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create vpc '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create vpc done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateVpc) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.CreateVpcInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateVpcInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.CreateVpc(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.CreateVpc call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: create vpc ok")
+			return fakeDryRunId("vpc"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *CreateVpc) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func (cmd *CreateVpc) ExtractResult(i interface{}) string {
+	return StringValue(i.(*ec2.CreateVpcOutput).Vpc.VpcId)
+}
+
+func NewCreateVpcendpoint(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateVpcendpoint {
+	cmd := new(CreateVpcendpoint)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateVpcendpoint) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *CreateVpcendpoint) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateVpcendpoint) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err, "ec2.")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create vpcendpoint: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create vpcendpoint '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create vpcendpoint done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateVpcendpoint) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewCreateZone(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateZone {
+	cmd := new(CreateZone)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = route53.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateZone) SetApi(api route53iface.Route53API) {
+	cmd.api = api
+}
+
+func (cmd *CreateZone) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateZone) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &route53.CreateHostedZoneInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in route53.CreateHostedZoneInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateHostedZone(input)
+	renv.Log().ExtraVerbosef("route53.CreateHostedZone call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err, "route53.CreateHostedZone")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create zone: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create zone '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create zone done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateZone) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("zone"), nil
+}
+
+func (cmd *CreateZone) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func (cmd *CreateZone) ExtractResult(i interface{}) string {
+	return StringValue(i.(*route53.CreateHostedZoneOutput).HostedZone.Id)
+}
+
+func NewDeleteAccesskey(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteAccesskey {
+	cmd := new(DeleteAccesskey)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = iam.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteAccesskey) SetApi(api iamiface.IAMAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteAccesskey) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *DeleteAccesskey) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &iam.DeleteAccessKeyInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in iam.DeleteAccessKeyInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteAccessKey(input)
+	renv.Log().ExtraVerbosef("iam.DeleteAccessKey call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err, "iam.DeleteAccessKey")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete accesskey: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete accesskey '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete accesskey done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteAccesskey) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("accesskey"), nil
+}
+
+func (cmd *DeleteAccesskey) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteAlarm(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteAlarm {
+	cmd := new(DeleteAlarm)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = cloudwatch.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteAlarm) SetApi(api cloudwatchiface.CloudWatchAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteAlarm) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *DeleteAlarm) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &cloudwatch.DeleteAlarmsInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in cloudwatch.DeleteAlarmsInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteAlarms(input)
+	renv.Log().ExtraVerbosef("cloudwatch.DeleteAlarms call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err, "cloudwatch.DeleteAlarms")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete alarm: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete alarm '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete alarm done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteAlarm) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("alarm"), nil
+}
+
+func (cmd *DeleteAlarm) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteApplication(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteApplication {
+	cmd := new(DeleteApplication)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = elasticbeanstalk.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteApplication) SetApi(api elasticbeanstalkiface.ElasticBeanstalkAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteApplication) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *DeleteApplication) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &elasticbeanstalk.DeleteApplicationInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in elasticbeanstalk.DeleteApplicationInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteApplication(input)
+	renv.Log().ExtraVerbosef("elasticbeanstalk.DeleteApplication call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err, "elasticbeanstalk.DeleteApplication")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete application: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete application '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete application done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteApplication) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("application"), nil
+}
+
+func (cmd *DeleteApplication) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteAppscalingpolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteAppscalingpolicy {
+	cmd := new(DeleteAppscalingpolicy)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = applicationautoscaling.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteAppscalingpolicy) SetApi(api applicationautoscalingiface.ApplicationAutoScalingAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteAppscalingpolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *DeleteAppscalingpolicy) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &applicationautoscaling.DeleteScalingPolicyInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in applicationautoscaling.DeleteScalingPolicyInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteScalingPolicy(input)
+	renv.Log().ExtraVerbosef("applicationautoscaling.DeleteScalingPolicy call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err, "applicationautoscaling.DeleteScalingPolicy")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete appscalingpolicy: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete appscalingpolicy '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete appscalingpolicy done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteAppscalingpolicy) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("appscalingpolicy"), nil
+}
+
+func (cmd *DeleteAppscalingpolicy) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteAppscalingtarget(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteAppscalingtarget {
+	cmd := new(DeleteAppscalingtarget)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = applicationautoscaling.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteAppscalingtarget) SetApi(api applicationautoscalingiface.ApplicationAutoScalingAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteAppscalingtarget) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *DeleteAppscalingtarget) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &applicationautoscaling.DeregisterScalableTargetInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in applicationautoscaling.DeregisterScalableTargetInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeregisterScalableTarget(input)
+	renv.Log().ExtraVerbosef("applicationautoscaling.DeregisterScalableTarget call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err, "applicationautoscaling.DeregisterScalableTarget")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete appscalingtarget: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete appscalingtarget '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete appscalingtarget done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteAppscalingtarget) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("appscalingtarget"), nil
+}
+
+func (cmd *DeleteAppscalingtarget) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteBucket(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteBucket {
+	cmd := new(DeleteBucket)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = s3.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteBucket) SetApi(api s3iface.S3API) {
+	cmd.api = api
+}
+
+func (cmd *DeleteBucket) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *DeleteBucket) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &s3.DeleteBucketInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in s3.DeleteBucketInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteBucket(input)
+	renv.Log().ExtraVerbosef("s3.DeleteBucket call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err, "s3.DeleteBucket")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete bucket: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete bucket '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete bucket done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteBucket) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("bucket"), nil
+}
+
+func (cmd *DeleteBucket) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteCertificate(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteCertificate {
+	cmd := new(DeleteCertificate)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = acm.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteCertificate) SetApi(api acmiface.ACMAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteCertificate) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *DeleteCertificate) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &acm.DeleteCertificateInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in acm.DeleteCertificateInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteCertificate(input)
+	renv.Log().ExtraVerbosef("acm.DeleteCertificate call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err, "acm.DeleteCertificate")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete certificate: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete certificate '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete certificate done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteCertificate) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("certificate"), nil
+}
+
+func (cmd *DeleteCertificate) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteClassicLoadbalancer(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteClassicLoadbalancer {
+	cmd := new(DeleteClassicLoadbalancer)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = elb.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteClassicLoadbalancer) SetApi(api elbiface.ELBAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteClassicLoadbalancer) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *DeleteClassicLoadbalancer) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &elb.DeleteLoadBalancerInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in elb.DeleteLoadBalancerInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteLoadBalancer(input)
+	renv.Log().ExtraVerbosef("elb.DeleteLoadBalancer call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err, "elb.DeleteLoadBalancer")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete classicloadbalancer: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete classicloadbalancer '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete classicloadbalancer done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteClassicLoadbalancer) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("classicloadbalancer"), nil
+}
+
+func (cmd *DeleteClassicLoadbalancer) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteContainercluster(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteContainercluster {
+	cmd := new(DeleteContainercluster)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ecs.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteContainercluster) SetApi(api ecsiface.ECSAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteContainercluster) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *DeleteContainercluster) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ecs.DeleteClusterInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ecs.DeleteClusterInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteCluster(input)
+	renv.Log().ExtraVerbosef("ecs.DeleteCluster call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err, "ecs.DeleteCluster")
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete containercluster: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete containercluster '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete containercluster done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteContainercluster) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("containercluster"), nil
+}
+
+func (cmd *DeleteContainercluster) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateS3object(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateS3object {
-	cmd := new(CreateS3object)
+func NewDeleteContainertask(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteContainertask {
+	cmd := new(DeleteContainertask)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = s3.New(sess)
+		cmd.api = ecs.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateS3object) SetApi(api s3iface.S3API) {
+func (cmd *DeleteContainertask) SetApi(api ecsiface.ECSAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateS3object) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteContainertask) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateS3object) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteContainertask) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -5159,7 +9301,7 @@ func (cmd *CreateS3object) run(renv env.Running, params map[string]interface{})
 
 	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ecs.")
 	}
 
 	var extracted interface{}
@@ -5167,14 +9309,14 @@ func (cmd *CreateS3object) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create s3object: AWS command returned nil output")
+			renv.Log().Warning("delete containertask: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create s3object '%s' done", extracted)
+		renv.Log().Verbosef("delete containertask '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create s3object done")
+		renv.Log().Verbose("delete containertask done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -5186,40 +9328,39 @@ func (cmd *CreateS3object) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *CreateS3object) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("s3object"), nil
-}
-
-func (cmd *CreateS3object) inject(params map[string]interface{}) error {
+func (cmd *DeleteContainertask) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateScalinggroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateScalinggroup {
-	cmd := new(CreateScalinggroup)
+func NewDeleteDatabase(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteDatabase {
+	cmd := new(DeleteDatabase)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = autoscaling.New(sess)
+		cmd.api = rds.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateScalinggroup) SetApi(api autoscalingiface.AutoScalingAPI) {
+func (cmd *DeleteDatabase) SetApi(api rdsiface.RDSAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateScalinggroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteDatabase) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateScalinggroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteDatabase) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -5230,15 +9371,15 @@ func (cmd *CreateScalinggroup) run(renv env.Running, params map[string]interface
 		}
 	}
 
-	input := &autoscaling.CreateAutoScalingGroupInput{}
+	input := &rds.DeleteDBInstanceInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in autoscaling.CreateAutoScalingGroupInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in rds.DeleteDBInstanceInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateAutoScalingGroup(input)
-	renv.Log().ExtraVerbosef("autoscaling.CreateAutoScalingGroup call took %s", time.Since(start))
+	output, err := cmd.api.DeleteDBInstance(input)
+	renv.Log().ExtraVerbosef("rds.DeleteDBInstance call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "rds.DeleteDBInstance")
 	}
 
 	var extracted interface{}
@@ -5246,14 +9387,14 @@ func (cmd *CreateScalinggroup) run(renv env.Running, params map[string]interface
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create scalinggroup: AWS command returned nil output")
+			renv.Log().Warning("delete database: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create scalinggroup '%s' done", extracted)
+		renv.Log().Verbosef("delete database '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create scalinggroup done")
+		renv.Log().Verbose("delete database done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -5265,40 +9406,43 @@ func (cmd *CreateScalinggroup) run(renv env.Running, params map[string]interface
 	return extracted, nil
 }
 
-func (cmd *CreateScalinggroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("scalinggroup"), nil
+func (cmd *DeleteDatabase) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("database"), nil
 }
 
-func (cmd *CreateScalinggroup) inject(params map[string]interface{}) error {
+func (cmd *DeleteDatabase) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateScalingpolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateScalingpolicy {
-	cmd := new(CreateScalingpolicy)
+func NewDeleteDbsubnetgroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteDbsubnetgroup {
+	cmd := new(DeleteDbsubnetgroup)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = autoscaling.New(sess)
+		cmd.api = rds.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateScalingpolicy) SetApi(api autoscalingiface.AutoScalingAPI) {
+func (cmd *DeleteDbsubnetgroup) SetApi(api rdsiface.RDSAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateScalingpolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteDbsubnetgroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateScalingpolicy) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteDbsubnetgroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -5309,15 +9453,15 @@ func (cmd *CreateScalingpolicy) run(renv env.Running, params map[string]interfac
 		}
 	}
 
-	input := &autoscaling.PutScalingPolicyInput{}
+	input := &rds.DeleteDBSubnetGroupInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in autoscaling.PutScalingPolicyInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in rds.DeleteDBSubnetGroupInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.PutScalingPolicy(input)
-	renv.Log().ExtraVerbosef("autoscaling.PutScalingPolicy call took %s", time.Since(start))
+	output, err := cmd.api.DeleteDBSubnetGroup(input)
+	renv.Log().ExtraVerbosef("rds.DeleteDBSubnetGroup call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "rds.DeleteDBSubnetGroup")
 	}
 
 	var extracted interface{}
@@ -5325,14 +9469,14 @@ func (cmd *CreateScalingpolicy) run(renv env.Running, params map[string]interfac
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create scalingpolicy: AWS command returned nil output")
+			renv.Log().Warning("delete dbsubnetgroup: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create scalingpolicy '%s' done", extracted)
+		renv.Log().Verbosef("delete dbsubnetgroup '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create scalingpolicy done")
+		renv.Log().Verbose("delete dbsubnetgroup done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -5344,16 +9488,16 @@ func (cmd *CreateScalingpolicy) run(renv env.Running, params map[string]interfac
 	return extracted, nil
 }
 
-func (cmd *CreateScalingpolicy) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("scalingpolicy"), nil
+func (cmd *DeleteDbsubnetgroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("dbsubnetgroup"), nil
 }
 
-func (cmd *CreateScalingpolicy) inject(params map[string]interface{}) error {
+func (cmd *DeleteDbsubnetgroup) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateSecuritygroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateSecuritygroup {
-	cmd := new(CreateSecuritygroup)
+func NewDeleteDhcpoptions(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteDhcpoptions {
+	cmd := new(DeleteDhcpoptions)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -5366,18 +9510,21 @@ func NewCreateSecuritygroup(sess *session.Session, g cloud.GraphAPI, l ...*logge
 	return cmd
 }
 
-func (cmd *CreateSecuritygroup) SetApi(api ec2iface.EC2API) {
+func (cmd *DeleteDhcpoptions) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CreateSecuritygroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteDhcpoptions) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateSecuritygroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteDhcpoptions) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -5388,15 +9535,15 @@ func (cmd *CreateSecuritygroup) run(renv env.Running, params map[string]interfac
 		}
 	}
 
-	input := &ec2.CreateSecurityGroupInput{}
+	input := &ec2.DeleteDhcpOptionsInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateSecurityGroupInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteDhcpOptionsInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateSecurityGroup(input)
-	renv.Log().ExtraVerbosef("ec2.CreateSecurityGroup call took %s", time.Since(start))
+	output, err := cmd.api.DeleteDhcpOptions(input)
+	renv.Log().ExtraVerbosef("ec2.DeleteDhcpOptions call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.DeleteDhcpOptions")
 	}
 
 	var extracted interface{}
@@ -5404,14 +9551,14 @@ func (cmd *CreateSecuritygroup) run(renv env.Running, params map[string]interfac
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create securitygroup: AWS command returned nil output")
+			renv.Log().Warning("delete dhcpoptions: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create securitygroup '%s' done", extracted)
+		renv.Log().Verbosef("delete dhcpoptions '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create securitygroup done")
+		renv.Log().Verbose("delete dhcpoptions done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -5423,61 +9570,64 @@ func (cmd *CreateSecuritygroup) run(renv env.Running, params map[string]interfac
 	return extracted, nil
 }
 
-func (cmd *CreateSecuritygroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteDhcpoptions) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
 
-	input := &ec2.CreateSecurityGroupInput{}
+	input := &ec2.DeleteDhcpOptionsInput{}
 	input.SetDryRun(true)
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateSecurityGroupInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteDhcpOptionsInput: %s", err)
 	}
 
 	start := time.Now()
-	_, err := cmd.api.CreateSecurityGroup(input)
+	_, err := cmd.api.DeleteDhcpOptions(input)
 	if awsErr, ok := err.(awserr.Error); ok {
 		switch code := awsErr.Code(); {
 		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.CreateSecurityGroup call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: create securitygroup ok")
-			return fakeDryRunId("securitygroup"), nil
+			renv.Log().ExtraVerbosef("dry run: ec2.DeleteDhcpOptions call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete dhcpoptions ok")
+			return fakeDryRunId("dhcpoptions"), nil
 		}
 	}
 
 	return nil, err
 }
 
-func (cmd *CreateSecuritygroup) inject(params map[string]interface{}) error {
+func (cmd *DeleteDhcpoptions) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateSnapshot(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateSnapshot {
-	cmd := new(CreateSnapshot)
+func NewDeleteDistribution(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteDistribution {
+	cmd := new(DeleteDistribution)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = cloudfront.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateSnapshot) SetApi(api ec2iface.EC2API) {
+func (cmd *DeleteDistribution) SetApi(api cloudfrontiface.CloudFrontAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateSnapshot) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteDistribution) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateSnapshot) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteDistribution) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -5488,15 +9638,9 @@ func (cmd *CreateSnapshot) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &ec2.CreateSnapshotInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateSnapshotInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.CreateSnapshot(input)
-	renv.Log().ExtraVerbosef("ec2.CreateSnapshot call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "cloudfront.")
 	}
 
 	var extracted interface{}
@@ -5504,14 +9648,14 @@ func (cmd *CreateSnapshot) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create snapshot: AWS command returned nil output")
+			renv.Log().Warning("delete distribution: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create snapshot '%s' done", extracted)
+		renv.Log().Verbosef("delete distribution '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create snapshot done")
+		renv.Log().Verbose("delete distribution done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -5523,61 +9667,43 @@ func (cmd *CreateSnapshot) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *CreateSnapshot) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.CreateSnapshotInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateSnapshotInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.CreateSnapshot(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.CreateSnapshot call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: create snapshot ok")
-			return fakeDryRunId("snapshot"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *DeleteDistribution) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("distribution"), nil
 }
 
-func (cmd *CreateSnapshot) inject(params map[string]interface{}) error {
+func (cmd *DeleteDistribution) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateStack(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateStack {
-	cmd := new(CreateStack)
+func NewDeleteEgressonlyinternetgateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteEgressonlyinternetgateway {
+	cmd := new(DeleteEgressonlyinternetgateway)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = cloudformation.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateStack) SetApi(api cloudformationiface.CloudFormationAPI) {
+func (cmd *DeleteEgressonlyinternetgateway) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CreateStack) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteEgressonlyinternetgateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateStack) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteEgressonlyinternetgateway) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -5588,15 +9714,15 @@ func (cmd *CreateStack) run(renv env.Running, params map[string]interface{}) (in
 		}
 	}
 
-	input := &cloudformation.CreateStackInput{}
+	input := &ec2.DeleteEgressOnlyInternetGatewayInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in cloudformation.CreateStackInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteEgressOnlyInternetGatewayInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateStack(input)
-	renv.Log().ExtraVerbosef("cloudformation.CreateStack call took %s", time.Since(start))
+	output, err := cmd.api.DeleteEgressOnlyInternetGateway(input)
+	renv.Log().ExtraVerbosef("ec2.DeleteEgressOnlyInternetGateway call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.DeleteEgressOnlyInternetGateway")
 	}
 
 	var extracted interface{}
@@ -5604,14 +9730,14 @@ func (cmd *CreateStack) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create stack: AWS command returned nil output")
+			renv.Log().Warning("delete egressonlyinternetgateway: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create stack '%s' done", extracted)
+		renv.Log().Verbosef("delete egressonlyinternetgateway '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create stack done")
+		renv.Log().Verbose("delete egressonlyinternetgateway done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -5623,16 +9749,37 @@ func (cmd *CreateStack) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *CreateStack) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("stack"), nil
+func (cmd *DeleteEgressonlyinternetgateway) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.DeleteEgressOnlyInternetGatewayInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteEgressOnlyInternetGatewayInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DeleteEgressOnlyInternetGateway(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.DeleteEgressOnlyInternetGateway call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete egressonlyinternetgateway ok")
+			return fakeDryRunId("egressonlyinternetgateway"), nil
+		}
+	}
+
+	return nil, err
 }
 
-func (cmd *CreateStack) inject(params map[string]interface{}) error {
+func (cmd *DeleteEgressonlyinternetgateway) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateSubnet(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateSubnet {
-	cmd := new(CreateSubnet)
+func NewDeleteElasticip(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteElasticip {
+	cmd := new(DeleteElasticip)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -5645,18 +9792,21 @@ func NewCreateSubnet(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logge
 	return cmd
 }
 
-func (cmd *CreateSubnet) SetApi(api ec2iface.EC2API) {
+func (cmd *DeleteElasticip) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CreateSubnet) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteElasticip) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateSubnet) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteElasticip) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -5667,15 +9817,15 @@ func (cmd *CreateSubnet) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	input := &ec2.CreateSubnetInput{}
+	input := &ec2.ReleaseAddressInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateSubnetInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.ReleaseAddressInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateSubnet(input)
-	renv.Log().ExtraVerbosef("ec2.CreateSubnet call took %s", time.Since(start))
+	output, err := cmd.api.ReleaseAddress(input)
+	renv.Log().ExtraVerbosef("ec2.ReleaseAddress call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.ReleaseAddress")
 	}
 
 	var extracted interface{}
@@ -5683,14 +9833,14 @@ func (cmd *CreateSubnet) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create subnet: AWS command returned nil output")
+			renv.Log().Warning("delete elasticip: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create subnet '%s' done", extracted)
+		renv.Log().Verbosef("delete elasticip '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create subnet done")
+		renv.Log().Verbose("delete elasticip done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -5702,61 +9852,64 @@ func (cmd *CreateSubnet) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *CreateSubnet) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteElasticip) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
 
-	input := &ec2.CreateSubnetInput{}
+	input := &ec2.ReleaseAddressInput{}
 	input.SetDryRun(true)
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateSubnetInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.ReleaseAddressInput: %s", err)
 	}
 
 	start := time.Now()
-	_, err := cmd.api.CreateSubnet(input)
+	_, err := cmd.api.ReleaseAddress(input)
 	if awsErr, ok := err.(awserr.Error); ok {
 		switch code := awsErr.Code(); {
 		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.CreateSubnet call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: create subnet ok")
-			return fakeDryRunId("subnet"), nil
+			renv.Log().ExtraVerbosef("dry run: ec2.ReleaseAddress call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete elasticip ok")
+			return fakeDryRunId("elasticip"), nil
 		}
 	}
 
 	return nil, err
 }
 
-func (cmd *CreateSubnet) inject(params map[string]interface{}) error {
+func (cmd *DeleteElasticip) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateSubscription(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateSubscription {
-	cmd := new(CreateSubscription)
+func NewDeleteElasticsearchdomain(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteElasticsearchdomain {
+	cmd := new(DeleteElasticsearchdomain)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = sns.New(sess)
+		cmd.api = elasticsearchservice.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateSubscription) SetApi(api snsiface.SNSAPI) {
+func (cmd *DeleteElasticsearchdomain) SetApi(api elasticsearchserviceiface.ElasticsearchServiceAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateSubscription) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteElasticsearchdomain) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateSubscription) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteElasticsearchdomain) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -5767,15 +9920,15 @@ func (cmd *CreateSubscription) run(renv env.Running, params map[string]interface
 		}
 	}
 
-	input := &sns.SubscribeInput{}
+	input := &elasticsearchservice.DeleteElasticsearchDomainInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in sns.SubscribeInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in elasticsearchservice.DeleteElasticsearchDomainInput: %s", err)
 	}
-	start := time.Now()
-	output, err := cmd.api.Subscribe(input)
-	renv.Log().ExtraVerbosef("sns.Subscribe call took %s", time.Since(start))
+	start := time.Now()
+	output, err := cmd.api.DeleteElasticsearchDomain(input)
+	renv.Log().ExtraVerbosef("elasticsearchservice.DeleteElasticsearchDomain call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "elasticsearchservice.DeleteElasticsearchDomain")
 	}
 
 	var extracted interface{}
@@ -5783,14 +9936,14 @@ func (cmd *CreateSubscription) run(renv env.Running, params map[string]interface
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create subscription: AWS command returned nil output")
+			renv.Log().Warning("delete elasticsearchdomain: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create subscription '%s' done", extracted)
+		renv.Log().Verbosef("delete elasticsearchdomain '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create subscription done")
+		renv.Log().Verbose("delete elasticsearchdomain done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -5802,40 +9955,43 @@ func (cmd *CreateSubscription) run(renv env.Running, params map[string]interface
 	return extracted, nil
 }
 
-func (cmd *CreateSubscription) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("subscription"), nil
+func (cmd *DeleteElasticsearchdomain) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("elasticsearchdomain"), nil
 }
 
-func (cmd *CreateSubscription) inject(params map[string]interface{}) error {
+func (cmd *DeleteElasticsearchdomain) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateTag(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateTag {
-	cmd := new(CreateTag)
+func NewDeleteEnvironment(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteEnvironment {
+	cmd := new(DeleteEnvironment)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = elasticbeanstalk.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateTag) SetApi(api ec2iface.EC2API) {
+func (cmd *DeleteEnvironment) SetApi(api elasticbeanstalkiface.ElasticBeanstalkAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateTag) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteEnvironment) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateTag) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteEnvironment) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -5846,9 +10002,15 @@ func (cmd *CreateTag) run(renv env.Running, params map[string]interface{}) (inte
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &elasticbeanstalk.TerminateEnvironmentInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in elasticbeanstalk.TerminateEnvironmentInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.TerminateEnvironment(input)
+	renv.Log().ExtraVerbosef("elasticbeanstalk.TerminateEnvironment call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "elasticbeanstalk.TerminateEnvironment")
 	}
 
 	var extracted interface{}
@@ -5856,14 +10018,14 @@ func (cmd *CreateTag) run(renv env.Running, params map[string]interface{}) (inte
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create tag: AWS command returned nil output")
+			renv.Log().Warning("delete environment: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create tag '%s' done", extracted)
+		renv.Log().Verbosef("delete environment '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create tag done")
+		renv.Log().Verbose("delete environment done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -5875,36 +10037,43 @@ func (cmd *CreateTag) run(renv env.Running, params map[string]interface{}) (inte
 	return extracted, nil
 }
 
-func (cmd *CreateTag) inject(params map[string]interface{}) error {
+func (cmd *DeleteEnvironment) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("environment"), nil
+}
+
+func (cmd *DeleteEnvironment) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateTargetgroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateTargetgroup {
-	cmd := new(CreateTargetgroup)
+func NewDeleteFunction(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteFunction {
+	cmd := new(DeleteFunction)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = elbv2.New(sess)
+		cmd.api = lambda.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateTargetgroup) SetApi(api elbv2iface.ELBV2API) {
+func (cmd *DeleteFunction) SetApi(api lambdaiface.LambdaAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateTargetgroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteFunction) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateTargetgroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteFunction) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -5915,15 +10084,15 @@ func (cmd *CreateTargetgroup) run(renv env.Running, params map[string]interface{
 		}
 	}
 
-	input := &elbv2.CreateTargetGroupInput{}
+	input := &lambda.DeleteFunctionInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in elbv2.CreateTargetGroupInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in lambda.DeleteFunctionInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateTargetGroup(input)
-	renv.Log().ExtraVerbosef("elbv2.CreateTargetGroup call took %s", time.Since(start))
+	output, err := cmd.api.DeleteFunction(input)
+	renv.Log().ExtraVerbosef("lambda.DeleteFunction call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "lambda.DeleteFunction")
 	}
 
 	var extracted interface{}
@@ -5931,14 +10100,14 @@ func (cmd *CreateTargetgroup) run(renv env.Running, params map[string]interface{
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create targetgroup: AWS command returned nil output")
+			renv.Log().Warning("delete function: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create targetgroup '%s' done", extracted)
+		renv.Log().Verbosef("delete function '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create targetgroup done")
+		renv.Log().Verbose("delete function done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -5950,40 +10119,43 @@ func (cmd *CreateTargetgroup) run(renv env.Running, params map[string]interface{
 	return extracted, nil
 }
 
-func (cmd *CreateTargetgroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("targetgroup"), nil
+func (cmd *DeleteFunction) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("function"), nil
 }
 
-func (cmd *CreateTargetgroup) inject(params map[string]interface{}) error {
+func (cmd *DeleteFunction) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateTopic(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateTopic {
-	cmd := new(CreateTopic)
+func NewDeleteGroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteGroup {
+	cmd := new(DeleteGroup)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = sns.New(sess)
+		cmd.api = iam.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateTopic) SetApi(api snsiface.SNSAPI) {
+func (cmd *DeleteGroup) SetApi(api iamiface.IAMAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateTopic) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteGroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateTopic) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteGroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -5994,15 +10166,15 @@ func (cmd *CreateTopic) run(renv env.Running, params map[string]interface{}) (in
 		}
 	}
 
-	input := &sns.CreateTopicInput{}
+	input := &iam.DeleteGroupInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in sns.CreateTopicInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in iam.DeleteGroupInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateTopic(input)
-	renv.Log().ExtraVerbosef("sns.CreateTopic call took %s", time.Since(start))
+	output, err := cmd.api.DeleteGroup(input)
+	renv.Log().ExtraVerbosef("iam.DeleteGroup call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "iam.DeleteGroup")
 	}
 
 	var extracted interface{}
@@ -6010,14 +10182,14 @@ func (cmd *CreateTopic) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create topic: AWS command returned nil output")
+			renv.Log().Warning("delete group: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create topic '%s' done", extracted)
+		renv.Log().Verbosef("delete group '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create topic done")
+		renv.Log().Verbose("delete group done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -6029,40 +10201,43 @@ func (cmd *CreateTopic) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *CreateTopic) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("topic"), nil
+func (cmd *DeleteGroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("group"), nil
 }
 
-func (cmd *CreateTopic) inject(params map[string]interface{}) error {
+func (cmd *DeleteGroup) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateUser(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateUser {
-	cmd := new(CreateUser)
+func NewDeleteHealthcheck(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteHealthcheck {
+	cmd := new(DeleteHealthcheck)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = route53.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateUser) SetApi(api iamiface.IAMAPI) {
+func (cmd *DeleteHealthcheck) SetApi(api route53iface.Route53API) {
 	cmd.api = api
 }
 
-func (cmd *CreateUser) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteHealthcheck) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateUser) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteHealthcheck) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -6073,15 +10248,15 @@ func (cmd *CreateUser) run(renv env.Running, params map[string]interface{}) (int
 		}
 	}
 
-	input := &iam.CreateUserInput{}
+	input := &route53.DeleteHealthCheckInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.CreateUserInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in route53.DeleteHealthCheckInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateUser(input)
-	renv.Log().ExtraVerbosef("iam.CreateUser call took %s", time.Since(start))
+	output, err := cmd.api.DeleteHealthCheck(input)
+	renv.Log().ExtraVerbosef("route53.DeleteHealthCheck call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "route53.DeleteHealthCheck")
 	}
 
 	var extracted interface{}
@@ -6089,14 +10264,14 @@ func (cmd *CreateUser) run(renv env.Running, params map[string]interface{}) (int
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create user: AWS command returned nil output")
+			renv.Log().Warning("delete healthcheck: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create user '%s' done", extracted)
+		renv.Log().Verbosef("delete healthcheck '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create user done")
+		renv.Log().Verbose("delete healthcheck done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -6108,16 +10283,16 @@ func (cmd *CreateUser) run(renv env.Running, params map[string]interface{}) (int
 	return extracted, nil
 }
 
-func (cmd *CreateUser) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("user"), nil
+func (cmd *DeleteHealthcheck) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("healthcheck"), nil
 }
 
-func (cmd *CreateUser) inject(params map[string]interface{}) error {
+func (cmd *DeleteHealthcheck) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateVolume(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateVolume {
-	cmd := new(CreateVolume)
+func NewDeleteImage(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteImage {
+	cmd := new(DeleteImage)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -6130,18 +10305,21 @@ func NewCreateVolume(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logge
 	return cmd
 }
 
-func (cmd *CreateVolume) SetApi(api ec2iface.EC2API) {
+func (cmd *DeleteImage) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CreateVolume) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteImage) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateVolume) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteImage) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -6152,15 +10330,9 @@ func (cmd *CreateVolume) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	input := &ec2.CreateVolumeInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateVolumeInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.CreateVolume(input)
-	renv.Log().ExtraVerbosef("ec2.CreateVolume call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.")
 	}
 
 	var extracted interface{}
@@ -6168,14 +10340,14 @@ func (cmd *CreateVolume) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create volume: AWS command returned nil output")
+			renv.Log().Warning("delete image: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create volume '%s' done", extracted)
+		renv.Log().Verbosef("delete image '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create volume done")
+		renv.Log().Verbose("delete image done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -6187,37 +10359,12 @@ func (cmd *CreateVolume) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *CreateVolume) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.CreateVolumeInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateVolumeInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.CreateVolume(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.CreateVolume call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: create volume ok")
-			return fakeDryRunId("volume"), nil
-		}
-	}
-
-	return nil, err
-}
-
-func (cmd *CreateVolume) inject(params map[string]interface{}) error {
+func (cmd *DeleteImage) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateVpc(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateVpc {
-	cmd := new(CreateVpc)
+func NewDeleteInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteInstance {
+	cmd := new(DeleteInstance)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -6230,18 +10377,21 @@ func NewCreateVpc(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger)
 	return cmd
 }
 
-func (cmd *CreateVpc) SetApi(api ec2iface.EC2API) {
+func (cmd *DeleteInstance) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CreateVpc) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateVpc) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteInstance) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -6252,15 +10402,15 @@ func (cmd *CreateVpc) run(renv env.Running, params map[string]interface{}) (inte
 		}
 	}
 
-	input := &ec2.CreateVpcInput{}
+	input := &ec2.TerminateInstancesInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateVpcInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.TerminateInstancesInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateVpc(input)
-	renv.Log().ExtraVerbosef("ec2.CreateVpc call took %s", time.Since(start))
+	output, err := cmd.api.TerminateInstances(input)
+	renv.Log().ExtraVerbosef("ec2.TerminateInstances call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.TerminateInstances")
 	}
 
 	var extracted interface{}
@@ -6268,14 +10418,14 @@ func (cmd *CreateVpc) run(renv env.Running, params map[string]interface{}) (inte
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create vpc: AWS command returned nil output")
+			renv.Log().Warning("delete instance: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create vpc '%s' done", extracted)
+		renv.Log().Verbosef("delete instance '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create vpc done")
+		renv.Log().Verbose("delete instance done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -6287,61 +10437,64 @@ func (cmd *CreateVpc) run(renv env.Running, params map[string]interface{}) (inte
 	return extracted, nil
 }
 
-func (cmd *CreateVpc) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteInstance) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
 
-	input := &ec2.CreateVpcInput{}
+	input := &ec2.TerminateInstancesInput{}
 	input.SetDryRun(true)
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateVpcInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.TerminateInstancesInput: %s", err)
 	}
 
 	start := time.Now()
-	_, err := cmd.api.CreateVpc(input)
+	_, err := cmd.api.TerminateInstances(input)
 	if awsErr, ok := err.(awserr.Error); ok {
 		switch code := awsErr.Code(); {
 		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.CreateVpc call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: create vpc ok")
-			return fakeDryRunId("vpc"), nil
+			renv.Log().ExtraVerbosef("dry run: ec2.TerminateInstances call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete instance ok")
+			return fakeDryRunId("instance"), nil
 		}
 	}
 
 	return nil, err
 }
 
-func (cmd *CreateVpc) inject(params map[string]interface{}) error {
+func (cmd *DeleteInstance) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateZone(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateZone {
-	cmd := new(CreateZone)
+func NewDeleteInstanceprofile(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteInstanceprofile {
+	cmd := new(DeleteInstanceprofile)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = route53.New(sess)
+		cmd.api = iam.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateZone) SetApi(api route53iface.Route53API) {
+func (cmd *DeleteInstanceprofile) SetApi(api iamiface.IAMAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateZone) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteInstanceprofile) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateZone) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteInstanceprofile) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -6352,15 +10505,15 @@ func (cmd *CreateZone) run(renv env.Running, params map[string]interface{}) (int
 		}
 	}
 
-	input := &route53.CreateHostedZoneInput{}
+	input := &iam.DeleteInstanceProfileInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in route53.CreateHostedZoneInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in iam.DeleteInstanceProfileInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateHostedZone(input)
-	renv.Log().ExtraVerbosef("route53.CreateHostedZone call took %s", time.Since(start))
+	output, err := cmd.api.DeleteInstanceProfile(input)
+	renv.Log().ExtraVerbosef("iam.DeleteInstanceProfile call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "iam.DeleteInstanceProfile")
 	}
 
 	var extracted interface{}
@@ -6368,14 +10521,14 @@ func (cmd *CreateZone) run(renv env.Running, params map[string]interface{}) (int
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create zone: AWS command returned nil output")
+			renv.Log().Warning("delete instanceprofile: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create zone '%s' done", extracted)
+		renv.Log().Verbosef("delete instanceprofile '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create zone done")
+		renv.Log().Verbose("delete instanceprofile done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -6387,40 +10540,43 @@ func (cmd *CreateZone) run(renv env.Running, params map[string]interface{}) (int
 	return extracted, nil
 }
 
-func (cmd *CreateZone) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("zone"), nil
+func (cmd *DeleteInstanceprofile) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("instanceprofile"), nil
 }
 
-func (cmd *CreateZone) inject(params map[string]interface{}) error {
+func (cmd *DeleteInstanceprofile) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteAccesskey(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteAccesskey {
-	cmd := new(DeleteAccesskey)
+func NewDeleteInternetgateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteInternetgateway {
+	cmd := new(DeleteInternetgateway)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteAccesskey) SetApi(api iamiface.IAMAPI) {
+func (cmd *DeleteInternetgateway) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteAccesskey) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteInternetgateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteAccesskey) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteInternetgateway) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -6431,15 +10587,15 @@ func (cmd *DeleteAccesskey) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &iam.DeleteAccessKeyInput{}
+	input := &ec2.DeleteInternetGatewayInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.DeleteAccessKeyInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteInternetGatewayInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteAccessKey(input)
-	renv.Log().ExtraVerbosef("iam.DeleteAccessKey call took %s", time.Since(start))
+	output, err := cmd.api.DeleteInternetGateway(input)
+	renv.Log().ExtraVerbosef("ec2.DeleteInternetGateway call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.DeleteInternetGateway")
 	}
 
 	var extracted interface{}
@@ -6447,14 +10603,14 @@ func (cmd *DeleteAccesskey) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete accesskey: AWS command returned nil output")
+			renv.Log().Warning("delete internetgateway: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete accesskey '%s' done", extracted)
+		renv.Log().Verbosef("delete internetgateway '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete accesskey done")
+		renv.Log().Verbose("delete internetgateway done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -6466,40 +10622,64 @@ func (cmd *DeleteAccesskey) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *DeleteAccesskey) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("accesskey"), nil
+func (cmd *DeleteInternetgateway) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.DeleteInternetGatewayInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteInternetGatewayInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DeleteInternetGateway(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.DeleteInternetGateway call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete internetgateway ok")
+			return fakeDryRunId("internetgateway"), nil
+		}
+	}
+
+	return nil, err
 }
 
-func (cmd *DeleteAccesskey) inject(params map[string]interface{}) error {
+func (cmd *DeleteInternetgateway) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteAlarm(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteAlarm {
-	cmd := new(DeleteAlarm)
+func NewDeleteKeypair(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteKeypair {
+	cmd := new(DeleteKeypair)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = cloudwatch.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteAlarm) SetApi(api cloudwatchiface.CloudWatchAPI) {
+func (cmd *DeleteKeypair) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteAlarm) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteKeypair) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteAlarm) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteKeypair) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -6510,15 +10690,15 @@ func (cmd *DeleteAlarm) run(renv env.Running, params map[string]interface{}) (in
 		}
 	}
 
-	input := &cloudwatch.DeleteAlarmsInput{}
+	input := &ec2.DeleteKeyPairInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in cloudwatch.DeleteAlarmsInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteKeyPairInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteAlarms(input)
-	renv.Log().ExtraVerbosef("cloudwatch.DeleteAlarms call took %s", time.Since(start))
+	output, err := cmd.api.DeleteKeyPair(input)
+	renv.Log().ExtraVerbosef("ec2.DeleteKeyPair call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.DeleteKeyPair")
 	}
 
 	var extracted interface{}
@@ -6526,14 +10706,14 @@ func (cmd *DeleteAlarm) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete alarm: AWS command returned nil output")
+			renv.Log().Warning("delete keypair: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete alarm '%s' done", extracted)
+		renv.Log().Verbosef("delete keypair '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete alarm done")
+		renv.Log().Verbose("delete keypair done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -6545,40 +10725,64 @@ func (cmd *DeleteAlarm) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *DeleteAlarm) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("alarm"), nil
+func (cmd *DeleteKeypair) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.DeleteKeyPairInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteKeyPairInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DeleteKeyPair(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.DeleteKeyPair call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete keypair ok")
+			return fakeDryRunId("keypair"), nil
+		}
+	}
+
+	return nil, err
 }
 
-func (cmd *DeleteAlarm) inject(params map[string]interface{}) error {
+func (cmd *DeleteKeypair) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteAppscalingpolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteAppscalingpolicy {
-	cmd := new(DeleteAppscalingpolicy)
+func NewDeleteLaunchconfiguration(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteLaunchconfiguration {
+	cmd := new(DeleteLaunchconfiguration)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = applicationautoscaling.New(sess)
+		cmd.api = autoscaling.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteAppscalingpolicy) SetApi(api applicationautoscalingiface.ApplicationAutoScalingAPI) {
+func (cmd *DeleteLaunchconfiguration) SetApi(api autoscalingiface.AutoScalingAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteAppscalingpolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteLaunchconfiguration) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteAppscalingpolicy) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteLaunchconfiguration) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -6589,15 +10793,15 @@ func (cmd *DeleteAppscalingpolicy) run(renv env.Running, params map[string]inter
 		}
 	}
 
-	input := &applicationautoscaling.DeleteScalingPolicyInput{}
+	input := &autoscaling.DeleteLaunchConfigurationInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in applicationautoscaling.DeleteScalingPolicyInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in autoscaling.DeleteLaunchConfigurationInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteScalingPolicy(input)
-	renv.Log().ExtraVerbosef("applicationautoscaling.DeleteScalingPolicy call took %s", time.Since(start))
+	output, err := cmd.api.DeleteLaunchConfiguration(input)
+	renv.Log().ExtraVerbosef("autoscaling.DeleteLaunchConfiguration call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "autoscaling.DeleteLaunchConfiguration")
 	}
 
 	var extracted interface{}
@@ -6605,14 +10809,14 @@ func (cmd *DeleteAppscalingpolicy) run(renv env.Running, params map[string]inter
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete appscalingpolicy: AWS command returned nil output")
+			renv.Log().Warning("delete launchconfiguration: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete appscalingpolicy '%s' done", extracted)
+		renv.Log().Verbosef("delete launchconfiguration '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete appscalingpolicy done")
+		renv.Log().Verbose("delete launchconfiguration done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -6624,40 +10828,43 @@ func (cmd *DeleteAppscalingpolicy) run(renv env.Running, params map[string]inter
 	return extracted, nil
 }
 
-func (cmd *DeleteAppscalingpolicy) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("appscalingpolicy"), nil
+func (cmd *DeleteLaunchconfiguration) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("launchconfiguration"), nil
 }
 
-func (cmd *DeleteAppscalingpolicy) inject(params map[string]interface{}) error {
+func (cmd *DeleteLaunchconfiguration) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteAppscalingtarget(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteAppscalingtarget {
-	cmd := new(DeleteAppscalingtarget)
+func NewDeleteListener(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteListener {
+	cmd := new(DeleteListener)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = applicationautoscaling.New(sess)
+		cmd.api = elbv2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteAppscalingtarget) SetApi(api applicationautoscalingiface.ApplicationAutoScalingAPI) {
+func (cmd *DeleteListener) SetApi(api elbv2iface.ELBV2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteAppscalingtarget) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteListener) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteAppscalingtarget) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteListener) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -6668,15 +10875,15 @@ func (cmd *DeleteAppscalingtarget) run(renv env.Running, params map[string]inter
 		}
 	}
 
-	input := &applicationautoscaling.DeregisterScalableTargetInput{}
+	input := &elbv2.DeleteListenerInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in applicationautoscaling.DeregisterScalableTargetInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in elbv2.DeleteListenerInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeregisterScalableTarget(input)
-	renv.Log().ExtraVerbosef("applicationautoscaling.DeregisterScalableTarget call took %s", time.Since(start))
+	output, err := cmd.api.DeleteListener(input)
+	renv.Log().ExtraVerbosef("elbv2.DeleteListener call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "elbv2.DeleteListener")
 	}
 
 	var extracted interface{}
@@ -6684,14 +10891,14 @@ func (cmd *DeleteAppscalingtarget) run(renv env.Running, params map[string]inter
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete appscalingtarget: AWS command returned nil output")
+			renv.Log().Warning("delete listener: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete appscalingtarget '%s' done", extracted)
+		renv.Log().Verbosef("delete listener '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete appscalingtarget done")
+		renv.Log().Verbose("delete listener done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -6703,40 +10910,43 @@ func (cmd *DeleteAppscalingtarget) run(renv env.Running, params map[string]inter
 	return extracted, nil
 }
 
-func (cmd *DeleteAppscalingtarget) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("appscalingtarget"), nil
+func (cmd *DeleteListener) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("listener"), nil
 }
 
-func (cmd *DeleteAppscalingtarget) inject(params map[string]interface{}) error {
+func (cmd *DeleteListener) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteBucket(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteBucket {
-	cmd := new(DeleteBucket)
+func NewDeleteLoadbalancer(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteLoadbalancer {
+	cmd := new(DeleteLoadbalancer)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = s3.New(sess)
+		cmd.api = elbv2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteBucket) SetApi(api s3iface.S3API) {
+func (cmd *DeleteLoadbalancer) SetApi(api elbv2iface.ELBV2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteBucket) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteLoadbalancer) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteBucket) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteLoadbalancer) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -6747,15 +10957,15 @@ func (cmd *DeleteBucket) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	input := &s3.DeleteBucketInput{}
+	input := &elbv2.DeleteLoadBalancerInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in s3.DeleteBucketInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in elbv2.DeleteLoadBalancerInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteBucket(input)
-	renv.Log().ExtraVerbosef("s3.DeleteBucket call took %s", time.Since(start))
+	output, err := cmd.api.DeleteLoadBalancer(input)
+	renv.Log().ExtraVerbosef("elbv2.DeleteLoadBalancer call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "elbv2.DeleteLoadBalancer")
 	}
 
 	var extracted interface{}
@@ -6763,14 +10973,14 @@ func (cmd *DeleteBucket) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete bucket: AWS command returned nil output")
+			renv.Log().Warning("delete loadbalancer: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete bucket '%s' done", extracted)
+		renv.Log().Verbosef("delete loadbalancer '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete bucket done")
+		renv.Log().Verbose("delete loadbalancer done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -6782,40 +10992,43 @@ func (cmd *DeleteBucket) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *DeleteBucket) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("bucket"), nil
+func (cmd *DeleteLoadbalancer) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("loadbalancer"), nil
 }
 
-func (cmd *DeleteBucket) inject(params map[string]interface{}) error {
+func (cmd *DeleteLoadbalancer) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteCertificate(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteCertificate {
-	cmd := new(DeleteCertificate)
+func NewDeleteLoginprofile(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteLoginprofile {
+	cmd := new(DeleteLoginprofile)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = acm.New(sess)
+		cmd.api = iam.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteCertificate) SetApi(api acmiface.ACMAPI) {
+func (cmd *DeleteLoginprofile) SetApi(api iamiface.IAMAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteCertificate) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteLoginprofile) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteCertificate) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteLoginprofile) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -6826,15 +11039,15 @@ func (cmd *DeleteCertificate) run(renv env.Running, params map[string]interface{
 		}
 	}
 
-	input := &acm.DeleteCertificateInput{}
+	input := &iam.DeleteLoginProfileInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in acm.DeleteCertificateInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in iam.DeleteLoginProfileInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteCertificate(input)
-	renv.Log().ExtraVerbosef("acm.DeleteCertificate call took %s", time.Since(start))
+	output, err := cmd.api.DeleteLoginProfile(input)
+	renv.Log().ExtraVerbosef("iam.DeleteLoginProfile call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "iam.DeleteLoginProfile")
 	}
 
 	var extracted interface{}
@@ -6842,14 +11055,14 @@ func (cmd *DeleteCertificate) run(renv env.Running, params map[string]interface{
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete certificate: AWS command returned nil output")
+			renv.Log().Warning("delete loginprofile: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete certificate '%s' done", extracted)
+		renv.Log().Verbosef("delete loginprofile '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete certificate done")
+		renv.Log().Verbose("delete loginprofile done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -6861,40 +11074,43 @@ func (cmd *DeleteCertificate) run(renv env.Running, params map[string]interface{
 	return extracted, nil
 }
 
-func (cmd *DeleteCertificate) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("certificate"), nil
+func (cmd *DeleteLoginprofile) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("loginprofile"), nil
 }
 
-func (cmd *DeleteCertificate) inject(params map[string]interface{}) error {
+func (cmd *DeleteLoginprofile) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteClassicLoadbalancer(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteClassicLoadbalancer {
-	cmd := new(DeleteClassicLoadbalancer)
+func NewDeleteMfadevice(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteMfadevice {
+	cmd := new(DeleteMfadevice)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = elb.New(sess)
+		cmd.api = iam.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteClassicLoadbalancer) SetApi(api elbiface.ELBAPI) {
+func (cmd *DeleteMfadevice) SetApi(api iamiface.IAMAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteClassicLoadbalancer) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteMfadevice) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteClassicLoadbalancer) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteMfadevice) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -6905,15 +11121,15 @@ func (cmd *DeleteClassicLoadbalancer) run(renv env.Running, params map[string]in
 		}
 	}
 
-	input := &elb.DeleteLoadBalancerInput{}
+	input := &iam.DeleteVirtualMFADeviceInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in elb.DeleteLoadBalancerInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in iam.DeleteVirtualMFADeviceInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteLoadBalancer(input)
-	renv.Log().ExtraVerbosef("elb.DeleteLoadBalancer call took %s", time.Since(start))
+	output, err := cmd.api.DeleteVirtualMFADevice(input)
+	renv.Log().ExtraVerbosef("iam.DeleteVirtualMFADevice call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "iam.DeleteVirtualMFADevice")
 	}
 
 	var extracted interface{}
@@ -6921,14 +11137,14 @@ func (cmd *DeleteClassicLoadbalancer) run(renv env.Running, params map[string]in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete classicloadbalancer: AWS command returned nil output")
+			renv.Log().Warning("delete mfadevice: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete classicloadbalancer '%s' done", extracted)
+		renv.Log().Verbosef("delete mfadevice '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete classicloadbalancer done")
+		renv.Log().Verbose("delete mfadevice done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -6940,40 +11156,43 @@ func (cmd *DeleteClassicLoadbalancer) run(renv env.Running, params map[string]in
 	return extracted, nil
 }
 
-func (cmd *DeleteClassicLoadbalancer) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("classicloadbalancer"), nil
+func (cmd *DeleteMfadevice) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("mfadevice"), nil
 }
 
-func (cmd *DeleteClassicLoadbalancer) inject(params map[string]interface{}) error {
+func (cmd *DeleteMfadevice) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteContainercluster(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteContainercluster {
-	cmd := new(DeleteContainercluster)
+func NewDeleteNatgateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteNatgateway {
+	cmd := new(DeleteNatgateway)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ecs.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteContainercluster) SetApi(api ecsiface.ECSAPI) {
+func (cmd *DeleteNatgateway) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteContainercluster) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteNatgateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteContainercluster) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteNatgateway) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -6984,15 +11203,15 @@ func (cmd *DeleteContainercluster) run(renv env.Running, params map[string]inter
 		}
 	}
 
-	input := &ecs.DeleteClusterInput{}
+	input := &ec2.DeleteNatGatewayInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ecs.DeleteClusterInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteNatGatewayInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteCluster(input)
-	renv.Log().ExtraVerbosef("ecs.DeleteCluster call took %s", time.Since(start))
+	output, err := cmd.api.DeleteNatGateway(input)
+	renv.Log().ExtraVerbosef("ec2.DeleteNatGateway call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.DeleteNatGateway")
 	}
 
 	var extracted interface{}
@@ -7000,14 +11219,14 @@ func (cmd *DeleteContainercluster) run(renv env.Running, params map[string]inter
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete containercluster: AWS command returned nil output")
+			renv.Log().Warning("delete natgateway: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete containercluster '%s' done", extracted)
+		renv.Log().Verbosef("delete natgateway '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete containercluster done")
+		renv.Log().Verbose("delete natgateway done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -7019,40 +11238,43 @@ func (cmd *DeleteContainercluster) run(renv env.Running, params map[string]inter
 	return extracted, nil
 }
 
-func (cmd *DeleteContainercluster) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("containercluster"), nil
+func (cmd *DeleteNatgateway) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("natgateway"), nil
 }
 
-func (cmd *DeleteContainercluster) inject(params map[string]interface{}) error {
+func (cmd *DeleteNatgateway) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteContainertask(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteContainertask {
-	cmd := new(DeleteContainertask)
+func NewDeleteNetworkinterface(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteNetworkinterface {
+	cmd := new(DeleteNetworkinterface)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ecs.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteContainertask) SetApi(api ecsiface.ECSAPI) {
+func (cmd *DeleteNetworkinterface) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteContainertask) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteNetworkinterface) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteContainertask) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteNetworkinterface) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -7063,9 +11285,15 @@ func (cmd *DeleteContainertask) run(renv env.Running, params map[string]interfac
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &ec2.DeleteNetworkInterfaceInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteNetworkInterfaceInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteNetworkInterface(input)
+	renv.Log().ExtraVerbosef("ec2.DeleteNetworkInterface call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.DeleteNetworkInterface")
 	}
 
 	var extracted interface{}
@@ -7073,14 +11301,14 @@ func (cmd *DeleteContainertask) run(renv env.Running, params map[string]interfac
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete containertask: AWS command returned nil output")
+			renv.Log().Warning("delete networkinterface: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete containertask '%s' done", extracted)
+		renv.Log().Verbosef("delete networkinterface '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete containertask done")
+		renv.Log().Verbose("delete networkinterface done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -7092,36 +11320,64 @@ func (cmd *DeleteContainertask) run(renv env.Running, params map[string]interfac
 	return extracted, nil
 }
 
-func (cmd *DeleteContainertask) inject(params map[string]interface{}) error {
+func (cmd *DeleteNetworkinterface) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.DeleteNetworkInterfaceInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteNetworkInterfaceInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DeleteNetworkInterface(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.DeleteNetworkInterface call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete networkinterface ok")
+			return fakeDryRunId("networkinterface"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *DeleteNetworkinterface) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteDatabase(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteDatabase {
-	cmd := new(DeleteDatabase)
+func NewDeletePolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeletePolicy {
+	cmd := new(DeletePolicy)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = rds.New(sess)
+		cmd.api = iam.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteDatabase) SetApi(api rdsiface.RDSAPI) {
+func (cmd *DeletePolicy) SetApi(api iamiface.IAMAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteDatabase) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeletePolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteDatabase) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeletePolicy) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -7132,15 +11388,15 @@ func (cmd *DeleteDatabase) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &rds.DeleteDBInstanceInput{}
+	input := &iam.DeletePolicyInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in rds.DeleteDBInstanceInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in iam.DeletePolicyInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteDBInstance(input)
-	renv.Log().ExtraVerbosef("rds.DeleteDBInstance call took %s", time.Since(start))
+	output, err := cmd.api.DeletePolicy(input)
+	renv.Log().ExtraVerbosef("iam.DeletePolicy call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "iam.DeletePolicy")
 	}
 
 	var extracted interface{}
@@ -7148,14 +11404,14 @@ func (cmd *DeleteDatabase) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete database: AWS command returned nil output")
+			renv.Log().Warning("delete policy: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete database '%s' done", extracted)
+		renv.Log().Verbosef("delete policy '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete database done")
+		renv.Log().Verbose("delete policy done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -7167,40 +11423,43 @@ func (cmd *DeleteDatabase) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *DeleteDatabase) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("database"), nil
+func (cmd *DeletePolicy) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("policy"), nil
 }
 
-func (cmd *DeleteDatabase) inject(params map[string]interface{}) error {
+func (cmd *DeletePolicy) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteDbsubnetgroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteDbsubnetgroup {
-	cmd := new(DeleteDbsubnetgroup)
+func NewDeleteQueue(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteQueue {
+	cmd := new(DeleteQueue)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = rds.New(sess)
+		cmd.api = sqs.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteDbsubnetgroup) SetApi(api rdsiface.RDSAPI) {
+func (cmd *DeleteQueue) SetApi(api sqsiface.SQSAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteDbsubnetgroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteQueue) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteDbsubnetgroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteQueue) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -7211,15 +11470,15 @@ func (cmd *DeleteDbsubnetgroup) run(renv env.Running, params map[string]interfac
 		}
 	}
 
-	input := &rds.DeleteDBSubnetGroupInput{}
+	input := &sqs.DeleteQueueInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in rds.DeleteDBSubnetGroupInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in sqs.DeleteQueueInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteDBSubnetGroup(input)
-	renv.Log().ExtraVerbosef("rds.DeleteDBSubnetGroup call took %s", time.Since(start))
+	output, err := cmd.api.DeleteQueue(input)
+	renv.Log().ExtraVerbosef("sqs.DeleteQueue call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "sqs.DeleteQueue")
 	}
 
 	var extracted interface{}
@@ -7227,14 +11486,14 @@ func (cmd *DeleteDbsubnetgroup) run(renv env.Running, params map[string]interfac
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete dbsubnetgroup: AWS command returned nil output")
+			renv.Log().Warning("delete queue: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete dbsubnetgroup '%s' done", extracted)
+		renv.Log().Verbosef("delete queue '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete dbsubnetgroup done")
+		renv.Log().Verbose("delete queue done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -7246,40 +11505,43 @@ func (cmd *DeleteDbsubnetgroup) run(renv env.Running, params map[string]interfac
 	return extracted, nil
 }
 
-func (cmd *DeleteDbsubnetgroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("dbsubnetgroup"), nil
+func (cmd *DeleteQueue) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("queue"), nil
 }
 
-func (cmd *DeleteDbsubnetgroup) inject(params map[string]interface{}) error {
+func (cmd *DeleteQueue) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteDistribution(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteDistribution {
-	cmd := new(DeleteDistribution)
+func NewDeleteRecord(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteRecord {
+	cmd := new(DeleteRecord)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = cloudfront.New(sess)
+		cmd.api = route53.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteDistribution) SetApi(api cloudfrontiface.CloudFrontAPI) {
+func (cmd *DeleteRecord) SetApi(api route53iface.Route53API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteDistribution) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteRecord) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteDistribution) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteRecord) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -7292,7 +11554,7 @@ func (cmd *DeleteDistribution) run(renv env.Running, params map[string]interface
 
 	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "route53.")
 	}
 
 	var extracted interface{}
@@ -7300,14 +11562,14 @@ func (cmd *DeleteDistribution) run(renv env.Running, params map[string]interface
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete distribution: AWS command returned nil output")
+			renv.Log().Warning("delete record: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete distribution '%s' done", extracted)
+		renv.Log().Verbosef("delete record '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete distribution done")
+		renv.Log().Verbose("delete record done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -7319,40 +11581,43 @@ func (cmd *DeleteDistribution) run(renv env.Running, params map[string]interface
 	return extracted, nil
 }
 
-func (cmd *DeleteDistribution) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("distribution"), nil
+func (cmd *DeleteRecord) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("record"), nil
 }
 
-func (cmd *DeleteDistribution) inject(params map[string]interface{}) error {
+func (cmd *DeleteRecord) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteElasticip(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteElasticip {
-	cmd := new(DeleteElasticip)
+func NewDeleteRecords(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteRecords {
+	cmd := new(DeleteRecords)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = route53.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteElasticip) SetApi(api ec2iface.EC2API) {
+func (cmd *DeleteRecords) SetApi(api route53iface.Route53API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteElasticip) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteRecords) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteElasticip) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteRecords) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -7363,15 +11628,9 @@ func (cmd *DeleteElasticip) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &ec2.ReleaseAddressInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.ReleaseAddressInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.ReleaseAddress(input)
-	renv.Log().ExtraVerbosef("ec2.ReleaseAddress call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "route53.")
 	}
 
 	var extracted interface{}
@@ -7379,14 +11638,14 @@ func (cmd *DeleteElasticip) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete elasticip: AWS command returned nil output")
+			renv.Log().Warning("delete records: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete elasticip '%s' done", extracted)
+		renv.Log().Verbosef("delete records '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete elasticip done")
+		renv.Log().Verbose("delete records done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -7398,61 +11657,43 @@ func (cmd *DeleteElasticip) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *DeleteElasticip) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.ReleaseAddressInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.ReleaseAddressInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.ReleaseAddress(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.ReleaseAddress call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: delete elasticip ok")
-			return fakeDryRunId("elasticip"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *DeleteRecords) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("records"), nil
 }
 
-func (cmd *DeleteElasticip) inject(params map[string]interface{}) error {
+func (cmd *DeleteRecords) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteFunction(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteFunction {
-	cmd := new(DeleteFunction)
+func NewDeleteRepository(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteRepository {
+	cmd := new(DeleteRepository)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = lambda.New(sess)
+		cmd.api = ecr.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteFunction) SetApi(api lambdaiface.LambdaAPI) {
+func (cmd *DeleteRepository) SetApi(api ecriface.ECRAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteFunction) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteRepository) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteFunction) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteRepository) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -7463,15 +11704,15 @@ func (cmd *DeleteFunction) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &lambda.DeleteFunctionInput{}
+	input := &ecr.DeleteRepositoryInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in lambda.DeleteFunctionInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ecr.DeleteRepositoryInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteFunction(input)
-	renv.Log().ExtraVerbosef("lambda.DeleteFunction call took %s", time.Since(start))
+	output, err := cmd.api.DeleteRepository(input)
+	renv.Log().ExtraVerbosef("ecr.DeleteRepository call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ecr.DeleteRepository")
 	}
 
 	var extracted interface{}
@@ -7479,14 +11720,14 @@ func (cmd *DeleteFunction) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete function: AWS command returned nil output")
+			renv.Log().Warning("delete repository: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete function '%s' done", extracted)
+		renv.Log().Verbosef("delete repository '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete function done")
+		renv.Log().Verbose("delete repository done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -7498,16 +11739,16 @@ func (cmd *DeleteFunction) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *DeleteFunction) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("function"), nil
+func (cmd *DeleteRepository) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("repository"), nil
 }
 
-func (cmd *DeleteFunction) inject(params map[string]interface{}) error {
+func (cmd *DeleteRepository) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteGroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteGroup {
-	cmd := new(DeleteGroup)
+func NewDeleteRole(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteRole {
+	cmd := new(DeleteRole)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -7520,18 +11761,21 @@ func NewDeleteGroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger
 	return cmd
 }
 
-func (cmd *DeleteGroup) SetApi(api iamiface.IAMAPI) {
+func (cmd *DeleteRole) SetApi(api iamiface.IAMAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteGroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteRole) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteGroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteRole) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -7542,15 +11786,9 @@ func (cmd *DeleteGroup) run(renv env.Running, params map[string]interface{}) (in
 		}
 	}
 
-	input := &iam.DeleteGroupInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.DeleteGroupInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.DeleteGroup(input)
-	renv.Log().ExtraVerbosef("iam.DeleteGroup call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "iam.")
 	}
 
 	var extracted interface{}
@@ -7558,14 +11796,14 @@ func (cmd *DeleteGroup) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete group: AWS command returned nil output")
+			renv.Log().Warning("delete role: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete group '%s' done", extracted)
+		renv.Log().Verbosef("delete role '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete group done")
+		renv.Log().Verbose("delete role done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -7577,16 +11815,16 @@ func (cmd *DeleteGroup) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *DeleteGroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("group"), nil
+func (cmd *DeleteRole) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("role"), nil
 }
 
-func (cmd *DeleteGroup) inject(params map[string]interface{}) error {
+func (cmd *DeleteRole) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteImage(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteImage {
-	cmd := new(DeleteImage)
+func NewDeleteRoute(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteRoute {
+	cmd := new(DeleteRoute)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -7599,18 +11837,21 @@ func NewDeleteImage(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger
 	return cmd
 }
 
-func (cmd *DeleteImage) SetApi(api ec2iface.EC2API) {
+func (cmd *DeleteRoute) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteImage) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteRoute) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteImage) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteRoute) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -7621,9 +11862,15 @@ func (cmd *DeleteImage) run(renv env.Running, params map[string]interface{}) (in
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &ec2.DeleteRouteInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteRouteInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteRoute(input)
+	renv.Log().ExtraVerbosef("ec2.DeleteRoute call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.DeleteRoute")
 	}
 
 	var extracted interface{}
@@ -7631,14 +11878,14 @@ func (cmd *DeleteImage) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete image: AWS command returned nil output")
+			renv.Log().Warning("delete route: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete image '%s' done", extracted)
+		renv.Log().Verbosef("delete route '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete image done")
+		renv.Log().Verbose("delete route done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -7650,12 +11897,37 @@ func (cmd *DeleteImage) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *DeleteImage) inject(params map[string]interface{}) error {
+func (cmd *DeleteRoute) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.DeleteRouteInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteRouteInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DeleteRoute(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.DeleteRoute call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete route ok")
+			return fakeDryRunId("route"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *DeleteRoute) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteInstance {
-	cmd := new(DeleteInstance)
+func NewDeleteRoutetable(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteRoutetable {
+	cmd := new(DeleteRoutetable)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -7668,18 +11940,21 @@ func NewDeleteInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Log
 	return cmd
 }
 
-func (cmd *DeleteInstance) SetApi(api ec2iface.EC2API) {
+func (cmd *DeleteRoutetable) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteRoutetable) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteInstance) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteRoutetable) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -7690,15 +11965,15 @@ func (cmd *DeleteInstance) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &ec2.TerminateInstancesInput{}
+	input := &ec2.DeleteRouteTableInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.TerminateInstancesInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteRouteTableInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.TerminateInstances(input)
-	renv.Log().ExtraVerbosef("ec2.TerminateInstances call took %s", time.Since(start))
+	output, err := cmd.api.DeleteRouteTable(input)
+	renv.Log().ExtraVerbosef("ec2.DeleteRouteTable call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.DeleteRouteTable")
 	}
 
 	var extracted interface{}
@@ -7706,14 +11981,14 @@ func (cmd *DeleteInstance) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete instance: AWS command returned nil output")
+			renv.Log().Warning("delete routetable: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete instance '%s' done", extracted)
+		renv.Log().Verbosef("delete routetable '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete instance done")
+		renv.Log().Verbose("delete routetable done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -7725,61 +12000,64 @@ func (cmd *DeleteInstance) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *DeleteInstance) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteRoutetable) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
 
-	input := &ec2.TerminateInstancesInput{}
+	input := &ec2.DeleteRouteTableInput{}
 	input.SetDryRun(true)
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.TerminateInstancesInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteRouteTableInput: %s", err)
 	}
 
 	start := time.Now()
-	_, err := cmd.api.TerminateInstances(input)
+	_, err := cmd.api.DeleteRouteTable(input)
 	if awsErr, ok := err.(awserr.Error); ok {
 		switch code := awsErr.Code(); {
 		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.TerminateInstances call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: delete instance ok")
-			return fakeDryRunId("instance"), nil
+			renv.Log().ExtraVerbosef("dry run: ec2.DeleteRouteTable call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete routetable ok")
+			return fakeDryRunId("routetable"), nil
 		}
 	}
 
 	return nil, err
 }
 
-func (cmd *DeleteInstance) inject(params map[string]interface{}) error {
+func (cmd *DeleteRoutetable) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteInstanceprofile(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteInstanceprofile {
-	cmd := new(DeleteInstanceprofile)
+func NewDeleteS3object(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteS3object {
+	cmd := new(DeleteS3object)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = s3.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteInstanceprofile) SetApi(api iamiface.IAMAPI) {
+func (cmd *DeleteS3object) SetApi(api s3iface.S3API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteInstanceprofile) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteS3object) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteInstanceprofile) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteS3object) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -7790,15 +12068,15 @@ func (cmd *DeleteInstanceprofile) run(renv env.Running, params map[string]interf
 		}
 	}
 
-	input := &iam.DeleteInstanceProfileInput{}
+	input := &s3.DeleteObjectInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.DeleteInstanceProfileInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in s3.DeleteObjectInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteInstanceProfile(input)
-	renv.Log().ExtraVerbosef("iam.DeleteInstanceProfile call took %s", time.Since(start))
+	output, err := cmd.api.DeleteObject(input)
+	renv.Log().ExtraVerbosef("s3.DeleteObject call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "s3.DeleteObject")
 	}
 
 	var extracted interface{}
@@ -7806,14 +12084,14 @@ func (cmd *DeleteInstanceprofile) run(renv env.Running, params map[string]interf
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete instanceprofile: AWS command returned nil output")
+			renv.Log().Warning("delete s3object: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete instanceprofile '%s' done", extracted)
+		renv.Log().Verbosef("delete s3object '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete instanceprofile done")
+		renv.Log().Verbose("delete s3object done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -7825,40 +12103,43 @@ func (cmd *DeleteInstanceprofile) run(renv env.Running, params map[string]interf
 	return extracted, nil
 }
 
-func (cmd *DeleteInstanceprofile) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("instanceprofile"), nil
+func (cmd *DeleteS3object) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("s3object"), nil
 }
 
-func (cmd *DeleteInstanceprofile) inject(params map[string]interface{}) error {
+func (cmd *DeleteS3object) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteInternetgateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteInternetgateway {
-	cmd := new(DeleteInternetgateway)
+func NewDeleteScalinggroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteScalinggroup {
+	cmd := new(DeleteScalinggroup)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = autoscaling.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteInternetgateway) SetApi(api ec2iface.EC2API) {
+func (cmd *DeleteScalinggroup) SetApi(api autoscalingiface.AutoScalingAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteInternetgateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteScalinggroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteInternetgateway) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteScalinggroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -7869,15 +12150,15 @@ func (cmd *DeleteInternetgateway) run(renv env.Running, params map[string]interf
 		}
 	}
 
-	input := &ec2.DeleteInternetGatewayInput{}
+	input := &autoscaling.DeleteAutoScalingGroupInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteInternetGatewayInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in autoscaling.DeleteAutoScalingGroupInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteInternetGateway(input)
-	renv.Log().ExtraVerbosef("ec2.DeleteInternetGateway call took %s", time.Since(start))
+	output, err := cmd.api.DeleteAutoScalingGroup(input)
+	renv.Log().ExtraVerbosef("autoscaling.DeleteAutoScalingGroup call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "autoscaling.DeleteAutoScalingGroup")
 	}
 
 	var extracted interface{}
@@ -7885,14 +12166,14 @@ func (cmd *DeleteInternetgateway) run(renv env.Running, params map[string]interf
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete internetgateway: AWS command returned nil output")
+			renv.Log().Warning("delete scalinggroup: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete internetgateway '%s' done", extracted)
+		renv.Log().Verbosef("delete scalinggroup '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete internetgateway done")
+		renv.Log().Verbose("delete scalinggroup done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -7904,61 +12185,43 @@ func (cmd *DeleteInternetgateway) run(renv env.Running, params map[string]interf
 	return extracted, nil
 }
 
-func (cmd *DeleteInternetgateway) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.DeleteInternetGatewayInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteInternetGatewayInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.DeleteInternetGateway(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.DeleteInternetGateway call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: delete internetgateway ok")
-			return fakeDryRunId("internetgateway"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *DeleteScalinggroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("scalinggroup"), nil
 }
 
-func (cmd *DeleteInternetgateway) inject(params map[string]interface{}) error {
+func (cmd *DeleteScalinggroup) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteKeypair(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteKeypair {
-	cmd := new(DeleteKeypair)
+func NewDeleteScalingpolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteScalingpolicy {
+	cmd := new(DeleteScalingpolicy)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = autoscaling.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteKeypair) SetApi(api ec2iface.EC2API) {
+func (cmd *DeleteScalingpolicy) SetApi(api autoscalingiface.AutoScalingAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteKeypair) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteScalingpolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteKeypair) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteScalingpolicy) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -7969,15 +12232,15 @@ func (cmd *DeleteKeypair) run(renv env.Running, params map[string]interface{}) (
 		}
 	}
 
-	input := &ec2.DeleteKeyPairInput{}
+	input := &autoscaling.DeletePolicyInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteKeyPairInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in autoscaling.DeletePolicyInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteKeyPair(input)
-	renv.Log().ExtraVerbosef("ec2.DeleteKeyPair call took %s", time.Since(start))
+	output, err := cmd.api.DeletePolicy(input)
+	renv.Log().ExtraVerbosef("autoscaling.DeletePolicy call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "autoscaling.DeletePolicy")
 	}
 
 	var extracted interface{}
@@ -7985,14 +12248,14 @@ func (cmd *DeleteKeypair) run(renv env.Running, params map[string]interface{}) (
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete keypair: AWS command returned nil output")
+			renv.Log().Warning("delete scalingpolicy: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete keypair '%s' done", extracted)
+		renv.Log().Verbosef("delete scalingpolicy '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete keypair done")
+		renv.Log().Verbose("delete scalingpolicy done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -8004,61 +12267,43 @@ func (cmd *DeleteKeypair) run(renv env.Running, params map[string]interface{}) (
 	return extracted, nil
 }
 
-func (cmd *DeleteKeypair) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.DeleteKeyPairInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteKeyPairInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.DeleteKeyPair(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.DeleteKeyPair call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: delete keypair ok")
-			return fakeDryRunId("keypair"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *DeleteScalingpolicy) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("scalingpolicy"), nil
 }
 
-func (cmd *DeleteKeypair) inject(params map[string]interface{}) error {
+func (cmd *DeleteScalingpolicy) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteLaunchconfiguration(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteLaunchconfiguration {
-	cmd := new(DeleteLaunchconfiguration)
+func NewDeleteSecuritygroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteSecuritygroup {
+	cmd := new(DeleteSecuritygroup)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = autoscaling.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteLaunchconfiguration) SetApi(api autoscalingiface.AutoScalingAPI) {
+func (cmd *DeleteSecuritygroup) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteLaunchconfiguration) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteSecuritygroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteLaunchconfiguration) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteSecuritygroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -8069,15 +12314,15 @@ func (cmd *DeleteLaunchconfiguration) run(renv env.Running, params map[string]in
 		}
 	}
 
-	input := &autoscaling.DeleteLaunchConfigurationInput{}
+	input := &ec2.DeleteSecurityGroupInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in autoscaling.DeleteLaunchConfigurationInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteSecurityGroupInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteLaunchConfiguration(input)
-	renv.Log().ExtraVerbosef("autoscaling.DeleteLaunchConfiguration call took %s", time.Since(start))
+	output, err := cmd.api.DeleteSecurityGroup(input)
+	renv.Log().ExtraVerbosef("ec2.DeleteSecurityGroup call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.DeleteSecurityGroup")
 	}
 
 	var extracted interface{}
@@ -8085,14 +12330,14 @@ func (cmd *DeleteLaunchconfiguration) run(renv env.Running, params map[string]in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete launchconfiguration: AWS command returned nil output")
+			renv.Log().Warning("delete securitygroup: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete launchconfiguration '%s' done", extracted)
+		renv.Log().Verbosef("delete securitygroup '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete launchconfiguration done")
+		renv.Log().Verbose("delete securitygroup done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -8104,40 +12349,64 @@ func (cmd *DeleteLaunchconfiguration) run(renv env.Running, params map[string]in
 	return extracted, nil
 }
 
-func (cmd *DeleteLaunchconfiguration) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("launchconfiguration"), nil
+func (cmd *DeleteSecuritygroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.DeleteSecurityGroupInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteSecurityGroupInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DeleteSecurityGroup(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.DeleteSecurityGroup call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete securitygroup ok")
+			return fakeDryRunId("securitygroup"), nil
+		}
+	}
+
+	return nil, err
 }
 
-func (cmd *DeleteLaunchconfiguration) inject(params map[string]interface{}) error {
+func (cmd *DeleteSecuritygroup) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteListener(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteListener {
-	cmd := new(DeleteListener)
+func NewDeleteSnapshot(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteSnapshot {
+	cmd := new(DeleteSnapshot)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = elbv2.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteListener) SetApi(api elbv2iface.ELBV2API) {
+func (cmd *DeleteSnapshot) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteListener) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteSnapshot) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteListener) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteSnapshot) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -8148,15 +12417,15 @@ func (cmd *DeleteListener) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &elbv2.DeleteListenerInput{}
+	input := &ec2.DeleteSnapshotInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in elbv2.DeleteListenerInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteSnapshotInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteListener(input)
-	renv.Log().ExtraVerbosef("elbv2.DeleteListener call took %s", time.Since(start))
+	output, err := cmd.api.DeleteSnapshot(input)
+	renv.Log().ExtraVerbosef("ec2.DeleteSnapshot call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.DeleteSnapshot")
 	}
 
 	var extracted interface{}
@@ -8164,14 +12433,14 @@ func (cmd *DeleteListener) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete listener: AWS command returned nil output")
+			renv.Log().Warning("delete snapshot: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete listener '%s' done", extracted)
+		renv.Log().Verbosef("delete snapshot '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete listener done")
+		renv.Log().Verbose("delete snapshot done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -8183,40 +12452,64 @@ func (cmd *DeleteListener) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *DeleteListener) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("listener"), nil
+func (cmd *DeleteSnapshot) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.DeleteSnapshotInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteSnapshotInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DeleteSnapshot(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.DeleteSnapshot call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete snapshot ok")
+			return fakeDryRunId("snapshot"), nil
+		}
+	}
+
+	return nil, err
 }
 
-func (cmd *DeleteListener) inject(params map[string]interface{}) error {
+func (cmd *DeleteSnapshot) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteLoadbalancer(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteLoadbalancer {
-	cmd := new(DeleteLoadbalancer)
+func NewDeleteStack(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteStack {
+	cmd := new(DeleteStack)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = elbv2.New(sess)
+		cmd.api = cloudformation.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteLoadbalancer) SetApi(api elbv2iface.ELBV2API) {
+func (cmd *DeleteStack) SetApi(api cloudformationiface.CloudFormationAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteLoadbalancer) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteStack) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteLoadbalancer) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteStack) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -8227,15 +12520,15 @@ func (cmd *DeleteLoadbalancer) run(renv env.Running, params map[string]interface
 		}
 	}
 
-	input := &elbv2.DeleteLoadBalancerInput{}
+	input := &cloudformation.DeleteStackInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in elbv2.DeleteLoadBalancerInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in cloudformation.DeleteStackInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteLoadBalancer(input)
-	renv.Log().ExtraVerbosef("elbv2.DeleteLoadBalancer call took %s", time.Since(start))
+	output, err := cmd.api.DeleteStack(input)
+	renv.Log().ExtraVerbosef("cloudformation.DeleteStack call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "cloudformation.DeleteStack")
 	}
 
 	var extracted interface{}
@@ -8243,14 +12536,14 @@ func (cmd *DeleteLoadbalancer) run(renv env.Running, params map[string]interface
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete loadbalancer: AWS command returned nil output")
+			renv.Log().Warning("delete stack: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete loadbalancer '%s' done", extracted)
+		renv.Log().Verbosef("delete stack '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete loadbalancer done")
+		renv.Log().Verbose("delete stack done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -8262,40 +12555,43 @@ func (cmd *DeleteLoadbalancer) run(renv env.Running, params map[string]interface
 	return extracted, nil
 }
 
-func (cmd *DeleteLoadbalancer) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("loadbalancer"), nil
+func (cmd *DeleteStack) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("stack"), nil
 }
 
-func (cmd *DeleteLoadbalancer) inject(params map[string]interface{}) error {
+func (cmd *DeleteStack) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteLoginprofile(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteLoginprofile {
-	cmd := new(DeleteLoginprofile)
+func NewDeleteSubnet(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteSubnet {
+	cmd := new(DeleteSubnet)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteLoginprofile) SetApi(api iamiface.IAMAPI) {
+func (cmd *DeleteSubnet) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteLoginprofile) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteSubnet) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteLoginprofile) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteSubnet) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -8306,15 +12602,15 @@ func (cmd *DeleteLoginprofile) run(renv env.Running, params map[string]interface
 		}
 	}
 
-	input := &iam.DeleteLoginProfileInput{}
+	input := &ec2.DeleteSubnetInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.DeleteLoginProfileInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteSubnetInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteLoginProfile(input)
-	renv.Log().ExtraVerbosef("iam.DeleteLoginProfile call took %s", time.Since(start))
+	output, err := cmd.api.DeleteSubnet(input)
+	renv.Log().ExtraVerbosef("ec2.DeleteSubnet call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.DeleteSubnet")
 	}
 
 	var extracted interface{}
@@ -8322,14 +12618,14 @@ func (cmd *DeleteLoginprofile) run(renv env.Running, params map[string]interface
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete loginprofile: AWS command returned nil output")
+			renv.Log().Warning("delete subnet: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete loginprofile '%s' done", extracted)
+		renv.Log().Verbosef("delete subnet '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete loginprofile done")
+		renv.Log().Verbose("delete subnet done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -8341,40 +12637,64 @@ func (cmd *DeleteLoginprofile) run(renv env.Running, params map[string]interface
 	return extracted, nil
 }
 
-func (cmd *DeleteLoginprofile) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("loginprofile"), nil
+func (cmd *DeleteSubnet) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.DeleteSubnetInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteSubnetInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DeleteSubnet(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.DeleteSubnet call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete subnet ok")
+			return fakeDryRunId("subnet"), nil
+		}
+	}
+
+	return nil, err
 }
 
-func (cmd *DeleteLoginprofile) inject(params map[string]interface{}) error {
+func (cmd *DeleteSubnet) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteMfadevice(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteMfadevice {
-	cmd := new(DeleteMfadevice)
+func NewDeleteSubscription(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteSubscription {
+	cmd := new(DeleteSubscription)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = sns.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteMfadevice) SetApi(api iamiface.IAMAPI) {
+func (cmd *DeleteSubscription) SetApi(api snsiface.SNSAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteMfadevice) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteSubscription) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteMfadevice) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteSubscription) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -8385,15 +12705,15 @@ func (cmd *DeleteMfadevice) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &iam.DeleteVirtualMFADeviceInput{}
+	input := &sns.UnsubscribeInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.DeleteVirtualMFADeviceInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in sns.UnsubscribeInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteVirtualMFADevice(input)
-	renv.Log().ExtraVerbosef("iam.DeleteVirtualMFADevice call took %s", time.Since(start))
+	output, err := cmd.api.Unsubscribe(input)
+	renv.Log().ExtraVerbosef("sns.Unsubscribe call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "sns.Unsubscribe")
 	}
 
 	var extracted interface{}
@@ -8401,14 +12721,14 @@ func (cmd *DeleteMfadevice) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete mfadevice: AWS command returned nil output")
+			renv.Log().Warning("delete subscription: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete mfadevice '%s' done", extracted)
+		renv.Log().Verbosef("delete subscription '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete mfadevice done")
+		renv.Log().Verbose("delete subscription done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -8420,40 +12740,43 @@ func (cmd *DeleteMfadevice) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *DeleteMfadevice) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("mfadevice"), nil
+func (cmd *DeleteSubscription) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("subscription"), nil
 }
 
-func (cmd *DeleteMfadevice) inject(params map[string]interface{}) error {
+func (cmd *DeleteSubscription) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteNatgateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteNatgateway {
-	cmd := new(DeleteNatgateway)
+func NewDeleteTable(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteTable {
+	cmd := new(DeleteTable)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = dynamodb.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteNatgateway) SetApi(api ec2iface.EC2API) {
+func (cmd *DeleteTable) SetApi(api dynamodbiface.DynamoDBAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteNatgateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteTable) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteNatgateway) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteTable) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -8464,15 +12787,15 @@ func (cmd *DeleteNatgateway) run(renv env.Running, params map[string]interface{}
 		}
 	}
 
-	input := &ec2.DeleteNatGatewayInput{}
+	input := &dynamodb.DeleteTableInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteNatGatewayInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in dynamodb.DeleteTableInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteNatGateway(input)
-	renv.Log().ExtraVerbosef("ec2.DeleteNatGateway call took %s", time.Since(start))
+	output, err := cmd.api.DeleteTable(input)
+	renv.Log().ExtraVerbosef("dynamodb.DeleteTable call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "dynamodb.DeleteTable")
 	}
 
 	var extracted interface{}
@@ -8480,14 +12803,14 @@ func (cmd *DeleteNatgateway) run(renv env.Running, params map[string]interface{}
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete natgateway: AWS command returned nil output")
+			renv.Log().Warning("delete table: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete natgateway '%s' done", extracted)
+		renv.Log().Verbosef("delete table '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete natgateway done")
+		renv.Log().Verbose("delete table done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -8499,16 +12822,16 @@ func (cmd *DeleteNatgateway) run(renv env.Running, params map[string]interface{}
 	return extracted, nil
 }
 
-func (cmd *DeleteNatgateway) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("natgateway"), nil
+func (cmd *DeleteTable) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("table"), nil
 }
 
-func (cmd *DeleteNatgateway) inject(params map[string]interface{}) error {
+func (cmd *DeleteTable) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteNetworkinterface(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteNetworkinterface {
-	cmd := new(DeleteNetworkinterface)
+func NewDeleteTag(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteTag {
+	cmd := new(DeleteTag)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -8521,18 +12844,21 @@ func NewDeleteNetworkinterface(sess *session.Session, g cloud.GraphAPI, l ...*lo
 	return cmd
 }
 
-func (cmd *DeleteNetworkinterface) SetApi(api ec2iface.EC2API) {
+func (cmd *DeleteTag) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteNetworkinterface) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteTag) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteNetworkinterface) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteTag) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -8543,15 +12869,9 @@ func (cmd *DeleteNetworkinterface) run(renv env.Running, params map[string]inter
 		}
 	}
 
-	input := &ec2.DeleteNetworkInterfaceInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteNetworkInterfaceInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.DeleteNetworkInterface(input)
-	renv.Log().ExtraVerbosef("ec2.DeleteNetworkInterface call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.")
 	}
 
 	var extracted interface{}
@@ -8559,14 +12879,14 @@ func (cmd *DeleteNetworkinterface) run(renv env.Running, params map[string]inter
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete networkinterface: AWS command returned nil output")
+			renv.Log().Warning("delete tag: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete networkinterface '%s' done", extracted)
+		renv.Log().Verbosef("delete tag '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete networkinterface done")
+		renv.Log().Verbose("delete tag done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -8578,61 +12898,39 @@ func (cmd *DeleteNetworkinterface) run(renv env.Running, params map[string]inter
 	return extracted, nil
 }
 
-func (cmd *DeleteNetworkinterface) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.DeleteNetworkInterfaceInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteNetworkInterfaceInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.DeleteNetworkInterface(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.DeleteNetworkInterface call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: delete networkinterface ok")
-			return fakeDryRunId("networkinterface"), nil
-		}
-	}
-
-	return nil, err
-}
-
-func (cmd *DeleteNetworkinterface) inject(params map[string]interface{}) error {
+func (cmd *DeleteTag) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeletePolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeletePolicy {
-	cmd := new(DeletePolicy)
+func NewDeleteTargetgroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteTargetgroup {
+	cmd := new(DeleteTargetgroup)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = elbv2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeletePolicy) SetApi(api iamiface.IAMAPI) {
+func (cmd *DeleteTargetgroup) SetApi(api elbv2iface.ELBV2API) {
 	cmd.api = api
 }
 
-func (cmd *DeletePolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteTargetgroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeletePolicy) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteTargetgroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -8643,15 +12941,15 @@ func (cmd *DeletePolicy) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	input := &iam.DeletePolicyInput{}
+	input := &elbv2.DeleteTargetGroupInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.DeletePolicyInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in elbv2.DeleteTargetGroupInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeletePolicy(input)
-	renv.Log().ExtraVerbosef("iam.DeletePolicy call took %s", time.Since(start))
+	output, err := cmd.api.DeleteTargetGroup(input)
+	renv.Log().ExtraVerbosef("elbv2.DeleteTargetGroup call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "elbv2.DeleteTargetGroup")
 	}
 
 	var extracted interface{}
@@ -8659,14 +12957,14 @@ func (cmd *DeletePolicy) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete policy: AWS command returned nil output")
+			renv.Log().Warning("delete targetgroup: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete policy '%s' done", extracted)
+		renv.Log().Verbosef("delete targetgroup '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete policy done")
+		renv.Log().Verbose("delete targetgroup done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -8678,40 +12976,43 @@ func (cmd *DeletePolicy) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *DeletePolicy) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("policy"), nil
+func (cmd *DeleteTargetgroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("targetgroup"), nil
 }
 
-func (cmd *DeletePolicy) inject(params map[string]interface{}) error {
+func (cmd *DeleteTargetgroup) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteQueue(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteQueue {
-	cmd := new(DeleteQueue)
+func NewDeleteTopic(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteTopic {
+	cmd := new(DeleteTopic)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = sqs.New(sess)
+		cmd.api = sns.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteQueue) SetApi(api sqsiface.SQSAPI) {
+func (cmd *DeleteTopic) SetApi(api snsiface.SNSAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteQueue) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteTopic) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteQueue) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteTopic) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -8722,15 +13023,15 @@ func (cmd *DeleteQueue) run(renv env.Running, params map[string]interface{}) (in
 		}
 	}
 
-	input := &sqs.DeleteQueueInput{}
+	input := &sns.DeleteTopicInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in sqs.DeleteQueueInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in sns.DeleteTopicInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteQueue(input)
-	renv.Log().ExtraVerbosef("sqs.DeleteQueue call took %s", time.Since(start))
+	output, err := cmd.api.DeleteTopic(input)
+	renv.Log().ExtraVerbosef("sns.DeleteTopic call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "sns.DeleteTopic")
 	}
 
 	var extracted interface{}
@@ -8738,14 +13039,14 @@ func (cmd *DeleteQueue) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete queue: AWS command returned nil output")
+			renv.Log().Warning("delete topic: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete queue '%s' done", extracted)
+		renv.Log().Verbosef("delete topic '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete queue done")
+		renv.Log().Verbose("delete topic done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -8757,40 +13058,43 @@ func (cmd *DeleteQueue) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *DeleteQueue) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("queue"), nil
+func (cmd *DeleteTopic) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("topic"), nil
 }
 
-func (cmd *DeleteQueue) inject(params map[string]interface{}) error {
+func (cmd *DeleteTopic) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteRecord(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteRecord {
-	cmd := new(DeleteRecord)
+func NewDeleteUser(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteUser {
+	cmd := new(DeleteUser)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = route53.New(sess)
+		cmd.api = iam.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteRecord) SetApi(api route53iface.Route53API) {
+func (cmd *DeleteUser) SetApi(api iamiface.IAMAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteRecord) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteUser) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteRecord) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteUser) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -8801,9 +13105,15 @@ func (cmd *DeleteRecord) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &iam.DeleteUserInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in iam.DeleteUserInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteUser(input)
+	renv.Log().ExtraVerbosef("iam.DeleteUser call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "iam.DeleteUser")
 	}
 
 	var extracted interface{}
@@ -8811,14 +13121,14 @@ func (cmd *DeleteRecord) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete record: AWS command returned nil output")
+			renv.Log().Warning("delete user: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete record '%s' done", extracted)
+		renv.Log().Verbosef("delete user '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete record done")
+		renv.Log().Verbose("delete user done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -8830,40 +13140,43 @@ func (cmd *DeleteRecord) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *DeleteRecord) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("record"), nil
+func (cmd *DeleteUser) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("user"), nil
 }
 
-func (cmd *DeleteRecord) inject(params map[string]interface{}) error {
+func (cmd *DeleteUser) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteRepository(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteRepository {
-	cmd := new(DeleteRepository)
+func NewDeleteVolume(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteVolume {
+	cmd := new(DeleteVolume)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ecr.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteRepository) SetApi(api ecriface.ECRAPI) {
+func (cmd *DeleteVolume) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteRepository) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteVolume) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteRepository) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteVolume) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -8874,15 +13187,15 @@ func (cmd *DeleteRepository) run(renv env.Running, params map[string]interface{}
 		}
 	}
 
-	input := &ecr.DeleteRepositoryInput{}
+	input := &ec2.DeleteVolumeInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ecr.DeleteRepositoryInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteVolumeInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteRepository(input)
-	renv.Log().ExtraVerbosef("ecr.DeleteRepository call took %s", time.Since(start))
+	output, err := cmd.api.DeleteVolume(input)
+	renv.Log().ExtraVerbosef("ec2.DeleteVolume call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.DeleteVolume")
 	}
 
 	var extracted interface{}
@@ -8890,14 +13203,14 @@ func (cmd *DeleteRepository) run(renv env.Running, params map[string]interface{}
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete repository: AWS command returned nil output")
+			renv.Log().Warning("delete volume: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete repository '%s' done", extracted)
+		renv.Log().Verbosef("delete volume '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete repository done")
+		renv.Log().Verbose("delete volume done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -8909,40 +13222,64 @@ func (cmd *DeleteRepository) run(renv env.Running, params map[string]interface{}
 	return extracted, nil
 }
 
-func (cmd *DeleteRepository) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("repository"), nil
+func (cmd *DeleteVolume) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.DeleteVolumeInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteVolumeInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DeleteVolume(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.DeleteVolume call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete volume ok")
+			return fakeDryRunId("volume"), nil
+		}
+	}
+
+	return nil, err
 }
 
-func (cmd *DeleteRepository) inject(params map[string]interface{}) error {
+func (cmd *DeleteVolume) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteRole(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteRole {
-	cmd := new(DeleteRole)
+func NewDeleteVpc(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteVpc {
+	cmd := new(DeleteVpc)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteRole) SetApi(api iamiface.IAMAPI) {
+func (cmd *DeleteVpc) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteRole) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteVpc) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteRole) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteVpc) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -8953,9 +13290,15 @@ func (cmd *DeleteRole) run(renv env.Running, params map[string]interface{}) (int
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &ec2.DeleteVpcInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteVpcInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteVpc(input)
+	renv.Log().ExtraVerbosef("ec2.DeleteVpc call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.DeleteVpc")
 	}
 
 	var extracted interface{}
@@ -8963,14 +13306,14 @@ func (cmd *DeleteRole) run(renv env.Running, params map[string]interface{}) (int
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete role: AWS command returned nil output")
+			renv.Log().Warning("delete vpc: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete role '%s' done", extracted)
+		renv.Log().Verbosef("delete vpc '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete role done")
+		renv.Log().Verbose("delete vpc done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -8982,16 +13325,37 @@ func (cmd *DeleteRole) run(renv env.Running, params map[string]interface{}) (int
 	return extracted, nil
 }
 
-func (cmd *DeleteRole) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("role"), nil
+func (cmd *DeleteVpc) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.DeleteVpcInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteVpcInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DeleteVpc(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.DeleteVpc call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete vpc ok")
+			return fakeDryRunId("vpc"), nil
+		}
+	}
+
+	return nil, err
 }
 
-func (cmd *DeleteRole) inject(params map[string]interface{}) error {
+func (cmd *DeleteVpc) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteRoute(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteRoute {
-	cmd := new(DeleteRoute)
+func NewDeleteVpcendpoint(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteVpcendpoint {
+	cmd := new(DeleteVpcendpoint)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -9004,18 +13368,21 @@ func NewDeleteRoute(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger
 	return cmd
 }
 
-func (cmd *DeleteRoute) SetApi(api ec2iface.EC2API) {
+func (cmd *DeleteVpcendpoint) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteRoute) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteVpcendpoint) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteRoute) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteVpcendpoint) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -9026,15 +13393,15 @@ func (cmd *DeleteRoute) run(renv env.Running, params map[string]interface{}) (in
 		}
 	}
 
-	input := &ec2.DeleteRouteInput{}
+	input := &ec2.DeleteVpcEndpointsInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteRouteInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteVpcEndpointsInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteRoute(input)
-	renv.Log().ExtraVerbosef("ec2.DeleteRoute call took %s", time.Since(start))
+	output, err := cmd.api.DeleteVpcEndpoints(input)
+	renv.Log().ExtraVerbosef("ec2.DeleteVpcEndpoints call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.DeleteVpcEndpoints")
 	}
 
 	var extracted interface{}
@@ -9042,14 +13409,14 @@ func (cmd *DeleteRoute) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete route: AWS command returned nil output")
+			renv.Log().Warning("delete vpcendpoint: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete route '%s' done", extracted)
+		renv.Log().Verbosef("delete vpcendpoint '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete route done")
+		renv.Log().Verbose("delete vpcendpoint done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -9061,61 +13428,64 @@ func (cmd *DeleteRoute) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *DeleteRoute) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteVpcendpoint) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
 
-	input := &ec2.DeleteRouteInput{}
+	input := &ec2.DeleteVpcEndpointsInput{}
 	input.SetDryRun(true)
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteRouteInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteVpcEndpointsInput: %s", err)
 	}
 
 	start := time.Now()
-	_, err := cmd.api.DeleteRoute(input)
+	_, err := cmd.api.DeleteVpcEndpoints(input)
 	if awsErr, ok := err.(awserr.Error); ok {
 		switch code := awsErr.Code(); {
 		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.DeleteRoute call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: delete route ok")
-			return fakeDryRunId("route"), nil
+			renv.Log().ExtraVerbosef("dry run: ec2.DeleteVpcEndpoints call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete vpcendpoint ok")
+			return fakeDryRunId("vpcendpoint"), nil
 		}
 	}
 
 	return nil, err
 }
 
-func (cmd *DeleteRoute) inject(params map[string]interface{}) error {
+func (cmd *DeleteVpcendpoint) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteRoutetable(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteRoutetable {
-	cmd := new(DeleteRoutetable)
+func NewDeleteZone(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteZone {
+	cmd := new(DeleteZone)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = route53.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteRoutetable) SetApi(api ec2iface.EC2API) {
+func (cmd *DeleteZone) SetApi(api route53iface.Route53API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteRoutetable) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteZone) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteRoutetable) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteZone) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -9126,15 +13496,15 @@ func (cmd *DeleteRoutetable) run(renv env.Running, params map[string]interface{}
 		}
 	}
 
-	input := &ec2.DeleteRouteTableInput{}
+	input := &route53.DeleteHostedZoneInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteRouteTableInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in route53.DeleteHostedZoneInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteRouteTable(input)
-	renv.Log().ExtraVerbosef("ec2.DeleteRouteTable call took %s", time.Since(start))
+	output, err := cmd.api.DeleteHostedZone(input)
+	renv.Log().ExtraVerbosef("route53.DeleteHostedZone call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "route53.DeleteHostedZone")
 	}
 
 	var extracted interface{}
@@ -9142,14 +13512,14 @@ func (cmd *DeleteRoutetable) run(renv env.Running, params map[string]interface{}
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete routetable: AWS command returned nil output")
+			renv.Log().Warning("delete zone: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete routetable '%s' done", extracted)
+		renv.Log().Verbosef("delete zone '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete routetable done")
+		renv.Log().Verbose("delete zone done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -9161,61 +13531,43 @@ func (cmd *DeleteRoutetable) run(renv env.Running, params map[string]interface{}
 	return extracted, nil
 }
 
-func (cmd *DeleteRoutetable) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.DeleteRouteTableInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteRouteTableInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.DeleteRouteTable(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.DeleteRouteTable call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: delete routetable ok")
-			return fakeDryRunId("routetable"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *DeleteZone) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("zone"), nil
 }
 
-func (cmd *DeleteRoutetable) inject(params map[string]interface{}) error {
+func (cmd *DeleteZone) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteS3object(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteS3object {
-	cmd := new(DeleteS3object)
+func NewDetachAlarm(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachAlarm {
+	cmd := new(DetachAlarm)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = s3.New(sess)
+		cmd.api = cloudwatch.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteS3object) SetApi(api s3iface.S3API) {
+func (cmd *DetachAlarm) SetApi(api cloudwatchiface.CloudWatchAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteS3object) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachAlarm) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteS3object) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachAlarm) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -9226,15 +13578,9 @@ func (cmd *DeleteS3object) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &s3.DeleteObjectInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in s3.DeleteObjectInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.DeleteObject(input)
-	renv.Log().ExtraVerbosef("s3.DeleteObject call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "cloudwatch.")
 	}
 
 	var extracted interface{}
@@ -9242,14 +13588,14 @@ func (cmd *DeleteS3object) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete s3object: AWS command returned nil output")
+			renv.Log().Warning("detach alarm: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete s3object '%s' done", extracted)
+		renv.Log().Verbosef("detach alarm '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete s3object done")
+		renv.Log().Verbose("detach alarm done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -9261,40 +13607,43 @@ func (cmd *DeleteS3object) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *DeleteS3object) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("s3object"), nil
+func (cmd *DetachAlarm) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("alarm"), nil
 }
 
-func (cmd *DeleteS3object) inject(params map[string]interface{}) error {
+func (cmd *DetachAlarm) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteScalinggroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteScalinggroup {
-	cmd := new(DeleteScalinggroup)
+func NewDetachClassicLoadbalancer(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachClassicLoadbalancer {
+	cmd := new(DetachClassicLoadbalancer)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = autoscaling.New(sess)
+		cmd.api = elb.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteScalinggroup) SetApi(api autoscalingiface.AutoScalingAPI) {
+func (cmd *DetachClassicLoadbalancer) SetApi(api elbiface.ELBAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteScalinggroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachClassicLoadbalancer) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteScalinggroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachClassicLoadbalancer) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -9305,15 +13654,15 @@ func (cmd *DeleteScalinggroup) run(renv env.Running, params map[string]interface
 		}
 	}
 
-	input := &autoscaling.DeleteAutoScalingGroupInput{}
+	input := &elb.DeregisterInstancesFromLoadBalancerInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in autoscaling.DeleteAutoScalingGroupInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in elb.DeregisterInstancesFromLoadBalancerInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteAutoScalingGroup(input)
-	renv.Log().ExtraVerbosef("autoscaling.DeleteAutoScalingGroup call took %s", time.Since(start))
+	output, err := cmd.api.DeregisterInstancesFromLoadBalancer(input)
+	renv.Log().ExtraVerbosef("elb.DeregisterInstancesFromLoadBalancer call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "elb.DeregisterInstancesFromLoadBalancer")
 	}
 
 	var extracted interface{}
@@ -9321,14 +13670,14 @@ func (cmd *DeleteScalinggroup) run(renv env.Running, params map[string]interface
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete scalinggroup: AWS command returned nil output")
+			renv.Log().Warning("detach classicloadbalancer: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete scalinggroup '%s' done", extracted)
+		renv.Log().Verbosef("detach classicloadbalancer '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete scalinggroup done")
+		renv.Log().Verbose("detach classicloadbalancer done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -9340,40 +13689,43 @@ func (cmd *DeleteScalinggroup) run(renv env.Running, params map[string]interface
 	return extracted, nil
 }
 
-func (cmd *DeleteScalinggroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("scalinggroup"), nil
+func (cmd *DetachClassicLoadbalancer) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("classicloadbalancer"), nil
 }
 
-func (cmd *DeleteScalinggroup) inject(params map[string]interface{}) error {
+func (cmd *DetachClassicLoadbalancer) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteScalingpolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteScalingpolicy {
-	cmd := new(DeleteScalingpolicy)
+func NewDetachContainertask(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachContainertask {
+	cmd := new(DetachContainertask)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = autoscaling.New(sess)
+		cmd.api = ecs.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteScalingpolicy) SetApi(api autoscalingiface.AutoScalingAPI) {
+func (cmd *DetachContainertask) SetApi(api ecsiface.ECSAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteScalingpolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachContainertask) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteScalingpolicy) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachContainertask) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -9384,15 +13736,9 @@ func (cmd *DeleteScalingpolicy) run(renv env.Running, params map[string]interfac
 		}
 	}
 
-	input := &autoscaling.DeletePolicyInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in autoscaling.DeletePolicyInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.DeletePolicy(input)
-	renv.Log().ExtraVerbosef("autoscaling.DeletePolicy call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ecs.")
 	}
 
 	var extracted interface{}
@@ -9400,14 +13746,14 @@ func (cmd *DeleteScalingpolicy) run(renv env.Running, params map[string]interfac
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete scalingpolicy: AWS command returned nil output")
+			renv.Log().Warning("detach containertask: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete scalingpolicy '%s' done", extracted)
+		renv.Log().Verbosef("detach containertask '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete scalingpolicy done")
+		renv.Log().Verbose("detach containertask done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -9419,16 +13765,16 @@ func (cmd *DeleteScalingpolicy) run(renv env.Running, params map[string]interfac
 	return extracted, nil
 }
 
-func (cmd *DeleteScalingpolicy) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("scalingpolicy"), nil
+func (cmd *DetachContainertask) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("containertask"), nil
 }
 
-func (cmd *DeleteScalingpolicy) inject(params map[string]interface{}) error {
+func (cmd *DetachContainertask) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteSecuritygroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteSecuritygroup {
-	cmd := new(DeleteSecuritygroup)
+func NewDetachDhcpoptions(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachDhcpoptions {
+	cmd := new(DetachDhcpoptions)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -9441,18 +13787,21 @@ func NewDeleteSecuritygroup(sess *session.Session, g cloud.GraphAPI, l ...*logge
 	return cmd
 }
 
-func (cmd *DeleteSecuritygroup) SetApi(api ec2iface.EC2API) {
+func (cmd *DetachDhcpoptions) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteSecuritygroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachDhcpoptions) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteSecuritygroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachDhcpoptions) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -9463,15 +13812,9 @@ func (cmd *DeleteSecuritygroup) run(renv env.Running, params map[string]interfac
 		}
 	}
 
-	input := &ec2.DeleteSecurityGroupInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteSecurityGroupInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.DeleteSecurityGroup(input)
-	renv.Log().ExtraVerbosef("ec2.DeleteSecurityGroup call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.")
 	}
 
 	var extracted interface{}
@@ -9479,14 +13822,14 @@ func (cmd *DeleteSecuritygroup) run(renv env.Running, params map[string]interfac
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete securitygroup: AWS command returned nil output")
+			renv.Log().Warning("detach dhcpoptions: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete securitygroup '%s' done", extracted)
+		renv.Log().Verbosef("detach dhcpoptions '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete securitygroup done")
+		renv.Log().Verbose("detach dhcpoptions done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -9498,37 +13841,16 @@ func (cmd *DeleteSecuritygroup) run(renv env.Running, params map[string]interfac
 	return extracted, nil
 }
 
-func (cmd *DeleteSecuritygroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.DeleteSecurityGroupInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteSecurityGroupInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.DeleteSecurityGroup(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.DeleteSecurityGroup call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: delete securitygroup ok")
-			return fakeDryRunId("securitygroup"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *DetachDhcpoptions) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("dhcpoptions"), nil
 }
 
-func (cmd *DeleteSecuritygroup) inject(params map[string]interface{}) error {
+func (cmd *DetachDhcpoptions) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteSnapshot(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteSnapshot {
-	cmd := new(DeleteSnapshot)
+func NewDetachElasticip(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachElasticip {
+	cmd := new(DetachElasticip)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -9541,18 +13863,21 @@ func NewDeleteSnapshot(sess *session.Session, g cloud.GraphAPI, l ...*logger.Log
 	return cmd
 }
 
-func (cmd *DeleteSnapshot) SetApi(api ec2iface.EC2API) {
+func (cmd *DetachElasticip) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteSnapshot) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachElasticip) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteSnapshot) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachElasticip) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -9563,15 +13888,15 @@ func (cmd *DeleteSnapshot) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &ec2.DeleteSnapshotInput{}
+	input := &ec2.DisassociateAddressInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteSnapshotInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.DisassociateAddressInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteSnapshot(input)
-	renv.Log().ExtraVerbosef("ec2.DeleteSnapshot call took %s", time.Since(start))
+	output, err := cmd.api.DisassociateAddress(input)
+	renv.Log().ExtraVerbosef("ec2.DisassociateAddress call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.DisassociateAddress")
 	}
 
 	var extracted interface{}
@@ -9579,14 +13904,14 @@ func (cmd *DeleteSnapshot) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete snapshot: AWS command returned nil output")
+			renv.Log().Warning("detach elasticip: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete snapshot '%s' done", extracted)
+		renv.Log().Verbosef("detach elasticip '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete snapshot done")
+		renv.Log().Verbose("detach elasticip done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -9598,61 +13923,64 @@ func (cmd *DeleteSnapshot) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *DeleteSnapshot) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachElasticip) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
 
-	input := &ec2.DeleteSnapshotInput{}
+	input := &ec2.DisassociateAddressInput{}
 	input.SetDryRun(true)
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteSnapshotInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.DisassociateAddressInput: %s", err)
 	}
 
 	start := time.Now()
-	_, err := cmd.api.DeleteSnapshot(input)
+	_, err := cmd.api.DisassociateAddress(input)
 	if awsErr, ok := err.(awserr.Error); ok {
 		switch code := awsErr.Code(); {
 		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.DeleteSnapshot call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: delete snapshot ok")
-			return fakeDryRunId("snapshot"), nil
+			renv.Log().ExtraVerbosef("dry run: ec2.DisassociateAddress call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: detach elasticip ok")
+			return fakeDryRunId("elasticip"), nil
 		}
 	}
 
 	return nil, err
 }
 
-func (cmd *DeleteSnapshot) inject(params map[string]interface{}) error {
+func (cmd *DetachElasticip) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteStack(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteStack {
-	cmd := new(DeleteStack)
+func NewDetachInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachInstance {
+	cmd := new(DetachInstance)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = cloudformation.New(sess)
+		cmd.api = elbv2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteStack) SetApi(api cloudformationiface.CloudFormationAPI) {
+func (cmd *DetachInstance) SetApi(api elbv2iface.ELBV2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteStack) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteStack) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachInstance) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -9663,15 +13991,15 @@ func (cmd *DeleteStack) run(renv env.Running, params map[string]interface{}) (in
 		}
 	}
 
-	input := &cloudformation.DeleteStackInput{}
+	input := &elbv2.DeregisterTargetsInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in cloudformation.DeleteStackInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in elbv2.DeregisterTargetsInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteStack(input)
-	renv.Log().ExtraVerbosef("cloudformation.DeleteStack call took %s", time.Since(start))
+	output, err := cmd.api.DeregisterTargets(input)
+	renv.Log().ExtraVerbosef("elbv2.DeregisterTargets call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "elbv2.DeregisterTargets")
 	}
 
 	var extracted interface{}
@@ -9679,14 +14007,14 @@ func (cmd *DeleteStack) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete stack: AWS command returned nil output")
+			renv.Log().Warning("detach instance: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete stack '%s' done", extracted)
+		renv.Log().Verbosef("detach instance '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete stack done")
+		renv.Log().Verbose("detach instance done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -9698,16 +14026,16 @@ func (cmd *DeleteStack) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *DeleteStack) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("stack"), nil
+func (cmd *DetachInstance) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("instance"), nil
 }
 
-func (cmd *DeleteStack) inject(params map[string]interface{}) error {
+func (cmd *DetachInstance) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteSubnet(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteSubnet {
-	cmd := new(DeleteSubnet)
+func NewDetachInstanceprofile(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachInstanceprofile {
+	cmd := new(DetachInstanceprofile)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -9720,18 +14048,21 @@ func NewDeleteSubnet(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logge
 	return cmd
 }
 
-func (cmd *DeleteSubnet) SetApi(api ec2iface.EC2API) {
+func (cmd *DetachInstanceprofile) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteSubnet) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachInstanceprofile) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteSubnet) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachInstanceprofile) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -9742,15 +14073,9 @@ func (cmd *DeleteSubnet) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	input := &ec2.DeleteSubnetInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteSubnetInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.DeleteSubnet(input)
-	renv.Log().ExtraVerbosef("ec2.DeleteSubnet call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.")
 	}
 
 	var extracted interface{}
@@ -9758,14 +14083,14 @@ func (cmd *DeleteSubnet) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete subnet: AWS command returned nil output")
+			renv.Log().Warning("detach instanceprofile: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete subnet '%s' done", extracted)
+		renv.Log().Verbosef("detach instanceprofile '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete subnet done")
+		renv.Log().Verbose("detach instanceprofile done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -9777,61 +14102,43 @@ func (cmd *DeleteSubnet) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *DeleteSubnet) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.DeleteSubnetInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteSubnetInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.DeleteSubnet(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.DeleteSubnet call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: delete subnet ok")
-			return fakeDryRunId("subnet"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *DetachInstanceprofile) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("instanceprofile"), nil
 }
 
-func (cmd *DeleteSubnet) inject(params map[string]interface{}) error {
+func (cmd *DetachInstanceprofile) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteSubscription(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteSubscription {
-	cmd := new(DeleteSubscription)
+func NewDetachInternetgateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachInternetgateway {
+	cmd := new(DetachInternetgateway)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = sns.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteSubscription) SetApi(api snsiface.SNSAPI) {
+func (cmd *DetachInternetgateway) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteSubscription) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachInternetgateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteSubscription) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachInternetgateway) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -9842,15 +14149,15 @@ func (cmd *DeleteSubscription) run(renv env.Running, params map[string]interface
 		}
 	}
 
-	input := &sns.UnsubscribeInput{}
+	input := &ec2.DetachInternetGatewayInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in sns.UnsubscribeInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.DetachInternetGatewayInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.Unsubscribe(input)
-	renv.Log().ExtraVerbosef("sns.Unsubscribe call took %s", time.Since(start))
+	output, err := cmd.api.DetachInternetGateway(input)
+	renv.Log().ExtraVerbosef("ec2.DetachInternetGateway call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.DetachInternetGateway")
 	}
 
 	var extracted interface{}
@@ -9858,14 +14165,14 @@ func (cmd *DeleteSubscription) run(renv env.Running, params map[string]interface
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete subscription: AWS command returned nil output")
+			renv.Log().Warning("detach internetgateway: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete subscription '%s' done", extracted)
+		renv.Log().Verbosef("detach internetgateway '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete subscription done")
+		renv.Log().Verbose("detach internetgateway done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -9877,40 +14184,64 @@ func (cmd *DeleteSubscription) run(renv env.Running, params map[string]interface
 	return extracted, nil
 }
 
-func (cmd *DeleteSubscription) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("subscription"), nil
+func (cmd *DetachInternetgateway) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.DetachInternetGatewayInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DetachInternetGatewayInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DetachInternetGateway(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.DetachInternetGateway call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: detach internetgateway ok")
+			return fakeDryRunId("internetgateway"), nil
+		}
+	}
+
+	return nil, err
 }
 
-func (cmd *DeleteSubscription) inject(params map[string]interface{}) error {
+func (cmd *DetachInternetgateway) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteTag(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteTag {
-	cmd := new(DeleteTag)
+func NewDetachMfadevice(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachMfadevice {
+	cmd := new(DetachMfadevice)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = iam.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteTag) SetApi(api ec2iface.EC2API) {
+func (cmd *DetachMfadevice) SetApi(api iamiface.IAMAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteTag) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachMfadevice) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteTag) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachMfadevice) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -9921,9 +14252,15 @@ func (cmd *DeleteTag) run(renv env.Running, params map[string]interface{}) (inte
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &iam.DeactivateMFADeviceInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in iam.DeactivateMFADeviceInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeactivateMFADevice(input)
+	renv.Log().ExtraVerbosef("iam.DeactivateMFADevice call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "iam.DeactivateMFADevice")
 	}
 
 	var extracted interface{}
@@ -9931,14 +14268,14 @@ func (cmd *DeleteTag) run(renv env.Running, params map[string]interface{}) (inte
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete tag: AWS command returned nil output")
+			renv.Log().Warning("detach mfadevice: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete tag '%s' done", extracted)
+		renv.Log().Verbosef("detach mfadevice '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete tag done")
+		renv.Log().Verbose("detach mfadevice done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -9950,36 +14287,43 @@ func (cmd *DeleteTag) run(renv env.Running, params map[string]interface{}) (inte
 	return extracted, nil
 }
 
-func (cmd *DeleteTag) inject(params map[string]interface{}) error {
+func (cmd *DetachMfadevice) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("mfadevice"), nil
+}
+
+func (cmd *DetachMfadevice) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteTargetgroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteTargetgroup {
-	cmd := new(DeleteTargetgroup)
+func NewDetachNetworkinterface(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachNetworkinterface {
+	cmd := new(DetachNetworkinterface)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = elbv2.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteTargetgroup) SetApi(api elbv2iface.ELBV2API) {
+func (cmd *DetachNetworkinterface) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteTargetgroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachNetworkinterface) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteTargetgroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachNetworkinterface) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -9990,15 +14334,9 @@ func (cmd *DeleteTargetgroup) run(renv env.Running, params map[string]interface{
 		}
 	}
 
-	input := &elbv2.DeleteTargetGroupInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in elbv2.DeleteTargetGroupInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.DeleteTargetGroup(input)
-	renv.Log().ExtraVerbosef("elbv2.DeleteTargetGroup call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.")
 	}
 
 	var extracted interface{}
@@ -10006,14 +14344,14 @@ func (cmd *DeleteTargetgroup) run(renv env.Running, params map[string]interface{
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete targetgroup: AWS command returned nil output")
+			renv.Log().Warning("detach networkinterface: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete targetgroup '%s' done", extracted)
+		renv.Log().Verbosef("detach networkinterface '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete targetgroup done")
+		renv.Log().Verbose("detach networkinterface done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -10025,40 +14363,39 @@ func (cmd *DeleteTargetgroup) run(renv env.Running, params map[string]interface{
 	return extracted, nil
 }
 
-func (cmd *DeleteTargetgroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("targetgroup"), nil
-}
-
-func (cmd *DeleteTargetgroup) inject(params map[string]interface{}) error {
+func (cmd *DetachNetworkinterface) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteTopic(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteTopic {
-	cmd := new(DeleteTopic)
+func NewDetachPolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachPolicy {
+	cmd := new(DetachPolicy)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = sns.New(sess)
+		cmd.api = iam.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteTopic) SetApi(api snsiface.SNSAPI) {
+func (cmd *DetachPolicy) SetApi(api iamiface.IAMAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteTopic) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachPolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteTopic) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachPolicy) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -10069,15 +14406,9 @@ func (cmd *DeleteTopic) run(renv env.Running, params map[string]interface{}) (in
 		}
 	}
 
-	input := &sns.DeleteTopicInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in sns.DeleteTopicInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.DeleteTopic(input)
-	renv.Log().ExtraVerbosef("sns.DeleteTopic call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "iam.")
 	}
 
 	var extracted interface{}
@@ -10085,14 +14416,14 @@ func (cmd *DeleteTopic) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete topic: AWS command returned nil output")
+			renv.Log().Warning("detach policy: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete topic '%s' done", extracted)
+		renv.Log().Verbosef("detach policy '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete topic done")
+		renv.Log().Verbose("detach policy done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -10104,40 +14435,43 @@ func (cmd *DeleteTopic) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *DeleteTopic) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("topic"), nil
+func (cmd *DetachPolicy) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("policy"), nil
 }
 
-func (cmd *DeleteTopic) inject(params map[string]interface{}) error {
+func (cmd *DetachPolicy) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteUser(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteUser {
-	cmd := new(DeleteUser)
+func NewDetachQueuepolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachQueuepolicy {
+	cmd := new(DetachQueuepolicy)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = sqs.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteUser) SetApi(api iamiface.IAMAPI) {
+func (cmd *DetachQueuepolicy) SetApi(api sqsiface.SQSAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteUser) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachQueuepolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteUser) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachQueuepolicy) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -10148,15 +14482,9 @@ func (cmd *DeleteUser) run(renv env.Running, params map[string]interface{}) (int
 		}
 	}
 
-	input := &iam.DeleteUserInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.DeleteUserInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.DeleteUser(input)
-	renv.Log().ExtraVerbosef("iam.DeleteUser call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "sqs.")
 	}
 
 	var extracted interface{}
@@ -10164,14 +14492,14 @@ func (cmd *DeleteUser) run(renv env.Running, params map[string]interface{}) (int
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete user: AWS command returned nil output")
+			renv.Log().Warning("detach queuepolicy: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete user '%s' done", extracted)
+		renv.Log().Verbosef("detach queuepolicy '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete user done")
+		renv.Log().Verbose("detach queuepolicy done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -10183,40 +14511,43 @@ func (cmd *DeleteUser) run(renv env.Running, params map[string]interface{}) (int
 	return extracted, nil
 }
 
-func (cmd *DeleteUser) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("user"), nil
+func (cmd *DetachQueuepolicy) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("queuepolicy"), nil
 }
 
-func (cmd *DeleteUser) inject(params map[string]interface{}) error {
+func (cmd *DetachQueuepolicy) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteVolume(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteVolume {
-	cmd := new(DeleteVolume)
+func NewDetachRole(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachRole {
+	cmd := new(DetachRole)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = iam.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteVolume) SetApi(api ec2iface.EC2API) {
+func (cmd *DetachRole) SetApi(api iamiface.IAMAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteVolume) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachRole) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteVolume) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachRole) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -10227,15 +14558,15 @@ func (cmd *DeleteVolume) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	input := &ec2.DeleteVolumeInput{}
+	input := &iam.RemoveRoleFromInstanceProfileInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteVolumeInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in iam.RemoveRoleFromInstanceProfileInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteVolume(input)
-	renv.Log().ExtraVerbosef("ec2.DeleteVolume call took %s", time.Since(start))
+	output, err := cmd.api.RemoveRoleFromInstanceProfile(input)
+	renv.Log().ExtraVerbosef("iam.RemoveRoleFromInstanceProfile call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "iam.RemoveRoleFromInstanceProfile")
 	}
 
 	var extracted interface{}
@@ -10243,14 +14574,14 @@ func (cmd *DeleteVolume) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete volume: AWS command returned nil output")
+			renv.Log().Warning("detach role: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete volume '%s' done", extracted)
+		renv.Log().Verbosef("detach role '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete volume done")
+		renv.Log().Verbose("detach role done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -10262,37 +14593,16 @@ func (cmd *DeleteVolume) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *DeleteVolume) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.DeleteVolumeInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteVolumeInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.DeleteVolume(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.DeleteVolume call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: delete volume ok")
-			return fakeDryRunId("volume"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *DetachRole) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("role"), nil
 }
 
-func (cmd *DeleteVolume) inject(params map[string]interface{}) error {
+func (cmd *DetachRole) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteVpc(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteVpc {
-	cmd := new(DeleteVpc)
+func NewDetachRoutetable(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachRoutetable {
+	cmd := new(DetachRoutetable)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -10305,18 +14615,21 @@ func NewDeleteVpc(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger)
 	return cmd
 }
 
-func (cmd *DeleteVpc) SetApi(api ec2iface.EC2API) {
+func (cmd *DetachRoutetable) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteVpc) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachRoutetable) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteVpc) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachRoutetable) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -10327,15 +14640,15 @@ func (cmd *DeleteVpc) run(renv env.Running, params map[string]interface{}) (inte
 		}
 	}
 
-	input := &ec2.DeleteVpcInput{}
+	input := &ec2.DisassociateRouteTableInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteVpcInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.DisassociateRouteTableInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteVpc(input)
-	renv.Log().ExtraVerbosef("ec2.DeleteVpc call took %s", time.Since(start))
+	output, err := cmd.api.DisassociateRouteTable(input)
+	renv.Log().ExtraVerbosef("ec2.DisassociateRouteTable call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.DisassociateRouteTable")
 	}
 
 	var extracted interface{}
@@ -10343,14 +14656,14 @@ func (cmd *DeleteVpc) run(renv env.Running, params map[string]interface{}) (inte
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete vpc: AWS command returned nil output")
+			renv.Log().Warning("detach routetable: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete vpc '%s' done", extracted)
+		renv.Log().Verbosef("detach routetable '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete vpc done")
+		renv.Log().Verbose("detach routetable done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -10362,61 +14675,64 @@ func (cmd *DeleteVpc) run(renv env.Running, params map[string]interface{}) (inte
 	return extracted, nil
 }
 
-func (cmd *DeleteVpc) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachRoutetable) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
 
-	input := &ec2.DeleteVpcInput{}
+	input := &ec2.DisassociateRouteTableInput{}
 	input.SetDryRun(true)
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteVpcInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.DisassociateRouteTableInput: %s", err)
 	}
 
 	start := time.Now()
-	_, err := cmd.api.DeleteVpc(input)
+	_, err := cmd.api.DisassociateRouteTable(input)
 	if awsErr, ok := err.(awserr.Error); ok {
 		switch code := awsErr.Code(); {
 		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.DeleteVpc call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: delete vpc ok")
-			return fakeDryRunId("vpc"), nil
+			renv.Log().ExtraVerbosef("dry run: ec2.DisassociateRouteTable call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: detach routetable ok")
+			return fakeDryRunId("routetable"), nil
 		}
 	}
 
 	return nil, err
 }
 
-func (cmd *DeleteVpc) inject(params map[string]interface{}) error {
+func (cmd *DetachRoutetable) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteZone(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteZone {
-	cmd := new(DeleteZone)
+func NewDetachScalinggroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachScalinggroup {
+	cmd := new(DetachScalinggroup)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = route53.New(sess)
+		cmd.api = autoscaling.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteZone) SetApi(api route53iface.Route53API) {
+func (cmd *DetachScalinggroup) SetApi(api autoscalingiface.AutoScalingAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteZone) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachScalinggroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteZone) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachScalinggroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -10427,15 +14743,15 @@ func (cmd *DeleteZone) run(renv env.Running, params map[string]interface{}) (int
 		}
 	}
 
-	input := &route53.DeleteHostedZoneInput{}
+	input := &autoscaling.DetachLoadBalancerTargetGroupsInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in route53.DeleteHostedZoneInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in autoscaling.DetachLoadBalancerTargetGroupsInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteHostedZone(input)
-	renv.Log().ExtraVerbosef("route53.DeleteHostedZone call took %s", time.Since(start))
+	output, err := cmd.api.DetachLoadBalancerTargetGroups(input)
+	renv.Log().ExtraVerbosef("autoscaling.DetachLoadBalancerTargetGroups call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "autoscaling.DetachLoadBalancerTargetGroups")
 	}
 
 	var extracted interface{}
@@ -10443,14 +14759,14 @@ func (cmd *DeleteZone) run(renv env.Running, params map[string]interface{}) (int
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete zone: AWS command returned nil output")
+			renv.Log().Warning("detach scalinggroup: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete zone '%s' done", extracted)
+		renv.Log().Verbosef("detach scalinggroup '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete zone done")
+		renv.Log().Verbose("detach scalinggroup done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -10462,40 +14778,43 @@ func (cmd *DeleteZone) run(renv env.Running, params map[string]interface{}) (int
 	return extracted, nil
 }
 
-func (cmd *DeleteZone) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("zone"), nil
+func (cmd *DetachScalinggroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("scalinggroup"), nil
 }
 
-func (cmd *DeleteZone) inject(params map[string]interface{}) error {
+func (cmd *DetachScalinggroup) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDetachAlarm(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachAlarm {
-	cmd := new(DetachAlarm)
+func NewDetachSecuritygroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachSecuritygroup {
+	cmd := new(DetachSecuritygroup)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = cloudwatch.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DetachAlarm) SetApi(api cloudwatchiface.CloudWatchAPI) {
+func (cmd *DetachSecuritygroup) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DetachAlarm) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachSecuritygroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DetachAlarm) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachSecuritygroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -10508,7 +14827,7 @@ func (cmd *DetachAlarm) run(renv env.Running, params map[string]interface{}) (in
 
 	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.")
 	}
 
 	var extracted interface{}
@@ -10516,14 +14835,14 @@ func (cmd *DetachAlarm) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("detach alarm: AWS command returned nil output")
+			renv.Log().Warning("detach securitygroup: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("detach alarm '%s' done", extracted)
+		renv.Log().Verbosef("detach securitygroup '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("detach alarm done")
+		renv.Log().Verbose("detach securitygroup done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -10535,40 +14854,43 @@ func (cmd *DetachAlarm) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *DetachAlarm) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("alarm"), nil
+func (cmd *DetachSecuritygroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("securitygroup"), nil
 }
 
-func (cmd *DetachAlarm) inject(params map[string]interface{}) error {
+func (cmd *DetachSecuritygroup) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDetachClassicLoadbalancer(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachClassicLoadbalancer {
-	cmd := new(DetachClassicLoadbalancer)
+func NewDetachUser(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachUser {
+	cmd := new(DetachUser)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = elb.New(sess)
+		cmd.api = iam.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DetachClassicLoadbalancer) SetApi(api elbiface.ELBAPI) {
+func (cmd *DetachUser) SetApi(api iamiface.IAMAPI) {
 	cmd.api = api
 }
 
-func (cmd *DetachClassicLoadbalancer) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachUser) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DetachClassicLoadbalancer) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachUser) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -10579,15 +14901,15 @@ func (cmd *DetachClassicLoadbalancer) run(renv env.Running, params map[string]in
 		}
 	}
 
-	input := &elb.DeregisterInstancesFromLoadBalancerInput{}
+	input := &iam.RemoveUserFromGroupInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in elb.DeregisterInstancesFromLoadBalancerInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in iam.RemoveUserFromGroupInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeregisterInstancesFromLoadBalancer(input)
-	renv.Log().ExtraVerbosef("elb.DeregisterInstancesFromLoadBalancer call took %s", time.Since(start))
+	output, err := cmd.api.RemoveUserFromGroup(input)
+	renv.Log().ExtraVerbosef("iam.RemoveUserFromGroup call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "iam.RemoveUserFromGroup")
 	}
 
 	var extracted interface{}
@@ -10595,14 +14917,14 @@ func (cmd *DetachClassicLoadbalancer) run(renv env.Running, params map[string]in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("detach classicloadbalancer: AWS command returned nil output")
+			renv.Log().Warning("detach user: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("detach classicloadbalancer '%s' done", extracted)
+		renv.Log().Verbosef("detach user '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("detach classicloadbalancer done")
+		renv.Log().Verbose("detach user done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -10614,40 +14936,43 @@ func (cmd *DetachClassicLoadbalancer) run(renv env.Running, params map[string]in
 	return extracted, nil
 }
 
-func (cmd *DetachClassicLoadbalancer) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("classicloadbalancer"), nil
+func (cmd *DetachUser) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("user"), nil
 }
 
-func (cmd *DetachClassicLoadbalancer) inject(params map[string]interface{}) error {
+func (cmd *DetachUser) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDetachContainertask(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachContainertask {
-	cmd := new(DetachContainertask)
+func NewDetachVolume(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachVolume {
+	cmd := new(DetachVolume)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ecs.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DetachContainertask) SetApi(api ecsiface.ECSAPI) {
+func (cmd *DetachVolume) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DetachContainertask) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachVolume) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DetachContainertask) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachVolume) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -10658,9 +14983,15 @@ func (cmd *DetachContainertask) run(renv env.Running, params map[string]interfac
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &ec2.DetachVolumeInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DetachVolumeInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DetachVolume(input)
+	renv.Log().ExtraVerbosef("ec2.DetachVolume call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.DetachVolume")
 	}
 
 	var extracted interface{}
@@ -10668,14 +14999,14 @@ func (cmd *DetachContainertask) run(renv env.Running, params map[string]interfac
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("detach containertask: AWS command returned nil output")
+			renv.Log().Warning("detach volume: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("detach containertask '%s' done", extracted)
+		renv.Log().Verbosef("detach volume '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("detach containertask done")
+		renv.Log().Verbose("detach volume done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -10687,16 +15018,41 @@ func (cmd *DetachContainertask) run(renv env.Running, params map[string]interfac
 	return extracted, nil
 }
 
-func (cmd *DetachContainertask) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("containertask"), nil
+func (cmd *DetachVolume) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.DetachVolumeInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DetachVolumeInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DetachVolume(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.DetachVolume call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: detach volume ok")
+			return fakeDryRunId("volume"), nil
+		}
+	}
+
+	return nil, err
 }
 
-func (cmd *DetachContainertask) inject(params map[string]interface{}) error {
+func (cmd *DetachVolume) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDetachElasticip(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachElasticip {
-	cmd := new(DetachElasticip)
+func (cmd *DetachVolume) ExtractResult(i interface{}) string {
+	return StringValue(i.(*ec2.VolumeAttachment).VolumeId)
+}
+
+func NewDetachVpcendpoint(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachVpcendpoint {
+	cmd := new(DetachVpcendpoint)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -10709,18 +15065,21 @@ func NewDetachElasticip(sess *session.Session, g cloud.GraphAPI, l ...*logger.Lo
 	return cmd
 }
 
-func (cmd *DetachElasticip) SetApi(api ec2iface.EC2API) {
+func (cmd *DetachVpcendpoint) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DetachElasticip) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachVpcendpoint) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DetachElasticip) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachVpcendpoint) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -10731,15 +15090,15 @@ func (cmd *DetachElasticip) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &ec2.DisassociateAddressInput{}
+	input := &ec2.ModifyVpcEndpointInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DisassociateAddressInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.ModifyVpcEndpointInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DisassociateAddress(input)
-	renv.Log().ExtraVerbosef("ec2.DisassociateAddress call took %s", time.Since(start))
+	output, err := cmd.api.ModifyVpcEndpoint(input)
+	renv.Log().ExtraVerbosef("ec2.ModifyVpcEndpoint call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.ModifyVpcEndpoint")
 	}
 
 	var extracted interface{}
@@ -10747,14 +15106,14 @@ func (cmd *DetachElasticip) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("detach elasticip: AWS command returned nil output")
+			renv.Log().Warning("detach vpcendpoint: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("detach elasticip '%s' done", extracted)
+		renv.Log().Verbosef("detach vpcendpoint '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("detach elasticip done")
+		renv.Log().Verbose("detach vpcendpoint done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -10766,61 +15125,64 @@ func (cmd *DetachElasticip) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *DetachElasticip) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DetachVpcendpoint) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
 
-	input := &ec2.DisassociateAddressInput{}
+	input := &ec2.ModifyVpcEndpointInput{}
 	input.SetDryRun(true)
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DisassociateAddressInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.ModifyVpcEndpointInput: %s", err)
 	}
 
 	start := time.Now()
-	_, err := cmd.api.DisassociateAddress(input)
+	_, err := cmd.api.ModifyVpcEndpoint(input)
 	if awsErr, ok := err.(awserr.Error); ok {
 		switch code := awsErr.Code(); {
 		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.DisassociateAddress call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: detach elasticip ok")
-			return fakeDryRunId("elasticip"), nil
+			renv.Log().ExtraVerbosef("dry run: ec2.ModifyVpcEndpoint call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: detach vpcendpoint ok")
+			return fakeDryRunId("vpcendpoint"), nil
 		}
 	}
 
 	return nil, err
 }
 
-func (cmd *DetachElasticip) inject(params map[string]interface{}) error {
+func (cmd *DetachVpcendpoint) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDetachInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachInstance {
-	cmd := new(DetachInstance)
+func NewImportImage(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *ImportImage {
+	cmd := new(ImportImage)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = elbv2.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DetachInstance) SetApi(api elbv2iface.ELBV2API) {
+func (cmd *ImportImage) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DetachInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *ImportImage) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DetachInstance) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *ImportImage) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -10831,15 +15193,15 @@ func (cmd *DetachInstance) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &elbv2.DeregisterTargetsInput{}
+	input := &ec2.ImportImageInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in elbv2.DeregisterTargetsInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.ImportImageInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeregisterTargets(input)
-	renv.Log().ExtraVerbosef("elbv2.DeregisterTargets call took %s", time.Since(start))
+	output, err := cmd.api.ImportImage(input)
+	renv.Log().ExtraVerbosef("ec2.ImportImage call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.ImportImage")
 	}
 
 	var extracted interface{}
@@ -10847,14 +15209,14 @@ func (cmd *DetachInstance) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("detach instance: AWS command returned nil output")
+			renv.Log().Warning("import image: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("detach instance '%s' done", extracted)
+		renv.Log().Verbosef("import image '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("detach instance done")
+		renv.Log().Verbose("import image done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -10866,40 +15228,68 @@ func (cmd *DetachInstance) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *DetachInstance) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("instance"), nil
+func (cmd *ImportImage) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.ImportImageInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.ImportImageInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.ImportImage(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.ImportImage call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: import image ok")
+			return fakeDryRunId("image"), nil
+		}
+	}
+
+	return nil, err
 }
 
-func (cmd *DetachInstance) inject(params map[string]interface{}) error {
+func (cmd *ImportImage) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDetachInstanceprofile(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachInstanceprofile {
-	cmd := new(DetachInstanceprofile)
+func (cmd *ImportImage) ExtractResult(i interface{}) string {
+	return StringValue(i.(*ec2.ImportImageOutput).ImportTaskId)
+}
+
+func NewInvokeFunction(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *InvokeFunction {
+	cmd := new(InvokeFunction)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = lambda.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DetachInstanceprofile) SetApi(api ec2iface.EC2API) {
+func (cmd *InvokeFunction) SetApi(api lambdaiface.LambdaAPI) {
 	cmd.api = api
 }
 
-func (cmd *DetachInstanceprofile) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *InvokeFunction) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DetachInstanceprofile) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *InvokeFunction) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -10912,7 +15302,7 @@ func (cmd *DetachInstanceprofile) run(renv env.Running, params map[string]interf
 
 	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "lambda.")
 	}
 
 	var extracted interface{}
@@ -10920,14 +15310,14 @@ func (cmd *DetachInstanceprofile) run(renv env.Running, params map[string]interf
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("detach instanceprofile: AWS command returned nil output")
+			renv.Log().Warning("invoke function: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("detach instanceprofile '%s' done", extracted)
+		renv.Log().Verbosef("invoke function '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("detach instanceprofile done")
+		renv.Log().Verbose("invoke function done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -10939,40 +15329,39 @@ func (cmd *DetachInstanceprofile) run(renv env.Running, params map[string]interf
 	return extracted, nil
 }
 
-func (cmd *DetachInstanceprofile) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("instanceprofile"), nil
-}
-
-func (cmd *DetachInstanceprofile) inject(params map[string]interface{}) error {
+func (cmd *InvokeFunction) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDetachInternetgateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachInternetgateway {
-	cmd := new(DetachInternetgateway)
+func NewRestartDatabase(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *RestartDatabase {
+	cmd := new(RestartDatabase)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = rds.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DetachInternetgateway) SetApi(api ec2iface.EC2API) {
+func (cmd *RestartDatabase) SetApi(api rdsiface.RDSAPI) {
 	cmd.api = api
 }
 
-func (cmd *DetachInternetgateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *RestartDatabase) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DetachInternetgateway) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *RestartDatabase) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -10983,15 +15372,15 @@ func (cmd *DetachInternetgateway) run(renv env.Running, params map[string]interf
 		}
 	}
 
-	input := &ec2.DetachInternetGatewayInput{}
+	input := &rds.RebootDBInstanceInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DetachInternetGatewayInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in rds.RebootDBInstanceInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DetachInternetGateway(input)
-	renv.Log().ExtraVerbosef("ec2.DetachInternetGateway call took %s", time.Since(start))
+	output, err := cmd.api.RebootDBInstance(input)
+	renv.Log().ExtraVerbosef("rds.RebootDBInstance call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "rds.RebootDBInstance")
 	}
 
 	var extracted interface{}
@@ -10999,14 +15388,14 @@ func (cmd *DetachInternetgateway) run(renv env.Running, params map[string]interf
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("detach internetgateway: AWS command returned nil output")
+			renv.Log().Warning("restart database: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("detach internetgateway '%s' done", extracted)
+		renv.Log().Verbosef("restart database '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("detach internetgateway done")
+		renv.Log().Verbose("restart database done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -11018,61 +15407,43 @@ func (cmd *DetachInternetgateway) run(renv env.Running, params map[string]interf
 	return extracted, nil
 }
 
-func (cmd *DetachInternetgateway) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.DetachInternetGatewayInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DetachInternetGatewayInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.DetachInternetGateway(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.DetachInternetGateway call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: detach internetgateway ok")
-			return fakeDryRunId("internetgateway"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *RestartDatabase) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("database"), nil
 }
 
-func (cmd *DetachInternetgateway) inject(params map[string]interface{}) error {
+func (cmd *RestartDatabase) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDetachMfadevice(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachMfadevice {
-	cmd := new(DetachMfadevice)
+func NewRestartInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *RestartInstance {
+	cmd := new(RestartInstance)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DetachMfadevice) SetApi(api iamiface.IAMAPI) {
+func (cmd *RestartInstance) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DetachMfadevice) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *RestartInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DetachMfadevice) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *RestartInstance) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -11083,15 +15454,15 @@ func (cmd *DetachMfadevice) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &iam.DeactivateMFADeviceInput{}
+	input := &ec2.RebootInstancesInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.DeactivateMFADeviceInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.RebootInstancesInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeactivateMFADevice(input)
-	renv.Log().ExtraVerbosef("iam.DeactivateMFADevice call took %s", time.Since(start))
+	output, err := cmd.api.RebootInstances(input)
+	renv.Log().ExtraVerbosef("ec2.RebootInstances call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.RebootInstances")
 	}
 
 	var extracted interface{}
@@ -11099,14 +15470,14 @@ func (cmd *DetachMfadevice) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("detach mfadevice: AWS command returned nil output")
+			renv.Log().Warning("restart instance: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("detach mfadevice '%s' done", extracted)
+		renv.Log().Verbosef("restart instance '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("detach mfadevice done")
+		renv.Log().Verbose("restart instance done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -11118,16 +15489,37 @@ func (cmd *DetachMfadevice) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *DetachMfadevice) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("mfadevice"), nil
+func (cmd *RestartInstance) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.RebootInstancesInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.RebootInstancesInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.RebootInstances(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.RebootInstances call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: restart instance ok")
+			return fakeDryRunId("instance"), nil
+		}
+	}
+
+	return nil, err
 }
 
-func (cmd *DetachMfadevice) inject(params map[string]interface{}) error {
+func (cmd *RestartInstance) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDetachNetworkinterface(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachNetworkinterface {
-	cmd := new(DetachNetworkinterface)
+func NewRestoreBackup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *RestoreBackup {
+	cmd := new(RestoreBackup)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -11140,18 +15532,21 @@ func NewDetachNetworkinterface(sess *session.Session, g cloud.GraphAPI, l ...*lo
 	return cmd
 }
 
-func (cmd *DetachNetworkinterface) SetApi(api ec2iface.EC2API) {
+func (cmd *RestoreBackup) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DetachNetworkinterface) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *RestoreBackup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DetachNetworkinterface) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *RestoreBackup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -11164,7 +15559,7 @@ func (cmd *DetachNetworkinterface) run(renv env.Running, params map[string]inter
 
 	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.")
 	}
 
 	var extracted interface{}
@@ -11172,14 +15567,14 @@ func (cmd *DetachNetworkinterface) run(renv env.Running, params map[string]inter
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("detach networkinterface: AWS command returned nil output")
+			renv.Log().Warning("restore backup: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("detach networkinterface '%s' done", extracted)
+		renv.Log().Verbosef("restore backup '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("detach networkinterface done")
+		renv.Log().Verbose("restore backup done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -11191,36 +15586,43 @@ func (cmd *DetachNetworkinterface) run(renv env.Running, params map[string]inter
 	return extracted, nil
 }
 
-func (cmd *DetachNetworkinterface) inject(params map[string]interface{}) error {
+func (cmd *RestoreBackup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("backup"), nil
+}
+
+func (cmd *RestoreBackup) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDetachPolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachPolicy {
-	cmd := new(DetachPolicy)
+func NewStartAlarm(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *StartAlarm {
+	cmd := new(StartAlarm)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = cloudwatch.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DetachPolicy) SetApi(api iamiface.IAMAPI) {
+func (cmd *StartAlarm) SetApi(api cloudwatchiface.CloudWatchAPI) {
 	cmd.api = api
 }
 
-func (cmd *DetachPolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *StartAlarm) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DetachPolicy) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *StartAlarm) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -11231,9 +15633,15 @@ func (cmd *DetachPolicy) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &cloudwatch.EnableAlarmActionsInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in cloudwatch.EnableAlarmActionsInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.EnableAlarmActions(input)
+	renv.Log().ExtraVerbosef("cloudwatch.EnableAlarmActions call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "cloudwatch.EnableAlarmActions")
 	}
 
 	var extracted interface{}
@@ -11241,14 +15649,14 @@ func (cmd *DetachPolicy) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("detach policy: AWS command returned nil output")
+			renv.Log().Warning("start alarm: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("detach policy '%s' done", extracted)
+		renv.Log().Verbosef("start alarm '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("detach policy done")
+		renv.Log().Verbose("start alarm done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -11260,40 +15668,43 @@ func (cmd *DetachPolicy) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *DetachPolicy) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("policy"), nil
+func (cmd *StartAlarm) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("alarm"), nil
 }
 
-func (cmd *DetachPolicy) inject(params map[string]interface{}) error {
+func (cmd *StartAlarm) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDetachRole(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachRole {
-	cmd := new(DetachRole)
+func NewStartContainertask(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *StartContainertask {
+	cmd := new(StartContainertask)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = ecs.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DetachRole) SetApi(api iamiface.IAMAPI) {
+func (cmd *StartContainertask) SetApi(api ecsiface.ECSAPI) {
 	cmd.api = api
 }
 
-func (cmd *DetachRole) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *StartContainertask) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DetachRole) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *StartContainertask) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -11304,15 +15715,9 @@ func (cmd *DetachRole) run(renv env.Running, params map[string]interface{}) (int
 		}
 	}
 
-	input := &iam.RemoveRoleFromInstanceProfileInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.RemoveRoleFromInstanceProfileInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.RemoveRoleFromInstanceProfile(input)
-	renv.Log().ExtraVerbosef("iam.RemoveRoleFromInstanceProfile call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ecs.")
 	}
 
 	var extracted interface{}
@@ -11320,14 +15725,14 @@ func (cmd *DetachRole) run(renv env.Running, params map[string]interface{}) (int
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("detach role: AWS command returned nil output")
+			renv.Log().Warning("start containertask: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("detach role '%s' done", extracted)
+		renv.Log().Verbosef("start containertask '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("detach role done")
+		renv.Log().Verbose("start containertask done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -11339,40 +15744,43 @@ func (cmd *DetachRole) run(renv env.Running, params map[string]interface{}) (int
 	return extracted, nil
 }
 
-func (cmd *DetachRole) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("role"), nil
+func (cmd *StartContainertask) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("containertask"), nil
 }
 
-func (cmd *DetachRole) inject(params map[string]interface{}) error {
+func (cmd *StartContainertask) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDetachRoutetable(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachRoutetable {
-	cmd := new(DetachRoutetable)
+func NewStartDatabase(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *StartDatabase {
+	cmd := new(StartDatabase)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = rds.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DetachRoutetable) SetApi(api ec2iface.EC2API) {
+func (cmd *StartDatabase) SetApi(api rdsiface.RDSAPI) {
 	cmd.api = api
 }
 
-func (cmd *DetachRoutetable) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *StartDatabase) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DetachRoutetable) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *StartDatabase) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -11383,15 +15791,15 @@ func (cmd *DetachRoutetable) run(renv env.Running, params map[string]interface{}
 		}
 	}
 
-	input := &ec2.DisassociateRouteTableInput{}
+	input := &rds.StartDBInstanceInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DisassociateRouteTableInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in rds.StartDBInstanceInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DisassociateRouteTable(input)
-	renv.Log().ExtraVerbosef("ec2.DisassociateRouteTable call took %s", time.Since(start))
+	output, err := cmd.api.StartDBInstance(input)
+	renv.Log().ExtraVerbosef("rds.StartDBInstance call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "rds.StartDBInstance")
 	}
 
 	var extracted interface{}
@@ -11399,14 +15807,14 @@ func (cmd *DetachRoutetable) run(renv env.Running, params map[string]interface{}
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("detach routetable: AWS command returned nil output")
+			renv.Log().Warning("start database: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("detach routetable '%s' done", extracted)
+		renv.Log().Verbosef("start database '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("detach routetable done")
+		renv.Log().Verbose("start database done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -11418,37 +15826,16 @@ func (cmd *DetachRoutetable) run(renv env.Running, params map[string]interface{}
 	return extracted, nil
 }
 
-func (cmd *DetachRoutetable) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.DisassociateRouteTableInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DisassociateRouteTableInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.DisassociateRouteTable(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.DisassociateRouteTable call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: detach routetable ok")
-			return fakeDryRunId("routetable"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *StartDatabase) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("database"), nil
 }
 
-func (cmd *DetachRoutetable) inject(params map[string]interface{}) error {
+func (cmd *StartDatabase) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDetachSecuritygroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachSecuritygroup {
-	cmd := new(DetachSecuritygroup)
+func NewStartInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *StartInstance {
+	cmd := new(StartInstance)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -11461,18 +15848,21 @@ func NewDetachSecuritygroup(sess *session.Session, g cloud.GraphAPI, l ...*logge
 	return cmd
 }
 
-func (cmd *DetachSecuritygroup) SetApi(api ec2iface.EC2API) {
+func (cmd *StartInstance) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DetachSecuritygroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *StartInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DetachSecuritygroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *StartInstance) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -11483,9 +15873,15 @@ func (cmd *DetachSecuritygroup) run(renv env.Running, params map[string]interfac
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &ec2.StartInstancesInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.StartInstancesInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.StartInstances(input)
+	renv.Log().ExtraVerbosef("ec2.StartInstances call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.StartInstances")
 	}
 
 	var extracted interface{}
@@ -11493,14 +15889,14 @@ func (cmd *DetachSecuritygroup) run(renv env.Running, params map[string]interfac
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("detach securitygroup: AWS command returned nil output")
+			renv.Log().Warning("start instance: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("detach securitygroup '%s' done", extracted)
+		renv.Log().Verbosef("start instance '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("detach securitygroup done")
+		renv.Log().Verbose("start instance done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -11512,40 +15908,68 @@ func (cmd *DetachSecuritygroup) run(renv env.Running, params map[string]interfac
 	return extracted, nil
 }
 
-func (cmd *DetachSecuritygroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("securitygroup"), nil
+func (cmd *StartInstance) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.StartInstancesInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.StartInstancesInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.StartInstances(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.StartInstances call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: start instance ok")
+			return fakeDryRunId("instance"), nil
+		}
+	}
+
+	return nil, err
 }
 
-func (cmd *DetachSecuritygroup) inject(params map[string]interface{}) error {
+func (cmd *StartInstance) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDetachUser(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachUser {
-	cmd := new(DetachUser)
+func (cmd *StartInstance) ExtractResult(i interface{}) string {
+	return StringValue(i.(*ec2.StartInstancesOutput).StartingInstances[0].InstanceId)
+}
+
+func NewStopAlarm(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *StopAlarm {
+	cmd := new(StopAlarm)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = cloudwatch.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DetachUser) SetApi(api iamiface.IAMAPI) {
+func (cmd *StopAlarm) SetApi(api cloudwatchiface.CloudWatchAPI) {
 	cmd.api = api
 }
 
-func (cmd *DetachUser) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *StopAlarm) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DetachUser) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *StopAlarm) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -11556,15 +15980,15 @@ func (cmd *DetachUser) run(renv env.Running, params map[string]interface{}) (int
 		}
 	}
 
-	input := &iam.RemoveUserFromGroupInput{}
+	input := &cloudwatch.DisableAlarmActionsInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.RemoveUserFromGroupInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in cloudwatch.DisableAlarmActionsInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.RemoveUserFromGroup(input)
-	renv.Log().ExtraVerbosef("iam.RemoveUserFromGroup call took %s", time.Since(start))
+	output, err := cmd.api.DisableAlarmActions(input)
+	renv.Log().ExtraVerbosef("cloudwatch.DisableAlarmActions call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "cloudwatch.DisableAlarmActions")
 	}
 
 	var extracted interface{}
@@ -11572,14 +15996,14 @@ func (cmd *DetachUser) run(renv env.Running, params map[string]interface{}) (int
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("detach user: AWS command returned nil output")
+			renv.Log().Warning("stop alarm: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("detach user '%s' done", extracted)
+		renv.Log().Verbosef("stop alarm '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("detach user done")
+		renv.Log().Verbose("stop alarm done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -11591,40 +16015,43 @@ func (cmd *DetachUser) run(renv env.Running, params map[string]interface{}) (int
 	return extracted, nil
 }
 
-func (cmd *DetachUser) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("user"), nil
+func (cmd *StopAlarm) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("alarm"), nil
 }
 
-func (cmd *DetachUser) inject(params map[string]interface{}) error {
+func (cmd *StopAlarm) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDetachVolume(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DetachVolume {
-	cmd := new(DetachVolume)
+func NewStopContainertask(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *StopContainertask {
+	cmd := new(StopContainertask)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = ecs.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DetachVolume) SetApi(api ec2iface.EC2API) {
+func (cmd *StopContainertask) SetApi(api ecsiface.ECSAPI) {
 	cmd.api = api
 }
 
-func (cmd *DetachVolume) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *StopContainertask) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DetachVolume) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *StopContainertask) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -11635,15 +16062,9 @@ func (cmd *DetachVolume) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	input := &ec2.DetachVolumeInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DetachVolumeInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.DetachVolume(input)
-	renv.Log().ExtraVerbosef("ec2.DetachVolume call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ecs.")
 	}
 
 	var extracted interface{}
@@ -11651,14 +16072,14 @@ func (cmd *DetachVolume) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("detach volume: AWS command returned nil output")
+			renv.Log().Warning("stop containertask: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("detach volume '%s' done", extracted)
+		renv.Log().Verbosef("stop containertask '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("detach volume done")
+		renv.Log().Verbose("stop containertask done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -11670,61 +16091,43 @@ func (cmd *DetachVolume) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *DetachVolume) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.DetachVolumeInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DetachVolumeInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.DetachVolume(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.DetachVolume call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: detach volume ok")
-			return fakeDryRunId("volume"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *StopContainertask) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("containertask"), nil
 }
 
-func (cmd *DetachVolume) inject(params map[string]interface{}) error {
+func (cmd *StopContainertask) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewImportImage(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *ImportImage {
-	cmd := new(ImportImage)
+func NewStopDatabase(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *StopDatabase {
+	cmd := new(StopDatabase)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = rds.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *ImportImage) SetApi(api ec2iface.EC2API) {
+func (cmd *StopDatabase) SetApi(api rdsiface.RDSAPI) {
 	cmd.api = api
 }
 
-func (cmd *ImportImage) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *StopDatabase) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *ImportImage) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *StopDatabase) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -11735,15 +16138,15 @@ func (cmd *ImportImage) run(renv env.Running, params map[string]interface{}) (in
 		}
 	}
 
-	input := &ec2.ImportImageInput{}
+	input := &rds.StopDBInstanceInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.ImportImageInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in rds.StopDBInstanceInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.ImportImage(input)
-	renv.Log().ExtraVerbosef("ec2.ImportImage call took %s", time.Since(start))
+	output, err := cmd.api.StopDBInstance(input)
+	renv.Log().ExtraVerbosef("rds.StopDBInstance call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "rds.StopDBInstance")
 	}
 
 	var extracted interface{}
@@ -11751,14 +16154,14 @@ func (cmd *ImportImage) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("import image: AWS command returned nil output")
+			renv.Log().Warning("stop database: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("import image '%s' done", extracted)
+		renv.Log().Verbosef("stop database '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("import image done")
+		renv.Log().Verbose("stop database done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -11770,61 +16173,43 @@ func (cmd *ImportImage) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *ImportImage) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.ImportImageInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.ImportImageInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.ImportImage(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.ImportImage call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: import image ok")
-			return fakeDryRunId("image"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *StopDatabase) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("database"), nil
 }
 
-func (cmd *ImportImage) inject(params map[string]interface{}) error {
+func (cmd *StopDatabase) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewRestartDatabase(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *RestartDatabase {
-	cmd := new(RestartDatabase)
+func NewStopInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *StopInstance {
+	cmd := new(StopInstance)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = rds.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *RestartDatabase) SetApi(api rdsiface.RDSAPI) {
+func (cmd *StopInstance) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *RestartDatabase) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *StopInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *RestartDatabase) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *StopInstance) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -11835,15 +16220,15 @@ func (cmd *RestartDatabase) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &rds.RebootDBInstanceInput{}
+	input := &ec2.StopInstancesInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in rds.RebootDBInstanceInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.StopInstancesInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.RebootDBInstance(input)
-	renv.Log().ExtraVerbosef("rds.RebootDBInstance call took %s", time.Since(start))
+	output, err := cmd.api.StopInstances(input)
+	renv.Log().ExtraVerbosef("ec2.StopInstances call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.StopInstances")
 	}
 
 	var extracted interface{}
@@ -11851,14 +16236,14 @@ func (cmd *RestartDatabase) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("restart database: AWS command returned nil output")
+			renv.Log().Warning("stop instance: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("restart database '%s' done", extracted)
+		renv.Log().Verbosef("stop instance '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("restart database done")
+		renv.Log().Verbose("stop instance done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -11870,40 +16255,68 @@ func (cmd *RestartDatabase) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *RestartDatabase) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("database"), nil
+func (cmd *StopInstance) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.StopInstancesInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.StopInstancesInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.StopInstances(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.StopInstances call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: stop instance ok")
+			return fakeDryRunId("instance"), nil
+		}
+	}
+
+	return nil, err
 }
 
-func (cmd *RestartDatabase) inject(params map[string]interface{}) error {
+func (cmd *StopInstance) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewRestartInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *RestartInstance {
-	cmd := new(RestartInstance)
+func (cmd *StopInstance) ExtractResult(i interface{}) string {
+	return StringValue(i.(*ec2.StopInstancesOutput).StoppingInstances[0].InstanceId)
+}
+
+func NewUpdateBucket(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateBucket {
+	cmd := new(UpdateBucket)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = s3.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *RestartInstance) SetApi(api ec2iface.EC2API) {
+func (cmd *UpdateBucket) SetApi(api s3iface.S3API) {
 	cmd.api = api
 }
 
-func (cmd *RestartInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateBucket) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *RestartInstance) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateBucket) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -11914,15 +16327,9 @@ func (cmd *RestartInstance) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &ec2.RebootInstancesInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.RebootInstancesInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.RebootInstances(input)
-	renv.Log().ExtraVerbosef("ec2.RebootInstances call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "s3.")
 	}
 
 	var extracted interface{}
@@ -11930,14 +16337,14 @@ func (cmd *RestartInstance) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("restart instance: AWS command returned nil output")
+			renv.Log().Warning("update bucket: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("restart instance '%s' done", extracted)
+		renv.Log().Verbosef("update bucket '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("restart instance done")
+		renv.Log().Verbose("update bucket done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -11949,61 +16356,43 @@ func (cmd *RestartInstance) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *RestartInstance) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.RebootInstancesInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.RebootInstancesInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.RebootInstances(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.RebootInstances call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: restart instance ok")
-			return fakeDryRunId("instance"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *UpdateBucket) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("bucket"), nil
 }
 
-func (cmd *RestartInstance) inject(params map[string]interface{}) error {
+func (cmd *UpdateBucket) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewStartAlarm(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *StartAlarm {
-	cmd := new(StartAlarm)
+func NewUpdateClassicLoadbalancer(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateClassicLoadbalancer {
+	cmd := new(UpdateClassicLoadbalancer)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = cloudwatch.New(sess)
+		cmd.api = elb.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *StartAlarm) SetApi(api cloudwatchiface.CloudWatchAPI) {
+func (cmd *UpdateClassicLoadbalancer) SetApi(api elbiface.ELBAPI) {
 	cmd.api = api
 }
 
-func (cmd *StartAlarm) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateClassicLoadbalancer) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *StartAlarm) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateClassicLoadbalancer) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -12014,15 +16403,15 @@ func (cmd *StartAlarm) run(renv env.Running, params map[string]interface{}) (int
 		}
 	}
 
-	input := &cloudwatch.EnableAlarmActionsInput{}
+	input := &elb.ConfigureHealthCheckInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in cloudwatch.EnableAlarmActionsInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in elb.ConfigureHealthCheckInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.EnableAlarmActions(input)
-	renv.Log().ExtraVerbosef("cloudwatch.EnableAlarmActions call took %s", time.Since(start))
+	output, err := cmd.api.ConfigureHealthCheck(input)
+	renv.Log().ExtraVerbosef("elb.ConfigureHealthCheck call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "elb.ConfigureHealthCheck")
 	}
 
 	var extracted interface{}
@@ -12030,14 +16419,14 @@ func (cmd *StartAlarm) run(renv env.Running, params map[string]interface{}) (int
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("start alarm: AWS command returned nil output")
+			renv.Log().Warning("update classicloadbalancer: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("start alarm '%s' done", extracted)
+		renv.Log().Verbosef("update classicloadbalancer '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("start alarm done")
+		renv.Log().Verbose("update classicloadbalancer done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -12049,16 +16438,16 @@ func (cmd *StartAlarm) run(renv env.Running, params map[string]interface{}) (int
 	return extracted, nil
 }
 
-func (cmd *StartAlarm) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("alarm"), nil
+func (cmd *UpdateClassicLoadbalancer) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("classicloadbalancer"), nil
 }
 
-func (cmd *StartAlarm) inject(params map[string]interface{}) error {
+func (cmd *UpdateClassicLoadbalancer) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewStartContainertask(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *StartContainertask {
-	cmd := new(StartContainertask)
+func NewUpdateContainertask(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateContainertask {
+	cmd := new(UpdateContainertask)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -12071,18 +16460,21 @@ func NewStartContainertask(sess *session.Session, g cloud.GraphAPI, l ...*logger
 	return cmd
 }
 
-func (cmd *StartContainertask) SetApi(api ecsiface.ECSAPI) {
+func (cmd *UpdateContainertask) SetApi(api ecsiface.ECSAPI) {
 	cmd.api = api
 }
 
-func (cmd *StartContainertask) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateContainertask) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *StartContainertask) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateContainertask) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -12093,9 +16485,15 @@ func (cmd *StartContainertask) run(renv env.Running, params map[string]interface
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &ecs.UpdateServiceInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ecs.UpdateServiceInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.UpdateService(input)
+	renv.Log().ExtraVerbosef("ecs.UpdateService call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ecs.UpdateService")
 	}
 
 	var extracted interface{}
@@ -12103,14 +16501,14 @@ func (cmd *StartContainertask) run(renv env.Running, params map[string]interface
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("start containertask: AWS command returned nil output")
+			renv.Log().Warning("update containertask: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("start containertask '%s' done", extracted)
+		renv.Log().Verbosef("update containertask '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("start containertask done")
+		renv.Log().Verbose("update containertask done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -12122,40 +16520,43 @@ func (cmd *StartContainertask) run(renv env.Running, params map[string]interface
 	return extracted, nil
 }
 
-func (cmd *StartContainertask) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateContainertask) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	return fakeDryRunId("containertask"), nil
 }
 
-func (cmd *StartContainertask) inject(params map[string]interface{}) error {
+func (cmd *UpdateContainertask) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewStartDatabase(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *StartDatabase {
-	cmd := new(StartDatabase)
+func NewUpdateDistribution(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateDistribution {
+	cmd := new(UpdateDistribution)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = rds.New(sess)
+		cmd.api = cloudfront.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *StartDatabase) SetApi(api rdsiface.RDSAPI) {
+func (cmd *UpdateDistribution) SetApi(api cloudfrontiface.CloudFrontAPI) {
 	cmd.api = api
 }
 
-func (cmd *StartDatabase) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateDistribution) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *StartDatabase) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateDistribution) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -12166,15 +16567,9 @@ func (cmd *StartDatabase) run(renv env.Running, params map[string]interface{}) (
 		}
 	}
 
-	input := &rds.StartDBInstanceInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in rds.StartDBInstanceInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.StartDBInstance(input)
-	renv.Log().ExtraVerbosef("rds.StartDBInstance call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "cloudfront.")
 	}
 
 	var extracted interface{}
@@ -12182,14 +16577,14 @@ func (cmd *StartDatabase) run(renv env.Running, params map[string]interface{}) (
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("start database: AWS command returned nil output")
+			renv.Log().Warning("update distribution: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("start database '%s' done", extracted)
+		renv.Log().Verbosef("update distribution '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("start database done")
+		renv.Log().Verbose("update distribution done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -12201,40 +16596,43 @@ func (cmd *StartDatabase) run(renv env.Running, params map[string]interface{}) (
 	return extracted, nil
 }
 
-func (cmd *StartDatabase) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("database"), nil
+func (cmd *UpdateDistribution) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("distribution"), nil
 }
 
-func (cmd *StartDatabase) inject(params map[string]interface{}) error {
+func (cmd *UpdateDistribution) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewStartInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *StartInstance {
-	cmd := new(StartInstance)
+func NewUpdateElasticsearchdomain(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateElasticsearchdomain {
+	cmd := new(UpdateElasticsearchdomain)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = elasticsearchservice.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *StartInstance) SetApi(api ec2iface.EC2API) {
+func (cmd *UpdateElasticsearchdomain) SetApi(api elasticsearchserviceiface.ElasticsearchServiceAPI) {
 	cmd.api = api
 }
 
-func (cmd *StartInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateElasticsearchdomain) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *StartInstance) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateElasticsearchdomain) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -12245,15 +16643,9 @@ func (cmd *StartInstance) run(renv env.Running, params map[string]interface{}) (
 		}
 	}
 
-	input := &ec2.StartInstancesInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.StartInstancesInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.StartInstances(input)
-	renv.Log().ExtraVerbosef("ec2.StartInstances call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "elasticsearchservice.")
 	}
 
 	var extracted interface{}
@@ -12261,14 +16653,14 @@ func (cmd *StartInstance) run(renv env.Running, params map[string]interface{}) (
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("start instance: AWS command returned nil output")
+			renv.Log().Warning("update elasticsearchdomain: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("start instance '%s' done", extracted)
+		renv.Log().Verbosef("update elasticsearchdomain '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("start instance done")
+		renv.Log().Verbose("update elasticsearchdomain done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -12280,61 +16672,43 @@ func (cmd *StartInstance) run(renv env.Running, params map[string]interface{}) (
 	return extracted, nil
 }
 
-func (cmd *StartInstance) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.StartInstancesInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.StartInstancesInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.StartInstances(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.StartInstances call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: start instance ok")
-			return fakeDryRunId("instance"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *UpdateElasticsearchdomain) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("elasticsearchdomain"), nil
 }
 
-func (cmd *StartInstance) inject(params map[string]interface{}) error {
+func (cmd *UpdateElasticsearchdomain) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewStopAlarm(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *StopAlarm {
-	cmd := new(StopAlarm)
+func NewUpdateEnvironment(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateEnvironment {
+	cmd := new(UpdateEnvironment)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = cloudwatch.New(sess)
+		cmd.api = elasticbeanstalk.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *StopAlarm) SetApi(api cloudwatchiface.CloudWatchAPI) {
+func (cmd *UpdateEnvironment) SetApi(api elasticbeanstalkiface.ElasticBeanstalkAPI) {
 	cmd.api = api
 }
 
-func (cmd *StopAlarm) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateEnvironment) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *StopAlarm) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateEnvironment) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -12345,15 +16719,9 @@ func (cmd *StopAlarm) run(renv env.Running, params map[string]interface{}) (inte
 		}
 	}
 
-	input := &cloudwatch.DisableAlarmActionsInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in cloudwatch.DisableAlarmActionsInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.DisableAlarmActions(input)
-	renv.Log().ExtraVerbosef("cloudwatch.DisableAlarmActions call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "elasticbeanstalk.")
 	}
 
 	var extracted interface{}
@@ -12361,14 +16729,14 @@ func (cmd *StopAlarm) run(renv env.Running, params map[string]interface{}) (inte
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("stop alarm: AWS command returned nil output")
+			renv.Log().Warning("update environment: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("stop alarm '%s' done", extracted)
+		renv.Log().Verbosef("update environment '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("stop alarm done")
+		renv.Log().Verbose("update environment done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -12380,40 +16748,43 @@ func (cmd *StopAlarm) run(renv env.Running, params map[string]interface{}) (inte
 	return extracted, nil
 }
 
-func (cmd *StopAlarm) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("alarm"), nil
+func (cmd *UpdateEnvironment) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("environment"), nil
 }
 
-func (cmd *StopAlarm) inject(params map[string]interface{}) error {
+func (cmd *UpdateEnvironment) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewStopContainertask(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *StopContainertask {
-	cmd := new(StopContainertask)
+func NewUpdateFunction(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateFunction {
+	cmd := new(UpdateFunction)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ecs.New(sess)
+		cmd.api = lambda.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *StopContainertask) SetApi(api ecsiface.ECSAPI) {
+func (cmd *UpdateFunction) SetApi(api lambdaiface.LambdaAPI) {
 	cmd.api = api
 }
 
-func (cmd *StopContainertask) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateFunction) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *StopContainertask) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateFunction) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -12426,7 +16797,7 @@ func (cmd *StopContainertask) run(renv env.Running, params map[string]interface{
 
 	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "lambda.")
 	}
 
 	var extracted interface{}
@@ -12434,14 +16805,14 @@ func (cmd *StopContainertask) run(renv env.Running, params map[string]interface{
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("stop containertask: AWS command returned nil output")
+			renv.Log().Warning("update function: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("stop containertask '%s' done", extracted)
+		renv.Log().Verbosef("update function '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("stop containertask done")
+		renv.Log().Verbose("update function done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -12453,40 +16824,39 @@ func (cmd *StopContainertask) run(renv env.Running, params map[string]interface{
 	return extracted, nil
 }
 
-func (cmd *StopContainertask) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("containertask"), nil
-}
-
-func (cmd *StopContainertask) inject(params map[string]interface{}) error {
+func (cmd *UpdateFunction) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewStopDatabase(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *StopDatabase {
-	cmd := new(StopDatabase)
+func NewUpdateImage(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateImage {
+	cmd := new(UpdateImage)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = rds.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *StopDatabase) SetApi(api rdsiface.RDSAPI) {
+func (cmd *UpdateImage) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *StopDatabase) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateImage) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *StopDatabase) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateImage) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -12497,15 +16867,9 @@ func (cmd *StopDatabase) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	input := &rds.StopDBInstanceInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in rds.StopDBInstanceInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.StopDBInstance(input)
-	renv.Log().ExtraVerbosef("rds.StopDBInstance call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.")
 	}
 
 	var extracted interface{}
@@ -12513,14 +16877,14 @@ func (cmd *StopDatabase) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("stop database: AWS command returned nil output")
+			renv.Log().Warning("update image: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("stop database '%s' done", extracted)
+		renv.Log().Verbosef("update image '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("stop database done")
+		renv.Log().Verbose("update image done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -12532,16 +16896,12 @@ func (cmd *StopDatabase) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *StopDatabase) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("database"), nil
-}
-
-func (cmd *StopDatabase) inject(params map[string]interface{}) error {
+func (cmd *UpdateImage) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewStopInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *StopInstance {
-	cmd := new(StopInstance)
+func NewUpdateInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateInstance {
+	cmd := new(UpdateInstance)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -12554,18 +16914,21 @@ func NewStopInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logge
 	return cmd
 }
 
-func (cmd *StopInstance) SetApi(api ec2iface.EC2API) {
+func (cmd *UpdateInstance) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *StopInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *StopInstance) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateInstance) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -12576,15 +16939,15 @@ func (cmd *StopInstance) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	input := &ec2.StopInstancesInput{}
+	input := &ec2.ModifyInstanceAttributeInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.StopInstancesInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.ModifyInstanceAttributeInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.StopInstances(input)
-	renv.Log().ExtraVerbosef("ec2.StopInstances call took %s", time.Since(start))
+	output, err := cmd.api.ModifyInstanceAttribute(input)
+	renv.Log().ExtraVerbosef("ec2.ModifyInstanceAttribute call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.ModifyInstanceAttribute")
 	}
 
 	var extracted interface{}
@@ -12592,14 +16955,14 @@ func (cmd *StopInstance) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("stop instance: AWS command returned nil output")
+			renv.Log().Warning("update instance: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("stop instance '%s' done", extracted)
+		renv.Log().Verbosef("update instance '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("stop instance done")
+		renv.Log().Verbose("update instance done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -12611,24 +16974,24 @@ func (cmd *StopInstance) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *StopInstance) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateInstance) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
 
-	input := &ec2.StopInstancesInput{}
+	input := &ec2.ModifyInstanceAttributeInput{}
 	input.SetDryRun(true)
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.StopInstancesInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.ModifyInstanceAttributeInput: %s", err)
 	}
 
 	start := time.Now()
-	_, err := cmd.api.StopInstances(input)
+	_, err := cmd.api.ModifyInstanceAttribute(input)
 	if awsErr, ok := err.(awserr.Error); ok {
 		switch code := awsErr.Code(); {
 		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.StopInstances call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: stop instance ok")
+			renv.Log().ExtraVerbosef("dry run: ec2.ModifyInstanceAttribute call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: update instance ok")
 			return fakeDryRunId("instance"), nil
 		}
 	}
@@ -12636,36 +16999,39 @@ func (cmd *StopInstance) dryRun(renv env.Running, params map[string]interface{})
 	return nil, err
 }
 
-func (cmd *StopInstance) inject(params map[string]interface{}) error {
+func (cmd *UpdateInstance) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewUpdateBucket(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateBucket {
-	cmd := new(UpdateBucket)
+func NewUpdateLoginprofile(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateLoginprofile {
+	cmd := new(UpdateLoginprofile)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = s3.New(sess)
+		cmd.api = iam.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *UpdateBucket) SetApi(api s3iface.S3API) {
+func (cmd *UpdateLoginprofile) SetApi(api iamiface.IAMAPI) {
 	cmd.api = api
 }
 
-func (cmd *UpdateBucket) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateLoginprofile) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *UpdateBucket) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateLoginprofile) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -12676,9 +17042,15 @@ func (cmd *UpdateBucket) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &iam.UpdateLoginProfileInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in iam.UpdateLoginProfileInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.UpdateLoginProfile(input)
+	renv.Log().ExtraVerbosef("iam.UpdateLoginProfile call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "iam.UpdateLoginProfile")
 	}
 
 	var extracted interface{}
@@ -12686,14 +17058,14 @@ func (cmd *UpdateBucket) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("update bucket: AWS command returned nil output")
+			renv.Log().Warning("update loginprofile: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("update bucket '%s' done", extracted)
+		renv.Log().Verbosef("update loginprofile '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("update bucket done")
+		renv.Log().Verbose("update loginprofile done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -12705,40 +17077,43 @@ func (cmd *UpdateBucket) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *UpdateBucket) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("bucket"), nil
+func (cmd *UpdateLoginprofile) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("loginprofile"), nil
 }
 
-func (cmd *UpdateBucket) inject(params map[string]interface{}) error {
+func (cmd *UpdateLoginprofile) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewUpdateClassicLoadbalancer(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateClassicLoadbalancer {
-	cmd := new(UpdateClassicLoadbalancer)
+func NewUpdatePolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdatePolicy {
+	cmd := new(UpdatePolicy)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = elb.New(sess)
+		cmd.api = iam.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *UpdateClassicLoadbalancer) SetApi(api elbiface.ELBAPI) {
+func (cmd *UpdatePolicy) SetApi(api iamiface.IAMAPI) {
 	cmd.api = api
 }
 
-func (cmd *UpdateClassicLoadbalancer) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdatePolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *UpdateClassicLoadbalancer) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdatePolicy) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -12749,15 +17124,15 @@ func (cmd *UpdateClassicLoadbalancer) run(renv env.Running, params map[string]in
 		}
 	}
 
-	input := &elb.ConfigureHealthCheckInput{}
+	input := &iam.CreatePolicyVersionInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in elb.ConfigureHealthCheckInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in iam.CreatePolicyVersionInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.ConfigureHealthCheck(input)
-	renv.Log().ExtraVerbosef("elb.ConfigureHealthCheck call took %s", time.Since(start))
+	output, err := cmd.api.CreatePolicyVersion(input)
+	renv.Log().ExtraVerbosef("iam.CreatePolicyVersion call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "iam.CreatePolicyVersion")
 	}
 
 	var extracted interface{}
@@ -12765,14 +17140,14 @@ func (cmd *UpdateClassicLoadbalancer) run(renv env.Running, params map[string]in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("update classicloadbalancer: AWS command returned nil output")
+			renv.Log().Warning("update policy: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("update classicloadbalancer '%s' done", extracted)
+		renv.Log().Verbosef("update policy '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("update classicloadbalancer done")
+		renv.Log().Verbose("update policy done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -12784,40 +17159,43 @@ func (cmd *UpdateClassicLoadbalancer) run(renv env.Running, params map[string]in
 	return extracted, nil
 }
 
-func (cmd *UpdateClassicLoadbalancer) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("classicloadbalancer"), nil
+func (cmd *UpdatePolicy) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("policy"), nil
 }
 
-func (cmd *UpdateClassicLoadbalancer) inject(params map[string]interface{}) error {
+func (cmd *UpdatePolicy) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewUpdateContainertask(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateContainertask {
-	cmd := new(UpdateContainertask)
+func NewUpdateQueue(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateQueue {
+	cmd := new(UpdateQueue)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ecs.New(sess)
+		cmd.api = sqs.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *UpdateContainertask) SetApi(api ecsiface.ECSAPI) {
+func (cmd *UpdateQueue) SetApi(api sqsiface.SQSAPI) {
 	cmd.api = api
 }
 
-func (cmd *UpdateContainertask) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateQueue) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *UpdateContainertask) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateQueue) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -12828,15 +17206,15 @@ func (cmd *UpdateContainertask) run(renv env.Running, params map[string]interfac
 		}
 	}
 
-	input := &ecs.UpdateServiceInput{}
+	input := &sqs.SetQueueAttributesInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ecs.UpdateServiceInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in sqs.SetQueueAttributesInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.UpdateService(input)
-	renv.Log().ExtraVerbosef("ecs.UpdateService call took %s", time.Since(start))
+	output, err := cmd.api.SetQueueAttributes(input)
+	renv.Log().ExtraVerbosef("sqs.SetQueueAttributes call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "sqs.SetQueueAttributes")
 	}
 
 	var extracted interface{}
@@ -12844,14 +17222,14 @@ func (cmd *UpdateContainertask) run(renv env.Running, params map[string]interfac
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("update containertask: AWS command returned nil output")
+			renv.Log().Warning("update queue: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("update containertask '%s' done", extracted)
+		renv.Log().Verbosef("update queue '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("update containertask done")
+		renv.Log().Verbose("update queue done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -12863,40 +17241,43 @@ func (cmd *UpdateContainertask) run(renv env.Running, params map[string]interfac
 	return extracted, nil
 }
 
-func (cmd *UpdateContainertask) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("containertask"), nil
+func (cmd *UpdateQueue) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("queue"), nil
 }
 
-func (cmd *UpdateContainertask) inject(params map[string]interface{}) error {
+func (cmd *UpdateQueue) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewUpdateDistribution(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateDistribution {
-	cmd := new(UpdateDistribution)
+func NewUpdateRecord(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateRecord {
+	cmd := new(UpdateRecord)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = cloudfront.New(sess)
+		cmd.api = route53.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *UpdateDistribution) SetApi(api cloudfrontiface.CloudFrontAPI) {
+func (cmd *UpdateRecord) SetApi(api route53iface.Route53API) {
 	cmd.api = api
 }
 
-func (cmd *UpdateDistribution) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateRecord) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *UpdateDistribution) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateRecord) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -12909,7 +17290,7 @@ func (cmd *UpdateDistribution) run(renv env.Running, params map[string]interface
 
 	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "route53.")
 	}
 
 	var extracted interface{}
@@ -12917,14 +17298,14 @@ func (cmd *UpdateDistribution) run(renv env.Running, params map[string]interface
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("update distribution: AWS command returned nil output")
+			renv.Log().Warning("update record: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("update distribution '%s' done", extracted)
+		renv.Log().Verbosef("update record '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("update distribution done")
+		renv.Log().Verbose("update record done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -12936,40 +17317,43 @@ func (cmd *UpdateDistribution) run(renv env.Running, params map[string]interface
 	return extracted, nil
 }
 
-func (cmd *UpdateDistribution) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("distribution"), nil
+func (cmd *UpdateRecord) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("record"), nil
 }
 
-func (cmd *UpdateDistribution) inject(params map[string]interface{}) error {
+func (cmd *UpdateRecord) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewUpdateImage(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateImage {
-	cmd := new(UpdateImage)
+func NewUpdateRecords(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateRecords {
+	cmd := new(UpdateRecords)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = route53.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *UpdateImage) SetApi(api ec2iface.EC2API) {
+func (cmd *UpdateRecords) SetApi(api route53iface.Route53API) {
 	cmd.api = api
 }
 
-func (cmd *UpdateImage) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateRecords) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *UpdateImage) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateRecords) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -12982,7 +17366,7 @@ func (cmd *UpdateImage) run(renv env.Running, params map[string]interface{}) (in
 
 	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "route53.")
 	}
 
 	var extracted interface{}
@@ -12990,14 +17374,14 @@ func (cmd *UpdateImage) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("update image: AWS command returned nil output")
+			renv.Log().Warning("update records: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("update image '%s' done", extracted)
+		renv.Log().Verbosef("update records '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("update image done")
+		renv.Log().Verbose("update records done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -13009,36 +17393,43 @@ func (cmd *UpdateImage) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *UpdateImage) inject(params map[string]interface{}) error {
+func (cmd *UpdateRecords) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("records"), nil
+}
+
+func (cmd *UpdateRecords) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewUpdateInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateInstance {
-	cmd := new(UpdateInstance)
+func NewUpdateS3object(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateS3object {
+	cmd := new(UpdateS3object)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = s3.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *UpdateInstance) SetApi(api ec2iface.EC2API) {
+func (cmd *UpdateS3object) SetApi(api s3iface.S3API) {
 	cmd.api = api
 }
 
-func (cmd *UpdateInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateS3object) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *UpdateInstance) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateS3object) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -13049,15 +17440,15 @@ func (cmd *UpdateInstance) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &ec2.ModifyInstanceAttributeInput{}
+	input := &s3.PutObjectAclInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.ModifyInstanceAttributeInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in s3.PutObjectAclInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.ModifyInstanceAttribute(input)
-	renv.Log().ExtraVerbosef("ec2.ModifyInstanceAttribute call took %s", time.Since(start))
+	output, err := cmd.api.PutObjectAcl(input)
+	renv.Log().ExtraVerbosef("s3.PutObjectAcl call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "s3.PutObjectAcl")
 	}
 
 	var extracted interface{}
@@ -13065,80 +17456,62 @@ func (cmd *UpdateInstance) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("update instance: AWS command returned nil output")
+			renv.Log().Warning("update s3object: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("update instance '%s' done", extracted)
+		renv.Log().Verbosef("update s3object '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("update instance done")
-	}
-
-	if v, ok := implementsAfterRun(cmd); ok {
-		if brErr := v.AfterRun(renv, output); brErr != nil {
-			return nil, fmt.Errorf("after run: %s", brErr)
-		}
-	}
-
-	return extracted, nil
-}
-
-func (cmd *UpdateInstance) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.ModifyInstanceAttributeInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.ModifyInstanceAttributeInput: %s", err)
+		renv.Log().Verbose("update s3object done")
 	}
 
-	start := time.Now()
-	_, err := cmd.api.ModifyInstanceAttribute(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.ModifyInstanceAttribute call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: update instance ok")
-			return fakeDryRunId("instance"), nil
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
 		}
 	}
 
-	return nil, err
+	return extracted, nil
 }
 
-func (cmd *UpdateInstance) inject(params map[string]interface{}) error {
+func (cmd *UpdateS3object) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("s3object"), nil
+}
+
+func (cmd *UpdateS3object) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewUpdateLoginprofile(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateLoginprofile {
-	cmd := new(UpdateLoginprofile)
+func NewUpdateScalinggroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateScalinggroup {
+	cmd := new(UpdateScalinggroup)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = autoscaling.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *UpdateLoginprofile) SetApi(api iamiface.IAMAPI) {
+func (cmd *UpdateScalinggroup) SetApi(api autoscalingiface.AutoScalingAPI) {
 	cmd.api = api
 }
 
-func (cmd *UpdateLoginprofile) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateScalinggroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *UpdateLoginprofile) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateScalinggroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -13149,15 +17522,15 @@ func (cmd *UpdateLoginprofile) run(renv env.Running, params map[string]interface
 		}
 	}
 
-	input := &iam.UpdateLoginProfileInput{}
+	input := &autoscaling.UpdateAutoScalingGroupInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.UpdateLoginProfileInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in autoscaling.UpdateAutoScalingGroupInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.UpdateLoginProfile(input)
-	renv.Log().ExtraVerbosef("iam.UpdateLoginProfile call took %s", time.Since(start))
+	output, err := cmd.api.UpdateAutoScalingGroup(input)
+	renv.Log().ExtraVerbosef("autoscaling.UpdateAutoScalingGroup call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "autoscaling.UpdateAutoScalingGroup")
 	}
 
 	var extracted interface{}
@@ -13165,14 +17538,14 @@ func (cmd *UpdateLoginprofile) run(renv env.Running, params map[string]interface
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("update loginprofile: AWS command returned nil output")
+			renv.Log().Warning("update scalinggroup: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("update loginprofile '%s' done", extracted)
+		renv.Log().Verbosef("update scalinggroup '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("update loginprofile done")
+		renv.Log().Verbose("update scalinggroup done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -13184,40 +17557,43 @@ func (cmd *UpdateLoginprofile) run(renv env.Running, params map[string]interface
 	return extracted, nil
 }
 
-func (cmd *UpdateLoginprofile) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("loginprofile"), nil
+func (cmd *UpdateScalinggroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("scalinggroup"), nil
 }
 
-func (cmd *UpdateLoginprofile) inject(params map[string]interface{}) error {
+func (cmd *UpdateScalinggroup) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewUpdatePolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdatePolicy {
-	cmd := new(UpdatePolicy)
+func NewUpdateSecuritygroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateSecuritygroup {
+	cmd := new(UpdateSecuritygroup)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *UpdatePolicy) SetApi(api iamiface.IAMAPI) {
+func (cmd *UpdateSecuritygroup) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *UpdatePolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateSecuritygroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *UpdatePolicy) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateSecuritygroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -13228,15 +17604,9 @@ func (cmd *UpdatePolicy) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	input := &iam.CreatePolicyVersionInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.CreatePolicyVersionInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.CreatePolicyVersion(input)
-	renv.Log().ExtraVerbosef("iam.CreatePolicyVersion call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.")
 	}
 
 	var extracted interface{}
@@ -13244,14 +17614,14 @@ func (cmd *UpdatePolicy) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("update policy: AWS command returned nil output")
+			renv.Log().Warning("update securitygroup: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("update policy '%s' done", extracted)
+		renv.Log().Verbosef("update securitygroup '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("update policy done")
+		renv.Log().Verbose("update securitygroup done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -13263,40 +17633,39 @@ func (cmd *UpdatePolicy) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *UpdatePolicy) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("policy"), nil
-}
-
-func (cmd *UpdatePolicy) inject(params map[string]interface{}) error {
+func (cmd *UpdateSecuritygroup) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewUpdateRecord(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateRecord {
-	cmd := new(UpdateRecord)
+func NewUpdateStack(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateStack {
+	cmd := new(UpdateStack)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = route53.New(sess)
+		cmd.api = cloudformation.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *UpdateRecord) SetApi(api route53iface.Route53API) {
+func (cmd *UpdateStack) SetApi(api cloudformationiface.CloudFormationAPI) {
 	cmd.api = api
 }
 
-func (cmd *UpdateRecord) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateStack) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *UpdateRecord) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateStack) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -13307,9 +17676,15 @@ func (cmd *UpdateRecord) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &cloudformation.UpdateStackInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in cloudformation.UpdateStackInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.UpdateStack(input)
+	renv.Log().ExtraVerbosef("cloudformation.UpdateStack call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "cloudformation.UpdateStack")
 	}
 
 	var extracted interface{}
@@ -13317,14 +17692,14 @@ func (cmd *UpdateRecord) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("update record: AWS command returned nil output")
+			renv.Log().Warning("update stack: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("update record '%s' done", extracted)
+		renv.Log().Verbosef("update stack '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("update record done")
+		renv.Log().Verbose("update stack done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -13336,40 +17711,47 @@ func (cmd *UpdateRecord) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *UpdateRecord) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("record"), nil
+func (cmd *UpdateStack) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("stack"), nil
 }
 
-func (cmd *UpdateRecord) inject(params map[string]interface{}) error {
+func (cmd *UpdateStack) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewUpdateS3object(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateS3object {
-	cmd := new(UpdateS3object)
+func (cmd *UpdateStack) ExtractResult(i interface{}) string {
+	return StringValue(i.(*cloudformation.UpdateStackOutput).StackId)
+}
+
+func NewUpdateSubnet(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateSubnet {
+	cmd := new(UpdateSubnet)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = s3.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *UpdateS3object) SetApi(api s3iface.S3API) {
+func (cmd *UpdateSubnet) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *UpdateS3object) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateSubnet) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *UpdateS3object) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateSubnet) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -13380,15 +17762,9 @@ func (cmd *UpdateS3object) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &s3.PutObjectAclInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in s3.PutObjectAclInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.PutObjectAcl(input)
-	renv.Log().ExtraVerbosef("s3.PutObjectAcl call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.")
 	}
 
 	var extracted interface{}
@@ -13396,14 +17772,14 @@ func (cmd *UpdateS3object) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("update s3object: AWS command returned nil output")
+			renv.Log().Warning("update subnet: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("update s3object '%s' done", extracted)
+		renv.Log().Verbosef("update subnet '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("update s3object done")
+		renv.Log().Verbose("update subnet done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -13415,40 +17791,43 @@ func (cmd *UpdateS3object) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *UpdateS3object) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("s3object"), nil
+func (cmd *UpdateSubnet) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("subnet"), nil
 }
 
-func (cmd *UpdateS3object) inject(params map[string]interface{}) error {
+func (cmd *UpdateSubnet) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewUpdateScalinggroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateScalinggroup {
-	cmd := new(UpdateScalinggroup)
+func NewUpdateTable(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateTable {
+	cmd := new(UpdateTable)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = autoscaling.New(sess)
+		cmd.api = dynamodb.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *UpdateScalinggroup) SetApi(api autoscalingiface.AutoScalingAPI) {
+func (cmd *UpdateTable) SetApi(api dynamodbiface.DynamoDBAPI) {
 	cmd.api = api
 }
 
-func (cmd *UpdateScalinggroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateTable) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *UpdateScalinggroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateTable) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -13459,15 +17838,9 @@ func (cmd *UpdateScalinggroup) run(renv env.Running, params map[string]interface
 		}
 	}
 
-	input := &autoscaling.UpdateAutoScalingGroupInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in autoscaling.UpdateAutoScalingGroupInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.UpdateAutoScalingGroup(input)
-	renv.Log().ExtraVerbosef("autoscaling.UpdateAutoScalingGroup call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "dynamodb.")
 	}
 
 	var extracted interface{}
@@ -13475,14 +17848,14 @@ func (cmd *UpdateScalinggroup) run(renv env.Running, params map[string]interface
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("update scalinggroup: AWS command returned nil output")
+			renv.Log().Warning("update table: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("update scalinggroup '%s' done", extracted)
+		renv.Log().Verbosef("update table '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("update scalinggroup done")
+		renv.Log().Verbose("update table done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -13494,40 +17867,43 @@ func (cmd *UpdateScalinggroup) run(renv env.Running, params map[string]interface
 	return extracted, nil
 }
 
-func (cmd *UpdateScalinggroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("scalinggroup"), nil
+func (cmd *UpdateTable) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("table"), nil
 }
 
-func (cmd *UpdateScalinggroup) inject(params map[string]interface{}) error {
+func (cmd *UpdateTable) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewUpdateSecuritygroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateSecuritygroup {
-	cmd := new(UpdateSecuritygroup)
+func NewUpdateTargetgroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateTargetgroup {
+	cmd := new(UpdateTargetgroup)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = elbv2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *UpdateSecuritygroup) SetApi(api ec2iface.EC2API) {
+func (cmd *UpdateTargetgroup) SetApi(api elbv2iface.ELBV2API) {
 	cmd.api = api
 }
 
-func (cmd *UpdateSecuritygroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateTargetgroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *UpdateSecuritygroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateTargetgroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -13540,7 +17916,7 @@ func (cmd *UpdateSecuritygroup) run(renv env.Running, params map[string]interfac
 
 	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "elbv2.")
 	}
 
 	var extracted interface{}
@@ -13548,14 +17924,14 @@ func (cmd *UpdateSecuritygroup) run(renv env.Running, params map[string]interfac
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("update securitygroup: AWS command returned nil output")
+			renv.Log().Warning("update targetgroup: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("update securitygroup '%s' done", extracted)
+		renv.Log().Verbosef("update targetgroup '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("update securitygroup done")
+		renv.Log().Verbose("update targetgroup done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -13567,36 +17943,43 @@ func (cmd *UpdateSecuritygroup) run(renv env.Running, params map[string]interfac
 	return extracted, nil
 }
 
-func (cmd *UpdateSecuritygroup) inject(params map[string]interface{}) error {
+func (cmd *UpdateTargetgroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("targetgroup"), nil
+}
+
+func (cmd *UpdateTargetgroup) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewUpdateStack(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateStack {
-	cmd := new(UpdateStack)
+func NewUpdateVpc(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateVpc {
+	cmd := new(UpdateVpc)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = cloudformation.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *UpdateStack) SetApi(api cloudformationiface.CloudFormationAPI) {
+func (cmd *UpdateVpc) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *UpdateStack) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateVpc) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *UpdateStack) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *UpdateVpc) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -13607,15 +17990,9 @@ func (cmd *UpdateStack) run(renv env.Running, params map[string]interface{}) (in
 		}
 	}
 
-	input := &cloudformation.UpdateStackInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in cloudformation.UpdateStackInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.UpdateStack(input)
-	renv.Log().ExtraVerbosef("cloudformation.UpdateStack call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ec2.")
 	}
 
 	var extracted interface{}
@@ -13623,14 +18000,14 @@ func (cmd *UpdateStack) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("update stack: AWS command returned nil output")
+			renv.Log().Warning("update vpc: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("update stack '%s' done", extracted)
+		renv.Log().Verbosef("update vpc '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("update stack done")
+		renv.Log().Verbose("update vpc done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -13642,40 +18019,43 @@ func (cmd *UpdateStack) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *UpdateStack) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("stack"), nil
+func (cmd *UpdateVpc) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("vpc"), nil
 }
 
-func (cmd *UpdateStack) inject(params map[string]interface{}) error {
+func (cmd *UpdateVpc) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewUpdateSubnet(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateSubnet {
-	cmd := new(UpdateSubnet)
+func NewVerifyDomain(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *VerifyDomain {
+	cmd := new(VerifyDomain)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = ses.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *UpdateSubnet) SetApi(api ec2iface.EC2API) {
+func (cmd *VerifyDomain) SetApi(api sesiface.SESAPI) {
 	cmd.api = api
 }
 
-func (cmd *UpdateSubnet) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *VerifyDomain) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *UpdateSubnet) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *VerifyDomain) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -13686,15 +18066,9 @@ func (cmd *UpdateSubnet) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	input := &ec2.ModifySubnetAttributeInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.ModifySubnetAttributeInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.ModifySubnetAttribute(input)
-	renv.Log().ExtraVerbosef("ec2.ModifySubnetAttribute call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ses.")
 	}
 
 	var extracted interface{}
@@ -13702,14 +18076,14 @@ func (cmd *UpdateSubnet) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("update subnet: AWS command returned nil output")
+			renv.Log().Warning("verify domain: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("update subnet '%s' done", extracted)
+		renv.Log().Verbosef("verify domain '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("update subnet done")
+		renv.Log().Verbose("verify domain done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -13721,40 +18095,43 @@ func (cmd *UpdateSubnet) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *UpdateSubnet) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("subnet"), nil
+func (cmd *VerifyDomain) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("domain"), nil
 }
 
-func (cmd *UpdateSubnet) inject(params map[string]interface{}) error {
+func (cmd *VerifyDomain) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewUpdateTargetgroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateTargetgroup {
-	cmd := new(UpdateTargetgroup)
+func NewVerifyEmail(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *VerifyEmail {
+	cmd := new(VerifyEmail)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = elbv2.New(sess)
+		cmd.api = ses.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *UpdateTargetgroup) SetApi(api elbv2iface.ELBV2API) {
+func (cmd *VerifyEmail) SetApi(api sesiface.SESAPI) {
 	cmd.api = api
 }
 
-func (cmd *UpdateTargetgroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *VerifyEmail) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *UpdateTargetgroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *VerifyEmail) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -13765,9 +18142,15 @@ func (cmd *UpdateTargetgroup) run(renv env.Running, params map[string]interface{
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &ses.VerifyEmailIdentityInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ses.VerifyEmailIdentityInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.VerifyEmailIdentity(input)
+	renv.Log().ExtraVerbosef("ses.VerifyEmailIdentity call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "ses.VerifyEmailIdentity")
 	}
 
 	var extracted interface{}
@@ -13775,14 +18158,14 @@ func (cmd *UpdateTargetgroup) run(renv env.Running, params map[string]interface{
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("update targetgroup: AWS command returned nil output")
+			renv.Log().Warning("verify email: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("update targetgroup '%s' done", extracted)
+		renv.Log().Verbosef("verify email '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("update targetgroup done")
+		renv.Log().Verbose("verify email done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -13794,10 +18177,10 @@ func (cmd *UpdateTargetgroup) run(renv env.Running, params map[string]interface{
 	return extracted, nil
 }
 
-func (cmd *UpdateTargetgroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("targetgroup"), nil
+func (cmd *VerifyEmail) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("email"), nil
 }
 
-func (cmd *UpdateTargetgroup) inject(params map[string]interface{}) error {
+func (cmd *VerifyEmail) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
@@ -16,8 +16,6 @@ limitations under the License.
 package awsspec
 
 import (
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/logger"
@@ -25,7 +23,7 @@ import (
 )
 
 type CreateGroup struct {
-	_      string `action:"create" entity:"group" awsAPI:"iam" awsCall:"CreateGroup" awsInput:"iam.CreateGroupInput" awsOutput:"iam.CreateGroupOutput"`
+	_      string `action:"create" entity:"group" awsAPI:"iam" awsCall:"CreateGroup" awsInput:"iam.CreateGroupInput" awsOutput:"iam.CreateGroupOutput" awsOutputExtract:"Group.GroupId"`
 	logger *logger.Logger
 	graph  cloud.GraphAPI
 	api    iamiface.IAMAPI
@@ -36,10 +34,6 @@ func (cmd *CreateGroup) ParamsSpec() params.Spec {
 	return params.NewSpec(params.AllOf(params.Key("name")))
 }
 
-func (cmd *CreateGroup) ExtractResult(i interface{}) string {
-	return awssdk.StringValue(i.(*iam.CreateGroupOutput).Group.GroupId)
-}
-
 type DeleteGroup struct {
 	_      string `action:"delete" entity:"group" awsAPI:"iam" awsCall:"DeleteGroup" awsInput:"iam.DeleteGroupInput" awsOutput:"iam.DeleteGroupOutput"`
 	logger *logger.Logger
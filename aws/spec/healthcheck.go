@@ -0,0 +1,164 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53/route53iface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+type CreateHealthcheck struct {
+	_                string `action:"create" entity:"healthcheck" awsAPI:"route53"`
+	logger           *logger.Logger
+	graph            cloud.GraphAPI
+	api              route53iface.Route53API
+	Protocol         *string `templateName:"protocol" enum:"HTTP,HTTPS,TCP"`
+	Port             *int64  `templateName:"port"`
+	Host             *string `templateName:"host"`
+	IP               *string `templateName:"ip"`
+	Path             *string `templateName:"path"`
+	Interval         *int64  `templateName:"interval" enum:"10,30"`
+	FailureThreshold *int64  `templateName:"failure-threshold"`
+}
+
+func (cmd *CreateHealthcheck) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("protocol"), params.Key("port"),
+			params.AtLeastOneOf(params.Key("host"), params.Key("ip")),
+			params.Opt("failure-threshold", "interval", "path"),
+		),
+		params.Validators{
+			"protocol": params.IsInEnumIgnoreCase("HTTP", "HTTPS", "TCP"),
+			"ip":       params.IsIP,
+			"interval": func(i interface{}, others map[string]interface{}) error {
+				if v := fmt.Sprint(i); v != "10" && v != "30" {
+					return fmt.Errorf("expected 10 or 30 (seconds), got %s", v)
+				}
+				return nil
+			},
+		},
+	)
+}
+
+func (cmd *CreateHealthcheck) ManualRun(renv env.Running) (interface{}, error) {
+	config := &route53.HealthCheckConfig{
+		Type:                     String(strings.ToUpper(StringValue(cmd.Protocol))),
+		Port:                     cmd.Port,
+		FullyQualifiedDomainName: cmd.Host,
+		IPAddress:                cmd.IP,
+		RequestInterval:          cmd.Interval,
+		FailureThreshold:         cmd.FailureThreshold,
+	}
+	if StringValue(config.Type) != "TCP" {
+		config.ResourcePath = String("/")
+		if cmd.Path != nil {
+			config.ResourcePath = cmd.Path
+		}
+	}
+	input := &route53.CreateHealthCheckInput{
+		CallerReference:   String(fmt.Sprintf("awless-%d", time.Now().UnixNano())),
+		HealthCheckConfig: config,
+	}
+
+	start := time.Now()
+	output, err := cmd.api.CreateHealthCheck(input)
+	cmd.logger.ExtraVerbosef("route53.CreateHealthCheck call took %s", time.Since(start))
+	return output, err
+}
+
+func (cmd *CreateHealthcheck) ExtractResult(i interface{}) string {
+	return StringValue(i.(*route53.CreateHealthCheckOutput).HealthCheck.Id)
+}
+
+type DeleteHealthcheck struct {
+	_      string `action:"delete" entity:"healthcheck" awsAPI:"route53" awsCall:"DeleteHealthCheck" awsInput:"route53.DeleteHealthCheckInput" awsOutput:"route53.DeleteHealthCheckOutput"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    route53iface.Route53API
+	Id     *string `awsName:"HealthCheckId" awsType:"awsstr" templateName:"id"`
+}
+
+func (cmd *DeleteHealthcheck) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id")))
+}
+
+type CheckHealthcheck struct {
+	_       string `action:"check" entity:"healthcheck" awsAPI:"route53"`
+	logger  *logger.Logger
+	graph   cloud.GraphAPI
+	api     route53iface.Route53API
+	Id      *string `templateName:"id"`
+	State   *string `templateName:"state" enum:"healthy,unhealthy,not-found"`
+	Timeout *int64  `templateName:"timeout" enum:"10,60,180,300,600,900"`
+}
+
+func (cmd *CheckHealthcheck) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("id"), params.Key("state"), params.Key("timeout")),
+		params.Validators{
+			"state": params.IsInEnumIgnoreCase("healthy", "unhealthy", notFoundState),
+		},
+	)
+}
+
+func (cmd *CheckHealthcheck) ManualRun(renv env.Running) (interface{}, error) {
+	input := &route53.GetHealthCheckStatusInput{
+		HealthCheckId: cmd.Id,
+	}
+
+	c := &checker{
+		description: fmt.Sprintf("healthcheck %s", StringValue(cmd.Id)),
+		timeout:     time.Duration(Int64AsIntValue(cmd.Timeout)) * time.Second,
+		frequency:   10 * time.Second,
+		fetchFunc: func() (string, error) {
+			output, err := cmd.api.GetHealthCheckStatus(input)
+			if err != nil {
+				if aerr, ok := err.(awserr.Error); ok && aerr.Code() == route53.ErrCodeNoSuchHealthCheck {
+					return notFoundState, nil
+				}
+				return "", err
+			}
+			return healthcheckStatus(output.HealthCheckObservations), nil
+		},
+		expect: StringValue(cmd.State),
+		logger: cmd.logger,
+	}
+	return nil, c.check()
+}
+
+// healthcheckStatus follows Route53 which considers an endpoint healthy
+// when more than 18% of its checkers report a success
+func healthcheckStatus(observations []*route53.HealthCheckObservation) string {
+	var success int
+	for _, obs := range observations {
+		if obs.StatusReport != nil && strings.HasPrefix(StringValue(obs.StatusReport.Status), "Success") {
+			success++
+		}
+	}
+	if len(observations) > 0 && success*100 > 18*len(observations) {
+		return "healthy"
+	}
+	return "unhealthy"
+}
@@ -24,7 +24,6 @@ import (
 	"github.com/wallix/awless/template/env"
 	"github.com/wallix/awless/template/params"
 
-	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
@@ -32,13 +31,13 @@ import (
 )
 
 type CreateImage struct {
-	_           string `action:"create" entity:"image" awsAPI:"ec2" awsCall:"CreateImage" awsInput:"ec2.CreateImageInput" awsOutput:"ec2.CreateImageOutput" awsDryRun:"true"`
+	_           string `action:"create" entity:"image" awsAPI:"ec2" awsCall:"CreateImage" awsInput:"ec2.CreateImageInput" awsOutput:"ec2.CreateImageOutput" awsDryRun:"true" awsOutputExtract:"ImageId"`
 	logger      *logger.Logger
 	graph       cloud.GraphAPI
 	api         ec2iface.EC2API
 	Name        *string `awsName:"Name" awsType:"awsstr" templateName:"name"`
 	Instance    *string `awsName:"InstanceId" awsType:"awsstr" templateName:"instance"`
-	Reboot      *bool   `awsName:"NoReboot" awsType:"awsbool" templateName:"reboot"`
+	Reboot      *bool   `awsName:"NoReboot" awsType:"awsbool" templateName:"reboot" enum:"true,false"`
 	Description *string `awsName:"Description" awsType:"awsstr" templateName:"description"`
 }
 
@@ -59,10 +58,6 @@ func (cmd *CreateImage) BeforeRun(renv env.Running) error {
 	return nil
 }
 
-func (cmd *CreateImage) ExtractResult(i interface{}) string {
-	return awssdk.StringValue(i.(*ec2.CreateImageOutput).ImageId)
-}
-
 type UpdateImage struct {
 	_            string `action:"update" entity:"image" awsAPI:"ec2" awsDryRun:"manual"`
 	logger       *logger.Logger
@@ -71,7 +66,7 @@ type UpdateImage struct {
 	Id           *string   `awsName:"ImageId" awsType:"awsstr" templateName:"id"`
 	Groups       []*string `awsName:"UserGroups" awsType:"awsstringslice" templateName:"groups"`
 	Accounts     []*string `awsName:"UserIds" awsType:"awsstringslice" templateName:"accounts"`
-	Operation    *string   `awsName:"OperationType" awsType:"awsstr" templateName:"operation"`
+	Operation    *string   `awsName:"OperationType" awsType:"awsstr" templateName:"operation" enum:"add,remove"`
 	ProductCodes []*string `awsName:"ProductCodes" awsType:"awsstringslice" templateName:"product-codes"`
 	Description  *string   `awsName:"Description" awsType:"awsstringattribute" templateName:"description"`
 }
@@ -141,13 +136,13 @@ func (cmd *UpdateImage) dryRun(renv env.Running, params map[string]interface{})
 }
 
 type CopyImage struct {
-	_            string `action:"copy" entity:"image" awsAPI:"ec2" awsCall:"CopyImage" awsInput:"ec2.CopyImageInput" awsOutput:"ec2.CopyImageOutput" awsDryRun:""`
+	_            string `action:"copy" entity:"image" awsAPI:"ec2" awsCall:"CopyImage" awsInput:"ec2.CopyImageInput" awsOutput:"ec2.CopyImageOutput" awsDryRun:"" awsOutputExtract:"ImageId"`
 	logger       *logger.Logger
 	graph        cloud.GraphAPI
 	api          ec2iface.EC2API
 	Name         *string `awsName:"Name" awsType:"awsstr" templateName:"name"`
-	SourceId     *string `awsName:"SourceImageId" awsType:"awsstr" templateName:"source-id"`
-	SourceRegion *string `awsName:"SourceRegion" awsType:"awsstr" templateName:"source-region"`
+	SourceId     *string `awsName:"SourceImageId" awsType:"awsstr" templateName:"source-id" enum:""`
+	SourceRegion *string `awsName:"SourceRegion" awsType:"awsstr" templateName:"source-region" enum:"us-east-1,us-east-2,us-west-1,us-west-2,eu-west-1,eu-west-2,eu-west-3,eu-central-1,ca-central-1,ap-northeast-1,ap-northeast-2,ap-southeast-1,ap-southeast-2,ap-south-1,sa-east-1"`
 	Encrypted    *bool   `awsName:"Encrypted" awsType:"awsbool" templateName:"encrypted"`
 	Description  *string `awsName:"Description" awsType:"awsstr" templateName:"description"`
 }
@@ -155,22 +150,18 @@ type CopyImage struct {
 func (cmd *CopyImage) ParamsSpec() params.Spec {
 	return params.NewSpec(params.AllOf(params.Key("name"), params.Key("source-id"), params.Key("source-region"),
 		params.Opt("description", "encrypted"),
-	))
-}
-
-func (cmd *CopyImage) ExtractResult(i interface{}) string {
-	return awssdk.StringValue(i.(*ec2.CopyImageOutput).ImageId)
+	), params.Validators{"source-region": isRegion})
 }
 
 type ImportImage struct {
-	_            string `action:"import" entity:"image" awsAPI:"ec2" awsCall:"ImportImage" awsInput:"ec2.ImportImageInput" awsOutput:"ec2.ImportImageOutput" awsDryRun:""`
+	_            string `action:"import" entity:"image" awsAPI:"ec2" awsCall:"ImportImage" awsInput:"ec2.ImportImageInput" awsOutput:"ec2.ImportImageOutput" awsDryRun:"" awsOutputExtract:"ImportTaskId"`
 	logger       *logger.Logger
 	graph        cloud.GraphAPI
 	api          ec2iface.EC2API
-	Architecture *string `awsName:"Architecture" awsType:"awsstr" templateName:"architecture"`
+	Architecture *string `awsName:"Architecture" awsType:"awsstr" templateName:"architecture" enum:"i386,x86_64"`
 	Description  *string `awsName:"Description" awsType:"awsstr" templateName:"description"`
-	License      *string `awsName:"LicenseType" awsType:"awsstr" templateName:"license"`
-	Platform     *string `awsName:"Platform" awsType:"awsstr" templateName:"platform"`
+	License      *string `awsName:"LicenseType" awsType:"awsstr" templateName:"license" enum:"AWS,BYOL"`
+	Platform     *string `awsName:"Platform" awsType:"awsstr" templateName:"platform" enum:"Windows,Linux"`
 	Role         *string `awsName:"RoleName" awsType:"awsstr" templateName:"role"`
 	Snapshot     *string `awsName:"DiskContainers[0]SnapshotId" awsType:"awsslicestruct" templateName:"snapshot"`
 	Url          *string `awsName:"DiskContainers[0]Url" awsType:"awsslicestruct" templateName:"url"`
@@ -186,17 +177,13 @@ func (cmd *ImportImage) ParamsSpec() params.Spec {
 	))
 }
 
-func (cmd *ImportImage) ExtractResult(i interface{}) string {
-	return awssdk.StringValue(i.(*ec2.ImportImageOutput).ImportTaskId)
-}
-
 type DeleteImage struct {
 	_               string `action:"delete" entity:"image" awsAPI:"ec2" awsDryRun:"manual"`
 	logger          *logger.Logger
 	graph           cloud.GraphAPI
 	api             ec2iface.EC2API
 	Id              *string `templateName:"id"`
-	DeleteSnapshots *bool   `templateName:"delete-snapshots"`
+	DeleteSnapshots *bool   `templateName:"delete-snapshots" enum:"true,false"`
 }
 
 func (cmd *DeleteImage) ParamsSpec() params.Spec {
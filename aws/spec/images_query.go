@@ -11,6 +11,7 @@ import (
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/wallix/awless/aws/doc"
+	"github.com/wallix/awless/cache"
 )
 
 // Image resolving allows to find AWS AMIs identifiers specifying what you want instead
@@ -52,6 +53,9 @@ var DefaultImageResolverCache = new(ImageResolverCache)
 type ImageResolverCache struct {
 	mu    sync.Mutex
 	cache map[string][]*AwsImage
+
+	// Disk, when set, keeps the resolved images across awless invocations
+	Disk *cache.Disk
 }
 
 func (r *ImageResolverCache) Store(key string, images []*AwsImage) {
@@ -61,6 +65,7 @@ func (r *ImageResolverCache) Store(key string, images []*AwsImage) {
 		r.cache = make(map[string][]*AwsImage)
 	}
 	r.cache[key] = images
+	r.Disk.Put(key, images)
 }
 
 func (r *ImageResolverCache) Get(key string) ([]*AwsImage, bool) {
@@ -70,6 +75,9 @@ func (r *ImageResolverCache) Get(key string) ([]*AwsImage, bool) {
 		r.cache = make(map[string][]*AwsImage)
 	}
 	images, ok := r.cache[key]
+	if !ok && r.Disk.Get(key, &images) {
+		r.cache[key], ok = images, true
+	}
 	return images, ok
 }
 
@@ -1,8 +1,14 @@
 package awsspec
 
 import (
+	"io/ioutil"
+	"os"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/wallix/awless/cache"
 )
 
 func TestParseImageQueryErrorCases(t *testing.T) {
@@ -96,3 +102,37 @@ func TestParseImageQueryString(t *testing.T) {
 		}
 	}
 }
+
+func TestImageResolverDiskCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "awless-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	defer func(c *ImageResolverCache) { DefaultImageResolverCache = c }(DefaultImageResolverCache)
+
+	var calls int
+	resolver := ImageResolver(func(*ec2.DescribeImagesInput) (*ec2.DescribeImagesOutput, error) {
+		calls++
+		return &ec2.DescribeImagesOutput{}, nil
+	})
+	q, err := ParseImageQuery("canonical:ubuntu:xenial")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, expFound := range []bool{false, true} {
+		// new process memory, same disk
+		DefaultImageResolverCache = &ImageResolverCache{Disk: cache.NewDisk(dir, time.Hour)}
+		_, found, err := resolver.Resolve(q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if found != expFound {
+			t.Fatalf("%d: got found %t, want %t", i+1, found, expFound)
+		}
+	}
+	if got, want := calls, 1; got != want {
+		t.Fatalf("got %d DescribeImages calls, want %d", got, want)
+	}
+}
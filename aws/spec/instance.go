@@ -17,47 +17,56 @@ package awsspec
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
 	"time"
 
-	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws"
+
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/ssh"
 	"github.com/wallix/awless/template/env"
 	"github.com/wallix/awless/template/params"
 )
 
 type CreateInstance struct {
-	_              string `action:"create" entity:"instance" awsAPI:"ec2" awsCall:"RunInstances" awsInput:"ec2.RunInstancesInput" awsOutput:"ec2.Reservation" awsDryRun:""`
+	_              string `action:"create" entity:"instance" awsAPI:"ec2" awsCall:"RunInstances" awsInput:"ec2.RunInstancesInput" awsOutput:"ec2.Reservation" awsDryRun:"manual" awsOutputExtract:"Instances[0].InstanceId"`
 	logger         *logger.Logger
 	graph          cloud.GraphAPI
 	api            ec2iface.EC2API
 	Image          *string   `awsName:"ImageId" awsType:"awsstr" templateName:"image"`
 	Count          *int64    `awsName:"MaxCount,MinCount" awsType:"awsin64" templateName:"count"`
-	Type           *string   `awsName:"InstanceType" awsType:"awsstr" templateName:"type"`
+	Type           *string   `awsName:"InstanceType" awsType:"awsstr" templateName:"type" enum:"t2.nano,t2.micro,t2.small,t2.medium,t2.large,t2.xlarge,t2.2xlarge,m4.large,m4.xlarge,c4.large,c4.xlarge"`
 	Name           *string   `templateName:"name"`
 	Subnet         *string   `awsName:"SubnetId" awsType:"awsstr" templateName:"subnet"`
 	Keypair        *string   `awsName:"KeyName" awsType:"awsstr" templateName:"keypair"`
 	PrivateIP      *string   `awsName:"PrivateIpAddress" awsType:"awsstr" templateName:"ip"`
-	UserData       *string   `awsName:"UserData" awsType:"awsuserdatatobase64" templateName:"userdata"`
+	IPv6Count      *int64    `awsName:"Ipv6AddressCount" awsType:"awsint64" templateName:"ipv6-count"`
+	UserData       *string   `awsName:"UserData" awsType:"awsuserdatatobase64" templateName:"userdata" enum:""`
 	SecurityGroups []*string `awsName:"SecurityGroupIds" awsType:"awsstringslice" templateName:"securitygroup"`
-	Lock           *bool     `awsName:"DisableApiTermination" awsType:"awsbool" templateName:"lock"`
-	Role           *string   `awsName:"IamInstanceProfile.Name" awsType:"awsstr" templateName:"role"`
-	DistroQuery    *string   `awsType:"awsstr" templateName:"distro"`
+	Lock           *bool     `awsName:"DisableApiTermination" awsType:"awsbool" templateName:"lock" enum:"true,false"`
+	Role           *string   `awsName:"IamInstanceProfile.Name" awsType:"awsstr" templateName:"role" ref:"role.Name"`
+	DistroQuery    *string   `awsType:"awsstr" templateName:"distro" enum:"amazonlinux,canonical:ubuntu,redhat:rhel,debian:debian,centos:centos,coreos:coreos,suselinux,windows:server"`
 }
 
 func (cmd *CreateInstance) ParamsSpec() params.Spec {
 	builder := params.SpecBuilder(
 		params.AllOf(params.OnlyOneOf(params.Key("distro"), params.Key("image")),
 			params.Key("count"), params.Key("type"), params.Key("name"), params.Key("subnet"),
-			params.Opt(params.Suggested("keypair", "securitygroup"), "ip", "userdata", "lock", "role"),
+			params.Opt(params.Suggested("keypair", "securitygroup"), "ip", "ipv6-count", "userdata", "lock", "role"),
 		),
-		params.Validators{"ip": params.IsIP},
+		params.Validators{"ip": params.IsIP, "type": params.IsInstanceType},
 	)
 	builder.AddReducer(cmd.convertDistroToAMI, "distro")
+	builder.AddReducer(cmd.convertInstanceTypeAlias, "type")
 	return builder.Done()
 }
 
@@ -87,8 +96,109 @@ func (cmd *CreateInstance) convertDistroToAMI(values map[string]interface{}) (ma
 	return nil, nil
 }
 
-func (cmd *CreateInstance) ExtractResult(i interface{}) string {
-	return StringValue(i.(*ec2.Reservation).Instances[0].InstanceId)
+func (cmd *CreateInstance) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("dry run: cannot set params on command struct: %s", err)
+	}
+	if err := cmd.checkTypeAndImage(); err != nil {
+		return nil, fmt.Errorf("dry run: %s", err)
+	}
+
+	input := &ec2.RunInstancesInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("dry run: cannot inject in ec2.RunInstancesInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.RunInstances(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.RunInstances call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: create instance ok")
+			return fakeDryRunId("instance"), nil
+		}
+	}
+
+	return nil, err
+}
+
+// checkTypeAndImage verifies the instance type is offered in the region
+// and can boot the image, so that mismatches are reported before running.
+// Checks that cannot be performed (unknown image, missing permissions) are skipped.
+func (cmd *CreateInstance) checkTypeAndImage() error {
+	instanceType := StringValue(cmd.Type)
+	if instanceType == "" {
+		return nil
+	}
+
+	offerings, err := cmd.api.DescribeReservedInstancesOfferings(&ec2.DescribeReservedInstancesOfferingsInput{
+		InstanceType: cmd.Type,
+		MaxResults:   aws.Int64(5),
+	})
+	if err != nil {
+		cmd.logger.ExtraVerbosef("dry run: cannot verify instance type '%s' availability: %s", instanceType, err)
+	} else if len(offerings.ReservedInstancesOfferings) == 0 {
+		return fmt.Errorf("instance type '%s' is not available in region %s", instanceType, ec2Region(cmd.api))
+	}
+
+	imageID := StringValue(cmd.Image)
+	if imageID == "" {
+		return nil
+	}
+	out, err := cmd.api.DescribeImages(&ec2.DescribeImagesInput{ImageIds: []*string{cmd.Image}})
+	if err != nil || len(out.Images) == 0 {
+		cmd.logger.ExtraVerbosef("dry run: cannot verify image '%s' compatibility: %v", imageID, err)
+		return nil
+	}
+	image := out.Images[0]
+
+	return checkInstanceTypeSupportsImage(instanceType, StringValue(image.Architecture), StringValue(image.VirtualizationType), imageID)
+}
+
+var (
+	armInstanceFamily         = regexp.MustCompile(`^(a1|[a-z]+[0-9]+g[a-z]*)$`)
+	paravirtualOnlyFamilies   = map[string]bool{"t1": true, "m1": true, "m2": true, "c1": true}
+	paravirtualAndHVMFamilies = map[string]bool{"m3": true, "c3": true, "hi1": true, "hs1": true}
+)
+
+func checkInstanceTypeSupportsImage(instanceType, architecture, virtualization, imageID string) error {
+	family := strings.SplitN(instanceType, ".", 2)[0]
+
+	typeArch := "x86_64"
+	if armInstanceFamily.MatchString(family) {
+		typeArch = "arm64"
+	}
+	switch {
+	case architecture == "":
+	case architecture == "i386" && typeArch == "x86_64":
+	case architecture != typeArch:
+		return fmt.Errorf("instance type '%s' (%s) is not compatible with image '%s' architecture %s", instanceType, typeArch, imageID, architecture)
+	}
+
+	var supported bool
+	switch virtualization {
+	case "":
+		supported = true
+	case ec2.VirtualizationTypeParavirtual:
+		supported = paravirtualOnlyFamilies[family] || paravirtualAndHVMFamilies[family]
+	case ec2.VirtualizationTypeHvm:
+		supported = !paravirtualOnlyFamilies[family]
+	default:
+		supported = true
+	}
+	if !supported {
+		return fmt.Errorf("instance type '%s' does not support image '%s' virtualization type %s", instanceType, imageID, virtualization)
+	}
+	return nil
+}
+
+func ec2Region(api ec2iface.EC2API) string {
+	if client, ok := api.(*ec2.EC2); ok {
+		return StringValue(client.Config.Region)
+	}
+	return "current region"
 }
 
 func (cmd *CreateInstance) AfterRun(renv env.Running, output interface{}) error {
@@ -101,12 +211,25 @@ type UpdateInstance struct {
 	graph  cloud.GraphAPI
 	api    ec2iface.EC2API
 	Id     *string `awsName:"InstanceId" awsType:"awsstr" templateName:"id"`
-	Type   *string `awsName:"InstanceType.Value" awsType:"awsstr" templateName:"type"`
-	Lock   *bool   `awsName:"DisableApiTermination" awsType:"awsboolattribute" templateName:"lock"`
+	Type   *string `awsName:"InstanceType.Value" awsType:"awsstr" templateName:"type" enum:"t2.nano,t2.micro,t2.small,t2.medium,t2.large,t2.xlarge,t2.2xlarge,m4.large,m4.xlarge,c4.large,c4.xlarge"`
+	Lock   *bool   `awsName:"DisableApiTermination" awsType:"awsboolattribute" templateName:"lock" enum:"true,false"`
+	// AWS modifies only one of the following attributes per call
+	SourceDestCheck  *bool     `awsName:"SourceDestCheck" awsType:"awsboolattribute" templateName:"source-dest-check" enum:"true,false"`
+	ShutdownBehavior *string   `awsName:"InstanceInitiatedShutdownBehavior.Value" awsType:"awsstr" templateName:"shutdown-behavior" enum:"stop,terminate"`
+	SecurityGroups   []*string `awsName:"Groups" awsType:"awsstringslice" templateName:"securitygroups"`
 }
 
 func (cmd *UpdateInstance) ParamsSpec() params.Spec {
-	return params.NewSpec(params.AllOf(params.Key("id"), params.Opt("lock", "type")))
+	builder := params.SpecBuilder(params.AllOf(params.Key("id"), params.Opt("lock", "securitygroups", "shutdown-behavior", "source-dest-check", "type")),
+		params.Validators{
+			"type":              params.IsInstanceType,
+			"shutdown-behavior": params.IsInEnumIgnoreCase("stop", "terminate"),
+		})
+	builder.AddReducer(func(values map[string]interface{}) (map[string]interface{}, error) {
+		fn := CommandFactory.Build("createinstance")().(*CreateInstance).convertInstanceTypeAlias
+		return fn(values)
+	}, "type")
+	return builder.Done()
 }
 
 type DeleteInstance struct {
@@ -124,7 +247,7 @@ func (cmd *DeleteInstance) ParamsSpec() params.Spec {
 }
 
 type StartInstance struct {
-	_      string `action:"start" entity:"instance" awsAPI:"ec2" awsCall:"StartInstances" awsInput:"ec2.StartInstancesInput" awsOutput:"ec2.StartInstancesOutput" awsDryRun:""`
+	_      string `action:"start" entity:"instance" awsAPI:"ec2" awsCall:"StartInstances" awsInput:"ec2.StartInstancesInput" awsOutput:"ec2.StartInstancesOutput" awsDryRun:"" awsOutputExtract:"StartingInstances[0].InstanceId"`
 	logger *logger.Logger
 	graph  cloud.GraphAPI
 	api    ec2iface.EC2API
@@ -137,12 +260,8 @@ func (cmd *StartInstance) ParamsSpec() params.Spec {
 	return builder.Done()
 }
 
-func (cmd *StartInstance) ExtractResult(i interface{}) string {
-	return awssdk.StringValue(i.(*ec2.StartInstancesOutput).StartingInstances[0].InstanceId)
-}
-
 type StopInstance struct {
-	_      string `action:"stop" entity:"instance" awsAPI:"ec2" awsCall:"StopInstances" awsInput:"ec2.StopInstancesInput" awsOutput:"ec2.StopInstancesOutput" awsDryRun:""`
+	_      string `action:"stop" entity:"instance" awsAPI:"ec2" awsCall:"StopInstances" awsInput:"ec2.StopInstancesInput" awsOutput:"ec2.StopInstancesOutput" awsDryRun:"" awsOutputExtract:"StoppingInstances[0].InstanceId"`
 	logger *logger.Logger
 	graph  cloud.GraphAPI
 	api    ec2iface.EC2API
@@ -155,10 +274,6 @@ func (cmd *StopInstance) ParamsSpec() params.Spec {
 	return builder.Done()
 }
 
-func (cmd *StopInstance) ExtractResult(i interface{}) string {
-	return StringValue(i.(*ec2.StopInstancesOutput).StoppingInstances[0].InstanceId)
-}
-
 type RestartInstance struct {
 	_      string `action:"restart" entity:"instance" awsAPI:"ec2" awsCall:"RebootInstances" awsInput:"ec2.RebootInstancesInput" awsOutput:"ec2.RebootInstancesOutput" awsDryRun:""`
 	logger *logger.Logger
@@ -183,8 +298,8 @@ type CheckInstance struct {
 	graph   cloud.GraphAPI
 	api     ec2iface.EC2API
 	Id      *string `templateName:"id"`
-	State   *string `templateName:"state"`
-	Timeout *int64  `templateName:"timeout"`
+	State   *string `templateName:"state" enum:"pending,running,shutting-down,terminated,stopping,stopped,not-found"`
+	Timeout *int64  `templateName:"timeout" enum:"10,60,180,300,600,900"`
 }
 
 func (cmd *CheckInstance) ParamsSpec() params.Spec {
@@ -234,6 +349,97 @@ func (cmd *CheckInstance) ManualRun(renv env.Running) (interface{}, error) {
 	return nil, c.check()
 }
 
+type BootstrapInstance struct {
+	_       string `action:"bootstrap" entity:"instance" awsAPI:"ec2"`
+	logger  *logger.Logger
+	graph   cloud.GraphAPI
+	api     ec2iface.EC2API
+	Id      *string `templateName:"id"`
+	Script  *string `templateName:"script"`
+	User    *string `templateName:"user"`
+	Keypair *string `templateName:"keypair"`
+	Port    *int64  `templateName:"port"`
+	Private *bool   `templateName:"private"`
+	Timeout *int64  `templateName:"timeout"`
+}
+
+func (cmd *BootstrapInstance) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("id"), params.Key("script"),
+			params.Opt("keypair", "port", "private", "timeout", "user"),
+		),
+		params.Validators{"script": params.IsFilepath},
+	)
+}
+
+func (cmd *BootstrapInstance) ManualRun(renv env.Running) (interface{}, error) {
+	script, err := ioutil.ReadFile(StringValue(cmd.Script))
+	if err != nil {
+		return nil, fmt.Errorf("reading script: %s", err)
+	}
+
+	output, err := cmd.api.DescribeInstances(&ec2.DescribeInstancesInput{InstanceIds: []*string{cmd.Id}})
+	if err != nil {
+		return nil, err
+	}
+	var inst *ec2.Instance
+	for _, res := range output.Reservations {
+		for _, i := range res.Instances {
+			if StringValue(i.InstanceId) == StringValue(cmd.Id) {
+				inst = i
+			}
+		}
+	}
+	if inst == nil {
+		return nil, fmt.Errorf("instance %s not found", StringValue(cmd.Id))
+	}
+
+	ip := StringValue(inst.PublicIpAddress)
+	if ip == "" || BoolValue(cmd.Private) {
+		ip = StringValue(inst.PrivateIpAddress)
+	}
+	if ip == "" {
+		return nil, fmt.Errorf("no public/private IP resolved for instance %s (state '%s')", StringValue(cmd.Id), StringValue(inst.State.Name))
+	}
+
+	keypair := StringValue(cmd.Keypair)
+	if keypair == "" {
+		keypair = StringValue(inst.KeyName)
+	}
+	client, err := ssh.InitClient(keypair, os.Getenv(keyDirEnv), ssh.UserSSHDir())
+	if err != nil {
+		return nil, err
+	}
+	client.SetLogger(cmd.logger)
+	client.IP = ip
+	client.Port = 22
+	if cmd.Port != nil {
+		client.Port = Int64AsIntValue(cmd.Port)
+	}
+
+	users := ssh.DefaultAMIUsers
+	if u := StringValue(cmd.User); u != "" {
+		users = []string{u}
+	}
+
+	timeout := 180 * time.Second
+	if cmd.Timeout != nil {
+		timeout = time.Duration(Int64AsIntValue(cmd.Timeout)) * time.Second
+	}
+	cmd.logger.Infof("Waiting for SSH on %s (%s) ...", StringValue(cmd.Id), ip)
+	if err = client.WaitForDial(timeout, 5*time.Second, users...); err != nil {
+		return nil, err
+	}
+	defer client.CloseAll()
+
+	cmd.logger.Infof("Running script '%s' on %s as '%s'", StringValue(cmd.Script), StringValue(cmd.Id), client.User)
+	if err = client.RunScript(filepath.Base(StringValue(cmd.Script)), script, os.Stdout, os.Stderr); err != nil {
+		return nil, fmt.Errorf("script '%s': %s", StringValue(cmd.Script), err)
+	}
+
+	return nil, nil
+}
+
 type AttachInstance struct {
 	_           string `action:"attach" entity:"instance" awsAPI:"elbv2" awsCall:"RegisterTargets" awsInput:"elbv2.RegisterTargetsInput" awsOutput:"elbv2.RegisterTargetsOutput"`
 	logger      *logger.Logger
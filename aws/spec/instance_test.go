@@ -0,0 +1,124 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/wallix/awless/logger"
+)
+
+func TestCheckInstanceTypeSupportsImage(t *testing.T) {
+	tcases := []struct {
+		instanceType, arch, virtualization string
+		expErr                             string
+	}{
+		{instanceType: "t2.micro", arch: "x86_64", virtualization: "hvm"},
+		{instanceType: "t2.micro", arch: "i386", virtualization: "hvm"},
+		{instanceType: "m3.medium", arch: "x86_64", virtualization: "paravirtual"},
+		{instanceType: "t1.micro", arch: "x86_64", virtualization: "paravirtual"},
+		{instanceType: "a1.large", arch: "arm64", virtualization: "hvm"},
+		{instanceType: "m6g.large", arch: "arm64", virtualization: "hvm"},
+		{instanceType: "t2.micro", arch: "", virtualization: ""},
+		{instanceType: "t2.micro", arch: "arm64", virtualization: "hvm", expErr: "architecture arm64"},
+		{instanceType: "c6gd.large", arch: "x86_64", virtualization: "hvm", expErr: "architecture x86_64"},
+		{instanceType: "t2.micro", arch: "x86_64", virtualization: "paravirtual", expErr: "virtualization type paravirtual"},
+		{instanceType: "t1.micro", arch: "x86_64", virtualization: "hvm", expErr: "virtualization type hvm"},
+	}
+	for i, tcase := range tcases {
+		err := checkInstanceTypeSupportsImage(tcase.instanceType, tcase.arch, tcase.virtualization, "ami-12345")
+		if tcase.expErr == "" {
+			if err != nil {
+				t.Fatalf("%d: unexpected error: %s", i+1, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tcase.expErr) {
+			t.Fatalf("%d: got %v, want error containing '%s'", i+1, err, tcase.expErr)
+		}
+	}
+}
+
+type instanceCheckMock struct {
+	ec2iface.EC2API
+	offerings []*ec2.ReservedInstancesOffering
+	offersErr error
+	images    []*ec2.Image
+}
+
+func (m *instanceCheckMock) DescribeReservedInstancesOfferings(*ec2.DescribeReservedInstancesOfferingsInput) (*ec2.DescribeReservedInstancesOfferingsOutput, error) {
+	return &ec2.DescribeReservedInstancesOfferingsOutput{ReservedInstancesOfferings: m.offerings}, m.offersErr
+}
+
+func (m *instanceCheckMock) DescribeImages(*ec2.DescribeImagesInput) (*ec2.DescribeImagesOutput, error) {
+	return &ec2.DescribeImagesOutput{Images: m.images}, nil
+}
+
+func TestCreateInstanceCheckTypeAndImage(t *testing.T) {
+	offered := []*ec2.ReservedInstancesOffering{{InstanceType: aws.String("t2.micro")}}
+	hvm := []*ec2.Image{{Architecture: aws.String("x86_64"), VirtualizationType: aws.String("hvm")}}
+
+	tcases := []struct {
+		mock   *instanceCheckMock
+		expErr string
+	}{
+		{mock: &instanceCheckMock{offerings: offered, images: hvm}},
+		{mock: &instanceCheckMock{offersErr: errors.New("unauthorized"), images: hvm}},
+		{mock: &instanceCheckMock{offerings: offered}},
+		{mock: &instanceCheckMock{images: hvm}, expErr: "not available in region"},
+		{mock: &instanceCheckMock{offerings: offered, images: []*ec2.Image{{Architecture: aws.String("arm64"), VirtualizationType: aws.String("hvm")}}}, expErr: "architecture arm64"},
+	}
+	for i, tcase := range tcases {
+		cmd := &CreateInstance{api: tcase.mock, logger: logger.DiscardLogger, Type: aws.String("t2.micro"), Image: aws.String("ami-12345")}
+		err := cmd.checkTypeAndImage()
+		if tcase.expErr == "" {
+			if err != nil {
+				t.Fatalf("%d: unexpected error: %s", i+1, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tcase.expErr) {
+			t.Fatalf("%d: got %v, want error containing '%s'", i+1, err, tcase.expErr)
+		}
+	}
+}
+
+func TestResolveInstanceTypeAlias(t *testing.T) {
+	tcases := []struct {
+		typ, region string
+		exp         string
+		expAlias    bool
+	}{
+		{typ: "t2.micro", region: "eu-west-1", exp: "t2.micro"},
+		{typ: "general.large", region: "eu-west-1", exp: "m5.large", expAlias: true},
+		{typ: "Compute.xlarge", region: "us-east-1", exp: "c5.xlarge", expAlias: true},
+		{typ: "burstable.medium", region: "", exp: "t3.medium", expAlias: true},
+		{typ: "general.large", region: "cn-north-1", exp: "m4.large", expAlias: true},
+		{typ: "storage.large", region: "cn-north-1", exp: "i3.large", expAlias: true},
+		{typ: "general", region: "eu-west-1", exp: "general"},
+	}
+	for i, tcase := range tcases {
+		got, isAlias := ResolveInstanceTypeAlias(tcase.typ, tcase.region)
+		if got != tcase.exp || isAlias != tcase.expAlias {
+			t.Fatalf("%d: got (%s, %t), want (%s, %t)", i+1, got, isAlias, tcase.exp, tcase.expAlias)
+		}
+	}
+}
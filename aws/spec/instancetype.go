@@ -0,0 +1,70 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"strings"
+)
+
+// InstanceTypeFamilyAliases maps abstract instance families usable in `type` params
+// (ex: type=general.large) to the current generation family. Keeping this table
+// up to date lets templates follow instance generations without being edited.
+var InstanceTypeFamilyAliases = map[string]string{
+	"burstable": "t3",
+	"general":   "m5",
+	"compute":   "c5",
+	"memory":    "r5",
+	"storage":   "i3",
+	"gpu":       "p3",
+}
+
+// regionalInstanceTypeFamilyAliases overrides InstanceTypeFamilyAliases
+// for regions where the latest generation is not offered yet
+var regionalInstanceTypeFamilyAliases = map[string]map[string]string{
+	"cn-north-1":    {"burstable": "t2", "general": "m4", "compute": "c4", "memory": "r4", "gpu": "p2"},
+	"us-gov-west-1": {"burstable": "t2", "gpu": "p2"},
+}
+
+// ResolveInstanceTypeAlias returns the concrete instance type for an abstract
+// type in the given region. Concrete types are returned untouched.
+func ResolveInstanceTypeAlias(instanceType, region string) (string, bool) {
+	splits := strings.SplitN(instanceType, ".", 2)
+	if len(splits) != 2 {
+		return instanceType, false
+	}
+	alias, size := strings.ToLower(splits[0]), splits[1]
+	if family, ok := regionalInstanceTypeFamilyAliases[region][alias]; ok {
+		return family + "." + size, true
+	}
+	if family, ok := InstanceTypeFamilyAliases[alias]; ok {
+		return family + "." + size, true
+	}
+	return instanceType, false
+}
+
+func (cmd *CreateInstance) convertInstanceTypeAlias(values map[string]interface{}) (map[string]interface{}, error) {
+	typ, ok := values["type"]
+	if !ok {
+		return nil, nil
+	}
+	if alias, isStr := typ.(string); isStr {
+		if resolved, isAlias := ResolveInstanceTypeAlias(alias, ec2Region(cmd.api)); isAlias {
+			cmd.logger.Verbosef("instance type '%s' resolved to '%s'", alias, resolved)
+			return map[string]interface{}{"type": resolved}, nil
+		}
+	}
+	return map[string]interface{}{"type": typ}, nil
+}
@@ -16,8 +16,6 @@ limitations under the License.
 package awsspec
 
 import (
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/logger"
@@ -25,7 +23,7 @@ import (
 )
 
 type CreateInternetgateway struct {
-	_      string `action:"create" entity:"internetgateway" awsAPI:"ec2" awsCall:"CreateInternetGateway" awsInput:"ec2.CreateInternetGatewayInput" awsOutput:"ec2.CreateInternetGatewayOutput" awsDryRun:""`
+	_      string `action:"create" entity:"internetgateway" awsAPI:"ec2" awsCall:"CreateInternetGateway" awsInput:"ec2.CreateInternetGatewayInput" awsOutput:"ec2.CreateInternetGatewayOutput" awsDryRun:"" awsOutputExtract:"InternetGateway.InternetGatewayId"`
 	logger *logger.Logger
 	graph  cloud.GraphAPI
 	api    ec2iface.EC2API
@@ -35,10 +33,6 @@ func (cmd *CreateInternetgateway) ParamsSpec() params.Spec {
 	return params.NewSpec(params.None())
 }
 
-func (cmd *CreateInternetgateway) ExtractResult(i interface{}) string {
-	return awssdk.StringValue(i.(*ec2.CreateInternetGatewayOutput).InternetGateway.InternetGatewayId)
-}
-
 type DeleteInternetgateway struct {
 	_      string `action:"delete" entity:"internetgateway" awsAPI:"ec2" awsCall:"DeleteInternetGateway" awsInput:"ec2.DeleteInternetGatewayInput" awsOutput:"ec2.DeleteInternetGatewayOutput" awsDryRun:""`
 	logger *logger.Logger
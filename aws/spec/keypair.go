@@ -17,36 +17,37 @@ package awsspec
 
 import (
 	"fmt"
-	"io/ioutil"
 	"os"
 	"path/filepath"
+	"runtime"
 	"time"
 
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/template/env"
 	"github.com/wallix/awless/template/params"
 
-	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/wallix/awless/console"
 	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/ssh"
 )
 
 const keyDirEnv = "__AWLESS_KEYS_DIR"
 
 type CreateKeypair struct {
-	_                 string `action:"create" entity:"keypair" awsAPI:"ec2" awsCall:"ImportKeyPair" awsInput:"ec2.ImportKeyPairInput" awsOutput:"ec2.ImportKeyPairOutput"`
+	_                 string `action:"create" entity:"keypair" awsAPI:"ec2" awsCall:"ImportKeyPair" awsInput:"ec2.ImportKeyPairInput" awsOutput:"ec2.ImportKeyPairOutput" awsOutputExtract:"KeyName"`
 	logger            *logger.Logger
 	graph             cloud.GraphAPI
 	api               ec2iface.EC2API
 	Name              *string `awsName:"KeyName" awsType:"awsstr" templateName:"name"`
-	Encrypted         *bool   `templateName:"encrypted"`
+	Encrypted         *bool   `templateName:"encrypted" enum:"true,false"`
+	PPK               *bool   `templateName:"ppk"`
 	PublicKeyMaterial []byte  `awsName:"PublicKeyMaterial" awsType:"awsbyteslice"`
 }
 
 func (cmd *CreateKeypair) ParamsSpec() params.Spec {
 	return params.NewSpec(
-		params.AllOf(params.Key("name"), params.Opt("encrypted")),
+		params.AllOf(params.Key("name"), params.Opt("encrypted", "ppk")),
 		params.Validators{
 			"name": func(i interface{}, others map[string]interface{}) error {
 				keyDir := os.Getenv(keyDirEnv)
@@ -84,15 +85,29 @@ func (cmd *CreateKeypair) BeforeRun(renv env.Running) error {
 	if err != nil {
 		return fmt.Errorf("generating key: %s", err)
 	}
-	if err = ioutil.WriteFile(privKeyPath, priv, 0400); err != nil {
+	if err = ssh.SavePrivateKey(privKeyPath, priv); err != nil {
 		return fmt.Errorf("saving private key: %s", err)
 	}
+	if (cmd.PPK == nil && runtime.GOOS == "windows") || BoolValue(cmd.PPK) {
+		if err = cmd.savePuTTYKey(priv); err != nil {
+			cmd.logger.Warningf("PuTTY key not saved: %s", err)
+		}
+	}
 	cmd.PublicKeyMaterial = pub
 	return nil
 }
 
-func (cmd *CreateKeypair) ExtractResult(i interface{}) string {
-	return StringValue(i.(*ec2.ImportKeyPairOutput).KeyName)
+func (cmd *CreateKeypair) savePuTTYKey(priv []byte) error {
+	ppk, err := ssh.PuTTYKeyFromPEM(priv, StringValue(cmd.Name))
+	if err != nil {
+		return err
+	}
+	ppkPath := filepath.Join(os.Getenv(keyDirEnv), StringValue(cmd.Name)+".ppk")
+	if err = ssh.SavePrivateKey(ppkPath, ppk); err != nil {
+		return err
+	}
+	cmd.logger.Infof("PuTTY private key saved at %s", ppkPath)
+	return nil
 }
 
 type DeleteKeypair struct {
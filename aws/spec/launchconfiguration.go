@@ -28,15 +28,15 @@ type CreateLaunchconfiguration struct {
 	graph          cloud.GraphAPI
 	api            autoscalingiface.AutoScalingAPI
 	Image          *string   `awsName:"ImageId" awsType:"awsstr" templateName:"image"`
-	Type           *string   `awsName:"InstanceType" awsType:"awsstr" templateName:"type"`
+	Type           *string   `awsName:"InstanceType" awsType:"awsstr" templateName:"type" enum:"t2.nano,t2.micro,t2.small,t2.medium,t2.large,t2.xlarge,t2.2xlarge,m4.large,m4.xlarge,c4.large,c4.xlarge"`
 	Name           *string   `awsName:"LaunchConfigurationName" awsType:"awsstr" templateName:"name"`
-	Public         *bool     `awsName:"AssociatePublicIpAddress" awsType:"awsbool" templateName:"public"`
+	Public         *bool     `awsName:"AssociatePublicIpAddress" awsType:"awsbool" templateName:"public" enum:"true,false"`
 	Keypair        *string   `awsName:"KeyName" awsType:"awsstr" templateName:"keypair"`
-	Userdata       *string   `awsName:"UserData" awsType:"awsuserdatatobase64" templateName:"userdata"`
+	Userdata       *string   `awsName:"UserData" awsType:"awsuserdatatobase64" templateName:"userdata" enum:""`
 	Securitygroups []*string `awsName:"SecurityGroups" awsType:"awsstringslice" templateName:"securitygroups"`
 	Role           *string   `awsName:"IamInstanceProfile" awsType:"awsstr" templateName:"role"`
 	Spotprice      *string   `awsName:"SpotPrice" awsType:"awsstr" templateName:"spotprice"`
-	DistroQuery    *string   `awsType:"awsstr" templateName:"distro"`
+	DistroQuery    *string   `awsType:"awsstr" templateName:"distro" enum:"amazonlinux,canonical:ubuntu,redhat:rhel,debian:debian,centos:centos,coreos:coreos,suselinux,windows:server"`
 }
 
 func (cmd *CreateLaunchconfiguration) ParamsSpec() params.Spec {
@@ -44,11 +44,17 @@ func (cmd *CreateLaunchconfiguration) ParamsSpec() params.Spec {
 		params.OnlyOneOf(params.Key("distro"), params.Key("image")),
 		params.Key("name"), params.Key("type"),
 		params.Opt("keypair", "public", "role", "securitygroups", "spotprice", "userdata"),
-	))
+	),
+		params.Validators{"type": params.IsInstanceType},
+	)
 	builder.AddReducer(func(values map[string]interface{}) (map[string]interface{}, error) {
 		fn := CommandFactory.Build("createinstance")().(*CreateInstance).convertDistroToAMI
 		return fn(values)
 	}, "distro")
+	builder.AddReducer(func(values map[string]interface{}) (map[string]interface{}, error) {
+		fn := CommandFactory.Build("createinstance")().(*CreateInstance).convertInstanceTypeAlias
+		return fn(values)
+	}, "type")
 	return builder.Done()
 }
 
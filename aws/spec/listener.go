@@ -16,8 +16,6 @@ limitations under the License.
 package awsspec
 
 import (
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/logger"
@@ -25,17 +23,17 @@ import (
 )
 
 type CreateListener struct {
-	_            string `action:"create" entity:"listener" awsAPI:"elbv2" awsCall:"CreateListener" awsInput:"elbv2.CreateListenerInput" awsOutput:"elbv2.CreateListenerOutput"`
+	_            string `action:"create" entity:"listener" awsAPI:"elbv2" awsCall:"CreateListener" awsInput:"elbv2.CreateListenerInput" awsOutput:"elbv2.CreateListenerOutput" awsOutputExtract:"Listeners[0].ListenerArn"`
 	logger       *logger.Logger
 	graph        cloud.GraphAPI
 	api          elbv2iface.ELBV2API
-	Actiontype   *string `awsName:"DefaultActions[0]Type" awsType:"awsslicestruct" templateName:"actiontype"`
+	Actiontype   *string `awsName:"DefaultActions[0]Type" awsType:"awsslicestruct" templateName:"actiontype" enum:"forward"`
 	Targetgroup  *string `awsName:"DefaultActions[0]TargetGroupArn" awsType:"awsslicestruct" templateName:"targetgroup"`
 	Loadbalancer *string `awsName:"LoadBalancerArn" awsType:"awsstr" templateName:"loadbalancer"`
 	Port         *int64  `awsName:"Port" awsType:"awsint64" templateName:"port"`
-	Protocol     *string `awsName:"Protocol" awsType:"awsstr" templateName:"protocol"`
+	Protocol     *string `awsName:"Protocol" awsType:"awsstr" templateName:"protocol" enum:"HTTP,HTTPS"`
 	Certificate  *string `awsName:"Certificates[0]CertificateArn" awsType:"awsslicestruct" templateName:"certificate"`
-	Sslpolicy    *string `awsName:"SslPolicy" awsType:"awsstr" templateName:"sslpolicy"`
+	Sslpolicy    *string `awsName:"SslPolicy" awsType:"awsstr" templateName:"sslpolicy" enum:"ELBSecurityPolicy-2016-08,ELBSecurityPolicy-TLS-1-2-2017-01,ELBSecurityPolicy-TLS-1-1-2017-01,ELBSecurityPolicy-2015-05,ELBSecurityPolicy-TLS-1-0-2015-04"`
 }
 
 func (cmd *CreateListener) ParamsSpec() params.Spec {
@@ -44,10 +42,6 @@ func (cmd *CreateListener) ParamsSpec() params.Spec {
 	))
 }
 
-func (cmd *CreateListener) ExtractResult(i interface{}) string {
-	return awssdk.StringValue(i.(*elbv2.CreateListenerOutput).Listeners[0].ListenerArn)
-}
-
 type AttachListener struct {
 	_           string `action:"attach" entity:"listener" awsAPI:"elbv2" awsCall:"AddListenerCertificates" awsInput:"elbv2.AddListenerCertificatesInput" awsOutput:"elbv2.AddListenerCertificatesOutput"`
 	logger      *logger.Logger
@@ -19,7 +19,6 @@ import (
 	"fmt"
 	"time"
 
-	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
@@ -30,7 +29,7 @@ import (
 )
 
 type CreateLoadbalancer struct {
-	_              string `action:"create" entity:"loadbalancer" awsAPI:"elbv2" awsCall:"CreateLoadBalancer" awsInput:"elbv2.CreateLoadBalancerInput" awsOutput:"elbv2.CreateLoadBalancerOutput"`
+	_              string `action:"create" entity:"loadbalancer" awsAPI:"elbv2" awsCall:"CreateLoadBalancer" awsInput:"elbv2.CreateLoadBalancerInput" awsOutput:"elbv2.CreateLoadBalancerOutput" awsOutputExtract:"LoadBalancers[0].LoadBalancerArn"`
 	logger         *logger.Logger
 	graph          cloud.GraphAPI
 	api            elbv2iface.ELBV2API
@@ -50,10 +49,6 @@ func (cmd *CreateLoadbalancer) ParamsSpec() params.Spec {
 	))
 }
 
-func (cmd *CreateLoadbalancer) ExtractResult(i interface{}) string {
-	return awssdk.StringValue(i.(*elbv2.CreateLoadBalancerOutput).LoadBalancers[0].LoadBalancerArn)
-}
-
 type DeleteLoadbalancer struct {
 	_      string `action:"delete" entity:"loadbalancer" awsAPI:"elbv2" awsCall:"DeleteLoadBalancer" awsInput:"elbv2.DeleteLoadBalancerInput" awsOutput:"elbv2.DeleteLoadBalancerOutput"`
 	logger *logger.Logger
@@ -72,8 +67,8 @@ type CheckLoadbalancer struct {
 	graph   cloud.GraphAPI
 	api     elbv2iface.ELBV2API
 	Id      *string `templateName:"id"`
-	State   *string `templateName:"state"`
-	Timeout *int64  `templateName:"timeout"`
+	State   *string `templateName:"state" enum:"provisioning,active,failed,not-found"`
+	Timeout *int64  `templateName:"timeout" enum:"10,60,180,300,600,900"`
 }
 
 func (cmd *CheckLoadbalancer) ParamsSpec() params.Spec {
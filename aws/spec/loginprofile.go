@@ -16,8 +16,6 @@ limitations under the License.
 package awsspec
 
 import (
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/logger"
@@ -25,7 +23,7 @@ import (
 )
 
 type CreateLoginprofile struct {
-	_             string `action:"create" entity:"loginprofile" awsAPI:"iam" awsCall:"CreateLoginProfile" awsInput:"iam.CreateLoginProfileInput" awsOutput:"iam.CreateLoginProfileOutput"`
+	_             string `action:"create" entity:"loginprofile" awsAPI:"iam" awsCall:"CreateLoginProfile" awsInput:"iam.CreateLoginProfileInput" awsOutput:"iam.CreateLoginProfileOutput" awsOutputExtract:"LoginProfile.UserName"`
 	logger        *logger.Logger
 	graph         cloud.GraphAPI
 	api           iamiface.IAMAPI
@@ -40,10 +38,6 @@ func (cmd *CreateLoginprofile) ParamsSpec() params.Spec {
 	))
 }
 
-func (cmd *CreateLoginprofile) ExtractResult(i interface{}) string {
-	return awssdk.StringValue(i.(*iam.CreateLoginProfileOutput).LoginProfile.UserName)
-}
-
 type UpdateLoginprofile struct {
 	_             string `action:"update" entity:"loginprofile" awsAPI:"iam" awsCall:"UpdateLoginProfile" awsInput:"iam.UpdateLoginProfileInput" awsOutput:"iam.UpdateLoginProfileOutput"`
 	logger        *logger.Logger
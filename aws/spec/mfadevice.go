@@ -36,6 +36,7 @@ import (
 	"github.com/fatih/color"
 	"github.com/wallix/awless/aws/config"
 	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/prompt"
 )
 
 type CreateMfadevice struct {
@@ -109,10 +110,10 @@ type AttachMfadevice struct {
 	graph    cloud.GraphAPI
 	api      iamiface.IAMAPI
 	Id       *string `awsName:"SerialNumber" awsType:"awsstr" templateName:"id"`
-	User     *string `awsName:"UserName" awsType:"awsstr" templateName:"user"`
-	MfaCode1 *string `awsName:"AuthenticationCode1" awsType:"aws6digitsstring" templateName:"mfa-code-1"`
-	MfaCode2 *string `awsName:"AuthenticationCode2" awsType:"aws6digitsstring" templateName:"mfa-code-2"`
-	NoPrompt *bool   `templateName:"no-prompt"`
+	User     *string `awsName:"UserName" awsType:"awsstr" templateName:"user" ref:"user.Name"`
+	MfaCode1 *string `awsName:"AuthenticationCode1" awsType:"aws6digitsstring" templateName:"mfa-code-1" enum:""`
+	MfaCode2 *string `awsName:"AuthenticationCode2" awsType:"aws6digitsstring" templateName:"mfa-code-2" enum:""`
+	NoPrompt *bool   `templateName:"no-prompt" enum:"true,false"`
 }
 
 func (cmd *AttachMfadevice) ParamsSpec() params.Spec {
@@ -123,6 +124,9 @@ func (cmd *AttachMfadevice) ParamsSpec() params.Spec {
 
 func (cmd *AttachMfadevice) AfterRun(renv env.Running, output interface{}) error {
 	if !BoolValue(cmd.NoPrompt) {
+		if err := prompt.Check(prompt.ProfileSetup, "creating a profile for the MFA device requires input: set no-prompt=true"); err != nil {
+			return err
+		}
 		if promptConfirm("\nDo you want to create a profile for this MFA device in %s?", awsConfigFilepath) {
 			roleArn, err := promptRole(cmd.api)
 			for err != nil {
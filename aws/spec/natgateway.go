@@ -23,7 +23,6 @@ import (
 	"github.com/wallix/awless/template/env"
 	"github.com/wallix/awless/template/params"
 
-	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
@@ -31,7 +30,7 @@ import (
 )
 
 type CreateNatgateway struct {
-	_           string `action:"create" entity:"natgateway" awsAPI:"ec2" awsCall:"CreateNatGateway" awsInput:"ec2.CreateNatGatewayInput" awsOutput:"ec2.CreateNatGatewayOutput"`
+	_           string `action:"create" entity:"natgateway" awsAPI:"ec2" awsCall:"CreateNatGateway" awsInput:"ec2.CreateNatGatewayInput" awsOutput:"ec2.CreateNatGatewayOutput" awsOutputExtract:"NatGateway.NatGatewayId"`
 	logger      *logger.Logger
 	graph       cloud.GraphAPI
 	api         ec2iface.EC2API
@@ -43,10 +42,6 @@ func (cmd *CreateNatgateway) ParamsSpec() params.Spec {
 	return params.NewSpec(params.AllOf(params.Key("elasticip-id"), params.Key("subnet")))
 }
 
-func (cmd *CreateNatgateway) ExtractResult(i interface{}) string {
-	return awssdk.StringValue(i.(*ec2.CreateNatGatewayOutput).NatGateway.NatGatewayId)
-}
-
 type DeleteNatgateway struct {
 	_      string `action:"delete" entity:"natgateway" awsAPI:"ec2" awsCall:"DeleteNatGateway" awsInput:"ec2.DeleteNatGatewayInput" awsOutput:"ec2.DeleteNatGatewayOutput"`
 	logger *logger.Logger
@@ -65,8 +60,8 @@ type CheckNatgateway struct {
 	graph   cloud.GraphAPI
 	api     ec2iface.EC2API
 	Id      *string `templateName:"id"`
-	State   *string `templateName:"state"`
-	Timeout *int64  `templateName:"timeout"`
+	State   *string `templateName:"state" enum:"pending,failed,available,deleting,deleted,not-found"`
+	Timeout *int64  `templateName:"timeout" enum:"10,60,180,300,600,900"`
 }
 
 func (cmd *CheckNatgateway) ParamsSpec() params.Spec {
@@ -25,7 +25,6 @@ import (
 	"github.com/wallix/awless/template/env"
 	"github.com/wallix/awless/template/params"
 
-	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
@@ -33,7 +32,7 @@ import (
 )
 
 type CreateNetworkinterface struct {
-	_              string `action:"create" entity:"networkinterface" awsAPI:"ec2" awsCall:"CreateNetworkInterface" awsInput:"ec2.CreateNetworkInterfaceInput" awsOutput:"ec2.CreateNetworkInterfaceOutput" awsDryRun:""`
+	_              string `action:"create" entity:"networkinterface" awsAPI:"ec2" awsCall:"CreateNetworkInterface" awsInput:"ec2.CreateNetworkInterfaceInput" awsOutput:"ec2.CreateNetworkInterfaceOutput" awsDryRun:"" awsOutputExtract:"NetworkInterface.NetworkInterfaceId"`
 	logger         *logger.Logger
 	graph          cloud.GraphAPI
 	api            ec2iface.EC2API
@@ -50,10 +49,6 @@ func (cmd *CreateNetworkinterface) ParamsSpec() params.Spec {
 	)
 }
 
-func (cmd *CreateNetworkinterface) ExtractResult(i interface{}) string {
-	return awssdk.StringValue(i.(*ec2.CreateNetworkInterfaceOutput).NetworkInterface.NetworkInterfaceId)
-}
-
 type DeleteNetworkinterface struct {
 	_      string `action:"delete" entity:"networkinterface" awsAPI:"ec2" awsCall:"DeleteNetworkInterface" awsInput:"ec2.DeleteNetworkInterfaceInput" awsOutput:"ec2.DeleteNetworkInterfaceOutput" awsDryRun:""`
 	logger *logger.Logger
@@ -67,7 +62,7 @@ func (cmd *DeleteNetworkinterface) ParamsSpec() params.Spec {
 }
 
 type AttachNetworkinterface struct {
-	_           string `action:"attach" entity:"networkinterface" awsAPI:"ec2" awsCall:"AttachNetworkInterface" awsInput:"ec2.AttachNetworkInterfaceInput" awsOutput:"ec2.AttachNetworkInterfaceOutput" awsDryRun:""`
+	_           string `action:"attach" entity:"networkinterface" awsAPI:"ec2" awsCall:"AttachNetworkInterface" awsInput:"ec2.AttachNetworkInterfaceInput" awsOutput:"ec2.AttachNetworkInterfaceOutput" awsDryRun:"" awsOutputExtract:"AttachmentId"`
 	logger      *logger.Logger
 	graph       cloud.GraphAPI
 	api         ec2iface.EC2API
@@ -80,10 +75,6 @@ func (cmd *AttachNetworkinterface) ParamsSpec() params.Spec {
 	return params.NewSpec(params.AllOf(params.Key("device-index"), params.Key("id"), params.Key("instance")))
 }
 
-func (cmd *AttachNetworkinterface) ExtractResult(i interface{}) string {
-	return awssdk.StringValue(i.(*ec2.AttachNetworkInterfaceOutput).AttachmentId)
-}
-
 type DetachNetworkinterface struct {
 	_          string `action:"detach" entity:"networkinterface" awsAPI:"ec2" awsDryRun:"manual"`
 	logger     *logger.Logger
@@ -92,7 +83,7 @@ type DetachNetworkinterface struct {
 	Attachment *string `awsName:"AttachmentId" awsType:"awsstr" templateName:"attachment"`
 	Instance   *string `awsName:"InstanceId" awsType:"awsstr" templateName:"instance"`
 	Id         *string `awsName:"NetworkInterfaceId" awsType:"awsstr" templateName:"id"`
-	Force      *bool   `awsName:"Force" awsType:"awsbool" templateName:"force"`
+	Force      *bool   `awsName:"Force" awsType:"awsbool" templateName:"force" enum:"true,false"`
 }
 
 func (cmd *DetachNetworkinterface) ParamsSpec() params.Spec {
@@ -181,8 +172,8 @@ type CheckNetworkinterface struct {
 	graph   cloud.GraphAPI
 	api     ec2iface.EC2API
 	Id      *string `templateName:"id"`
-	State   *string `templateName:"state"`
-	Timeout *int64  `templateName:"timeout"`
+	State   *string `templateName:"state" enum:"available,attaching,detaching,in-use,not-found"`
+	Timeout *int64  `templateName:"timeout" enum:"10,60,180,300,600,900"`
 }
 
 func (cmd *CheckNetworkinterface) ParamsSpec() params.Spec {
@@ -39,14 +39,14 @@ import (
 )
 
 type CreatePolicy struct {
-	_           string `action:"create" entity:"policy" awsAPI:"iam" awsCall:"CreatePolicy" awsInput:"iam.CreatePolicyInput" awsOutput:"iam.CreatePolicyOutput"`
+	_           string `action:"create" entity:"policy" awsAPI:"iam" awsCall:"CreatePolicy" awsInput:"iam.CreatePolicyInput" awsOutput:"iam.CreatePolicyOutput" awsOutputExtract:"Policy.Arn"`
 	logger      *logger.Logger
 	graph       cloud.GraphAPI
 	api         iamiface.IAMAPI
 	Name        *string   `awsName:"PolicyName" awsType:"awsstr" templateName:"name"`
-	Effect      *string   `templateName:"effect"`
-	Action      []*string `templateName:"action"`
-	Resource    []*string `templateName:"resource"`
+	Effect      *string   `templateName:"effect" enum:"Allow,Deny"`
+	Action      []*string `templateName:"action" enum:""`
+	Resource    []*string `templateName:"resource" enum:"*"`
 	Description *string   `awsName:"Description" awsType:"awsstr" templateName:"description"`
 	Document    *string   `awsName:"PolicyDocument" awsType:"awsstr"`
 	Conditions  []*string `templateName:"conditions"`
@@ -77,17 +77,13 @@ func (cmd *CreatePolicy) BeforeRun(renv env.Running) error {
 	return nil
 }
 
-func (cmd *CreatePolicy) ExtractResult(i interface{}) string {
-	return StringValue(i.(*iam.CreatePolicyOutput).Policy.Arn)
-}
-
 type UpdatePolicy struct {
 	_              string `action:"update" entity:"policy" awsAPI:"iam" awsCall:"CreatePolicyVersion" awsInput:"iam.CreatePolicyVersionInput" awsOutput:"iam.CreatePolicyVersionOutput"`
 	logger         *logger.Logger
 	graph          cloud.GraphAPI
 	api            iamiface.IAMAPI
-	Arn            *string   `awsName:"PolicyArn" awsType:"awsstr" templateName:"arn"`
-	Effect         *string   `templateName:"effect"`
+	Arn            *string   `awsName:"PolicyArn" awsType:"awsstr" templateName:"arn" ref:"policy.Arn"`
+	Effect         *string   `templateName:"effect" enum:"Allow,Deny"`
 	Action         []*string `templateName:"action"`
 	Resource       []*string `templateName:"resource"`
 	Conditions     []*string `templateName:"conditions"`
@@ -173,8 +169,8 @@ type DeletePolicy struct {
 	logger      *logger.Logger
 	graph       cloud.GraphAPI
 	api         iamiface.IAMAPI
-	Arn         *string `awsName:"PolicyArn" awsType:"awsstr" templateName:"arn"`
-	AllVersions *bool   `templateName:"all-versions"`
+	Arn         *string `awsName:"PolicyArn" awsType:"awsstr" templateName:"arn" ref:"policy.Arn"`
+	AllVersions *bool   `templateName:"all-versions" enum:"true,false"`
 }
 
 func (cmd *DeletePolicy) ParamsSpec() params.Spec {
@@ -206,19 +202,21 @@ type AttachPolicy struct {
 	logger  *logger.Logger
 	graph   cloud.GraphAPI
 	api     iamiface.IAMAPI
-	Arn     *string `awsName:"PolicyArn" awsType:"awsstr" templateName:"arn"`
-	User    *string `awsName:"UserName" awsType:"awsstr" templateName:"user"`
-	Group   *string `awsName:"GroupName" awsType:"awsstr" templateName:"group"`
-	Role    *string `awsName:"RoleName" awsType:"awsstr" templateName:"role"`
-	Service *string `templateName:"service"`
-	Access  *string `templateName:"access"`
+	Arn     *string `awsName:"PolicyArn" awsType:"awsstr" templateName:"arn" ref:"policy.Arn"`
+	User    *string `awsName:"UserName" awsType:"awsstr" templateName:"user" ref:"user.Name"`
+	Group   *string `awsName:"GroupName" awsType:"awsstr" templateName:"group" ref:"group.Name"`
+	Role    *string `awsName:"RoleName" awsType:"awsstr" templateName:"role" ref:"role.Name"`
+	Service *string `templateName:"service" enum:"iam,ec2,s3,route53,elbv2,rds,autoscaling,lambda,sns,sqs,cloudwatch,cloudfront,ecr,ecs,applicationautoscaling,acm,sts,cloudformation"`
+	Access  *string `templateName:"access" enum:"readonly,full"`
 }
 
 func (cmd *AttachPolicy) ParamsSpec() params.Spec {
 	builder := params.SpecBuilder(params.AllOf(
 		params.OnlyOneOf(params.Key("user"), params.Key("role"), params.Key("group")),
 		params.OnlyOneOf(params.Key("arn"), params.AllOf(params.Key("access"), params.Key("service"))),
-	))
+	),
+		params.Validators{"arn": params.IsARN},
+	)
 	builder.AddReducer(transformAccessServiceToARN, "access", "service")
 	return builder.Done()
 }
@@ -272,17 +270,19 @@ type DetachPolicy struct {
 	logger *logger.Logger
 	graph  cloud.GraphAPI
 	api    iamiface.IAMAPI
-	Arn    *string `awsName:"PolicyArn" awsType:"awsstr" templateName:"arn"`
-	User   *string `awsName:"UserName" awsType:"awsstr" templateName:"user"`
-	Group  *string `awsName:"GroupName" awsType:"awsstr" templateName:"group"`
-	Role   *string `awsName:"RoleName" awsType:"awsstr" templateName:"role"`
+	Arn    *string `awsName:"PolicyArn" awsType:"awsstr" templateName:"arn" ref:"policy.Arn"`
+	User   *string `awsName:"UserName" awsType:"awsstr" templateName:"user" ref:"user.Name"`
+	Group  *string `awsName:"GroupName" awsType:"awsstr" templateName:"group" ref:"group.Name"`
+	Role   *string `awsName:"RoleName" awsType:"awsstr" templateName:"role" ref:"role.Name"`
 }
 
 func (cmd *DetachPolicy) ParamsSpec() params.Spec {
 	builder := params.SpecBuilder(params.AllOf(
 		params.OnlyOneOf(params.Key("user"), params.Key("role"), params.Key("group")),
 		params.OnlyOneOf(params.Key("arn"), params.AllOf(params.Key("access"), params.Key("service"))),
-	))
+	),
+		params.Validators{"arn": params.IsARN},
+	)
 	builder.AddReducer(transformAccessServiceToARN, "access", "service")
 	return builder.Done()
 }
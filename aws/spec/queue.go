@@ -16,16 +16,21 @@ limitations under the License.
 package awsspec
 
 import (
-	awssdk "github.com/aws/aws-sdk-go/aws"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
 	"github.com/wallix/awless/template/params"
 )
 
 type CreateQueue struct {
-	_                 string `action:"create" entity:"queue" awsAPI:"sqs" awsCall:"CreateQueue" awsInput:"sqs.CreateQueueInput" awsOutput:"sqs.CreateQueueOutput"`
+	_                 string `action:"create" entity:"queue" awsAPI:"sqs" awsCall:"CreateQueue" awsInput:"sqs.CreateQueueInput" awsOutput:"sqs.CreateQueueOutput" awsOutputExtract:"QueueUrl"`
 	logger            *logger.Logger
 	graph             cloud.GraphAPI
 	api               sqsiface.SQSAPI
@@ -45,10 +50,6 @@ func (cmd *CreateQueue) ParamsSpec() params.Spec {
 	))
 }
 
-func (cmd *CreateQueue) ExtractResult(i interface{}) string {
-	return awssdk.StringValue(i.(*sqs.CreateQueueOutput).QueueUrl)
-}
-
 type DeleteQueue struct {
 	_      string `action:"delete" entity:"queue" awsAPI:"sqs" awsCall:"DeleteQueue" awsInput:"sqs.DeleteQueueInput" awsOutput:"sqs.DeleteQueueOutput"`
 	logger *logger.Logger
@@ -60,3 +61,176 @@ type DeleteQueue struct {
 func (cmd *DeleteQueue) ParamsSpec() params.Spec {
 	return params.NewSpec(params.AllOf(params.Key("url")))
 }
+
+type UpdateQueue struct {
+	_                 string `action:"update" entity:"queue" awsAPI:"sqs" awsCall:"SetQueueAttributes" awsInput:"sqs.SetQueueAttributesInput" awsOutput:"sqs.SetQueueAttributesOutput"`
+	logger            *logger.Logger
+	graph             cloud.GraphAPI
+	api               sqsiface.SQSAPI
+	Url               *string `awsName:"QueueUrl" awsType:"awsstr" templateName:"url"`
+	Delay             *string `awsName:"Attributes[DelaySeconds]" awsType:"awsstringpointermap" templateName:"delay"`
+	MaxMsgSize        *string `awsName:"Attributes[MaximumMessageSize]" awsType:"awsstringpointermap" templateName:"max-msg-size"`
+	RetentionPeriod   *string `awsName:"Attributes[MessageRetentionPeriod]" awsType:"awsstringpointermap" templateName:"retention-period"`
+	Policy            *string `awsName:"Attributes[Policy]" awsType:"awsstringpointermap" templateName:"policy"`
+	MsgWait           *string `awsName:"Attributes[ReceiveMessageWaitTimeSeconds]" awsType:"awsstringpointermap" templateName:"msg-wait"`
+	RedrivePolicy     *string `awsName:"Attributes[RedrivePolicy]" awsType:"awsstringpointermap" templateName:"redrive-policy"`
+	VisibilityTimeout *string `awsName:"Attributes[VisibilityTimeout]" awsType:"awsstringpointermap" templateName:"visibility-timeout"`
+}
+
+func (cmd *UpdateQueue) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("url"),
+		params.AtLeastOneOf(params.Key("delay"), params.Key("max-msg-size"), params.Key("msg-wait"), params.Key("policy"), params.Key("redrive-policy"), params.Key("retention-period"), params.Key("visibility-timeout")),
+	),
+		params.Validators{
+			"policy":         isJSONPolicy,
+			"redrive-policy": isJSONPolicy,
+		},
+	)
+}
+
+// The statements of the policy are added to the existing policy of the queue,
+// replacing the statements with the same Sid (ex: to allow a SNS topic to send messages)
+type AttachQueuepolicy struct {
+	_      string `action:"attach" entity:"queuepolicy" awsAPI:"sqs"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    sqsiface.SQSAPI
+	Url    *string `templateName:"url"`
+	Policy *string `templateName:"policy"`
+}
+
+func (cmd *AttachQueuepolicy) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("url"), params.Key("policy")),
+		params.Validators{"policy": isJSONPolicy},
+	)
+}
+
+func (cmd *AttachQueuepolicy) ManualRun(renv env.Running) (interface{}, error) {
+	current, err := getQueuePolicy(cmd.api, cmd.logger, cmd.Url)
+	if err != nil {
+		return nil, err
+	}
+	attached, err := parseQueuePolicy(StringValue(cmd.Policy))
+	if err != nil {
+		return nil, err
+	}
+	current.Statement = mergePolicyStatements(current.Statement, attached.Statement)
+	return nil, setQueuePolicy(cmd.api, cmd.logger, cmd.Url, current)
+}
+
+// Without policy, the whole policy of the queue is removed
+type DetachQueuepolicy struct {
+	_      string `action:"detach" entity:"queuepolicy" awsAPI:"sqs"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    sqsiface.SQSAPI
+	Url    *string `templateName:"url"`
+	Policy *string `templateName:"policy"`
+}
+
+func (cmd *DetachQueuepolicy) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("url"), params.Opt("policy")),
+		params.Validators{"policy": isJSONPolicy},
+	)
+}
+
+func (cmd *DetachQueuepolicy) ManualRun(renv env.Running) (interface{}, error) {
+	if cmd.Policy == nil {
+		return nil, setQueuePolicy(cmd.api, cmd.logger, cmd.Url, nil)
+	}
+	current, err := getQueuePolicy(cmd.api, cmd.logger, cmd.Url)
+	if err != nil {
+		return nil, err
+	}
+	detached, err := parseQueuePolicy(StringValue(cmd.Policy))
+	if err != nil {
+		return nil, err
+	}
+	current.Statement = removePolicyStatements(current.Statement, detached.Statement)
+	if len(current.Statement) == 0 {
+		return nil, setQueuePolicy(cmd.api, cmd.logger, cmd.Url, nil)
+	}
+	return nil, setQueuePolicy(cmd.api, cmd.logger, cmd.Url, current)
+}
+
+type queuePolicy struct {
+	Version   string                   `json:",omitempty"`
+	Id        string                   `json:",omitempty"`
+	Statement []map[string]interface{} `json:"Statement"`
+}
+
+// parseQueuePolicy parses a JSON policy, its statement being either a single statement or a list
+func parseQueuePolicy(text string) (*queuePolicy, error) {
+	var raw struct {
+		Version, Id string
+		Statement   json.RawMessage
+	}
+	if err := json.Unmarshal([]byte(text), &raw); err != nil {
+		return nil, fmt.Errorf("invalid JSON policy: %s", err)
+	}
+	policy := &queuePolicy{Version: raw.Version, Id: raw.Id}
+	if len(raw.Statement) == 0 {
+		return policy, nil
+	}
+	if err := json.Unmarshal(raw.Statement, &policy.Statement); err != nil {
+		var single map[string]interface{}
+		if err = json.Unmarshal(raw.Statement, &single); err != nil {
+			return nil, fmt.Errorf("invalid policy statement: %s", err)
+		}
+		policy.Statement = []map[string]interface{}{single}
+	}
+	return policy, nil
+}
+
+func mergePolicyStatements(existing, added []map[string]interface{}) []map[string]interface{} {
+	merged := removePolicyStatements(existing, added)
+	return append(merged, added...)
+}
+
+// removePolicyStatements removes the statements with the same Sid or, without Sid, the identical ones
+func removePolicyStatements(existing, removed []map[string]interface{}) (out []map[string]interface{}) {
+	for _, st := range existing {
+		var found bool
+		for _, rm := range removed {
+			sid, hasSid := rm["Sid"]
+			if (hasSid && sid == st["Sid"]) || (!hasSid && reflect.DeepEqual(st, rm)) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			out = append(out, st)
+		}
+	}
+	return
+}
+
+func getQueuePolicy(api sqsiface.SQSAPI, l *logger.Logger, url *string) (*queuePolicy, error) {
+	start := time.Now()
+	out, err := api.GetQueueAttributes(&sqs.GetQueueAttributesInput{QueueUrl: url, AttributeNames: []*string{String("Policy")}})
+	l.ExtraVerbosef("sqs.GetQueueAttributes call took %s", time.Since(start))
+	if err != nil {
+		return nil, err
+	}
+	policy := StringValue(out.Attributes["Policy"])
+	if policy == "" {
+		return &queuePolicy{Version: "2012-10-17"}, nil
+	}
+	return parseQueuePolicy(policy)
+}
+
+// a nil policy removes the policy of the queue
+func setQueuePolicy(api sqsiface.SQSAPI, l *logger.Logger, url *string, policy *queuePolicy) error {
+	var text string
+	if policy != nil {
+		b, err := json.Marshal(policy)
+		if err != nil {
+			return err
+		}
+		text = string(b)
+	}
+	start := time.Now()
+	_, err := api.SetQueueAttributes(&sqs.SetQueueAttributesInput{QueueUrl: url, Attributes: map[string]*string{"Policy": String(text)}})
+	l.ExtraVerbosef("sqs.SetQueueAttributes call took %s", time.Since(start))
+	return err
+}
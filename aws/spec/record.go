@@ -17,6 +17,7 @@ package awsspec
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/wallix/awless/cloud"
@@ -26,35 +27,39 @@ import (
 	"github.com/wallix/awless/template/env"
 	"github.com/wallix/awless/template/params"
 
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/route53/route53iface"
 	"github.com/wallix/awless/logger"
 )
 
 type CreateRecord struct {
-	_       string `action:"create" entity:"record" awsAPI:"route53"`
-	logger  *logger.Logger
-	graph   cloud.GraphAPI
-	api     route53iface.Route53API
-	Zone    *string   `templateName:"zone"`
-	Name    *string   `templateName:"name"`
-	Type    *string   `templateName:"type"`
-	Values  []*string `templateName:"values"`
-	Ttl     *int64    `templateName:"ttl"`
-	Comment *string   `templateName:"comment"`
+	_             string `action:"create" entity:"record" awsAPI:"route53"`
+	logger        *logger.Logger
+	graph         cloud.GraphAPI
+	api           route53iface.Route53API
+	Zone          *string   `templateName:"zone"`
+	Name          *string   `templateName:"name"`
+	Type          *string   `templateName:"type" enum:"A,AAAA,CNAME,MX,NAPTR,NS,PTR,SOA,SPF,SRV,TXT"`
+	Values        []*string `templateName:"values" ref:"record.Records"`
+	Ttl           *int64    `templateName:"ttl"`
+	Comment       *string   `templateName:"comment"`
+	Failover      *string   `templateName:"failover" enum:"PRIMARY,SECONDARY"`
+	Healthcheck   *string   `templateName:"healthcheck"`
+	SetIdentifier *string   `templateName:"set-identifier"`
 }
 
 func (cmd *CreateRecord) ParamsSpec() params.Spec {
 	builder := params.SpecBuilder(params.AllOf(params.Key("name"), params.Key("ttl"), params.Key("type"), params.OnlyOneOf(params.Key("values"), params.Key("value")), params.Key("zone"),
-		params.Opt("comment"),
-	))
+		params.Opt("comment", "failover", "healthcheck", "set-identifier"),
+	), recordValidators)
 	builder.AddReducer(valueToValues, "value")
 	return builder.Done()
 }
 
 func (cmd *CreateRecord) ManualRun(renv env.Running) (interface{}, error) {
 	start := time.Now()
-	output, err := changeResourceRecordSets(cmd.api, String("CREATE"), cmd.Zone, cmd.Name, cmd.Type, cmd.Values, cmd.Comment, cmd.Ttl)
+	output, err := changeResourceRecordSets(cmd.api, String("CREATE"), cmd.Zone, cmd.Name, cmd.Type, cmd.Values, cmd.Comment, cmd.Ttl, cmd.routing())
 	cmd.logger.ExtraVerbosef("route53.ChangeResourceRecordSets call took %s", time.Since(start))
 	return output, err
 }
@@ -63,27 +68,37 @@ func (cmd *CreateRecord) ExtractResult(i interface{}) string {
 	return StringValue(i.(*route53.ChangeResourceRecordSetsOutput).ChangeInfo.Id)
 }
 
+func (cmd *CreateRecord) routing() *recordRouting {
+	return &recordRouting{failover: cmd.Failover, healthcheck: cmd.Healthcheck, setIdentifier: cmd.SetIdentifier}
+}
+
 type UpdateRecord struct {
-	_      string `action:"update" entity:"record" awsAPI:"route53"`
-	logger *logger.Logger
-	graph  cloud.GraphAPI
-	api    route53iface.Route53API
-	Zone   *string   `templateName:"zone"`
-	Name   *string   `templateName:"name"`
-	Type   *string   `templateName:"type"`
-	Values []*string `templateName:"values"`
-	Ttl    *int64    `templateName:"ttl"`
+	_             string `action:"update" entity:"record" awsAPI:"route53"`
+	logger        *logger.Logger
+	graph         cloud.GraphAPI
+	api           route53iface.Route53API
+	Zone          *string   `templateName:"zone"`
+	Name          *string   `templateName:"name"`
+	Type          *string   `templateName:"type" enum:"A,AAAA,CNAME,MX,NAPTR,NS,PTR,SOA,SPF,SRV,TXT"`
+	Values        []*string `templateName:"values"`
+	Ttl           *int64    `templateName:"ttl"`
+	Comment       *string   `templateName:"comment"`
+	Failover      *string   `templateName:"failover"`
+	Healthcheck   *string   `templateName:"healthcheck"`
+	SetIdentifier *string   `templateName:"set-identifier"`
 }
 
 func (cmd *UpdateRecord) ParamsSpec() params.Spec {
-	builder := params.SpecBuilder(params.AllOf(params.Key("name"), params.Key("ttl"), params.Key("type"), params.OnlyOneOf(params.Key("values"), params.Key("value")), params.Key("zone")))
+	builder := params.SpecBuilder(params.AllOf(params.Key("name"), params.Key("ttl"), params.Key("type"), params.OnlyOneOf(params.Key("values"), params.Key("value")), params.Key("zone"),
+		params.Opt("comment", "failover", "healthcheck", "set-identifier"),
+	), recordValidators)
 	builder.AddReducer(valueToValues, "value")
 	return builder.Done()
 }
 
 func (cmd *UpdateRecord) ManualRun(renv env.Running) (interface{}, error) {
 	start := time.Now()
-	output, err := changeResourceRecordSets(cmd.api, String("UPSERT"), cmd.Zone, cmd.Name, cmd.Type, cmd.Values, nil, cmd.Ttl)
+	output, err := changeResourceRecordSets(cmd.api, String("UPSERT"), cmd.Zone, cmd.Name, cmd.Type, cmd.Values, cmd.Comment, cmd.Ttl, cmd.routing())
 	cmd.logger.ExtraVerbosef("route53.ChangeResourceRecordSets call took %s", time.Since(start))
 	return output, err
 }
@@ -92,24 +107,34 @@ func (cmd *UpdateRecord) ExtractResult(i interface{}) string {
 	return StringValue(i.(*route53.ChangeResourceRecordSetsOutput).ChangeInfo.Id)
 }
 
+func (cmd *UpdateRecord) routing() *recordRouting {
+	return &recordRouting{failover: cmd.Failover, healthcheck: cmd.Healthcheck, setIdentifier: cmd.SetIdentifier}
+}
+
 type DeleteRecord struct {
-	_      string `action:"delete" entity:"record" awsAPI:"route53"`
-	logger *logger.Logger
-	graph  cloud.GraphAPI
-	api    route53iface.Route53API
-	Zone   *string   `templateName:"zone"`
-	Name   *string   `templateName:"name"`
-	Type   *string   `templateName:"type"`
-	Values []*string `templateName:"values"`
-	Ttl    *int64    `templateName:"ttl"`
+	_             string `action:"delete" entity:"record" awsAPI:"route53"`
+	logger        *logger.Logger
+	graph         cloud.GraphAPI
+	api           route53iface.Route53API
+	Zone          *string   `templateName:"zone"`
+	Name          *string   `templateName:"name"`
+	Type          *string   `templateName:"type" enum:"A,AAAA,CNAME,MX,NAPTR,NS,PTR,SOA,SPF,SRV,TXT"`
+	Values        []*string `templateName:"values"`
+	Ttl           *int64    `templateName:"ttl"`
+	Failover      *string   `templateName:"failover"`
+	Healthcheck   *string   `templateName:"healthcheck"`
+	SetIdentifier *string   `templateName:"set-identifier"`
 }
 
 func (cmd *DeleteRecord) ParamsSpec() params.Spec {
 	builder := params.SpecBuilder(
 		params.OnlyOneOf(
-			params.AllOf(params.Key("name"), params.Key("ttl"), params.Key("type"), params.OnlyOneOf(params.Key("values"), params.Key("value")), params.Key("zone")),
+			params.AllOf(params.Key("name"), params.Key("ttl"), params.Key("type"), params.OnlyOneOf(params.Key("values"), params.Key("value")), params.Key("zone"),
+				params.Opt("failover", "healthcheck", "set-identifier"),
+			),
 			params.AllOf(params.Key("id")),
 		),
+		recordValidators,
 	)
 	builder.AddReducer(valueToValues, "value")
 	builder.AddReducer(
@@ -154,7 +179,7 @@ func (cmd *DeleteRecord) ParamsSpec() params.Spec {
 
 func (cmd *DeleteRecord) ManualRun(renv env.Running) (interface{}, error) {
 	start := time.Now()
-	output, err := changeResourceRecordSets(cmd.api, String("DELETE"), cmd.Zone, cmd.Name, cmd.Type, cmd.Values, nil, cmd.Ttl)
+	output, err := changeResourceRecordSets(cmd.api, String("DELETE"), cmd.Zone, cmd.Name, cmd.Type, cmd.Values, nil, cmd.Ttl, cmd.routing())
 	cmd.logger.ExtraVerbosef("route53.ChangeResourceRecordSets call took %s", time.Since(start))
 	return output, err
 }
@@ -163,9 +188,135 @@ func (cmd *DeleteRecord) ExtractResult(i interface{}) string {
 	return StringValue(i.(*route53.ChangeResourceRecordSetsOutput).ChangeInfo.Id)
 }
 
-func changeResourceRecordSets(api route53iface.Route53API, action, zone, name, recordType *string, values []*string, comment *string, ttl *int64) (*route53.ChangeResourceRecordSetsOutput, error) {
+func (cmd *DeleteRecord) routing() *recordRouting {
+	return &recordRouting{failover: cmd.Failover, healthcheck: cmd.Healthcheck, setIdentifier: cmd.SetIdentifier}
+}
+
+var recordValidators = params.Validators{
+	"failover": params.IsInEnumIgnoreCase("PRIMARY", "SECONDARY"),
+	"name":     isRecordName,
+}
+
+const zoneApex = "@"
+
+// isRecordName validates a record name: the zone apex '@' or a domain name,
+// with an optional trailing dot and a wildcard only as its leftmost label
+func isRecordName(i interface{}, others map[string]interface{}) error {
+	name, ok := i.(string)
+	if !ok || name == zoneApex {
+		return nil
+	}
+	labels := strings.Split(strings.TrimSuffix(name, "."), ".")
+	for j, label := range labels {
+		if label == "" {
+			return fmt.Errorf("invalid record name '%s': empty label", name)
+		}
+		if strings.Contains(label, "*") && (j > 0 || label != "*") {
+			return fmt.Errorf("invalid record name '%s': wildcard '*' only allowed as the leftmost label (ex: *.example.com)", name)
+		}
+	}
+	return nil
+}
+
+// resolveRecordZoneAndName returns the hosted zone ID of a zone given by ID or by domain name (ex: example.com.)
+// and the normalized record name, replacing the zone apex '@' by the zone domain name
+func resolveRecordZoneAndName(api route53iface.Route53API, zone, name string) (string, string, error) {
+	zoneID, zoneName, err := resolveRecordZone(api, zone, name)
+	if err != nil {
+		return zone, name, err
+	}
+	name, err = normalizeRecordName(name, zoneName)
+	return zoneID, name, err
+}
+
+// resolveRecordZone returns the hosted zone ID of a zone given by ID or by domain name.
+// The zone domain name is only returned (without trailing dot) when it had to be fetched:
+// for a zone given by domain name or when one of the record names is the zone apex
+func resolveRecordZone(api route53iface.Route53API, zone string, names ...string) (string, string, error) {
+	isZoneName := strings.Contains(strings.TrimPrefix(zone, "/hostedzone/"), ".")
+	if !isZoneName {
+		var hasApex bool
+		for _, name := range names {
+			if name == zoneApex {
+				hasApex = true
+			}
+		}
+		if !hasApex {
+			return zone, "", nil
+		}
+		out, err := api.GetHostedZone(&route53.GetHostedZoneInput{Id: String(zone)})
+		if err != nil {
+			return zone, "", err
+		}
+		return zone, strings.TrimRight(StringValue(out.HostedZone.Name), "."), nil
+	}
+
+	var zoneID string
+	zoneName := strings.ToLower(strings.TrimRight(zone, ".")) + "."
+	out, err := api.ListHostedZonesByName(&route53.ListHostedZonesByNameInput{DNSName: String(zoneName)})
+	if err != nil {
+		return zone, "", err
+	}
+	for _, z := range out.HostedZones {
+		if strings.ToLower(StringValue(z.Name)) != zoneName {
+			continue
+		}
+		if zoneID != "" {
+			return zone, "", fmt.Errorf("several hosted zones named '%s': use the hosted zone ID instead", zoneName)
+		}
+		zoneID = StringValue(z.Id)
+	}
+	if zoneID == "" {
+		return zone, "", fmt.Errorf("no hosted zone named '%s'", zoneName)
+	}
+	return zoneID, strings.TrimRight(zoneName, "."), nil
+}
+
+// normalizeRecordName removes the trailing dots of a record name and replaces the zone apex '@'
+// by the zone domain name. The name is checked to be in the zone when the zone domain name is known.
+func normalizeRecordName(name, zoneName string) (string, error) {
+	name = strings.TrimRight(name, ".")
+	if zoneName == "" {
+		return name, nil
+	}
+	if name == zoneApex {
+		return zoneName, nil
+	}
+	if lower := strings.ToLower(name); lower != zoneName && !strings.HasSuffix(lower, "."+zoneName) {
+		return name, fmt.Errorf("record name '%s' is not in zone '%s'", name, zoneName)
+	}
+	return name, nil
+}
+
+// recordRouting holds the failover routing of a record, associated with a healthcheck
+type recordRouting struct {
+	failover, healthcheck, setIdentifier *string
+}
+
+func (r *recordRouting) apply(set *route53.ResourceRecordSet) {
+	if r == nil {
+		return
+	}
+	if r.failover != nil {
+		set.Failover = String(strings.ToUpper(StringValue(r.failover)))
+		set.SetIdentifier = String(strings.ToLower(StringValue(r.failover)))
+	}
+	if r.setIdentifier != nil {
+		set.SetIdentifier = r.setIdentifier
+	}
+	if r.healthcheck != nil {
+		set.HealthCheckId = r.healthcheck
+	}
+}
+
+func changeResourceRecordSets(api route53iface.Route53API, action, zone, name, recordType *string, values []*string, comment *string, ttl *int64, routing *recordRouting) (*route53.ChangeResourceRecordSetsOutput, error) {
+	zoneID, recordName, err := resolveRecordZoneAndName(api, StringValue(zone), StringValue(name))
+	if err != nil {
+		return nil, err
+	}
+	zone, name = String(zoneID), String(recordName)
+
 	input := &route53.ChangeResourceRecordSetsInput{}
-	var err error
 	// Required params
 	err = setFieldWithType(zone, input, "HostedZoneId", awsstr)
 	if err != nil {
@@ -193,6 +344,8 @@ func changeResourceRecordSets(api route53iface.Route53API, action, zone, name, r
 		change.ResourceRecordSet.ResourceRecords = append(change.ResourceRecordSet.ResourceRecords, resourceRecord)
 	}
 
+	routing.apply(change.ResourceRecordSet)
+
 	// Extra params
 	if comment != nil {
 		if err = setFieldWithType(comment, input, "ChangeBatch.Comment", awsstr); err != nil {
@@ -210,3 +363,49 @@ func valueToValues(values map[string]interface{}) (map[string]interface{}, error
 		return nil, nil
 	}
 }
+
+type CheckRecord struct {
+	_       string `action:"check" entity:"record" awsAPI:"route53"`
+	logger  *logger.Logger
+	graph   cloud.GraphAPI
+	api     route53iface.Route53API
+	Id      *string `templateName:"id"`
+	State   *string `templateName:"state" enum:"PENDING,INSYNC,not-found"`
+	Timeout *int64  `templateName:"timeout" enum:"10,60,180,300,600,900"`
+}
+
+func (cmd *CheckRecord) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("id"), params.Key("state"), params.Key("timeout")),
+		params.Validators{
+			"state": params.IsInEnumIgnoreCase(route53.ChangeStatusPending, route53.ChangeStatusInsync, notFoundState),
+		},
+	)
+}
+
+// ManualRun polls the change returned by a record change (ex: create record) until it reaches the expected state:
+// a change is INSYNC once propagated to all Route53 DNS servers
+func (cmd *CheckRecord) ManualRun(renv env.Running) (interface{}, error) {
+	input := &route53.GetChangeInput{
+		Id: cmd.Id,
+	}
+
+	c := &checker{
+		description: fmt.Sprintf("record change %s", StringValue(cmd.Id)),
+		timeout:     time.Duration(Int64AsIntValue(cmd.Timeout)) * time.Second,
+		frequency:   5 * time.Second,
+		fetchFunc: func() (string, error) {
+			output, err := cmd.api.GetChange(input)
+			if err != nil {
+				if aerr, ok := err.(awserr.Error); ok && aerr.Code() == route53.ErrCodeNoSuchChange {
+					return notFoundState, nil
+				}
+				return "", err
+			}
+			return StringValue(output.ChangeInfo.Status), nil
+		},
+		expect: StringValue(cmd.State),
+		logger: cmd.logger,
+	}
+	return nil, c.check()
+}
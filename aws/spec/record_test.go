@@ -0,0 +1,27 @@
+package awsspec
+
+import "testing"
+
+func TestIsRecordName(t *testing.T) {
+	tcases := []struct {
+		name   string
+		expErr bool
+	}{
+		{name: "@"},
+		{name: "example.com"},
+		{name: "www.example.com."},
+		{name: "*.example.com"},
+		{name: "*.sub.example.com."},
+		{name: "www..example.com", expErr: true},
+		{name: "example.com..", expErr: true},
+		{name: "www.*.example.com", expErr: true},
+		{name: "*www.example.com", expErr: true},
+	}
+	for _, tcase := range tcases {
+		if err := isRecordName(tcase.name, nil); tcase.expErr && err == nil {
+			t.Fatalf("%s: expected error", tcase.name)
+		} else if !tcase.expErr && err != nil {
+			t.Fatalf("%s: unexpected error: %s", tcase.name, err)
+		}
+	}
+}
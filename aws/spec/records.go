@@ -0,0 +1,180 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53/route53iface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+type CreateRecords struct {
+	_       string `action:"create" entity:"records" awsAPI:"route53"`
+	logger  *logger.Logger
+	graph   cloud.GraphAPI
+	api     route53iface.Route53API
+	Zone    *string   `templateName:"zone"`
+	Records []*string `templateName:"records"`
+	Comment *string   `templateName:"comment"`
+}
+
+func (cmd *CreateRecords) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("records"), params.Key("zone"), params.Opt("comment")), recordsValidators)
+}
+
+func (cmd *CreateRecords) ManualRun(renv env.Running) (interface{}, error) {
+	start := time.Now()
+	output, err := changeRecords(cmd.api, "CREATE", StringValue(cmd.Zone), castStringSlice(cmd.Records), cmd.Comment)
+	cmd.logger.ExtraVerbosef("route53.ChangeResourceRecordSets call took %s", time.Since(start))
+	return output, err
+}
+
+func (cmd *CreateRecords) ExtractResult(i interface{}) string {
+	return StringValue(i.(*route53.ChangeResourceRecordSetsOutput).ChangeInfo.Id)
+}
+
+type UpdateRecords struct {
+	_       string `action:"update" entity:"records" awsAPI:"route53"`
+	logger  *logger.Logger
+	graph   cloud.GraphAPI
+	api     route53iface.Route53API
+	Zone    *string   `templateName:"zone"`
+	Records []*string `templateName:"records"`
+	Comment *string   `templateName:"comment"`
+}
+
+func (cmd *UpdateRecords) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("records"), params.Key("zone"), params.Opt("comment")), recordsValidators)
+}
+
+func (cmd *UpdateRecords) ManualRun(renv env.Running) (interface{}, error) {
+	start := time.Now()
+	output, err := changeRecords(cmd.api, "UPSERT", StringValue(cmd.Zone), castStringSlice(cmd.Records), cmd.Comment)
+	cmd.logger.ExtraVerbosef("route53.ChangeResourceRecordSets call took %s", time.Since(start))
+	return output, err
+}
+
+func (cmd *UpdateRecords) ExtractResult(i interface{}) string {
+	return StringValue(i.(*route53.ChangeResourceRecordSetsOutput).ChangeInfo.Id)
+}
+
+type DeleteRecords struct {
+	_       string `action:"delete" entity:"records" awsAPI:"route53"`
+	logger  *logger.Logger
+	graph   cloud.GraphAPI
+	api     route53iface.Route53API
+	Zone    *string   `templateName:"zone"`
+	Records []*string `templateName:"records"`
+}
+
+func (cmd *DeleteRecords) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("records"), params.Key("zone")), recordsValidators)
+}
+
+func (cmd *DeleteRecords) ManualRun(renv env.Running) (interface{}, error) {
+	start := time.Now()
+	output, err := changeRecords(cmd.api, "DELETE", StringValue(cmd.Zone), castStringSlice(cmd.Records), nil)
+	cmd.logger.ExtraVerbosef("route53.ChangeResourceRecordSets call took %s", time.Since(start))
+	return output, err
+}
+
+func (cmd *DeleteRecords) ExtractResult(i interface{}) string {
+	return StringValue(i.(*route53.ChangeResourceRecordSetsOutput).ChangeInfo.Id)
+}
+
+var recordsValidators = params.Validators{
+	"records": func(i interface{}, others map[string]interface{}) error {
+		for _, line := range castStringSlice(i) {
+			if _, err := parseRecordLine(line); err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+}
+
+// recordLineRegex matches a record given as 'name ttl type value', the value possibly containing spaces
+var recordLineRegex = regexp.MustCompile(`^\s*(\S+)\s+(\d+)\s+(\S+)\s+(.*\S)\s*$`)
+
+type recordLine struct {
+	name, recordType, value string
+	ttl                     int64
+}
+
+func parseRecordLine(s string) (recordLine, error) {
+	matches := recordLineRegex.FindStringSubmatch(s)
+	if len(matches) != 5 {
+		return recordLine{}, fmt.Errorf("invalid record '%s': expected 'name ttl type value' (ex: 'www.example.com 300 A 52.1.2.3')", s)
+	}
+	if err := isRecordName(matches[1], nil); err != nil {
+		return recordLine{}, err
+	}
+	ttl, err := strconv.ParseInt(matches[2], 10, 64)
+	if err != nil {
+		return recordLine{}, fmt.Errorf("invalid record '%s': ttl: %s", s, err)
+	}
+	return recordLine{name: matches[1], ttl: ttl, recordType: strings.ToUpper(matches[3]), value: matches[4]}, nil
+}
+
+// changeRecords applies the same change action to all the given records in a single change batch,
+// which AWS applies atomically. Values of records with the same name and type are grouped in one record set.
+func changeRecords(api route53iface.Route53API, action, zone string, records []string, comment *string) (*route53.ChangeResourceRecordSetsOutput, error) {
+	var lines []recordLine
+	var names []string
+	for _, r := range records {
+		line, err := parseRecordLine(r)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, line)
+		names = append(names, line.name)
+	}
+
+	zoneID, zoneName, err := resolveRecordZone(api, zone, names...)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &route53.ChangeResourceRecordSetsInput{HostedZoneId: String(zoneID), ChangeBatch: &route53.ChangeBatch{Comment: comment}}
+	sets := make(map[string]*route53.ResourceRecordSet)
+	for _, line := range lines {
+		name, err := normalizeRecordName(line.name, zoneName)
+		if err != nil {
+			return nil, err
+		}
+		key := strings.ToLower(name) + " " + line.recordType
+		set, ok := sets[key]
+		if !ok {
+			set = &route53.ResourceRecordSet{Name: String(name), Type: String(line.recordType), TTL: Int64(line.ttl)}
+			sets[key] = set
+			input.ChangeBatch.Changes = append(input.ChangeBatch.Changes, &route53.Change{Action: String(action), ResourceRecordSet: set})
+		} else if *set.TTL != line.ttl {
+			return nil, fmt.Errorf("records %s %s: different ttls %d and %d", name, line.recordType, *set.TTL, line.ttl)
+		}
+		set.ResourceRecords = append(set.ResourceRecords, &route53.ResourceRecord{Value: String(line.value)})
+	}
+
+	return api.ChangeResourceRecordSets(input)
+}
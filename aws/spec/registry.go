@@ -17,9 +17,12 @@ package awsspec
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -31,6 +34,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/aws/aws-sdk-go/service/ecr/ecriface"
 	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/prompt"
+	"github.com/wallix/awless/ssh"
 )
 
 type AuthenticateRegistry struct {
@@ -41,10 +46,11 @@ type AuthenticateRegistry struct {
 	Accounts         []*string `templateName:"accounts"`
 	NoConfirm        *bool     `templateName:"no-confirm"`
 	DisableDockerCmd *bool     `templateName:"no-docker-login"`
+	DockerConfig     *bool     `templateName:"docker-config"`
 }
 
 func (cmd *AuthenticateRegistry) ParamsSpec() params.Spec {
-	return params.NewSpec(params.AtLeastOneOf(params.Key("accounts"), params.Key("no-confirm"), params.Key("no-docker-login")))
+	return params.NewSpec(params.AtLeastOneOf(params.Key("accounts"), params.Key("docker-config"), params.Key("no-confirm"), params.Key("no-docker-login")))
 }
 
 func (cmd *AuthenticateRegistry) ManualRun(renv env.Running) (interface{}, error) {
@@ -75,14 +81,31 @@ func (cmd *AuthenticateRegistry) ManualRun(renv env.Running) (interface{}, error
 		if len(credentials) != 2 {
 			return nil, fmt.Errorf("invalid authorization token: expect user:password, got %s", decoded)
 		}
-		torun := []string{"docker", "login", "--username", credentials[0], "--password", credentials[1], StringValue(auth.ProxyEndpoint)}
+		endpoint := StringValue(auth.ProxyEndpoint)
 
+		if BoolValue(cmd.DockerConfig) {
+			path := dockerConfigPath()
+			if err = writeDockerConfigAuth(path, endpoint, token, cmd.logger); err != nil {
+				return nil, fmt.Errorf("writing docker credentials: %s", err)
+			}
+			if auth.ExpiresAt != nil {
+				cmd.logger.Infof("Docker credentials for %s written in %s (valid until %s)", endpoint, path, auth.ExpiresAt.Local().Format(time.RFC1123))
+			} else {
+				cmd.logger.Infof("Docker credentials for %s written in %s", endpoint, path)
+			}
+			continue
+		}
+
+		torun := []string{"docker", "login", "--username", credentials[0], "--password-stdin", endpoint}
 		if BoolValue(cmd.DisableDockerCmd) {
-			cmd.logger.Infof("Docker authentication command:\n%s", strings.Join(torun, " "))
+			cmd.logger.Infof("Docker authentication command:\n%s", strings.Join([]string{"docker", "login", "--username", credentials[0], "--password", credentials[1], endpoint}, " "))
 		} else {
 			confirm := !(BoolValue(cmd.NoConfirm))
 			if confirm {
-				fmt.Fprintf(os.Stderr, "\nDocker authentication command:\n\n%s\n\nDo you want to run this command:(y/n)? ", strings.Join(torun, " "))
+				if err := prompt.Check(prompt.Confirmation, "running the docker authentication command cannot be confirmed: set no-confirm=true"); err != nil {
+					return nil, err
+				}
+				fmt.Fprintf(os.Stderr, "\nDocker authentication command (password given on stdin):\n\n%s\n\nDo you want to run this command:(y/n)? ", strings.Join(torun, " "))
 				var yesorno string
 				_, err := fmt.Scanln(&yesorno)
 				if err != nil {
@@ -93,12 +116,13 @@ func (cmd *AuthenticateRegistry) ManualRun(renv env.Running) (interface{}, error
 				}
 			}
 			dockerCmd := exec.Command("docker", torun[1:]...)
+			dockerCmd.Stdin = strings.NewReader(credentials[1])
 			out, err := dockerCmd.Output()
 			if err != nil {
 				if e, ok := err.(*exec.ExitError); ok {
 					return nil, fmt.Errorf("error running docker command: %s", e.Stderr)
 				}
-				return nil, fmt.Errorf("error running docker command: %s", err)
+				return nil, fmt.Errorf("error running docker command: %s (use docker-config=true to write the credentials without docker)", err)
 			}
 			if len(out) > 0 {
 				cmd.logger.Info(string(out))
@@ -108,3 +132,49 @@ func (cmd *AuthenticateRegistry) ManualRun(renv env.Running) (interface{}, error
 
 	return nil, nil
 }
+
+// dockerConfigPath returns the path of the Docker client config file ($DOCKER_CONFIG/config.json, default to ~/.docker/config.json)
+func dockerConfigPath() string {
+	if dir := os.Getenv("DOCKER_CONFIG"); dir != "" {
+		return filepath.Join(dir, "config.json")
+	}
+	return filepath.Join(ssh.HomeDir(), ".docker", "config.json")
+}
+
+// writeDockerConfigAuth sets the base64 'user:password' auth of the registry in the Docker
+// config file, as `docker login` does without credentials store, keeping the other settings
+func writeDockerConfigAuth(path, registry, auth string, l *logger.Logger) error {
+	conf := make(map[string]interface{})
+	content, err := ioutil.ReadFile(path)
+	switch {
+	case os.IsNotExist(err):
+	case err != nil:
+		return err
+	default:
+		if err = json.Unmarshal(content, &conf); err != nil {
+			return fmt.Errorf("invalid json in %s: %s", path, err)
+		}
+	}
+
+	auths, ok := conf["auths"].(map[string]interface{})
+	if !ok {
+		auths = make(map[string]interface{})
+	}
+	auths[registry] = map[string]interface{}{"auth": auth}
+	conf["auths"] = auths
+
+	host := strings.TrimPrefix(strings.TrimPrefix(registry, "https://"), "http://")
+	if helpers, ok := conf["credHelpers"].(map[string]interface{}); ok && helpers[host] != nil {
+		l.Warningf("docker uses the credentials helper '%v' for %s, ignoring the written credentials", helpers[host], host)
+	} else if store, ok := conf["credsStore"].(string); ok && store != "" {
+		l.Warningf("docker uses the credentials store '%s', ignoring the written credentials: run `docker login` instead", store)
+	}
+
+	if content, err = json.MarshalIndent(conf, "", "\t"); err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, content, 0600)
+}
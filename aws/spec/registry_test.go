@@ -0,0 +1,57 @@
+package awsspec
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/wallix/awless/logger"
+)
+
+func TestWriteDockerConfigAuth(t *testing.T) {
+	dir, err := ioutil.TempDir("", "docker")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, ".docker", "config.json")
+
+	if err = writeDockerConfigAuth(path, "https://123456789012.dkr.ecr.eu-west-1.amazonaws.com", "QVdTOnRva2Vu", logger.DiscardLogger); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(path, []byte(`{"auths": {"https://index.docker.io/v1/": {"auth": "dXNlcjpwd2Q="}}, "psFormat": "table {{.ID}}"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err = writeDockerConfigAuth(path, "https://123456789012.dkr.ecr.eu-west-1.amazonaws.com", "QVdTOm5ldw==", logger.DiscardLogger); err != nil {
+		t.Fatal(err)
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var conf map[string]interface{}
+	if err = json.Unmarshal(content, &conf); err != nil {
+		t.Fatal(err)
+	}
+	exp := map[string]interface{}{
+		"auths": map[string]interface{}{
+			"https://index.docker.io/v1/":                          map[string]interface{}{"auth": "dXNlcjpwd2Q="},
+			"https://123456789012.dkr.ecr.eu-west-1.amazonaws.com": map[string]interface{}{"auth": "QVdTOm5ldw=="},
+		},
+		"psFormat": "table {{.ID}}",
+	}
+	if !reflect.DeepEqual(conf, exp) {
+		t.Fatalf("got %#v, want %#v", conf, exp)
+	}
+
+	if err = ioutil.WriteFile(path, []byte("not json"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err = writeDockerConfigAuth(path, "https://123456789012.dkr.ecr.eu-west-1.amazonaws.com", "QVdTOm5ldw==", logger.DiscardLogger); err == nil {
+		t.Fatal("expected error on invalid config")
+	}
+}
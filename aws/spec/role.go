@@ -150,7 +150,7 @@ type AttachRole struct {
 	logger          *logger.Logger
 	graph           cloud.GraphAPI
 	api             iamiface.IAMAPI
-	Instanceprofile *string `awsName:"InstanceProfileName" awsType:"awsstr" templateName:"instanceprofile" `
+	Instanceprofile *string `awsName:"InstanceProfileName" awsType:"awsstr" templateName:"instanceprofile"  ref:"instanceprofile.Name"`
 	Name            *string `awsName:"RoleName" awsType:"awsstr" templateName:"name" `
 }
 
@@ -163,7 +163,7 @@ type DetachRole struct {
 	logger          *logger.Logger
 	graph           cloud.GraphAPI
 	api             iamiface.IAMAPI
-	Instanceprofile *string `awsName:"InstanceProfileName" awsType:"awsstr" templateName:"instanceprofile" `
+	Instanceprofile *string `awsName:"InstanceProfileName" awsType:"awsstr" templateName:"instanceprofile"  ref:"instanceprofile.Name"`
 	Name            *string `awsName:"RoleName" awsType:"awsstr" templateName:"name" `
 }
 
@@ -24,32 +24,35 @@ import (
 )
 
 type CreateRoute struct {
-	_       string `action:"create" entity:"route" awsAPI:"ec2" awsCall:"CreateRoute" awsInput:"ec2.CreateRouteInput" awsOutput:"ec2.CreateRouteOutput" awsDryRun:""`
-	logger  *logger.Logger
-	graph   cloud.GraphAPI
-	api     ec2iface.EC2API
-	Table   *string `awsName:"RouteTableId" awsType:"awsstr" templateName:"table"`
-	CIDR    *string `awsName:"DestinationCidrBlock" awsType:"awsstr" templateName:"cidr"`
-	Gateway *string `awsName:"GatewayId" awsType:"awsstr" templateName:"gateway"`
+	_                 string `action:"create" entity:"route" awsAPI:"ec2" awsCall:"CreateRoute" awsInput:"ec2.CreateRouteInput" awsOutput:"ec2.CreateRouteOutput" awsDryRun:""`
+	logger            *logger.Logger
+	graph             cloud.GraphAPI
+	api               ec2iface.EC2API
+	Table             *string `awsName:"RouteTableId" awsType:"awsstr" templateName:"table"`
+	CIDR              *string `awsName:"DestinationCidrBlock" awsType:"awsstr" templateName:"cidr"`
+	IPv6CIDR          *string `awsName:"DestinationIpv6CidrBlock" awsType:"awsstr" templateName:"ipv6-cidr"`
+	Gateway           *string `awsName:"GatewayId" awsType:"awsstr" templateName:"gateway"`
+	EgressOnlyGateway *string `awsName:"EgressOnlyInternetGatewayId" awsType:"awsstr" templateName:"egressonlygateway"`
 }
 
 func (cmd *CreateRoute) ParamsSpec() params.Spec {
 	return params.NewSpec(
-		params.AllOf(params.Key("cidr"), params.Key("gateway"), params.Key("table")),
-		params.Validators{"cidr": params.IsCIDR})
+		params.AllOf(params.OnlyOneOf(params.Key("cidr"), params.Key("ipv6-cidr")), params.OnlyOneOf(params.Key("gateway"), params.Key("egressonlygateway")), params.Key("table")),
+		params.Validators{"cidr": params.IsCIDR, "ipv6-cidr": isIPv6CIDR})
 }
 
 type DeleteRoute struct {
-	_      string `action:"delete" entity:"route" awsAPI:"ec2" awsCall:"DeleteRoute" awsInput:"ec2.DeleteRouteInput" awsOutput:"ec2.DeleteRouteOutput" awsDryRun:""`
-	logger *logger.Logger
-	graph  cloud.GraphAPI
-	api    ec2iface.EC2API
-	Table  *string `awsName:"RouteTableId" awsType:"awsstr" templateName:"table"`
-	CIDR   *string `awsName:"DestinationCidrBlock" awsType:"awsstr" templateName:"cidr"`
+	_        string `action:"delete" entity:"route" awsAPI:"ec2" awsCall:"DeleteRoute" awsInput:"ec2.DeleteRouteInput" awsOutput:"ec2.DeleteRouteOutput" awsDryRun:""`
+	logger   *logger.Logger
+	graph    cloud.GraphAPI
+	api      ec2iface.EC2API
+	Table    *string `awsName:"RouteTableId" awsType:"awsstr" templateName:"table"`
+	CIDR     *string `awsName:"DestinationCidrBlock" awsType:"awsstr" templateName:"cidr"`
+	IPv6CIDR *string `awsName:"DestinationIpv6CidrBlock" awsType:"awsstr" templateName:"ipv6-cidr"`
 }
 
 func (cmd *DeleteRoute) ParamsSpec() params.Spec {
 	return params.NewSpec(
-		params.AllOf(params.Key("cidr"), params.Key("table")),
-		params.Validators{"cidr": params.IsCIDR})
+		params.AllOf(params.OnlyOneOf(params.Key("cidr"), params.Key("ipv6-cidr")), params.Key("table")),
+		params.Validators{"cidr": params.IsCIDR, "ipv6-cidr": isIPv6CIDR})
 }
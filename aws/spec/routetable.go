@@ -16,8 +16,6 @@ limitations under the License.
 package awsspec
 
 import (
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/logger"
@@ -25,7 +23,7 @@ import (
 )
 
 type CreateRoutetable struct {
-	_      string `action:"create" entity:"routetable" awsAPI:"ec2" awsCall:"CreateRouteTable" awsInput:"ec2.CreateRouteTableInput" awsOutput:"ec2.CreateRouteTableOutput" awsDryRun:""`
+	_      string `action:"create" entity:"routetable" awsAPI:"ec2" awsCall:"CreateRouteTable" awsInput:"ec2.CreateRouteTableInput" awsOutput:"ec2.CreateRouteTableOutput" awsDryRun:"" awsOutputExtract:"RouteTable.RouteTableId"`
 	logger *logger.Logger
 	graph  cloud.GraphAPI
 	api    ec2iface.EC2API
@@ -36,10 +34,6 @@ func (cmd *CreateRoutetable) ParamsSpec() params.Spec {
 	return params.NewSpec(params.AllOf(params.Key("vpc")))
 }
 
-func (cmd *CreateRoutetable) ExtractResult(i interface{}) string {
-	return awssdk.StringValue(i.(*ec2.CreateRouteTableOutput).RouteTable.RouteTableId)
-}
-
 type DeleteRoutetable struct {
 	_      string `action:"delete" entity:"routetable" awsAPI:"ec2" awsCall:"DeleteRouteTable" awsInput:"ec2.DeleteRouteTableInput" awsOutput:"ec2.DeleteRouteTableOutput" awsDryRun:""`
 	logger *logger.Logger
@@ -53,7 +47,7 @@ func (cmd *DeleteRoutetable) ParamsSpec() params.Spec {
 }
 
 type AttachRoutetable struct {
-	_      string `action:"attach" entity:"routetable" awsAPI:"ec2" awsCall:"AssociateRouteTable" awsInput:"ec2.AssociateRouteTableInput" awsOutput:"ec2.AssociateRouteTableOutput" awsDryRun:""`
+	_      string `action:"attach" entity:"routetable" awsAPI:"ec2" awsCall:"AssociateRouteTable" awsInput:"ec2.AssociateRouteTableInput" awsOutput:"ec2.AssociateRouteTableOutput" awsDryRun:"" awsOutputExtract:"AssociationId"`
 	logger *logger.Logger
 	graph  cloud.GraphAPI
 	api    ec2iface.EC2API
@@ -65,10 +59,6 @@ func (cmd *AttachRoutetable) ParamsSpec() params.Spec {
 	return params.NewSpec(params.AllOf(params.Key("id"), params.Key("subnet")))
 }
 
-func (cmd *AttachRoutetable) ExtractResult(i interface{}) string {
-	return awssdk.StringValue(i.(*ec2.AssociateRouteTableOutput).AssociationId)
-}
-
 type DetachRoutetable struct {
 	_           string `action:"detach" entity:"routetable" awsAPI:"ec2" awsCall:"DisassociateRouteTable" awsInput:"ec2.DisassociateRouteTableInput" awsOutput:"ec2.DisassociateRouteTableOutput" awsDryRun:""`
 	logger      *logger.Logger
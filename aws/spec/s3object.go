@@ -39,7 +39,7 @@ type CreateS3object struct {
 	Bucket *string `awsName:"Bucket" awsType:"awsstr" templateName:"bucket"`
 	File   *string `awsName:"Body" awsType:"awsstr" templateName:"file"`
 	Name   *string `awsName:"Key" awsType:"awsstr" templateName:"name"`
-	Acl    *string `awsName:"ACL" awsType:"awsstr" templateName:"acl"`
+	Acl    *string `awsName:"ACL" awsType:"awsstr" templateName:"acl" enum:"private,public-read,public-read-write,aws-exec-read,authenticated-read,bucket-owner-read,bucket-owner-full-control,log-delivery-write"`
 }
 
 func (cmd *CreateS3object) ParamsSpec() params.Spec {
@@ -108,7 +108,7 @@ type UpdateS3object struct {
 	api     s3iface.S3API
 	Bucket  *string `awsName:"Bucket" awsType:"awsstr" templateName:"bucket"`
 	Name    *string `awsName:"Key" awsType:"awsstr" templateName:"name"`
-	Acl     *string `awsName:"ACL" awsType:"awsstr" templateName:"acl"`
+	Acl     *string `awsName:"ACL" awsType:"awsstr" templateName:"acl" enum:"private,public-read,public-read-write,aws-exec-read,authenticated-read,bucket-owner-read,bucket-owner-full-control,log-delivery-write"`
 	Version *string `awsName:"VersionId" awsType:"awsstr" templateName:"version"`
 }
 
@@ -17,6 +17,7 @@ package awsspec
 
 import (
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/wallix/awless/cloud"
@@ -41,7 +42,7 @@ type CreateScalinggroup struct {
 	Cooldown               *int64    `awsName:"DefaultCooldown" awsType:"awsint64" templateName:"cooldown"`
 	DesiredCapacity        *int64    `awsName:"DesiredCapacity" awsType:"awsint64" templateName:"desired-capacity"`
 	HealthcheckGracePeriod *int64    `awsName:"HealthCheckGracePeriod" awsType:"awsint64" templateName:"healthcheck-grace-period"`
-	HealthcheckType        *string   `awsName:"HealthCheckType" awsType:"awsstr" templateName:"healthcheck-type"`
+	HealthcheckType        *string   `awsName:"HealthCheckType" awsType:"awsstr" templateName:"healthcheck-type" enum:"EC2,ELB"`
 	NewInstancesProtected  *bool     `awsName:"NewInstancesProtectedFromScaleIn" awsType:"awsbool" templateName:"new-instances-protected"`
 	Targetgroups           []*string `awsName:"TargetGroupARNs" awsType:"awsstringslice" templateName:"targetgroups"`
 }
@@ -49,7 +50,7 @@ type CreateScalinggroup struct {
 func (cmd *CreateScalinggroup) ParamsSpec() params.Spec {
 	return params.NewSpec(params.AllOf(params.Key("launchconfiguration"), params.Key("max-size"), params.Key("min-size"), params.Key("name"), params.Key("subnets"),
 		params.Opt("cooldown", "desired-capacity", "healthcheck-grace-period", "healthcheck-type", "new-instances-protected", "targetgroups"),
-	))
+	), scalingGroupSizesValidators)
 }
 
 func (cmd *CreateScalinggroup) ExtractResult(i interface{}) string {
@@ -76,7 +77,7 @@ type UpdateScalinggroup struct {
 func (cmd *UpdateScalinggroup) ParamsSpec() params.Spec {
 	return params.NewSpec(params.AllOf(params.Key("name"),
 		params.Opt("cooldown", "desired-capacity", "healthcheck-grace-period", "healthcheck-type", "launchconfiguration", "max-size", "min-size", "new-instances-protected", "subnets"),
-	))
+	), scalingGroupSizesValidators)
 }
 
 type DeleteScalinggroup struct {
@@ -94,14 +95,40 @@ func (cmd *DeleteScalinggroup) ParamsSpec() params.Spec {
 	))
 }
 
+type AttachScalinggroup struct {
+	_           string `action:"attach" entity:"scalinggroup" awsAPI:"autoscaling" awsCall:"AttachLoadBalancerTargetGroups" awsInput:"autoscaling.AttachLoadBalancerTargetGroupsInput" awsOutput:"autoscaling.AttachLoadBalancerTargetGroupsOutput"`
+	logger      *logger.Logger
+	graph       cloud.GraphAPI
+	api         autoscalingiface.AutoScalingAPI
+	Name        *string `awsName:"AutoScalingGroupName" awsType:"awsstr" templateName:"name"`
+	Targetgroup *string `awsName:"TargetGroupARNs" awsType:"awsstringslice" templateName:"targetgroup"`
+}
+
+func (cmd *AttachScalinggroup) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name"), params.Key("targetgroup")))
+}
+
+type DetachScalinggroup struct {
+	_           string `action:"detach" entity:"scalinggroup" awsAPI:"autoscaling" awsCall:"DetachLoadBalancerTargetGroups" awsInput:"autoscaling.DetachLoadBalancerTargetGroupsInput" awsOutput:"autoscaling.DetachLoadBalancerTargetGroupsOutput"`
+	logger      *logger.Logger
+	graph       cloud.GraphAPI
+	api         autoscalingiface.AutoScalingAPI
+	Name        *string `awsName:"AutoScalingGroupName" awsType:"awsstr" templateName:"name"`
+	Targetgroup *string `awsName:"TargetGroupARNs" awsType:"awsstringslice" templateName:"targetgroup"`
+}
+
+func (cmd *DetachScalinggroup) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name"), params.Key("targetgroup")))
+}
+
 type CheckScalinggroup struct {
 	_       string `action:"check" entity:"scalinggroup" awsAPI:"autoscaling"`
 	logger  *logger.Logger
 	graph   cloud.GraphAPI
 	api     autoscalingiface.AutoScalingAPI
 	Name    *string `templateName:"name"`
-	Count   *int64  `templateName:"count"`
-	Timeout *int64  `templateName:"timeout"`
+	Count   *int64  `templateName:"count" enum:"0"`
+	Timeout *int64  `templateName:"timeout" enum:"10,60,180,300,600,900"`
 }
 
 func (cmd *CheckScalinggroup) ParamsSpec() params.Spec {
@@ -137,3 +164,37 @@ func (sg *CheckScalinggroup) ManualRun(renv env.Running) (interface{}, error) {
 	}
 	return nil, c.check()
 }
+
+// Sizes are only compared when known at validation time (i.e. not given as holes or references),
+// each one of the relations min-size <= desired-capacity <= max-size being checked once
+var scalingGroupSizesValidators = params.Validators{
+	"max-size": func(i interface{}, others map[string]interface{}) error {
+		max, isMax := scalingGroupSize(i)
+		min, isMin := scalingGroupSize(others["min-size"])
+		if isMax && isMin && min > max {
+			return fmt.Errorf("max-size %d is lower than min-size %d", max, min)
+		}
+		return nil
+	},
+	"desired-capacity": func(i interface{}, others map[string]interface{}) error {
+		desired, ok := scalingGroupSize(i)
+		if !ok {
+			return nil
+		}
+		if min, ok := scalingGroupSize(others["min-size"]); ok && desired < min {
+			return fmt.Errorf("desired-capacity %d is lower than min-size %d", desired, min)
+		}
+		if max, ok := scalingGroupSize(others["max-size"]); ok && desired > max {
+			return fmt.Errorf("desired-capacity %d is greater than max-size %d", desired, max)
+		}
+		return nil
+	},
+}
+
+func scalingGroupSize(i interface{}) (int, bool) {
+	if i == nil {
+		return 0, false
+	}
+	size, err := strconv.Atoi(fmt.Sprint(i))
+	return size, err == nil
+}
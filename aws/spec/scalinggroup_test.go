@@ -0,0 +1,32 @@
+package awsspec
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/template/params"
+)
+
+func TestScalingGroupSizesValidation(t *testing.T) {
+	tcases := []struct {
+		values map[string]interface{}
+		expErr string
+	}{
+		{values: map[string]interface{}{"min-size": 1, "max-size": 4, "desired-capacity": 2}},
+		{values: map[string]interface{}{"min-size": 2, "max-size": 2, "desired-capacity": 2}},
+		{values: map[string]interface{}{"desired-capacity": 6}},
+		{values: map[string]interface{}{"min-size": 1, "max-size": "{max}", "desired-capacity": 8}},
+		{values: map[string]interface{}{"min-size": 5, "max-size": 4}, expErr: "max-size 4 is lower than min-size 5"},
+		{values: map[string]interface{}{"min-size": 2, "max-size": 4, "desired-capacity": 1}, expErr: "desired-capacity 1 is lower than min-size 2"},
+		{values: map[string]interface{}{"max-size": 4, "desired-capacity": 6}, expErr: "desired-capacity 6 is greater than max-size 4"},
+	}
+	for i, tcase := range tcases {
+		err := params.Validate(scalingGroupSizesValidators, tcase.values)
+		switch {
+		case tcase.expErr == "" && err != nil:
+			t.Fatalf("%d: unexpected error: %s", i+1, err)
+		case tcase.expErr != "" && (err == nil || !strings.Contains(err.Error(), tcase.expErr)):
+			t.Fatalf("%d: got %v, want error containing %q", i+1, err, tcase.expErr)
+		}
+	}
+}
@@ -16,8 +16,6 @@ limitations under the License.
 package awsspec
 
 import (
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/logger"
@@ -25,11 +23,11 @@ import (
 )
 
 type CreateScalingpolicy struct {
-	_                   string `action:"create" entity:"scalingpolicy" awsAPI:"autoscaling" awsCall:"PutScalingPolicy" awsInput:"autoscaling.PutScalingPolicyInput" awsOutput:"autoscaling.PutScalingPolicyOutput"`
+	_                   string `action:"create" entity:"scalingpolicy" awsAPI:"autoscaling" awsCall:"PutScalingPolicy" awsInput:"autoscaling.PutScalingPolicyInput" awsOutput:"autoscaling.PutScalingPolicyOutput" awsOutputExtract:"PolicyARN"`
 	logger              *logger.Logger
 	graph               cloud.GraphAPI
 	api                 autoscalingiface.AutoScalingAPI
-	AdjustmentType      *string `awsName:"AdjustmentType" awsType:"awsstr" templateName:"adjustment-type"`
+	AdjustmentType      *string `awsName:"AdjustmentType" awsType:"awsstr" templateName:"adjustment-type" enum:"ChangeInCapacity,ExactCapacity,PercentChangeInCapacity"`
 	Scalinggroup        *string `awsName:"AutoScalingGroupName" awsType:"awsstr" templateName:"scalinggroup"`
 	Name                *string `awsName:"PolicyName" awsType:"awsstr" templateName:"name"`
 	AdjustmentScaling   *int64  `awsName:"ScalingAdjustment" awsType:"awsint64" templateName:"adjustment-scaling"`
@@ -43,10 +41,6 @@ func (cmd *CreateScalingpolicy) ParamsSpec() params.Spec {
 	))
 }
 
-func (cmd *CreateScalingpolicy) ExtractResult(i interface{}) string {
-	return awssdk.StringValue(i.(*autoscaling.PutScalingPolicyOutput).PolicyARN)
-}
-
 type DeleteScalingpolicy struct {
 	_      string `action:"delete" entity:"scalingpolicy" awsAPI:"autoscaling" awsCall:"DeletePolicy" awsInput:"autoscaling.DeletePolicyInput" awsOutput:"autoscaling.DeletePolicyOutput"`
 	logger *logger.Logger
@@ -0,0 +1,96 @@
+package awsspec
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/wallix/awless/aws/doc"
+	"github.com/wallix/awless/template/params"
+)
+
+// Schema describes all the template commands, for use by editors to complete and validate templates
+type Schema struct {
+	Actions  []string        `json:"actions"`
+	Entities []string        `json:"entities"`
+	Commands []CommandSchema `json:"commands"`
+}
+
+type CommandSchema struct {
+	Action      string                 `json:"action"`
+	Entity      string                 `json:"entity"`
+	API         string                 `json:"api"`
+	Description string                 `json:"description"`
+	Rule        string                 `json:"rule"`
+	Required    []string               `json:"required"`
+	Optional    []string               `json:"optional"`
+	Suggested   []string               `json:"suggested,omitempty"`
+	Params      map[string]ParamSchema `json:"params"`
+}
+
+type ParamSchema struct {
+	Description  string   `json:"description,omitempty"`
+	Enum         []string `json:"enum,omitempty"`
+	ResourceType string   `json:"resourceType,omitempty"`
+}
+
+// TemplatesSchema returns the schema of all the template commands, sorted by action and entity
+func TemplatesSchema() *Schema {
+	schema := &Schema{}
+	actions, entities := make(map[string]bool), make(map[string]bool)
+
+	for _, def := range AWSTemplatesDefinitions() {
+		actions[def.Action] = true
+		entities[def.Entity] = true
+
+		required, optional, suggested := params.List(def.Params)
+		cmd := CommandSchema{
+			Action:      def.Action,
+			Entity:      def.Entity,
+			API:         def.Api,
+			Description: awsdoc.AwlessCommandDefinitionsDoc(def.Action, def.Entity, fmt.Sprintf("%s a %s %s", strings.Title(def.Action), strings.ToUpper(def.Api), def.Entity)),
+			Rule:        def.Params.String(),
+			Required:    nonNil(required),
+			Optional:    nonNil(optional),
+			Suggested:   suggested,
+			Params:      make(map[string]ParamSchema),
+		}
+		for _, p := range append(required, optional...) {
+			var param ParamSchema
+			param.Description, _ = awsdoc.TemplateParamsDoc(def.Action, def.Entity, p)
+			key := strings.Join([]string{def.Action, def.Entity, p}, ".")
+			if enum, ok := awsdoc.EnumDoc[key]; ok && len(enum) > 0 && strings.TrimSpace(enum[0]) != "" {
+				param.Enum = enum
+			}
+			if typ, ok := awsdoc.ParamTypeDoc[key]; ok {
+				param.ResourceType = typ.ResourceType
+			}
+			cmd.Params[p] = param
+		}
+		schema.Commands = append(schema.Commands, cmd)
+	}
+
+	schema.Actions, schema.Entities = sortedKeys(actions), sortedKeys(entities)
+	sort.Slice(schema.Commands, func(i, j int) bool {
+		if schema.Commands[i].Action == schema.Commands[j].Action {
+			return schema.Commands[i].Entity < schema.Commands[j].Entity
+		}
+		return schema.Commands[i].Action < schema.Commands[j].Action
+	})
+	return schema
+}
+
+func sortedKeys(m map[string]bool) (keys []string) {
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return
+}
+
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
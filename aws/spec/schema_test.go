@@ -0,0 +1,54 @@
+package awsspec
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTemplatesSchema(t *testing.T) {
+	schema := TemplatesSchema()
+	if got, want := len(schema.Commands), len(AWSTemplatesDefinitions()); got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+
+	var createInstance *CommandSchema
+	for i, cmd := range schema.Commands {
+		if i > 0 {
+			prev := schema.Commands[i-1]
+			if prev.Action > cmd.Action || (prev.Action == cmd.Action && prev.Entity > cmd.Entity) {
+				t.Fatalf("commands not sorted: %s %s before %s %s", prev.Action, prev.Entity, cmd.Action, cmd.Entity)
+			}
+		}
+		if cmd.Action == "create" && cmd.Entity == "instance" {
+			createInstance = &schema.Commands[i]
+		}
+	}
+	if createInstance == nil {
+		t.Fatal("missing create instance in schema")
+	}
+	if got, want := createInstance.API, "ec2"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	for _, p := range []string{"name", "subnet"} {
+		if _, ok := createInstance.Params[p]; !ok {
+			t.Fatalf("missing param %s in %v", p, createInstance.Params)
+		}
+	}
+	if got, want := createInstance.Params["type"].Enum, []string{"t2.nano", "t2.micro", "t2.small", "t2.medium", "t2.large", "t2.xlarge", "t2.2xlarge", "m4.large", "m4.xlarge", "c4.large", "c4.xlarge"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := createInstance.Params["role"].ResourceType, "role"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if createInstance.Params["name"].Description == "" {
+		t.Fatal("expected param description")
+	}
+
+	var hasCreate bool
+	for _, a := range schema.Actions {
+		hasCreate = hasCreate || a == "create"
+	}
+	if !hasCreate {
+		t.Fatalf("missing create in actions %v", schema.Actions)
+	}
+}
@@ -26,7 +26,6 @@ import (
 	"github.com/wallix/awless/template/env"
 	"github.com/wallix/awless/template/params"
 
-	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
@@ -34,7 +33,7 @@ import (
 )
 
 type CreateSecuritygroup struct {
-	_           string `action:"create" entity:"securitygroup" awsAPI:"ec2" awsCall:"CreateSecurityGroup" awsInput:"ec2.CreateSecurityGroupInput" awsOutput:"ec2.CreateSecurityGroupOutput" awsDryRun:""`
+	_           string `action:"create" entity:"securitygroup" awsAPI:"ec2" awsCall:"CreateSecurityGroup" awsInput:"ec2.CreateSecurityGroupInput" awsOutput:"ec2.CreateSecurityGroupOutput" awsDryRun:"" awsOutputExtract:"GroupId"`
 	logger      *logger.Logger
 	graph       cloud.GraphAPI
 	api         ec2iface.EC2API
@@ -47,22 +46,18 @@ func (cmd *CreateSecuritygroup) ParamsSpec() params.Spec {
 	return params.NewSpec(params.AllOf(params.Key("description"), params.Key("name"), params.Key("vpc")))
 }
 
-func (cmd *CreateSecuritygroup) ExtractResult(i interface{}) string {
-	return awssdk.StringValue(i.(*ec2.CreateSecurityGroupOutput).GroupId)
-}
-
 type UpdateSecuritygroup struct {
 	_             string `action:"update" entity:"securitygroup" awsAPI:"ec2" awsDryRun:"manual"`
 	logger        *logger.Logger
 	graph         cloud.GraphAPI
 	api           ec2iface.EC2API
 	Id            *string `templateName:"id"`
-	Protocol      *string `templateName:"protocol"`
-	CIDR          *string `templateName:"cidr"`
+	Protocol      *string `templateName:"protocol" enum:"tcp,udp,icmp,any"`
+	CIDR          *string `templateName:"cidr" ref:"subnet.CIDR"`
 	Securitygroup *string `templateName:"securitygroup"`
-	Inbound       *string `templateName:"inbound"`
-	Outbound      *string `templateName:"outbound"`
-	Portrange     *string `templateName:"portrange"`
+	Inbound       *string `templateName:"inbound" enum:"revoke,authorize"`
+	Outbound      *string `templateName:"outbound" enum:"revoke,authorize"`
+	Portrange     *string `templateName:"portrange" enum:""`
 }
 
 func (cmd *UpdateSecuritygroup) ParamsSpec() params.Spec {
@@ -210,8 +205,8 @@ type CheckSecuritygroup struct {
 	graph   cloud.GraphAPI
 	api     ec2iface.EC2API
 	Id      *string `templateName:"id"`
-	State   *string `templateName:"state"`
-	Timeout *int64  `templateName:"timeout"`
+	State   *string `templateName:"state" enum:"unused"`
+	Timeout *int64  `templateName:"timeout" enum:"10,60,180,300,600,900"`
 }
 
 func (cmd *CheckSecuritygroup) ParamsSpec() params.Spec {
@@ -16,8 +16,6 @@ limitations under the License.
 package awsspec
 
 import (
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/logger"
@@ -25,7 +23,7 @@ import (
 )
 
 type CreateSnapshot struct {
-	_           string `action:"create" entity:"snapshot" awsAPI:"ec2" awsCall:"CreateSnapshot" awsInput:"ec2.CreateSnapshotInput" awsOutput:"ec2.Snapshot" awsDryRun:""`
+	_           string `action:"create" entity:"snapshot" awsAPI:"ec2" awsCall:"CreateSnapshot" awsInput:"ec2.CreateSnapshotInput" awsOutput:"ec2.Snapshot" awsDryRun:"" awsOutputExtract:"SnapshotId"`
 	logger      *logger.Logger
 	graph       cloud.GraphAPI
 	api         ec2iface.EC2API
@@ -39,10 +37,6 @@ func (cmd *CreateSnapshot) ParamsSpec() params.Spec {
 	))
 }
 
-func (cmd *CreateSnapshot) ExtractResult(i interface{}) string {
-	return awssdk.StringValue(i.(*ec2.Snapshot).SnapshotId)
-}
-
 type DeleteSnapshot struct {
 	_      string `action:"delete" entity:"snapshot" awsAPI:"ec2" awsCall:"DeleteSnapshot" awsInput:"ec2.DeleteSnapshotInput" awsOutput:"ec2.DeleteSnapshotOutput" awsDryRun:""`
 	logger *logger.Logger
@@ -56,7 +50,7 @@ func (cmd *DeleteSnapshot) ParamsSpec() params.Spec {
 }
 
 type CopySnapshot struct {
-	_            string `action:"copy" entity:"snapshot" awsAPI:"ec2" awsCall:"CopySnapshot" awsInput:"ec2.CopySnapshotInput" awsOutput:"ec2.CopySnapshotOutput" awsDryRun:""`
+	_            string `action:"copy" entity:"snapshot" awsAPI:"ec2" awsCall:"CopySnapshot" awsInput:"ec2.CopySnapshotInput" awsOutput:"ec2.CopySnapshotOutput" awsDryRun:"" awsOutputExtract:"SnapshotId"`
 	logger       *logger.Logger
 	graph        cloud.GraphAPI
 	api          ec2iface.EC2API
@@ -69,9 +63,5 @@ type CopySnapshot struct {
 func (cmd *CopySnapshot) ParamsSpec() params.Spec {
 	return params.NewSpec(params.AllOf(params.Key("source-id"), params.Key("source-region"),
 		params.Opt("description", "encrypted"),
-	))
-}
-
-func (cmd *CopySnapshot) ExtractResult(i interface{}) string {
-	return awssdk.StringValue(i.(*ec2.CopySnapshotOutput).SnapshotId)
+	), params.Validators{"source-region": isRegion})
 }
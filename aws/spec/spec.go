@@ -4,11 +4,21 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"net"
 	"reflect"
+	"regexp"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/wallix/awless/aws/config"
+	"github.com/wallix/awless/cloud/match"
+	"github.com/wallix/awless/cloud/properties"
 	"github.com/wallix/awless/template/env"
 	"github.com/wallix/awless/template/params"
 
@@ -22,6 +32,33 @@ const (
 	notFound        = "NotFound"
 )
 
+// MockAWSSessionFactory builds commands that cannot call AWS (ex: to get their params spec).
+// Its session is only created on first use to keep the CLI startup fast.
+var MockAWSSessionFactory Factory = new(offlineFactory)
+
+var errOfflineSession = errors.New("offline session: no AWS API call allowed")
+
+type offlineFactory struct {
+	once    sync.Once
+	factory *AWSFactory
+}
+
+func (f *offlineFactory) Build(key string) func() interface{} {
+	f.once.Do(func() {
+		sess := session.Must(session.NewSession(&aws.Config{
+			Region:      aws.String("us-east-1"),
+			Credentials: credentials.AnonymousCredentials,
+		}))
+		// no usable handlers: any API call fails without reaching the network
+		sess.Handlers.Clear()
+		sess.Handlers.Send.PushBack(func(r *request.Request) {
+			r.Error = errOfflineSession
+		})
+		f.factory = &AWSFactory{Log: logger.DiscardLogger, Sess: sess}
+	})
+	return f.factory.Build(key)
+}
+
 type BeforeRunner interface {
 	BeforeRun(env.Running) error
 }
@@ -40,6 +77,41 @@ type command interface {
 	Run(env.Running, map[string]interface{}) (interface{}, error)
 }
 
+// APICall returns the AWS API operation run by the command (ex: 'ec2.RunInstances') following the awsAPI and awsCall tags
+// of its definition, with the fields of its input (ex: 'ImageId') set from the params following the awsName tags.
+// It returns false for the commands without awsCall (i.e. with a manual run).
+func APICall(cmd interface{}, params map[string]interface{}) (string, map[string]interface{}, bool) {
+	val := reflect.Indirect(reflect.ValueOf(cmd))
+	if val.Kind() != reflect.Struct {
+		return "", nil, false
+	}
+	stru := val.Type()
+	var call string
+	input := make(map[string]interface{})
+	for i := 0; i < stru.NumField(); i++ {
+		field := stru.Field(i)
+		if field.Name == "_" {
+			if name, ok := field.Tag.Lookup("awsCall"); ok {
+				call = field.Tag.Get("awsAPI") + "." + name
+			}
+			continue
+		}
+		awsNames, ok := field.Tag.Lookup("awsName")
+		if !ok {
+			continue
+		}
+		if v, ok := params[field.Tag.Get("templateName")]; ok {
+			for _, awsName := range strings.Split(awsNames, ",") {
+				input[strings.TrimSpace(awsName)] = v
+			}
+		}
+	}
+	if call == "" {
+		return "", nil, false
+	}
+	return call, input, true
+}
+
 func implementsBeforeRun(i interface{}) (BeforeRunner, bool) {
 	v, ok := i.(BeforeRunner)
 	return v, ok
@@ -50,6 +122,12 @@ func implementsAfterRun(i interface{}) (AfterRunner, bool) {
 	return v, ok
 }
 
+// validateParams runs the validators declared in the command params spec,
+// so that the same checks apply on compilation, dry run and run
+func validateParams(cmd command, values map[string]interface{}) error {
+	return params.Validate(cmd.ParamsSpec().Validators(), values)
+}
+
 func implementsResultExtractor(i interface{}) (ResultExtractor, bool) {
 	v, ok := i.(ResultExtractor)
 	return v, ok
@@ -107,7 +185,7 @@ func (dc *awsCall) execute(input interface{}) (output interface{}, err error) {
 	results := fnVal.Call(values)
 
 	if err, ok := results[1].Interface().(error); ok && err != nil {
-		return nil, fmt.Errorf("%s", err)
+		return nil, decorateAWSError(err, dc.fnName)
 	}
 
 	dc.logger.ExtraVerbosef("%s call took %s", dc.fnName, time.Since(start))
@@ -217,9 +295,96 @@ func BoolValue(v *bool) bool {
 	return false
 }
 
-func decorateAWSError(err error) error {
-	if aerr, ok := err.(awserr.Error); ok {
-		return fmt.Errorf("%s: %s", aerr.Code(), aerr.Message())
+// isRegion validates a region against the regions known by the AWS SDK
+func isRegion(i interface{}, others map[string]interface{}) error {
+	region := fmt.Sprint(i)
+	for _, r := range awsconfig.AllRegions() {
+		if r == region {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown region '%s' (see `awless list regions`)", region)
+}
+
+var availabilityZoneSuffix = regexp.MustCompile(`^(-[a-z0-9]+)*[a-z]$`)
+
+// isAvailabilityZone validates an availability zone against the ones synced locally
+// (see `awless list availabilityzones`), or only against the known regions when none has been synced
+func isAvailabilityZone(g cloud.GraphAPI) func(interface{}, map[string]interface{}) error {
+	return func(i interface{}, others map[string]interface{}) error {
+		zone := fmt.Sprint(i)
+		if g != nil {
+			if zones, err := g.Find(cloud.NewQuery(cloud.AvailabilityZone)); err == nil && len(zones) > 0 {
+				var names []string
+				for _, z := range zones {
+					if name, ok := z.Properties()[properties.Name].(string); ok {
+						if name == zone {
+							return nil
+						}
+						names = append(names, name)
+					}
+				}
+				sort.Strings(names)
+				return fmt.Errorf("unknown availability zone '%s', expected one of: %s", zone, strings.Join(names, ", "))
+			}
+		}
+		for _, r := range awsconfig.AllRegions() {
+			if strings.HasPrefix(zone, r) && availabilityZoneSuffix.MatchString(zone[len(r):]) {
+				return nil
+			}
+		}
+		return fmt.Errorf("invalid availability zone '%s' (see `awless list availabilityzones`)", zone)
+	}
+}
+
+func isIPv6CIDR(i interface{}, others map[string]interface{}) error {
+	if err := params.IsCIDR(i, others); err != nil {
+		return err
+	}
+	if ip, _, _ := net.ParseCIDR(fmt.Sprint(i)); ip.To4() != nil {
+		return fmt.Errorf("expected an IPv6 CIDR, got %v", i)
+	}
+	return nil
+}
+
+// isCIDRNotOverlapping validates a CIDR and checks it does not overlap the CIDR of a locally synced resource
+// of the given type. For subnets, only the ones of the same VPC (i.e. 'vpc' param) are considered
+func isCIDRNotOverlapping(g cloud.GraphAPI, resType string) func(interface{}, map[string]interface{}) error {
+	return func(i interface{}, others map[string]interface{}) error {
+		if err := params.IsCIDR(i, others); err != nil {
+			return err
+		}
+		if g == nil {
+			return nil
+		}
+		_, cidr, _ := net.ParseCIDR(fmt.Sprint(i))
+
+		q := cloud.NewQuery(resType)
+		if resType == cloud.Subnet {
+			vpc, ok := others["vpc"].(string)
+			if !ok {
+				return nil
+			}
+			q = q.Match(match.Property(properties.Vpc, vpc))
+		}
+		resources, err := g.Find(q)
+		if err != nil {
+			return nil
+		}
+		for _, r := range resources {
+			existing, _ := r.Properties()[properties.CIDR].(string)
+			_, n, err := net.ParseCIDR(existing)
+			if err != nil {
+				continue
+			}
+			if n.Contains(cidr.IP) || cidr.Contains(n.IP) {
+				name := r.Id()
+				if rName, ok := r.Properties()[properties.Name].(string); ok && rName != "" {
+					name = fmt.Sprintf("%s (%s)", rName, r.Id())
+				}
+				return fmt.Errorf("%s overlaps with %s of existing %s %s (see `awless plan cidr`)", cidr, existing, resType, name)
+			}
+		}
+		return nil
 	}
-	return err
 }
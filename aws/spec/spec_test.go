@@ -3,8 +3,23 @@ package awsspec
 import (
 	"strings"
 	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
 )
 
+func TestOfflineFactory(t *testing.T) {
+	cmd, ok := MockAWSSessionFactory.Build("createvpc")().(*CreateVpc)
+	if !ok {
+		t.Fatal("expected create vpc command")
+	}
+	if _, err := cmd.api.DescribeVpcs(&ec2.DescribeVpcsInput{}); err != errOfflineSession {
+		t.Fatalf("got %v, want %v", err, errOfflineSession)
+	}
+}
+
 func TestEnumValidator(t *testing.T) {
 	tcases := []struct {
 		validator      *enumValidator
@@ -36,3 +51,95 @@ func TestEnumValidator(t *testing.T) {
 		}
 	}
 }
+
+func TestRegionAndAvailabilityZoneValidators(t *testing.T) {
+	if err := isRegion("eu-west-1", nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := isRegion("eu-west-12", nil); err == nil || !strings.Contains(err.Error(), "unknown region 'eu-west-12'") {
+		t.Fatalf("got %v", err)
+	}
+
+	tcases := []struct {
+		g      cloud.GraphAPI
+		zone   string
+		expErr string
+	}{
+		{nil, "eu-west-1a", ""},
+		{nil, "us-gov-west-1b", ""},
+		{nil, "eu-west-1", "invalid availability zone 'eu-west-1'"},
+		{nil, "mars-north-1a", "invalid availability zone 'mars-north-1a'"},
+		{graph.NewGraph(), "eu-west-1c", ""},
+		{newZonesGraph("eu-west-1a", "eu-west-1b"), "eu-west-1b", ""},
+		{newZonesGraph("eu-west-1a", "eu-west-1b"), "eu-west-1c", "unknown availability zone 'eu-west-1c', expected one of: eu-west-1a, eu-west-1b"},
+	}
+	for i, tcase := range tcases {
+		err := isAvailabilityZone(tcase.g)(tcase.zone, nil)
+		if tcase.expErr == "" {
+			if err != nil {
+				t.Fatalf("%d: %s", i+1, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tcase.expErr) {
+			t.Fatalf("%d: got %v, want %s", i+1, err, tcase.expErr)
+		}
+	}
+}
+
+func newZonesGraph(names ...string) cloud.GraphAPI {
+	g := graph.NewGraph()
+	for _, name := range names {
+		res := graph.InitResource(cloud.AvailabilityZone, name)
+		res.Properties()[properties.Name] = name
+		g.AddResource(res)
+	}
+	return g
+}
+
+func TestCIDROverlapValidation(t *testing.T) {
+	g := graph.NewGraph()
+	for _, r := range []struct{ typ, id, cidr, vpc, name string }{
+		{cloud.Vpc, "vpc-1", "10.0.0.0/16", "", "main"},
+		{cloud.Subnet, "sub-1", "10.0.1.0/24", "vpc-1", ""},
+		{cloud.Subnet, "sub-2", "10.1.0.0/24", "vpc-2", ""},
+	} {
+		res := graph.InitResource(r.typ, r.id)
+		res.Properties()[properties.CIDR] = r.cidr
+		if r.vpc != "" {
+			res.Properties()[properties.Vpc] = r.vpc
+		}
+		if r.name != "" {
+			res.Properties()[properties.Name] = r.name
+		}
+		g.AddResource(res)
+	}
+
+	tcases := []struct {
+		cmd    command
+		params map[string]interface{}
+		expErr string
+	}{
+		{NewCreateSubnet(nil, g), map[string]interface{}{"cidr": "10.0.2.0/24", "vpc": "vpc-1"}, ""},
+		{NewCreateSubnet(nil, g), map[string]interface{}{"cidr": "10.0.1.128/25", "vpc": "vpc-1"}, "10.0.1.128/25 overlaps with 10.0.1.0/24 of existing subnet sub-1"},
+		{NewCreateSubnet(nil, g), map[string]interface{}{"cidr": "10.0.0.0/20", "vpc": "vpc-1"}, "10.0.0.0/20 overlaps with 10.0.1.0/24 of existing subnet sub-1"},
+		{NewCreateSubnet(nil, g), map[string]interface{}{"cidr": "10.0.1.0/24", "vpc": "vpc-3"}, ""},
+		{NewCreateSubnet(nil, g), map[string]interface{}{"cidr": "10.0.1.0/24"}, ""},
+		{NewCreateSubnet(nil, g), map[string]interface{}{"cidr": "10.0.1.0", "vpc": "vpc-1"}, "invalid CIDR"},
+		{NewCreateVpc(nil, g), map[string]interface{}{"cidr": "10.1.0.0/16"}, ""},
+		{NewCreateVpc(nil, g), map[string]interface{}{"cidr": "10.0.0.0/8"}, "10.0.0.0/8 overlaps with 10.0.0.0/16 of existing vpc main (vpc-1)"},
+		{NewCreateVpc(nil, nil), map[string]interface{}{"cidr": "10.0.0.0/8"}, ""},
+	}
+	for i, tcase := range tcases {
+		err := validateParams(tcase.cmd, tcase.params)
+		if tcase.expErr == "" {
+			if err != nil {
+				t.Fatalf("%d: %s", i+1, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tcase.expErr) {
+			t.Fatalf("%d: got %v, want %s", i+1, err, tcase.expErr)
+		}
+	}
+}
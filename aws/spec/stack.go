@@ -27,23 +27,22 @@ import (
 	"github.com/wallix/awless/template/env"
 	"github.com/wallix/awless/template/params"
 
-	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
 	"github.com/wallix/awless/logger"
 	"gopkg.in/yaml.v2"
 )
 
 type CreateStack struct {
-	_                     string `action:"create" entity:"stack" awsAPI:"cloudformation" awsCall:"CreateStack" awsInput:"cloudformation.CreateStackInput" awsOutput:"cloudformation.CreateStackOutput"`
+	_                     string `action:"create" entity:"stack" awsAPI:"cloudformation" awsCall:"CreateStack" awsInput:"cloudformation.CreateStackInput" awsOutput:"cloudformation.CreateStackOutput" awsOutputExtract:"StackId"`
 	logger                *logger.Logger
 	graph                 cloud.GraphAPI
 	api                   cloudformationiface.CloudFormationAPI
 	Name                  *string   `awsName:"StackName" awsType:"awsstr" templateName:"name"`
 	TemplateFile          *string   `awsName:"TemplateBody" awsType:"awsfiletostring" templateName:"template-file"`
-	Capabilities          []*string `awsName:"Capabilities" awsType:"awsstringslice" templateName:"capabilities"`
+	Capabilities          []*string `awsName:"Capabilities" awsType:"awsstringslice" templateName:"capabilities" enum:"CAPABILITY_IAM,CAPABILITY_NAMED_IAM"`
 	DisableRollback       *bool     `awsName:"DisableRollback" awsType:"awsbool" templateName:"disable-rollback"`
 	Notifications         []*string `awsName:"NotificationARNs" awsType:"awsstringslice" templateName:"notifications"`
-	OnFailure             *string   `awsName:"OnFailure" awsType:"awsstr" templateName:"on-failure"`
+	OnFailure             *string   `awsName:"OnFailure" awsType:"awsstr" templateName:"on-failure" enum:"DO_NOTHING,ROLLBACK,DELETE"`
 	Parameters            []*string `awsName:"Parameters" awsType:"awsparameterslice" templateName:"parameters"`
 	ResourceTypes         []*string `awsName:"ResourceTypes" awsType:"awsstringslice" templateName:"resource-types"`
 	Role                  *string   `awsName:"RoleARN" awsType:"awsstr" templateName:"role"`
@@ -63,10 +62,6 @@ func (cmd *CreateStack) ParamsSpec() params.Spec {
 	)
 }
 
-func (cmd *CreateStack) ExtractResult(i interface{}) string {
-	return StringValue(i.(*cloudformation.CreateStackOutput).StackId)
-}
-
 // Add StackFile support via BeforeRun hook
 // https://github.com/wallix/awless/issues/145
 // http://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/continuous-delivery-codepipeline-cfn-artifacts.html
@@ -76,12 +71,12 @@ func (cmd *CreateStack) BeforeRun(renv env.Running) (err error) {
 }
 
 type UpdateStack struct {
-	_                     string `action:"update" entity:"stack" awsAPI:"cloudformation" awsCall:"UpdateStack" awsInput:"cloudformation.UpdateStackInput" awsOutput:"cloudformation.UpdateStackOutput"`
+	_                     string `action:"update" entity:"stack" awsAPI:"cloudformation" awsCall:"UpdateStack" awsInput:"cloudformation.UpdateStackInput" awsOutput:"cloudformation.UpdateStackOutput" awsOutputExtract:"StackId"`
 	logger                *logger.Logger
 	graph                 cloud.GraphAPI
 	api                   cloudformationiface.CloudFormationAPI
 	Name                  *string   `awsName:"StackName" awsType:"awsstr" templateName:"name"`
-	Capabilities          []*string `awsName:"Capabilities" awsType:"awsstringslice" templateName:"capabilities"`
+	Capabilities          []*string `awsName:"Capabilities" awsType:"awsstringslice" templateName:"capabilities" enum:"CAPABILITY_IAM,CAPABILITY_NAMED_IAM"`
 	Notifications         []*string `awsName:"NotificationARNs" awsType:"awsstringslice" templateName:"notifications"`
 	Parameters            []*string `awsName:"Parameters" awsType:"awsparameterslice" templateName:"parameters"`
 	ResourceTypes         []*string `awsName:"ResourceTypes" awsType:"awsstringslice" templateName:"resource-types"`
@@ -103,10 +98,6 @@ func (cmd *UpdateStack) ParamsSpec() params.Spec {
 	))
 }
 
-func (cmd *UpdateStack) ExtractResult(i interface{}) string {
-	return StringValue(i.(*cloudformation.UpdateStackOutput).StackId)
-}
-
 // Add StackFile support via BeforeRun hook
 // https://github.com/wallix/awless/issues/145
 // http://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/continuous-delivery-codepipeline-cfn-artifacts.html
@@ -16,6 +16,8 @@ limitations under the License.
 package awsspec
 
 import (
+	"time"
+
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
@@ -26,25 +28,22 @@ import (
 )
 
 type CreateSubnet struct {
-	_                string `action:"create" entity:"subnet" awsAPI:"ec2" awsCall:"CreateSubnet" awsInput:"ec2.CreateSubnetInput" awsOutput:"ec2.CreateSubnetOutput" awsDryRun:""`
+	_                string `action:"create" entity:"subnet" awsAPI:"ec2" awsCall:"CreateSubnet" awsInput:"ec2.CreateSubnetInput" awsOutput:"ec2.CreateSubnetOutput" awsDryRun:"" awsOutputExtract:"Subnet.SubnetId"`
 	logger           *logger.Logger
 	graph            cloud.GraphAPI
 	api              ec2iface.EC2API
 	CIDR             *string `awsName:"CidrBlock" awsType:"awsstr" templateName:"cidr"`
 	VPC              *string `awsName:"VpcId" awsType:"awsstr" templateName:"vpc"`
 	AvailabilityZone *string `awsName:"AvailabilityZone" awsType:"awsstr" templateName:"availabilityzone"`
-	Public           *bool   `awsType:"awsboolattribute" templateName:"public"`
+	IPv6CIDR         *string `awsName:"Ipv6CidrBlock" awsType:"awsstr" templateName:"ipv6-cidr"`
+	Public           *bool   `awsType:"awsboolattribute" templateName:"public" enum:"true,false"`
 	Name             *string `templateName:"name"`
 }
 
 func (cmd *CreateSubnet) ParamsSpec() params.Spec {
 	return params.NewSpec(
-		params.AllOf(params.Key("cidr"), params.Key("vpc"), params.Opt(params.Suggested("name"), "availabilityzone", "public")),
-		params.Validators{"cidr": params.IsCIDR})
-}
-
-func (cmd *CreateSubnet) ExtractResult(i interface{}) string {
-	return awssdk.StringValue(i.(*ec2.CreateSubnetOutput).Subnet.SubnetId)
+		params.AllOf(params.Key("cidr"), params.Key("vpc"), params.Opt(params.Suggested("name"), "availabilityzone", "ipv6-cidr", "public")),
+		params.Validators{"cidr": isCIDRNotOverlapping(cmd.graph, cloud.Subnet), "availabilityzone": isAvailabilityZone(cmd.graph), "ipv6-cidr": isIPv6CIDR})
 }
 
 func (cmd *CreateSubnet) AfterRun(renv env.Running, output interface{}) error {
@@ -66,16 +65,50 @@ func (cmd *CreateSubnet) AfterRun(renv env.Running, output interface{}) error {
 }
 
 type UpdateSubnet struct {
-	_      string `action:"update" entity:"subnet" awsAPI:"ec2" awsCall:"ModifySubnetAttribute" awsInput:"ec2.ModifySubnetAttributeInput" awsOutput:"ec2.ModifySubnetAttributeOutput"`
-	logger *logger.Logger
-	graph  cloud.GraphAPI
-	api    ec2iface.EC2API
-	Id     *string `awsName:"SubnetId" awsType:"awsstr" templateName:"id"`
-	Public *bool   `awsName:"MapPublicIpOnLaunch" awsType:"awsboolattribute" templateName:"public"`
+	_            string `action:"update" entity:"subnet" awsAPI:"ec2"`
+	logger       *logger.Logger
+	graph        cloud.GraphAPI
+	api          ec2iface.EC2API
+	Id           *string `templateName:"id"`
+	Public       *bool   `templateName:"public" enum:"true,false"`
+	IPv6CIDR     *string `templateName:"ipv6-cidr"`
+	IPv6OnLaunch *bool   `templateName:"ipv6-on-launch" enum:"true,false"`
 }
 
 func (cmd *UpdateSubnet) ParamsSpec() params.Spec {
-	return params.NewSpec(params.AllOf(params.Key("id"), params.Opt("public")))
+	return params.NewSpec(params.AllOf(params.Key("id"), params.Opt("public", "ipv6-cidr", "ipv6-on-launch")),
+		params.Validators{"ipv6-cidr": isIPv6CIDR})
+}
+
+// The IPv6 CIDR block is associated first, as assigning IPv6 addresses on launch requires it.
+// EC2 only allows to modify one subnet attribute per call
+func (cmd *UpdateSubnet) ManualRun(renv env.Running) (interface{}, error) {
+	var output interface{}
+	if cmd.IPv6CIDR != nil {
+		start := time.Now()
+		out, err := cmd.api.AssociateSubnetCidrBlock(&ec2.AssociateSubnetCidrBlockInput{SubnetId: cmd.Id, Ipv6CidrBlock: cmd.IPv6CIDR})
+		if err != nil {
+			return nil, err
+		}
+		cmd.logger.ExtraVerbosef("ec2.AssociateSubnetCidrBlock call took %s", time.Since(start))
+		output = out
+	}
+	var inputs []*ec2.ModifySubnetAttributeInput
+	if cmd.Public != nil {
+		inputs = append(inputs, &ec2.ModifySubnetAttributeInput{SubnetId: cmd.Id, MapPublicIpOnLaunch: &ec2.AttributeBooleanValue{Value: cmd.Public}})
+	}
+	if cmd.IPv6OnLaunch != nil {
+		inputs = append(inputs, &ec2.ModifySubnetAttributeInput{SubnetId: cmd.Id, AssignIpv6AddressOnCreation: &ec2.AttributeBooleanValue{Value: cmd.IPv6OnLaunch}})
+	}
+	for _, input := range inputs {
+		start := time.Now()
+		var err error
+		if output, err = cmd.api.ModifySubnetAttribute(input); err != nil {
+			return nil, err
+		}
+		cmd.logger.ExtraVerbosef("ec2.ModifySubnetAttribute call took %s", time.Since(start))
+	}
+	return output, nil
 }
 
 type DeleteSubnet struct {
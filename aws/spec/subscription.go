@@ -16,8 +16,6 @@ limitations under the License.
 package awsspec
 
 import (
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/aws/aws-sdk-go/service/sns/snsiface"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/logger"
@@ -25,21 +23,20 @@ import (
 )
 
 type CreateSubscription struct {
-	_        string `action:"create" entity:"subscription" awsAPI:"sns" awsCall:"Subscribe" awsInput:"sns.SubscribeInput" awsOutput:"sns.SubscribeOutput"`
+	_        string `action:"create" entity:"subscription" awsAPI:"sns" awsCall:"Subscribe" awsInput:"sns.SubscribeInput" awsOutput:"sns.SubscribeOutput" awsOutputExtract:"SubscriptionArn"`
 	logger   *logger.Logger
 	graph    cloud.GraphAPI
 	api      snsiface.SNSAPI
 	Topic    *string `awsName:"TopicArn" awsType:"awsstr" templateName:"topic"`
 	Endpoint *string `awsName:"Endpoint" awsType:"awsstr" templateName:"endpoint"`
-	Protocol *string `awsName:"Protocol" awsType:"awsstr" templateName:"protocol"`
+	Protocol *string `awsName:"Protocol" awsType:"awsstr" templateName:"protocol" enum:"http,https,email,email-json,sms,sqs,application,lambda"`
 }
 
 func (cmd *CreateSubscription) ParamsSpec() params.Spec {
-	return params.NewSpec(params.AllOf(params.Key("endpoint"), params.Key("protocol"), params.Key("topic")))
-}
-
-func (cmd *CreateSubscription) ExtractResult(i interface{}) string {
-	return awssdk.StringValue(i.(*sns.SubscribeOutput).SubscriptionArn)
+	return params.NewSpec(params.AllOf(params.Key("endpoint"), params.Key("protocol"), params.Key("topic")),
+		params.Validators{
+			"protocol": params.IsInEnumIgnoreCase("http", "https", "email", "email-json", "sms", "sqs", "application", "lambda"),
+		})
 }
 
 type DeleteSubscription struct {
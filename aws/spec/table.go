@@ -0,0 +1,212 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+var tableKeyTypes = []string{dynamodb.ScalarAttributeTypeS, dynamodb.ScalarAttributeTypeN, dynamodb.ScalarAttributeTypeB}
+
+const defaultTableCapacity = 5
+
+type CreateTable struct {
+	_             string `action:"create" entity:"table" awsAPI:"dynamodb"`
+	logger        *logger.Logger
+	graph         cloud.GraphAPI
+	api           dynamodbiface.DynamoDBAPI
+	Name          *string `templateName:"name"`
+	HashKey       *string `templateName:"hash-key"`
+	HashKeyType   *string `templateName:"hash-key-type" enum:"S,N,B"`
+	RangeKey      *string `templateName:"range-key"`
+	RangeKeyType  *string `templateName:"range-key-type" enum:"S,N,B"`
+	ReadCapacity  *int64  `templateName:"read-capacity"`
+	WriteCapacity *int64  `templateName:"write-capacity"`
+}
+
+func (cmd *CreateTable) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("name"), params.Key("hash-key"),
+			params.Opt(params.Suggested("read-capacity", "write-capacity"), "hash-key-type", "range-key", "range-key-type"),
+		),
+		params.Validators{
+			"hash-key-type":  params.IsInEnumIgnoreCase(tableKeyTypes...),
+			"range-key-type": params.IsInEnumIgnoreCase(tableKeyTypes...),
+		},
+	)
+}
+
+func (cmd *CreateTable) ManualRun(renv env.Running) (interface{}, error) {
+	input := &dynamodb.CreateTableInput{
+		TableName: cmd.Name,
+		AttributeDefinitions: []*dynamodb.AttributeDefinition{
+			{AttributeName: cmd.HashKey, AttributeType: String(tableKeyType(cmd.HashKeyType))},
+		},
+		KeySchema: []*dynamodb.KeySchemaElement{
+			{AttributeName: cmd.HashKey, KeyType: String(dynamodb.KeyTypeHash)},
+		},
+		ProvisionedThroughput: &dynamodb.ProvisionedThroughput{
+			ReadCapacityUnits:  Int64(tableCapacity(cmd.ReadCapacity)),
+			WriteCapacityUnits: Int64(tableCapacity(cmd.WriteCapacity)),
+		},
+	}
+	if cmd.RangeKey != nil {
+		input.AttributeDefinitions = append(input.AttributeDefinitions, &dynamodb.AttributeDefinition{AttributeName: cmd.RangeKey, AttributeType: String(tableKeyType(cmd.RangeKeyType))})
+		input.KeySchema = append(input.KeySchema, &dynamodb.KeySchemaElement{AttributeName: cmd.RangeKey, KeyType: String(dynamodb.KeyTypeRange)})
+	} else if cmd.RangeKeyType != nil {
+		return nil, fmt.Errorf("create table: range-key-type given without range-key")
+	}
+
+	start := time.Now()
+	output, err := cmd.api.CreateTable(input)
+	cmd.logger.ExtraVerbosef("dynamodb.CreateTable call took %s", time.Since(start))
+	return output, err
+}
+
+func (cmd *CreateTable) ExtractResult(i interface{}) string {
+	return StringValue(i.(*dynamodb.CreateTableOutput).TableDescription.TableName)
+}
+
+// The provisioned throughput is updated as a whole: a missing capacity keeps its current value
+type UpdateTable struct {
+	_             string `action:"update" entity:"table" awsAPI:"dynamodb"`
+	logger        *logger.Logger
+	graph         cloud.GraphAPI
+	api           dynamodbiface.DynamoDBAPI
+	Name          *string `templateName:"name"`
+	ReadCapacity  *int64  `templateName:"read-capacity"`
+	WriteCapacity *int64  `templateName:"write-capacity"`
+}
+
+func (cmd *UpdateTable) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name"),
+		params.AtLeastOneOf(params.Key("read-capacity"), params.Key("write-capacity")),
+	))
+}
+
+func (cmd *UpdateTable) ManualRun(renv env.Running) (interface{}, error) {
+	throughput := &dynamodb.ProvisionedThroughput{
+		ReadCapacityUnits:  cmd.ReadCapacity,
+		WriteCapacityUnits: cmd.WriteCapacity,
+	}
+	if throughput.ReadCapacityUnits == nil || throughput.WriteCapacityUnits == nil {
+		start := time.Now()
+		output, err := cmd.api.DescribeTable(&dynamodb.DescribeTableInput{TableName: cmd.Name})
+		cmd.logger.ExtraVerbosef("dynamodb.DescribeTable call took %s", time.Since(start))
+		if err != nil {
+			return nil, err
+		}
+		if current := output.Table.ProvisionedThroughput; current != nil {
+			if throughput.ReadCapacityUnits == nil {
+				throughput.ReadCapacityUnits = current.ReadCapacityUnits
+			}
+			if throughput.WriteCapacityUnits == nil {
+				throughput.WriteCapacityUnits = current.WriteCapacityUnits
+			}
+		}
+	}
+
+	start := time.Now()
+	output, err := cmd.api.UpdateTable(&dynamodb.UpdateTableInput{TableName: cmd.Name, ProvisionedThroughput: throughput})
+	cmd.logger.ExtraVerbosef("dynamodb.UpdateTable call took %s", time.Since(start))
+	return output, err
+}
+
+type DeleteTable struct {
+	_      string `action:"delete" entity:"table" awsAPI:"dynamodb" awsCall:"DeleteTable" awsInput:"dynamodb.DeleteTableInput" awsOutput:"dynamodb.DeleteTableOutput"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    dynamodbiface.DynamoDBAPI
+	Name   *string `awsName:"TableName" awsType:"awsstr" templateName:"name"`
+}
+
+func (cmd *DeleteTable) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name")))
+}
+
+type CheckTable struct {
+	_       string `action:"check" entity:"table" awsAPI:"dynamodb"`
+	logger  *logger.Logger
+	graph   cloud.GraphAPI
+	api     dynamodbiface.DynamoDBAPI
+	Name    *string `templateName:"name"`
+	State   *string `templateName:"state" enum:"ACTIVE,CREATING,UPDATING,DELETING,not-found"`
+	Timeout *int64  `templateName:"timeout" enum:"10,60,180,300,600,900"`
+}
+
+func (cmd *CheckTable) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("name"), params.Key("state"), params.Key("timeout")),
+		params.Validators{
+			"state": params.IsInEnumIgnoreCase(dynamodb.TableStatusActive, dynamodb.TableStatusCreating, dynamodb.TableStatusUpdating, dynamodb.TableStatusDeleting, notFoundState),
+		},
+	)
+}
+
+func (cmd *CheckTable) ManualRun(renv env.Running) (interface{}, error) {
+	input := &dynamodb.DescribeTableInput{
+		TableName: cmd.Name,
+	}
+
+	c := &checker{
+		description: fmt.Sprintf("table %s", StringValue(cmd.Name)),
+		timeout:     time.Duration(Int64AsIntValue(cmd.Timeout)) * time.Second,
+		frequency:   5 * time.Second,
+		fetchFunc: func() (string, error) {
+			output, err := cmd.api.DescribeTable(input)
+			if err != nil {
+				if awserr, ok := err.(awserr.Error); ok {
+					if awserr.Code() == dynamodb.ErrCodeResourceNotFoundException {
+						return notFoundState, nil
+					}
+				}
+				return "", err
+			}
+			if output.Table == nil {
+				return notFoundState, nil
+			}
+			return StringValue(output.Table.TableStatus), nil
+		},
+		expect: StringValue(cmd.State),
+		logger: cmd.logger,
+	}
+	return nil, c.check()
+}
+
+func tableKeyType(typ *string) string {
+	if typ == nil {
+		return dynamodb.ScalarAttributeTypeS
+	}
+	return strings.ToUpper(StringValue(typ))
+}
+
+func tableCapacity(capacity *int64) int64 {
+	if capacity == nil {
+		return defaultTableCapacity
+	}
+	return *capacity
+}
@@ -21,14 +21,13 @@ import (
 	"github.com/wallix/awless/template/env"
 	"github.com/wallix/awless/template/params"
 
-	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
 	"github.com/wallix/awless/logger"
 )
 
 type CreateTargetgroup struct {
-	_                   string `action:"create" entity:"targetgroup" awsAPI:"elbv2" awsCall:"CreateTargetGroup" awsInput:"elbv2.CreateTargetGroupInput" awsOutput:"elbv2.CreateTargetGroupOutput"`
+	_                   string `action:"create" entity:"targetgroup" awsAPI:"elbv2" awsCall:"CreateTargetGroup" awsInput:"elbv2.CreateTargetGroupInput" awsOutput:"elbv2.CreateTargetGroupOutput" awsOutputExtract:"TargetGroups[0].TargetGroupArn"`
 	logger              *logger.Logger
 	graph               cloud.GraphAPI
 	api                 elbv2iface.ELBV2API
@@ -52,10 +51,6 @@ func (cmd *CreateTargetgroup) ParamsSpec() params.Spec {
 	))
 }
 
-func (cmd *CreateTargetgroup) ExtractResult(i interface{}) string {
-	return awssdk.StringValue(i.(*elbv2.CreateTargetGroupOutput).TargetGroups[0].TargetGroupArn)
-}
-
 type UpdateTargetgroup struct {
 	_                   string `action:"update" entity:"targetgroup" awsAPI:"elbv2"`
 	logger              *logger.Logger
@@ -63,7 +58,7 @@ type UpdateTargetgroup struct {
 	api                 elbv2iface.ELBV2API
 	Id                  *string `awsName:"TargetGroupArn" awsType:"awsstr" templateName:"id"`
 	Deregistrationdelay *string `awsType:"awsstr" templateName:"deregistrationdelay"`
-	Stickiness          *string `awsType:"awsstr" templateName:"stickiness"`
+	Stickiness          *string `awsType:"awsstr" templateName:"stickiness" enum:"true,false"`
 	Stickinessduration  *string `awsType:"awsstr" templateName:"stickinessduration"`
 	Healthcheckinterval *int64  `awsName:"HealthCheckIntervalSeconds" awsType:"awsint64" templateName:"healthcheckinterval"`
 	Healthcheckpath     *string `awsName:"HealthCheckPath" awsType:"awsstr" templateName:"healthcheckpath"`
@@ -16,8 +16,6 @@ limitations under the License.
 package awsspec
 
 import (
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/logger"
@@ -25,7 +23,7 @@ import (
 )
 
 type CreateUser struct {
-	_      string `action:"create" entity:"user" awsAPI:"iam" awsCall:"CreateUser" awsInput:"iam.CreateUserInput" awsOutput:"iam.CreateUserOutput"`
+	_      string `action:"create" entity:"user" awsAPI:"iam" awsCall:"CreateUser" awsInput:"iam.CreateUserInput" awsOutput:"iam.CreateUserOutput" awsOutputExtract:"User.UserId"`
 	logger *logger.Logger
 	graph  cloud.GraphAPI
 	api    iamiface.IAMAPI
@@ -36,10 +34,6 @@ func (cmd *CreateUser) ParamsSpec() params.Spec {
 	return params.NewSpec(params.AllOf(params.Key("name")))
 }
 
-func (cmd *CreateUser) ExtractResult(i interface{}) string {
-	return awssdk.StringValue(i.(*iam.CreateUserOutput).User.UserId)
-}
-
 type DeleteUser struct {
 	_      string `action:"delete" entity:"user" awsAPI:"iam" awsCall:"DeleteUser" awsInput:"iam.DeleteUserInput" awsOutput:"iam.DeleteUserOutput"`
 	logger *logger.Logger
@@ -57,8 +51,8 @@ type AttachUser struct {
 	logger *logger.Logger
 	graph  cloud.GraphAPI
 	api    iamiface.IAMAPI
-	Group  *string `awsName:"GroupName" awsType:"awsstr" templateName:"group"`
-	Name   *string `awsName:"UserName" awsType:"awsstr" templateName:"name"`
+	Group  *string `awsName:"GroupName" awsType:"awsstr" templateName:"group" ref:"group.Name"`
+	Name   *string `awsName:"UserName" awsType:"awsstr" templateName:"name" ref:"user.Name"`
 }
 
 func (cmd *AttachUser) ParamsSpec() params.Spec {
@@ -22,7 +22,6 @@ import (
 	"github.com/wallix/awless/template/env"
 	"github.com/wallix/awless/template/params"
 
-	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
@@ -30,7 +29,7 @@ import (
 )
 
 type CreateVolume struct {
-	_                string `action:"create" entity:"volume" awsAPI:"ec2" awsCall:"CreateVolume" awsInput:"ec2.CreateVolumeInput" awsOutput:"ec2.Volume" awsDryRun:""`
+	_                string `action:"create" entity:"volume" awsAPI:"ec2" awsCall:"CreateVolume" awsInput:"ec2.CreateVolumeInput" awsOutput:"ec2.Volume" awsDryRun:"" awsOutputExtract:"VolumeId"`
 	logger           *logger.Logger
 	graph            cloud.GraphAPI
 	api              ec2iface.EC2API
@@ -39,11 +38,8 @@ type CreateVolume struct {
 }
 
 func (cmd *CreateVolume) ParamsSpec() params.Spec {
-	return params.NewSpec(params.AllOf(params.Key("availabilityzone"), params.Key("size")))
-}
-
-func (cmd *CreateVolume) ExtractResult(i interface{}) string {
-	return awssdk.StringValue(i.(*ec2.Volume).VolumeId)
+	return params.NewSpec(params.AllOf(params.Key("availabilityzone"), params.Key("size")),
+		params.Validators{"availabilityzone": isAvailabilityZone(cmd.graph)})
 }
 
 type CheckVolume struct {
@@ -52,8 +48,8 @@ type CheckVolume struct {
 	graph   cloud.GraphAPI
 	api     ec2iface.EC2API
 	Id      *string `templateName:"id"`
-	State   *string `templateName:"state"`
-	Timeout *int64  `templateName:"timeout"`
+	State   *string `templateName:"state" enum:"available,in-use,not-found"`
+	Timeout *int64  `templateName:"timeout" enum:"10,60,180,300,600,900"`
 }
 
 func (cmd *CheckVolume) ParamsSpec() params.Spec {
@@ -110,7 +106,7 @@ func (cmd *DeleteVolume) ParamsSpec() params.Spec {
 }
 
 type AttachVolume struct {
-	_        string `action:"attach" entity:"volume" awsAPI:"ec2" awsCall:"AttachVolume" awsInput:"ec2.AttachVolumeInput" awsOutput:"ec2.VolumeAttachment" awsDryRun:""`
+	_        string `action:"attach" entity:"volume" awsAPI:"ec2" awsCall:"AttachVolume" awsInput:"ec2.AttachVolumeInput" awsOutput:"ec2.VolumeAttachment" awsDryRun:"" awsOutputExtract:"VolumeId"`
 	logger   *logger.Logger
 	graph    cloud.GraphAPI
 	api      ec2iface.EC2API
@@ -122,12 +118,8 @@ type AttachVolume struct {
 func (cmd *AttachVolume) ParamsSpec() params.Spec {
 	return params.NewSpec(params.AllOf(params.Key("device"), params.Key("id"), params.Key("instance")))
 }
-func (cmd *AttachVolume) ExtractResult(i interface{}) string {
-	return awssdk.StringValue(i.(*ec2.VolumeAttachment).VolumeId)
-}
-
 type DetachVolume struct {
-	_        string `action:"detach" entity:"volume" awsAPI:"ec2" awsCall:"DetachVolume" awsInput:"ec2.DetachVolumeInput" awsOutput:"ec2.VolumeAttachment" awsDryRun:""`
+	_        string `action:"detach" entity:"volume" awsAPI:"ec2" awsCall:"DetachVolume" awsInput:"ec2.DetachVolumeInput" awsOutput:"ec2.VolumeAttachment" awsDryRun:"" awsOutputExtract:"VolumeId"`
 	logger   *logger.Logger
 	graph    cloud.GraphAPI
 	api      ec2iface.EC2API
@@ -142,7 +134,3 @@ func (cmd *DetachVolume) ParamsSpec() params.Spec {
 		params.Opt("force"),
 	))
 }
-
-func (cmd *DetachVolume) ExtractResult(i interface{}) string {
-	return awssdk.StringValue(i.(*ec2.VolumeAttachment).VolumeId)
-}
@@ -16,6 +16,9 @@ limitations under the License.
 package awsspec
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/template/env"
 	"github.com/wallix/awless/template/params"
@@ -27,28 +30,79 @@ import (
 )
 
 type CreateVpc struct {
-	_      string `action:"create" entity:"vpc" awsAPI:"ec2" awsCall:"CreateVpc" awsInput:"ec2.CreateVpcInput" awsOutput:"ec2.CreateVpcOutput" awsDryRun:""`
+	_      string `action:"create" entity:"vpc" awsAPI:"ec2" awsCall:"CreateVpc" awsInput:"ec2.CreateVpcInput" awsOutput:"ec2.CreateVpcOutput" awsDryRun:"" awsOutputExtract:"Vpc.VpcId"`
 	logger *logger.Logger
 	graph  cloud.GraphAPI
 	api    ec2iface.EC2API
 	CIDR   *string `awsName:"CidrBlock" awsType:"awsstr" templateName:"cidr"`
+	IPv6   *bool   `awsName:"AmazonProvidedIpv6CidrBlock" awsType:"awsbool" templateName:"ipv6" enum:"true,false"`
 	Name   *string `awsName:"Name" templateName:"name"`
 }
 
 func (cmd *CreateVpc) ParamsSpec() params.Spec {
 	return params.NewSpec(
-		params.AllOf(params.Key("cidr"), params.Opt(params.Suggested("name"))),
-		params.Validators{"cidr": params.IsCIDR})
-}
-
-func (cmd *CreateVpc) ExtractResult(i interface{}) string {
-	return awssdk.StringValue(i.(*ec2.CreateVpcOutput).Vpc.VpcId)
+		params.AllOf(params.Key("cidr"), params.Opt(params.Suggested("name"), "ipv6")),
+		params.Validators{"cidr": isCIDRNotOverlapping(cmd.graph, cloud.Vpc)})
 }
 
 func (cmd *CreateVpc) AfterRun(renv env.Running, output interface{}) error {
 	return createNameTag(awssdk.String(cmd.ExtractResult(output)), cmd.Name, renv)
 }
 
+type UpdateVpc struct {
+	_            string `action:"update" entity:"vpc" awsAPI:"ec2"`
+	logger       *logger.Logger
+	graph        cloud.GraphAPI
+	api          ec2iface.EC2API
+	Id           *string `templateName:"id"`
+	DNSSupport   *bool   `templateName:"dns-support" enum:"true,false"`
+	DNSHostnames *bool   `templateName:"dns-hostnames" enum:"true,false"`
+	IPv6         *bool   `templateName:"ipv6" enum:"true"`
+}
+
+func (cmd *UpdateVpc) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("id"), params.AtLeastOneOf(params.Key("dns-support"), params.Key("dns-hostnames"), params.Key("ipv6"))),
+		params.Validators{"ipv6": func(i interface{}, others map[string]interface{}) error {
+			if b, err := castBool(i); err != nil || !b {
+				return fmt.Errorf("only ipv6=true is supported, to associate an Amazon provided IPv6 CIDR block")
+			}
+			return nil
+		}},
+	)
+}
+
+// EC2 only allows to modify one VPC attribute per call
+func (cmd *UpdateVpc) ManualRun(renv env.Running) (interface{}, error) {
+	var output interface{}
+	if BoolValue(cmd.IPv6) {
+		start := time.Now()
+		out, err := cmd.api.AssociateVpcCidrBlock(&ec2.AssociateVpcCidrBlockInput{VpcId: cmd.Id, AmazonProvidedIpv6CidrBlock: Bool(true)})
+		if err != nil {
+			return nil, err
+		}
+		cmd.logger.ExtraVerbosef("ec2.AssociateVpcCidrBlock call took %s", time.Since(start))
+		output = out
+	}
+
+	var inputs []*ec2.ModifyVpcAttributeInput
+	if cmd.DNSSupport != nil {
+		inputs = append(inputs, &ec2.ModifyVpcAttributeInput{VpcId: cmd.Id, EnableDnsSupport: &ec2.AttributeBooleanValue{Value: cmd.DNSSupport}})
+	}
+	if cmd.DNSHostnames != nil {
+		inputs = append(inputs, &ec2.ModifyVpcAttributeInput{VpcId: cmd.Id, EnableDnsHostnames: &ec2.AttributeBooleanValue{Value: cmd.DNSHostnames}})
+	}
+	for _, input := range inputs {
+		start := time.Now()
+		var err error
+		if output, err = cmd.api.ModifyVpcAttribute(input); err != nil {
+			return nil, err
+		}
+		cmd.logger.ExtraVerbosef("ec2.ModifyVpcAttribute call took %s", time.Since(start))
+	}
+	return output, nil
+}
+
 type DeleteVpc struct {
 	_      string `action:"delete" entity:"vpc" awsAPI:"ec2" awsCall:"DeleteVpc" awsInput:"ec2.DeleteVpcInput" awsOutput:"ec2.DeleteVpcOutput" awsDryRun:""`
 	logger *logger.Logger
@@ -0,0 +1,214 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+type CreateVpcendpoint struct {
+	_              string `action:"create" entity:"vpcendpoint" awsAPI:"ec2" awsDryRun:"manual"`
+	logger         *logger.Logger
+	graph          cloud.GraphAPI
+	api            ec2iface.EC2API
+	Vpc            *string   `templateName:"vpc"`
+	Service        *string   `templateName:"service" enum:"s3,dynamodb,ec2,ecr.api,ecr.dkr,kms,logs,sns,sqs,ssm"`
+	Type           *string   `templateName:"type" enum:"gateway,interface"`
+	Routetables    []*string `templateName:"routetables"`
+	Subnets        []*string `templateName:"subnets"`
+	Securitygroups []*string `templateName:"securitygroups"`
+	PrivateDNS     *bool     `templateName:"private-dns" enum:"true,false"`
+	Policy         *string   `templateName:"policy"`
+	Name           *string   `templateName:"name"`
+}
+
+func (cmd *CreateVpcendpoint) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("vpc"), params.Key("service"),
+			params.Opt(params.Suggested("name"), "policy", "private-dns", "routetables", "securitygroups", "subnets", "type"),
+		),
+		params.Validators{
+			"type": params.IsInEnumIgnoreCase("gateway", "interface"),
+		},
+	)
+}
+
+// prepareVpcEndpointInput resolves short service names (ex: s3, dynamodb) into the full names
+// of the region (ex: com.amazonaws.us-west-2.s3) and defaults the endpoint type to the one supported by the service
+func (cmd *CreateVpcendpoint) prepareVpcEndpointInput() (*ec2.CreateVpcEndpointInput, error) {
+	input := &ec2.CreateVpcEndpointInput{
+		VpcId:             cmd.Vpc,
+		ServiceName:       cmd.Service,
+		RouteTableIds:     cmd.Routetables,
+		SubnetIds:         cmd.Subnets,
+		SecurityGroupIds:  cmd.Securitygroups,
+		PrivateDnsEnabled: cmd.PrivateDNS,
+		PolicyDocument:    cmd.Policy,
+	}
+	if cmd.Type != nil {
+		input.VpcEndpointType = String(strings.Title(strings.ToLower(StringValue(cmd.Type))))
+	}
+	service := StringValue(cmd.Service)
+	if strings.Contains(service, ".") && input.VpcEndpointType != nil {
+		return input, nil
+	}
+
+	start := time.Now()
+	out, err := cmd.api.DescribeVpcEndpointServices(&ec2.DescribeVpcEndpointServicesInput{})
+	cmd.logger.ExtraVerbosef("ec2.DescribeVpcEndpointServices call took %s", time.Since(start))
+	if err != nil {
+		return nil, err
+	}
+	for _, detail := range out.ServiceDetails {
+		name := StringValue(detail.ServiceName)
+		if name != service && !strings.HasSuffix(name, "."+service) {
+			continue
+		}
+		input.ServiceName = String(name)
+		if input.VpcEndpointType == nil && len(detail.ServiceType) > 0 {
+			input.VpcEndpointType = detail.ServiceType[0].ServiceType
+		}
+		return input, nil
+	}
+	return nil, fmt.Errorf("create vpcendpoint: unknown service '%s' (ex: s3, dynamodb, ec2, sqs or full service name)", service)
+}
+
+func (cmd *CreateVpcendpoint) ManualRun(renv env.Running) (interface{}, error) {
+	input, err := cmd.prepareVpcEndpointInput()
+	if err != nil {
+		return nil, err
+	}
+	if err = checkVpcEndpointInput(input); err != nil {
+		return nil, err
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateVpcEndpoint(input)
+	cmd.logger.ExtraVerbosef("ec2.CreateVpcEndpoint call took %s", time.Since(start))
+	return output, err
+}
+
+func (cmd *CreateVpcendpoint) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("dry run: cannot set params on command struct: %s", err)
+	}
+	input, err := cmd.prepareVpcEndpointInput()
+	if err != nil {
+		return nil, fmt.Errorf("dry run: %s", err)
+	}
+	if err = checkVpcEndpointInput(input); err != nil {
+		return nil, fmt.Errorf("dry run: %s", err)
+	}
+	input.SetDryRun(true)
+
+	start := time.Now()
+	_, err = cmd.api.CreateVpcEndpoint(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound):
+			cmd.logger.ExtraVerbosef("dry run: ec2.CreateVpcEndpoint call took %s", time.Since(start))
+			cmd.logger.Verbose("dry run: create vpcendpoint ok")
+			return fakeDryRunId("vpcendpoint"), nil
+		}
+	}
+
+	return nil, fmt.Errorf("dry run: %s", err)
+}
+
+func (cmd *CreateVpcendpoint) ExtractResult(i interface{}) string {
+	return StringValue(i.(*ec2.CreateVpcEndpointOutput).VpcEndpoint.VpcEndpointId)
+}
+
+func (cmd *CreateVpcendpoint) AfterRun(renv env.Running, output interface{}) error {
+	if cmd.Name == nil {
+		return nil
+	}
+	return createNameTag(String(cmd.ExtractResult(output)), cmd.Name, renv)
+}
+
+// Gateway endpoints (S3, DynamoDB) are routed through route tables
+// whereas interface endpoints are network interfaces living in subnets
+func checkVpcEndpointInput(input *ec2.CreateVpcEndpointInput) error {
+	switch StringValue(input.VpcEndpointType) {
+	case ec2.VpcEndpointTypeGateway:
+		if len(input.SubnetIds) > 0 || len(input.SecurityGroupIds) > 0 || input.PrivateDnsEnabled != nil {
+			return fmt.Errorf("gateway endpoint for %s: subnets, securitygroups and private-dns only apply to interface endpoints (use routetables)", StringValue(input.ServiceName))
+		}
+	case ec2.VpcEndpointTypeInterface:
+		if len(input.RouteTableIds) > 0 {
+			return fmt.Errorf("interface endpoint for %s: routetables only apply to gateway endpoints (use subnets and securitygroups)", StringValue(input.ServiceName))
+		}
+	}
+	return nil
+}
+
+type DeleteVpcendpoint struct {
+	_      string `action:"delete" entity:"vpcendpoint" awsAPI:"ec2" awsCall:"DeleteVpcEndpoints" awsInput:"ec2.DeleteVpcEndpointsInput" awsOutput:"ec2.DeleteVpcEndpointsOutput" awsDryRun:""`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    ec2iface.EC2API
+	Ids    []*string `awsName:"VpcEndpointIds" awsType:"awsstringslice" templateName:"ids"`
+}
+
+func (cmd *DeleteVpcendpoint) ParamsSpec() params.Spec {
+	builder := params.SpecBuilder(params.OnlyOneOf(params.Key("ids"), params.Key("id")))
+	builder.AddReducer(idToIds, "id")
+	return builder.Done()
+}
+
+type AttachVpcendpoint struct {
+	_              string `action:"attach" entity:"vpcendpoint" awsAPI:"ec2" awsCall:"ModifyVpcEndpoint" awsInput:"ec2.ModifyVpcEndpointInput" awsOutput:"ec2.ModifyVpcEndpointOutput" awsDryRun:""`
+	logger         *logger.Logger
+	graph          cloud.GraphAPI
+	api            ec2iface.EC2API
+	Id             *string   `awsName:"VpcEndpointId" awsType:"awsstr" templateName:"id"`
+	Routetables    []*string `awsName:"AddRouteTableIds" awsType:"awsstringslice" templateName:"routetables"`
+	Subnets        []*string `awsName:"AddSubnetIds" awsType:"awsstringslice" templateName:"subnets"`
+	Securitygroups []*string `awsName:"AddSecurityGroupIds" awsType:"awsstringslice" templateName:"securitygroups"`
+}
+
+func (cmd *AttachVpcendpoint) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id"),
+		params.AtLeastOneOf(params.Key("routetables"), params.Key("securitygroups"), params.Key("subnets")),
+	))
+}
+
+type DetachVpcendpoint struct {
+	_              string `action:"detach" entity:"vpcendpoint" awsAPI:"ec2" awsCall:"ModifyVpcEndpoint" awsInput:"ec2.ModifyVpcEndpointInput" awsOutput:"ec2.ModifyVpcEndpointOutput" awsDryRun:""`
+	logger         *logger.Logger
+	graph          cloud.GraphAPI
+	api            ec2iface.EC2API
+	Id             *string   `awsName:"VpcEndpointId" awsType:"awsstr" templateName:"id"`
+	Routetables    []*string `awsName:"RemoveRouteTableIds" awsType:"awsstringslice" templateName:"routetables"`
+	Subnets        []*string `awsName:"RemoveSubnetIds" awsType:"awsstringslice" templateName:"subnets"`
+	Securitygroups []*string `awsName:"RemoveSecurityGroupIds" awsType:"awsstringslice" templateName:"securitygroups"`
+}
+
+func (cmd *DetachVpcendpoint) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id"),
+		params.AtLeastOneOf(params.Key("routetables"), params.Key("securitygroups"), params.Key("subnets")),
+	))
+}
@@ -15,8 +15,6 @@ limitations under the License.
 package awsspec
 
 import (
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/route53/route53iface"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/logger"
@@ -24,7 +22,7 @@ import (
 )
 
 type CreateZone struct {
-	_               string `action:"create" entity:"zone" awsAPI:"route53" awsCall:"CreateHostedZone" awsInput:"route53.CreateHostedZoneInput" awsOutput:"route53.CreateHostedZoneOutput"`
+	_               string `action:"create" entity:"zone" awsAPI:"route53" awsCall:"CreateHostedZone" awsInput:"route53.CreateHostedZoneInput" awsOutput:"route53.CreateHostedZoneOutput" awsOutputExtract:"HostedZone.Id"`
 	logger          *logger.Logger
 	graph           cloud.GraphAPI
 	api             route53iface.Route53API
@@ -32,7 +30,7 @@ type CreateZone struct {
 	Name            *string `awsName:"Name" awsType:"awsstr" templateName:"name"`
 	Delegationsetid *string `awsName:"DelegationSetId" awsType:"awsstr" templateName:"delegationsetid"`
 	Comment         *string `awsName:"HostedZoneConfig.Comment" awsType:"awsstr" templateName:"comment"`
-	Isprivate       *bool   `awsName:"HostedZoneConfig.PrivateZone" awsType:"awsbool" templateName:"isprivate"`
+	Isprivate       *bool   `awsName:"HostedZoneConfig.PrivateZone" awsType:"awsbool" templateName:"isprivate" enum:"true,false"`
 	Vpcid           *string `awsName:"VPC.VPCId" awsType:"awsstr" templateName:"vpcid"`
 	Vpcregion       *string `awsName:"VPC.VPCRegion" awsType:"awsstr" templateName:"vpcregion"`
 }
@@ -40,11 +38,7 @@ type CreateZone struct {
 func (cmd *CreateZone) ParamsSpec() params.Spec {
 	return params.NewSpec(params.AllOf(params.Key("callerreference"), params.Key("name"),
 		params.Opt("comment", "delegationsetid", "isprivate", "vpcid", "vpcregion"),
-	))
-}
-
-func (cmd *CreateZone) ExtractResult(i interface{}) string {
-	return awssdk.StringValue(i.(*route53.CreateHostedZoneOutput).HostedZone.Id)
+	), params.Validators{"vpcregion": isRegion})
 }
 
 type DeleteZone struct {
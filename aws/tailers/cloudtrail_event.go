@@ -0,0 +1,175 @@
+package awstailers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/aws/aws-sdk-go/service/cloudtrail/cloudtrailiface"
+	"github.com/fatih/color"
+)
+
+// lookupAttributeReadOnly is accepted by the CloudTrail API
+// but not yet declared in the vendored SDK enums
+const lookupAttributeReadOnly = "ReadOnly"
+
+type cloudTrailEventsTailer struct {
+	api              cloudtrailiface.CloudTrailAPI
+	nameOf           func(id string) string
+	follow           bool
+	pollingFrequency time.Duration
+	nbEvents         int
+	lastEventTime    time.Time
+	seen             map[string]bool
+}
+
+// NewCloudTrailEventsTailer tails the changes (i.e. non read only calls) recorded by CloudTrail.
+// The nameOf func resolves resources IDs to their names (ex: using the local graph).
+func NewCloudTrailEventsTailer(api cloudtrailiface.CloudTrailAPI, nameOf func(string) string, nbEvents int, follow bool, frequency time.Duration) *cloudTrailEventsTailer {
+	if nameOf == nil {
+		nameOf = func(string) string { return "" }
+	}
+	return &cloudTrailEventsTailer{
+		api:              api,
+		nameOf:           nameOf,
+		nbEvents:         nbEvents,
+		follow:           follow,
+		pollingFrequency: frequency,
+		seen:             make(map[string]bool),
+	}
+}
+
+func (t *cloudTrailEventsTailer) Name() string {
+	return "events"
+}
+
+func (t *cloudTrailEventsTailer) Tail(w io.Writer) error {
+	if t.follow && t.pollingFrequency < 5*time.Second {
+		return fmt.Errorf("invalid polling frequency: %s, must be greater than 5s", t.pollingFrequency)
+	}
+
+	events, err := t.lastEvents()
+	if err != nil {
+		return err
+	}
+	if err := t.display(w, events); err != nil {
+		return err
+	}
+
+	if !t.follow {
+		return nil
+	}
+
+	if t.lastEventTime.IsZero() {
+		t.lastEventTime = time.Now().UTC()
+	}
+
+	ticker := time.NewTicker(t.pollingFrequency)
+	defer ticker.Stop()
+	for range ticker.C {
+		events, err := t.newEvents()
+		if err != nil {
+			return err
+		}
+		if err := t.display(w, events); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *cloudTrailEventsTailer) lastEvents() ([]*cloudtrail.Event, error) {
+	out, err := t.api.LookupEvents(&cloudtrail.LookupEventsInput{
+		LookupAttributes: changesOnlyAttributes(),
+		MaxResults:       awssdk.Int64(int64(t.nbEvents)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.Events, nil
+}
+
+// newEvents returns the events since the last displayed one.
+// CloudTrail time filters are inclusive, hence events already seen are skipped.
+func (t *cloudTrailEventsTailer) newEvents() (events []*cloudtrail.Event, err error) {
+	input := &cloudtrail.LookupEventsInput{
+		LookupAttributes: changesOnlyAttributes(),
+		StartTime:        awssdk.Time(t.lastEventTime),
+	}
+	err = t.api.LookupEventsPages(input, func(page *cloudtrail.LookupEventsOutput, lastPage bool) bool {
+		for _, e := range page.Events {
+			if !t.seen[awssdk.StringValue(e.EventId)] {
+				events = append(events, e)
+			}
+		}
+		return true
+	})
+	return
+}
+
+func (t *cloudTrailEventsTailer) display(w io.Writer, events []*cloudtrail.Event) error {
+	sort.SliceStable(events, func(i, j int) bool {
+		return awssdk.TimeValue(events[i].EventTime).Before(awssdk.TimeValue(events[j].EventTime))
+	})
+	for _, e := range events {
+		stamp := awssdk.TimeValue(e.EventTime)
+		if stamp.After(t.lastEventTime) {
+			t.lastEventTime = stamp
+			t.seen = make(map[string]bool)
+		}
+		t.seen[awssdk.StringValue(e.EventId)] = true
+
+		if _, err := fmt.Fprintln(w, t.format(e)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *cloudTrailEventsTailer) format(e *cloudtrail.Event) string {
+	var resources []string
+	for _, r := range e.Resources {
+		id := awssdk.StringValue(r.ResourceName)
+		if name := t.nameOf(id); name != "" && name != id {
+			resources = append(resources, fmt.Sprintf("%s (%s)", id, name))
+		} else {
+			resources = append(resources, id)
+		}
+	}
+
+	action := awssdk.StringValue(e.EventName)
+	if code := errorCodeOf(e); code != "" {
+		action = color.New(color.FgRed).Sprintf("%s [%s]", action, code)
+	} else {
+		action = color.New(color.FgGreen).Sprint(action)
+	}
+
+	return fmt.Sprintf("%s\t%s\t%s\t%s\t%s",
+		awssdk.TimeValue(e.EventTime).UTC().Format(time.RFC3339),
+		awssdk.StringValue(e.Username),
+		strings.TrimSuffix(awssdk.StringValue(e.EventSource), ".amazonaws.com"),
+		action,
+		strings.Join(resources, ", "),
+	)
+}
+
+func errorCodeOf(e *cloudtrail.Event) string {
+	var raw struct {
+		ErrorCode string `json:"errorCode"`
+	}
+	if err := json.Unmarshal([]byte(awssdk.StringValue(e.CloudTrailEvent)), &raw); err != nil {
+		return ""
+	}
+	return raw.ErrorCode
+}
+
+func changesOnlyAttributes() []*cloudtrail.LookupAttribute {
+	return []*cloudtrail.LookupAttribute{
+		{AttributeKey: awssdk.String(lookupAttributeReadOnly), AttributeValue: awssdk.String("false")},
+	}
+}
@@ -0,0 +1,92 @@
+package awstailers
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/aws/aws-sdk-go/service/cloudtrail/cloudtrailiface"
+	"github.com/fatih/color"
+)
+
+func TestCloudTrailEventsTailer(t *testing.T) {
+	color.NoColor = true
+	now := time.Date(2017, 10, 1, 12, 0, 0, 0, time.UTC)
+	mock := &cloudtrailMock{events: []*cloudtrail.Event{
+		newTrailEvent("2", "TerminateInstances", now.Add(time.Minute), `{"errorCode":"UnauthorizedOperation"}`, "i-1234"),
+		newTrailEvent("1", "RunInstances", now, `{}`, "i-1234", "ami-5678"),
+	}}
+	names := map[string]string{"i-1234": "web"}
+	tailer := NewCloudTrailEventsTailer(mock, func(id string) string { return names[id] }, 10, false, 0)
+
+	var buf bytes.Buffer
+	if err := tailer.Tail(&buf); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if got, want := len(lines), 2; got != want {
+		t.Fatalf("got %d, want %d lines: %s", got, want, buf.String())
+	}
+	if got, want := lines[0], "2017-10-01T12:00:00Z\tjohn\tec2\tRunInstances\ti-1234 (web), ami-5678"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	if got, want := lines[1], "2017-10-01T12:01:00Z\tjohn\tec2\tTerminateInstances [UnauthorizedOperation]\ti-1234 (web)"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	if got, want := awssdk.StringValue(mock.lastInput.LookupAttributes[0].AttributeKey), "ReadOnly"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	mock.events = append([]*cloudtrail.Event{newTrailEvent("3", "CreateTags", now.Add(time.Minute), `{}`, "i-1234")}, mock.events...)
+	events, err := tailer.newEvents()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(events), 1; got != want {
+		t.Fatalf("got %d, want %d new events", got, want)
+	}
+	if got, want := awssdk.TimeValue(mock.lastInput.StartTime), now.Add(time.Minute); !got.Equal(want) {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
+
+func newTrailEvent(id, name string, stamp time.Time, raw string, resources ...string) *cloudtrail.Event {
+	e := &cloudtrail.Event{
+		EventId:         awssdk.String(id),
+		EventName:       awssdk.String(name),
+		EventSource:     awssdk.String("ec2.amazonaws.com"),
+		EventTime:       awssdk.Time(stamp),
+		Username:        awssdk.String("john"),
+		CloudTrailEvent: awssdk.String(raw),
+	}
+	for _, r := range resources {
+		e.Resources = append(e.Resources, &cloudtrail.Resource{ResourceName: awssdk.String(r)})
+	}
+	return e
+}
+
+type cloudtrailMock struct {
+	cloudtrailiface.CloudTrailAPI
+	events    []*cloudtrail.Event
+	lastInput *cloudtrail.LookupEventsInput
+}
+
+func (m *cloudtrailMock) LookupEvents(input *cloudtrail.LookupEventsInput) (*cloudtrail.LookupEventsOutput, error) {
+	m.lastInput = input
+	return &cloudtrail.LookupEventsOutput{Events: m.events}, nil
+}
+
+func (m *cloudtrailMock) LookupEventsPages(input *cloudtrail.LookupEventsInput, fn func(*cloudtrail.LookupEventsOutput, bool) bool) error {
+	m.lastInput = input
+	var events []*cloudtrail.Event
+	for _, e := range m.events {
+		if !awssdk.TimeValue(e.EventTime).Before(awssdk.TimeValue(input.StartTime)) {
+			events = append(events, e)
+		}
+	}
+	fn(&cloudtrail.LookupEventsOutput{Events: events}, true)
+	return nil
+}
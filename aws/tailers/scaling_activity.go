@@ -27,7 +27,7 @@ func (t *scalingActivitiesTailer) Name() string {
 }
 
 func (t *scalingActivitiesTailer) Tail(w io.Writer) error {
-	infra, ok := awsservices.InfraService.(*awsservices.Infra)
+	infra, ok := awsservices.Resolve(awsservices.InfraService).(*awsservices.Infra)
 	if !ok {
 		return fmt.Errorf("invalid cloud service, expected awsservices.Infra, got %T", awsservices.InfraService)
 	}
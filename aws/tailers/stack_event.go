@@ -65,7 +65,7 @@ func (t *stackEventTailer) Name() string {
 }
 
 func (t *stackEventTailer) Tail(w io.Writer) error {
-	cfn, ok := awsservices.CloudformationService.(*awsservices.Cloudformation)
+	cfn, ok := awsservices.Resolve(awsservices.CloudformationService).(*awsservices.Cloudformation)
 	if !ok {
 		return fmt.Errorf("invalid cloud service, expected awsservices.Cloudformation, got %T", awsservices.CloudformationService)
 	}
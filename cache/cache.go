@@ -0,0 +1,110 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cache stores on disk the results of slow and seldom changing lookups
+// (ex: AMIs resolution) so that successive awless invocations do not pay their latency
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Disk is a cache of JSON encoded values stored as files, expiring after a time to live
+type Disk struct {
+	dir string
+	ttl time.Duration
+	now func() time.Time
+}
+
+// NewDisk returns a cache storing its entries in the given directory.
+// A zero or negative time to live disables the cache.
+func NewDisk(dir string, ttl time.Duration) *Disk {
+	return &Disk{dir: dir, ttl: ttl, now: time.Now}
+}
+
+func (c *Disk) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+type entry struct {
+	Key     string          `json:"key"`
+	Expires time.Time       `json:"expires"`
+	Value   json.RawMessage `json:"value"`
+}
+
+// Get decodes into v the value cached for the key, returning false if none or expired
+func (c *Disk) Get(key string, v interface{}) bool {
+	if c == nil || c.ttl <= 0 {
+		return false
+	}
+	b, err := ioutil.ReadFile(c.path(key))
+	if err != nil {
+		return false
+	}
+	var e entry
+	if err := json.Unmarshal(b, &e); err != nil || e.Key != key || c.now().After(e.Expires) {
+		return false
+	}
+	return json.Unmarshal(e.Value, v) == nil
+}
+
+// Put caches the value for the key
+func (c *Disk) Put(key string, v interface{}) error {
+	if c == nil || c.ttl <= 0 {
+		return nil
+	}
+	value, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(&entry{Key: key, Expires: c.now().Add(c.ttl), Value: value})
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(c.dir, 0700); err != nil {
+		return err
+	}
+	tmp := c.path(key) + ".tmp"
+	if err := ioutil.WriteFile(tmp, b, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, c.path(key))
+}
+
+// Fetch decodes into v the value cached for the key, or else fills v
+// with the given fetch func and caches it (best effort) when the fetch succeeds
+func (c *Disk) Fetch(key string, v interface{}, fetch func() error) error {
+	if c.Get(key, v) {
+		return nil
+	}
+	if err := fetch(); err != nil {
+		return err
+	}
+	c.Put(key, v)
+	return nil
+}
+
+// Clear removes all the entries of the cache
+func (c *Disk) Clear() error {
+	return os.RemoveAll(c.dir)
+}
@@ -0,0 +1,78 @@
+package cache
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestDiskCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "awless-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	now := time.Now()
+	c := NewDisk(dir, time.Hour)
+	c.now = func() time.Time { return now }
+
+	var fetches int
+	fetch := func(v *[]string) func() error {
+		return func() error {
+			fetches++
+			*v = []string{"ami-1", "ami-2"}
+			return nil
+		}
+	}
+
+	for i := 0; i < 2; i++ {
+		var images []string
+		if err := c.Fetch("canonical:ubuntu", &images, fetch(&images)); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := len(images), 2; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+	}
+	if got, want := fetches, 1; got != want {
+		t.Fatalf("got %d fetches, want %d", got, want)
+	}
+
+	var images []string
+	if NewDisk(dir, time.Hour).Get("canonical:ubuntu", &images); len(images) != 2 {
+		t.Fatalf("expected value cached across instances, got %v", images)
+	}
+	if NewDisk(dir, time.Hour).Get("redhat:rhel", &images) {
+		t.Fatal("expected no value for other key")
+	}
+
+	now = now.Add(2 * time.Hour)
+	if err := c.Fetch("canonical:ubuntu", &images, fetch(&images)); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := fetches, 2; got != want {
+		t.Fatalf("expected expired value fetched again, got %d fetches", got)
+	}
+
+	if err := c.Fetch("failing", &images, func() error { return errors.New("throttled") }); err == nil {
+		t.Fatal("expected fetch error")
+	}
+	if c.Get("failing", &images) {
+		t.Fatal("expected failed fetch not cached")
+	}
+
+	disabled := NewDisk(dir, 0)
+	if disabled.Get("canonical:ubuntu", &images) {
+		t.Fatal("expected disabled cache")
+	}
+
+	if err := c.Clear(); err != nil {
+		t.Fatal(err)
+	}
+	if c.Get("canonical:ubuntu", &images) {
+		t.Fatal("expected cleared cache")
+	}
+}
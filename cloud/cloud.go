@@ -46,6 +46,8 @@ const (
 	Snapshot         string = "snapshot"
 	NetworkInterface string = "networkinterface"
 	Certificate      string = "certificate"
+	//elasticsearch
+	ElasticsearchDomain string = "elasticsearchdomain"
 	//loadbalancer
 	ClassicLoadBalancer string = "classicloadbalancer"
 	LoadBalancer        string = "loadbalancer"
@@ -71,6 +73,8 @@ const (
 	Topic        string = "topic"
 	//queue
 	Queue string = "queue"
+	//email
+	EmailIdentity string = "emailidentity"
 	//dns
 	Zone   string = "zone"
 	Record string = "record"
@@ -140,7 +144,7 @@ func GetServiceForType(t string) (Service, error) {
 }
 
 func PluralizeResource(singular string) string {
-	if strings.HasSuffix(singular, "cy") || strings.HasSuffix(singular, "ry") {
+	if strings.HasSuffix(singular, "cy") || strings.HasSuffix(singular, "ry") || strings.HasSuffix(singular, "ty") {
 		return strings.TrimSuffix(singular, "y") + "ies"
 	}
 	return singular + "s"
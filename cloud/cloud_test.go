@@ -33,6 +33,7 @@ func TestResourceTypePluralizeName(t *testing.T) {
 		{in: "internetgateway", out: "internetgateways"},
 		{in: "repository", out: "repositories"},
 		{in: "registry", out: "registries"},
+		{in: "emailidentity", out: "emailidentities"},
 	}
 	for _, tc := range tcases {
 		if got, want := PluralizeResource(tc.in), tc.out; got != want {
@@ -41,6 +41,7 @@ const (
 	Attached                          = "Attached"
 	AttachedAt                        = "AttachedAt"
 	Attachment                        = "Attachment"
+	AttachmentCount                   = "AttachmentCount"
 	Attributes                        = "Attributes"
 	AutoUpgrade                       = "AutoUpgrade"
 	AvailabilityZone                  = "AvailabilityZone"
@@ -84,6 +85,7 @@ const (
 	Deployments                       = "Deployments"
 	Description                       = "Description"
 	DesiredCapacity                   = "DesiredCapacity"
+	DesiredTasksCount                 = "DesiredTasksCount"
 	Dimensions                        = "Dimensions"
 	DisableRollback                   = "DisableRollback"
 	DockerVersion                     = "DockerVersion"
@@ -114,6 +116,7 @@ const (
 	InlinePolicies                    = "InlinePolicies"
 	Instance                          = "Instance"
 	InstanceOwner                     = "InstanceOwner"
+	InstanceProfiles                  = "InstanceProfiles"
 	Instances                         = "Instances"
 	InsufficientDataActions           = "InsufficientDataActions"
 	IOPS                              = "IOPS"
@@ -123,6 +126,7 @@ const (
 	Key                               = "Key"
 	KeyName                           = "KeyName"
 	KeyPair                           = "KeyPair"
+	LastAccessed                      = "LastAccessed"
 	LatestRestorableTime              = "LatestRestorableTime"
 	LaunchConfigurationName           = "LaunchConfigurationName"
 	Launched                          = "Launched"
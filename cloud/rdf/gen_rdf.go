@@ -43,6 +43,7 @@ const (
 	Attached                          = "cloud:attached"
 	AttachedAt                        = "cloud:attachedAt"
 	Attachment                        = "cloud:attachment"
+	AttachmentCount                   = "cloud:attachmentCount"
 	Attributes                        = "cloud:attributes"
 	AutoUpgrade                       = "cloud:autoUpgrade"
 	AvailabilityZone                  = "cloud:availabilityZone"
@@ -86,6 +87,7 @@ const (
 	Deployments                       = "cloud:deployments"
 	Description                       = "cloud:description"
 	DesiredCapacity                   = "cloud:desiredCapacity"
+	DesiredTasksCount                 = "cloud:desiredTasksCount"
 	Dimensions                        = "cloud:dimensions"
 	DisableRollback                   = "cloud:disableRollback"
 	DockerVersion                     = "cloud:dockerVersion"
@@ -116,6 +118,7 @@ const (
 	InlinePolicies                    = "cloud:inlinePolicies"
 	Instance                          = "cloud:instance"
 	InstanceOwner                     = "cloud:instanceOwner"
+	InstanceProfiles                  = "cloud:instanceProfiles"
 	Instances                         = "cloud:instances"
 	InsufficientDataActions           = "cloud:insufficientDataActions"
 	IOPS                              = "cloud:iops"
@@ -125,6 +128,7 @@ const (
 	Key                               = "cloud:key"
 	KeyName                           = "cloud:keyName"
 	KeyPair                           = "cloud:keyPair"
+	LastAccessed                      = "cloud:lastAccessed"
 	LatestRestorableTime              = "cloud:latestRestorableTime"
 	LaunchConfigurationName           = "cloud:launchConfigurationName"
 	Launched                          = "cloud:launched"
@@ -257,6 +261,7 @@ func init() {
 		properties.Attached:                          Attached,
 		properties.AttachedAt:                        AttachedAt,
 		properties.Attachment:                        Attachment,
+		properties.AttachmentCount:                   AttachmentCount,
 		properties.Attributes:                        Attributes,
 		properties.AutoUpgrade:                       AutoUpgrade,
 		properties.AvailabilityZone:                  AvailabilityZone,
@@ -300,6 +305,7 @@ func init() {
 		properties.Deployments:                       Deployments,
 		properties.Description:                       Description,
 		properties.DesiredCapacity:                   DesiredCapacity,
+		properties.DesiredTasksCount:                 DesiredTasksCount,
 		properties.Dimensions:                        Dimensions,
 		properties.DisableRollback:                   DisableRollback,
 		properties.DockerVersion:                     DockerVersion,
@@ -330,6 +336,7 @@ func init() {
 		properties.InlinePolicies:                    InlinePolicies,
 		properties.Instance:                          Instance,
 		properties.InstanceOwner:                     InstanceOwner,
+		properties.InstanceProfiles:                  InstanceProfiles,
 		properties.Instances:                         Instances,
 		properties.InsufficientDataActions:           InsufficientDataActions,
 		properties.IOPS:                              IOPS,
@@ -339,6 +346,7 @@ func init() {
 		properties.Key:                               Key,
 		properties.KeyName:                           KeyName,
 		properties.KeyPair:                           KeyPair,
+		properties.LastAccessed:                      LastAccessed,
 		properties.LatestRestorableTime:              LatestRestorableTime,
 		properties.LaunchConfigurationName:           LaunchConfigurationName,
 		properties.Launched:                          Launched,
@@ -471,6 +479,7 @@ var Properties = RDFProperties{
 	Attached:                {ID: Attached, RdfType: "rdf:Property", RdfsLabel: "Attached", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
 	AttachedAt:              {ID: AttachedAt, RdfType: "rdf:Property", RdfsLabel: "AttachedAt", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:dateTime"},
 	Attachment:              {ID: Attachment, RdfType: "rdf:Property", RdfsLabel: "Attachment", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	AttachmentCount:         {ID: AttachmentCount, RdfType: "rdf:Property", RdfsLabel: "AttachmentCount", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	Attributes:              {ID: Attributes, RdfType: "rdf:Property", RdfsLabel: "Attributes", RdfsDefinedBy: "rdfs:list", RdfsDataType: "cloud-owl:KeyValue"},
 	AutoUpgrade:             {ID: AutoUpgrade, RdfType: "rdf:Property", RdfsLabel: "AutoUpgrade", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
 	AvailabilityZone:        {ID: AvailabilityZone, RdfType: "rdf:Property", RdfsLabel: "AvailabilityZone", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
@@ -514,6 +523,7 @@ var Properties = RDFProperties{
 	Deployments:             {ID: Deployments, RdfType: "rdf:Property", RdfsLabel: "Deployments", RdfsDefinedBy: "rdfs:list", RdfsDataType: "cloud-owl:KeyValue"},
 	Description:             {ID: Description, RdfType: "rdf:Property", RdfsLabel: "Description", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	DesiredCapacity:         {ID: DesiredCapacity, RdfType: "rdf:Property", RdfsLabel: "DesiredCapacity", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	DesiredTasksCount:       {ID: DesiredTasksCount, RdfType: "rdf:Property", RdfsLabel: "DesiredTasksCount", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	Dimensions:              {ID: Dimensions, RdfType: "rdf:Property", RdfsLabel: "Dimensions", RdfsDefinedBy: "rdfs:list", RdfsDataType: "cloud-owl:KeyValue"},
 	DisableRollback:         {ID: DisableRollback, RdfType: "rdf:Property", RdfsLabel: "DisableRollback", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
 	DockerVersion:           {ID: DockerVersion, RdfType: "rdf:Property", RdfsLabel: "DockerVersion", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
@@ -544,6 +554,7 @@ var Properties = RDFProperties{
 	InlinePolicies:          {ID: InlinePolicies, RdfType: "rdf:Property", RdfsLabel: "InlinePolicies", RdfsDefinedBy: "rdfs:list", RdfsDataType: "rdfs:Class"},
 	Instance:                {ID: Instance, RdfType: "rdf:Property", RdfsLabel: "Instance", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
 	InstanceOwner:           {ID: InstanceOwner, RdfType: "rdf:Property", RdfsLabel: "InstanceOwner", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	InstanceProfiles:        {ID: InstanceProfiles, RdfType: "rdf:Property", RdfsLabel: "InstanceProfiles", RdfsDefinedBy: "rdfs:list", RdfsDataType: "rdfs:Class"},
 	Instances:               {ID: Instances, RdfType: "rdf:Property", RdfsLabel: "Instances", RdfsDefinedBy: "rdfs:list", RdfsDataType: "rdfs:Class"},
 	InsufficientDataActions: {ID: InsufficientDataActions, RdfType: "rdf:Property", RdfsLabel: "InsufficientDataActions", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
 	IOPS:                     {ID: IOPS, RdfType: "rdf:Property", RdfsLabel: "IOPS", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
@@ -553,6 +564,7 @@ var Properties = RDFProperties{
 	Key:                      {ID: Key, RdfType: "rdf:Property", RdfsLabel: "Key", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	KeyName:                  {ID: KeyName, RdfType: "rdf:Property", RdfsLabel: "KeyName", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	KeyPair:                  {ID: KeyPair, RdfType: "rdf:Property", RdfsLabel: "KeyPair", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
+	LastAccessed:             {ID: LastAccessed, RdfType: "rdf:Property", RdfsLabel: "LastAccessed", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:dateTime"},
 	LatestRestorableTime:     {ID: LatestRestorableTime, RdfType: "rdf:Property", RdfsLabel: "LatestRestorableTime", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:dateTime"},
 	LaunchConfigurationName:  {ID: LaunchConfigurationName, RdfType: "rdf:Property", RdfsLabel: "LaunchConfigurationName", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Launched:                 {ID: Launched, RdfType: "rdf:Property", RdfsLabel: "Launched", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:dateTime"},
@@ -0,0 +1,102 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"crypto/rand"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/oklog/ulid"
+	"github.com/wallix/awless/audit"
+	"github.com/wallix/awless/aws/spec"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template"
+)
+
+const auditS3Prefix = "awless-audit"
+
+func auditShippers() (shippers []audit.Shipper) {
+	bucket, group := config.GetAuditS3Bucket(), config.GetAuditLogGroup()
+	if bucket == "" && group == "" {
+		return
+	}
+	factory, ok := awsspec.CommandFactory.(*awsspec.AWSFactory)
+	if !ok || factory.Sess == nil {
+		logger.Warning("audit: no AWS session available to ship records")
+		return
+	}
+	if bucket != "" {
+		shippers = append(shippers, audit.NewS3Shipper(s3.New(factory.Sess), bucket, auditS3Prefix))
+	}
+	if group != "" {
+		shippers = append(shippers, audit.NewCloudWatchLogsShipper(cloudwatchlogs.New(factory.Sess), group, auditStreamName()))
+	}
+	return
+}
+
+func auditStreamName() string {
+	host, err := os.Hostname()
+	if err != nil || host == "" {
+		return "awless"
+	}
+	return "awless-" + host
+}
+
+func shipAuditRecord(r *audit.Record) {
+	shippers := auditShippers()
+	if len(shippers) == 0 {
+		return
+	}
+	r.Host, _ = os.Hostname()
+	r.Profile = config.GetAWSProfile()
+	r.Region = config.GetAWSRegion()
+	for _, s := range shippers {
+		if err := s.Ship(r); err != nil {
+			logger.Warningf("audit: cannot ship %s record: %s", r.Type, err)
+		}
+	}
+}
+
+func auditRun(tplExec *template.TemplateExecution, duration time.Duration) {
+	shipAuditRecord(&audit.Record{
+		Type:     audit.RunRecord,
+		ID:       tplExec.ID,
+		Date:     time.Now(),
+		Author:   tplExec.Author,
+		Duration: duration.String(),
+		Data:     tplExec,
+	})
+}
+
+func auditSync(services []cloud.Service, duration time.Duration, syncErr error) {
+	data := map[string]interface{}{"services": cloud.Services(services).Names()}
+	if syncErr != nil {
+		data["error"] = syncErr.Error()
+	}
+	shipAuditRecord(&audit.Record{
+		Type:     audit.SyncRecord,
+		ID:       ulid.MustNew(ulid.Timestamp(time.Now()), rand.Reader).String(),
+		Date:     time.Now(),
+		Duration: duration.String(),
+		Data:     data,
+	})
+}
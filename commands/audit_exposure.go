@@ -0,0 +1,77 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/wallix/awless/aws/services"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/inspect/inspectors"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/sync"
+)
+
+var (
+	exposureProbeFlag        bool
+	exposureProbeTimeoutFlag time.Duration
+)
+
+func init() {
+	RootCmd.AddCommand(auditCmd)
+	auditCmd.AddCommand(auditExposureCmd)
+
+	auditExposureCmd.Flags().BoolVar(&exposureProbeFlag, "probe", false, "Verify exposed TCP ports with an actual connection from this host")
+	auditExposureCmd.Flags().DurationVar(&exposureProbeTimeoutFlag, "probe-timeout", 2*time.Second, "Timeout of each TCP probe")
+}
+
+var auditCmd = &cobra.Command{
+	Use:               "audit",
+	Short:             "Audit your infrastructure security",
+	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, initSyncerHook, firstInstallDoneHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook, apiCallsHook),
+}
+
+var auditExposureCmd = &cobra.Command{
+	Use:     "exposure",
+	Short:   "List the ports of instances reachable from the internet, cross-referencing security groups and public IPs",
+	Example: "  awless audit exposure\n  awless audit exposure --probe\n  awless audit exposure --local",
+
+	RunE: func(c *cobra.Command, args []string) error {
+		if !localGlobalFlag {
+			if _, err := sync.DefaultSyncer.Sync(awsservices.InfraService); err != nil {
+				logger.Verbose(err)
+			}
+		}
+
+		g, err := sync.LoadLocalGraphs(config.GetAWSProfile(), config.GetAWSRegion())
+		exitOn(err)
+
+		exposure := &inspectors.Exposure{}
+		exitOn(exposure.Inspect(g))
+
+		if exposureProbeFlag {
+			logger.Infof("probing %d exposed port ranges", len(exposure.Exposed))
+			exposure.Probe(exposureProbeTimeoutFlag)
+		}
+
+		exposure.Print(os.Stdout)
+		return nil
+	},
+}
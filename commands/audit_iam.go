@@ -0,0 +1,67 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/wallix/awless/aws/services"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/inspect/inspectors"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/sync"
+)
+
+var unusedIAMDaysFlag int
+
+func init() {
+	auditCmd.AddCommand(auditIAMCmd)
+
+	auditIAMCmd.Flags().IntVar(&unusedIAMDaysFlag, "days", inspectors.DefaultUnusedIAMDays, "Flag users, roles and policies not used for this number of days")
+}
+
+var auditIAMCmd = &cobra.Command{
+	Use:     "iam",
+	Short:   "List the IAM users, roles and customer managed policies not used for a while, from the last accessed data of Access Advisor",
+	Example: "  awless audit iam\n  awless audit iam --days 30\n  awless audit iam --local",
+
+	RunE: func(c *cobra.Command, args []string) error {
+		if unusedIAMDaysFlag <= 0 {
+			return fmt.Errorf("invalid --days %d: expecting a positive number of days", unusedIAMDaysFlag)
+		}
+
+		var g cloud.GraphAPI
+		var err error
+		if localGlobalFlag {
+			g, err = sync.LoadLocalGraphs(config.GetAWSProfile(), config.GetAWSRegion())
+		} else {
+			logger.Info("generating Access Advisor reports of IAM users, roles and policies (this may take a while)")
+			g, err = awsservices.AccessService.Fetch(context.WithValue(context.Background(), "lastaccessed", true))
+		}
+		exitOn(err)
+
+		unused := &inspectors.UnusedIAM{Days: unusedIAMDaysFlag}
+		exitOn(unused.Inspect(g))
+
+		unused.Print(os.Stdout)
+		return nil
+	},
+}
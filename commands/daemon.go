@@ -0,0 +1,98 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/wallix/awless/aws/services"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/metrics"
+	"github.com/wallix/awless/sync"
+)
+
+var (
+	daemonListenFlag   string
+	daemonIntervalFlag time.Duration
+)
+
+func init() {
+	RootCmd.AddCommand(daemonCmd)
+	daemonCmd.Flags().StringVar(&daemonListenFlag, "listen", ":9100", "Address serving the Prometheus metrics on /metrics")
+	daemonCmd.Flags().DurationVar(&daemonIntervalFlag, "interval", 15*time.Minute, "Interval between syncs")
+}
+
+var daemonCmd = &cobra.Command{
+	Use:              "daemon",
+	Short:            "Sync periodically and expose Prometheus metrics of the inventory (instances by state and type, unattached volumes, syncs, API calls)",
+	Example:          "  awless daemon\n  awless daemon --listen :9200 --interval 5m",
+	PersistentPreRun: applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, firstInstallDoneHook),
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if daemonIntervalFlag < time.Minute {
+			return errors.New("interval must be at least 1m")
+		}
+		profile, region := config.GetAWSProfile(), config.GetAWSRegion()
+		collector := metrics.NewCollector(profile, region, awsservices.DefaultAPIStats)
+		if g, err := sync.LoadLocalGraphs(profile, region); err == nil {
+			collector.SetGraph(g)
+		}
+
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", collector)
+		errc := make(chan error, 1)
+		go func() { errc <- http.ListenAndServe(daemonListenFlag, mux) }()
+		logger.Infof("serving metrics on %s/metrics, syncing '%s' region every %s", daemonListenFlag, region, daemonIntervalFlag)
+
+		var services []cloud.Service
+		for _, srv := range cloud.ServiceRegistry {
+			services = append(services, srv)
+		}
+		syncer := sync.NewSyncerWithProgress(func(ss *sync.ServiceSync) {
+			collector.RecordSync(ss.Service.Name(), ss.Duration, ss.Err)
+			if ss.Err != nil {
+				logger.Warningf("sync of %s failed: %s", ss.Service.Name(), ss.Err)
+			}
+		}, logger.DefaultLogger)
+
+		ticker := time.NewTicker(daemonIntervalFlag)
+		defer ticker.Stop()
+		for {
+			start := time.Now()
+			_, syncErr := syncer.Sync(services...)
+			logger.Verbosef("sync took %s", time.Since(start))
+			auditSync(services, time.Since(start), syncErr)
+			if g, err := sync.LoadLocalGraphs(profile, region); err != nil {
+				logger.Warningf("cannot load synced resources: %s", err)
+			} else {
+				collector.SetGraph(g)
+			}
+			pushSyncSnapshot()
+
+			select {
+			case err := <-errc:
+				return err
+			case <-ticker.C:
+			}
+		}
+	},
+}
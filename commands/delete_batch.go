@@ -0,0 +1,297 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/wallix/awless/aws/services"
+	"github.com/wallix/awless/aws/spec"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/match"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/console"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/sync"
+	"github.com/wallix/awless/template"
+	"github.com/wallix/awless/template/params"
+)
+
+var (
+	batchDeleteFiltersFlag     []string
+	batchDeleteOlderThanFlag   string
+	batchDeleteConcurrencyFlag int
+	batchDeleteRetryFlag       int
+)
+
+// addBatchDeleteCommands adds to the delete command a plural subcommand (ex: `awless delete instances`)
+// for each resource type of the graph that can be deleted given only its id, name or arn
+func addBatchDeleteCommands(deleteCmd *cobra.Command, entities []string) {
+	isEntity := make(map[string]bool)
+	for _, entity := range entities {
+		isEntity[entity] = true
+	}
+	for _, entity := range entities {
+		if _, ok := awsservices.ServicePerResourceType[entity]; !ok || isEntity[cloud.PluralizeResource(entity)] {
+			continue
+		}
+		def, ok := awsspec.AWSLookupDefinitions("delete" + entity)
+		if !ok {
+			continue
+		}
+		key := batchDeleteParamKey(def)
+		if key == "" {
+			continue
+		}
+		deleteCmd.AddCommand(batchDeleteCmd(entity, key))
+	}
+}
+
+// batchDeleteParamKey returns the single param identifying the resource to delete
+func batchDeleteParamKey(def awsspec.Definition) string {
+	for _, key := range []string{"id", "name", "arn"} {
+		if err := params.Run(def.Params, []string{key}); err == nil {
+			return key
+		}
+	}
+	return ""
+}
+
+func batchDeleteCmd(entity, paramKey string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               cloud.PluralizeResource(entity),
+		Short:             fmt.Sprintf("Delete all the %s matching filters, after confirmation", cloud.PluralizeResource(entity)),
+		Example:           fmt.Sprintf("  awless delete %[1]s --filter tag:env=test --older-than 7d\n  awless delete %[1]s --filter state=stopped --local --concurrency 10", cloud.PluralizeResource(entity)),
+		PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, initSyncerHook, firstInstallDoneHook),
+		PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook, apiCallsHook),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				return fmt.Errorf("invalid arguments '%s': use --filter", strings.Join(args, " "))
+			}
+			filters, err := parseBatchDeleteFilters(batchDeleteFiltersFlag)
+			exitOn(validationErr(err))
+			var olderThan time.Duration
+			if batchDeleteOlderThanFlag != "" {
+				olderThan, err = parseAgeDuration(batchDeleteOlderThanFlag)
+				exitOn(validationErr(err))
+			}
+			if len(filters) == 0 && olderThan == 0 {
+				exitOn(validationErr(errors.New("at least one --filter or --older-than is required to delete resources in bulk")))
+			}
+
+			g, err := batchDeleteGraph(entity)
+			exitOn(err)
+			resources, err := g.Find(cloud.NewQuery(entity))
+			exitOn(err)
+
+			targets := filterBatchDeleteTargets(resources, filters, olderThan, time.Now())
+			var protected []cloud.Resource
+			targets, protected = excludeProtectedResources(targets, config.GetProtectedTags())
+			for _, res := range protected {
+				logger.Warningf("skipping %s %s: protected by its tags", entity, res.Id())
+			}
+			if len(targets) == 0 {
+				logger.Infof("no %s to delete", cloud.PluralizeResource(entity))
+				return nil
+			}
+
+			printBatchDeleteTargets(os.Stdout, entity, targets)
+
+			tpl, err := template.Parse(batchDeleteTemplateText(entity, paramKey, targets, batchDeleteRetryFlag))
+			exitOn(err)
+
+			runner := NewRunner(tpl, fmt.Sprintf("Delete %d %s", len(targets), cloud.PluralizeResource(entity)), "")
+			runner.Concurrency = batchDeleteConcurrencyFlag
+			runner.ConcurrencyControl = bulkConcurrencyControl(batchDeleteConcurrencyFlag)
+			exitOn(runner.Run())
+			return nil
+		},
+	}
+	cmd.Flags().StringSliceVar(&batchDeleteFiltersFlag, "filter", []string{}, "Select the resources to delete given property values (case insensitive) or tags (tag:key=value). Ex: --filter state=stopped --filter tag:env=test")
+	cmd.Flags().StringVar(&batchDeleteOlderThanFlag, "older-than", "", "Select only the resources created or launched before this duration. Ex: 12h, 7d")
+	cmd.Flags().IntVar(&batchDeleteConcurrencyFlag, "concurrency", 5, "Number of deletions run concurrently, bounded per AWS service with `awless config set aws.api.concurrency ec2=5`")
+	cmd.Flags().IntVar(&batchDeleteRetryFlag, "retry", 2, "Number of retries of a failed deletion")
+	return cmd
+}
+
+// bulkConcurrencyControl bounds per AWS service (see aws.api.concurrency) the commands of bulk operations
+// in flight, defaultMax bounding the services not configured. The bounds are lowered on throttling.
+func bulkConcurrencyControl(defaultMax int) *template.ConcurrencyControl {
+	return template.NewConcurrencyControl(config.GetAPIConcurrency(), defaultMax,
+		func(action, entity string) string { return awsspec.APIPerTemplateDefName[action+entity] },
+		func(err error) bool { return awsspec.ClassifyError(err) == awsspec.ThrottlingError },
+	)
+}
+
+func batchDeleteGraph(entity string) (cloud.GraphAPI, error) {
+	if localGlobalFlag {
+		srvName := awsservices.ServicePerResourceType[entity]
+		logLocalSyncAge(srvName)
+		return sync.LoadLocalGraphForService(srvName, config.GetAWSProfile(), config.GetAWSRegion()), nil
+	}
+	srv, err := cloud.GetServiceForType(entity)
+	if err != nil {
+		return nil, err
+	}
+	return srv.FetchByType(context.WithValue(context.Background(), "force", true), entity)
+}
+
+type batchDeleteFilter struct {
+	tag        bool
+	key, value string
+}
+
+// parseBatchDeleteFilters parses filters as key=value for properties and tag:key=value for tags
+func parseBatchDeleteFilters(flags []string) ([]batchDeleteFilter, error) {
+	var filters []batchDeleteFilter
+	for _, f := range flags {
+		splits := strings.SplitN(f, "=", 2)
+		if len(splits) != 2 || strings.TrimSpace(splits[0]) == "" {
+			return nil, fmt.Errorf("invalid filter '%s': expecting key=value or tag:key=value", f)
+		}
+		filter := batchDeleteFilter{key: strings.TrimSpace(splits[0]), value: strings.TrimSpace(splits[1])}
+		if strings.HasPrefix(strings.ToLower(filter.key), "tag:") {
+			filter.tag, filter.key = true, filter.key[len("tag:"):]
+		}
+		filters = append(filters, filter)
+	}
+	return filters, nil
+}
+
+func (f batchDeleteFilter) match(res cloud.Resource) bool {
+	if f.tag {
+		return match.Tag(f.key, f.value).Match(res)
+	}
+	for k, v := range res.Properties() {
+		if strings.EqualFold(k, f.key) {
+			return strings.EqualFold(fmt.Sprint(v), f.value)
+		}
+	}
+	return false
+}
+
+// parseAgeDuration parses Go durations, also accepting days (ex: 7d)
+func parseAgeDuration(s string) (time.Duration, error) {
+	if strings.HasSuffix(s, "d") {
+		days, err := strconv.Atoi(strings.TrimSuffix(s, "d"))
+		if err != nil || days < 0 {
+			return 0, fmt.Errorf("invalid duration '%s'", s)
+		}
+		return time.Duration(days) * 24 * time.Hour, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid duration '%s'", s)
+	}
+	return d, nil
+}
+
+// filterBatchDeleteTargets returns the resources matching all the filters, sorted by id.
+// Resources without creation date are excluded when filtering on age.
+func filterBatchDeleteTargets(resources []cloud.Resource, filters []batchDeleteFilter, olderThan time.Duration, now time.Time) (targets []cloud.Resource) {
+	for _, res := range resources {
+		matching := true
+		for _, f := range filters {
+			if !f.match(res) {
+				matching = false
+				break
+			}
+		}
+		if !matching {
+			continue
+		}
+		if olderThan > 0 {
+			created, ok := resourceCreation(res)
+			if !ok || now.Sub(created) < olderThan {
+				continue
+			}
+		}
+		targets = append(targets, res)
+	}
+	sort.Slice(targets, func(i, j int) bool { return targets[i].Id() < targets[j].Id() })
+	return
+}
+
+func resourceCreation(res cloud.Resource) (time.Time, bool) {
+	for _, prop := range []string{properties.Created, properties.Launched} {
+		if t, ok := res.Properties()[prop].(time.Time); ok && !t.IsZero() {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func excludeProtectedResources(resources []cloud.Resource, protectedTags []string) (kept, protected []cloud.Resource) {
+	for _, res := range resources {
+		if isProtectedResource(res, protectedTags) {
+			protected = append(protected, res)
+		} else {
+			kept = append(kept, res)
+		}
+	}
+	return
+}
+
+func printBatchDeleteTargets(w io.Writer, entity string, targets []cloud.Resource) {
+	fmt.Fprintf(w, "About to delete %d %s:\n", len(targets), cloud.PluralizeResource(entity))
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	for _, res := range targets {
+		name, _ := res.Properties()[properties.Name].(string)
+		var age string
+		if created, ok := resourceCreation(res); ok {
+			age = console.HumanizeTime(created)
+		}
+		tags, _ := res.Properties()[properties.Tags].([]string)
+		fmt.Fprintf(tw, "\t%s\t%s\t%s\t%s\n", res.Id(), name, age, strings.Join(tags, ","))
+	}
+	tw.Flush()
+	fmt.Fprintln(w)
+}
+
+func batchDeleteTemplateText(entity, paramKey string, targets []cloud.Resource, retry int) string {
+	var lines []string
+	for _, res := range targets {
+		value := res.Id()
+		switch paramKey {
+		case "name":
+			if name, ok := res.Properties()[properties.Name].(string); ok && name != "" {
+				value = name
+			}
+		case "arn":
+			if arn, ok := res.Properties()[properties.Arn].(string); ok && arn != "" {
+				value = arn
+			}
+		}
+		line := fmt.Sprintf("delete %s %s=%s", entity, paramKey, template.QuoteParamIfNeeded(value))
+		if retry > 0 {
+			line += fmt.Sprintf(" retry=%d", retry)
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,144 @@
+package commands
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wallix/awless/aws/spec"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+)
+
+func TestBatchDelete(t *testing.T) {
+	now := time.Date(2017, 10, 16, 12, 0, 0, 0, time.UTC)
+	inst1 := graph.InitResource(cloud.Instance, "i-1")
+	inst1.Properties()[properties.Name] = "web 1"
+	inst1.Properties()[properties.State] = "stopped"
+	inst1.Properties()[properties.Tags] = []string{"env=test"}
+	inst1.Properties()[properties.Launched] = now.Add(-10 * 24 * time.Hour)
+	inst2 := graph.InitResource(cloud.Instance, "i-2")
+	inst2.Properties()[properties.State] = "running"
+	inst2.Properties()[properties.Tags] = []string{"env=test"}
+	inst2.Properties()[properties.Launched] = now.Add(-2 * 24 * time.Hour)
+	inst3 := graph.InitResource(cloud.Instance, "i-3")
+	inst3.Properties()[properties.State] = "stopped"
+	inst3.Properties()[properties.Tags] = []string{"env=prod", "Protected=true"}
+	inst4 := graph.InitResource(cloud.Instance, "i-4")
+	inst4.Properties()[properties.Tags] = []string{"env=test"}
+	all := []cloud.Resource{inst4, inst3, inst2, inst1}
+
+	t.Run("parse age", func(t *testing.T) {
+		tcases := []struct {
+			in  string
+			exp time.Duration
+		}{
+			{"7d", 7 * 24 * time.Hour},
+			{"0d", 0},
+			{"12h", 12 * time.Hour},
+			{"90m", 90 * time.Minute},
+		}
+		for _, tcase := range tcases {
+			d, err := parseAgeDuration(tcase.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, want := d, tcase.exp; got != want {
+				t.Fatalf("%s: got %s, want %s", tcase.in, got, want)
+			}
+		}
+		for _, in := range []string{"", "d", "-1d", "7days", "week", "-2h"} {
+			if _, err := parseAgeDuration(in); err == nil {
+				t.Fatalf("%s: expected error", in)
+			}
+		}
+	})
+
+	t.Run("parse filters", func(t *testing.T) {
+		filters, err := parseBatchDeleteFilters([]string{"state=stopped", "tag:env=test", "Tag:Team=a=b"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		exp := []batchDeleteFilter{{key: "state", value: "stopped"}, {tag: true, key: "env", value: "test"}, {tag: true, key: "Team", value: "a=b"}}
+		if len(filters) != len(exp) {
+			t.Fatalf("got %v, want %v", filters, exp)
+		}
+		for i := range exp {
+			if filters[i] != exp[i] {
+				t.Fatalf("%d: got %v, want %v", i+1, filters[i], exp[i])
+			}
+		}
+		for _, in := range []string{"state", "=stopped"} {
+			if _, err := parseBatchDeleteFilters([]string{in}); err == nil {
+				t.Fatalf("%s: expected error", in)
+			}
+		}
+	})
+
+	t.Run("filter targets", func(t *testing.T) {
+		tcases := []struct {
+			filters   []string
+			olderThan time.Duration
+			exp       []string
+		}{
+			{filters: []string{"tag:env=test"}, exp: []string{"i-1", "i-2", "i-4"}},
+			{filters: []string{"tag:env=test", "STATE=Stopped"}, exp: []string{"i-1"}},
+			{filters: []string{"state=stopped"}, exp: []string{"i-1", "i-3"}},
+			{filters: []string{"tag:env=*"}, olderThan: 7 * 24 * time.Hour, exp: []string{"i-1"}},
+			{olderThan: 24 * time.Hour, exp: []string{"i-1", "i-2"}},
+			{filters: []string{"unknown=value"}},
+		}
+		for i, tcase := range tcases {
+			filters, err := parseBatchDeleteFilters(tcase.filters)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, res := range filterBatchDeleteTargets(all, filters, tcase.olderThan, now) {
+				got = append(got, res.Id())
+			}
+			if len(got) != len(tcase.exp) {
+				t.Fatalf("%d: got %v, want %v", i+1, got, tcase.exp)
+			}
+			for j := range got {
+				if got[j] != tcase.exp[j] {
+					t.Fatalf("%d: got %v, want %v", i+1, got, tcase.exp)
+				}
+			}
+		}
+	})
+
+	t.Run("exclude protected", func(t *testing.T) {
+		kept, protected := excludeProtectedResources(all, []string{"Protected"})
+		if got, want := len(kept), 3; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+		if len(protected) != 1 || protected[0].Id() != "i-3" {
+			t.Fatalf("got %v", protected)
+		}
+	})
+
+	t.Run("template", func(t *testing.T) {
+		text := batchDeleteTemplateText(cloud.Instance, "id", []cloud.Resource{inst1, inst2}, 2)
+		if got, want := text, "delete instance id=i-1 retry=2\ndelete instance id=i-2 retry=2"; got != want {
+			t.Fatalf("got %q, want %q", got, want)
+		}
+		text = batchDeleteTemplateText(cloud.Instance, "name", []cloud.Resource{inst1, inst2}, 0)
+		if got, want := text, "delete instance name='web 1'\ndelete instance name=i-2"; got != want {
+			t.Fatalf("got %q, want %q", got, want)
+		}
+	})
+
+	t.Run("param key", func(t *testing.T) {
+		tcases := map[string]string{"instance": "id", "keypair": "name", "policy": "arn", "s3object": ""}
+		for entity, exp := range tcases {
+			def, ok := awsspec.AWSLookupDefinitions("delete" + entity)
+			if !ok {
+				t.Fatalf("no definition for delete %s", entity)
+			}
+			if got, want := batchDeleteParamKey(def), exp; got != want {
+				t.Fatalf("%s: got %q, want %q", entity, got, want)
+			}
+		}
+	})
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/wallix/awless/aws/services"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/match"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/cloud/rdf"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/console"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/prompt"
+	"github.com/wallix/awless/sync"
+	"github.com/wallix/awless/template"
+)
+
+// deleteOneLinerTarget returns the locally synced resource targeted by a `awless delete ...` one-liner, if any
+func deleteOneLinerTarget(tpl *template.Template) (cloud.Resource, cloud.GraphAPI) {
+	cmds := tpl.CommandNodesIterator()
+	if len(cmds) != 1 || cmds[0].Action != "delete" {
+		return nil, nil
+	}
+	entity := cmds[0].Entity
+	srvName, ok := awsservices.ServicePerResourceType[entity]
+	if !ok {
+		return nil, nil
+	}
+	g := sync.LoadLocalGraphForService(srvName, config.GetAWSProfile(), config.GetAWSRegion())
+	res, err := resolveDeleteTarget(g, entity, cmds[0].ToDriverParams())
+	if err != nil || res == nil {
+		return nil, nil
+	}
+	return res, g
+}
+
+func resolveDeleteTarget(g cloud.GraphAPI, entity string, params map[string]interface{}) (cloud.Resource, error) {
+	for _, key := range []string{"id", "name"} {
+		ref, ok := params[key].(string)
+		if !ok || ref == "" {
+			continue
+		}
+		resources, err := g.Find(cloud.NewQuery(entity).Match(match.Property(properties.ID, ref)))
+		if err != nil {
+			return nil, err
+		}
+		if len(resources) == 0 {
+			if resources, err = g.Find(cloud.NewQuery(entity).Match(match.Property(properties.Name, ref))); err != nil {
+				return nil, err
+			}
+		}
+		if len(resources) == 1 {
+			return resources[0], nil
+		}
+		return nil, nil
+	}
+	return nil, nil
+}
+
+func printDeleteSummary(w io.Writer, res cloud.Resource, g cloud.GraphAPI) error {
+	if name, _ := res.Properties()[properties.Name].(string); name != "" {
+		fmt.Fprintf(w, "About to delete %s %s (%s)\n", res.Type(), res.Id(), name)
+	} else {
+		fmt.Fprintf(w, "About to delete %s %s\n", res.Type(), res.Id())
+	}
+
+	if tags, ok := res.Properties()[properties.Tags].([]string); ok && len(tags) > 0 {
+		fmt.Fprintf(w, "\ttags: %s\n", strings.Join(tags, ", "))
+	}
+	for _, prop := range []string{properties.Created, properties.Launched} {
+		if t, ok := res.Properties()[prop].(time.Time); ok && !t.IsZero() {
+			fmt.Fprintf(w, "\tage: %s\n", console.HumanizeTime(t))
+			break
+		}
+	}
+
+	children, err := g.ResourceRelations(res, rdf.ChildrenOfRel, true)
+	if err != nil {
+		return err
+	}
+	appliedOn, err := g.ResourceRelations(res, rdf.ApplyOn, false)
+	if err != nil {
+		return err
+	}
+	if count := len(children) + len(appliedOn); count > 0 {
+		fmt.Fprintf(w, "\tdependents: %d (see `awless show %s`)\n", count, res.Id())
+	}
+	fmt.Fprintln(w)
+	return nil
+}
+
+// confirmDeleteOneLiner summarizes the resource deleted by a `awless delete ...` one-liner. Deleting a resource
+// protected by its tags always requires typing its name: --force only skips the regular confirmation.
+// It returns true when the deletion has been confirmed by typing the name.
+func confirmDeleteOneLiner(in io.Reader, out io.Writer, res cloud.Resource, g cloud.GraphAPI, protectedTags []string, force bool) (bool, error) {
+	protected := isProtectedResource(res, protectedTags)
+	if force && !protected {
+		return false, nil
+	}
+	if err := printDeleteSummary(out, res, g); err != nil {
+		logger.Verbosef("cannot summarize resource to delete: %s", err)
+	}
+	if !protected {
+		return false, nil
+	}
+	if err := confirmProtectedDelete(in, out, res); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// isProtectedResource returns true when one of the resource tags matches
+// a protected tag key (any value) or key=value pair (case insensitive)
+func isProtectedResource(res cloud.Resource, protectedTags []string) bool {
+	tags, _ := res.Properties()[properties.Tags].([]string)
+	for _, tag := range tags {
+		key := strings.SplitN(tag, "=", 2)[0]
+		for _, protected := range protectedTags {
+			if strings.Contains(protected, "=") {
+				if strings.EqualFold(tag, protected) {
+					return true
+				}
+			} else if strings.EqualFold(key, protected) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// confirmProtectedDelete requires typing the name (or the id if unnamed) of the resource to delete
+func confirmProtectedDelete(in io.Reader, out io.Writer, res cloud.Resource) error {
+	expected, _ := res.Properties()[properties.Name].(string)
+	if expected == "" {
+		expected = res.Id()
+	}
+	if err := prompt.Check(prompt.Confirmation, fmt.Sprintf("%s %s is protected by its tags: its deletion cannot be confirmed without input", res.Type(), res.Id())); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "%s is protected by its tags. Type its name '%s' to confirm (region: %s): ", res.Type(), expected, config.GetAWSRegion())
+	typed, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return err
+	}
+	if strings.TrimSpace(typed) != expected {
+		return fmt.Errorf("deletion of protected %s %s cancelled: typed name does not match", res.Type(), res.Id())
+	}
+	return nil
+}
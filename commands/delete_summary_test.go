@@ -0,0 +1,140 @@
+package commands
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/prompt"
+)
+
+func TestDeleteSummary(t *testing.T) {
+	g := graph.NewGraph()
+	vpc := graph.InitResource(cloud.Vpc, "vpc-1")
+	vpc.Properties()[properties.Name] = "prod-vpc"
+	vpc.Properties()[properties.Tags] = []string{"Name=prod-vpc", "Protected=true"}
+	other := graph.InitResource(cloud.Vpc, "vpc-2")
+	other.Properties()[properties.Tags] = []string{"Protected=false"}
+	sub1, sub2 := graph.InitResource(cloud.Subnet, "sub-1"), graph.InitResource(cloud.Subnet, "sub-2")
+	g.AddResource(vpc, other, sub1, sub2)
+	g.AddParentRelation(vpc, sub1)
+	g.AddParentRelation(vpc, sub2)
+
+	t.Run("resolve target", func(t *testing.T) {
+		for _, params := range []map[string]interface{}{{"id": "vpc-1"}, {"id": "prod-vpc"}, {"name": "prod-vpc"}} {
+			res, err := resolveDeleteTarget(g, cloud.Vpc, params)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res == nil || res.Id() != "vpc-1" {
+				t.Fatalf("%v: got %v, want vpc-1", params, res)
+			}
+		}
+		if res, _ := resolveDeleteTarget(g, cloud.Vpc, map[string]interface{}{"id": "vpc-unknown"}); res != nil {
+			t.Fatalf("got %v, want none", res)
+		}
+	})
+
+	t.Run("print summary", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := printDeleteSummary(&buf, vpc, g); err != nil {
+			t.Fatal(err)
+		}
+		for _, exp := range []string{"vpc vpc-1 (prod-vpc)", "tags: Name=prod-vpc, Protected=true", "dependents: 2"} {
+			if !strings.Contains(buf.String(), exp) {
+				t.Fatalf("expected '%s' in %s", exp, buf.String())
+			}
+		}
+	})
+
+	t.Run("protected", func(t *testing.T) {
+		tcases := []struct {
+			res       cloud.Resource
+			protected []string
+			exp       bool
+		}{
+			{res: vpc, protected: []string{"Protected=true"}, exp: true},
+			{res: vpc, protected: []string{"protected=TRUE"}, exp: true},
+			{res: other, protected: []string{"Protected=true"}, exp: false},
+			{res: other, protected: []string{"Env", "protected"}, exp: true},
+			{res: sub1, protected: []string{"Protected"}, exp: false},
+		}
+		for i, tcase := range tcases {
+			if got, want := isProtectedResource(tcase.res, tcase.protected), tcase.exp; got != want {
+				t.Fatalf("%d: got %t, want %t", i+1, got, want)
+			}
+		}
+	})
+
+	t.Run("confirm protected", func(t *testing.T) {
+		var out bytes.Buffer
+		if err := confirmProtectedDelete(strings.NewReader("prod-vpc\n"), &out, vpc); err != nil {
+			t.Fatal(err)
+		}
+		if err := confirmProtectedDelete(strings.NewReader("vpc-1\n"), &out, vpc); err == nil {
+			t.Fatal("expected error")
+		}
+		if err := confirmProtectedDelete(strings.NewReader("sub-1"), &out, sub1); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("confirm one-liner with force", func(t *testing.T) {
+		var out bytes.Buffer
+		confirmed, err := confirmDeleteOneLiner(strings.NewReader(""), &out, other, g, []string{"Protected=true"}, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if confirmed || out.Len() != 0 {
+			t.Fatalf("expected no confirmation for unprotected resource, got %t, %s", confirmed, out.String())
+		}
+
+		out.Reset()
+		if _, err = confirmDeleteOneLiner(strings.NewReader("\n"), &out, vpc, g, []string{"Protected=true"}, true); err == nil {
+			t.Fatal("expected error")
+		}
+		if !strings.Contains(out.String(), "Type its name 'prod-vpc' to confirm") {
+			t.Fatalf("expected typed name prompt, got %s", out.String())
+		}
+
+		out.Reset()
+		confirmed, err = confirmDeleteOneLiner(strings.NewReader("prod-vpc\n"), &out, vpc, g, []string{"Protected=true"}, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !confirmed {
+			t.Fatal("expected confirmation")
+		}
+		if !strings.Contains(out.String(), "About to delete vpc vpc-1 (prod-vpc)") {
+			t.Fatalf("expected summary, got %s", out.String())
+		}
+	})
+
+	t.Run("confirm one-liner with force without input", func(t *testing.T) {
+		defer func(disabled bool) { prompt.Disabled = disabled }(prompt.Disabled)
+		prompt.Disabled = true
+
+		var out bytes.Buffer
+		_, err := confirmDeleteOneLiner(strings.NewReader("prod-vpc\n"), &out, vpc, g, []string{"Protected=true"}, true)
+		if reason, ok := prompt.Reason(err); !ok || reason != prompt.Confirmation {
+			t.Fatalf("got %v", err)
+		}
+	})
+
+	t.Run("confirm protected without input", func(t *testing.T) {
+		defer func(disabled bool) { prompt.Disabled = disabled }(prompt.Disabled)
+		prompt.Disabled = true
+
+		var out bytes.Buffer
+		err := confirmProtectedDelete(strings.NewReader("prod-vpc\n"), &out, vpc)
+		if reason, ok := prompt.Reason(err); !ok || reason != prompt.Confirmation {
+			t.Fatalf("got %v", err)
+		}
+		if out.Len() != 0 {
+			t.Fatalf("expected no prompt, got %s", out.String())
+		}
+	})
+}
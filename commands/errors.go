@@ -21,11 +21,16 @@ import (
 	"os"
 
 	"github.com/fatih/color"
+	"github.com/wallix/awless/prompt"
 )
 
 func exitOn(err error) {
 	if err != nil {
 		fmt.Fprintln(os.Stderr, color.RedString("[error]  "), err)
-		os.Exit(1)
+		if reason, ok := prompt.Reason(err); ok {
+			prompt.WriteError(os.Stderr, reason, err)
+		}
+		releaseHeldRunLock()
+		os.Exit(ExitCode(err))
 	}
 }
@@ -0,0 +1,88 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/spf13/cobra"
+	"github.com/wallix/awless/aws/spec"
+	"github.com/wallix/awless/aws/tailers"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/sync"
+)
+
+var (
+	eventsFollowFlag    bool
+	eventsFrequencyFlag time.Duration
+	eventsNumberFlag    int
+)
+
+func init() {
+	RootCmd.AddCommand(eventsCmd)
+	eventsCmd.Flags().BoolVar(&eventsFollowFlag, "follow", false, "Periodically poll CloudTrail and print new changes")
+	eventsCmd.Flags().DurationVar(&eventsFrequencyFlag, "frequency", 30*time.Second, "Polling frequency when following")
+	eventsCmd.Flags().IntVarP(&eventsNumberFlag, "number", "n", 10, "Number of past events to display")
+}
+
+var eventsCmd = &cobra.Command{
+	Use:               "events",
+	Short:             "Stream the changes made to resources in the current region (from CloudTrail), resolving IDs to names with the local graph",
+	Example:           "  awless events\n  awless events --follow\n  awless events -n 50 --follow --frequency 1m",
+	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, firstInstallDoneHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook, networkMonitorHook, apiCallsHook),
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		factory, ok := awsspec.CommandFactory.(*awsspec.AWSFactory)
+		if !ok || factory.Sess == nil {
+			return errors.New("events: no AWS session available")
+		}
+
+		g, err := sync.LoadLocalGraphs(config.GetAWSProfile(), config.GetAWSRegion())
+		if err != nil {
+			logger.Verbosef("cannot load local resources to resolve names: %s", err)
+		}
+
+		tailer := awstailers.NewCloudTrailEventsTailer(cloudtrail.New(factory.Sess), resourceNameResolver(g), eventsNumberFlag, eventsFollowFlag, eventsFrequencyFlag)
+		if err := tailer.Tail(os.Stdout); err != nil {
+			return fmt.Errorf("events: %s", err)
+		}
+		return nil
+	},
+}
+
+func resourceNameResolver(g cloud.GraphAPI) func(string) string {
+	return func(id string) string {
+		if g == nil || id == "" {
+			return ""
+		}
+		resources, err := g.FindWithProperties(map[string]interface{}{properties.ID: id})
+		if err != nil || len(resources) != 1 {
+			return ""
+		}
+		name, _ := resources[0].Property(properties.Name)
+		s, _ := name.(string)
+		return s
+	}
+}
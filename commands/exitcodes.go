@@ -0,0 +1,103 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"strings"
+
+	"github.com/wallix/awless/aws/spec"
+	"github.com/wallix/awless/prompt"
+	"github.com/wallix/awless/template"
+)
+
+// Exit codes of awless, for scripts to branch on the type of failure
+const (
+	ExitFailure          = 1
+	ExitValidation       = 2
+	ExitInputRequired    = prompt.ExitCode
+	ExitDryRunFailure    = 4
+	ExitPartialExecution = 5
+	ExitPermissionDenied = 6
+	ExitThrottled        = 7
+	ExitTimeout          = 8
+	ExitAssertionFailure = 9
+)
+
+type validationError struct {
+	error
+}
+
+// validationErr marks an error as invalid input from the user (ex: template syntax error)
+func validationErr(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &validationError{err}
+}
+
+var usageErrorPrefixes = []string{"unknown flag", "unknown shorthand flag", "unknown command", "invalid argument", "flag needs an argument", "accepts ", "requires "}
+
+// ExitCode returns the exit code of awless for the given error
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	if _, ok := prompt.Reason(err); ok {
+		return ExitInputRequired
+	}
+	if _, ok := err.(*validationError); ok {
+		return ExitValidation
+	}
+
+	if runErr, ok := err.(*template.RunError); ok {
+		switch runErr.Failure {
+		case template.ValidationFailure:
+			return ExitValidation
+		case template.DryRunFailure:
+			return ExitDryRunFailure
+		case template.PartialExecutionFailure:
+			return ExitPartialExecution
+		case template.AssertionFailure:
+			return ExitAssertionFailure
+		}
+		for _, e := range runErr.Errs {
+			if code := awsErrorExitCode(e); code != ExitFailure {
+				return code
+			}
+		}
+		return ExitFailure
+	}
+
+	for _, prefix := range usageErrorPrefixes {
+		if strings.HasPrefix(err.Error(), prefix) {
+			return ExitValidation
+		}
+	}
+	return awsErrorExitCode(err)
+}
+
+func awsErrorExitCode(err error) int {
+	switch awsspec.ClassifyError(err) {
+	case awsspec.PermissionDeniedError:
+		return ExitPermissionDenied
+	case awsspec.ThrottlingError:
+		return ExitThrottled
+	case awsspec.TimeoutError:
+		return ExitTimeout
+	}
+	return ExitFailure
+}
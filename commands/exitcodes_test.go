@@ -0,0 +1,37 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/wallix/awless/prompt"
+	"github.com/wallix/awless/template"
+)
+
+func TestExitCode(t *testing.T) {
+	tcases := []struct {
+		err error
+		exp int
+	}{
+		{err: nil, exp: 0},
+		{err: errors.New("any error"), exp: ExitFailure},
+		{err: validationErr(errors.New("syntax error")), exp: ExitValidation},
+		{err: errors.New("unknown flag: --unknown"), exp: ExitValidation},
+		{err: fmt.Errorf("before run: %s", &prompt.InputRequiredError{Reason: prompt.Confirmation}), exp: ExitInputRequired},
+		{err: &template.RunError{Failure: template.ValidationFailure}, exp: ExitValidation},
+		{err: &template.RunError{Failure: template.DryRunFailure}, exp: ExitDryRunFailure},
+		{err: &template.RunError{Failure: template.AssertionFailure}, exp: ExitAssertionFailure},
+		{err: &template.RunError{Failure: template.PartialExecutionFailure, Errs: []error{errors.New("AccessDenied: not authorized")}}, exp: ExitPartialExecution},
+		{err: &template.RunError{Failure: template.ExecutionFailure, Errs: []error{errors.New("create vpc: AccessDenied: not authorized")}}, exp: ExitPermissionDenied},
+		{err: &template.RunError{Failure: template.ExecutionFailure, Errs: []error{errors.New("create vpc: Throttling: Rate exceeded")}}, exp: ExitThrottled},
+		{err: &template.RunError{Failure: template.ExecutionFailure, Errs: []error{errors.New("check instance: timeout of 2m0s expired")}}, exp: ExitTimeout},
+		{err: &template.RunError{Failure: template.ExecutionFailure, Errs: []error{errors.New("create vpc: InvalidParameterValue")}}, exp: ExitFailure},
+		{err: errors.New("AccessDenied: not authorized"), exp: ExitPermissionDenied},
+	}
+	for i, tcase := range tcases {
+		if got, want := ExitCode(tcase.err), tcase.exp; got != want {
+			t.Fatalf("%d: got %d, want %d", i+1, got, want)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/wallix/awless/template"
+)
+
+var runExportEnvFlag string
+
+var (
+	envVarInvalidCharsRegex = regexp.MustCompile(`[^A-Z0-9_]`)
+	envSafeValueRegex       = regexp.MustCompile(`^[a-zA-Z0-9-_.:/@%+=,]*$`)
+)
+
+// exportEnvFile writes the results of a run in an environment file (see writeEnv)
+func exportEnvFile(path string, tplExec *template.TemplateExecution) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	if err = writeEnv(f, tplExec); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// writeEnv writes as VAR=value lines the results of the commands assigned to a reference
+// (ex: INST=i-1234 for 'inst = create instance') then the resolved outputs of a run,
+// outputs prevailing over references with the same variable name
+func writeEnv(w io.Writer, tplExec *template.TemplateExecution) error {
+	vars := make(map[string]string)
+	for ref, result := range tplExec.Template.ReferencedResults() {
+		vars[envVarName(ref)] = envValue(result)
+	}
+	for name, output := range tplExec.ResolvedOutputs {
+		vars[envVarName(name)] = envValue(output)
+	}
+	var names []string
+	for name := range vars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if _, err := fmt.Fprintf(w, "%s=%s\n", name, vars[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// envVarName returns the shell variable name of a template reference or output (ex: my-vpc: MY_VPC)
+func envVarName(name string) string {
+	name = envVarInvalidCharsRegex.ReplaceAllString(strings.ToUpper(name), "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "_" + name
+	}
+	return name
+}
+
+// envValue returns the value single quoted when needed to be sourced by a shell, lists being comma separated
+func envValue(v interface{}) string {
+	var s string
+	switch vv := v.(type) {
+	case []string:
+		s = strings.Join(vv, ",")
+	case []interface{}:
+		var all []string
+		for _, e := range vv {
+			all = append(all, fmt.Sprint(e))
+		}
+		s = strings.Join(all, ",")
+	default:
+		s = fmt.Sprint(v)
+	}
+	if envSafeValueRegex.MatchString(s) {
+		return s
+	}
+	return "'" + strings.Replace(s, "'", `'\''`, -1) + "'"
+}
@@ -0,0 +1,59 @@
+package commands
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/wallix/awless/template"
+)
+
+func TestWriteEnv(t *testing.T) {
+	tpl := template.MustParse("inst = create instance\nmy-vpc = create vpc\n2nd = create subnet\ncreate keypair name=demo")
+	for i, cmd := range tpl.CommandNodesIterator() {
+		cmd.CmdResult = []string{"i-1234", "vpc-1234", "subnet-1234", "demo"}[i]
+	}
+	tplExec := &template.TemplateExecution{
+		Template: tpl,
+		ResolvedOutputs: map[string]interface{}{
+			"inst":    "overridden",
+			"dns":     "ec2-1-2-3-4.compute.amazonaws.com",
+			"message": "it's up",
+			"subnets": []interface{}{"subnet-1", "subnet-2"},
+		},
+	}
+
+	var buff bytes.Buffer
+	if err := writeEnv(&buff, tplExec); err != nil {
+		t.Fatal(err)
+	}
+	exp := `DNS=ec2-1-2-3-4.compute.amazonaws.com
+INST=overridden
+MESSAGE='it'\''s up'
+MY_VPC=vpc-1234
+SUBNETS=subnet-1,subnet-2
+_2ND=subnet-1234
+`
+	if got, want := buff.String(), exp; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+
+	dir, err := ioutil.TempDir("", "awless-export-env")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "out.env")
+	if err := exportEnvFile(path, tplExec); err != nil {
+		t.Fatal(err)
+	}
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(content), exp; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+}
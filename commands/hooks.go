@@ -18,16 +18,19 @@ package commands
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
 
-	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/spf13/cobra"
 	"github.com/wallix/awless/aws/services"
+	"github.com/wallix/awless/aws/spec"
+	"github.com/wallix/awless/cache"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/console"
 	"github.com/wallix/awless/database"
 	"github.com/wallix/awless/logger"
 	"github.com/wallix/awless/sync"
@@ -47,6 +50,10 @@ func initAwlessEnvHook(cmd *cobra.Command, args []string) error {
 	if err := config.InitAwlessEnv(); err != nil {
 		return fmt.Errorf("cannot init awless environment: %s", err)
 	}
+	console.CurrentTheme = config.GetDisplayTheme()
+	if err := console.LoadDisplayProfiles(config.DisplayProfiles); err != nil {
+		logger.Warningf("cannot load display profiles: %s", err)
+	}
 
 	return applyRegionAndProfilePrecedence()
 }
@@ -91,6 +98,11 @@ func applyRegionAndProfilePrecedence() error {
 			return err
 		}
 		regionOverridenThrough = "command flag"
+	} else if envRegion := os.Getenv("AWLESS_REGION"); envRegion != "" {
+		if err := config.SetVolatile(config.RegionConfigKey, envRegion); err != nil {
+			return err
+		}
+		regionOverridenThrough = "AWLESS_REGION variable"
 	} else if envRegion := os.Getenv("AWS_DEFAULT_REGION"); envRegion != "" {
 		if err := config.SetVolatile(config.RegionConfigKey, envRegion); err != nil {
 			return err
@@ -126,6 +138,7 @@ func initCloudServicesHook(cmd *cobra.Command, args []string) error {
 	if err := awsservices.Init(profile, region, config.GetConfigWithPrefix("aws."), logger.DefaultLogger, config.SetProfileCallback, networkMonitorFlag); err != nil {
 		return err
 	}
+	awsspec.DefaultImageResolverCache.Disk = cache.NewDisk(filepath.Join(config.AwlessHome, "cache", "images", region), config.GetCacheTTL())
 
 	if config.TriggerSyncOnConfigUpdate && !strings.HasPrefix(cmd.Name(), "sync") {
 		var services []cloud.Service
@@ -169,8 +182,17 @@ func initLoggerHook(cmd *cobra.Command, args []string) error {
 	}
 
 	logger.DefaultLogger.SetVerbose(flag)
+	logger.DefaultLogger.SetQuiet(quietGlobalFlag)
 	if silentGlobalFlag {
-		logger.DefaultLogger = logger.DiscardLogger
+		logger.DefaultLogger = logger.New("", 0, ioutil.Discard)
+	}
+	if logFileGlobalFlag != "" {
+		f, err := os.OpenFile(logFileGlobalFlag, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+		if err != nil {
+			return fmt.Errorf("cannot open log file: %s", err)
+		}
+		logger.DefaultLogger.SetFile(f)
+		logger.DefaultLogger.ExtraVerbosef("awless %s: %s", config.Version, strings.Join(os.Args[1:], " "))
 	}
 	return nil
 }
@@ -213,6 +235,13 @@ func networkMonitorHook(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func apiCallsHook(cmd *cobra.Command, args []string) error {
+	if showAPICallsFlag {
+		awsservices.DefaultAPIStats.Display(os.Stderr)
+	}
+	return nil
+}
+
 func firstInstallDoneHook(cmd *cobra.Command, args []string) error {
 	if config.TriggerSyncOnConfigUpdate {
 		fmt.Fprintln(os.Stderr, "\nAll done. Enjoy!")
@@ -250,7 +279,7 @@ func migrationActionsAndExtraMessages(current string) {
 
 func hasEmbeddedRegionInSharedConfigForProfile(profile string) (string, bool, error) {
 	s, err := session.NewSessionWithOptions(session.Options{
-		AssumeRoleTokenProvider: stscreds.StdinTokenProvider,
+		AssumeRoleTokenProvider: awsservices.StdinTokenProvider,
 		SharedConfigState:       session.SharedConfigEnable,
 		Profile:                 profile,
 	})
@@ -42,13 +42,13 @@ func init() {
 var inspectCmd = &cobra.Command{
 	Use:               "inspect",
 	Short:             "Analyze your infrastructure through inspectors",
-	Long:              fmt.Sprintf("Basic proof of concept inspectors to analyze your infrastructure: %s", allInspectors()),
-	Example:           "  awless inspect -i bucket_sizer\n  awless inspect -i pricer\n  awless inspect -i port_scanner",
+	Long:              fmt.Sprintf("Inspectors analyze the graph of your infrastructure and print a report: %s.\n\nAdd your own by implementing inspect.Inspector and registering it with inspect.Register.", allInspectors()),
+	Example:           "  awless inspect -i bucket-sizes\n  awless inspect -i pricer\n  awless inspect -i port-scan",
 	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, initSyncerHook, firstInstallDoneHook),
-	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook, apiCallsHook),
 
 	RunE: func(c *cobra.Command, args []string) error {
-		inspector, ok := inspect.InspectorsRegister[inspectorFlag]
+		inspector, ok := inspect.Get(inspectorFlag)
 		if !ok {
 			return fmt.Errorf("command needs a valid inspector: %s", allInspectors())
 		}
@@ -77,9 +77,5 @@ var inspectCmd = &cobra.Command{
 }
 
 func allInspectors() string {
-	var all []string
-	for name := range inspect.InspectorsRegister {
-		all = append(all, name)
-	}
-	return strings.Join(all, ", ")
+	return strings.Join(inspect.Names(), ", ")
 }
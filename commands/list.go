@@ -17,14 +17,19 @@ limitations under the License.
 package commands
 
 import (
+	"bufio"
 	"context"
 	"fmt"
 	"os"
 	"sort"
 	"strings"
+	"text/tabwriter"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/spf13/cobra"
 	"github.com/wallix/awless/aws/services"
+	"github.com/wallix/awless/aws/spec"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/config"
 	"github.com/wallix/awless/console"
@@ -43,6 +48,8 @@ var (
 	noHeadersFlag              bool
 	sortBy                     []string
 	reverseFlag                bool
+	listRelationsFlag          bool
+	listTreeFlag               bool
 )
 
 func init() {
@@ -64,8 +71,10 @@ func init() {
 			listCmd.AddCommand(listSpecificResourceCmd(resType))
 		}
 	}
+	listCmd.AddCommand(listBackupsCmd)
+	listCmd.AddCommand(listRegionsCmd)
 
-	listCmd.PersistentFlags().StringVar(&listingFormat, "format", "table", "Output format: table, csv, tsv, json (default to table)")
+	listCmd.PersistentFlags().StringVar(&listingFormat, "format", "table", "Output format: table, csv, tsv, json, template='{{.Id}} {{.Name}}' (default to table)")
 	listCmd.PersistentFlags().StringSliceVar(&listingFiltersFlag, "filter", []string{}, "Filter resources given key/values fields (case insensitive). Ex: --filter type=t2.micro")
 	listCmd.PersistentFlags().StringSliceVar(&listingTagFiltersFlag, "tag", []string{}, "Filter EC2 resources given tags (case sensitive!). Ex: --tag Env=Production")
 	listCmd.PersistentFlags().StringSliceVar(&listingTagKeyFiltersFlag, "tag-key", []string{}, "Filter EC2 resources given a tag key only (case sensitive!). Ex: --tag-key Env")
@@ -74,15 +83,17 @@ func init() {
 	listCmd.PersistentFlags().BoolVar(&listOnlyIDs, "ids", false, "List only ids")
 	listCmd.PersistentFlags().BoolVar(&noHeadersFlag, "no-headers", false, "Do not display headers")
 	listCmd.PersistentFlags().BoolVar(&reverseFlag, "reverse", false, "Use in conjunction with --sort to reverse sort")
+	listCmd.PersistentFlags().BoolVar(&listRelationsFlag, "relations", false, "Display a column with the relations of each resource (parent, applies on, applied by)")
+	listCmd.PersistentFlags().BoolVar(&listTreeFlag, "tree", false, "Display resources indented under their parents")
 	listCmd.PersistentFlags().StringSliceVar(&sortBy, "sort", []string{"Id"}, "Sort tables by column(s) name(s)")
 }
 
 var listCmd = &cobra.Command{
 	Use:               "list",
 	Aliases:           []string{"ls"},
-	Example:           "  awless list instances --sort uptime\n  awless list users --format csv\n  awless list volumes --filter state=use --filter type=gp2\n  awless list volumes --tag-value Purchased\n  awless list vpcs --tag-key Dept --tag-key Internal\n  awless list instances --tag Env=Production,Dept=Marketing\n  awless list instances --filter state=running,type=micro\n  awless list s3objects --filter bucket=pdf-bucket ",
+	Example:           "  awless list instances --sort uptime\n  awless list users --format csv\n  awless list volumes --filter state=use --filter type=gp2\n  awless list volumes --tag-value Purchased\n  awless list vpcs --tag-key Dept --tag-key Internal\n  awless list instances --tag Env=Production,Dept=Marketing\n  awless list instances --filter state=running,type=micro\n  awless list s3objects --filter bucket=pdf-bucket\n  awless list instances --local   # from last sync, without calling AWS\n  awless list subnets --relations\n  awless list instances --tree\n  awless list instances --format template='{{.Id}} {{.Name}} {{index .Tags \"Env\"}}'",
 	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, firstInstallDoneHook),
-	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook, apiCallsHook),
 	Short:             "List resources: sorting, filtering via tag/properties, output formatting, etc...",
 }
 
@@ -112,6 +123,7 @@ var listSpecificResourceCmd = func(resType string) *cobra.Command {
 			if localGlobalFlag {
 				if srvName, ok := awsservices.ServicePerResourceType[resType]; ok {
 					g = sync.LoadLocalGraphForService(srvName, config.GetAWSProfile(), config.GetAWSRegion())
+					logLocalSyncAge(srvName)
 				} else {
 					exitOn(fmt.Errorf("cannot find service for resource type %s", resType))
 				}
@@ -128,6 +140,69 @@ var listSpecificResourceCmd = func(resType string) *cobra.Command {
 	}
 }
 
+var listBackupsCmd = &cobra.Command{
+	Use:   "backups",
+	Short: "[infra] List instances backups (images created with `awless backup instance`)",
+
+	Run: func(cmd *cobra.Command, args []string) {
+		var g cloud.GraphAPI
+		if localGlobalFlag {
+			srvName := awsservices.ServicePerResourceType[cloud.Image]
+			g = sync.LoadLocalGraphForService(srvName, config.GetAWSProfile(), config.GetAWSRegion())
+			logLocalSyncAge(srvName)
+		} else {
+			srv, err := cloud.GetServiceForType(cloud.Image)
+			exitOn(err)
+			g, err = srv.FetchByType(context.WithValue(context.Background(), "force", true), cloud.Image)
+			exitOn(err)
+		}
+		listingTagKeyFiltersFlag = append(listingTagKeyFiltersFlag, awsspec.BackupInstanceTag)
+
+		printResources(g, cloud.Image)
+	},
+}
+
+var listRegionsCmd = &cobra.Command{
+	Use:   "regions",
+	Short: "[infra] List EC2 regions available to your account (current region marked with *)",
+
+	Run: func(cmd *cobra.Command, args []string) {
+		out, err := awsservices.Resolve(awsservices.InfraService).(*awsservices.Infra).DescribeRegions(&ec2.DescribeRegionsInput{})
+		exitOn(err)
+
+		var regions []*ec2.Region
+		for _, r := range out.Regions {
+			if r != nil && r.RegionName != nil {
+				regions = append(regions, r)
+			}
+		}
+		sort.Slice(regions, func(i, j int) bool {
+			return aws.StringValue(regions[i].RegionName) < aws.StringValue(regions[j].RegionName)
+		})
+
+		if listOnlyIDs {
+			for _, r := range regions {
+				fmt.Println(aws.StringValue(r.RegionName))
+			}
+			return
+		}
+
+		tabw := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+		if !noHeadersFlag {
+			fmt.Fprintln(tabw, "REGION\tENDPOINT\t")
+		}
+		current := config.GetAWSRegion()
+		for _, r := range regions {
+			name := aws.StringValue(r.RegionName)
+			if name == current {
+				name = name + " *"
+			}
+			fmt.Fprintf(tabw, "%s\t%s\t\n", name, aws.StringValue(r.Endpoint))
+		}
+		exitOn(tabw.Flush())
+	},
+}
+
 var listAllResourceInServiceCmd = func(srvName string) *cobra.Command {
 	return &cobra.Command{
 		Use:    srvName,
@@ -140,6 +215,8 @@ var listAllResourceInServiceCmd = func(srvName string) *cobra.Command {
 				console.WithFormat(listingFormat),
 				console.WithMaxWidth(console.GetTerminalWidth()),
 				console.WithIDsOnly(listOnlyIDs),
+				console.WithRelations(listRelationsFlag),
+				console.WithTree(listTreeFlag),
 			).SetSource(g).Build()
 			exitOn(err)
 			exitOn(displayer.Print(os.Stdout))
@@ -161,8 +238,29 @@ func printResources(g cloud.GraphAPI, resType string) {
 		console.WithSortBy(sortBy...),
 		console.WithReverseSort(reverseFlag),
 		console.WithNoHeaders(noHeadersFlag),
+		console.WithRelations(listRelationsFlag),
+		console.WithTree(listTreeFlag),
+		console.WithRelationsSource(relationsSource(g)),
 	).SetSource(g).Build()
 	exitOn(err)
 
-	exitOn(displayer.Print(os.Stdout))
+	// buffered since the table of many resources is written row by row
+	w := bufio.NewWriter(os.Stdout)
+	err = displayer.Print(w)
+	w.Flush()
+	exitOn(err)
+}
+
+// relationsSource returns the graph in which to resolve the relations of the listed resources.
+// Fetching a single resource type does not bring its related resources, so they are taken from local data.
+func relationsSource(g cloud.GraphAPI) cloud.GraphAPI {
+	if !listRelationsFlag && !listTreeFlag {
+		return g
+	}
+	local, err := sync.LoadLocalGraphs(config.GetAWSProfile(), config.GetAWSRegion())
+	if err != nil {
+		logger.Verbosef("cannot load local data to resolve relations: %s", err)
+		return g
+	}
+	return local
 }
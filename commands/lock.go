@@ -0,0 +1,150 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"crypto/rand"
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/oklog/ulid"
+	"github.com/wallix/awless/aws/spec"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/lock"
+	"github.com/wallix/awless/logger"
+)
+
+// runLockTTL is the duration after which the lock of a crashed run can be taken over.
+// The lock of a run in progress is renewed every runLockRenewal.
+const (
+	runLockTTL     = 10 * time.Minute
+	runLockRenewal = runLockTTL / 3
+)
+
+var lockNameRegex = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
+
+func runLockers() ([]lock.Locker, error) {
+	lockers := []lock.Locker{lock.NewFileLocker(filepath.Join(config.AwlessHome, "locks"))}
+	table := config.GetLockDynamoDBTable()
+	bucket, prefix := config.GetLockS3Bucket()
+	if table == "" && bucket == "" {
+		return lockers, nil
+	}
+	factory, ok := awsspec.CommandFactory.(*awsspec.AWSFactory)
+	if !ok || factory.Sess == nil {
+		return nil, fmt.Errorf("lock: no AWS session available to lock with DynamoDB table or S3 bucket")
+	}
+	if table != "" {
+		lockers = append(lockers, lock.NewDynamoDBLocker(dynamodb.New(factory.Sess), table))
+	}
+	if bucket != "" {
+		lockers = append(lockers, lock.NewS3Locker(s3.New(factory.Sess), bucket, prefix))
+	}
+	return lockers, nil
+}
+
+// heldRunLock releases the lock held by the current run, if any (see releaseHeldRunLock)
+var heldRunLock func()
+
+// releaseHeldRunLock releases the lock held by the current run, if any. It is called when the run
+// returns, and before exiting (see exitOn) so that an early exit does not leave the lock held until it expires.
+func releaseHeldRunLock() {
+	if release := heldRunLock; release != nil {
+		heldRunLock = nil
+		release()
+	}
+}
+
+// acquireRunLock locks the named environment with all the configured lockers
+// and returns the func releasing the lock (see --lock)
+func acquireRunLock(name string) (func(), error) {
+	if !lockNameRegex.MatchString(name) {
+		return nil, validationErr(fmt.Errorf("invalid lock name '%s': expecting letters, digits, '.', '_' or '-'", name))
+	}
+	lockers, err := runLockers()
+	if err != nil {
+		return nil, err
+	}
+	return acquireLocks(lockers, name, newLockInfo(time.Now()))
+}
+
+func acquireLocks(lockers []lock.Locker, name string, info lock.Info) (func(), error) {
+	release := func(acquired []lock.Locker) {
+		for _, l := range acquired {
+			if err := l.Unlock(name, info); err != nil {
+				logger.Warningf("cannot release lock: %s", err)
+			}
+		}
+	}
+	for i, l := range lockers {
+		if err := l.Lock(name, info); err != nil {
+			release(lockers[:i])
+			return nil, err
+		}
+	}
+	logger.Verbosef("acquired lock '%s' until %s", name, info.Expires.Format(time.RFC3339))
+	stop, renewed := make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(renewed)
+		renewLocks(lockers, name, info, time.NewTicker(runLockRenewal), stop)
+	}()
+	return func() {
+		close(stop)
+		<-renewed
+		release(lockers)
+	}, nil
+}
+
+// renewLocks extends the expiration of the locks on each tick until stopped
+func renewLocks(lockers []lock.Locker, name string, info lock.Info, ticker *time.Ticker, stop <-chan struct{}) {
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case now := <-ticker.C:
+			info.Expires = now.Add(runLockTTL)
+			for _, l := range lockers {
+				if err := l.Renew(name, info); err != nil {
+					logger.Warningf("cannot renew lock: %s", err)
+				}
+			}
+		}
+	}
+}
+
+func newLockInfo(now time.Time) lock.Info {
+	info := lock.Info{
+		ID:       ulid.MustNew(ulid.Timestamp(now), rand.Reader).String(),
+		Acquired: now,
+		Expires:  now.Add(runLockTTL),
+	}
+	if u, err := user.Current(); err == nil {
+		info.Owner = u.Username
+	}
+	if info.Owner == "" {
+		info.Owner = os.Getenv("USER")
+	}
+	info.Host, _ = os.Hostname()
+	return info
+}
@@ -0,0 +1,127 @@
+package commands
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/lock"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template"
+	"github.com/wallix/awless/template/driver/fake"
+)
+
+func TestAcquireLocks(t *testing.T) {
+	dir, err := ioutil.TempDir("", "awless-locks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	local, shared := lock.NewFileLocker(filepath.Join(dir, "local")), lock.NewFileLocker(filepath.Join(dir, "shared"))
+	other := newLockInfo(time.Now())
+	if err := shared.Lock("prod", other); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := acquireLocks([]lock.Locker{local, shared}, "prod", newLockInfo(time.Now())); err == nil {
+		t.Fatal("expected lock held by other run")
+	}
+	if _, err := os.Stat(filepath.Join(dir, "local", "prod.lock")); !os.IsNotExist(err) {
+		t.Fatalf("expected local lock released on failure, got %v", err)
+	}
+
+	if err := shared.Unlock("prod", other); err != nil {
+		t.Fatal(err)
+	}
+	release, err := acquireLocks([]lock.Locker{local, shared}, "prod", newLockInfo(time.Now()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	release()
+	for _, sub := range []string{"local", "shared"} {
+		if _, err := os.Stat(filepath.Join(dir, sub, "prod.lock")); !os.IsNotExist(err) {
+			t.Fatalf("expected %s lock released, got %v", sub, err)
+		}
+	}
+
+	if _, err := acquireRunLock("../prod"); err == nil {
+		t.Fatal("expected invalid lock name")
+	}
+}
+
+func TestRunLocked(t *testing.T) {
+	dir, err := ioutil.TempDir("", "awless-locks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	defer func(home, flag string) { config.AwlessHome, runLockFlag = home, flag }(config.AwlessHome, runLockFlag)
+	config.AwlessHome, runLockFlag = dir, "prod"
+	lockFile := filepath.Join(dir, "locks", "prod.lock")
+
+	driver := fake.NewDriver()
+	driver.FailOn("create", "vpc", errors.New("vpc limit exceeded"))
+	var heldDuringRun bool
+	runner := &template.Runner{
+		Template:    template.MustParse("create vpc cidr=10.0.0.0/16"),
+		CmdLookuper: driver.Lookup,
+		Log:         logger.DiscardLogger,
+		BeforeRun:   func(*template.TemplateExecution) (bool, error) { return true, nil },
+		AfterRun: func(*template.TemplateExecution) error {
+			_, err := os.Stat(lockFile)
+			heldDuringRun = err == nil
+			return nil
+		},
+	}
+	if err := runLocked(runner); err == nil {
+		t.Fatal("expected run error")
+	}
+	if !heldDuringRun {
+		t.Fatal("expected lock held during run")
+	}
+	if _, err := os.Stat(lockFile); !os.IsNotExist(err) {
+		t.Fatalf("expected lock released after failed run, got %v", err)
+	}
+
+	if heldRunLock, err = acquireRunLock("prod"); err != nil {
+		t.Fatal(err)
+	}
+	releaseHeldRunLock()
+	releaseHeldRunLock()
+	if _, err := os.Stat(lockFile); !os.IsNotExist(err) {
+		t.Fatalf("expected lock released before exit, got %v", err)
+	}
+}
+
+func TestRenewLocks(t *testing.T) {
+	dir, err := ioutil.TempDir("", "awless-locks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	locker := lock.NewFileLocker(dir)
+	info := newLockInfo(time.Now().Add(-time.Hour))
+	if err := locker.Lock("prod", info); err != nil {
+		t.Fatal(err)
+	}
+
+	stop := make(chan struct{})
+	ticker := time.NewTicker(time.Millisecond)
+	time.AfterFunc(20*time.Millisecond, func() { close(stop) })
+	renewLocks([]lock.Locker{locker}, "prod", info, ticker, stop)
+
+	err = locker.Lock("prod", newLockInfo(time.Now()))
+	held, ok := err.(*lock.HeldError)
+	if !ok {
+		t.Fatalf("got %v, want held error", err)
+	}
+	if !held.Holder.Expires.After(time.Now()) {
+		t.Fatalf("expected lock renewed, expires %s", held.Holder.Expires)
+	}
+}
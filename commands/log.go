@@ -31,6 +31,8 @@ var (
 	limitLogCountFlag             int
 	rawJSONLogFlag, idOnlyLogFlag bool
 	fullLogFlag, shortLogFlag     bool
+	stacksLogFlag                 bool
+	stackLogFlag                  string
 )
 
 func init() {
@@ -43,6 +45,8 @@ func init() {
 	logCmd.Flags().BoolVar(&shortLogFlag, "short", false, "Display one or more template log with less info")
 	logCmd.Flags().BoolVar(&fullLogFlag, "full", false, "Display template logs with full info")
 	logCmd.Flags().BoolVar(&idOnlyLogFlag, "id-only", false, "Show only log template IDs (i.e. revert IDs)")
+	logCmd.Flags().BoolVar(&stacksLogFlag, "stacks", false, "List the stacks labeling runs (see `awless run --stack`)")
+	logCmd.Flags().StringVar(&stackLogFlag, "stack", "", "Show only the logs of the runs of the given stack")
 }
 
 var logCmd = &cobra.Command{
@@ -69,6 +73,13 @@ var logCmd = &cobra.Command{
 			return nil
 		}
 
+		if stacksLogFlag {
+			stacks, err := loadStacks()
+			exitOn(err)
+			printStacks(os.Stdout, stacks)
+			return nil
+		}
+
 		if deleteAllLogsFlag {
 			exitOn(database.Execute(func(db *database.DB) error {
 				return db.DeleteTemplates()
@@ -88,6 +99,10 @@ var logCmd = &cobra.Command{
 			return
 		}))
 
+		if stackLogFlag != "" {
+			all = filterStackLogs(all, stackLogFlag)
+		}
+
 		print(all, printer)
 		return nil
 	},
@@ -120,6 +135,15 @@ func print(all []*database.LoadedTemplate, printer logPrinter) {
 	}
 }
 
+func filterStackLogs(all []*database.LoadedTemplate, stack string) (filtered []*database.LoadedTemplate) {
+	for _, loaded := range all {
+		if loaded.Err == nil && loaded.TplExec.Stack == stack {
+			filtered = append(filtered, loaded)
+		}
+	}
+	return
+}
+
 func getPrinter(args []string) logPrinter {
 	var defaultPrinter logPrinter
 	if len(args) > 0 {
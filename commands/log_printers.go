@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"strings"
 	"time"
 
 	"github.com/fatih/color"
@@ -42,6 +43,11 @@ func (p *fullLogPrinter) print(t *template.TemplateExecution) error {
 			line = fmt.Sprintf("    %s\t%s", status, cmd.String())
 		}
 
+		if comment := t.CommentOf(cmd); comment != "" {
+			for _, l := range strings.Split(comment, "\n") {
+				fmt.Fprintf(p.w, "    \t%s\n", renderBlueFn("# "+l))
+			}
+		}
 		fmt.Fprintln(p.w, line)
 		logger.New("", 0, p.w).MultiLineError(cmd.Err())
 	}
@@ -112,6 +118,9 @@ func writeLogHeader(t *template.TemplateExecution, w io.Writer) {
 	if t.Locale != "" {
 		fmt.Fprintf(w, " in %s", renderBlueFn(t.Locale))
 	}
+	if t.Stack != "" {
+		fmt.Fprintf(w, " stack %s", renderBlueFn(t.Stack))
+	}
 	if !template.IsRevertible(t.Template) {
 		fmt.Fprintf(w, " (not revertible)")
 	}
@@ -135,5 +144,8 @@ func writeMultilineLogHeader(t *template.TemplateExecution, w io.Writer) {
 	if t.Locale != "" {
 		fmt.Fprintf(w, "Region: %s\n", t.Locale)
 	}
+	if t.Stack != "" {
+		fmt.Fprintf(w, "Stack: %s\n", t.Stack)
+	}
 	fmt.Fprintln(w)
 }
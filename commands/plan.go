@@ -0,0 +1,222 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/wallix/awless/aws/services"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/sync"
+)
+
+const (
+	// AWS reserves the first four and the last IP addresses of each subnet
+	awsReservedIPsPerSubnet = 5
+	minSubnetPrefixSize     = 16
+	maxSubnetPrefixSize     = 28
+)
+
+var (
+	planVpcFlag        string
+	planSubnetsFlag    int
+	planSizeFlag       string
+	planAsTemplateFlag bool
+)
+
+func init() {
+	RootCmd.AddCommand(planCmd)
+	planCmd.AddCommand(planCidrCmd)
+
+	planCidrCmd.Flags().StringVar(&planVpcFlag, "vpc", "", "CIDR of the VPC to plan into (a VPC id or name from the local sync is also accepted)")
+	planCidrCmd.Flags().IntVar(&planSubnetsFlag, "subnets", 1, "Number of subnets to plan")
+	planCidrCmd.Flags().StringVar(&planSizeFlag, "size", "/24", "Size of each subnet as a prefix length. Ex: /24")
+	planCidrCmd.Flags().BoolVar(&planAsTemplateFlag, "template", false, "Output a ready-to-run template creating the planned subnets")
+}
+
+var planCmd = &cobra.Command{
+	Use:               "plan",
+	Short:             "Plan resources before creating them: subnets CIDRs, etc.",
+	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, firstInstallDoneHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade),
+}
+
+var planCidrCmd = &cobra.Command{
+	Use:     "cidr",
+	Short:   "Compute non-overlapping subnets CIDRs in a VPC, considering its existing subnets from the local sync",
+	Example: "  awless plan cidr --vpc 10.0.0.0/16 --subnets 6 --size /24\n  awless plan cidr --vpc vpc-12345678 --subnets 2 --size /20 --template > subnets.aws\n  awless run ./subnets.aws",
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if planVpcFlag == "" {
+			return errors.New("missing required --vpc flag")
+		}
+		prefix, err := strconv.Atoi(strings.TrimPrefix(planSizeFlag, "/"))
+		if err != nil {
+			return fmt.Errorf("invalid --size '%s': expecting a prefix length such as /24", planSizeFlag)
+		}
+
+		srvName := awsservices.ServicePerResourceType[cloud.Subnet]
+		g := sync.LoadLocalGraphForService(srvName, config.GetAWSProfile(), config.GetAWSRegion())
+		logLocalSyncAge(srvName)
+
+		vpcID, vpcNet, existing, err := resolvePlanVpc(g, planVpcFlag)
+		exitOn(err)
+		if vpcID == "" {
+			logger.Warningf("no VPC with CIDR %s found in local sync: planning without considering existing subnets", vpcNet)
+		} else {
+			logger.Verbosef("considering %d existing subnet(s) of %s", len(existing), vpcID)
+		}
+
+		planned, err := planSubnetCIDRs(vpcNet, existing, planSubnetsFlag, prefix)
+		exitOn(err)
+
+		if planAsTemplateFlag {
+			printPlannedSubnetsTemplate(os.Stdout, vpcID, planned)
+		} else {
+			printPlannedSubnets(os.Stdout, planned)
+		}
+		return nil
+	},
+}
+
+// resolvePlanVpc returns the VPC id (empty when not synced), its network and the networks of its existing subnets
+func resolvePlanVpc(g cloud.GraphAPI, vpc string) (string, *net.IPNet, []*net.IPNet, error) {
+	vpcs, err := g.Find(cloud.NewQuery(cloud.Vpc))
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	_, vpcNet, cidrErr := net.ParseCIDR(vpc)
+	var vpcID string
+	for _, v := range vpcs {
+		cidr, _ := v.Properties()[properties.CIDR].(string)
+		name, _ := v.Properties()[properties.Name].(string)
+		if (cidrErr == nil && cidr == vpcNet.String()) || (cidrErr != nil && (v.Id() == vpc || name == vpc)) {
+			vpcID = v.Id()
+			if cidrErr != nil {
+				if _, vpcNet, err = net.ParseCIDR(cidr); err != nil {
+					return "", nil, nil, fmt.Errorf("vpc %s: invalid CIDR '%s'", vpcID, cidr)
+				}
+			}
+			break
+		}
+	}
+	if vpcNet == nil {
+		return "", nil, nil, fmt.Errorf("invalid --vpc '%s': neither a CIDR nor a VPC found in local sync", vpc)
+	}
+	if vpcNet.IP.To4() == nil {
+		return "", nil, nil, fmt.Errorf("invalid --vpc '%s': only IPv4 CIDRs are supported", vpc)
+	}
+	if vpcID == "" {
+		return "", vpcNet, nil, nil
+	}
+
+	subnets, err := g.Find(cloud.NewQuery(cloud.Subnet))
+	if err != nil {
+		return "", nil, nil, err
+	}
+	var existing []*net.IPNet
+	for _, s := range subnets {
+		if s.Properties()[properties.Vpc] != vpcID {
+			continue
+		}
+		cidr, _ := s.Properties()[properties.CIDR].(string)
+		if _, n, err := net.ParseCIDR(cidr); err == nil {
+			existing = append(existing, n)
+		}
+	}
+	return vpcID, vpcNet, existing, nil
+}
+
+// planSubnetCIDRs returns the first count subnets of the given prefix length
+// in vpc that do not overlap any of the existing subnets
+func planSubnetCIDRs(vpc *net.IPNet, existing []*net.IPNet, count, prefix int) ([]*net.IPNet, error) {
+	vpcPrefix, bits := vpc.Mask.Size()
+	if bits != 32 {
+		return nil, fmt.Errorf("only IPv4 CIDRs are supported, got %s", vpc)
+	}
+	if prefix < minSubnetPrefixSize || prefix > maxSubnetPrefixSize {
+		return nil, fmt.Errorf("invalid subnet size /%d: expecting between /%d and /%d", prefix, minSubnetPrefixSize, maxSubnetPrefixSize)
+	}
+	if prefix < vpcPrefix {
+		return nil, fmt.Errorf("invalid subnet size /%d: larger than the VPC %s", prefix, vpc)
+	}
+	if count < 1 {
+		return nil, fmt.Errorf("invalid number of subnets %d", count)
+	}
+
+	start := binary.BigEndian.Uint32(vpc.IP.To4())
+	end := uint64(start) + (uint64(1) << uint(32-vpcPrefix))
+	step := uint64(1) << uint(32-prefix)
+
+	var planned []*net.IPNet
+	for base := uint64(start); base < end && len(planned) < count; base += step {
+		ip := make(net.IP, net.IPv4len)
+		binary.BigEndian.PutUint32(ip, uint32(base))
+		candidate := &net.IPNet{IP: ip, Mask: net.CIDRMask(prefix, 32)}
+
+		var overlaps bool
+		for _, e := range existing {
+			if e.Contains(candidate.IP) || candidate.Contains(e.IP) {
+				overlaps = true
+				break
+			}
+		}
+		if !overlaps {
+			planned = append(planned, candidate)
+		}
+	}
+	if len(planned) < count {
+		return planned, fmt.Errorf("only %d free /%d subnet(s) left in %s (%d requested)", len(planned), prefix, vpc, count)
+	}
+	return planned, nil
+}
+
+func printPlannedSubnets(w io.Writer, planned []*net.IPNet) {
+	tabw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tabw, "CIDR\tFIRST IP\tLAST IP\tUSABLE IPS\t")
+	for _, n := range planned {
+		ones, bits := n.Mask.Size()
+		size := uint32(1) << uint(bits-ones)
+		last := make(net.IP, net.IPv4len)
+		binary.BigEndian.PutUint32(last, binary.BigEndian.Uint32(n.IP.To4())+size-1)
+		fmt.Fprintf(tabw, "%s\t%s\t%s\t%d\t\n", n, n.IP, last, size-awsReservedIPsPerSubnet)
+	}
+	tabw.Flush()
+}
+
+func printPlannedSubnetsTemplate(w io.Writer, vpcID string, planned []*net.IPNet) {
+	vpc := vpcID
+	if vpc == "" {
+		vpc = "{subnet.vpc}"
+	}
+	for i, n := range planned {
+		fmt.Fprintf(w, "subnet%d = create subnet cidr=%s vpc=%s name=subnet-%d\n", i+1, n, vpc, i+1)
+	}
+}
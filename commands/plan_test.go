@@ -0,0 +1,122 @@
+package commands
+
+import (
+	"bytes"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+)
+
+func TestPlanSubnetCIDRs(t *testing.T) {
+	parse := func(cidrs ...string) (nets []*net.IPNet) {
+		for _, c := range cidrs {
+			_, n, err := net.ParseCIDR(c)
+			if err != nil {
+				t.Fatal(err)
+			}
+			nets = append(nets, n)
+		}
+		return
+	}
+	toStrings := func(nets []*net.IPNet) (out []string) {
+		for _, n := range nets {
+			out = append(out, n.String())
+		}
+		return
+	}
+
+	tcases := []struct {
+		vpc      string
+		existing []string
+		count    int
+		prefix   int
+		exp      []string
+		expErr   string
+	}{
+		{"10.0.0.0/16", nil, 3, 24, []string{"10.0.0.0/24", "10.0.1.0/24", "10.0.2.0/24"}, ""},
+		{"10.0.0.0/16", []string{"10.0.0.0/24", "10.0.2.0/23"}, 3, 24, []string{"10.0.1.0/24", "10.0.4.0/24", "10.0.5.0/24"}, ""},
+		{"10.0.0.0/16", []string{"10.0.1.128/25"}, 2, 24, []string{"10.0.0.0/24", "10.0.2.0/24"}, ""},
+		{"10.0.0.0/16", []string{"10.0.0.0/24"}, 2, 20, []string{"10.0.16.0/20", "10.0.32.0/20"}, ""},
+		{"172.31.0.0/24", []string{"172.31.0.0/26"}, 4, 26, nil, "only 3 free /26 subnet(s) left in 172.31.0.0/24 (4 requested)"},
+		{"10.0.0.0/24", nil, 1, 20, nil, "larger than the VPC"},
+		{"10.0.0.0/16", nil, 1, 29, nil, "expecting between /16 and /28"},
+		{"10.0.0.0/16", nil, 0, 24, nil, "invalid number of subnets"},
+	}
+	for i, tcase := range tcases {
+		planned, err := planSubnetCIDRs(parse(tcase.vpc)[0], parse(tcase.existing...), tcase.count, tcase.prefix)
+		if tcase.expErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tcase.expErr) {
+				t.Fatalf("%d: got %v, want %s", i+1, err, tcase.expErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%d: %s", i+1, err)
+		}
+		if got, want := toStrings(planned), tcase.exp; !reflect.DeepEqual(got, want) {
+			t.Fatalf("%d: got %v, want %v", i+1, got, want)
+		}
+	}
+}
+
+func TestResolvePlanVpcAndPrint(t *testing.T) {
+	newRes := func(typ, id string, props map[string]interface{}) *graph.Resource {
+		res := graph.InitResource(typ, id)
+		for k, v := range props {
+			res.SetProperty(k, v)
+		}
+		return res
+	}
+	g := graph.NewGraph()
+	g.AddResource(
+		newRes(cloud.Vpc, "vpc-1", map[string]interface{}{properties.CIDR: "10.0.0.0/16", properties.Name: "main"}),
+		newRes(cloud.Vpc, "vpc-2", map[string]interface{}{properties.CIDR: "10.1.0.0/16"}),
+		newRes(cloud.Subnet, "sub-1", map[string]interface{}{properties.CIDR: "10.0.0.0/24", properties.Vpc: "vpc-1"}),
+		newRes(cloud.Subnet, "sub-2", map[string]interface{}{properties.CIDR: "10.1.0.0/24", properties.Vpc: "vpc-2"}),
+	)
+
+	for _, vpc := range []string{"10.0.0.0/16", "vpc-1", "main"} {
+		id, vpcNet, existing, err := resolvePlanVpc(g, vpc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := id, "vpc-1"; got != want {
+			t.Fatalf("%s: got %s, want %s", vpc, got, want)
+		}
+		if got, want := vpcNet.String(), "10.0.0.0/16"; got != want {
+			t.Fatalf("%s: got %s, want %s", vpc, got, want)
+		}
+		if got, want := len(existing), 1; got != want {
+			t.Fatalf("%s: got %d, want %d", vpc, got, want)
+		}
+	}
+
+	id, vpcNet, existing, err := resolvePlanVpc(g, "192.168.0.0/16")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != "" || vpcNet.String() != "192.168.0.0/16" || len(existing) != 0 {
+		t.Fatalf("got %s, %s, %v", id, vpcNet, existing)
+	}
+	if _, _, _, err := resolvePlanVpc(g, "unknown"); err == nil {
+		t.Fatal("expected error got none")
+	}
+
+	_, planned, _ := net.ParseCIDR("10.0.1.0/24")
+	var buff bytes.Buffer
+	printPlannedSubnets(&buff, []*net.IPNet{planned})
+	if got, want := buff.String(), "CIDR         FIRST IP  LAST IP     USABLE IPS  \n10.0.1.0/24  10.0.1.0  10.0.1.255  251         \n"; got != want {
+		t.Fatalf("got\n%q\nwant\n%q", got, want)
+	}
+
+	buff.Reset()
+	printPlannedSubnetsTemplate(&buff, "", []*net.IPNet{planned})
+	if got, want := buff.String(), "subnet1 = create subnet cidr=10.0.1.0/24 vpc={subnet.vpc} name=subnet-1\n"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
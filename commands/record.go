@@ -0,0 +1,377 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/wallix/awless/aws/services"
+	"github.com/wallix/awless/aws/spec"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/sync"
+	"github.com/wallix/awless/template"
+	"github.com/wallix/awless/template/params"
+)
+
+func init() {
+	RootCmd.AddCommand(recordCmd)
+	recordCmd.AddCommand(recordStartCmd)
+	recordCmd.AddCommand(recordStopCmd)
+}
+
+var recordCmd = &cobra.Command{
+	Use:               "record",
+	Short:             "Record a manual change (ex: in the console) and emit the awless template doing the same",
+	Example:           "  awless record start\n  # ... change things in the AWS console ...\n  awless record stop > fix.awls",
+	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, initSyncerHook, firstInstallDoneHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook, apiCallsHook),
+}
+
+var recordStartCmd = &cobra.Command{
+	Use:   "start",
+	Short: "Sync and keep a snapshot of the resources of the current region to compare with on stop",
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		g, err := syncForRecord()
+		if err != nil {
+			return err
+		}
+		path := recordFilePath()
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			return err
+		}
+		f, err := os.Create(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		if err := g.MarshalTo(f); err != nil {
+			return err
+		}
+		logger.Infof("recording changes in region '%s'. Run `awless record stop` once done", config.GetAWSRegion())
+		return nil
+	},
+}
+
+var recordStopCmd = &cobra.Command{
+	Use:   "stop",
+	Short: "Sync again and print the template of the changes made since `awless record start`",
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path := recordFilePath()
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			return errors.New("no recording in progress: run `awless record start` first")
+		}
+		before, err := graph.NewGraphFromFiles(path)
+		if err != nil {
+			return fmt.Errorf("cannot load recording: %s", err)
+		}
+		after, err := syncForRecord()
+		if err != nil {
+			return err
+		}
+		text, err := recordedChangesTemplate(before, after)
+		if err != nil {
+			return err
+		}
+		if text == "" {
+			logger.Info("no change recorded")
+		} else {
+			fmt.Println(text)
+		}
+		return os.Remove(path)
+	},
+}
+
+func recordFilePath() string {
+	return filepath.Join(config.AwlessHome, "records", fmt.Sprintf("%s-%s.nt", config.GetAWSProfile(), config.GetAWSRegion()))
+}
+
+func syncForRecord() (cloud.GraphAPI, error) {
+	if !localGlobalFlag {
+		var services []cloud.Service
+		for _, srv := range cloud.ServiceRegistry {
+			services = append(services, srv)
+		}
+		if _, err := sync.DefaultSyncer.Sync(services...); err != nil {
+			logger.Verbose(err)
+		}
+	}
+	return sync.LoadLocalGraphs(config.GetAWSProfile(), config.GetAWSRegion())
+}
+
+// resource types created first (and deleted last) when emitting a recorded change
+var recordTypesOrder = []string{
+	cloud.Vpc, cloud.InternetGateway, cloud.Subnet, cloud.RouteTable, cloud.SecurityGroup,
+	cloud.Keypair, cloud.Role, cloud.Policy, cloud.Group, cloud.User,
+}
+
+var recordVariableRegex = regexp.MustCompile(`[^a-zA-Z0-9_]+`)
+
+// recordedChangesTemplate emits a best-effort template doing the changes found between two
+// snapshots: creations, updates of the properties settable with update commands, tags and
+// deletions. Changes without an equivalent command are left as comments to review.
+func recordedChangesTemplate(before, after cloud.GraphAPI) (string, error) {
+	added, removed, err := resourcesDiff(before, after)
+	if err != nil {
+		return "", err
+	}
+	sortResourcesForRecord(added)
+	sortResourcesForRecord(removed)
+
+	var buf bytes.Buffer
+	vars := make(map[string]string)
+	for _, res := range added {
+		def, ok := awsspec.AWSLookupDefinitions("create" + res.Type())
+		if !ok {
+			fmt.Fprintf(&buf, "# created %s %s: no 'create %s' command\n", res.Type(), res.Id(), res.Type())
+			continue
+		}
+		variable := recordVariableName(res, vars)
+		vars[res.Id()] = variable
+		fmt.Fprintf(&buf, "%s = create %s%s\n", variable, res.Type(), recordParams(def.Params, res.Properties(), vars, "id"))
+	}
+
+	modified, err := modifiedResources(before, after)
+	if err != nil {
+		return "", err
+	}
+	for _, change := range modified {
+		writeResourceUpdate(&buf, change[0], change[1])
+	}
+
+	for i := len(removed) - 1; i >= 0; i-- {
+		res := removed[i]
+		if _, ok := awsspec.AWSLookupDefinitions("delete" + res.Type()); !ok {
+			fmt.Fprintf(&buf, "# deleted %s %s: no 'delete %s' command\n", res.Type(), res.Id(), res.Type())
+			continue
+		}
+		fmt.Fprintf(&buf, "delete %s id=%s\n", res.Type(), recordValue(res.Id()))
+	}
+
+	text := strings.TrimSpace(buf.String())
+	if text == "" {
+		return "", nil
+	}
+	if _, err := template.Parse(text); err != nil {
+		return "", fmt.Errorf("cannot emit recorded changes as template: %s", err)
+	}
+	return text, nil
+}
+
+// modifiedResources returns the pairs of before/after resources with different properties
+func modifiedResources(before, after cloud.GraphAPI) (out [][2]cloud.Resource, err error) {
+	for _, resType := range awsservices.ResourceTypes {
+		var olds, news []cloud.Resource
+		if olds, err = before.Find(cloud.NewQuery(resType)); err != nil {
+			return
+		}
+		if news, err = after.Find(cloud.NewQuery(resType)); err != nil {
+			return
+		}
+		byID := make(map[string]cloud.Resource)
+		for _, r := range olds {
+			byID[r.Id()] = r
+		}
+		sort.Slice(news, func(i, j int) bool { return news[i].Id() < news[j].Id() })
+		for _, r := range news {
+			if old, ok := byID[r.Id()]; ok && !reflect.DeepEqual(old.Properties(), r.Properties()) {
+				out = append(out, [2]cloud.Resource{old, r})
+			}
+		}
+	}
+	return
+}
+
+func writeResourceUpdate(buf *bytes.Buffer, old, res cloud.Resource) {
+	changed := make(map[string]interface{})
+	for k, v := range res.Properties() {
+		if k != properties.Tags && !reflect.DeepEqual(old.Properties()[k], v) {
+			changed[k] = v
+		}
+	}
+	if len(changed) > 0 {
+		if def, ok := awsspec.AWSLookupDefinitions("update" + res.Type()); ok {
+			if p := recordParams(def.Params, changed, nil); p != "" {
+				fmt.Fprintf(buf, "update %s id=%s%s\n", res.Type(), recordValue(res.Id()), p)
+			}
+		} else {
+			var keys []string
+			for k := range changed {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			fmt.Fprintf(buf, "# updated %s %s (%s): no 'update %s' command\n", res.Type(), res.Id(), strings.Join(keys, ", "), res.Type())
+		}
+	}
+
+	oldTags, newTags := recordTags(old), recordTags(res)
+	var keys []string
+	for k := range newTags {
+		keys = append(keys, k)
+	}
+	for k := range oldTags {
+		if _, ok := newTags[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		v, exists := newTags[k]
+		previous, existed := oldTags[k]
+		switch {
+		case !exists:
+			fmt.Fprintf(buf, "delete tag resource=%s key=%s\n", recordValue(res.Id()), recordValue(k))
+		case !existed || v != previous:
+			fmt.Fprintf(buf, "create tag resource=%s key=%s value=%s\n", recordValue(res.Id()), recordValue(k), recordValue(v))
+		}
+	}
+}
+
+// recordParams returns the ' key=value' params of a command built from the resource properties matching its params
+func recordParams(rule params.Rule, props map[string]interface{}, vars map[string]string, excluded ...string) string {
+	required, optionals, suggested := params.List(rule)
+	paramPerProp := make(map[string]string)
+	for _, p := range append(append(required, optionals...), suggested...) {
+		paramPerProp[normalizePropertyKey(p)] = p
+	}
+	for _, p := range excluded {
+		delete(paramPerProp, normalizePropertyKey(p))
+	}
+
+	values := make(map[string]string)
+	for k, v := range props {
+		p, ok := paramPerProp[normalizePropertyKey(k)]
+		if !ok {
+			continue
+		}
+		if s, ok := v.(string); ok && vars[s] != "" {
+			values[p] = "$" + vars[s]
+		} else if val := recordValue(v); val != "" {
+			values[p] = val
+		}
+	}
+
+	var keys []string
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var out bytes.Buffer
+	for _, k := range keys {
+		fmt.Fprintf(&out, " %s=%s", k, values[k])
+	}
+	return out.String()
+}
+
+func recordValue(v interface{}) string {
+	switch vv := v.(type) {
+	case string:
+		if vv == "" {
+			return ""
+		}
+		if _, err := strconv.ParseFloat(vv, 64); err == nil || !recordSimpleValue.MatchString(vv) {
+			if strings.ContainsRune(vv, '\'') {
+				return "\"" + vv + "\""
+			}
+			return "'" + vv + "'"
+		}
+		return vv
+	case bool, int, int64, float64:
+		return fmt.Sprint(vv)
+	case []string:
+		var all []string
+		for _, s := range vv {
+			all = append(all, recordValue(s))
+		}
+		if len(all) == 0 {
+			return ""
+		}
+		return "[" + strings.Join(all, ",") + "]"
+	default:
+		return ""
+	}
+}
+
+// in sync with the unquoted values of the template grammar
+var recordSimpleValue = regexp.MustCompile("^[a-zA-Z0-9-._:/+;~@<>*()]+$")
+
+func recordVariableName(res cloud.Resource, taken map[string]string) string {
+	base := res.Type()
+	if name, ok := res.Properties()[properties.Name].(string); ok && name != "" {
+		base = strings.Trim(recordVariableRegex.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	}
+	used := make(map[string]bool)
+	for _, v := range taken {
+		used[v] = true
+	}
+	name := base
+	for i := 2; used[name] || name == ""; i++ {
+		name = fmt.Sprintf("%s_%d", base, i)
+	}
+	return name
+}
+
+func recordTags(res cloud.Resource) map[string]string {
+	tags := make(map[string]string)
+	all, _ := res.Properties()[properties.Tags].([]string)
+	for _, t := range all {
+		splits := strings.SplitN(t, "=", 2)
+		if len(splits) == 2 && splits[0] != "Name" {
+			tags[splits[0]] = splits[1]
+		}
+	}
+	return tags
+}
+
+func normalizePropertyKey(s string) string {
+	return strings.ToLower(strings.NewReplacer("-", "", ".", "", "_", "").Replace(s))
+}
+
+func sortResourcesForRecord(all []cloud.Resource) {
+	rank := func(t string) int {
+		for i, typ := range recordTypesOrder {
+			if typ == t {
+				return i
+			}
+		}
+		return len(recordTypesOrder)
+	}
+	sort.SliceStable(all, func(i, j int) bool {
+		if ri, rj := rank(all[i].Type()), rank(all[j].Type()); ri != rj {
+			return ri < rj
+		}
+		if all[i].Type() != all[j].Type() {
+			return all[i].Type() < all[j].Type()
+		}
+		return all[i].Id() < all[j].Id()
+	})
+}
@@ -0,0 +1,48 @@
+package commands
+
+import (
+	"testing"
+
+	p "github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+)
+
+func TestRecordedChangesTemplate(t *testing.T) {
+	before := graph.NewGraph()
+	before.AddResource(
+		resourcetest.Instance("i-1").Prop(p.Name, "web").Prop(p.Tags, []string{"Env=dev", "Team=ops"}).Build(),
+		resourcetest.Instance("i-2").Build(),
+		resourcetest.Subnet("sub-1").Prop(p.Public, false).Build(),
+	)
+	after := graph.NewGraph()
+	after.AddResource(
+		resourcetest.Instance("i-1").Prop(p.Name, "web").Prop(p.Tags, []string{"Env=prod"}).Build(),
+		resourcetest.Subnet("sub-1").Prop(p.Public, true).Build(),
+		resourcetest.VPC("vpc-1").Prop(p.Name, "my vpc").Prop(p.CIDR, "10.0.0.0/16").Build(),
+		resourcetest.Subnet("sub-2").Prop(p.CIDR, "10.0.1.0/24").Prop(p.Vpc, "vpc-1").Build(),
+		resourcetest.KeyPair("my-key").Build(),
+	)
+
+	text, err := recordedChangesTemplate(before, after)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := `my_vpc = create vpc cidr=10.0.0.0/16 name='my vpc'
+subnet = create subnet cidr=10.0.1.0/24 vpc=$my_vpc
+keypair = create keypair
+create tag resource=i-1 key=Env value=prod
+delete tag resource=i-1 key=Team
+update subnet id=sub-1 public=true
+delete instance id=i-2`
+	if text != expect {
+		t.Fatalf("got\n%s\n\nwant\n%s", text, expect)
+	}
+
+	if text, err = recordedChangesTemplate(after, after); err != nil {
+		t.Fatal(err)
+	}
+	if text != "" {
+		t.Fatalf("got %q, want no change", text)
+	}
+}
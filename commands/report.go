@@ -0,0 +1,234 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/wallix/awless/aws/services"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/console"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/inspect"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/report"
+	"github.com/wallix/awless/sync"
+	"github.com/wallix/awless/sync/repo"
+)
+
+var (
+	reportFormatFlag string
+	reportOutFlag    string
+	reportSinceFlag  time.Duration
+)
+
+var reportInspectors = []string{"open-buckets", "unencrypted-buckets", "port-scan"}
+
+func init() {
+	RootCmd.AddCommand(reportCmd)
+
+	reportCmd.Flags().StringVar(&reportFormatFlag, "format", report.HTML, "Report format: html, xlsx")
+	reportCmd.Flags().StringVar(&reportOutFlag, "out", "", "Report file path (default to awless-report.<format>)")
+	reportCmd.Flags().DurationVar(&reportSinceFlag, "since", 7*24*time.Hour, "Report changes made since this duration")
+}
+
+var reportCmd = &cobra.Command{
+	Use:               "report",
+	Short:             "Generate a shareable inventory report (HTML or Excel) from local data: resources per service, security findings and changes since last week",
+	Example:           "  awless report --format html --out infra.html\n  awless report --format xlsx --out infra.xlsx\n  awless report --since 720h   # changes since last month",
+	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initSyncerHook, firstInstallDoneHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook, apiCallsHook),
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if reportFormatFlag != report.HTML && reportFormatFlag != report.XLSX {
+			return fmt.Errorf("invalid format '%s': expecting %s or %s", reportFormatFlag, report.HTML, report.XLSX)
+		}
+		out := reportOutFlag
+		if out == "" {
+			out = fmt.Sprintf("awless-report.%s", reportFormatFlag)
+		}
+
+		profile, region := config.GetAWSProfile(), config.GetAWSRegion()
+		g, err := sync.LoadLocalGraphs(profile, region)
+		exitOn(err)
+
+		rep := report.New("AWS infrastructure report", profile, region)
+		exitOn(addServicesSections(rep, g))
+		exitOn(addSecurityFindingsSection(rep, g))
+		addChangesSection(rep, g, profile, region, reportSinceFlag)
+
+		f, err := os.Create(out)
+		exitOn(err)
+		defer f.Close()
+		exitOn(rep.Write(f, reportFormatFlag))
+
+		logger.Infof("report written to %s", out)
+		return nil
+	},
+}
+
+func addServicesSections(rep *report.Report, g cloud.GraphAPI) error {
+	for _, srvName := range awsservices.ServiceNames {
+		resTypes := append([]string{}, awsservices.ResourceTypesPerServiceName()[srvName]...)
+		sort.Strings(resTypes)
+
+		var section *report.Section
+		for _, resType := range resTypes {
+			headers, rows, err := resourcesTable(g, resType)
+			if err != nil {
+				return err
+			}
+			if len(rows) == 0 {
+				continue
+			}
+			if section == nil {
+				section = rep.AddSection(srvName)
+			}
+			section.AddTable(strings.Title(cloud.PluralizeResource(resType)), headers, rows)
+		}
+	}
+	return nil
+}
+
+func resourcesTable(g cloud.GraphAPI, resType string) (headers []string, rows [][]string, err error) {
+	displayer, err := console.BuildOptions(
+		console.WithRdfType(resType),
+		console.WithFormat("csv"),
+	).SetSource(g).Build()
+	if err != nil {
+		return
+	}
+	var buf bytes.Buffer
+	if err = displayer.Print(&buf); err != nil {
+		return
+	}
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil || len(records) == 0 {
+		return
+	}
+	return records[0], records[1:], nil
+}
+
+func addSecurityFindingsSection(rep *report.Report, g cloud.GraphAPI) error {
+	section := rep.AddSection("Security findings")
+	for _, name := range reportInspectors {
+		inspector, ok := inspect.Get(name)
+		if !ok {
+			continue
+		}
+		if err := inspector.Inspect(g); err != nil {
+			return fmt.Errorf("%s: %s", name, err)
+		}
+		var buf bytes.Buffer
+		inspector.Print(&buf)
+		section.AddLines(fmt.Sprintf("[%s]", name))
+		section.AddLines(strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")...)
+		section.AddLines("")
+	}
+	return nil
+}
+
+func addChangesSection(rep *report.Report, g cloud.GraphAPI, profile, region string, since time.Duration) {
+	section := rep.AddSection("Changes")
+
+	revs, err := sync.DefaultSyncer.List()
+	if err != nil || len(revs) == 0 {
+		section.AddLines("no sync history available")
+		return
+	}
+	rev := revisionBefore(revs, time.Now().Add(-since))
+
+	graphs, err := sync.LoadLocalGraphsPerRegionAt(sync.DefaultSyncer, profile, rev.Id)
+	if err != nil {
+		logger.Verbosef("cannot load revision %s: %s", rev.Id, err)
+		section.AddLines("no sync history available")
+		return
+	}
+	previous := graph.NewGraph()
+	for _, reg := range []string{region, "global"} {
+		if prev, ok := graphs[reg]; ok {
+			previous.Merge(prev)
+		}
+	}
+
+	added, removed, err := resourcesDiff(previous, g)
+	if err != nil {
+		logger.Verbosef("cannot compute changes: %s", err)
+		return
+	}
+	var rows [][]string
+	for _, res := range added {
+		name, _ := res.Properties()[properties.Name].(string)
+		rows = append(rows, []string{"added", res.Type(), res.Id(), name})
+	}
+	for _, res := range removed {
+		name, _ := res.Properties()[properties.Name].(string)
+		rows = append(rows, []string{"removed", res.Type(), res.Id(), name})
+	}
+	section.AddTable(fmt.Sprintf("Since sync of %s", rev.DateString()), []string{"Change", "Type", "ID", "Name"}, rows)
+}
+
+// revisionBefore returns the most recent revision made before the given date,
+// or the oldest one if all revisions are more recent. Revisions are sorted by date.
+func revisionBefore(revs []*repo.Rev, date time.Time) *repo.Rev {
+	found := revs[0]
+	for _, rev := range revs {
+		if rev.Date.After(date) {
+			break
+		}
+		found = rev
+	}
+	return found
+}
+
+func resourcesDiff(from, to cloud.GraphAPI) (added, removed []cloud.Resource, err error) {
+	for _, resType := range awsservices.ResourceTypes {
+		var before, after []cloud.Resource
+		if before, err = from.Find(cloud.NewQuery(resType)); err != nil {
+			return
+		}
+		if after, err = to.Find(cloud.NewQuery(resType)); err != nil {
+			return
+		}
+		added = append(added, subtractResources(after, before)...)
+		removed = append(removed, subtractResources(before, after)...)
+	}
+	return
+}
+
+func subtractResources(all, others []cloud.Resource) (out []cloud.Resource) {
+	ids := make(map[string]bool)
+	for _, r := range others {
+		ids[r.Id()] = true
+	}
+	for _, r := range all {
+		if !ids[r.Id()] {
+			out = append(out, r)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Id() < out[j].Id() })
+	return
+}
@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/sync/repo"
+)
+
+func TestResourcesDiff(t *testing.T) {
+	from := graph.NewGraph()
+	from.AddResource(graph.InitResource(cloud.Instance, "i-1"), graph.InitResource(cloud.Instance, "i-2"), graph.InitResource(cloud.Subnet, "sub-1"))
+	to := graph.NewGraph()
+	to.AddResource(graph.InitResource(cloud.Instance, "i-2"), graph.InitResource(cloud.Instance, "i-3"), graph.InitResource(cloud.Subnet, "sub-1"), graph.InitResource(cloud.Vpc, "vpc-1"))
+
+	added, removed, err := resourcesDiff(from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids := func(res []cloud.Resource) (out []string) {
+		for _, r := range res {
+			out = append(out, r.Id())
+		}
+		return
+	}
+	if got, want := ids(added), []string{"i-3", "vpc-1"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := ids(removed), []string{"i-1"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	headers, rows, err := resourcesTable(to, cloud.Instance)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(headers) == 0 || headers[0] != "ID" {
+		t.Fatalf("unexpected headers %v", headers)
+	}
+	if got, want := len(rows), 2; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+}
+
+func TestRevisionBefore(t *testing.T) {
+	now := time.Now()
+	revs := []*repo.Rev{
+		{Id: "1", Date: now.Add(-10 * 24 * time.Hour)},
+		{Id: "2", Date: now.Add(-8 * 24 * time.Hour)},
+		{Id: "3", Date: now.Add(-1 * time.Hour)},
+	}
+	if got, want := revisionBefore(revs, now.Add(-7*24*time.Hour)).Id, "2"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := revisionBefore(revs, now.Add(-30*24*time.Hour)).Id, "1"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
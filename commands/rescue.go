@@ -0,0 +1,220 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/spf13/cobra"
+	"github.com/wallix/awless/aws/services"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/ssh"
+	"github.com/wallix/awless/template"
+	"github.com/wallix/awless/template/env"
+)
+
+var (
+	rescuePublicKeyFlag  string
+	rescueHelperTypeFlag string
+)
+
+func init() {
+	RootCmd.AddCommand(rescueCmd)
+	rescueCmd.Flags().StringVar(&rescuePublicKeyFlag, "pubkey", filepath.Join("~", ".ssh", "id_rsa.pub"), "Path of the public key to inject in the instance")
+	rescueCmd.Flags().StringVar(&rescueHelperTypeFlag, "helper-type", "t2.micro", "Type of the temporary helper instance")
+}
+
+var rescueCmd = &cobra.Command{
+	Use:   "rescue INSTANCE",
+	Short: "Regain SSH access to an instance whose keypair is lost, injecting a new public key in its root volume",
+	Long: `Regain SSH access to an instance whose keypair is lost, injecting a new public key in its root volume.
+
+The instance is stopped and its root volume attached to a temporary helper instance (in the same subnet)
+that adds the public key to the authorized keys of root and of all the users, then shuts down.
+The root volume is then attached back to the instance, the helper deleted and the instance restarted if it was running.`,
+	Example: `  awless rescue i-0123456789                      # inject ~/.ssh/id_rsa.pub
+  awless rescue @redis --pubkey ~/.ssh/new_key.pub`,
+	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, initSyncerHook, firstInstallDoneHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook, apiCallsHook),
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return errors.New("missing INSTANCE arg (id or @name)")
+		}
+
+		pubkey, err := readPublicKey(rescuePublicKeyFlag)
+		exitOn(err)
+
+		target, err := fetchRescueTarget(resolveInstanceID(args[0]))
+		exitOn(err)
+
+		script, err := ioutil.TempFile("", "awless-rescue-")
+		exitOn(err)
+		defer os.Remove(script.Name())
+		_, err = script.WriteString(rescueScript(pubkey))
+		script.Close()
+		exitOn(err)
+
+		templ, err := template.Parse(rescueTemplate(target, script.Name(), rescueHelperTypeFlag))
+		exitOn(err)
+
+		runner := NewRunner(templ, fmt.Sprintf("Rescue instance %s", target.id), "")
+		runner.ParamsSuggested = env.REQUIRED_PARAMS_ONLY
+		exitOn(runner.Run())
+		return nil
+	},
+}
+
+type rescueTarget struct {
+	id, rootVolume, rootDevice, subnet string
+	running                            bool
+}
+
+func fetchRescueTarget(id string) (*rescueTarget, error) {
+	out, err := awsservices.Resolve(awsservices.InfraService).(*awsservices.Infra).DescribeInstances(&ec2.DescribeInstancesInput{InstanceIds: []*string{aws.String(id)}})
+	if err != nil {
+		return nil, err
+	}
+	for _, res := range out.Reservations {
+		for _, inst := range res.Instances {
+			target := &rescueTarget{
+				id:         id,
+				rootDevice: aws.StringValue(inst.RootDeviceName),
+				subnet:     aws.StringValue(inst.SubnetId),
+			}
+			if inst.State != nil {
+				switch state := aws.StringValue(inst.State.Name); state {
+				case ec2.InstanceStateNameRunning:
+					target.running = true
+				case ec2.InstanceStateNameStopped:
+				default:
+					return nil, fmt.Errorf("cannot rescue instance %s in state '%s'", id, state)
+				}
+			}
+			if aws.StringValue(inst.RootDeviceType) != ec2.DeviceTypeEbs {
+				return nil, fmt.Errorf("cannot rescue instance %s: its root device is not an EBS volume", id)
+			}
+			for _, dev := range inst.BlockDeviceMappings {
+				if aws.StringValue(dev.DeviceName) == target.rootDevice && dev.Ebs != nil {
+					target.rootVolume = aws.StringValue(dev.Ebs.VolumeId)
+				}
+			}
+			if target.rootVolume == "" {
+				return nil, fmt.Errorf("cannot find root volume of instance %s", id)
+			}
+			return target, nil
+		}
+	}
+	return nil, fmt.Errorf("instance %s not found", id)
+}
+
+func resolveInstanceID(ref string) string {
+	if strings.HasPrefix(ref, "i-") {
+		return ref
+	}
+	_, resources, _ := resolveResourceFromRefInCurrentRegion(ref)
+	var ids []string
+	for _, r := range resources {
+		if r.Type() == cloud.Instance {
+			ids = append(ids, r.Id())
+		}
+	}
+	switch len(ids) {
+	case 0:
+		exitOn(fmt.Errorf("instance '%s' not found (try `awless sync` or use the instance id)", ref))
+	case 1:
+	default:
+		exitOn(fmt.Errorf("several instances match '%s': %s", ref, strings.Join(ids, ", ")))
+	}
+	return ids[0]
+}
+
+func readPublicKey(path string) (string, error) {
+	if strings.HasPrefix(path, "~") {
+		path = filepath.Join(ssh.HomeDir(), path[1:])
+	}
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("reading public key: %s", err)
+	}
+	key := strings.TrimSpace(string(content))
+	if !strings.HasPrefix(key, "ssh-") && !strings.HasPrefix(key, "ecdsa-") {
+		return "", fmt.Errorf("%s is not a SSH public key", path)
+	}
+	return key, nil
+}
+
+const rescueHelperDevice = "/dev/sdf"
+
+func rescueTemplate(target *rescueTarget, scriptPath, helperType string) string {
+	var buf bytes.Buffer
+	if target.running {
+		fmt.Fprintf(&buf, "stop instance id=%s\n", target.id)
+		fmt.Fprintf(&buf, "check instance id=%s state=stopped timeout=600\n", target.id)
+	}
+	fmt.Fprintf(&buf, "detach volume id=%s instance=%s device=%s\n", target.rootVolume, target.id, target.rootDevice)
+	fmt.Fprintf(&buf, "check volume id=%s state=available timeout=300\n", target.rootVolume)
+	fmt.Fprintf(&buf, "helper = create instance distro=amazonlinux type=%s subnet=%s count=1 name=awless-rescue-%s userdata='%s'\n", helperType, target.subnet, target.id, scriptPath)
+	fmt.Fprintln(&buf, "check instance id=$helper state=running timeout=300")
+	fmt.Fprintf(&buf, "attach volume id=%s instance=$helper device=%s\n", target.rootVolume, rescueHelperDevice)
+	fmt.Fprintln(&buf, "check instance id=$helper state=stopped timeout=900")
+	fmt.Fprintf(&buf, "detach volume id=%s instance=$helper device=%s\n", target.rootVolume, rescueHelperDevice)
+	fmt.Fprintf(&buf, "check volume id=%s state=available timeout=300\n", target.rootVolume)
+	fmt.Fprintf(&buf, "attach volume id=%s instance=%s device=%s\n", target.rootVolume, target.id, target.rootDevice)
+	fmt.Fprintln(&buf, "delete instance id=$helper")
+	if target.running {
+		fmt.Fprintf(&buf, "start instance id=%s\n", target.id)
+	}
+	return buf.String()
+}
+
+// rescueScript is the userdata of the helper instance: it waits for the rescued root volume,
+// adds the public key to the authorized keys found on it, then shuts the helper down
+func rescueScript(pubkey string) string {
+	return fmt.Sprintf(`#!/bin/bash
+PUBKEY='%s'
+for i in $(seq 1 120); do
+  for dev in /dev/xvdf /dev/nvme1n1 /dev/sdf; do
+    if [ -b $dev ]; then DEVICE=$dev; break 2; fi
+  done
+  sleep 5
+done
+if [ -n "$DEVICE" ]; then
+  sleep 5
+  for part in ${DEVICE}1 ${DEVICE}p1; do
+    if [ -b $part ]; then DEVICE=$part; fi
+  done
+  mkdir -p /mnt/rescue
+  mount -o nouuid $DEVICE /mnt/rescue || mount $DEVICE /mnt/rescue
+  for home in /mnt/rescue/root /mnt/rescue/home/*; do
+    if [ -d $home/.ssh ]; then
+      echo "$PUBKEY" >> $home/.ssh/authorized_keys
+    fi
+  done
+  umount /mnt/rescue
+fi
+shutdown -h now
+`, strings.Replace(pubkey, "'", "", -1))
+}
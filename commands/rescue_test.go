@@ -0,0 +1,37 @@
+package commands
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/template"
+)
+
+func TestRescueTemplate(t *testing.T) {
+	target := &rescueTarget{id: "i-1234", rootVolume: "vol-1234", rootDevice: "/dev/xvda", subnet: "subnet-1234", running: true}
+	text := rescueTemplate(target, "/tmp/awless-rescue-1234", "t2.micro")
+	tpl, err := template.Parse(text)
+	if err != nil {
+		t.Fatalf("%s\n%s", text, err)
+	}
+	var got []string
+	for _, cmd := range tpl.CommandNodesIterator() {
+		got = append(got, cmd.Action+" "+cmd.Entity)
+	}
+	exp := []string{
+		"stop instance", "check instance", "detach volume", "check volume", "create instance", "check instance", "attach volume",
+		"check instance", "detach volume", "check volume", "attach volume", "delete instance", "start instance",
+	}
+	if strings.Join(got, ",") != strings.Join(exp, ",") {
+		t.Fatalf("got %v, want %v", got, exp)
+	}
+
+	target.running = false
+	tpl, err = template.Parse(rescueTemplate(target, "/tmp/awless-rescue-1234", "t2.micro"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(tpl.CommandNodesIterator()), len(exp)-3; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+}
@@ -23,24 +23,35 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/cloud/rdf"
 	"github.com/wallix/awless/config"
 	"github.com/wallix/awless/database"
 	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/sync"
 	"github.com/wallix/awless/template"
 )
 
+var revertStackFlag string
+
 func init() {
 	RootCmd.AddCommand(revertCmd)
+	revertCmd.Flags().StringVar(&revertStackFlag, "stack", "", "Revert all the runs of the given stack, latest first (see `awless log --stacks`)")
 }
 
 var revertCmd = &cobra.Command{
 	Use:               "revert REVERTID",
 	Short:             "Revert a template from a revert ID (see `awless log`). If deployment has changed there is no guarantee that it is still revertible.",
-	Example:           "  awless revert 01BA7RV6ES86PZYCM3H28WM6KZ",
+	Example:           "  awless revert 01BA7RV6ES86PZYCM3H28WM6KZ\n  awless revert --stack demo-42",
 	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, initSyncerHook, firstInstallDoneHook),
-	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook, apiCallsHook),
 
 	RunE: func(c *cobra.Command, args []string) error {
+		if revertStackFlag != "" {
+			exitOn(deleteStack(revertStackFlag))
+			return nil
+		}
 		if len(args) < 1 {
 			return errors.New("REVERTID required (see `awless log` to list revert ids)")
 		}
@@ -65,6 +76,7 @@ var revertCmd = &cobra.Command{
 
 		reverted, err := loaded.Template.Revert()
 		exitOn(err)
+		reverted = reverted.OrderTeardown(localTeardownDependencies())
 
 		tplExec := &template.TemplateExecution{
 			Template: reverted,
@@ -79,3 +91,50 @@ var revertCmd = &cobra.Command{
 		return nil
 	},
 }
+
+// localTeardownDependencies returns the dependencies between resources known from the local graphs
+// to order their deletion, or nil when the graphs cannot be loaded (the order being then based on entities)
+func localTeardownDependencies() template.DependsOnFunc {
+	g, err := sync.LoadLocalGraphs(config.GetAWSProfile(), config.GetAWSRegion())
+	if err != nil {
+		logger.Verbosef("cannot load local graphs to order the deletions: %s", err)
+		return nil
+	}
+	return graphTeardownDependencies(g)
+}
+
+// graphTeardownDependencies tells whether a resource has to be deleted before another one:
+// when it is one of its descendants or references it in its properties (ex: subnet of an instance)
+func graphTeardownDependencies(g cloud.GraphAPI) template.DependsOnFunc {
+	return func(id, otherID string) bool {
+		found, err := g.FindWithProperties(map[string]interface{}{properties.ID: id})
+		if err != nil || len(found) != 1 {
+			return false
+		}
+		res := found[0]
+		for _, v := range res.Properties() {
+			switch vv := v.(type) {
+			case string:
+				if vv == otherID {
+					return true
+				}
+			case []string:
+				for _, s := range vv {
+					if s == otherID {
+						return true
+					}
+				}
+			}
+		}
+		parents, err := g.ResourceRelations(res, rdf.ParentOf, true)
+		if err != nil {
+			return false
+		}
+		for _, p := range parents {
+			if p.Id() == otherID {
+				return true
+			}
+		}
+		return false
+	}
+}
@@ -0,0 +1,37 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+)
+
+func TestGraphTeardownDependencies(t *testing.T) {
+	g := graph.NewGraph()
+	vpc, sub := graph.InitResource(cloud.Vpc, "vpc-1"), graph.InitResource(cloud.Subnet, "sub-1")
+	inst := graph.InitResource(cloud.Instance, "i-1")
+	inst.Properties()[properties.Subnet] = "sub-1"
+	inst.Properties()[properties.SecurityGroups] = []string{"sg-1", "sg-2"}
+	g.AddResource(vpc, sub, inst)
+	g.AddParentRelation(vpc, sub)
+
+	dependsOn := graphTeardownDependencies(g)
+	tcases := []struct {
+		id, other string
+		exp       bool
+	}{
+		{"i-1", "sub-1", true},
+		{"i-1", "sg-2", true},
+		{"sub-1", "vpc-1", true},
+		{"vpc-1", "sub-1", false},
+		{"sub-1", "i-1", false},
+		{"unknown", "vpc-1", false},
+	}
+	for _, tcase := range tcases {
+		if got, want := dependsOn(tcase.id, tcase.other), tcase.exp; got != want {
+			t.Fatalf("%s depends on %s: got %t, want %t", tcase.id, tcase.other, got, want)
+		}
+	}
+}
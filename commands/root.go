@@ -17,22 +17,35 @@ limitations under the License.
 package commands
 
 import (
+	"fmt"
+	"os"
+
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
+	"github.com/wallix/awless/console"
+	"github.com/wallix/awless/prompt"
 )
 
 var (
 	verboseGlobalFlag      bool
 	extraVerboseGlobalFlag bool
 	silentGlobalFlag       bool
+	quietGlobalFlag        bool
+	logFileGlobalFlag      string
 	localGlobalFlag        bool
 	noSyncGlobalFlag       bool
 	forceGlobalFlag        bool
+	readOnlyGlobalFlag     bool
+	noInputGlobalFlag      bool
+	dryRunGlobalFlag       bool
 	versionGlobalFlag      bool
 	awsRegionGlobalFlag    string
 	awsProfileGlobalFlag   string
 	awsColorGlobalFlag     string
+	noColorGlobalFlag      bool
+	absoluteTimeGlobalFlag bool
 	networkMonitorFlag     bool
+	showAPICallsFlag       bool
 
 	renderGreenFn    = color.New(color.FgGreen).SprintFunc()
 	renderRedFn      = color.New(color.FgRed).SprintFunc()
@@ -45,14 +58,24 @@ func init() {
 	RootCmd.PersistentFlags().BoolVarP(&verboseGlobalFlag, "verbose", "v", false, "Turn on verbose mode for all commands")
 	RootCmd.PersistentFlags().BoolVarP(&extraVerboseGlobalFlag, "extra-verbose", "e", false, "Turn on extra verbose mode (including regular verbose) for all commands")
 	RootCmd.PersistentFlags().BoolVar(&silentGlobalFlag, "silent", false, "Turn on silent mode for all commands: disable logging, etc...")
+	RootCmd.PersistentFlags().BoolVarP(&quietGlobalFlag, "quiet", "q", false, "Only log errors and print the resulting IDs of run commands on stdout (for scripts)")
+	RootCmd.PersistentFlags().StringVar(&logFileGlobalFlag, "log-file", "", "Append all logs, including verbose ones, to this file")
 	RootCmd.PersistentFlags().BoolVarP(&localGlobalFlag, "local", "l", false, "Work offline only using locally synced resources")
 	RootCmd.PersistentFlags().BoolVarP(&forceGlobalFlag, "force", "f", false, "Force the command and bypass confirmation prompts")
+	RootCmd.PersistentFlags().BoolVar(&noInputGlobalFlag, "no-input", false, fmt.Sprintf("Never prompt: fail with exit code %d and a JSON line on stderr giving the reason instead (ex: for CI pipelines)", prompt.ExitCode))
+	RootCmd.PersistentFlags().BoolVar(&readOnlyGlobalFlag, "readonly", false, "Block all actions modifying cloud resources (see also `awless config set mode readonly`)")
+	RootCmd.PersistentFlags().BoolVar(&dryRunGlobalFlag, "dry-run", false, "Stop after the dry run of the command and print the API calls it would perform")
 	RootCmd.PersistentFlags().BoolVar(&noSyncGlobalFlag, "no-sync", false, "Do not run any sync on command")
 	RootCmd.PersistentFlags().StringVarP(&awsRegionGlobalFlag, "aws-region", "r", "", "Override AWS region temporarily for the current command")
 	RootCmd.PersistentFlags().SetAnnotation("aws-region", cobra.BashCompCustom, []string{"__awless_region_list"})
+	RootCmd.PersistentFlags().StringVar(&awsRegionGlobalFlag, "region", "", "Override AWS region temporarily for the current command (same as --aws-region, or AWLESS_REGION variable)")
+	RootCmd.PersistentFlags().SetAnnotation("region", cobra.BashCompCustom, []string{"__awless_region_list"})
 	RootCmd.PersistentFlags().StringVarP(&awsProfileGlobalFlag, "aws-profile", "p", "", "Override AWS profile temporarily for the current command")
 	RootCmd.PersistentFlags().SetAnnotation("aws-profile", cobra.BashCompCustom, []string{"__awless_profile_list"})
 	RootCmd.PersistentFlags().StringVar(&awsColorGlobalFlag, "color", "auto", "Force enabling/disabling colors in display (auto, never, always)")
+	RootCmd.PersistentFlags().BoolVar(&noColorGlobalFlag, "no-color", false, "Disable colors in display (same as --color never or setting NO_COLOR variable)")
+	RootCmd.PersistentFlags().BoolVar(&absoluteTimeGlobalFlag, "absolute-time", false, "Display dates instead of relative times (ex: 3d ago) in tables")
+	RootCmd.PersistentFlags().BoolVar(&showAPICallsFlag, "show-api-calls", false, "Display the AWS API calls (count and retries per operation) made by the command")
 	RootCmd.PersistentFlags().BoolVar(&networkMonitorFlag, "network-monitor", false, "Debug requests with network monitor")
 	RootCmd.PersistentFlags().MarkHidden("network-monitor")
 
@@ -60,16 +83,19 @@ func init() {
 
 	cobra.AddTemplateFunc("IsCmdAnnotatedOneliner", IsCmdAnnotatedOneliner)
 	cobra.AddTemplateFunc("HasCmdOnelinerChilds", HasCmdOnelinerChilds)
+	cobra.AddTemplateFunc("LongHelp", commandLongHelp)
 
 	RootCmd.SetUsageTemplate(customRootUsage)
 
 	cobra.OnInitialize(func() {
-		switch awsColorGlobalFlag {
-		case "never":
-			color.NoColor = true
-		case "always":
+		switch {
+		case awsColorGlobalFlag == "always":
 			color.NoColor = false
+		case awsColorGlobalFlag == "never", noColorGlobalFlag, os.Getenv("NO_COLOR") != "":
+			color.NoColor = true
 		}
+		console.AbsoluteTime = absoluteTimeGlobalFlag
+		prompt.Disabled = noInputGlobalFlag || os.Getenv("AWLESS_NO_INPUT") != ""
 	})
 }
 
@@ -45,7 +45,10 @@ import (
 	"github.com/wallix/awless/cloud/match"
 	"github.com/wallix/awless/cloud/properties"
 	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/database"
+	"github.com/wallix/awless/graph"
 	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/prompt"
 	"github.com/wallix/awless/sync"
 	"github.com/wallix/awless/template"
 	"github.com/wallix/awless/template/params"
@@ -58,6 +61,12 @@ var (
 	listRemoteTemplatesFlag bool
 	noSuggestedParamsFlag   bool
 	allSuggestedParamsFlag  bool
+	runOutputFormatFlag     string
+	resumeRunFlag           string
+	idempotentRunFlag       bool
+	runLockFlag             string
+	backupEveryFlag         string
+	backupTimesFlag         int
 )
 
 func init() {
@@ -66,6 +75,12 @@ func init() {
 	runCmd.Flags().StringVar(&scheduleRunInFlag, "run-in", "", "Postpone the execution of this template")
 	runCmd.Flags().StringVar(&scheduleRevertInFlag, "revert-in", "", "Schedule the revertion of this template")
 	runCmd.Flags().StringVarP(&runLogMessage, "message", "m", "", "Add a message for this template execution to be persisted in your logs")
+	runCmd.Flags().StringVar(&runOutputFormatFlag, "output", "text", "Format of the template outputs printed at the end of the run: text, json")
+	runCmd.Flags().BoolVar(&idempotentRunFlag, "idempotent", false, "Reuse the existing resources with the same name (and VPC, subnet) instead of creating duplicates, for safe reruns")
+	runCmd.Flags().StringVar(&runLockFlag, "lock", "", "Lock the given environment name during the run, failing if already locked by another run (see `awless config` lock.dynamodb.table or lock.s3.bucket for a lock shared by all operators)")
+	runCmd.Flags().StringVar(&runStackFlag, "stack", "", "Label this run with a stack name: created EC2 resources are tagged "+stackTagKey+"=NAME and all the runs of the stack can be deleted at once (see `awless log --stacks`)")
+	runCmd.Flags().StringVar(&runExportEnvFlag, "export-env", "", "Write the IDs of the created resources (named after their template references) and the outputs as VAR=value lines in the given file, for the next steps of a pipeline")
+	runCmd.Flags().StringVar(&resumeRunFlag, "resume", "", "Resume a failed template run (see `awless log`) from its failed command, skipping the commands already succeeded")
 
 	var actions []string
 	for a := range awsspec.DriverSupportedActions {
@@ -79,6 +94,17 @@ func init() {
 		cmd := createDriverCommands(action, entities)
 		cmd.PersistentFlags().StringVar(&scheduleRunInFlag, "run-in", "", "Postpone the execution of this command")
 		cmd.PersistentFlags().StringVar(&scheduleRevertInFlag, "revert-in", "", "Schedule the revertion of this command")
+		cmd.PersistentFlags().StringVar(&runStackFlag, "stack", "", "Label this command run with a stack name (see `awless run --stack`)")
+		if action == "delete" {
+			addBatchDeleteCommands(cmd, entities)
+		}
+		if action == "backup" {
+			cmd.PersistentFlags().StringVar(&backupEveryFlag, "every", "", "Schedule periodic backups with the given interval (ex: 24h) through the scheduler, starting after --run-in. Requires --times")
+			cmd.PersistentFlags().IntVar(&backupTimesFlag, "times", 0, "Number of periodic backups scheduled with --every. The scheduler does not repeat tasks: backups stop after the last one")
+		}
+		if action == "create" {
+			cmd.PersistentFlags().BoolVar(&idempotentRunFlag, "idempotent", false, "Reuse the existing resource with the same name instead of creating a duplicate")
+		}
 		RootCmd.AddCommand(cmd)
 	}
 }
@@ -88,15 +114,19 @@ const maxMsgLen = 140
 var runCmd = &cobra.Command{
 	Use:               "run PATH",
 	Short:             "Run a template given a filepath or URL",
-	Example:           "  awless run ~/templates/my-infra.aws\n  awless run https://raw.githubusercontent.com/wallix/awless-templates/master/create_vpc.aws\n  awless run repo:create_vpc",
+	Example:           "  awless run ~/templates/my-infra.aws\n  awless run https://raw.githubusercontent.com/wallix/awless-templates/master/create_vpc.aws\n  awless run repo:create_vpc\n  awless run ~/templates/my-instance.aws --output json    # print outputs (ex: output ip = $inst.publicip) as json\n  awless run ~/templates/my-db.aws password=kms:AQICAH... # KMS encrypted value, decrypted at run time and never logged in clear\n  awless run --resume 01BA7RV6ES86PZYCM3H28WM6KZ          # retry a failed run from its failed command, reusing the resources already created\n  awless run ~/templates/my-infra.aws --stack demo-42     # label the run, deleting all the runs of the stack with `awless delete stack demo-42`",
 	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, initSyncerHook, firstInstallDoneHook),
-	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook, apiCallsHook),
 
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if listRemoteTemplatesFlag {
 			exitOn(listRemoteTemplates())
 			return nil
 		}
+		if resumeRunFlag != "" {
+			exitOn(resumeRun(resumeRunFlag))
+			return nil
+		}
 		if len(args) < 1 {
 			return errors.New("missing PATH arg (filepath or url)")
 		}
@@ -111,10 +141,10 @@ var runCmd = &cobra.Command{
 		logger.Verbosef("Loaded template text:\n\n%s\n", removeComments(content))
 
 		templ, err := template.Parse(string(content))
-		exitOn(err)
+		exitOn(validationErr(err))
 
 		extraParams, err := template.ParseParams(strings.Join(args[1:], " "))
-		exitOn(err)
+		exitOn(validationErr(err))
 
 		tplExec := &template.TemplateExecution{
 			Template: templ,
@@ -125,15 +155,72 @@ var runCmd = &cobra.Command{
 			Source:   templ.String(),
 		}
 
-		exitOn(NewRunnerRequiredParamsOnly(tplExec.Template, tplExec.Message, tplExec.Path, config.Defaults, extraParams).Run())
+		exitOn(runLocked(NewRunnerRequiredParamsOnly(tplExec.Template, tplExec.Message, tplExec.Path, config.Defaults, extraParams)))
 
 		return nil
 	},
 }
 
+// runLocked runs the template holding, once confirmed, the lock of the environment given with --lock (if any)
+func runLocked(runner *template.Runner) error {
+	if runLockFlag == "" {
+		return runner.Run()
+	}
+	defer releaseHeldRunLock()
+	beforeRun := runner.BeforeRun
+	runner.BeforeRun = func(tplExec *template.TemplateExecution) (bool, error) {
+		ok, err := beforeRun(tplExec)
+		if !ok || err != nil {
+			return ok, err
+		}
+		if heldRunLock, err = acquireRunLock(runLockFlag); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+	return runner.Run()
+}
+
+func resumeRun(runID string) error {
+	var loaded *template.TemplateExecution
+	if err := database.Execute(func(db *database.DB) (terr error) {
+		loaded, terr = db.GetTemplate(runID)
+		return
+	}); err != nil {
+		return err
+	}
+
+	if loc := loaded.Locale; loc != "" && loc != config.GetAWSRegion() {
+		return fmt.Errorf("run %s was originally in region %s: resume with `awless run --resume %s -r %s -p %s`", runID, loc, runID, loc, loaded.Profile)
+	}
+	if prof := loaded.Profile; prof != config.GetAWSProfile() {
+		logger.Warningf("This template was originally run with profile %s", prof)
+	}
+
+	templ, err := template.Parse(loaded.Source)
+	if err != nil {
+		return validationErr(fmt.Errorf("cannot parse source of run %s: %s", runID, err))
+	}
+
+	if runStackFlag == "" {
+		runStackFlag = loaded.Stack
+	}
+	msg := runLogMessage
+	if msg == "" {
+		msg = fmt.Sprintf("Resume %s: %s", loaded.ID, loaded.Message)
+	}
+	runner := NewRunnerRequiredParamsOnly(templ, msg, loaded.Path, loaded.Fillers)
+	runner.Resume = loaded
+	return runLocked(runner)
+}
+
 func missingHolesStdinFunc() func(string, []string, bool) string {
 	var count int
 	return func(hole string, paramPaths []string, optional bool) (response string) {
+		if optional && prompt.Disabled {
+			return ""
+		}
+		prompt.ExitIfDisabled(prompt.TemplateParam, fmt.Sprintf("missing value for '%s': give it on the command line", hole))
 		if count < 1 {
 			fmt.Println("Please specify " + "(" + renderYellowFn("TAB") + " for completion, " + renderYellowFn("','+TAB") + " for list completion, " + renderYellowFn("Enter") + " to skip optionals, " + renderYellowFn("Ctrl+C") + " to quit) :")
 		}
@@ -232,6 +319,44 @@ func (l *onceLoader) mustLoad() cloud.GraphAPI {
 
 var allGraphsOnce = &onceLoader{}
 
+// localGraphsCache loads the local graphs once per command, per service
+// or for all services, as the aliases of a template are resolved
+type localGraphsCache struct {
+	mu         stdsync.Mutex
+	byService  map[string]cloud.GraphAPI
+	allGraphs  cloud.GraphAPI
+	allErr     error
+	allFetched bool
+}
+
+var aliasGraphs = &localGraphsCache{byService: make(map[string]cloud.GraphAPI)}
+
+// service returns the local graph of the service of the resource type (empty if unknown or never synced)
+func (c *localGraphsCache) service(resType string) cloud.GraphAPI {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	srvName := awsservices.ServicePerResourceType[resType]
+	if g, ok := c.byService[srvName]; ok {
+		return g
+	}
+	var g cloud.GraphAPI = graph.NewGraph()
+	if srvName != "" {
+		g = sync.LoadLocalGraphForService(srvName, config.GetAWSProfile(), config.GetAWSRegion())
+	}
+	c.byService[srvName] = g
+	return g
+}
+
+func (c *localGraphsCache) all() (cloud.GraphAPI, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.allFetched {
+		c.allGraphs, c.allErr = sync.LoadLocalGraphs(config.GetAWSProfile(), config.GetAWSRegion())
+		c.allFetched = true
+	}
+	return c.allGraphs, c.allErr
+}
+
 func createDriverCommands(action string, entities []string) *cobra.Command {
 	actionCmd := &cobra.Command{
 		Use:               fmt.Sprintf("%s ENTITY [param=value ...]", action),
@@ -239,7 +364,7 @@ func createDriverCommands(action string, entities []string) *cobra.Command {
 		Long:              fmt.Sprintf("Allow to %s: %v", action, strings.Join(entities, ", ")),
 		Annotations:       map[string]string{"one-liner": "true"},
 		PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, initSyncerHook, firstInstallDoneHook),
-		PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook),
+		PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook, apiCallsHook),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) == 0 {
 				return fmt.Errorf("missing ENTITY")
@@ -278,16 +403,20 @@ func createDriverCommands(action string, entities []string) *cobra.Command {
 		}
 		run := func(def awsspec.Definition) func(cmd *cobra.Command, args []string) error {
 			return func(cmd *cobra.Command, args []string) error {
+				if def.Action == "delete" && def.Entity == "stack" && len(args) == 1 && !strings.Contains(args[0], "=") && isLocalStack(args[0]) {
+					exitOn(deleteStack(args[0]))
+					return nil
+				}
 				text := fmt.Sprintf("%s %s %s", def.Action, def.Entity, strings.Join(args, " "))
 
 				templ, err := template.Parse(text)
 				if err != nil {
 					_, resources, matchingProperty := resolveResourceFromRefInCurrentRegion(args[0])
 					if len(resources) != 1 {
-						exitOn(err)
+						exitOn(validationErr(err))
 					}
 					templ, err = suggestFixParsingError(def, args, matchingProperty, err)
-					exitOn(err)
+					exitOn(validationErr(err))
 				}
 
 				tplExec := &template.TemplateExecution{
@@ -306,25 +435,7 @@ func createDriverCommands(action string, entities []string) *cobra.Command {
 			apiStr = fmt.Sprint(strings.ToUpper(api) + " ")
 		}
 
-		var paramsStr bytes.Buffer
 		allParams, optParams, _ := params.List(templDef.Params)
-		tab := tabwriter.NewWriter(&paramsStr, 0, 0, 3, '.', 0)
-		for _, p := range allParams {
-			fmt.Fprintf(tab, "  %s\t", p)
-			if d, ok := awsdoc.TemplateParamsDocWithEnums(templDef.Action, templDef.Entity, p); ok {
-				fmt.Fprintf(tab, " %s", d)
-			}
-			fmt.Fprintln(tab)
-		}
-		for _, p := range optParams {
-			fmt.Fprintf(tab, "  [%s]\t", p)
-			if d, ok := awsdoc.TemplateParamsDocWithEnums(templDef.Action, templDef.Entity, p); ok {
-				fmt.Fprintf(tab, " %s", d)
-			}
-			fmt.Fprintln(tab)
-		}
-		tab.Flush()
-
 		var validArgs []string
 		for _, param := range append(allParams, optParams...) {
 			validArgs = append(validArgs, param+"=")
@@ -332,13 +443,13 @@ func createDriverCommands(action string, entities []string) *cobra.Command {
 		currentCmd := &cobra.Command{
 			Use:               fmt.Sprintf("%s [param=value ...]", templDef.Entity),
 			PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, initSyncerHook, firstInstallDoneHook),
-			PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook),
+			PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook, apiCallsHook),
 			Short:             awsdoc.AwlessCommandDefinitionsDoc(action, templDef.Entity, fmt.Sprintf("%s a %s%s", strings.Title(action), apiStr, templDef.Entity)),
-			Long:              fmt.Sprintf("PARAMS:\n%s\nPARAMS PATTERNS:\n  %s\n\nSEE ALSO:\n%s", paramsStr.String(), templDef.Params, availableActionsForEntity(templDef.Entity)),
 			Example:           awsdoc.AwlessExamplesDoc(action, templDef.Entity),
 			RunE:              run(templDef),
 			ValidArgs:         validArgs,
 		}
+		driverCommandsLongHelp[currentCmd] = driverCommandLongHelpFunc(templDef)
 		currentCmd.SetUsageTemplate(customCommandUsageTemplate)
 		currentCmd.SetHelpTemplate(`{{with .Short}}{{. | trimTrailingWhitespaces}}
 {{end}}{{if or .Runnable .HasSubCommands}}{{.UsageString}}{{end}}`)
@@ -373,6 +484,9 @@ func runSyncFor(tplExec *template.TemplateExecution) {
 	if _, err := sync.DefaultSyncer.Sync(services...); err != nil {
 		logger.ExtraVerbosef(err.Error())
 	}
+	if !noSyncGlobalFlag {
+		pushSyncSnapshot()
+	}
 }
 
 func resolveAliasFunc(paramPath, alias string) string {
@@ -387,11 +501,6 @@ func resolveAliasFunc(paramPath, alias string) string {
 		typedParam = tparam
 	}
 
-	gph, err := sync.LoadLocalGraphs(config.GetAWSProfile(), config.GetAWSRegion())
-	if err != nil {
-		fmt.Printf("resolve alias '%s': cannot load local graphs for region %s: %s\n", alias, config.GetAWSRegion(), err)
-		return ""
-	}
 	resType := key
 	if typedParam != nil {
 		resType = typedParam.ResourceType
@@ -401,6 +510,9 @@ func resolveAliasFunc(paramPath, alias string) string {
 		}
 	}
 
+	// the resources of the type are all in the graph of their service: the graphs
+	// of all services are only loaded when the alias does not name one of them
+	gph := aliasGraphs.service(resType)
 	resources, err := gph.Find(cloud.NewQuery(resType).Match(match.And(match.Property("Name", alias))))
 	if err != nil {
 		return ""
@@ -410,6 +522,11 @@ func resolveAliasFunc(paramPath, alias string) string {
 	case 1:
 		matchingResource = resources[0]
 	default:
+		gph, err = aliasGraphs.all()
+		if err != nil {
+			fmt.Printf("resolve alias '%s': cannot load local graphs for region %s: %s\n", alias, config.GetAWSRegion(), err)
+			return ""
+		}
 		resources, err := gph.FindWithProperties(map[string]interface{}{"Name": alias})
 		if err != nil {
 			return ""
@@ -596,38 +713,89 @@ func isCSV(s string) bool {
 	return true
 }
 
-func scheduleTemplate(t *template.Template, runIn, revertIn string) error {
+func scheduleTemplate(t *template.Template, runIn, revertIn, every string, times int) error {
+	forms, err := scheduleForms(t.String(), config.GetAWSRegion(), runIn, revertIn, every, times)
+	if err != nil {
+		return err
+	}
 	schedClient, err := client.New(config.GetSchedulerURL())
 	if err != nil {
 		return fmt.Errorf("cannot connect to scheduler: %s", err)
 	}
 	logger.Verbosef("sending template to scheduler %s", schedClient.ServiceURL)
 
-	if err := schedClient.Post(client.Form{
-		Region:   config.GetAWSRegion(),
-		RunIn:    runIn,
-		RevertIn: revertIn,
-		Template: t.String(),
-	}); err != nil {
-		return fmt.Errorf("cannot schedule template: %s", err)
+	for _, form := range forms {
+		if err := schedClient.Post(form); err != nil {
+			return fmt.Errorf("cannot schedule template: %s", err)
+		}
 	}
 
-	logger.Info("template scheduled successfully")
+	if len(forms) > 1 {
+		logger.Infof("template scheduled successfully: %d runs every %s", len(forms), every)
+		logger.Warningf("the scheduler does not repeat tasks: the last of these runs is in %s. Schedule it again before then to keep it running", forms[len(forms)-1].RunIn)
+	} else {
+		logger.Info("template scheduled successfully")
+	}
 
 	return nil
 }
 
+// scheduleForms returns the scheduler forms of a template: a single run, or with every set, the given
+// number of runs spaced by the every interval (ex: periodic backups), each with the same revert delay.
+// The scheduler has no recurring tasks, hence the explicit number of runs.
+func scheduleForms(tpl, region, runIn, revertIn, every string, times int) ([]client.Form, error) {
+	if every == "" {
+		return []client.Form{{Region: region, RunIn: runIn, RevertIn: revertIn, Template: tpl}}, nil
+	}
+	interval, err := time.ParseDuration(every)
+	if err != nil || interval <= 0 {
+		return nil, fmt.Errorf("invalid interval '%s': expecting a positive duration (ex: 24h)", every)
+	}
+	if times < 1 {
+		return nil, fmt.Errorf("invalid number of scheduled runs %d: expecting at least 1 with --times, as the scheduler does not repeat tasks", times)
+	}
+	var start, revert time.Duration
+	if runIn != "" {
+		if start, err = time.ParseDuration(runIn); err != nil {
+			return nil, fmt.Errorf("invalid run delay '%s': %s", runIn, err)
+		}
+	}
+	if revertIn != "" {
+		if revert, err = time.ParseDuration(revertIn); err != nil {
+			return nil, fmt.Errorf("invalid revert delay '%s': %s", revertIn, err)
+		}
+	}
+	var forms []client.Form
+	for i := 0; i < times; i++ {
+		form := client.Form{Region: region, RunIn: (start + time.Duration(i)*interval).String(), Template: tpl}
+		if revertIn != "" {
+			form.RevertIn = (revert + time.Duration(i)*interval).String()
+		}
+		forms = append(forms, form)
+	}
+	return forms, nil
+}
+
 func suggestFixParsingError(def awsspec.Definition, args []string, matchingProperty string, defaultErr error) (*template.Template, error) {
-	if len(def.Params.Required()) != 1 || len(args) != 1 {
+	required := def.Params.Required()
+	if len(required) == 0 || len(args) != len(required) {
 		return nil, defaultErr
 	}
-	propKey := def.Params.Required()[0]
+	for _, arg := range args[1:] {
+		if strings.Contains(arg, "=") {
+			return nil, defaultErr
+		}
+	}
+	propKey := required[0]
 	propValue := args[0]
 	if matchingProperty == properties.Name && !strings.HasPrefix(propValue, "@") && !strings.HasSuffix(propKey, "name") {
 		propValue = "@" + propValue
 	}
 
 	suggestText := fmt.Sprintf("%s %s %s=%s", def.Action, def.Entity, propKey, propValue)
+	for i, arg := range args[1:] {
+		suggestText += fmt.Sprintf(" %s=%s", required[i+1], arg)
+	}
 
 	if !promptConfirmDefaultYes("Did you mean `awless %s` ? ", suggestText) {
 		return nil, defaultErr
@@ -644,8 +812,9 @@ func suggestFixParsingError(def awsspec.Definition, args []string, matchingPrope
 func isSchedulingMode() bool {
 	runin := strings.TrimSpace(scheduleRunInFlag)
 	revertin := strings.TrimSpace(scheduleRevertInFlag)
+	every := strings.TrimSpace(backupEveryFlag)
 
-	if runin != "" || revertin != "" {
+	if runin != "" || revertin != "" || every != "" {
 		return true
 	}
 	return false
@@ -659,6 +828,41 @@ func joinSentence(arr []string) string {
 	return strings.Join(arr, sep)
 }
 
+// Long help of one-liner commands is only built when displayed
+// since generating the params docs of all commands slows down startup
+var driverCommandsLongHelp = make(map[*cobra.Command]func() string)
+
+func commandLongHelp(c *cobra.Command) string {
+	if fn, ok := driverCommandsLongHelp[c]; ok {
+		return fn()
+	}
+	return c.Long
+}
+
+func driverCommandLongHelpFunc(templDef awsspec.Definition) func() string {
+	return func() string {
+		var paramsStr bytes.Buffer
+		allParams, optParams, _ := params.List(templDef.Params)
+		tab := tabwriter.NewWriter(&paramsStr, 0, 0, 3, '.', 0)
+		for _, p := range allParams {
+			fmt.Fprintf(tab, "  %s\t", p)
+			if d, ok := awsdoc.TemplateParamsDocWithEnums(templDef.Action, templDef.Entity, p); ok {
+				fmt.Fprintf(tab, " %s", d)
+			}
+			fmt.Fprintln(tab)
+		}
+		for _, p := range optParams {
+			fmt.Fprintf(tab, "  [%s]\t", p)
+			if d, ok := awsdoc.TemplateParamsDocWithEnums(templDef.Action, templDef.Entity, p); ok {
+				fmt.Fprintf(tab, " %s", d)
+			}
+			fmt.Fprintln(tab)
+		}
+		tab.Flush()
+		return fmt.Sprintf("PARAMS:\n%s\nPARAMS PATTERNS:\n  %s\n\nSEE ALSO:\n%s", paramsStr.String(), templDef.Params, availableActionsForEntity(templDef.Entity))
+	}
+}
+
 const customCommandUsageTemplate = `
 USAGE:{{if .Runnable}}
   {{.UseLine}}{{end}}{{if .HasAvailableSubCommands}}
@@ -670,7 +874,7 @@ ALIASES:
 EXAMPLES:
 {{.Example}}{{end}}
 
-{{.Long}}{{if .HasAvailableSubCommands}}
+{{LongHelp .}}{{if .HasAvailableSubCommands}}
 
 AVAILABLE COMMANDS:{{range .Commands}}{{if (or .IsAvailableCommand (eq .Name "help"))}}
   {{rpad .Name .NamePadding }} {{.Short}}{{end}}{{end}}{{end}}{{if .HasAvailableLocalFlags}}
@@ -688,6 +892,7 @@ Use "{{.CommandPath}} [command] --help" for more information about a command.{{e
 `
 
 func promptConfirmDefaultYes(msg string, a ...interface{}) bool {
+	prompt.ExitIfDisabled(prompt.Confirmation, fmt.Sprintf(msg, a...))
 	var yesorno string
 	fmt.Fprintf(os.Stderr, "%s [Y/n] ", fmt.Sprintf(msg, a...))
 	fmt.Scanln(&yesorno)
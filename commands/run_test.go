@@ -1,6 +1,19 @@
 package commands
 
-import "testing"
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless-scheduler/client"
+	"github.com/wallix/awless/aws/spec"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/template"
+)
 
 func TestIsCSV(t *testing.T) {
 	tcases := []struct {
@@ -43,3 +56,153 @@ func TestJoinSentence(t *testing.T) {
 		}
 	}
 }
+
+func TestFindEquivalentResource(t *testing.T) {
+	g := graph.NewGraph()
+	sub1 := graph.InitResource(cloud.Subnet, "subnet-1")
+	sub1.Properties()[properties.Name] = "web"
+	sub1.Properties()[properties.Vpc] = "vpc-1"
+	sub2 := graph.InitResource(cloud.Subnet, "subnet-2")
+	sub2.Properties()[properties.Name] = "web"
+	sub2.Properties()[properties.Vpc] = "vpc-2"
+	key := graph.InitResource(cloud.Keypair, "mykey")
+	inst := graph.InitResource(cloud.Instance, "i-1")
+	inst.Properties()[properties.Name] = "old"
+	inst.Properties()[properties.State] = "terminated"
+	g.AddResource(sub1, sub2, key, inst)
+
+	tcases := []struct {
+		entity string
+		params map[string]interface{}
+		expID  string
+		expErr bool
+	}{
+		{entity: cloud.Subnet, params: map[string]interface{}{"name": "web", "vpc": "vpc-2"}, expID: "subnet-2"},
+		{entity: cloud.Subnet, params: map[string]interface{}{"name": "web", "vpc": "vpc-3"}, expID: ""},
+		{entity: cloud.Subnet, params: map[string]interface{}{"name": "web"}, expErr: true},
+		{entity: cloud.Keypair, params: map[string]interface{}{"name": "mykey"}, expID: "mykey"},
+		{entity: cloud.Instance, params: map[string]interface{}{"name": "old"}, expID: ""},
+	}
+	for i, tcase := range tcases {
+		id, err := findEquivalentResource(g, tcase.entity, tcase.params)
+		if tcase.expErr {
+			if err == nil {
+				t.Fatalf("%d: expected error", i+1)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%d: %s", i+1, err)
+		}
+		if got, want := id, tcase.expID; got != want {
+			t.Fatalf("%d: got %s, want %s", i+1, got, want)
+		}
+	}
+}
+
+func TestCountMatchingResources(t *testing.T) {
+	g := graph.NewGraph()
+	for i, state := range []string{"running", "running", "stopped"} {
+		inst := graph.InitResource(cloud.Instance, fmt.Sprintf("i-%d", i+1))
+		inst.Properties()[properties.State] = state
+		inst.Properties()[properties.AvailabilityZone] = "eu-west-1a"
+		inst.Properties()[properties.Tags] = []string{"env=prod"}
+		g.AddResource(inst)
+	}
+
+	tcases := []struct {
+		conditions map[string]string
+		exp        int
+	}{
+		{conditions: map[string]string{}, exp: 3},
+		{conditions: map[string]string{"state": "running"}, exp: 2},
+		{conditions: map[string]string{"tag:env": "prod", "State": "stopped"}, exp: 1},
+		{conditions: map[string]string{"availability-zone": "eu-west-1a", "id": "i-2"}, exp: 1},
+		{conditions: map[string]string{"tag:env": "dev"}, exp: 0},
+		{conditions: map[string]string{"unknown": "value"}, exp: 0},
+	}
+	for i, tcase := range tcases {
+		count, err := countMatchingResources(g, cloud.Instance, tcase.conditions)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := count, tcase.exp; got != want {
+			t.Fatalf("%d: got %d, want %d", i+1, got, want)
+		}
+	}
+}
+
+func TestPrintGeneratedSecrets(t *testing.T) {
+	tpl := template.MustParse("create loginprofile username=jdoe password=auto(24)\ncreate user name=jdoe")
+	tpl.CommandNodesIterator()[0].GeneratedSecrets = map[string]string{"password": "s3cr3t"}
+
+	var out bytes.Buffer
+	printGeneratedSecrets(&out, tpl, false)
+	if got := out.String(); !strings.Contains(got, "Generated secrets for create loginprofile") || !strings.Contains(got, "password = s3cr3t") {
+		t.Fatalf("got %s", got)
+	}
+
+	out.Reset()
+	printGeneratedSecrets(&out, tpl, true)
+	if got, want := out.String(), "password = s3cr3t\n"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestScheduleForms(t *testing.T) {
+	forms, err := scheduleForms("backup instance id=i-1", "eu-west-1", "1h", "", "", 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := forms, []client.Form{{Region: "eu-west-1", RunIn: "1h", Template: "backup instance id=i-1"}}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %#v, want %#v", got, want)
+	}
+
+	forms, err = scheduleForms("backup instance id=i-1", "eu-west-1", "1h", "2h", "24h", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var runs, reverts []string
+	for _, f := range forms {
+		runs = append(runs, f.RunIn)
+		reverts = append(reverts, f.RevertIn)
+	}
+	if got, want := runs, []string{"1h0m0s", "25h0m0s", "49h0m0s"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := reverts, []string{"2h0m0s", "26h0m0s", "50h0m0s"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	for _, invalid := range []struct {
+		every string
+		times int
+	}{{"daily", 3}, {"-1h", 3}, {"24h", 0}} {
+		if _, err := scheduleForms("backup instance id=i-1", "eu-west-1", "", "", invalid.every, invalid.times); err == nil {
+			t.Fatalf("%v: expected error", invalid)
+		}
+	}
+}
+
+func TestPrintDryRunCalls(t *testing.T) {
+	tpl := template.MustParse("sub = create subnet cidr=10.0.0.0/24 vpc=vpc-1234\ncreate instance count=1 image=ami-12345 name=web subnet=$sub type=t2.micro\nattach alarm name=cpu action-arn=arn:aws:sns:topic")
+	cenv := template.NewEnv().WithLookupCommandFunc(func(tokens ...string) interface{} {
+		return awsspec.MockAWSSessionFactory.Build(strings.Join(tokens, ""))()
+	}).Build()
+	if _, _, err := template.Compile(tpl, cenv, template.NewRunnerCompileMode); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	printDryRunCalls(&buf, tpl)
+	for _, exp := range []string{
+		"-> ec2.CreateSubnet {CidrBlock: 10.0.0.0/24, VpcId: vpc-1234}",
+		"-> ec2.RunInstances {ImageId: ami-12345, InstanceType: t2.micro, MaxCount: 1, MinCount: 1, SubnetId: $sub}",
+	} {
+		if !strings.Contains(buf.String(), exp) {
+			t.Fatalf("expected '%s' in\n%s", exp, buf.String())
+		}
+	}
+	if got, want := strings.Count(buf.String(), "->"), 2; got != want {
+		t.Fatalf("got %d calls, want %d (no call for manual run commands):\n%s", got, want, buf.String())
+	}
+}
@@ -1,16 +1,28 @@
 package commands
 
 import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"sort"
 	"strings"
+	"time"
 
+	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/wallix/awless/aws/services"
 	"github.com/wallix/awless/aws/spec"
 	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/match"
+	"github.com/wallix/awless/cloud/properties"
 	"github.com/wallix/awless/config"
 	"github.com/wallix/awless/database"
 	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/notify"
+	"github.com/wallix/awless/prompt"
 	"github.com/wallix/awless/sync"
 	"github.com/wallix/awless/template"
 	"github.com/wallix/awless/template/env"
@@ -40,6 +52,8 @@ func NewRunner(tpl *template.Template, msg, tplPath string, fillers ...map[strin
 	if noSuggestedParamsFlag {
 		runner.ParamsSuggested = env.REQUIRED_PARAMS_ONLY
 	}
+	runner.ReadOnly = readOnlyGlobalFlag || config.IsReadOnlyMode()
+	runner.Stack = runStackFlag
 
 	runner.Validators = []template.Validator{
 		&template.UniqueNameValidator{LookupGraph: func(key string) (cloud.GraphAPI, bool) {
@@ -57,24 +71,58 @@ func NewRunner(tpl *template.Template, msg, tplPath string, fillers ...map[strin
 		return newCommandFunc()
 	}
 
+	runner.PropertyFetcher = fetchResourceProperty
+	runner.ResourceCounter = countResources
+	runner.ParamDecrypter = decryptKMSParam
+	if idempotentRunFlag {
+		runner.ExistingResourceFinder = findExistingResource
+	}
+
+	var runStart time.Time
+
 	runner.BeforeRun = func(tplExec *template.TemplateExecution) (bool, error) {
+		// in quiet mode, stdout is kept for the resulting IDs
+		var out io.Writer = os.Stdout
+		if quietGlobalFlag {
+			out = os.Stderr
+		}
+		printContextBanner(out)
 		var yesorno string
 		if forceGlobalFlag {
 			yesorno = "y"
+			if res, g := deleteOneLinerTarget(tplExec.Template); res != nil {
+				if _, err := confirmDeleteOneLiner(os.Stdin, out, res, g, config.GetProtectedTags(), true); err != nil {
+					return false, err
+				}
+			}
+		} else if err := prompt.Check(prompt.Confirmation, "use --force to run the template without confirmation"); err != nil {
+			return false, err
 		} else {
-			fmt.Printf("%s\n\n", renderGreenFn(tplExec.Template))
-			if isSchedulingMode() {
-				fmt.Printf("Confirm scheduling (region: %s)? [y/N] ", config.GetAWSRegion())
-			} else {
-				fmt.Printf("Confirm (region: %s)? [y/N] ", config.GetAWSRegion())
+			fmt.Fprintf(out, "%s\n\n", renderGreenFn(tplExec.Template))
+			printSecuritygroupsRulesReport(out, tplExec.Template)
+			if res, g := deleteOneLinerTarget(tplExec.Template); res != nil {
+				confirmed, err := confirmDeleteOneLiner(os.Stdin, out, res, g, config.GetProtectedTags(), false)
+				if err != nil {
+					return false, err
+				}
+				if confirmed {
+					yesorno = "y"
+				}
 			}
-			if _, err := fmt.Scanln(&yesorno); err != nil && err.Error() != "unexpected newline" {
-				return false, err
+			if yesorno == "" {
+				if isSchedulingMode() {
+					fmt.Fprintf(out, "Confirm scheduling (region: %s)? [y/N] ", config.GetAWSRegion())
+				} else {
+					fmt.Fprintf(out, "Confirm (region: %s)? [y/N] ", config.GetAWSRegion())
+				}
+				if _, err := fmt.Scanln(&yesorno); err != nil && err.Error() != "unexpected newline" {
+					return false, err
+				}
 			}
 		}
 
 		if strings.TrimSpace(strings.ToLower(yesorno)) == "y" {
-			me, err := awsservices.AccessService.(*awsservices.Access).GetIdentity()
+			me, err := awsservices.Resolve(awsservices.AccessService).(*awsservices.Access).GetIdentity()
 			if err != nil {
 				logger.Warningf("cannot resolve template author identity: %s", err)
 			} else {
@@ -82,14 +130,23 @@ func NewRunner(tpl *template.Template, msg, tplPath string, fillers ...map[strin
 				logger.ExtraVerbosef("resolved template author: %s", tplExec.Author)
 			}
 			if isSchedulingMode() {
-				return false, scheduleTemplate(tplExec.Template, scheduleRunInFlag, scheduleRevertInFlag)
+				return false, scheduleTemplate(tplExec.Template, scheduleRunInFlag, scheduleRevertInFlag, backupEveryFlag, backupTimesFlag)
 			}
+			runStart = time.Now()
 			return true, nil
 		}
 		os.Exit(1)
 		return false, nil
 	}
 
+	if dryRunGlobalFlag {
+		runner.BeforeRun = func(tplExec *template.TemplateExecution) (bool, error) {
+			printDryRunCalls(os.Stdout, tplExec.Template)
+			printSecuritygroupsRulesReport(os.Stdout, tplExec.Template)
+			return false, nil
+		}
+	}
+
 	runner.AfterRun = func(tplExec *template.TemplateExecution) error {
 		if tplExec.Message == "" {
 			if tplExec.IsOneLiner() {
@@ -110,15 +167,329 @@ func NewRunner(tpl *template.Template, msg, tplPath string, fillers ...map[strin
 			logger.Errorf("Cannot save executed template in awless logs: %s", err)
 		}
 
-		if template.IsRevertible(tplExec.Template) {
+		if quietGlobalFlag {
+			printResultIDs(os.Stdout, tplExec.Template)
+		}
+		printGeneratedSecrets(os.Stderr, tplExec.Template, quietGlobalFlag)
+		printOutputs(tplExec.ResolvedOutputs)
+		if runExportEnvFlag != "" {
+			if err := exportEnvFile(runExportEnvFlag, tplExec); err != nil {
+				logger.Errorf("Cannot export run results in %s: %s", runExportEnvFlag, err)
+			} else {
+				logger.Verbosef("run results exported in %s", runExportEnvFlag)
+			}
+		}
+
+		if template.IsRevertible(tplExec.Template) && !quietGlobalFlag {
 			fmt.Println()
 			logger.Infof("Revert this template with `awless revert %s`", tplExec.Template.ID)
 		}
+		if stats := tplExec.Stats(); stats.KOCount > 0 && !tplExec.IsOneLiner() {
+			logger.Infof("Resume this template from its failed command with `awless run --resume %s`", tplExec.Template.ID)
+		}
+
+		notifyRun(tplExec, time.Since(runStart))
+		auditRun(tplExec, time.Since(runStart))
 
 		runSyncFor(tplExec)
 
 		return nil
 	}
 
+	runner.StatementHooks = configStatementHooks()
+	if runner.Stack != "" {
+		runner.StatementHooks = append(runner.StatementHooks, stackTaggingHook(runner.Stack, tagEC2ResourceWithStack))
+	}
+
 	return runner
 }
+
+// fetchResourceProperty fetches a freshly created resource to get one of its properties (case insensitive)
+func fetchResourceProperty(resourceType, id, property string) (interface{}, error) {
+	srv, err := cloud.GetServiceForType(resourceType)
+	if err != nil {
+		return nil, err
+	}
+	g, err := srv.FetchByType(context.WithValue(context.Background(), "force", true), resourceType)
+	if err != nil {
+		return nil, err
+	}
+	res, err := g.FindOne(cloud.NewQuery(resourceType).Match(match.Property(properties.ID, id)))
+	if err != nil {
+		return nil, fmt.Errorf("%s %s: %s", resourceType, id, err)
+	}
+	for k, v := range res.Properties() {
+		if strings.EqualFold(k, property) {
+			return v, nil
+		}
+	}
+	return nil, fmt.Errorf("%s %s: no value for property '%s'", resourceType, id, property)
+}
+
+// idempotentKeys are per entity the create params identifying, along with the name,
+// an existing resource equivalent to the one to create (param -> resource property)
+var idempotentKeys = map[string]map[string]string{
+	cloud.Subnet:        {"vpc": properties.Vpc},
+	cloud.SecurityGroup: {"vpc": properties.Vpc},
+	cloud.Instance:      {"subnet": properties.Subnet},
+}
+
+// findExistingResource fetches the existing resource equivalent to the one created
+// with the given params, to be reused in idempotent runs (see --idempotent)
+func findExistingResource(entity string, params map[string]interface{}) (string, error) {
+	if _, hasName := params["name"]; !hasName {
+		return "", nil
+	}
+	srv, err := cloud.GetServiceForType(entity)
+	if err != nil {
+		return "", nil
+	}
+	g, err := srv.FetchByType(context.WithValue(context.Background(), "force", true), entity)
+	if err != nil {
+		return "", err
+	}
+	return findEquivalentResource(g, entity, params)
+}
+
+func findEquivalentResource(g cloud.GraphAPI, entity string, params map[string]interface{}) (string, error) {
+	name := params["name"]
+	matchers := []cloud.Matcher{match.Or(match.Property(properties.Name, name).MatchString(), match.Property(properties.ID, name).MatchString())}
+	for param, prop := range idempotentKeys[entity] {
+		if v, ok := params[param]; ok {
+			matchers = append(matchers, match.Property(prop, v).MatchString())
+		}
+	}
+	resources, err := g.Find(cloud.NewQuery(entity).Match(match.And(matchers...)))
+	if err != nil {
+		return "", err
+	}
+
+	var ids []string
+	for _, res := range resources {
+		switch res.Properties()[properties.State] {
+		case "terminated", "shutting-down", "deleted", "deleting":
+			continue
+		}
+		ids = append(ids, res.Id())
+	}
+	switch len(ids) {
+	case 0:
+		return "", nil
+	case 1:
+		return ids[0], nil
+	}
+	sort.Strings(ids)
+	return "", fmt.Errorf("%d existing %s match name '%v' (%s): cannot decide which one to reuse", len(ids), entity, name, strings.Join(ids, ", "))
+}
+
+// countResources counts the existing resources matching the conditions of a template assertion
+func countResources(entity string, conditions map[string]string) (int, error) {
+	srv, err := cloud.GetServiceForType(entity)
+	if err != nil {
+		return 0, err
+	}
+	g, err := srv.FetchByType(context.WithValue(context.Background(), "force", true), entity)
+	if err != nil {
+		return 0, err
+	}
+	return countMatchingResources(g, entity, conditions)
+}
+
+// countMatchingResources counts the resources whose properties match all the conditions,
+// keys being properties names (ignoring case and dashes, ex: state, availability-zone) or 'tag:Key'
+func countMatchingResources(g cloud.GraphAPI, entity string, conditions map[string]string) (int, error) {
+	resources, err := g.Find(cloud.NewQuery(entity))
+	if err != nil {
+		return 0, err
+	}
+	var count int
+	for _, res := range resources {
+		if resourceMatchesConditions(res, conditions) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func resourceMatchesConditions(res cloud.Resource, conditions map[string]string) bool {
+	for key, val := range conditions {
+		if strings.HasPrefix(key, "tag:") {
+			key, val = properties.Tags, fmt.Sprintf("%s=%s", strings.TrimPrefix(key, "tag:"), val)
+		}
+		var found bool
+		for prop, v := range res.Properties() {
+			if normalizePropertyKey(prop) == normalizePropertyKey(key) && propertyValueMatches(v, val) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func propertyValueMatches(v interface{}, val string) bool {
+	if all, ok := v.([]string); ok {
+		for _, s := range all {
+			if s == val {
+				return true
+			}
+		}
+		return false
+	}
+	return strings.EqualFold(fmt.Sprint(v), val)
+}
+
+// decryptKMSParam decrypts with KMS a base64 ciphertext given as param value (ex: password=kms:AQICAH...)
+func decryptKMSParam(ciphertext string) (string, error) {
+	factory, ok := awsspec.CommandFactory.(*awsspec.AWSFactory)
+	if !ok || factory.Sess == nil {
+		return "", errors.New("no AWS session available")
+	}
+	blob, err := base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		return "", fmt.Errorf("invalid base64 ciphertext: %s", err)
+	}
+	out, err := kms.New(factory.Sess).Decrypt(&kms.DecryptInput{CiphertextBlob: blob})
+	if err != nil {
+		return "", err
+	}
+	return string(out.Plaintext), nil
+}
+
+func printOutputs(outputs map[string]interface{}) {
+	if len(outputs) == 0 {
+		return
+	}
+	if runOutputFormatFlag == "json" {
+		b, err := json.MarshalIndent(outputs, "", "  ")
+		if err != nil {
+			logger.Errorf("cannot marshal template outputs: %s", err)
+			return
+		}
+		fmt.Println(string(b))
+		return
+	}
+	var names []string
+	for name := range outputs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	if !quietGlobalFlag {
+		fmt.Println()
+	}
+	logger.Info("Outputs:")
+	for _, name := range names {
+		fmt.Printf("\t%s = %v\n", name, outputs[name])
+	}
+}
+
+// printGeneratedSecrets displays the secrets generated for the run commands (see template.GeneratedSecretPattern).
+// As for access keys, this is the only opportunity to view them: they are displayed even in quiet mode, without banner.
+func printGeneratedSecrets(w io.Writer, tpl *template.Template, quiet bool) {
+	for _, cmd := range tpl.CommandNodesIterator() {
+		if len(cmd.GeneratedSecrets) == 0 {
+			continue
+		}
+		var keys []string
+		for k := range cmd.GeneratedSecrets {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		if !quiet {
+			fmt.Fprintf(w, "\nGenerated secrets for %s %s:\n", cmd.Action, cmd.Entity)
+			fmt.Fprintln(w, strings.Repeat("*", 64))
+		}
+		for _, k := range keys {
+			fmt.Fprintf(w, "%s = %s\n", k, cmd.GeneratedSecrets[k])
+		}
+		if !quiet {
+			fmt.Fprintln(w, strings.Repeat("*", 64))
+			fmt.Fprintln(w, "This is your only opportunity to view these secrets. Save them in a safe and secure place.")
+			fmt.Fprintln(w)
+		}
+	}
+}
+
+// printResultIDs prints the results (ex: created IDs) of the succeeded commands, one per line
+func printResultIDs(w io.Writer, tpl *template.Template) {
+	for _, cmd := range tpl.CommandNodesIterator() {
+		if cmd.Err() == nil && cmd.Result() != nil {
+			fmt.Fprintln(w, cmd.Result())
+		}
+	}
+}
+
+// printDryRunCalls prints the statements of the template with, below each, the AWS API call
+// it would perform with its input params (the references to previous results being left as is)
+func printDryRunCalls(w io.Writer, tpl *template.Template) {
+	logger.Info("Dry run only (--dry-run): nothing has been run. Would perform:")
+	for _, cmd := range tpl.CommandNodesIterator() {
+		api := awsspec.APIPerTemplateDefName[cmd.Action+cmd.Entity]
+		if comment := tpl.CommentOf(cmd); comment != "" {
+			for _, line := range strings.Split(comment, "\n") {
+				fmt.Fprintf(w, "\t%-12s %s\n", "", renderBlueFn("# "+line))
+			}
+		}
+		fmt.Fprintf(w, "\t%-12s %s\n", api, renderGreenFn(cmd))
+		params := cmd.ToDriverParams()
+		for k, ref := range cmd.Refs {
+			if _, ok := params[k]; !ok {
+				params[k] = ref
+			}
+		}
+		if call, input, ok := awsspec.APICall(cmd.Command, params); ok {
+			fmt.Fprintf(w, "\t%-12s -> %s\n", "", formatAPICall(call, input))
+		}
+	}
+}
+
+// formatAPICall formats an API call with its input fields sorted (ex: 'ec2.RunInstances {ImageId: ami-12345, SubnetId: sub-1234}')
+func formatAPICall(call string, input map[string]interface{}) string {
+	var fields []string
+	for k, v := range input {
+		fields = append(fields, fmt.Sprintf("%s: %v", k, v))
+	}
+	sort.Strings(fields)
+	return fmt.Sprintf("%s {%s}", call, strings.Join(fields, ", "))
+}
+
+func configStatementHooks() (hooks []template.StatementHook) {
+	stageOnly := func(stage string, hook template.StatementHook) template.StatementHook {
+		return func(event *template.StatementEvent) error {
+			if event.Stage != stage {
+				return nil
+			}
+			return hook(event)
+		}
+	}
+	for _, path := range config.GetBeforeStatementHooks() {
+		hooks = append(hooks, stageOnly(template.BeforeStatement, template.ExecStatementHook(path)))
+	}
+	for _, path := range config.GetAfterStatementHooks() {
+		hooks = append(hooks, stageOnly(template.AfterStatement, template.ExecStatementHook(path)))
+	}
+	return
+}
+
+func notifyRun(tplExec *template.TemplateExecution, duration time.Duration) {
+	var notifiers []notify.Notifier
+	if webhook := config.GetSlackWebhook(); webhook != "" {
+		notifiers = append(notifiers, notify.Slack(webhook))
+	}
+	if webhook := config.GetTeamsWebhook(); webhook != "" {
+		notifiers = append(notifiers, notify.Teams(webhook))
+	}
+	if len(notifiers) == 0 {
+		return
+	}
+	summary := notify.NewSummary(tplExec, duration)
+	for _, n := range notifiers {
+		if err := n.Notify(summary); err != nil {
+			logger.Warningf("cannot notify template run: %s", err)
+		}
+	}
+}
@@ -50,7 +50,7 @@ var searchCmd = &cobra.Command{
 var awsImagesCmd = &cobra.Command{
 	Use:               "images",
 	PersistentPreRun:  applyHooks(initAwlessEnvHook, initLoggerHook, initCloudServicesHook, firstInstallDoneHook),
-	PersistentPostRun: applyHooks(networkMonitorHook),
+	PersistentPostRun: applyHooks(networkMonitorHook, apiCallsHook),
 	Short:             fmt.Sprintf("Resolve from current region the official community AMIs according to an awless specific bare distro query format, ordering by latest first. Supported owners: %s", strings.Join(awsspec.SupportedAMIOwners, ", ")),
 	Long:              fmt.Sprintf("Resolve from current region the official community AMIs according to an awless specific bare distro query format, ordering by latest first.\n\nQuery string specification is the following column separated format:\n\n\t\t%s\n\nEverything optional expect for the 'owner'. Supported owners: %s", awsspec.ImageQuerySpec, strings.Join(awsspec.SupportedAMIOwners, ", ")),
 	Example: `  awless search images redhat:rhel:7.2
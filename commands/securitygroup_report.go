@@ -0,0 +1,240 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/match"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/sync"
+	"github.com/wallix/awless/template"
+)
+
+// securitygroupRulesChange holds the rules authorized and revoked on one direction
+// (inbound or outbound) of a security group by the 'update securitygroup' commands of a template
+type securitygroupRulesChange struct {
+	authorized, revoked []string
+}
+
+type securitygroupChanges struct {
+	group             string
+	inbound, outbound *securitygroupRulesChange
+}
+
+// printSecuritygroupsRulesReport prints the full rule sets that the security groups updated in the template
+// will end up with (existing rules from the local sync, plus authorized and minus revoked ones)
+func printSecuritygroupsRulesReport(w io.Writer, tpl *template.Template) {
+	changes := securitygroupsRulesChanges(tpl)
+	if len(changes) == 0 {
+		return
+	}
+	g := sync.LoadLocalGraphForService("infra", config.GetAWSProfile(), config.GetAWSRegion())
+	if err := writeSecuritygroupsRulesReport(w, changes, g); err != nil {
+		logger.Verbosef("cannot report security groups rules: %s", err)
+	}
+}
+
+// securitygroupsRulesChanges collects the rules changes per security group, in template order
+func securitygroupsRulesChanges(tpl *template.Template) (all []*securitygroupChanges) {
+	byGroup := make(map[string]*securitygroupChanges)
+	for _, cmd := range tpl.CommandNodesIterator() {
+		if cmd.Action != "update" || cmd.Entity != cloud.SecurityGroup {
+			continue
+		}
+		params := cmd.ToDriverParams()
+		group, ok := params["id"].(string)
+		if !ok {
+			if ref, isRef := cmd.Refs["id"]; isRef {
+				group = fmt.Sprint(ref)
+			}
+		}
+		if group == "" {
+			continue
+		}
+		changes, ok := byGroup[group]
+		if !ok {
+			changes = &securitygroupChanges{group: group, inbound: &securitygroupRulesChange{}, outbound: &securitygroupRulesChange{}}
+			byGroup[group] = changes
+			all = append(all, changes)
+		}
+		rules := templateRuleStrings(params, cmd.Refs)
+		for direction, change := range map[string]*securitygroupRulesChange{"inbound": changes.inbound, "outbound": changes.outbound} {
+			switch strings.ToLower(fmt.Sprint(params[direction])) {
+			case "authorize":
+				change.authorized = append(change.authorized, rules...)
+			case "revoke":
+				change.revoked = append(change.revoked, rules...)
+			}
+		}
+	}
+	return
+}
+
+func writeSecuritygroupsRulesReport(w io.Writer, all []*securitygroupChanges, g cloud.GraphAPI) error {
+	for _, changes := range all {
+		var existingIn, existingOut []string
+		title := changes.group
+		resources, err := g.Find(cloud.NewQuery(cloud.SecurityGroup).Match(match.Property(properties.ID, changes.group)))
+		if err != nil {
+			return err
+		}
+		if len(resources) == 1 {
+			props := resources[0].Properties()
+			if name, _ := props[properties.Name].(string); name != "" {
+				title = fmt.Sprintf("%s (%s)", changes.group, name)
+			}
+			existingIn = firewallRuleStrings(props[properties.InboundRules])
+			existingOut = firewallRuleStrings(props[properties.OutboundRules])
+		}
+		fmt.Fprintf(w, "Resulting rules of securitygroup %s:\n", title)
+		writeRulesReport(w, "inbound", existingIn, changes.inbound)
+		writeRulesReport(w, "outbound", existingOut, changes.outbound)
+		fmt.Fprintln(w)
+	}
+	return nil
+}
+
+func writeRulesReport(w io.Writer, direction string, existing []string, change *securitygroupRulesChange) {
+	if len(existing) == 0 && len(change.authorized) == 0 && len(change.revoked) == 0 {
+		return
+	}
+	revoked := make(map[string]bool)
+	for _, r := range change.revoked {
+		revoked[r] = true
+	}
+	current := make(map[string]bool)
+	var lines []string
+	for _, r := range existing {
+		current[r] = true
+		if revoked[r] {
+			lines = append(lines, renderRedFn("- "+r))
+		} else {
+			lines = append(lines, "  "+r)
+		}
+	}
+	for _, r := range change.revoked {
+		if !current[r] {
+			lines = append(lines, renderYellowFn(fmt.Sprintf("- %s (not found in existing rules)", r)))
+		}
+	}
+	remaining := 0
+	for _, r := range existing {
+		if !revoked[r] {
+			remaining++
+		}
+	}
+	for _, r := range change.authorized {
+		if current[r] && !revoked[r] {
+			continue
+		}
+		current[r] = true
+		remaining++
+		lines = append(lines, renderGreenFn("+ "+r))
+	}
+	fmt.Fprintf(w, "\t%s:\n", direction)
+	for _, l := range lines {
+		fmt.Fprintf(w, "\t  %s\n", l)
+	}
+	if remaining == 0 {
+		fmt.Fprintf(w, "\t  (no %s rule left)\n", direction)
+	}
+}
+
+// firewallRuleStrings flattens synced firewall rules into one string per source (i.e. cidr or security group)
+func firewallRuleStrings(i interface{}) (rules []string) {
+	fwRules, _ := i.([]*graph.FirewallRule)
+	for _, r := range fwRules {
+		var sources []string
+		for _, net := range r.IPRanges {
+			sources = append(sources, net.String())
+		}
+		sources = append(sources, r.Sources...)
+		for _, src := range sources {
+			rules = append(rules, fmt.Sprintf("%s (%s)", src, formatProtocolPorts(r.Protocol, r.PortRange)))
+		}
+	}
+	sort.Strings(rules)
+	return
+}
+
+// templateRuleStrings formats the rule of an 'update securitygroup' command as firewallRuleStrings does,
+// applying the same protocol and port range defaults as the command when calling AWS
+func templateRuleStrings(params, refs map[string]interface{}) []string {
+	var source string
+	if cidr, ok := params["cidr"]; ok {
+		source = fmt.Sprint(cidr)
+	} else if group, ok := params["securitygroup"]; ok {
+		source = fmt.Sprint(group)
+	} else if ref, ok := refs["securitygroup"]; ok {
+		source = fmt.Sprint(ref)
+	} else {
+		return nil
+	}
+
+	protocol := strings.ToLower(fmt.Sprint(params["protocol"]))
+	if strings.Contains("any", protocol) {
+		return []string{fmt.Sprintf("%s (%s)", source, formatProtocolPorts("any", graph.PortRange{Any: true}))}
+	}
+	ports := graph.PortRange{Any: true}
+	if portrange, ok := params["portrange"]; ok {
+		pr := fmt.Sprint(portrange)
+		switch {
+		case strings.Contains(pr, "any"):
+			if isTCPorUDP(protocol) {
+				ports = graph.PortRange{FromPort: 0, ToPort: 65535}
+			}
+		case strings.Contains(pr, "-"):
+			splits := strings.SplitN(pr, "-", 2)
+			from, errFrom := strconv.ParseInt(splits[0], 10, 64)
+			to, errTo := strconv.ParseInt(splits[1], 10, 64)
+			if errFrom == nil && errTo == nil {
+				ports = graph.PortRange{FromPort: from, ToPort: to}
+			}
+		default:
+			if port, err := strconv.ParseInt(pr, 10, 64); err == nil {
+				ports = graph.PortRange{FromPort: port, ToPort: port}
+			}
+		}
+	}
+	return []string{fmt.Sprintf("%s (%s)", source, formatProtocolPorts(protocol, ports))}
+}
+
+func formatProtocolPorts(protocol string, ports graph.PortRange) string {
+	switch {
+	case protocol == "any":
+		return protocol
+	case ports.Any:
+		return fmt.Sprintf("%s:any", protocol)
+	case ports.FromPort == ports.ToPort:
+		return fmt.Sprintf("%s:%d", protocol, ports.FromPort)
+	default:
+		return fmt.Sprintf("%s:%d-%d", protocol, ports.FromPort, ports.ToPort)
+	}
+}
+
+func isTCPorUDP(protocol string) bool {
+	return protocol == "tcp" || protocol == "udp"
+}
@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"bytes"
+	"net"
+	"testing"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/template"
+)
+
+func TestSecuritygroupsRulesReport(t *testing.T) {
+	_, world, _ := net.ParseCIDR("0.0.0.0/0")
+	_, private, _ := net.ParseCIDR("10.0.0.0/8")
+	g := graph.NewGraph()
+	sg := graph.InitResource(cloud.SecurityGroup, "sg-1")
+	sg.Properties()[properties.Name] = "web"
+	sg.Properties()[properties.InboundRules] = []*graph.FirewallRule{
+		{Protocol: "tcp", PortRange: graph.PortRange{FromPort: 22, ToPort: 22}, IPRanges: []*net.IPNet{private}},
+		{Protocol: "tcp", PortRange: graph.PortRange{FromPort: 443, ToPort: 443}, IPRanges: []*net.IPNet{world}},
+	}
+	sg.Properties()[properties.OutboundRules] = []*graph.FirewallRule{
+		{Protocol: "any", PortRange: graph.PortRange{Any: true}, IPRanges: []*net.IPNet{world}},
+	}
+	g.AddResource(sg)
+
+	tpl := template.MustParse(`update securitygroup id=sg-1 inbound=revoke protocol=tcp portrange=22 cidr=10.0.0.0/8
+update securitygroup id=sg-1 inbound=authorize protocol=tcp portrange=22 cidr=0.0.0.0/0
+update securitygroup id=sg-1 inbound=revoke protocol=udp portrange=53 cidr=0.0.0.0/0
+update securitygroup id=sg-1 outbound=revoke protocol=any cidr=0.0.0.0/0
+update securitygroup id=sg-2 inbound=authorize protocol=tcp portrange=any securitygroup=sg-1
+create vpc cidr=10.0.0.0/16`)
+
+	var buff bytes.Buffer
+	if err := writeSecuritygroupsRulesReport(&buff, securitygroupsRulesChanges(tpl), g); err != nil {
+		t.Fatal(err)
+	}
+	expect := `Resulting rules of securitygroup sg-1 (web):
+	inbound:
+	    0.0.0.0/0 (tcp:443)
+	  - 10.0.0.0/8 (tcp:22)
+	  - 0.0.0.0/0 (udp:53) (not found in existing rules)
+	  + 0.0.0.0/0 (tcp:22)
+	outbound:
+	  - 0.0.0.0/0 (any)
+	  (no outbound rule left)
+
+Resulting rules of securitygroup sg-2:
+	inbound:
+	  + sg-1 (tcp:0-65535)
+
+`
+	if got := buff.String(); got != expect {
+		t.Fatalf("got\n%s\nwant\n%s", got, expect)
+	}
+
+	if changes := securitygroupsRulesChanges(template.MustParse("create vpc cidr=10.0.0.0/16")); len(changes) != 0 {
+		t.Fatalf("got %d changes, want none", len(changes))
+	}
+}
@@ -18,16 +18,21 @@ package commands
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"os"
+	"os/exec"
+	"runtime"
 	"sort"
 	"strings"
 
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 	"github.com/wallix/awless/aws/config"
+	"github.com/wallix/awless/aws/services"
 	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/match"
 	"github.com/wallix/awless/cloud/properties"
 	"github.com/wallix/awless/cloud/rdf"
 	"github.com/wallix/awless/config"
@@ -40,13 +45,21 @@ var (
 	listAllSiblingsFlag          bool
 	noAliasFlag                  bool
 	showPropertiesValuesOnlyFlag []string
+	refreshShowFlag              bool
+	showUserDataFlag             bool
+	showConsoleFlag              bool
+	showURLFlag                  bool
 )
 
 func init() {
 	RootCmd.AddCommand(showCmd)
 	showCmd.Flags().BoolVar(&listAllSiblingsFlag, "siblings", false, "List all the resource's siblings")
 	showCmd.Flags().BoolVar(&noAliasFlag, "no-alias", false, "Disable the resolution of ID to alias")
+	showCmd.Flags().BoolVar(&refreshShowFlag, "refresh", false, "Re-fetch the resource type from AWS before displaying (ignores autosync)")
 	showCmd.Flags().StringSliceVar(&showPropertiesValuesOnlyFlag, "values-for", []string{}, "Output values only for given properties keys")
+	showCmd.Flags().BoolVar(&showUserDataFlag, "userdata", false, "Output the decoded userdata of an instance, with secrets masked (see `awless config set display.userdata.secrets`)")
+	showCmd.Flags().BoolVar(&showConsoleFlag, "console", false, "Open the resource page in the AWS console (signing in with a federation URL when using an assumed role)")
+	showCmd.Flags().BoolVar(&showURLFlag, "url", false, "Output the AWS console URL of the resource instead of opening it")
 }
 
 var showCmd = &cobra.Command{
@@ -55,9 +68,14 @@ var showCmd = &cobra.Command{
 	Example: `  awless show i-8d43b21b            # show an instance via its ref
   awless show AIDAJ3Z24GOKHTZO4OIX6 # show a user via its ref
   awless show jsmith                # show a user via its ref,
-  awless show @jsmith               # forcing search by name`,
+  awless show @jsmith               # forcing search by name
+  awless show i-8d43b21b --refresh  # fetch latest instance data from AWS
+  awless show i-8d43b21b --local    # only from last sync
+  awless show i-8d43b21b --userdata # decoded userdata of the instance
+  awless show i-8d43b21b --console  # open the instance in the AWS console
+  awless show i-8d43b21b --url      # print the AWS console URL of the instance`,
 	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, initSyncerHook, firstInstallDoneHook),
-	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook, apiCallsHook),
 
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if len(args) < 1 {
@@ -88,7 +106,13 @@ var showCmd = &cobra.Command{
 			}
 		}
 
-		if !localGlobalFlag && config.GetAutosync() {
+		if localGlobalFlag {
+			if srvName, ok := awsservices.ServicePerResourceType[resource.Type()]; ok {
+				logLocalSyncAge(srvName)
+			}
+		} else if refreshShowFlag {
+			resource = refreshResource(resource)
+		} else if config.GetAutosync() {
 			var services []cloud.Service
 			if resource.Type() == cloud.Region {
 				services = append(services, cloud.AllServices()...)
@@ -106,7 +130,11 @@ var showCmd = &cobra.Command{
 		}
 
 		if resource != nil {
-			if len(showPropertiesValuesOnlyFlag) > 0 {
+			if showConsoleFlag || showURLFlag {
+				exitOn(showInConsole(resource, showURLFlag))
+			} else if showUserDataFlag {
+				exitOn(showInstanceUserData(resource))
+			} else if len(showPropertiesValuesOnlyFlag) > 0 {
 				showResourceValuesOnlyFor(resource, showPropertiesValuesOnlyFlag)
 			} else {
 				showResource(resource, gph)
@@ -117,6 +145,62 @@ var showCmd = &cobra.Command{
 	},
 }
 
+func showInConsole(resource cloud.Resource, printOnly bool) error {
+	region := config.GetAWSRegion()
+	consoleURL, err := awsservices.ConsoleURL(resource, region)
+	if err != nil {
+		return err
+	}
+	signinURL, err := awsservices.Resolve(awsservices.AccessService).(*awsservices.Access).ConsoleSigninURL(consoleURL, region)
+	if err != nil {
+		logger.Warningf("cannot generate federation sign-in URL: %s", err)
+		signinURL = consoleURL
+	}
+	if printOnly {
+		fmt.Println(signinURL)
+		return nil
+	}
+	logger.Infof("opening %s %s in AWS console", resource.Type(), resource.Id())
+	if err = openBrowser(signinURL); err != nil {
+		logger.Errorf("cannot open browser: %s", err)
+		fmt.Println(signinURL)
+	}
+	return nil
+}
+
+func openBrowser(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+	return cmd.Start()
+}
+
+func showInstanceUserData(resource cloud.Resource) error {
+	if resource.Type() != cloud.Instance {
+		return fmt.Errorf("cannot show userdata of %s '%s': only instances have userdata", resource.Type(), resource.Id())
+	}
+	patterns, err := console.ParseSecretsPatterns(config.GetUserDataSecretsPatterns())
+	if err != nil {
+		return err
+	}
+	userdata, err := awsservices.Resolve(awsservices.InfraService).(*awsservices.Infra).GetUserData(resource.Id())
+	if err != nil {
+		return err
+	}
+	if userdata == "" {
+		logger.Infof("no userdata for instance %s", resource.Id())
+		return nil
+	}
+	fmt.Println(console.MaskSecrets(userdata, patterns))
+	return nil
+}
+
 func showResourceValuesOnlyFor(resource cloud.Resource, propKeys []string) {
 	var normalized []string
 	for _, p := range propKeys {
@@ -216,6 +300,20 @@ func showResource(resource cloud.Resource, gph cloud.GraphAPI) {
 	printResourceList(renderCyanBoldFn("Siblings"), siblings, "display all with flag --siblings")
 }
 
+func refreshResource(resource cloud.Resource) cloud.Resource {
+	srv, err := cloud.GetServiceForType(resource.Type())
+	exitOn(err)
+	logger.Verbosef("fetching %s resources", resource.Type())
+	g, err := srv.FetchByType(context.WithValue(context.Background(), "force", true), resource.Type())
+	exitOn(err)
+	refreshed, err := g.Find(cloud.NewQuery(resource.Type()).Match(match.Property(properties.ID, resource.Id())))
+	exitOn(err)
+	if len(refreshed) != 1 {
+		exitOn(fmt.Errorf("%s '%s' not found anymore in AWS", resource.Type(), resource.Id()))
+	}
+	return refreshed[0]
+}
+
 func runFullSync() {
 	if !config.GetAutosync() {
 		logger.Info("autosync disabled")
@@ -0,0 +1,108 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/spf13/cobra"
+	"github.com/wallix/awless/aws/services"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/ssh"
+)
+
+var showPasswordKeyFlag string
+
+func init() {
+	showCmd.AddCommand(showPasswordCmd)
+	showPasswordCmd.Flags().StringVarP(&showPasswordKeyFlag, "identity", "i", "", "Name or path of the private key decrypting the password (default to the instance keypair in ~/.awless/keys or ~/.ssh)")
+}
+
+var showPasswordCmd = &cobra.Command{
+	Use:   "password INSTANCE",
+	Short: "Show the administrator password of a Windows instance, decrypted with its local keypair",
+	Example: `  awless show password i-0123456789         # decrypt with the instance keypair
+  awless show password @my-windows-server
+  awless show password i-0123456789 -i ~/keys/admin.pem`,
+	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, firstInstallDoneHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook, apiCallsHook),
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return errors.New("missing INSTANCE arg (id or @name)")
+		}
+		id := resolveInstanceID(args[0])
+		infra := awsservices.Resolve(awsservices.InfraService).(*awsservices.Infra)
+
+		out, err := infra.GetPasswordData(&ec2.GetPasswordDataInput{InstanceId: aws.String(id)})
+		exitOn(err)
+		data := strings.TrimSpace(aws.StringValue(out.PasswordData))
+		if data == "" {
+			exitOn(fmt.Errorf("no password available for instance %s: Windows instances make it available a few minutes after launch, other platforms have none", id))
+		}
+
+		keyname := showPasswordKeyFlag
+		if keyname == "" {
+			keyname, err = instanceKeyName(infra, id)
+			exitOn(err)
+		}
+		key, err := ssh.LoadRSAPrivateKey(keyname, config.KeysDir, ssh.UserSSHDir())
+		exitOn(err)
+
+		password, err := decryptPasswordData(data, key)
+		exitOn(err)
+		fmt.Println(password)
+		return nil
+	},
+}
+
+func instanceKeyName(infra *awsservices.Infra, id string) (string, error) {
+	out, err := infra.DescribeInstances(&ec2.DescribeInstancesInput{InstanceIds: []*string{aws.String(id)}})
+	if err != nil {
+		return "", err
+	}
+	for _, res := range out.Reservations {
+		for _, inst := range res.Instances {
+			if inst.KeyName == nil {
+				return "", fmt.Errorf("instance %s has no keypair: use -i to give the decrypting key", id)
+			}
+			return aws.StringValue(inst.KeyName), nil
+		}
+	}
+	return "", fmt.Errorf("instance %s not found", id)
+}
+
+// decryptPasswordData decrypts the base64 password data of a Windows instance,
+// encrypted by EC2 with the public key of the instance keypair
+func decryptPasswordData(data string, key *rsa.PrivateKey) (string, error) {
+	encrypted, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return "", fmt.Errorf("decoding password data: %s", err)
+	}
+	password, err := rsa.DecryptPKCS1v15(rand.Reader, key, encrypted)
+	if err != nil {
+		return "", fmt.Errorf("decrypting password: %s (is it the key of the instance keypair?)", err)
+	}
+	return string(password), nil
+}
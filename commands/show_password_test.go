@@ -0,0 +1,39 @@
+package commands
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"testing"
+)
+
+func TestDecryptPasswordData(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encrypted, err := rsa.EncryptPKCS1v15(rand.Reader, &key.PublicKey, []byte("Adm1n!p@ss"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	password, err := decryptPasswordData("not base64!", key)
+	if err == nil {
+		t.Fatalf("expected error on invalid base64, got %q", password)
+	}
+	password, err = decryptPasswordData(base64.StdEncoding.EncodeToString(encrypted), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := password, "Adm1n!p@ss"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	other, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = decryptPasswordData(base64.StdEncoding.EncodeToString(encrypted), other); err == nil {
+		t.Fatal("expected error with another key")
+	}
+}
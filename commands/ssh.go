@@ -21,8 +21,6 @@ import (
 	"errors"
 	"fmt"
 	"net"
-	"os"
-	"path/filepath"
 	"strings"
 	"sync"
 	"time"
@@ -45,10 +43,12 @@ var printSSHConfigFlag bool
 var printSSHCLIFlag bool
 var privateIPFlag bool
 var disableStrictHostKeyCheckingFlag bool
+var forwardAgentFlag bool
+var localForwardFlags, dynamicForwardFlags []string
 
 func init() {
 	RootCmd.AddCommand(sshCmd)
-	sshCmd.Flags().StringVarP(&keyPathFlag, "identity", "i", "", "Set path or name toward the identity (key file) to use to connect through SSH")
+	sshCmd.Flags().StringVarP(&keyPathFlag, "identity", "i", "", "Set path or name toward the identity (key file) to use to connect through SSH (default to the ssh-agent keys, then the instance key pair file)")
 	sshCmd.Flags().IntVar(&sshPortFlag, "port", 22, "Set SSH target port")
 	sshCmd.Flags().IntVar(&sshTroughPortFlag, "through-port", 22, "Set SSH proxy port")
 	sshCmd.Flags().StringVar(&proxyInstanceThroughFlag, "through", "", "Name of instance to proxy through to connect to a destination host")
@@ -56,26 +56,32 @@ func init() {
 	sshCmd.Flags().BoolVar(&printSSHCLIFlag, "print-cli", false, "Print the CLI one-liner to connect with SSH. (/usr/bin/ssh user@ip -i ...)")
 	sshCmd.Flags().BoolVar(&privateIPFlag, "private", false, "Use private ip to connect to host")
 	sshCmd.Flags().BoolVar(&disableStrictHostKeyCheckingFlag, "disable-strict-host-keychecking", false, "Disable the remote host key check from ~/.ssh/known_hosts or ~/.awless/known_hosts file")
+	sshCmd.Flags().BoolVarP(&forwardAgentFlag, "forward-agent", "A", false, "Forward the running ssh-agent to the instance")
+	sshCmd.Flags().StringArrayVarP(&localForwardFlags, "local-forward", "L", nil, "Forward a local port to a host and port reachable from the instance: [bind_address:]port:host:hostport")
+	sshCmd.Flags().StringArrayVarP(&dynamicForwardFlags, "dynamic-forward", "D", nil, "Run a local SOCKS proxy forwarding connections through the instance: [bind_address:]port")
 }
 
-var defaultAMIUsers = []string{"ec2-user", "ubuntu", "centos", "core", "bitnami", "admin", "root"}
-
 var sshCmd = &cobra.Command{
 	Use:   "ssh [USER@]INSTANCE",
 	Short: "Launch a SSH session to an instance given an id or alias",
 	Long:  "Launch a SSH session to an instance given an id or alias. All connection details are derived from a given instance name/id.",
 	Example: `  awless ssh i-8d43b21b                       # using the instance id
   awless ssh redis-prod                       # using name only (other infos are derived)
-  awless ssh ec2-user@redis-prod              # forcing the user
+  awless ssh ec2-user@redis-prod              # forcing the user (detected from the AMI otherwise)
   awless ssh 34.215.29.221                    # using the IP
   awless ssh root@34.215.29.221 --port 23     # specifying a port
 
   awless ssh redis-prod -i keyname            # using AWS keyname (look into ~/.ssh/keyname.pem & ~/.awless/keys/keyname.pem)
   awless ssh redis-prod -i ~/path/toward/key  # specifying a full key path
+  awless ssh redis-prod --forward-agent       # forwarding the running ssh-agent (its keys are used first when available)
 
   awless ssh db-private --through my-bastion  # connect to a private inst through a public one
   awless ssh db-private --private             # connect using the private IP (when you have a VPN, tunnel, etc ...)
 
+  awless ssh i-0123 -L 8080:localhost:80      # forward local port 8080 to port 80 of the instance
+  awless ssh db-private --through my-bastion -L 5432:localhost:5432  # forward local port 5432 to a private instance
+  awless ssh my-bastion -D 1080               # run a local SOCKS proxy reaching the network of the instance
+
   awless ssh redis-prod --print-cli           # print out the full terminal command to connect to instance
   awless ssh redis-prod --print-config        # print out the full SSH config (i.e: ~/.ssh/config) to connect to instance
   
@@ -84,13 +90,26 @@ var sshCmd = &cobra.Command{
   awless ssh 172.31.77.151 --port 2222 --through my-proxy --through-port 23  # specifying target & proxy port`,
 
 	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, firstInstallDoneHook),
-	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook, apiCallsHook),
 
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if len(args) != 1 {
 			return fmt.Errorf("instance required")
 		}
 
+		var localForwards []ssh.LocalForward
+		for _, spec := range localForwardFlags {
+			fwd, err := ssh.ParseLocalForward(spec)
+			exitOn(err)
+			localForwards = append(localForwards, fwd)
+		}
+		var dynamicForwards []ssh.DynamicForward
+		for _, spec := range dynamicForwardFlags {
+			fwd, err := ssh.ParseDynamicForward(spec)
+			exitOn(err)
+			dynamicForwards = append(dynamicForwards, fwd)
+		}
+
 		var err error
 		var connectionCtx *instanceConnectionContext
 
@@ -101,10 +120,15 @@ var sshCmd = &cobra.Command{
 		}
 		exitOn(err)
 
-		firsHopClient, err := ssh.InitClient(connectionCtx.keypath, config.KeysDir, filepath.Join(os.Getenv("HOME"), ".ssh"))
-		exitOn(err)
+		keyFolders := []string{config.KeysDir, ssh.UserSSHDir()}
+		var firsHopClient *ssh.Client
+		if keyPathFlag != "" {
+			firsHopClient, err = ssh.InitClientWithIdentity(keyPathFlag, keyFolders...)
+		} else {
+			firsHopClient, err = ssh.InitClient(connectionCtx.keypath, keyFolders...)
+		}
 
-		if err != nil && strings.Contains(err.Error(), "cannot find SSH key") && keyPathFlag == "" {
+		if err != nil && strings.Contains(err.Error(), "unable to resolve auth") {
 			logger.Info("you may want to specify a key filepath with `-i /path/to/key.pem`")
 		}
 		exitOn(err)
@@ -112,6 +136,10 @@ var sshCmd = &cobra.Command{
 		firsHopClient.SetLogger(logger.DefaultLogger)
 		firsHopClient.SetStrictHostKeyChecking(!disableStrictHostKeyCheckingFlag)
 		firsHopClient.InteractiveTerminalFunc = console.InteractiveTerminal
+		if forwardAgentFlag {
+			firsHopClient.ForwardAgent = true
+			firsHopClient.InteractiveTerminalFunc = console.InteractiveTerminalWithAgentForwarding
+		}
 		if proxyInstanceThroughFlag != "" {
 			firsHopClient.Port = sshTroughPortFlag
 		} else {
@@ -137,11 +165,7 @@ var sshCmd = &cobra.Command{
 			}
 		}
 
-		if connectionCtx.user != "" {
-			err = firsHopClient.DialWithUsers(connectionCtx.user)
-		} else {
-			err = firsHopClient.DialWithUsers(defaultAMIUsers...)
-		}
+		err = firsHopClient.DialWithUsers(connectionCtx.loginUsers()...)
 
 		if isConnectionRefusedErr(err) {
 			logger.Warning("cannot connect to this instance, maybe the system is still booting?")
@@ -161,14 +185,13 @@ var sshCmd = &cobra.Command{
 		if proxyInstanceThroughFlag != "" {
 			destInstanceCtx, err := initInstanceConnectionContext(args[0], keyPathFlag)
 			exitOn(err)
-			if destInstanceCtx.user != "" {
-				targetClient, err = firsHopClient.NewClientWithProxy(destInstanceCtx.privip, sshPortFlag, destInstanceCtx.user)
-			} else {
-				targetClient, err = firsHopClient.NewClientWithProxy(destInstanceCtx.privip, sshPortFlag, defaultAMIUsers...)
-			}
+			targetClient, err = firsHopClient.NewClientWithProxy(destInstanceCtx.privip, sshPortFlag, destInstanceCtx.loginUsers()...)
 			exitOn(err)
 		}
 
+		targetClient.LocalForwards = localForwards
+		targetClient.DynamicForwards = dynamicForwards
+
 		if printSSHConfigFlag {
 			host := connectionCtx.instanceName
 			if proxyInstanceThroughFlag != "" {
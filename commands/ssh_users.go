@@ -0,0 +1,73 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/wallix/awless/aws/services"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/ssh"
+	"github.com/wallix/awless/sync"
+)
+
+// loginUsers returns the users to try to connect to the instance: the one given
+// with user@instance or else the default user detected from the AMI of the instance
+func (ctx *instanceConnectionContext) loginUsers() []string {
+	if ctx.user != "" {
+		return []string{ctx.user}
+	}
+	imageID, _ := ctx.instance.Properties()[properties.Image].(string)
+	if imageID == "" {
+		return ssh.DefaultAMIUsers
+	}
+	name, description := amiNameAndDescription(imageID)
+	users := ssh.UsersForAMI(name, description)
+	if name != "" || description != "" {
+		logger.ExtraVerbosef("trying users %v for AMI %s (%s)", users, imageID, name)
+	}
+	return users
+}
+
+// amiNameAndDescription looks up the AMI in the local graph (ex: for owned images),
+// and falls back on the EC2 API for public AMIs which are not synced
+func amiNameAndDescription(id string) (name, description string) {
+	if g, err := sync.LoadLocalGraphs(config.GetAWSProfile(), config.GetAWSRegion()); err == nil {
+		if res, err := findResource(g, id, cloud.Image); err == nil {
+			name, _ = res.Properties()[properties.Name].(string)
+			description, _ = res.Properties()[properties.Description].(string)
+			return
+		}
+	}
+
+	infra, ok := awsservices.Resolve(awsservices.InfraService).(*awsservices.Infra)
+	if !ok {
+		return
+	}
+	out, err := infra.EC2API.DescribeImages(&ec2.DescribeImagesInput{ImageIds: []*string{awssdk.String(id)}})
+	if err != nil {
+		logger.ExtraVerbosef("cannot describe AMI %s: %s", id, err)
+		return
+	}
+	if len(out.Images) == 1 {
+		name, description = awssdk.StringValue(out.Images[0].Name), awssdk.StringValue(out.Images[0].Description)
+	}
+	return
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/wallix/awless/aws/services"
+	"github.com/wallix/awless/aws/spec"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/console"
+	"github.com/wallix/awless/database"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template"
+)
+
+// stackTagKey is the tag labeling the resources created by the runs of a stack (see --stack)
+const stackTagKey = "awless:stack"
+
+var runStackFlag string
+
+// runStack groups the runs labeled with the same stack name, in chronological order
+type runStack struct {
+	Name string
+	Runs []*template.TemplateExecution
+}
+
+// createdCount returns the number of resources successfully created by the runs of the stack
+func (s *runStack) createdCount() (count int) {
+	for _, run := range s.Runs {
+		for _, cmd := range run.CommandNodesIterator() {
+			if cmd.Action == "create" && cmd.Err() == nil && cmd.Result() != nil {
+				count++
+			}
+		}
+	}
+	return
+}
+
+// revertTemplate returns the template reverting all the revertible runs of the stack, latest run first
+func (s *runStack) revertTemplate() (*template.Template, error) {
+	var lines []string
+	for i := len(s.Runs) - 1; i >= 0; i-- {
+		run := s.Runs[i]
+		if !template.IsRevertible(run.Template) {
+			continue
+		}
+		reverted, err := run.Template.Revert()
+		if err != nil {
+			return nil, fmt.Errorf("stack %s: run %s: %s", s.Name, run.ID, err)
+		}
+		lines = append(lines, reverted.String())
+	}
+	if len(lines) == 0 {
+		return nil, fmt.Errorf("stack %s: nothing to revert", s.Name)
+	}
+	return template.Parse(strings.Join(lines, "\n"))
+}
+
+func groupRunsByStack(all []*database.LoadedTemplate) []*runStack {
+	byName := make(map[string]*runStack)
+	var stacks []*runStack
+	for _, loaded := range all {
+		if loaded.Err != nil || loaded.TplExec.Stack == "" {
+			continue
+		}
+		name := loaded.TplExec.Stack
+		if _, ok := byName[name]; !ok {
+			byName[name] = &runStack{Name: name}
+			stacks = append(stacks, byName[name])
+		}
+		byName[name].Runs = append(byName[name].Runs, loaded.TplExec)
+	}
+	sort.Slice(stacks, func(i, j int) bool { return stacks[i].Name < stacks[j].Name })
+	return stacks
+}
+
+func loadStacks() (stacks []*runStack, err error) {
+	err = database.Execute(func(db *database.DB) error {
+		all, dberr := db.ListTemplates()
+		stacks = groupRunsByStack(all)
+		return dberr
+	})
+	return
+}
+
+func findStack(name string) (*runStack, error) {
+	stacks, err := loadStacks()
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range stacks {
+		if s.Name == name {
+			return s, nil
+		}
+	}
+	return nil, fmt.Errorf("no stack '%s' in your logs (see `awless log --stacks`)", name)
+}
+
+func printStacks(w io.Writer, stacks []*runStack) {
+	if len(stacks) == 0 {
+		fmt.Fprintln(w, "No stack found: label your runs with `awless run --stack NAME`")
+		return
+	}
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAME\tRUNS\tCREATED\tREGION\tLAST RUN")
+	for _, s := range stacks {
+		last := s.Runs[len(s.Runs)-1]
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%s\t%s ago (%s)\n", s.Name, len(s.Runs), s.createdCount(), last.Locale, console.HumanizeTime(last.Date()), last.ID)
+	}
+	tw.Flush()
+}
+
+// deleteStack reverts all the runs of the stack in a single template. Once fully reverted,
+// the runs are no longer labeled with the stack, their logs being kept.
+func deleteStack(name string) error {
+	stack, err := findStack(name)
+	if err != nil {
+		return err
+	}
+	for _, run := range stack.Runs {
+		if loc := run.Locale; loc != "" && loc != config.GetAWSRegion() {
+			return fmt.Errorf("run %s of stack %s was in region %s: delete with `awless revert --stack %s -r %s`", run.ID, name, loc, name, loc)
+		}
+	}
+	reverted, err := stack.revertTemplate()
+	if err != nil {
+		return err
+	}
+	reverted = reverted.OrderTeardown(localTeardownDependencies())
+
+	if err = NewRunnerRequiredParamsOnly(reverted, fmt.Sprintf("Delete stack %s (%d runs)", name, len(stack.Runs)), "").Run(); err != nil {
+		return err
+	}
+
+	return database.Execute(func(db *database.DB) error {
+		for _, run := range stack.Runs {
+			run.Stack = ""
+			if err := db.AddTemplate(run); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// isLocalStack returns true when runs of the local logs are labeled with the given name
+func isLocalStack(name string) bool {
+	_, err := findStack(name)
+	return err == nil
+}
+
+// stackTaggingHook tags with the stack name the resources created successfully
+// by EC2 commands, other resources being only grouped in the logs
+func stackTaggingHook(stack string, tag func(id, stack string) error) template.StatementHook {
+	return func(event *template.StatementEvent) error {
+		if event.Stage != template.AfterStatement || event.Action != "create" || event.Error != "" {
+			return nil
+		}
+		id, ok := event.Result.(string)
+		if !ok || id == "" || event.Entity == "tag" || awsspec.APIPerTemplateDefName[event.Action+event.Entity] != "ec2" {
+			return nil
+		}
+		if err := tag(id, stack); err != nil {
+			return fmt.Errorf("tagging %s %s with stack %s: %s", event.Entity, id, stack, err)
+		}
+		logger.ExtraVerbosef("tagged %s %s with %s=%s", event.Entity, id, stackTagKey, stack)
+		return nil
+	}
+}
+
+func tagEC2ResourceWithStack(id, stack string) error {
+	infra, ok := awsservices.Resolve(awsservices.InfraService).(*awsservices.Infra)
+	if !ok {
+		return fmt.Errorf("no EC2 service available")
+	}
+	_, err := infra.CreateTags(&ec2.CreateTagsInput{
+		Resources: []*string{aws.String(id)},
+		Tags:      []*ec2.Tag{{Key: aws.String(stackTagKey), Value: aws.String(stack)}},
+	})
+	return err
+}
@@ -0,0 +1,95 @@
+package commands
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/wallix/awless/database"
+	"github.com/wallix/awless/template"
+)
+
+func TestGroupRunsByStack(t *testing.T) {
+	run := func(id, stack, text string) *database.LoadedTemplate {
+		tpl := template.MustParse(text)
+		tpl.ID = id
+		return &database.LoadedTemplate{TplExec: &template.TemplateExecution{Template: tpl, Stack: stack}}
+	}
+	all := []*database.LoadedTemplate{
+		run("1", "demo", "create vpc cidr=10.0.0.0/16"),
+		run("2", "", "create keypair name=other"),
+		run("3", "alpha", "create bucket name=logs"),
+		{Err: errors.New("corrupted"), TplExec: &template.TemplateExecution{Stack: "demo"}},
+		run("4", "demo", "create subnet cidr=10.0.0.0/24 vpc=vpc-1\ncreate keypair name=demo-key"),
+	}
+	for i, cmd := range all[0].TplExec.CommandNodesIterator() {
+		cmd.CmdResult = []string{"vpc-1"}[i]
+	}
+	for i, cmd := range all[4].TplExec.CommandNodesIterator() {
+		cmd.CmdResult = []string{"subnet-1", "demo-key"}[i]
+	}
+	all[2].TplExec.CommandNodesIterator()[0].CmdErr = errors.New("failed")
+
+	stacks := groupRunsByStack(all)
+	var names []string
+	for _, s := range stacks {
+		names = append(names, s.Name)
+	}
+	if got, want := names, []string{"alpha", "demo"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	demo := stacks[1]
+	if got, want := len(demo.Runs), 2; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := demo.createdCount(), 3; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := stacks[0].createdCount(), 0; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+
+	reverted, err := demo.revertTemplate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := reverted.String(), "delete keypair name=demo-key\ndelete subnet id=subnet-1\ndelete vpc id=vpc-1"; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+	if _, err = stacks[0].revertTemplate(); err == nil {
+		t.Fatal("expected error when nothing to revert")
+	}
+
+	if got, want := len(filterStackLogs(all, "demo")), 2; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+}
+
+func TestStackTaggingHook(t *testing.T) {
+	var tagged []string
+	hook := stackTaggingHook("demo", func(id, stack string) error {
+		tagged = append(tagged, id+":"+stack)
+		return nil
+	})
+	events := []*template.StatementEvent{
+		{Stage: template.BeforeStatement, Action: "create", Entity: "vpc"},
+		{Stage: template.AfterStatement, Action: "create", Entity: "vpc", Result: "vpc-1"},
+		{Stage: template.AfterStatement, Action: "create", Entity: "subnet", Result: "subnet-1", Error: "failed"},
+		{Stage: template.AfterStatement, Action: "create", Entity: "bucket", Result: "my-bucket"},
+		{Stage: template.AfterStatement, Action: "start", Entity: "instance", Result: "i-1"},
+		{Stage: template.AfterStatement, Action: "create", Entity: "instance", Result: "i-2"},
+	}
+	for _, e := range events {
+		if err := hook(e); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got, want := tagged, []string{"vpc-1:demo", "i-2:demo"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	failing := stackTaggingHook("demo", func(id, stack string) error { return errors.New("unauthorized") })
+	if err := failing(events[1]); err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,271 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/wallix/awless/aws/services"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/inspect/inspectors"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/sync"
+)
+
+const (
+	hoursPerMonth = 730
+	topTagsCount  = 5
+)
+
+var statsNoCostFlag bool
+
+func init() {
+	RootCmd.AddCommand(statsCmd)
+
+	statsCmd.Flags().BoolVar(&statsNoCostFlag, "no-cost", false, "Do not fetch instances prices to estimate the monthly cost")
+}
+
+var statsCmd = &cobra.Command{
+	Use:               "stats",
+	Short:             "One screen summary of your local resources: counts per region, instances states, estimated cost, top tags and changes since previous sync",
+	Example:           "  awless stats\n  awless stats --no-cost   # no prices fetching, local data only",
+	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initSyncerHook, firstInstallDoneHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook, apiCallsHook),
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		profile := config.GetAWSProfile()
+
+		current, err := sync.LoadLocalGraphsPerRegion(profile)
+		exitOn(err)
+		if len(current) == 0 {
+			return fmt.Errorf("no local data for profile '%s': run `awless sync` first", profile)
+		}
+
+		previous, err := sync.LoadPreviousLocalGraphsPerRegion(sync.DefaultSyncer, profile)
+		if err != nil {
+			logger.Verbosef("cannot load previous sync: %s", err)
+			previous = nil
+		}
+
+		stats, err := computeLocalStats(current, previous)
+		exitOn(err)
+
+		if !statsNoCostFlag {
+			stats.monthlyCost, stats.costErrors = estimateMonthlyCost(stats.runningInstanceTypes, inspectors.FetchPrice)
+		}
+
+		stats.print(os.Stdout)
+
+		return nil
+	},
+}
+
+type localStats struct {
+	regions              []string
+	countPerType         map[string]map[string]int
+	previousCountPerType map[string]int
+	instanceStates       map[string]int
+	runningInstanceTypes map[string]map[string]int
+	tags                 map[string]int
+
+	monthlyCost float64
+	costErrors  []error
+}
+
+func computeLocalStats(current, previous map[string]cloud.GraphAPI) (*localStats, error) {
+	stats := &localStats{
+		countPerType:         make(map[string]map[string]int),
+		instanceStates:       make(map[string]int),
+		runningInstanceTypes: make(map[string]map[string]int),
+		tags:                 make(map[string]int),
+	}
+
+	for region, g := range current {
+		stats.regions = append(stats.regions, region)
+		for _, resType := range awsservices.ResourceTypes {
+			resources, err := g.Find(cloud.NewQuery(resType))
+			if err != nil {
+				return stats, err
+			}
+			if len(resources) == 0 {
+				continue
+			}
+			if stats.countPerType[resType] == nil {
+				stats.countPerType[resType] = make(map[string]int)
+			}
+			stats.countPerType[resType][region] += len(resources)
+
+			for _, res := range resources {
+				for _, tag := range resourceTags(res) {
+					stats.tags[tag]++
+				}
+				if resType != cloud.Instance {
+					continue
+				}
+				state, _ := res.Properties()[properties.State].(string)
+				stats.instanceStates[state]++
+				if state == "running" {
+					if stats.runningInstanceTypes[region] == nil {
+						stats.runningInstanceTypes[region] = make(map[string]int)
+					}
+					instType, _ := res.Properties()[properties.Type].(string)
+					stats.runningInstanceTypes[region][instType]++
+				}
+			}
+		}
+	}
+	sort.Strings(stats.regions)
+
+	if len(previous) == 0 {
+		return stats, nil
+	}
+
+	stats.previousCountPerType = make(map[string]int)
+	for _, g := range previous {
+		for _, resType := range awsservices.ResourceTypes {
+			resources, err := g.Find(cloud.NewQuery(resType))
+			if err != nil {
+				return stats, err
+			}
+			stats.previousCountPerType[resType] += len(resources)
+		}
+	}
+
+	return stats, nil
+}
+
+func resourceTags(res cloud.Resource) (tags []string) {
+	switch tt := res.Properties()[properties.Tags].(type) {
+	case []string:
+		tags = tt
+	case []interface{}:
+		for _, t := range tt {
+			if s, ok := t.(string); ok {
+				tags = append(tags, s)
+			}
+		}
+	}
+	return
+}
+
+func estimateMonthlyCost(instanceTypesPerRegion map[string]map[string]int, priceFn func(instType, region string) (float64, error)) (total float64, errs []error) {
+	for region, types := range instanceTypesPerRegion {
+		for instType, count := range types {
+			price, err := priceFn(instType, region)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("fetching price for '%s' in %s: %s", instType, region, err))
+				continue
+			}
+			total += price * float64(count) * hoursPerMonth
+		}
+	}
+	return
+}
+
+func (s *localStats) total(resType string) (total int) {
+	for _, count := range s.countPerType[resType] {
+		total += count
+	}
+	return
+}
+
+func (s *localStats) print(w io.Writer) {
+	var types []string
+	for resType := range s.countPerType {
+		types = append(types, resType)
+	}
+	sort.Strings(types)
+
+	tabw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	headers := append([]string{"RESOURCE"}, s.regions...)
+	headers = append(headers, "TOTAL")
+	if s.previousCountPerType != nil {
+		headers = append(headers, "SINCE PREVIOUS SYNC")
+	}
+	fmt.Fprintln(tabw, strings.Join(headers, "\t"))
+	for _, resType := range types {
+		line := []string{cloud.PluralizeResource(resType)}
+		for _, region := range s.regions {
+			line = append(line, fmt.Sprint(s.countPerType[resType][region]))
+		}
+		line = append(line, fmt.Sprint(s.total(resType)))
+		if s.previousCountPerType != nil {
+			line = append(line, formatDelta(s.total(resType)-s.previousCountPerType[resType]))
+		}
+		fmt.Fprintln(tabw, strings.Join(line, "\t"))
+	}
+	tabw.Flush()
+
+	if len(s.instanceStates) > 0 {
+		var states, counts []string
+		for state := range s.instanceStates {
+			states = append(states, state)
+		}
+		sort.Strings(states)
+		for _, state := range states {
+			counts = append(counts, fmt.Sprintf("%d %s", s.instanceStates[state], state))
+		}
+		fmt.Fprintf(w, "\nInstances: %s\n", strings.Join(counts, ", "))
+	}
+
+	if s.monthlyCost > 0 || len(s.costErrors) > 0 {
+		fmt.Fprintf(w, "Estimated monthly cost of running instances (no EBS): $%.2f\n", s.monthlyCost)
+		for _, err := range s.costErrors {
+			logger.Warning(err)
+		}
+	}
+
+	if tags := topTags(s.tags, topTagsCount); len(tags) > 0 {
+		fmt.Fprintf(w, "Top tags: %s\n", strings.Join(tags, ", "))
+	}
+}
+
+func topTags(tags map[string]int, max int) (top []string) {
+	var all []string
+	for tag := range tags {
+		all = append(all, tag)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		if tags[all[i]] == tags[all[j]] {
+			return all[i] < all[j]
+		}
+		return tags[all[i]] > tags[all[j]]
+	})
+	for i := 0; i < len(all) && i < max; i++ {
+		top = append(top, fmt.Sprintf("%s (%d)", all[i], tags[all[i]]))
+	}
+	return
+}
+
+func formatDelta(delta int) string {
+	switch {
+	case delta > 0:
+		return fmt.Sprintf("+%d", delta)
+	case delta < 0:
+		return fmt.Sprint(delta)
+	default:
+		return "="
+	}
+}
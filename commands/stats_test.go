@@ -0,0 +1,73 @@
+package commands
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+)
+
+func TestComputeLocalStats(t *testing.T) {
+	newInstance := func(id, state, typ string, tags ...string) *graph.Resource {
+		inst := graph.InitResource(cloud.Instance, id)
+		inst.SetProperty(properties.State, state)
+		inst.SetProperty(properties.Type, typ)
+		inst.SetProperty(properties.Tags, tags)
+		return inst
+	}
+
+	paris := graph.NewGraph()
+	paris.AddResource(newInstance("i-1", "running", "t2.micro", "Env=Prod"), newInstance("i-2", "stopped", "t2.micro", "Env=Prod", "Team=web"), graph.InitResource(cloud.Subnet, "sub-1"))
+	dublin := graph.NewGraph()
+	dublin.AddResource(newInstance("i-3", "running", "m4.large", "Env=Dev"), newInstance("i-4", "running", "t2.micro"))
+	previous := graph.NewGraph()
+	previous.AddResource(graph.InitResource(cloud.Instance, "i-1"), graph.InitResource(cloud.Subnet, "sub-1"), graph.InitResource(cloud.Subnet, "sub-2"))
+
+	stats, err := computeLocalStats(map[string]cloud.GraphAPI{"eu-west-3": paris, "eu-west-1": dublin}, map[string]cloud.GraphAPI{"eu-west-3": previous})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := stats.regions, []string{"eu-west-1", "eu-west-3"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := stats.countPerType[cloud.Instance], map[string]int{"eu-west-3": 2, "eu-west-1": 2}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := stats.instanceStates, map[string]int{"running": 3, "stopped": 1}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := stats.runningInstanceTypes, map[string]map[string]int{"eu-west-3": {"t2.micro": 1}, "eu-west-1": {"m4.large": 1, "t2.micro": 1}}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := topTags(stats.tags, 2), []string{"Env=Prod (2)", "Env=Dev (1)"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	prices := map[string]float64{"t2.micro": 0.01, "m4.large": 0.1}
+	stats.monthlyCost, stats.costErrors = estimateMonthlyCost(stats.runningInstanceTypes, func(typ, region string) (float64, error) {
+		if region == "eu-west-1" && typ == "m4.large" {
+			return 0, errors.New("unavailable")
+		}
+		return prices[typ], nil
+	})
+	if got, want := stats.monthlyCost, 2*0.01*hoursPerMonth; got != want {
+		t.Fatalf("got %f, want %f", got, want)
+	}
+	if got, want := len(stats.costErrors), 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+
+	var buf bytes.Buffer
+	stats.print(&buf)
+	for _, exp := range []string{"instances  2          2          4      +3", "subnets    0          1          1      -1", "Instances: 3 running, 1 stopped", "$14.60"} {
+		if !strings.Contains(buf.String(), exp) {
+			t.Fatalf("expected %q in\n%s", exp, buf.String())
+		}
+	}
+}
@@ -22,13 +22,16 @@ import (
 	"os"
 	"runtime"
 	"runtime/pprof"
+	"sort"
 	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/wallix/awless/aws/services"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/console"
 	"github.com/wallix/awless/logger"
 	"github.com/wallix/awless/sync"
 )
@@ -36,10 +39,12 @@ import (
 var (
 	servicesToSyncFlags map[string]*bool
 	profileSyncFlag     bool
+	syncStatsFlag       bool
 )
 
 func init() {
 	RootCmd.AddCommand(syncCmd)
+	syncCmd.Flags().BoolVar(&syncStatsFlag, "stats", false, "Display a summary of durations, API calls and throttling per service")
 	syncCmd.Flags().BoolVar(&profileSyncFlag, "profile-sync", false, "Will dump a cpu and mem profiling file")
 
 	servicesToSyncFlags = make(map[string]*bool)
@@ -50,12 +55,22 @@ func init() {
 }
 
 var syncCmd = &cobra.Command{
-	Use:               "sync",
+	Use:               "sync [SERVICE|RESOURCE_TYPE ...]",
+	Example:           "  awless sync                # sync all services\n  awless sync infra dns      # sync only given services\n  awless sync instances      # refresh only instances in the local infra snapshot",
 	Short:             "Manual sync of remote resources to the local store (ex: when autosync is unset)",
 	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, initSyncerHook, firstInstallDoneHook),
-	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook, apiCallsHook),
 
 	RunE: func(cmd *cobra.Command, args []string) error {
+		typesPerService, err := parseSyncArgs(args)
+		exitOn(err)
+		if len(typesPerService) > 0 {
+			syncResourceTypes(typesPerService)
+			if len(args) == countResourceTypes(typesPerService) {
+				return nil
+			}
+		}
+
 		var services []cloud.Service
 		displayAllServices := true
 		for _, srv := range cloud.ServiceRegistry {
@@ -68,16 +83,20 @@ var syncCmd = &cobra.Command{
 				services = append(services, srv)
 			}
 		}
-		localGraphs := make(map[string]cloud.GraphAPI)
-		for _, service := range services {
-			localGraphs[service.Name()] = sync.LoadLocalGraphForService(service.Name(), config.GetAWSProfile(), config.GetAWSRegion())
-		}
 		logger.Infof("running sync for region '%s'", config.GetAWSRegion())
 
+		var synced []*sync.ServiceSync
+		syncer := sync.DefaultSyncer
+		if !noSyncGlobalFlag {
+			syncer = sync.NewSyncerWithProgress(func(ss *sync.ServiceSync) {
+				synced = append(synced, ss)
+				displaySyncProgress(ss)
+			}, logger.DefaultLogger)
+		}
+
 		var syncErr error
-		var graphs map[string]cloud.GraphAPI
 		syncFn := func() {
-			graphs, syncErr = sync.DefaultSyncer.Sync(services...)
+			_, syncErr = syncer.Sync(services...)
 		}
 
 		start := time.Now()
@@ -90,15 +109,65 @@ var syncCmd = &cobra.Command{
 			logger.Verbose(syncErr)
 		}
 
-		for k, g := range graphs {
-			displaySyncStats(k, g)
+		if syncStatsFlag {
+			displaySyncSummary(synced)
 		}
 		logger.Infof("sync took %s", time.Since(start))
+		auditSync(services, time.Since(start), syncErr)
+		pushSyncSnapshot()
 
 		return nil
 	},
 }
 
+// parseSyncArgs enables the sync flags of the services given as args
+// and returns the resource types given as args per service name
+func parseSyncArgs(args []string) (map[string][]string, error) {
+	typesPerService := make(map[string][]string)
+	for _, arg := range args {
+		if flag, ok := servicesToSyncFlags[arg]; ok {
+			*flag = true
+			continue
+		}
+		resType := cloud.SingularizeResource(arg)
+		srvName, ok := awsservices.ServicePerResourceType[resType]
+		if !ok {
+			return typesPerService, fmt.Errorf("sync: unknown service or resource type '%s'", arg)
+		}
+		typesPerService[srvName] = append(typesPerService[srvName], resType)
+	}
+	return typesPerService, nil
+}
+
+func countResourceTypes(typesPerService map[string][]string) (count int) {
+	for _, types := range typesPerService {
+		count += len(types)
+	}
+	return
+}
+
+func syncResourceTypes(typesPerService map[string][]string) {
+	start := time.Now()
+	for srvName, types := range typesPerService {
+		srv, ok := cloud.ServiceRegistry[srvName]
+		if !ok {
+			exitOn(fmt.Errorf("sync: service %s not available", srvName))
+		}
+		var plurals []string
+		for _, t := range types {
+			plurals = append(plurals, cloud.PluralizeResource(t))
+		}
+		logger.Infof("running sync of %s for region '%s'", strings.Join(plurals, ", "), config.GetAWSRegion())
+		g, err := sync.DefaultSyncer.SyncResourceTypes(srv, types...)
+		if err != nil {
+			logger.Error(err)
+			continue
+		}
+		displaySyncStats(srvName, g)
+	}
+	logger.Infof("sync took %s", time.Since(start))
+}
+
 func withProfiling(fn func()) {
 	logger.Infof("sync profiling on")
 	mem, err := os.Create("mem-sync.prof")
@@ -127,7 +196,71 @@ func withProfiling(fn func()) {
 }
 
 func displaySyncStats(serviceName string, g cloud.GraphAPI) {
+	logger.Infof("-> %s: %s", serviceName, syncResourcesCount(serviceName, g))
+}
+
+func displaySyncProgress(ss *sync.ServiceSync) {
+	name := ss.Service.Name()
+	if ss.Err != nil {
+		logger.Errorf("-> %s: failed after %s: %s", name, ss.Duration.Round(time.Millisecond), ss.Err)
+		return
+	}
+	details := fmt.Sprintf("%s, %d API calls", ss.Duration.Round(time.Millisecond), awsservices.DefaultAPIStats.Calls(name))
+	if throttled := awsservices.DefaultAPIStats.Throttled(name); throttled > 0 {
+		details += fmt.Sprintf(", %d throttled", throttled)
+	}
+	logger.Infof("-> %s: %s (%s)", name, syncResourcesCount(name, ss.Graph), details)
+}
+
+func displaySyncSummary(synced []*sync.ServiceSync) {
+	sort.Slice(synced, func(i, j int) bool { return synced[i].Duration > synced[j].Duration })
+
+	w := tabwriter.NewWriter(os.Stderr, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "SERVICE\tRESOURCES\tDURATION\tAPI CALLS\tTHROTTLED\tTOP OPERATIONS")
+	for _, ss := range synced {
+		name := ss.Service.Name()
+		var count int
+		if ss.Graph != nil {
+			for _, rt := range awsservices.ResourceTypesPerServiceName()[name] {
+				if res, err := ss.Graph.Find(cloud.NewQuery(rt)); err == nil {
+					count += len(res)
+				}
+			}
+		}
+		fmt.Fprintf(w, "%s\t%d\t%s\t%d\t%d\t%s\n", name, count, ss.Duration.Round(time.Millisecond),
+			awsservices.DefaultAPIStats.Calls(name), awsservices.DefaultAPIStats.Throttled(name), mostCalledOperations(name, 3))
+	}
+	w.Flush()
+}
+
+func mostCalledOperations(serviceName string, max int) string {
+	ops := awsservices.DefaultAPIStats.Operations(serviceName)
+	var names []string
+	for op := range ops {
+		names = append(names, op)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if ops[names[i]] == ops[names[j]] {
+			return names[i] < names[j]
+		}
+		return ops[names[i]] > ops[names[j]]
+	})
+	if len(names) > max {
+		names = names[:max]
+	}
+	var out []string
+	for _, n := range names {
+		out = append(out, fmt.Sprintf("%s(%d)", n, ops[n]))
+	}
+	return strings.Join(out, " ")
+}
+
+func syncResourcesCount(serviceName string, g cloud.GraphAPI) string {
 	var strs []string
+	if g == nil {
+		return "no resources"
+	}
 	for rt, service := range awsservices.ServicePerResourceType {
 		if service == serviceName {
 			res, err := g.Find(cloud.NewQuery(rt))
@@ -142,5 +275,18 @@ func displaySyncStats(serviceName string, g cloud.GraphAPI) {
 			}
 		}
 	}
-	logger.Infof("-> %s: %s", serviceName, strings.Join(strs, ", "))
+	return strings.Join(strs, ", ")
+}
+
+func logLocalSyncAge(serviceName string) {
+	age, err := sync.LocalGraphAgeForService(serviceName, config.GetAWSProfile(), config.GetAWSRegion())
+	if err != nil {
+		logger.Warningf("%s. Run `awless sync` to fetch it", err)
+		return
+	}
+	if ago := console.HumanizeTime(time.Now().UTC().Add(-age)); ago == "now" {
+		logger.Infof("using local %s data synced just now", serviceName)
+	} else {
+		logger.Infof("using local %s data synced %s ago (refresh with `awless sync`)", serviceName, ago)
+	}
 }
@@ -0,0 +1,174 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/wallix/awless/aws/spec"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template"
+)
+
+var (
+	templateSchemaFormatFlag string
+	templateMigrateWriteFlag bool
+	templateGraphFormatFlag  string
+)
+
+func init() {
+	RootCmd.AddCommand(templateCmd)
+	templateCmd.AddCommand(templateSchemaCmd)
+	templateCmd.AddCommand(templateMigrateCmd)
+	templateCmd.AddCommand(templateInvertCmd)
+	templateCmd.AddCommand(templateGraphCmd)
+
+	templateSchemaCmd.Flags().StringVar(&templateSchemaFormatFlag, "format", "json", "Output format: json")
+	templateGraphCmd.Flags().StringVar(&templateGraphFormatFlag, "format", "dot", "Output format: dot, stages")
+	templateMigrateCmd.Flags().BoolVarP(&templateMigrateWriteFlag, "write", "w", false, "Write the migrated template to the file instead of stdout")
+}
+
+var templateCmd = &cobra.Command{
+	Use:   "template",
+	Short: "Utilities to write awless templates",
+}
+
+var templateSchemaCmd = &cobra.Command{
+	Use:     "schema",
+	Short:   "Dump all template actions, entities, params and values enums (for editors completion and validation)",
+	Example: "  awless template schema --format json > awless-schema.json",
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if templateSchemaFormatFlag != "json" {
+			return fmt.Errorf("invalid format '%s': expecting json", templateSchemaFormatFlag)
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(awsspec.TemplatesSchema())
+	},
+}
+
+var templateMigrateCmd = &cobra.Command{
+	Use:     "migrate PATH",
+	Short:   fmt.Sprintf("Rewrite a template written with an older grammar to the current version (%d)", template.CurrentVersion),
+	Example: "  awless template migrate old.awls\n  awless template migrate -w old.awls   # rewrite file in place",
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return errors.New("missing PATH arg")
+		}
+		path := args[0]
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		migrated, err := template.Migrate(string(content))
+		if err != nil {
+			return fmt.Errorf("%s: %s", path, err)
+		}
+		if !templateMigrateWriteFlag {
+			fmt.Print(migrated)
+			return nil
+		}
+		if migrated == string(content) {
+			logger.Infof("%s already at template version %d", path, template.CurrentVersion)
+			return nil
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+		if err = ioutil.WriteFile(path, []byte(migrated), info.Mode()); err != nil {
+			return err
+		}
+		logger.Infof("%s migrated to template version %d", path, template.CurrentVersion)
+		return nil
+	},
+}
+
+var templateInvertCmd = &cobra.Command{
+	Use:     "invert PATH",
+	Short:   "Generate the template destroying what a template creates (deletes, detaches, etc. in reverse order), without needing a run to revert",
+	Example: "  awless template invert create-env.awls > destroy-env.awls",
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return errors.New("missing PATH arg")
+		}
+		path := args[0]
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		tpl, err := template.Parse(string(content))
+		if err != nil {
+			return fmt.Errorf("%s: %s", path, err)
+		}
+		inverted, err := tpl.Invert()
+		if err != nil {
+			return fmt.Errorf("%s: %s", path, err)
+		}
+		fmt.Println(inverted)
+		return nil
+	},
+}
+
+var templateGraphCmd = &cobra.Command{
+	Use:     "graph PATH",
+	Short:   "Render the dependencies between the statements of a template (references, names of created resources, waits on checks), or the stages of statements that could run in parallel",
+	Example: "  awless template graph create-env.awls --format dot | dot -Tsvg > create-env.svg\n  awless template graph create-env.awls --format stages",
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return errors.New("missing PATH arg")
+		}
+		path := args[0]
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		tpl, err := template.Parse(string(content))
+		if err != nil {
+			return fmt.Errorf("%s: %s", path, err)
+		}
+		g := tpl.DependencyGraph()
+		switch templateGraphFormatFlag {
+		case "dot":
+			return g.WriteDot(os.Stdout)
+		case "stages":
+			for i, stage := range g.Stages() {
+				fmt.Printf("Stage %d:\n", i+1)
+				for _, index := range stage {
+					fmt.Printf("\t%s\n", g.Statements[index])
+				}
+			}
+			for index, refs := range g.Undefined {
+				for _, ref := range refs {
+					logger.Warningf("'%s': '$%s' is undefined in template", g.Statements[index], ref)
+				}
+			}
+			return nil
+		default:
+			return fmt.Errorf("invalid format '%s': expecting dot or stages", templateGraphFormatFlag)
+		}
+	},
+}
@@ -18,6 +18,7 @@ package commands
 
 import (
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net"
 	"net/http"
@@ -27,6 +28,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/spf13/cobra"
 	"github.com/wallix/awless/aws/services"
+	"github.com/wallix/awless/aws/spec"
+	"github.com/wallix/awless/config"
 	"github.com/wallix/awless/logger"
 )
 
@@ -47,8 +50,8 @@ var whoamiCmd = &cobra.Command{
 	Use:               "whoami",
 	Aliases:           []string{"who"},
 	PersistentPreRun:  applyHooks(initAwlessEnvHook, initLoggerHook, initCloudServicesHook, firstInstallDoneHook),
-	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook),
-	Short:             "Show your account, attached (i.e. managed) and inlined policies",
+	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook, apiCallsHook),
+	Short:             "Show your identity, account (and alias), region, profile, credentials origin, attached (i.e. managed) and inlined policies",
 
 	Run: func(cmd *cobra.Command, args []string) {
 		if onlyMyIPFlag {
@@ -61,7 +64,7 @@ var whoamiCmd = &cobra.Command{
 			return
 		}
 
-		me, err := awsservices.AccessService.(*awsservices.Access).GetIdentity()
+		me, err := awsservices.Resolve(awsservices.AccessService).(*awsservices.Access).GetIdentity()
 		exitOn(err)
 
 		if me.IsRoot() {
@@ -90,12 +93,14 @@ var whoamiCmd = &cobra.Command{
 
 		if !me.IsUserType() {
 			fmt.Printf("ResourceType: %s, Resource: %s, Id: %s, Account: %s\n", me.ResourceType, me.Resource, me.UserId, me.Account)
+			printAccountContext()
 			return
 		}
 
 		fmt.Printf("Username: %s, Id: %s, Account: %s\n", me.Resource, me.UserId, me.Account)
+		printAccountContext()
 
-		policies, err := awsservices.AccessService.(*awsservices.Access).GetUserPolicies(me.Resource)
+		policies, err := awsservices.Resolve(awsservices.AccessService).(*awsservices.Access).GetUserPolicies(me.Resource)
 		if err != nil {
 			if aerr, ok := err.(awserr.RequestFailure); ok && aerr.Code() == "AccessDenied" {
 				logger.Warningf("user '%s' is not authorized to list its policies", me.Resource)
@@ -129,6 +134,55 @@ var whoamiCmd = &cobra.Command{
 	},
 }
 
+// printAccountContext prints the account alias, region, profile and where the credentials
+// in use were resolved from (ex: shared credentials file, EC2 instance profile, ECS task role)
+func printAccountContext() {
+	alias, err := awsservices.Resolve(awsservices.AccessService).(*awsservices.Access).GetAccountAlias()
+	if err != nil {
+		logger.Verbosef("cannot get account alias: %s", err)
+	}
+	if alias == "" {
+		alias = "none"
+	}
+	source := "unknown"
+	if factory, ok := awsspec.CommandFactory.(*awsspec.AWSFactory); ok && factory.Sess != nil {
+		if creds, err := factory.Sess.Config.Credentials.Get(); err == nil {
+			source = awsservices.CredentialsSource(creds)
+		}
+	}
+	fmt.Printf("Account alias: %s, Region: %s, Profile: %s, Credentials: %s\n", alias, config.GetAWSRegion(), config.GetAWSProfile(), source)
+}
+
+// printContextBanner prints a one-line reminder of the account and region about to be modified
+// (enabled with `awless config set display.context.banner true`)
+func printContextBanner(w io.Writer) {
+	if !config.IsContextBannerEnabled() {
+		return
+	}
+	var account, alias string
+	if access, ok := awsservices.Resolve(awsservices.AccessService).(*awsservices.Access); ok {
+		if me, err := access.GetIdentity(); err != nil {
+			logger.Verbosef("cannot resolve account for context banner: %s", err)
+		} else {
+			account = me.Account
+		}
+		if a, err := access.GetAccountAlias(); err == nil {
+			alias = a
+		}
+	}
+	fmt.Fprintln(w, renderYellowFn(contextBanner(account, alias, config.GetAWSRegion(), config.GetAWSProfile())))
+}
+
+func contextBanner(account, alias, region, profile string) string {
+	if account == "" {
+		account = "unknown"
+	}
+	if alias != "" {
+		account = fmt.Sprintf("%s (%s)", account, alias)
+	}
+	return fmt.Sprintf("[account %s | region %s | profile %s]", account, region, profile)
+}
+
 func getMyIP() net.IP {
 	client := &http.Client{Timeout: 3 * time.Second}
 	if resp, err := client.Get("http://checkip.amazonaws.com/"); err == nil {
@@ -0,0 +1,18 @@
+package commands
+
+import "testing"
+
+func TestContextBanner(t *testing.T) {
+	tcases := []struct {
+		account, alias, exp string
+	}{
+		{account: "123456789012", alias: "prod", exp: "[account 123456789012 (prod) | region eu-west-1 | profile default]"},
+		{account: "123456789012", exp: "[account 123456789012 | region eu-west-1 | profile default]"},
+		{exp: "[account unknown | region eu-west-1 | profile default]"},
+	}
+	for _, tcase := range tcases {
+		if got, want := contextBanner(tcase.account, tcase.alias, "eu-west-1", "default"), tcase.exp; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	}
+}
@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"strings"
 	"time"
+
+	"github.com/wallix/awless/aws/config"
+	"github.com/wallix/awless/console"
 )
 
 func GetAWSRegion() string {
@@ -45,6 +48,128 @@ func GetSchedulerURL() string {
 	return ""
 }
 
+func GetBeforeStatementHooks() []string {
+	return splitList(Config[beforeStatementHookConfigKey])
+}
+
+func GetAfterStatementHooks() []string {
+	return splitList(Config[afterStatementHookConfigKey])
+}
+
+func GetSlackWebhook() string {
+	if u, ok := Config[slackWebhookConfigKey].(string); ok {
+		return u
+	}
+	return ""
+}
+
+func GetTeamsWebhook() string {
+	if u, ok := Config[teamsWebhookConfigKey].(string); ok {
+		return u
+	}
+	return ""
+}
+
+func GetAuditS3Bucket() string {
+	if b, ok := Config[auditS3BucketConfigKey].(string); ok {
+		return b
+	}
+	return ""
+}
+
+func GetAuditLogGroup() string {
+	if g, ok := Config[auditLogGroupConfigKey].(string); ok {
+		return g
+	}
+	return ""
+}
+
+func GetLockDynamoDBTable() string {
+	if t, ok := Config[lockDynamoDBTableConfigKey].(string); ok {
+		return t
+	}
+	return ""
+}
+
+// GetLockS3Bucket returns the bucket and key prefix (default: awless/locks) of the S3 locks
+func GetLockS3Bucket() (bucket, prefix string) {
+	b, _ := Config[lockS3BucketConfigKey].(string)
+	if b == "" {
+		return "", ""
+	}
+	splits := strings.SplitN(strings.Trim(b, "/"), "/", 2)
+	if len(splits) == 2 {
+		return splits[0], splits[1]
+	}
+	return splits[0], "awless/locks"
+}
+
+func GetGraphDBURL() string {
+	if u, ok := Config[graphDBURLConfigKey].(string); ok {
+		return u
+	}
+	return ""
+}
+
+// GetUserDataSecretsPatterns returns the regexps masking secrets in displayed userdata
+func GetUserDataSecretsPatterns() []string {
+	var patterns []string
+	if s, ok := Config[userDataSecretsConfigKey].(string); ok {
+		for _, p := range strings.Split(s, ";") {
+			if p = strings.TrimSpace(p); p != "" {
+				patterns = append(patterns, p)
+			}
+		}
+	}
+	if len(patterns) == 0 {
+		return console.DefaultSecretsPatterns
+	}
+	return patterns
+}
+
+var defaultProtectedTags = []string{"Protected=true"}
+
+// GetProtectedTags returns the tag keys or key=value pairs marking resources protected from deletion
+func GetProtectedTags() []string {
+	if tags := splitList(Config[protectedTagsConfigKey]); len(tags) > 0 {
+		return tags
+	}
+	return defaultProtectedTags
+}
+
+// GetAPIConcurrency returns per AWS service the maximum number of concurrent commands of bulk operations
+func GetAPIConcurrency() map[string]int {
+	s, _ := Config[APIConcurrencyConfigKey].(string)
+	maxima, _ := awsconfig.ParseAPIConcurrency(s)
+	return maxima
+}
+
+// IsContextBannerEnabled returns true when the account and region banner is printed before mutating commands
+func IsContextBannerEnabled() bool {
+	enabled, _ := Config[contextBannerConfigKey].(bool)
+	return enabled
+}
+
+func IsReadOnlyMode() bool {
+	if m, ok := Config[modeConfigKey].(string); ok {
+		return m == ReadOnlyMode
+	}
+	return false
+}
+
+func splitList(i interface{}) (out []string) {
+	s, ok := i.(string)
+	if !ok {
+		return
+	}
+	for _, e := range strings.Split(s, ",") {
+		if e = strings.TrimSpace(e); e != "" {
+			out = append(out, e)
+		}
+	}
+	return
+}
+
 func GetConfigWithPrefix(prefix string) map[string]interface{} {
 	conf := make(map[string]interface{})
 	for k, v := range Config {
@@ -61,3 +186,27 @@ func getCheckUpgradeFrequency() time.Duration {
 	}
 	return 8 * time.Hour
 }
+
+// GetCacheTTL returns the time to live of the on disk cache of slow lookups
+func GetCacheTTL() time.Duration {
+	if ttl, ok := Config[cacheTTLConfigKey].(int); ok {
+		return time.Duration(ttl) * time.Hour
+	}
+	return 24 * time.Hour
+}
+
+// GetDisplayTheme returns the default display theme with the configured colors overrides
+func GetDisplayTheme() console.Theme {
+	theme := console.DefaultTheme
+	if s, ok := Config[headerColorsConfigKey].(string); ok {
+		if colors, err := console.ParseColors(s); err == nil {
+			theme.Header = colors
+		}
+	}
+	if s, ok := Config[stateColorsConfigKey].(string); ok {
+		if states, err := console.ParseStateColors(s); err == nil {
+			theme = theme.WithStateColors(states)
+		}
+	}
+	return theme
+}
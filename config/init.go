@@ -25,13 +25,15 @@ import (
 
 	"github.com/wallix/awless/aws/services"
 	"github.com/wallix/awless/database"
+	"github.com/wallix/awless/ssh"
 )
 
 var (
-	AwlessHome         = filepath.Join(os.Getenv("HOME"), ".awless")
+	AwlessHome         = filepath.Join(ssh.HomeDir(), ".awless")
 	DBPath             = filepath.Join(AwlessHome, database.Filename)
 	Dir                = filepath.Join(AwlessHome, "aws")
 	KeysDir            = filepath.Join(AwlessHome, "keys")
+	DisplayProfiles    = filepath.Join(AwlessHome, "display.yml")
 	AwlessFirstInstall bool
 )
 
@@ -49,22 +49,25 @@ var ColumnsInListing = map[string][]string{
 	cloud.ScalingPolicy:       {properties.Name, properties.Type, properties.ScalingGroupName, properties.AlarmNames, properties.AdjustmentType, properties.ScalingAdjustment},
 	cloud.Repository:          {properties.Name, properties.URI, properties.Created, properties.Account, properties.Arn},
 	cloud.ContainerCluster:    {properties.Name, properties.State, properties.ActiveServicesCount, properties.PendingTasksCount, properties.RegisteredContainerInstancesCount, properties.RunningTasksCount},
+	cloud.ContainerService:    {properties.Name, properties.Cluster, properties.State, properties.ContainerTask, properties.DesiredTasksCount, properties.RunningTasksCount, properties.PendingTasksCount, properties.Type, properties.Created},
 	cloud.ContainerTask:       {properties.Name, properties.Version, properties.State, properties.ContainersImages, properties.Deployments},
 	cloud.Container:           {properties.Name, properties.DeploymentName, properties.State, properties.Created, properties.Launched, properties.Stopped, properties.Cluster, properties.ContainerTask},
 	cloud.ContainerInstance:   {properties.ID, properties.Instance, properties.Cluster, properties.State, properties.RunningTasksCount, properties.PendingTasksCount, properties.Created, properties.AgentConnected},
 	cloud.Certificate:         {properties.Arn, properties.Name},
+	cloud.ElasticsearchDomain: {properties.Name, properties.State, properties.Type, properties.Version, properties.Storage, properties.Endpoint},
 	cloud.User:                {properties.ID, properties.Name, properties.PasswordLastUsed, properties.Created},
-	cloud.Role:                {properties.ID, properties.Name, properties.Created},
-	cloud.InstanceProfile:     {properties.ID, properties.Name, properties.Path, properties.Created},
-	cloud.Policy:              {properties.ID, properties.Name, properties.Type, properties.Created, properties.Updated, properties.Attached},
+	cloud.Role:                {properties.ID, properties.Name, properties.InstanceProfiles, properties.Created},
+	cloud.InstanceProfile:     {properties.ID, properties.Name, properties.Roles, properties.Path, properties.Created},
+	cloud.Policy:              {properties.ID, properties.Name, properties.Type, properties.Created, properties.Updated, properties.Attached, properties.AttachmentCount},
 	cloud.Group:               {properties.ID, properties.Name, properties.Created},
 	cloud.AccessKey:           {properties.ID, properties.State, properties.Username, properties.Created},
 	cloud.MFADevice:           {properties.ID, properties.AttachedAt},
 	cloud.Bucket:              {properties.ID, properties.Grants, properties.Created},
 	cloud.S3Object:            {properties.ID, properties.Bucket, properties.Modified, properties.Owner, properties.Size, properties.Class},
 	cloud.Subscription:        {properties.Arn, properties.Topic, properties.Endpoint, properties.Protocol, properties.Owner},
-	cloud.Topic:               {properties.ID},
+	cloud.Topic:               {properties.ID, properties.Name},
 	cloud.Queue:               {properties.ID, properties.ApproximateMessageCount, properties.Created, properties.Modified, properties.Delay},
+	cloud.EmailIdentity:       {properties.Name, properties.Type, properties.State},
 	cloud.Zone:                {properties.ID, properties.Name, properties.Comment, properties.Private, properties.RecordCount, properties.CallerReference},
 	cloud.Record:              {properties.ID, properties.Type, properties.Name, properties.Records, properties.Zone, properties.Alias, properties.TTL},
 	cloud.Function:            {properties.Name, properties.Size, properties.Memory, properties.Runtime, properties.Version, properties.Modified, properties.Description},
@@ -80,14 +83,11 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		StringColumnDefinition{Prop: properties.ID},
 		StringColumnDefinition{Prop: properties.AvailabilityZone, Friendly: "Zone"},
 		StringColumnDefinition{Prop: properties.Name},
-		ColoredValueColumnDefinition{
-			StringColumnDefinition: StringColumnDefinition{Prop: properties.State},
-			ColoredValues:          map[string]color.Attribute{"running": color.FgGreen, "stopped": color.FgRed},
-		},
+		StateColumnDefinition{StringColumnDefinition{Prop: properties.State}},
 		StringColumnDefinition{Prop: properties.Type},
 		StringColumnDefinition{Prop: properties.PublicIP, Friendly: "Public IP"},
 		StringColumnDefinition{Prop: properties.PrivateIP, Friendly: "Private IP"},
-		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Launched, Friendly: "Uptime"}},
+		AgeColumnDefinition{StringColumnDefinition{Prop: properties.Launched, Friendly: "Uptime"}},
 		StringColumnDefinition{Prop: properties.KeyPair},
 	},
 	cloud.Vpc: {
@@ -97,7 +97,7 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 			StringColumnDefinition: StringColumnDefinition{Prop: properties.Default, Friendly: "Default"},
 			ColoredValues:          map[string]color.Attribute{"true": color.FgGreen},
 		},
-		StringColumnDefinition{Prop: properties.State},
+		StateColumnDefinition{StringColumnDefinition{Prop: properties.State}},
 		StringColumnDefinition{Prop: properties.CIDR},
 	},
 	cloud.Subnet: {
@@ -113,9 +113,7 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		ColoredValueColumnDefinition{
 			StringColumnDefinition: StringColumnDefinition{Prop: properties.Public},
 			ColoredValues:          map[string]color.Attribute{"true": color.FgYellow}},
-		ColoredValueColumnDefinition{
-			StringColumnDefinition: StringColumnDefinition{Prop: properties.State},
-			ColoredValues:          map[string]color.Attribute{"available": color.FgGreen}},
+		StateColumnDefinition{StringColumnDefinition{Prop: properties.State}},
 	},
 	cloud.SecurityGroup: {
 		StringColumnDefinition{Prop: properties.ID},
@@ -132,7 +130,7 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 	},
 	cloud.NatGateway: {
 		StringColumnDefinition{Prop: properties.ID},
-		StringColumnDefinition{Prop: properties.State},
+		StateColumnDefinition{StringColumnDefinition{Prop: properties.State}},
 		StringColumnDefinition{Prop: properties.Vpc},
 		StringColumnDefinition{Prop: properties.Subnet},
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created, Friendly: "Created"}},
@@ -155,7 +153,7 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 	cloud.Image: {
 		StringColumnDefinition{Prop: properties.ID},
 		StringColumnDefinition{Prop: properties.Name},
-		StringColumnDefinition{Prop: properties.State},
+		StateColumnDefinition{StringColumnDefinition{Prop: properties.State}},
 		StringColumnDefinition{Prop: properties.Location},
 		StringColumnDefinition{Prop: properties.Public},
 		StringColumnDefinition{Prop: properties.Type},
@@ -169,14 +167,14 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		StringColumnDefinition{Prop: properties.Description},
 		StringColumnDefinition{Prop: properties.Image},
 		StringColumnDefinition{Prop: properties.Progress},
-		StringColumnDefinition{Prop: properties.State},
+		StateColumnDefinition{StringColumnDefinition{Prop: properties.State}},
 		StringColumnDefinition{Prop: properties.StateMessage},
 	},
 	cloud.Volume: {
 		StringColumnDefinition{Prop: properties.ID},
 		StringColumnDefinition{Prop: properties.Name},
 		StringColumnDefinition{Prop: properties.Type},
-		StringColumnDefinition{Prop: properties.State},
+		StateColumnDefinition{StringColumnDefinition{Prop: properties.State}},
 		StorageColumnDefinition{Unit: gb, StringColumnDefinition: StringColumnDefinition{Prop: properties.Size}},
 		StringColumnDefinition{Prop: properties.Encrypted},
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
@@ -185,7 +183,7 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 	},
 	cloud.AvailabilityZone: {
 		StringColumnDefinition{Prop: properties.Name},
-		StringColumnDefinition{Prop: properties.State},
+		StateColumnDefinition{StringColumnDefinition{Prop: properties.State}},
 		StringColumnDefinition{Prop: properties.Region},
 		StringColumnDefinition{Prop: properties.Messages},
 	},
@@ -200,7 +198,7 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		StringColumnDefinition{Prop: properties.Volume},
 		StringColumnDefinition{Prop: properties.Encrypted},
 		StringColumnDefinition{Prop: properties.Owner},
-		StringColumnDefinition{Prop: properties.State},
+		StateColumnDefinition{StringColumnDefinition{Prop: properties.State}},
 		StringColumnDefinition{Prop: properties.Progress},
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
 		StorageColumnDefinition{Unit: gb, StringColumnDefinition: StringColumnDefinition{Prop: properties.Size}},
@@ -209,7 +207,7 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		StringColumnDefinition{Prop: properties.ID},
 		StringColumnDefinition{Prop: properties.Vpc},
 		StringColumnDefinition{Prop: properties.Subnet},
-		StringColumnDefinition{Prop: properties.State},
+		StateColumnDefinition{StringColumnDefinition{Prop: properties.State}},
 		StringColumnDefinition{Prop: properties.Instance},
 		StringColumnDefinition{Prop: properties.PrivateIP},
 		StringColumnDefinition{Prop: properties.PublicIP},
@@ -219,7 +217,7 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 	cloud.LoadBalancer: {
 		StringColumnDefinition{Prop: properties.Name},
 		StringColumnDefinition{Prop: properties.Vpc},
-		StringColumnDefinition{Prop: properties.State},
+		StateColumnDefinition{StringColumnDefinition{Prop: properties.State}},
 		StringColumnDefinition{Prop: properties.PublicDNS},
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created, Friendly: "Created"}},
 		StringColumnDefinition{Prop: properties.Scheme},
@@ -259,9 +257,7 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		StringColumnDefinition{Prop: properties.AvailabilityZone, Friendly: "Zone"},
 		StringColumnDefinition{Prop: properties.Zone, Friendly: "HostedZone"},
 		StringColumnDefinition{Prop: properties.Class},
-		ColoredValueColumnDefinition{
-			StringColumnDefinition: StringColumnDefinition{Prop: properties.State},
-			ColoredValues:          map[string]color.Attribute{"available": color.FgGreen}},
+		StateColumnDefinition{StringColumnDefinition{Prop: properties.State}},
 		StorageColumnDefinition{Unit: gb, StringColumnDefinition: StringColumnDefinition{Prop: properties.Storage}},
 		StringColumnDefinition{Prop: properties.Port},
 		StringColumnDefinition{Prop: properties.Username},
@@ -275,7 +271,7 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 	},
 	cloud.DbSubnetGroup: {
 		StringColumnDefinition{Prop: properties.ID},
-		StringColumnDefinition{Prop: properties.State, Friendly: "Status"},
+		StateColumnDefinition{StringColumnDefinition{Prop: properties.State, Friendly: "Status"}},
 		StringColumnDefinition{Prop: properties.Vpc},
 		StringColumnDefinition{Prop: properties.Subnets},
 		StringColumnDefinition{Prop: properties.Description},
@@ -291,7 +287,7 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		StringColumnDefinition{Prop: properties.Name},
 		StringColumnDefinition{Prop: properties.LaunchConfigurationName, Friendly: "LaunchConfiguration"},
 		StringColumnDefinition{Prop: properties.DesiredCapacity},
-		StringColumnDefinition{Prop: properties.State},
+		StateColumnDefinition{StringColumnDefinition{Prop: properties.State}},
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
 		StringColumnDefinition{Prop: properties.NewInstancesProtected},
 	},
@@ -313,23 +309,34 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 	},
 	cloud.ContainerCluster: {
 		StringColumnDefinition{Prop: properties.Name},
-		StringColumnDefinition{Prop: properties.State},
+		StateColumnDefinition{StringColumnDefinition{Prop: properties.State}},
 		StringColumnDefinition{Prop: properties.ActiveServicesCount, Friendly: "ActiveServices"},
 		StringColumnDefinition{Prop: properties.PendingTasksCount, Friendly: "PendingTasks"},
 		StringColumnDefinition{Prop: properties.RegisteredContainerInstancesCount, Friendly: "RegisteredContainerInstances"},
 		StringColumnDefinition{Prop: properties.RunningTasksCount, Friendly: "RunningTasks"},
 	},
+	cloud.ContainerService: {
+		StringColumnDefinition{Prop: properties.Name},
+		ARNLastValueColumnDefinition{Separator: "/", StringColumnDefinition: StringColumnDefinition{Prop: properties.Cluster}},
+		StateColumnDefinition{StringColumnDefinition{Prop: properties.State}},
+		ARNLastValueColumnDefinition{Separator: "/", StringColumnDefinition: StringColumnDefinition{Prop: properties.ContainerTask}},
+		StringColumnDefinition{Prop: properties.DesiredTasksCount, Friendly: "DesiredTasks"},
+		StringColumnDefinition{Prop: properties.RunningTasksCount, Friendly: "RunningTasks"},
+		StringColumnDefinition{Prop: properties.PendingTasksCount, Friendly: "PendingTasks"},
+		StringColumnDefinition{Prop: properties.Type, Friendly: "LaunchType"},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
+	},
 	cloud.ContainerTask: {
 		StringColumnDefinition{Prop: properties.Name},
 		StringColumnDefinition{Prop: properties.Version},
-		StringColumnDefinition{Prop: properties.State},
+		StateColumnDefinition{StringColumnDefinition{Prop: properties.State}},
 		KeyValuesColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.ContainersImages}},
 		KeyValuesColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Deployments}},
 	},
 	cloud.Container: {
 		StringColumnDefinition{Prop: properties.Name},
 		StringColumnDefinition{Prop: properties.DeploymentName},
-		StringColumnDefinition{Prop: properties.State},
+		StateColumnDefinition{StringColumnDefinition{Prop: properties.State}},
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Launched}},
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Stopped}},
@@ -340,7 +347,7 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		StringColumnDefinition{Prop: properties.ID},
 		StringColumnDefinition{Prop: properties.Instance},
 		ARNLastValueColumnDefinition{Separator: "/", StringColumnDefinition: StringColumnDefinition{Prop: properties.Cluster}},
-		StringColumnDefinition{Prop: properties.State},
+		StateColumnDefinition{StringColumnDefinition{Prop: properties.State}},
 		StringColumnDefinition{Prop: properties.RunningTasksCount, Friendly: "RunningTasks"},
 		StringColumnDefinition{Prop: properties.PendingTasksCount, Friendly: "PendingTasks"},
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
@@ -351,21 +358,36 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		StringColumnDefinition{Prop: properties.Arn},
 		StringColumnDefinition{Prop: properties.Name},
 	},
+	//ElasticSearch
+	cloud.ElasticsearchDomain: {
+		StringColumnDefinition{Prop: properties.Name},
+		StateColumnDefinition{StringColumnDefinition{Prop: properties.State}},
+		StringColumnDefinition{Prop: properties.Type},
+		StringColumnDefinition{Prop: properties.Version},
+		StorageColumnDefinition{Unit: gb, StringColumnDefinition: StringColumnDefinition{Prop: properties.Storage}},
+		StringColumnDefinition{Prop: properties.StorageType},
+		StringColumnDefinition{Prop: properties.Endpoint},
+		StringColumnDefinition{Prop: properties.Arn},
+	},
 	//IAM
 	cloud.User: {
 		StringColumnDefinition{Prop: properties.ID},
 		StringColumnDefinition{Prop: properties.Name},
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.PasswordLastUsed, Friendly: "PasswordLastUsed"}},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.LastAccessed, Friendly: "LastAccessed"}},
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
 	},
 	cloud.Role: {
 		StringColumnDefinition{Prop: properties.ID},
 		StringColumnDefinition{Prop: properties.Name},
+		SliceColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.InstanceProfiles}},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.LastAccessed, Friendly: "LastAccessed"}},
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
 	},
 	cloud.InstanceProfile: {
 		StringColumnDefinition{Prop: properties.ID},
 		StringColumnDefinition{Prop: properties.Name},
+		SliceColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Roles}},
 		StringColumnDefinition{Prop: properties.Path},
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
 	},
@@ -379,6 +401,7 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 			StringColumnDefinition: StringColumnDefinition{Prop: properties.Attached},
 			ColoredValues:          map[string]color.Attribute{"false": color.FgYellow},
 		},
+		StringColumnDefinition{Prop: properties.AttachmentCount, Friendly: "Attachments"},
 	},
 	cloud.Group: {
 		StringColumnDefinition{Prop: properties.ID},
@@ -387,7 +410,7 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 	},
 	cloud.AccessKey: {
 		StringColumnDefinition{Prop: properties.ID},
-		StringColumnDefinition{Prop: properties.State},
+		StateColumnDefinition{StringColumnDefinition{Prop: properties.State}},
 		StringColumnDefinition{Prop: properties.Username},
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
 	},
@@ -418,7 +441,8 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		StringColumnDefinition{Prop: properties.Owner},
 	},
 	cloud.Topic: {
-		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.ID, Friendly: "ARN"},
+		StringColumnDefinition{Prop: properties.Name},
 	},
 	//Queue
 	cloud.Queue: {
@@ -428,6 +452,15 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Modified, Friendly: "LastModif"}},
 		StringColumnDefinition{Prop: properties.Delay, Friendly: "Delay(s)"},
 	},
+	//Email
+	cloud.EmailIdentity: {
+		StringColumnDefinition{Prop: properties.Name},
+		StringColumnDefinition{Prop: properties.Type},
+		ColoredValueColumnDefinition{
+			StringColumnDefinition: StringColumnDefinition{Prop: properties.State},
+			ColoredValues:          map[string]color.Attribute{"success": color.FgGreen, "pending": color.FgYellow, "failed": color.FgRed, "temporaryfailure": color.FgRed},
+		},
+	},
 	// DNS
 	cloud.Zone: {
 		StringColumnDefinition{Prop: properties.ID},
@@ -468,7 +501,7 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		StringColumnDefinition{Prop: properties.Namespace},
 		StringColumnDefinition{Prop: properties.MetricName},
 		StringColumnDefinition{Prop: properties.Description},
-		StringColumnDefinition{Prop: properties.State},
+		StateColumnDefinition{StringColumnDefinition{Prop: properties.State}},
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Updated}},
 		KeyValuesColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Dimensions}},
 	},
@@ -477,7 +510,7 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		StringColumnDefinition{Prop: properties.ID},
 		StringColumnDefinition{Prop: properties.PublicDNS},
 		StringColumnDefinition{Prop: properties.Enabled},
-		StringColumnDefinition{Prop: properties.State},
+		StateColumnDefinition{StringColumnDefinition{Prop: properties.State}},
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Modified}},
 		SliceColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Aliases}},
 		StringColumnDefinition{Prop: properties.SSLSupportMethod},
@@ -487,7 +520,7 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 	cloud.Stack: {
 		StringColumnDefinition{Prop: properties.ID},
 		StringColumnDefinition{Prop: properties.Name},
-		StringColumnDefinition{Prop: properties.State},
+		StateColumnDefinition{StringColumnDefinition{Prop: properties.State}},
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Modified}},
 	},
@@ -25,12 +25,14 @@ import (
 	"reflect"
 	"sort"
 	"strings"
+	"text/template"
 	"time"
 
 	"github.com/fatih/color"
 	"github.com/olekukonko/tablewriter"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/cloud/match"
+	"github.com/wallix/awless/cloud/properties"
 	"github.com/wallix/awless/graph"
 )
 
@@ -39,6 +41,10 @@ var (
 	autowrapMaxSize = 35
 )
 
+// TemplateFormatPrefix prefixes formats given as a Go text/template
+// executed for each resource (ex: template='{{.Id}} {{.Name}}')
+const TemplateFormatPrefix = "template="
+
 type Displayer interface {
 	Print(io.Writer) error
 }
@@ -63,6 +69,9 @@ type Builder struct {
 	dataSource        interface{}
 	root              cloud.Resource
 	noHeaders         bool
+	relations         bool
+	tree              bool
+	relationsSource   cloud.GraphAPI
 }
 
 func (b *Builder) SetSource(i interface{}) *Builder {
@@ -118,6 +127,48 @@ func (b *Builder) Build() (Displayer, error) {
 
 	switch b.dataSource.(type) {
 	case cloud.GraphAPI:
+		if strings.HasPrefix(b.format, TemplateFormatPrefix) {
+			tpl, err := parseFormatTemplate(b.format)
+			if err != nil {
+				return nil, err
+			}
+			filteredGraph := b.dataSource.(cloud.GraphAPI)
+			if b.rdfType != "" {
+				q, err := b.buildQuery()
+				if err != nil {
+					return nil, err
+				}
+				if filteredGraph, err = filteredGraph.FilterGraph(q); err != nil {
+					return nil, err
+				}
+			}
+			dis := &templateDisplayer{fromGraphDisplayer: base, tpl: tpl}
+			dis.setGraph(filteredGraph)
+			return dis, nil
+		}
+		relationsGraph := b.relationsSource
+		if relationsGraph == nil {
+			relationsGraph = b.dataSource.(cloud.GraphAPI)
+		}
+		if b.tree {
+			filteredGraph := b.dataSource.(cloud.GraphAPI)
+			if b.rdfType != "" {
+				q, err := b.buildQuery()
+				if err != nil {
+					return nil, err
+				}
+				if filteredGraph, err = filteredGraph.FilterGraph(q); err != nil {
+					return nil, err
+				}
+			}
+			dis := &treeDisplayer{fromGraphDisplayer: base, relations: relationsGraph}
+			dis.setGraph(filteredGraph)
+			return dis, nil
+		}
+		if b.relations {
+			base.relations = relationsGraph
+			base.columnDefinitions = append(append([]ColumnDefinition{}, b.columnDefinitions...), relationsColumnDefinition{StringColumnDefinition{Prop: RelationsColumn}})
+		}
 		if b.rdfType == "" {
 			gph := b.dataSource.(cloud.GraphAPI)
 			switch b.format {
@@ -178,6 +229,13 @@ func (b *Builder) Build() (Displayer, error) {
 			return dis, nil
 		}
 	case cloud.Resource:
+		if strings.HasPrefix(b.format, TemplateFormatPrefix) {
+			tpl, err := parseFormatTemplate(b.format)
+			if err != nil {
+				return nil, err
+			}
+			return &templateResourceDisplayer{res: b.dataSource.(cloud.Resource), tpl: tpl}, nil
+		}
 		dis := &tableResourceDisplayer{columnDefinitions: b.columnDefinitions, maxwidth: b.maxwidth}
 		dis.SetResource(b.dataSource.(cloud.Resource))
 		return dis, nil
@@ -245,6 +303,10 @@ func WithColumns(properties []string) optsFn {
 				}
 			}
 			if !found {
+				if age, ok := derivedAgeColumn(b.rdfType, p); ok {
+					columns = append(columns, age)
+					continue
+				}
 				columns = append(columns, StringColumnDefinition{Prop: strings.Title(p)})
 			}
 		}
@@ -253,6 +315,22 @@ func WithColumns(properties []string) optsFn {
 	}
 }
 
+// derivedAgeColumn returns an "Age" column computed from the launch or creation time
+// of the given resource type when requested
+func derivedAgeColumn(rdfType, name string) (ColumnDefinition, bool) {
+	if strings.ToLower(name) != "age" {
+		return nil, false
+	}
+	for _, prop := range []string{properties.Launched, properties.Created} {
+		for _, definition := range DefaultsColumnDefinitions[rdfType] {
+			if definition.propKey() == prop {
+				return AgeColumnDefinition{StringColumnDefinition{Prop: prop, Friendly: "Age"}}, true
+			}
+		}
+	}
+	return nil, false
+}
+
 func WithColumnDefinitions(definitions []ColumnDefinition) optsFn {
 	return func(b *Builder) *Builder {
 		b.columnDefinitions = definitions
@@ -343,6 +421,30 @@ func WithRootNode(root cloud.Resource) optsFn {
 	}
 }
 
+// WithRelations adds a column displaying the parent and the applies on relations of each resource
+func WithRelations(show bool) optsFn {
+	return func(b *Builder) *Builder {
+		b.relations = show
+		return b
+	}
+}
+
+// WithTree displays the resources indented under their parents
+func WithTree(tree bool) optsFn {
+	return func(b *Builder) *Builder {
+		b.tree = tree
+		return b
+	}
+}
+
+// WithRelationsSource sets the graph in which relations are resolved (default to the displayed graph)
+func WithRelationsSource(g cloud.GraphAPI) optsFn {
+	return func(b *Builder) *Builder {
+		b.relationsSource = g
+		return b
+	}
+}
+
 func WithNoHeaders(nh bool) optsFn {
 	return func(b *Builder) *Builder {
 		b.noHeaders = nh
@@ -359,12 +461,23 @@ type fromGraphDisplayer struct {
 	columnDefinitions []ColumnDefinition
 	maxwidth          int
 	noHeaders         bool
+	relations         cloud.GraphAPI
 }
 
 func (d *fromGraphDisplayer) setGraph(g cloud.GraphAPI) {
 	d.g = g
 }
 
+func (d *fromGraphDisplayer) value(res cloud.Resource, h ColumnDefinition) interface{} {
+	if _, ok := h.(relationsColumnDefinition); ok && d.relations != nil {
+		return resourceRelations(d.relations, res)
+	}
+	if v, ok := h.(resourceValuer); ok {
+		return v.value(res)
+	}
+	return res.Properties()[h.propKey()]
+}
+
 type csvDisplayer struct {
 	fromGraphDisplayer
 }
@@ -385,7 +498,7 @@ func (d *csvDisplayer) Print(w io.Writer) error {
 			values[i] = make([]interface{}, len(d.columnDefinitions))
 		}
 		for j, h := range d.columnDefinitions {
-			values[i][j] = res.Properties()[h.propKey()]
+			values[i][j] = d.value(res, h)
 		}
 	}
 
@@ -419,6 +532,103 @@ func (d *csvDisplayer) Print(w io.Writer) error {
 	return err
 }
 
+type templateDisplayer struct {
+	fromGraphDisplayer
+	tpl *template.Template
+}
+
+func (d *templateDisplayer) Print(w io.Writer) error {
+	var types []string
+	if d.rdfType == "" {
+		for t := range DefaultsColumnDefinitions {
+			types = append(types, t)
+		}
+		sort.Strings(types)
+	} else {
+		types = append(types, d.rdfType)
+	}
+
+	for _, t := range types {
+		resources, err := d.g.Find(cloud.NewQuery(t))
+		if err != nil {
+			return err
+		}
+
+		values := make(table, len(resources))
+		for i, res := range resources {
+			values[i] = make([]interface{}, len(d.columnDefinitions)+1)
+			for j, h := range d.columnDefinitions {
+				values[i][j] = d.value(res, h)
+			}
+			values[i][len(d.columnDefinitions)] = res
+		}
+		if len(d.columnDefinitions) > 0 {
+			d.sorter.sort(values)
+		} else {
+			sort.Slice(values, func(i, j int) bool {
+				return values[i][0].(cloud.Resource).Id() < values[j][0].(cloud.Resource).Id()
+			})
+		}
+
+		for _, row := range values {
+			res := row[len(row)-1].(cloud.Resource)
+			if err := d.tpl.Execute(w, templateData(res)); err != nil {
+				return fmt.Errorf("format template: %s", err)
+			}
+			fmt.Fprintln(w)
+		}
+	}
+
+	return nil
+}
+
+type templateResourceDisplayer struct {
+	res cloud.Resource
+	tpl *template.Template
+}
+
+func (d *templateResourceDisplayer) Print(w io.Writer) error {
+	if err := d.tpl.Execute(w, templateData(d.res)); err != nil {
+		return fmt.Errorf("format template: %s", err)
+	}
+	_, err := fmt.Fprintln(w)
+	return err
+}
+
+func parseFormatTemplate(format string) (*template.Template, error) {
+	tpl, err := template.New("format").Parse(strings.TrimPrefix(format, TemplateFormatPrefix))
+	if err != nil {
+		return nil, fmt.Errorf("invalid format template: %s", err)
+	}
+	return tpl, nil
+}
+
+// templateData returns the resource properties with the additional "Id" and "Type" keys.
+// Tags are given as a map to allow the template to lookup a tag value: {{index .Tags "Env"}}
+func templateData(res cloud.Resource) map[string]interface{} {
+	data := make(map[string]interface{})
+	for k, v := range res.Properties() {
+		data[k] = v
+	}
+	data["Id"] = res.Id()
+	data["Type"] = res.Type()
+
+	tags := make(map[string]string)
+	if tt, ok := data[properties.Tags].([]string); ok {
+		for _, t := range tt {
+			splits := strings.SplitN(t, "=", 2)
+			if len(splits) == 2 {
+				tags[splits[0]] = splits[1]
+			} else {
+				tags[splits[0]] = ""
+			}
+		}
+	}
+	data[properties.Tags] = tags
+
+	return data
+}
+
 type tsvDisplayer struct {
 	fromGraphDisplayer
 }
@@ -441,7 +651,7 @@ func (d *tsvDisplayer) Print(w io.Writer) error {
 			values[i] = make([]interface{}, len(d.columnDefinitions))
 		}
 		for j, h := range d.columnDefinitions {
-			values[i][j] = res.Properties()[h.propKey()]
+			values[i][j] = d.value(res, h)
 		}
 	}
 
@@ -514,7 +724,7 @@ func (d *tableDisplayer) Print(w io.Writer) error {
 			values[i] = make([]interface{}, len(d.columnDefinitions))
 		}
 		for j, h := range d.columnDefinitions {
-			values[i][j] = res.Properties()[h.propKey()]
+			values[i][j] = d.value(res, h)
 		}
 	}
 
@@ -558,7 +768,7 @@ func (d *tableDisplayer) Print(w io.Writer) error {
 			}
 			displayHeaders = append(displayHeaders, h.title(symbol))
 		}
-		table.SetHeader(displayHeaders)
+		setHeaderWithColors(table, displayHeaders)
 	}
 
 	var enableWraping bool
@@ -678,6 +888,11 @@ func (d *multiResourcesTableDisplayer) Print(w io.Writer) error {
 				row[3] = header.format(val)
 				values = append(values, row[:])
 			}
+			if d.relations != nil {
+				if rels := resourceRelations(d.relations, res); rels != "" {
+					values = append(values, []interface{}{t, nameOrID(res), RelationsColumn, rels})
+				}
+			}
 		}
 	}
 
@@ -690,7 +905,7 @@ func (d *multiResourcesTableDisplayer) Print(w io.Writer) error {
 	table.SetColWidth(tableColWidth)
 	table.SetBorders(tablewriter.Border{Left: true, Top: false, Right: true, Bottom: false})
 	table.SetCenterSeparator("|")
-	table.SetHeader([]string{"Type" + ds.symbol(), "Name/Id", "Property", "Value"})
+	setHeaderWithColors(table, []string{"Type" + ds.symbol(), "Name/Id", "Property", "Value"})
 
 	wraper := autoWraper{maxWidth: autowrapMaxSize, wrappingChar: " "}
 
@@ -817,7 +1032,7 @@ func (d *diffTableDisplayer) Print(w io.Writer) error {
 	table.SetAlignment(tablewriter.ALIGN_LEFT)
 	table.SetBorders(tablewriter.Border{Left: true, Top: false, Right: true, Bottom: false})
 	table.SetCenterSeparator("|")
-	table.SetHeader([]string{"Type" + ds.symbol(), "Name/Id", "Property", "Value"})
+	setHeaderWithColors(table, []string{"Type" + ds.symbol(), "Name/Id", "Property", "Value"})
 
 	for i := range values {
 		row := make([]string, len(values[i]))
@@ -416,8 +416,8 @@ func TestDateLists(t *testing.T) {
 	expected := `| ID ▲  |     NAME      | PASSWORDLASTUSED |
 |-------|---------------|------------------|
 | user1 | my_username_1 |                  |
-| user2 | my_username_2 | 9 months         |
-| user3 | my_username_3 | 9 months         |
+| user2 | my_username_2 | 9mo ago          |
+| user3 | my_username_3 | 9mo ago          |
 `
 	var w bytes.Buffer
 	if err := displayer.Print(&w); err != nil {
@@ -436,8 +436,8 @@ func TestDateLists(t *testing.T) {
 	expected = `|  ID   |     NAME      | PASSWORDLASTUSED ▲ |
 |-------|---------------|--------------------|
 | user1 | my_username_1 |                    |
-| user2 | my_username_2 | 9 months           |
-| user3 | my_username_3 | 9 months           |
+| user2 | my_username_2 | 9mo ago            |
+| user3 | my_username_3 | 9mo ago            |
 `
 	w.Reset()
 	if err := displayer.Print(&w); err != nil {
@@ -448,6 +448,28 @@ func TestDateLists(t *testing.T) {
 	}
 }
 
+func TestDerivedAgeColumn(t *testing.T) {
+	globalNow = time.Unix(1505832866, 0)
+	defer func() {
+		globalNow = time.Now().UTC()
+	}()
+	g := graph.NewGraph()
+	g.AddResource(resourcetest.Instance("inst_1").Prop(p.Launched, globalNow.Add(-50*time.Hour)).Build())
+
+	displayer, _ := BuildOptions(
+		WithRdfType("instance"),
+		WithColumns([]string{"ID", "Age", "Launched"}),
+		WithFormat("csv"),
+	).SetSource(g).Build()
+	var w bytes.Buffer
+	if err := displayer.Print(&w); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := w.String(), "ID,Age,Uptime\ninst_1,2d2h,2d2h\n"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
 func TestMaxWidth(t *testing.T) {
 	g := createInfraGraph()
 	columns := []string{"ID", "Name", "State", "Type", "PublicIP"}
@@ -708,6 +730,140 @@ func TestCSVDisplayWithCommaAndQuotes(t *testing.T) {
 	}
 }
 
+func TestTemplateDisplay(t *testing.T) {
+	g := graph.NewGraph()
+	g.AddResource(
+		resourcetest.Instance("inst_2").Prop(p.Name, "django").Prop(p.State, "stopped").Build(),
+		resourcetest.Instance("inst_1").Prop(p.Name, "redis").Prop(p.State, "running").Prop(p.Tags, []string{"env=prod", "team=web"}).Build(),
+		resourcetest.Subnet("sub_1").Prop(p.Name, "my_subnet").Build(),
+	)
+
+	displayer, err := BuildOptions(
+		WithRdfType("instance"),
+		WithFormat(`template={{.Id}} {{.Name}} {{index .Tags "env"}}`),
+	).SetSource(g).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var w bytes.Buffer
+	if err := displayer.Print(&w); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := w.String(), "inst_1 redis prod\ninst_2 django \n"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+
+	displayer, _ = BuildOptions(
+		WithRdfType("instance"),
+		WithFormat("template={{.Name}}"),
+		WithFilters([]string{"state=stopped"}),
+	).SetSource(g).Build()
+	w.Reset()
+	if err := displayer.Print(&w); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := w.String(), "django\n"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+
+	displayer, _ = BuildOptions(
+		WithFormat("template={{.Type}}:{{.Id}}"),
+	).SetSource(g).Build()
+	w.Reset()
+	if err := displayer.Print(&w); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := w.String(), "instance:inst_1\ninstance:inst_2\nsubnet:sub_1\n"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+
+	res, _ := g.GetResource("instance", "inst_1")
+	displayer, _ = BuildOptions(
+		WithFormat("template={{.Name}} {{.State}}"),
+	).SetSource(res).Build()
+	w.Reset()
+	if err := displayer.Print(&w); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := w.String(), "redis running\n"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+
+	if _, err := BuildOptions(WithFormat("template={{.Id")).SetSource(g).Build(); err == nil {
+		t.Fatal("expected error got none")
+	}
+}
+
+func TestRelationsDisplay(t *testing.T) {
+	g := createInfraGraph()
+	resourcetest.AddParents(g,
+		"eu-west-1 -> vpc_1", "eu-west-1 -> vpc_2",
+		"vpc_1 -> sub_1", "vpc_2 -> sub_2",
+		"sub_1 -> inst_1", "sub_2 -> inst_2", "sub_2 -> inst_3",
+	)
+	sg := resourcetest.SecurityGroup("sg_1").Prop(p.Name, "http").Build()
+	g.AddResource(sg)
+	inst1, _ := g.GetResource("instance", "inst_1")
+	inst3, _ := g.GetResource("instance", "inst_3")
+	g.AddAppliesOnRelation(sg, inst1)
+	g.AddAppliesOnRelation(sg, inst3)
+
+	displayer, err := BuildOptions(
+		WithRdfType("instance"),
+		WithColumns([]string{"id", "name"}),
+		WithFormat("csv"),
+		WithRelations(true),
+	).SetSource(g).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var w bytes.Buffer
+	if err := displayer.Print(&w); err != nil {
+		t.Fatal(err)
+	}
+	expected := "ID,Name,Relations\n" +
+		"inst_1,redis,parent: subnet[my_subnet]; applied by: securitygroup[http]\n" +
+		"inst_2,django,parent: subnet[sub_2]\n" +
+		"inst_3,apache,parent: subnet[sub_2]; applied by: securitygroup[http]\n"
+	if got, want := w.String(), expected; got != want {
+		t.Fatalf("got \n%s\n\nwant\n\n%s\n", got, want)
+	}
+
+	displayer, _ = BuildOptions(
+		WithRdfType("securitygroup"),
+		WithColumns([]string{"id"}),
+		WithFormat("csv"),
+		WithRelations(true),
+	).SetSource(g).Build()
+	w.Reset()
+	if err := displayer.Print(&w); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := w.String(), "ID,Relations\nsg_1,\"applies on: instance[apache], instance[redis]\"\n"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+
+	displayer, _ = BuildOptions(
+		WithRdfType("instance"),
+		WithFilters([]string{"state=running"}),
+		WithTree(true),
+	).SetSource(g).Build()
+	w.Reset()
+	if err := displayer.Print(&w); err != nil {
+		t.Fatal(err)
+	}
+	expected = "region, eu-west-1\n" +
+		"\tvpc, vpc_1\n" +
+		"\t\tsubnet, sub_1 (my_subnet)\n" +
+		"\t\t\tinstance, inst_1 (redis)\n" +
+		"\tvpc, vpc_2 (my_vpc_2)\n" +
+		"\t\tsubnet, sub_2\n" +
+		"\t\t\tinstance, inst_3 (apache)\n"
+	if got, want := w.String(), expected; got != want {
+		t.Fatalf("got \n%s\n\nwant\n\n%s\n", got, want)
+	}
+}
+
 func createInfraGraph() *graph.Graph {
 	g := graph.NewGraph()
 	g.AddResource(resourcetest.Region("eu-west-1").Build(),
@@ -806,6 +962,7 @@ func TestEmotyDisplays(t *testing.T) {
 
 	g = createInfraGraph()
 	columns = []string{}
+	defer func(defs map[string][]ColumnDefinition) { DefaultsColumnDefinitions = defs }(DefaultsColumnDefinitions)
 	DefaultsColumnDefinitions = make(map[string][]ColumnDefinition)
 
 	displayer, _ = BuildOptions(
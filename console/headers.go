@@ -90,6 +90,15 @@ func (h ColoredValueColumnDefinition) format(i interface{}) string {
 	return str
 }
 
+// StateColumnDefinition colors states values according to the current theme
+type StateColumnDefinition struct {
+	StringColumnDefinition
+}
+
+func (h StateColumnDefinition) format(i interface{}) string {
+	return CurrentTheme.colorState(h.StringColumnDefinition.format(i))
+}
+
 type ARNLastValueColumnDefinition struct {
 	StringColumnDefinition
 	Separator string
@@ -174,9 +183,12 @@ func (h TimeColumnDefinition) format(i interface{}) string {
 	if !ok {
 		return "invalid time"
 	}
+	if h.Format == Humanize && AbsoluteTime {
+		return ii.Format("Mon, Jan 2, 2006 15:04")
+	}
 	switch h.Format {
 	case Humanize:
-		return HumanizeTime(ii)
+		return HumanizeTimeAgo(ii)
 	case Short:
 		return ii.Format("1/2/06 15:04")
 	default:
@@ -184,6 +196,22 @@ func (h TimeColumnDefinition) format(i interface{}) string {
 	}
 }
 
+// AgeColumnDefinition displays the duration elapsed since a time property (ex: 3d4h)
+type AgeColumnDefinition struct {
+	StringColumnDefinition
+}
+
+func (h AgeColumnDefinition) format(i interface{}) string {
+	if i == nil {
+		return ""
+	}
+	ii, ok := i.(time.Time)
+	if !ok {
+		return "invalid time"
+	}
+	return HumanizeDuration(globalNow.Sub(ii))
+}
+
 type StorageColumnDefinition struct {
 	StringColumnDefinition
 	Unit storageUnit
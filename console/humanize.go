@@ -45,6 +45,73 @@ func HumanizeTime(t time.Time) string {
 	}
 }
 
+// AbsoluteTime makes time columns display dates instead of relative times
+var AbsoluteTime bool
+
+// HumanizeTimeAgo returns a compact relative time (ex: 3d ago, in 2h)
+func HumanizeTimeAgo(t time.Time) string {
+	d := globalNow.Sub(t)
+	if d < 0 {
+		if d > -time.Second {
+			return "now"
+		}
+		return fmt.Sprintf("in %s", humanizeDurationUnit(-d))
+	}
+	if d < time.Second {
+		return "now"
+	}
+	return fmt.Sprintf("%s ago", humanizeDurationUnit(d))
+}
+
+// HumanizeDuration returns a compact duration with its 2 most significant units (ex: 3d4h, 5m12s)
+func HumanizeDuration(d time.Duration) string {
+	if d < 0 {
+		d = -d
+	}
+	if d < time.Second {
+		return "0s"
+	}
+	units := []struct {
+		name string
+		dur  time.Duration
+	}{
+		{"d", 24 * time.Hour}, {"h", time.Hour}, {"m", time.Minute}, {"s", time.Second},
+	}
+	var out string
+	var count int
+	for _, u := range units {
+		if n := d / u.dur; n > 0 {
+			out += fmt.Sprintf("%d%s", n, u.name)
+			d -= n * u.dur
+			count++
+		} else if count > 0 {
+			break
+		}
+		if count == 2 {
+			break
+		}
+	}
+	return out
+}
+
+func humanizeDurationUnit(d time.Duration) string {
+	day := 24 * time.Hour
+	switch {
+	case d < 2*time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < 2*time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 2*day:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	case d < 60*day:
+		return fmt.Sprintf("%dd", int(d/day))
+	case d < 2*365*day:
+		return fmt.Sprintf("%dmo", int(d/(30*day)))
+	default:
+		return fmt.Sprintf("%dy", int(d/(365*day)))
+	}
+}
+
 type storageUnit uint
 
 const (
@@ -72,11 +139,13 @@ func HumanizeStorage(nb uint64, unit storageUnit) string {
 	case nbBytes < 1024:
 		return fmt.Sprintf("%dB", nbBytes)
 	case nbBytes < 1024*1024:
-		return fmt.Sprintf("%sK", divideValue(nbBytes, 1024))
+		return fmt.Sprintf("%sKiB", divideValue(nbBytes, 1024))
 	case nbBytes < 1024*1024*1024:
-		return fmt.Sprintf("%sM", divideValue(nbBytes, 1024*1024))
+		return fmt.Sprintf("%sMiB", divideValue(nbBytes, 1024*1024))
+	case nbBytes < 1024*1024*1024*1024:
+		return fmt.Sprintf("%sGiB", divideValue(nbBytes, 1024*1024*1024))
 	default:
-		return fmt.Sprintf("%sG", divideValue(nbBytes, 1024*1024*1024))
+		return fmt.Sprintf("%sTiB", divideValue(nbBytes, 1024*1024*1024*1024))
 	}
 }
 
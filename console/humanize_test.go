@@ -54,14 +54,15 @@ func TestHumanizeStorage(t *testing.T) {
 	}{
 		{from: 3, unit: b, expect: "3B"},
 		{from: 300, unit: b, expect: "300B"},
-		{from: 3072, unit: b, expect: "3K"},
-		{from: 31457280, unit: b, expect: "30M"},
-		{from: 31457285, unit: b, expect: "~30M"},
-		{from: 2, unit: kb, expect: "2K"},
-		{from: 20, unit: kb, expect: "20K"},
-		{from: 2048, unit: kb, expect: "2M"},
-		{from: 2070, unit: kb, expect: "~2M"},
-		{from: 4096, unit: mb, expect: "4G"},
+		{from: 3072, unit: b, expect: "3KiB"},
+		{from: 31457280, unit: b, expect: "30MiB"},
+		{from: 31457285, unit: b, expect: "~30MiB"},
+		{from: 2, unit: kb, expect: "2KiB"},
+		{from: 20, unit: kb, expect: "20KiB"},
+		{from: 2048, unit: kb, expect: "2MiB"},
+		{from: 2070, unit: kb, expect: "~2MiB"},
+		{from: 4096, unit: mb, expect: "4GiB"},
+		{from: 3072, unit: gb, expect: "3TiB"},
 	}
 
 	for _, tcase := range tcases {
@@ -70,3 +71,44 @@ func TestHumanizeStorage(t *testing.T) {
 		}
 	}
 }
+
+func TestHumanizeTimeAgo(t *testing.T) {
+	tcases := []struct {
+		stamp  time.Time
+		expect string
+	}{
+		{stamp: globalNow, expect: "now"},
+		{stamp: globalNow.Add(-5 * time.Second), expect: "5s ago"},
+		{stamp: globalNow.Add(-3 * time.Minute), expect: "3m ago"},
+		{stamp: globalNow.Add(-3 * time.Hour), expect: "3h ago"},
+		{stamp: globalNow.Add(-3 * 24 * time.Hour), expect: "3d ago"},
+		{stamp: globalNow.Add(-90 * 24 * time.Hour), expect: "3mo ago"},
+		{stamp: globalNow.Add(-3 * 365 * 24 * time.Hour), expect: "3y ago"},
+		{stamp: globalNow.Add(2 * time.Hour), expect: "in 2h"},
+	}
+
+	for _, tcase := range tcases {
+		if got, want := HumanizeTimeAgo(tcase.stamp), tcase.expect; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	}
+}
+
+func TestHumanizeDuration(t *testing.T) {
+	tcases := []struct {
+		d      time.Duration
+		expect string
+	}{
+		{d: 0, expect: "0s"},
+		{d: 45 * time.Second, expect: "45s"},
+		{d: 5*time.Minute + 12*time.Second, expect: "5m12s"},
+		{d: 3*time.Hour + 5*time.Second, expect: "3h"},
+		{d: 3*24*time.Hour + 4*time.Hour + 10*time.Minute, expect: "3d4h"},
+	}
+
+	for _, tcase := range tcases {
+		if got, want := HumanizeDuration(tcase.d), tcase.expect; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	}
+}
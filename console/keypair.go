@@ -26,11 +26,15 @@ import (
 	"os"
 	"syscall"
 
+	"github.com/wallix/awless/prompt"
 	"golang.org/x/crypto/ssh"
 	"golang.org/x/crypto/ssh/terminal"
 )
 
 var askPasswordFunc func() ([]byte, error) = func() ([]byte, error) {
+	if err := prompt.Check(prompt.KeyPassphrase, "encrypting the SSH key requires a password: set encrypted=false"); err != nil {
+		return nil, err
+	}
 	fmt.Fprint(os.Stderr, "This SSH key will be encrypted. Please enter new password:")
 	for {
 		pass, err := terminal.ReadPassword(int(syscall.Stdin))
@@ -0,0 +1,167 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package console
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/wallix/awless/cloud"
+	"gopkg.in/yaml.v2"
+)
+
+// DisplayProfile overrides the built-in display of a resource type.
+// Ex, in YAML:
+//
+//	instance:
+//	  columns: [id, name, env, age]
+//	  headers:
+//	    PublicIP: IP
+//	  computed:
+//	    Env: '{{index .Tags "Env"}}'
+type DisplayProfile struct {
+	// Columns lists the columns displayed by default, in order
+	Columns []string `yaml:"columns"`
+	// Headers renames column headers, keyed by property name or current header
+	Headers map[string]string `yaml:"headers"`
+	// Computed adds columns rendered from a Go text/template over the resource properties
+	Computed map[string]string `yaml:"computed"`
+}
+
+// LoadDisplayProfiles reads the YAML display profiles, keyed by resource type, at the given path
+// and applies them on the built-in display definitions. A missing file is not an error.
+func LoadDisplayProfiles(path string) error {
+	content, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	profiles := make(map[string]DisplayProfile)
+	if err = yaml.Unmarshal(content, &profiles); err != nil {
+		return fmt.Errorf("%s: %s", path, err)
+	}
+	if err = ApplyDisplayProfiles(profiles); err != nil {
+		return fmt.Errorf("%s: %s", path, err)
+	}
+	return nil
+}
+
+// ApplyDisplayProfiles overrides the built-in display definitions with the given profiles
+func ApplyDisplayProfiles(profiles map[string]DisplayProfile) error {
+	var types []string
+	for t := range profiles {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+
+	for _, t := range types {
+		rdfType, ok := resolveDisplayedType(t)
+		if !ok {
+			return fmt.Errorf("unknown resource type '%s'", t)
+		}
+		profile := profiles[t]
+
+		definitions := append([]ColumnDefinition{}, DefaultsColumnDefinitions[rdfType]...)
+
+		var computedNames []string
+		for name := range profile.Computed {
+			computedNames = append(computedNames, name)
+		}
+		sort.Strings(computedNames)
+		for _, name := range computedNames {
+			tpl, err := template.New(name).Parse(profile.Computed[name])
+			if err != nil {
+				return fmt.Errorf("%s: computed column '%s': %s", t, name, err)
+			}
+			definitions = append(definitions, computedColumnDefinition{StringColumnDefinition{Prop: name}, tpl})
+		}
+
+		for key, header := range profile.Headers {
+			var found bool
+			for i, def := range definitions {
+				if strings.EqualFold(key, def.propKey()) || strings.EqualFold(key, def.title()) {
+					definitions[i] = renamedColumnDefinition{def, header}
+					found = true
+				}
+			}
+			if !found {
+				return fmt.Errorf("%s: cannot rename header of unknown column '%s'", t, key)
+			}
+		}
+
+		DefaultsColumnDefinitions[rdfType] = definitions
+		if len(profile.Columns) > 0 {
+			ColumnsInListing[rdfType] = profile.Columns
+		}
+	}
+	return nil
+}
+
+func resolveDisplayedType(name string) (string, bool) {
+	name = strings.ToLower(name)
+	if _, ok := DefaultsColumnDefinitions[name]; ok {
+		return name, true
+	}
+	if _, ok := DefaultsColumnDefinitions[cloud.SingularizeResource(name)]; ok {
+		return cloud.SingularizeResource(name), true
+	}
+	return "", false
+}
+
+// resourceValuer is implemented by columns whose value is computed from the whole resource
+type resourceValuer interface {
+	value(cloud.Resource) interface{}
+}
+
+type computedColumnDefinition struct {
+	StringColumnDefinition
+	tpl *template.Template
+}
+
+func (h computedColumnDefinition) value(res cloud.Resource) interface{} {
+	var buf bytes.Buffer
+	if err := h.tpl.Execute(&buf, templateData(res)); err != nil {
+		return fmt.Sprintf("error: %s", err)
+	}
+	return buf.String()
+}
+
+type renamedColumnDefinition struct {
+	ColumnDefinition
+	header string
+}
+
+func (h renamedColumnDefinition) title(suffix ...string) string {
+	if len(suffix) > 0 {
+		return h.header + suffix[0]
+	}
+	return h.header
+}
+
+func (h renamedColumnDefinition) value(res cloud.Resource) interface{} {
+	if v, ok := h.ColumnDefinition.(resourceValuer); ok {
+		return v.value(res)
+	}
+	return res.Properties()[h.propKey()]
+}
@@ -0,0 +1,80 @@
+package console
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	p "github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+)
+
+func TestLoadDisplayProfiles(t *testing.T) {
+	defer func(defs []ColumnDefinition, columns []string) {
+		DefaultsColumnDefinitions["instance"] = defs
+		ColumnsInListing["instance"] = columns
+	}(DefaultsColumnDefinitions["instance"], ColumnsInListing["instance"])
+
+	dir, err := ioutil.TempDir("", "awless-display")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err = LoadDisplayProfiles(filepath.Join(dir, "display.yml")); err != nil {
+		t.Fatalf("missing file: %s", err)
+	}
+
+	path := filepath.Join(dir, "display.yml")
+	content := `instances:
+  columns: [id, env, ip]
+  headers:
+    PublicIP: IP
+  computed:
+    Env: '{{index .Tags "env"}}'
+`
+	if err = ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err = LoadDisplayProfiles(path); err != nil {
+		t.Fatal(err)
+	}
+
+	g := graph.NewGraph()
+	g.AddResource(
+		resourcetest.Instance("inst_1").Prop(p.PublicIP, "1.2.3.4").Prop(p.Tags, []string{"env=prod"}).Build(),
+		resourcetest.Instance("inst_2").Prop(p.PublicIP, "5.6.7.8").Build(),
+	)
+	displayer, err := BuildOptions(
+		WithRdfType("instance"),
+		WithColumns(nil),
+		WithFormat("csv"),
+	).SetSource(g).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var w bytes.Buffer
+	if err := displayer.Print(&w); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := w.String(), "ID,Env,IP\ninst_1,prod,1.2.3.4\ninst_2,,5.6.7.8\n"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+
+	for _, invalid := range []string{
+		"unknowns:\n  columns: [id]\n",
+		"instance:\n  headers:\n    Unknown: X\n",
+		"instance:\n  computed:\n    Env: '{{.Tags'\n",
+		"instance: [id]\n",
+	} {
+		if err = ioutil.WriteFile(path, []byte(invalid), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if err = LoadDisplayProfiles(path); err == nil {
+			t.Fatalf("expected error for %q, got none", invalid)
+		}
+	}
+}
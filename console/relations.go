@@ -0,0 +1,144 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package console
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/rdf"
+)
+
+// RelationsColumn is the title of the column displaying the relations
+// (parent, applies on, applied by) of each resource
+const RelationsColumn = "Relations"
+
+type relationsColumnDefinition struct {
+	StringColumnDefinition
+}
+
+var relationsLabels = []struct {
+	relation, label string
+}{
+	{rdf.ParentOf, "parent"},
+	{rdf.ApplyOn, "applies on"},
+	{rdf.DependingOnRel, "applied by"},
+}
+
+// resourceRelations returns a one line description of the direct relations of a resource
+// in the given graph. Ex: parent: subnet[sub-1]; applied by: securitygroup[http]
+func resourceRelations(g cloud.GraphAPI, res cloud.Resource) string {
+	var descs []string
+	for _, rel := range relationsLabels {
+		related, err := g.ResourceRelations(res, rel.relation, false)
+		if err != nil || len(related) == 0 {
+			continue
+		}
+		var names []string
+		for _, r := range related {
+			names = append(names, fmt.Sprintf("%s[%s]", r.Type(), nameOrID(r)))
+		}
+		sort.Strings(names)
+		descs = append(descs, fmt.Sprintf("%s: %s", rel.label, strings.Join(names, ", ")))
+	}
+	return strings.Join(descs, "; ")
+}
+
+type treeNode struct {
+	res      cloud.Resource
+	children map[string]*treeNode
+}
+
+func (n *treeNode) child(res cloud.Resource) *treeNode {
+	key := res.Type() + "/" + res.Id()
+	c, ok := n.children[key]
+	if !ok {
+		c = &treeNode{res: res, children: make(map[string]*treeNode)}
+		n.children[key] = c
+	}
+	return c
+}
+
+func (n *treeNode) print(w io.Writer, depth int) {
+	var keys []string
+	for k := range n.children {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		c := n.children[k]
+		label := c.res.Id()
+		if name := nameOrID(c.res); name != label {
+			label = fmt.Sprintf("%s (%s)", label, name)
+		}
+		fmt.Fprintf(w, "%s%s, %s\n", strings.Repeat("\t", depth), c.res.Type(), label)
+		c.print(w, depth+1)
+	}
+}
+
+// treeDisplayer displays the resources indented under their parents
+// (ex: instances under their subnet, under their vpc, under their region)
+type treeDisplayer struct {
+	fromGraphDisplayer
+	relations cloud.GraphAPI
+}
+
+func (d *treeDisplayer) Print(w io.Writer) error {
+	var types []string
+	if d.rdfType == "" {
+		for t := range DefaultsColumnDefinitions {
+			types = append(types, t)
+		}
+	} else {
+		types = append(types, d.rdfType)
+	}
+
+	root := &treeNode{children: make(map[string]*treeNode)}
+	var count int
+	for _, t := range types {
+		resources, err := d.g.Find(cloud.NewQuery(t))
+		if err != nil {
+			return err
+		}
+		for _, res := range resources {
+			count++
+			ancestors := []cloud.Resource{res}
+			for current := res; ; {
+				parents, err := d.relations.ResourceRelations(current, rdf.ParentOf, false)
+				if err != nil || len(parents) == 0 {
+					break
+				}
+				current = parents[0]
+				ancestors = append(ancestors, current)
+			}
+			node := root
+			for i := len(ancestors) - 1; i >= 0; i-- {
+				node = node.child(ancestors[i])
+			}
+		}
+	}
+
+	if count == 0 {
+		w.Write([]byte("No results found.\n"))
+		return nil
+	}
+	root.print(w, 0)
+	return nil
+}
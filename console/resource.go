@@ -70,7 +70,7 @@ func (d *tableResourceDisplayer) Print(w io.Writer) error {
 	table.SetColWidth(valueColumnMaxwidth)
 	table.SetCenterSeparator("|")
 	table.SetAlignment(tablewriter.ALIGN_LEFT)
-	table.SetHeader([]string{"Property" + ds.symbol(), "Value"})
+	setHeaderWithColors(table, []string{"Property" + ds.symbol(), "Value"})
 
 	wraper := autoWraper{maxWidth: valueColumnMaxwidth, wrappingChar: " "}
 
@@ -0,0 +1,66 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package console
+
+import (
+	"fmt"
+	"regexp"
+)
+
+const secretMask = "********"
+
+// DefaultSecretsPatterns match the values of common secret assignments (ex: PASSWORD=xxx, api_key: xxx)
+var DefaultSecretsPatterns = []string{
+	`(?i)(?:password|passwd|secret|token|api[_-]?key|access[_-]?key)[a-z_]*["']?\s*[=:]\s*["']?([^\s"']+)`,
+}
+
+// ParseSecretsPatterns compiles the given regexps masking secrets
+func ParseSecretsPatterns(patterns []string) ([]*regexp.Regexp, error) {
+	var all []*regexp.Regexp
+	for _, p := range patterns {
+		r, err := regexp.Compile(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid secret pattern '%s': %s", p, err)
+		}
+		all = append(all, r)
+	}
+	return all, nil
+}
+
+// MaskSecrets masks the text matching any of the patterns.
+// When a pattern has groups, only the text of its outermost groups is masked (ex: the value, not the key).
+func MaskSecrets(text string, patterns []*regexp.Regexp) string {
+	for _, r := range patterns {
+		if r.NumSubexp() == 0 {
+			text = r.ReplaceAllLiteralString(text, secretMask)
+			continue
+		}
+		var masked string
+		var last int
+		for _, indexes := range r.FindAllStringSubmatchIndex(text, -1) {
+			for i := 2; i+1 < len(indexes); i += 2 {
+				if indexes[i] < 0 || indexes[i] < last {
+					continue
+				}
+				masked += text[last:indexes[i]] + secretMask
+				last = indexes[i+1]
+			}
+		}
+		text = masked + text[last:]
+	}
+	return text
+}
@@ -23,6 +23,7 @@ import (
 	"os/signal"
 
 	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
 	"golang.org/x/crypto/ssh/terminal"
 )
 
@@ -43,6 +44,16 @@ func GetTerminalHeight() int {
 }
 
 func InteractiveTerminal(client *ssh.Client) error {
+	return interactiveTerminal(client, false)
+}
+
+// InteractiveTerminalWithAgentForwarding is like InteractiveTerminal but requests
+// the forwarding of the agent (already forwarded on the client) to the remote shell
+func InteractiveTerminalWithAgentForwarding(client *ssh.Client) error {
+	return interactiveTerminal(client, true)
+}
+
+func interactiveTerminal(client *ssh.Client, forwardAgent bool) error {
 	defer client.Close()
 
 	session, err := client.NewSession()
@@ -51,6 +62,12 @@ func InteractiveTerminal(client *ssh.Client) error {
 	}
 	defer session.Close()
 
+	if forwardAgent {
+		if err := agent.RequestAgentForwarding(session); err != nil {
+			return err
+		}
+	}
+
 	stdin, err := session.StdinPipe()
 	if err != nil {
 		return err
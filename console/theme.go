@@ -0,0 +1,152 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package console
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/olekukonko/tablewriter"
+)
+
+// Theme holds the colors used in tables: headers style and resources states coloring.
+// Colors are only output when color.NoColor is false.
+type Theme struct {
+	Header []color.Attribute
+	States map[string]color.Attribute
+}
+
+var DefaultTheme = Theme{
+	Header: []color.Attribute{color.Bold, color.FgCyan},
+	States: map[string]color.Attribute{
+		"running":       color.FgGreen,
+		"available":     color.FgGreen,
+		"active":        color.FgGreen,
+		"in-use":        color.FgGreen,
+		"pending":       color.FgYellow,
+		"stopping":      color.FgYellow,
+		"stopped":       color.FgYellow,
+		"shutting-down": color.FgRed,
+		"terminated":    color.FgRed,
+		"failed":        color.FgRed,
+		"deleted":       color.FgRed,
+	},
+}
+
+var CurrentTheme = DefaultTheme
+
+// WithStateColors returns a copy of the theme with the given states colors added or overridden
+func (t Theme) WithStateColors(states map[string]color.Attribute) Theme {
+	merged := make(map[string]color.Attribute)
+	for state, col := range t.States {
+		merged[state] = col
+	}
+	for state, col := range states {
+		merged[strings.ToLower(state)] = col
+	}
+	t.States = merged
+	return t
+}
+
+func (t Theme) colorState(state string) string {
+	if col, ok := t.States[strings.ToLower(state)]; ok {
+		return color.New(col).SprintFunc()(state)
+	}
+	return state
+}
+
+func (t Theme) headerColors(count int) (colors []tablewriter.Colors) {
+	if color.NoColor || len(t.Header) == 0 {
+		return
+	}
+	var header tablewriter.Colors
+	for _, attr := range t.Header {
+		header = append(header, int(attr))
+	}
+	for i := 0; i < count; i++ {
+		colors = append(colors, header)
+	}
+	return
+}
+
+func setHeaderWithColors(table *tablewriter.Table, headers []string) {
+	table.SetHeader(headers)
+	if colors := CurrentTheme.headerColors(len(headers)); len(colors) > 0 {
+		table.SetHeaderColor(colors...)
+	}
+}
+
+var colorsByName = map[string]color.Attribute{
+	"bold":      color.Bold,
+	"underline": color.Underline,
+	"black":     color.FgBlack,
+	"red":       color.FgRed,
+	"green":     color.FgGreen,
+	"yellow":    color.FgYellow,
+	"blue":      color.FgBlue,
+	"magenta":   color.FgMagenta,
+	"cyan":      color.FgCyan,
+	"white":     color.FgWhite,
+}
+
+// ParseColors parses comma separated color names (ex: bold,cyan)
+func ParseColors(s string) ([]color.Attribute, error) {
+	var colors []color.Attribute
+	for _, name := range strings.Split(s, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" || name == "none" {
+			continue
+		}
+		col, ok := colorsByName[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown color '%s', expecting any of: %s", name, strings.Join(colorNames(), ", "))
+		}
+		colors = append(colors, col)
+	}
+	return colors, nil
+}
+
+// ParseStateColors parses comma separated state=color pairs (ex: running=green,stopped=yellow)
+func ParseStateColors(s string) (map[string]color.Attribute, error) {
+	states := make(map[string]color.Attribute)
+	for _, pair := range strings.Split(s, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		splits := strings.SplitN(pair, "=", 2)
+		if len(splits) != 2 {
+			return nil, fmt.Errorf("invalid state color '%s', expecting state=color", pair)
+		}
+		name := strings.ToLower(strings.TrimSpace(splits[1]))
+		col, ok := colorsByName[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown color '%s', expecting any of: %s", name, strings.Join(colorNames(), ", "))
+		}
+		states[strings.TrimSpace(splits[0])] = col
+	}
+	return states, nil
+}
+
+func colorNames() (names []string) {
+	for name := range colorsByName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return
+}
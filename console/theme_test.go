@@ -0,0 +1,78 @@
+package console
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/wallix/awless/cloud/properties"
+)
+
+func TestParseColors(t *testing.T) {
+	colors, err := ParseColors("bold, Cyan")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := colors, []color.Attribute{color.Bold, color.FgCyan}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if colors, err = ParseColors("none"); err != nil || len(colors) != 0 {
+		t.Fatalf("got %v, %v", colors, err)
+	}
+	if _, err = ParseColors("bold,pink"); err == nil {
+		t.Fatal("expected error got none")
+	}
+
+	states, err := ParseStateColors("running=blue, stopped=red")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := states, map[string]color.Attribute{"running": color.FgBlue, "stopped": color.FgRed}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for _, invalid := range []string{"running", "running=pink"} {
+		if _, err = ParseStateColors(invalid); err == nil {
+			t.Fatalf("%s: expected error got none", invalid)
+		}
+	}
+}
+
+func TestThemeColors(t *testing.T) {
+	defer func(noColor bool, theme Theme) {
+		color.NoColor = noColor
+		CurrentTheme = theme
+	}(color.NoColor, CurrentTheme)
+
+	theme := DefaultTheme.WithStateColors(map[string]color.Attribute{"Running": color.FgBlue, "rebooting": color.FgMagenta})
+	if got, want := DefaultTheme.States["running"], color.FgGreen; got != want {
+		t.Fatalf("default theme modified: got %v, want %v", got, want)
+	}
+	if got, want := theme.States["running"], color.FgBlue; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := theme.States["terminated"], color.FgRed; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	CurrentTheme = theme
+	def := StateColumnDefinition{StringColumnDefinition{Prop: properties.State}}
+
+	color.NoColor = true
+	if got, want := def.format("running"), "running"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	if got := theme.headerColors(2); len(got) != 0 {
+		t.Fatalf("expected no header colors, got %v", got)
+	}
+
+	color.NoColor = false
+	if got, want := def.format("running"), "\x1b[34mrunning\x1b[0m"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	if got, want := def.format("unknown"), "unknown"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	if got, want := len(theme.headerColors(2)), 2; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+}
@@ -32,8 +32,14 @@ func ApiToInterface(api string) string {
 		return "CloudFrontAPI"
 	case "applicationautoscaling":
 		return "ApplicationAutoScalingAPI"
+	case "elasticsearchservice":
+		return "ElasticsearchServiceAPI"
 	case "cloudformation":
 		return "CloudFormationAPI"
+	case "dynamodb":
+		return "DynamoDBAPI"
+	case "elasticbeanstalk":
+		return "ElasticBeanstalkAPI"
 	case "route53", "lambda":
 		return strings.Title(api) + "API"
 	default:
@@ -62,7 +68,7 @@ type fetcher struct {
 var FetchersDefs = []fetchersDef{
 	{
 		Name: "infra",
-		Api:  []string{"ec2", "elbv2", "elb", "rds", "autoscaling", "ecr", "ecs", "applicationautoscaling", "acm"},
+		Api:  []string{"ec2", "elbv2", "elb", "rds", "autoscaling", "ecr", "ecs", "applicationautoscaling", "acm", "elasticsearchservice"},
 		Fetchers: []fetcher{
 			{Api: "ec2", ResourceType: cloud.Instance, AWSType: "ec2.Instance", ApiMethod: "DescribeInstancesPages", Input: "ec2.DescribeInstancesInput{}", Output: "ec2.DescribeInstancesOutput", OutputsExtractor: "Instances", OutputsContainers: "Reservations", Multipage: true, NextPageMarker: "NextToken"},
 			{Api: "ec2", ResourceType: cloud.Subnet, AWSType: "ec2.Subnet", ApiMethod: "DescribeSubnets", Input: "ec2.DescribeSubnetsInput{}", Output: "ec2.DescribeSubnetsOutput", OutputsExtractor: "Subnets"},
@@ -90,10 +96,12 @@ var FetchersDefs = []fetchersDef{
 			{Api: "autoscaling", ResourceType: cloud.ScalingPolicy, AWSType: "autoscaling.ScalingPolicy", ApiMethod: "DescribePoliciesPages", Input: "autoscaling.DescribePoliciesInput{}", Output: "autoscaling.DescribePoliciesOutput", OutputsExtractor: "ScalingPolicies", Multipage: true, NextPageMarker: "NextToken"},
 			{Api: "ecr", ResourceType: cloud.Repository, AWSType: "ecr.Repository", ApiMethod: "DescribeRepositoriesPages", Input: "ecr.DescribeRepositoriesInput{}", Output: "ecr.DescribeRepositoriesOutput", OutputsExtractor: "Repositories", Multipage: true, NextPageMarker: "NextToken"},
 			{Api: "ecs", ResourceType: cloud.ContainerCluster, AWSType: "ecs.Cluster", ManualFetcher: true},
+			{Api: "ecs", ResourceType: cloud.ContainerService, AWSType: "ecs.Service", ManualFetcher: true},
 			{Api: "ecs", ResourceType: cloud.ContainerTask, AWSType: "ecs.TaskDefinition", ManualFetcher: true},
 			{Api: "ecs", ResourceType: cloud.Container, AWSType: "ecs.Container", ManualFetcher: true},
 			{Api: "ecs", ResourceType: cloud.ContainerInstance, AWSType: "ecs.ContainerInstance", ManualFetcher: true},
 			{Api: "acm", ResourceType: cloud.Certificate, AWSType: "acm.CertificateSummary", ApiMethod: "ListCertificatesPages", Input: "acm.ListCertificatesInput{}", Output: "acm.ListCertificatesOutput", OutputsExtractor: "CertificateSummaryList", Multipage: true, NextPageMarker: "NextToken"},
+			{Api: "elasticsearchservice", ResourceType: cloud.ElasticsearchDomain, AWSType: "elasticsearchservice.ElasticsearchDomainStatus", ManualFetcher: true},
 		},
 	},
 	{
@@ -120,11 +128,12 @@ var FetchersDefs = []fetchersDef{
 	},
 	{
 		Name: "messaging",
-		Api:  []string{"sns", "sqs"},
+		Api:  []string{"sns", "sqs", "ses"},
 		Fetchers: []fetcher{
 			{Api: "sns", ResourceType: cloud.Subscription, AWSType: "sns.Subscription", ApiMethod: "ListSubscriptionsPages", Input: "sns.ListSubscriptionsInput{}", Output: "sns.ListSubscriptionsOutput", OutputsExtractor: "Subscriptions", Multipage: true, NextPageMarker: "NextToken"},
 			{Api: "sns", ResourceType: cloud.Topic, AWSType: "sns.Topic", ApiMethod: "ListTopicsPages", Input: "sns.ListTopicsInput{}", Output: "sns.ListTopicsOutput", OutputsExtractor: "Topics", Multipage: true, NextPageMarker: "NextToken"},
 			{Api: "sqs", ResourceType: cloud.Queue, AWSType: "string", ManualFetcher: true},
+			{Api: "ses", ResourceType: cloud.EmailIdentity, AWSType: "string", ManualFetcher: true},
 		},
 	},
 	{
@@ -75,8 +75,15 @@ func generateCommands() {
 	writeTemplateToFile(templ, cmdsData, SPEC_DIR, "gen_cmds_defs.go")
 }
 
+// cmdData is the declarative spec of a command, read from the tags of its struct in aws/spec:
+// action, entity and awsAPI (required), awsCall, awsInput and awsOutput (the API call generating the run),
+// awsDryRun ("" to generate the dry run, "manual" when hand-written), awsOutputExtract (the output field
+// path of the command result) and the templateName of its params. Commands without awsCall have a ManualRun.
+// A param can also declare its completion values with enum ("" for no completion) and the resource
+// property it references with ref (ex: "role.Name"), generating the enums and param types of aws/doc.
 type cmdData struct {
 	Action, Entity, API, Call, Input, Output string
+	OutputExtractor                          string
 	Params                                   []templateParam
 	RequiredParamsKey                        []string
 	ExtrasParamsKey                          []string
@@ -89,6 +96,10 @@ type templateParam struct {
 	Name       string
 	AwsField   string
 	IsRequired bool
+	Enum       []string
+	HasEnum    bool
+	RefType    string
+	RefProp    string
 }
 
 type findStructs struct {
@@ -155,6 +166,9 @@ func extractCmdData(s string) (t cmdData) {
 	if v, ok := tags["awsOutput"]; ok {
 		t.Output = v
 	}
+	if v, ok := tags["awsOutputExtract"]; ok {
+		t.OutputExtractor = v
+	}
 	if v, ok := tags["awsDryRun"]; ok {
 		t.HasDryRun = true
 		t.GenDryRun = true
@@ -176,6 +190,17 @@ func extractParam(s string) (p templateParam) {
 	if v, ok := tags["awsName"]; ok {
 		p.AwsField = v
 	}
+	if v, ok := tags["enum"]; ok {
+		p.HasEnum = true
+		p.Enum = strings.Split(v, ",")
+	}
+	if v, ok := tags["ref"]; ok {
+		ref := strings.Split(v, ".")
+		if len(ref) != 2 || ref[0] == "" || ref[1] == "" {
+			panic(fmt.Sprintf("malformed ref tag '%s' of param '%s': expected 'resourcetype.Property'", v, p.Name))
+		}
+		p.RefType, p.RefProp = ref[0], ref[1]
+	}
 	return
 }
 
@@ -196,7 +221,7 @@ func extractTags(s string) map[string]string {
 	splits := strings.Split(s[1:len(s)-1], " ")
 	tags := make(map[string]string)
 	for _, e := range splits {
-		el := strings.Split(e, ":")
+		el := strings.SplitN(e, ":", 2)
 		if len(el) > 1 {
 			if len(el[1]) < 2 || el[1][0] != '"' || el[1][len(el[1])-1] != '"' {
 				panic(fmt.Sprintf("malformed tag: '%s':'%s'", el[0], el[1]))
@@ -246,6 +271,9 @@ func (cmd *{{ $cmdName }}) SetApi(api {{$tag.API}}iface.{{ ApiToInterface $tag.A
 }
 
 func (cmd *{{ $cmdName }}) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := validateParams(cmd, params); err != nil {
+		return nil, err
+	}
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
@@ -272,13 +300,13 @@ func (cmd *{{ $cmdName }}) run(renv env.Running, params map[string]interface{})
 	output, err := cmd.api.{{ $tag.Call }}(input)
 	renv.Log().ExtraVerbosef("{{ $tag.API }}.{{ $tag.Call }} call took %s", time.Since(start))
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "{{ $tag.API }}.{{ $tag.Call }}")
 	}
 	{{- else }}
 	
 	output, err := cmd.ManualRun(renv)
 	if err != nil {
-		return nil, decorateAWSError(err)
+		return nil, decorateAWSError(err, "{{ $tag.API }}.{{ $tag.Call }}")
 	}
 	{{- end }}
 	
@@ -342,6 +370,12 @@ func (cmd *{{ $cmdName }}) dryRun(renv env.Running, params map[string]interface{
 func (cmd *{{ $cmdName }}) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
+{{- if $tag.OutputExtractor }}
+
+func (cmd *{{ $cmdName }}) ExtractResult(i interface{}) string {
+	return StringValue(i.(*{{ $tag.Output }}).{{ $tag.OutputExtractor }})
+}
+{{- end }}
 {{ end }}
 `
 
@@ -366,7 +400,6 @@ package awsspec
 
 import (
 	"github.com/aws/aws-sdk-go/aws/session"
-	"github.com/aws/aws-sdk-go/awstesting/mock"
 	"github.com/wallix/awless/logger"
 )
 
@@ -376,11 +409,6 @@ type Factory interface {
 
 var CommandFactory Factory
 
-var MockAWSSessionFactory = &AWSFactory{
-	Log:  logger.DiscardLogger,
-	Sess: mock.Session,
-}
-
 type AWSFactory struct {
 	Log   *logger.Logger
 	Sess *session.Session
@@ -434,14 +462,16 @@ var APIPerTemplateDefName = map[string]string {
 {{- end }}
 }
 
-var AWSTemplatesDefinitions = map[string]Definition{
+var awsTemplatesDefinitions = map[string]func() Definition{
 {{- range $cmdName, $cmd := . }}
-	"{{ $cmd.Action }}{{ $cmd.Entity }}": Definition{
+	"{{ $cmd.Action }}{{ $cmd.Entity }}": func() Definition {
+		return Definition{
 			Action: "{{ $cmd.Action }}",
 			Entity: "{{ $cmd.Entity }}",
 			Api: "{{ $cmd.API }}",
 			Params: new({{ $cmdName }}).ParamsSpec().Rule(),
-		},
+		}
+	},
 {{- end }}
 }
 
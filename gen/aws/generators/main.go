@@ -68,6 +68,7 @@ func main() {
 
 	// doc
 	generateParamsDocLookup()
+	generateEnumsDoc()
 }
 
 func writeTemplateToFile(templ *template.Template, data interface{}, dir, filename string) {
@@ -57,6 +57,33 @@ func generateParamsDocLookup() {
 	writeTemplateToFile(templ, doc, DOC_DIR, "gen_paramsdoc.go")
 }
 
+func generateEnumsDoc() {
+	templ, err := template.New("enumsdoc").Parse(enumsdocTempl)
+	if err != nil {
+		panic(err)
+	}
+
+	cmdsData := loadCommandStructs()
+
+	data := struct {
+		Enums      map[string][]string
+		ParamTypes map[string]templateParam
+	}{make(map[string][]string), make(map[string]templateParam)}
+	for _, cmd := range cmdsData {
+		for _, p := range cmd.Params {
+			key := fmt.Sprintf("%s.%s.%s", cmd.Action, cmd.Entity, p.Name)
+			if p.HasEnum {
+				data.Enums[key] = p.Enum
+			}
+			if p.RefType != "" {
+				data.ParamTypes[key] = p
+			}
+		}
+	}
+
+	writeTemplateToFile(templ, data, DOC_DIR, "gen_enumsdoc.go")
+}
+
 func searchParamInDoc(paramsDoc map[string]string, input, field string) (string, bool) {
 	var lowerField string
 	if len(field) > 0 {
@@ -221,3 +248,34 @@ var generatedParamsDoc = map[string]map[string]string{
   },
   {{- end }}
 }`
+
+const enumsdocTempl = `/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// DO NOT EDIT
+// This file was automatically generated with go generate
+package awsdoc
+
+var EnumDoc = map[string][]string{
+  {{- range $key, $enum := .Enums }}
+  "{{ $key }}": []string{ {{- range $enum }}{{ printf "%q" . }}, {{ end -}} },
+  {{- end }}
+}
+
+var ParamTypeDoc = map[string]*ParamType{
+  {{- range $key, $param := .ParamTypes }}
+  "{{ $key }}": &ParamType{ResourceType: "{{ $param.RefType }}", PropertyName: "{{ $param.RefProp }}"},
+  {{- end }}
+}`
@@ -87,6 +87,12 @@ var mocksDefs = []*mockDef{
 			{FuncType: "list", AWSType: "acm.CertificateSummary", ApiMethod: "ListCertificatesPages", Input: "acm.ListCertificatesInput", Output: "acm.ListCertificatesOutput", OutputsExtractor: "CertificateSummaryList", Multipage: true, NextPageMarker: "NextToken"},
 		},
 	},
+	{
+		Api: "elasticsearchservice",
+		Funcs: []*mockFuncDef{
+			{FuncType: "list", AWSType: "elasticsearchservice.ElasticsearchDomainStatus", Manual: true},
+		},
+	},
 	{
 		Api: "iam",
 		Funcs: []*mockFuncDef{
@@ -123,6 +129,13 @@ var mocksDefs = []*mockDef{
 			{FuncType: "list", AWSType: "map[string]*string", Manual: true, MockFieldType: "map"},
 		},
 	},
+	{
+		Api: "ses",
+		Funcs: []*mockFuncDef{
+			{FuncType: "list", AWSType: "string", Manual: true},
+			{FuncType: "list", MockField: "attributes", AWSType: "*ses.IdentityVerificationAttributes", Manual: true, MockFieldType: "map"},
+		},
+	},
 	{
 		Api: "route53",
 		Funcs: []*mockFuncDef{
@@ -172,6 +185,8 @@ var mocksDefs = []*mockDef{
 			{FuncType: "list", MockFieldType: "mapslice", MockField: "tasksNames", AWSType: "string", Manual: true},
 			{FuncType: "list", MockFieldType: "mapslice", MockField: "containerinstancesNames", AWSType: "string", Manual: true},
 			{FuncType: "list", MockFieldType: "mapslice", AWSType: "ecs.ContainerInstance", Manual: true},
+			{FuncType: "list", MockFieldType: "mapslice", MockField: "servicesNames", AWSType: "string", Manual: true},
+			{FuncType: "list", MockFieldType: "mapslice", AWSType: "ecs.Service", Manual: true},
 		},
 	},
 }
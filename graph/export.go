@@ -0,0 +1,327 @@
+package graph
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/wallix/awless/cloud/rdf"
+	tstore "github.com/wallix/triplestore"
+)
+
+// Formats of exported graphs
+const (
+	NTriplesFormat = "ntriples"
+	JSONLDFormat   = "jsonld"
+)
+
+var ExportFormats = []string{NTriplesFormat, JSONLDFormat}
+
+// ExportContext expands the prefixed terms and resource identifiers of graphs
+// into the IRIs expected by standard RDF tools (triple stores, graph databases)
+var ExportContext = &tstore.Context{
+	Base: "urn:awless:",
+	Prefixes: map[string]string{
+		rdf.RdfNS:      "http://www.w3.org/1999/02/22-rdf-syntax-ns#",
+		rdf.RdfsNS:     "http://www.w3.org/2000/01/rdf-schema#",
+		rdf.XsdNS:      "http://www.w3.org/2001/XMLSchema#",
+		rdf.CloudNS:    "https://awless.io/rdf/cloud#",
+		rdf.CloudRelNS: "https://awless.io/rdf/cloud-rel#",
+		rdf.CloudOwlNS: "https://awless.io/rdf/cloud-owl#",
+		rdf.NetNS:      "https://awless.io/rdf/net#",
+		rdf.NetowlNS:   "https://awless.io/rdf/net-owl#",
+	},
+}
+
+// Export writes the graph in a standard RDF format (see ExportFormats) with IRIs expanded from ExportContext
+func (g *Graph) Export(w io.Writer, format string) error {
+	triples := g.store.CopyTriples()
+	switch format {
+	case NTriplesFormat:
+		// blank nodes are stored as plain subjects (see TriplesFromStruct), not linking to their parent in standard RDF
+		bnodes := bnodeSubjects(triples)
+		for i, t := range triples {
+			if bnodes[t.Subject()] {
+				triples[i] = tstore.BnodePred(t.Subject(), t.Predicate()).Object(t.Object())
+			}
+		}
+		return tstore.NewLenientNTEncoderWithContext(w, ExportContext).Encode(triples...)
+	case JSONLDFormat:
+		return encodeJSONLD(w, triples)
+	default:
+		return fmt.Errorf("unknown export format '%s': expecting %s", format, strings.Join(ExportFormats, ", "))
+	}
+}
+
+// Import adds to the graph the triples of a standard RDF export (see Export)
+func (g *Graph) Import(r io.Reader, format string) error {
+	var (
+		triples []tstore.Triple
+		err     error
+	)
+	switch format {
+	case NTriplesFormat:
+		triples, err = decodeNTriples(r)
+	case JSONLDFormat:
+		triples, err = decodeJSONLD(r)
+	default:
+		return fmt.Errorf("unknown import format '%s': expecting %s", format, strings.Join(ExportFormats, ", "))
+	}
+	if err != nil {
+		return fmt.Errorf("import %s: %s", format, err)
+	}
+	g.store.Add(triples...)
+	return nil
+}
+
+func expandIRI(id string) string {
+	for prefix, ns := range ExportContext.Prefixes {
+		if strings.HasPrefix(id, prefix+":") {
+			return ns + url.QueryEscape(strings.TrimPrefix(id, prefix+":"))
+		}
+	}
+	if strings.HasPrefix(id, "http") {
+		return id
+	}
+	return ExportContext.Base + url.QueryEscape(id)
+}
+
+func compactIRI(iri string) string {
+	unescape := func(s string) string {
+		if u, err := url.QueryUnescape(s); err == nil {
+			return u
+		}
+		return s
+	}
+	for prefix, ns := range ExportContext.Prefixes {
+		if strings.HasPrefix(iri, ns) {
+			return prefix + ":" + unescape(strings.TrimPrefix(iri, ns))
+		}
+	}
+	if strings.HasPrefix(iri, ExportContext.Base) {
+		return unescape(strings.TrimPrefix(iri, ExportContext.Base))
+	}
+	return iri
+}
+
+// node is a triple of an imported graph with its IRIs compacted,
+// blank node subjects being plain subjects as in local graphs
+type node struct {
+	sub, pred                string
+	resource, bnode, literal *string
+	typ, lang                string
+}
+
+func (n *node) triple() (tstore.Triple, error) {
+	builder := tstore.SubjPred(n.sub, n.pred)
+	switch {
+	case n.resource != nil:
+		return builder.Resource(*n.resource), nil
+	case n.bnode != nil:
+		return builder.Bnode(*n.bnode), nil
+	case n.lang != "":
+		return builder.StringLiteralWithLang(*n.literal, n.lang), nil
+	case n.typ == "" || n.typ == rdf.XsdString:
+		return builder.StringLiteral(*n.literal), nil
+	}
+	// typed literals are built as in local graph files
+	line := fmt.Sprintf("<%s> <%s> \"%s\"^^<%s> .\n", n.sub, n.pred, *n.literal, n.typ)
+	triples, err := tstore.NewLenientNTDecoder(strings.NewReader(line)).Decode()
+	if err != nil {
+		return nil, err
+	}
+	if len(triples) != 1 {
+		return nil, fmt.Errorf("invalid literal %q of type %s", *n.literal, n.typ)
+	}
+	return triples[0], nil
+}
+
+func decodeNTriples(r io.Reader) ([]tstore.Triple, error) {
+	decoded, err := tstore.NewLenientNTDecoder(r).Decode()
+	if err != nil {
+		return nil, err
+	}
+	var triples []tstore.Triple
+	for _, t := range decoded {
+		n := &node{sub: compactIRI(t.Subject()), pred: compactIRI(t.Predicate())}
+		if bnode, ok := t.Object().Bnode(); ok {
+			n.bnode = &bnode
+		} else if res, ok := t.Object().Resource(); ok {
+			res = compactIRI(res)
+			n.resource = &res
+		} else if lit, ok := t.Object().Literal(); ok {
+			value := lit.Value()
+			n.literal, n.typ, n.lang = &value, compactIRI(string(lit.Type())), lit.Lang()
+		}
+		tri, err := n.triple()
+		if err != nil {
+			return nil, err
+		}
+		triples = append(triples, tri)
+	}
+	return triples, nil
+}
+
+// bnodeSubjects returns the subjects that are blank nodes, known as objects of other triples
+func bnodeSubjects(triples []tstore.Triple) map[string]bool {
+	bnodes := make(map[string]bool)
+	for _, t := range triples {
+		if bnode, ok := t.Object().Bnode(); ok {
+			bnodes[bnode] = true
+		}
+	}
+	return bnodes
+}
+
+func encodeJSONLD(w io.Writer, triples []tstore.Triple) error {
+	bnodes := bnodeSubjects(triples)
+
+	var subjects []string
+	nodes := make(map[string]map[string][]interface{})
+	for _, t := range triples {
+		sub := t.Subject()
+		id := expandIRI(sub)
+		if bnodes[sub] {
+			id = "_:" + sub
+		}
+		props, ok := nodes[id]
+		if !ok {
+			props = make(map[string][]interface{})
+			nodes[id] = props
+			subjects = append(subjects, id)
+		}
+
+		var value interface{}
+		if bnode, ok := t.Object().Bnode(); ok {
+			value = map[string]string{"@id": "_:" + bnode}
+		} else if res, ok := t.Object().Resource(); ok {
+			value = map[string]string{"@id": expandIRI(res)}
+		} else if lit, ok := t.Object().Literal(); ok {
+			switch {
+			case lit.Lang() != "":
+				value = map[string]string{"@value": lit.Value(), "@language": lit.Lang()}
+			case lit.Type() == tstore.XsdString:
+				value = lit.Value()
+			default:
+				value = map[string]string{"@value": lit.Value(), "@type": expandIRI(string(lit.Type()))}
+			}
+		}
+		if t.Predicate() == rdf.RdfType {
+			if res, ok := t.Object().Resource(); ok {
+				props["@type"] = append(props["@type"], expandIRI(res))
+				continue
+			}
+		}
+		pred := expandIRI(t.Predicate())
+		props[pred] = append(props[pred], value)
+	}
+
+	sort.Strings(subjects)
+	graph := make([]map[string]interface{}, 0, len(subjects))
+	for _, id := range subjects {
+		obj := map[string]interface{}{"@id": id}
+		for pred, values := range nodes[id] {
+			if len(values) == 1 {
+				obj[pred] = values[0]
+			} else {
+				sort.Slice(values, func(i, j int) bool { return fmt.Sprint(values[i]) < fmt.Sprint(values[j]) })
+				obj[pred] = values
+			}
+		}
+		graph = append(graph, obj)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(map[string]interface{}{"@graph": graph})
+}
+
+func decodeJSONLD(r io.Reader) ([]tstore.Triple, error) {
+	var doc struct {
+		Graph []map[string]json.RawMessage `json:"@graph"`
+	}
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, err
+	}
+
+	var triples []tstore.Triple
+	for _, obj := range doc.Graph {
+		var id string
+		if err := json.Unmarshal(obj["@id"], &id); err != nil {
+			return nil, fmt.Errorf("invalid node @id: %s", err)
+		}
+		sub := compactIRI(strings.TrimPrefix(id, "_:"))
+		for pred, raw := range obj {
+			if pred == "@id" {
+				continue
+			}
+			values, err := jsonldValues(raw)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s: %s", id, pred, err)
+			}
+			for _, v := range values {
+				n := &node{sub: sub, pred: compactIRI(pred)}
+				switch {
+				case pred == "@type":
+					typ := compactIRI(v.Value)
+					n.pred, n.resource = rdf.RdfType, &typ
+				case v.ID != "" && strings.HasPrefix(v.ID, "_:"):
+					bnode := strings.TrimPrefix(v.ID, "_:")
+					n.bnode = &bnode
+				case v.ID != "":
+					res := compactIRI(v.ID)
+					n.resource = &res
+				default:
+					value := v.Value
+					n.literal, n.lang = &value, v.Language
+					if v.Type != "" {
+						n.typ = compactIRI(v.Type)
+					}
+				}
+				tri, err := n.triple()
+				if err != nil {
+					return nil, err
+				}
+				triples = append(triples, tri)
+			}
+		}
+	}
+	return triples, nil
+}
+
+type jsonldValue struct {
+	ID       string `json:"@id"`
+	Value    string `json:"@value"`
+	Type     string `json:"@type"`
+	Language string `json:"@language"`
+}
+
+// jsonldValues decodes a single or an array of JSON-LD values: strings or value/node objects
+func jsonldValues(raw json.RawMessage) ([]jsonldValue, error) {
+	raw = bytes.TrimSpace(raw)
+	var elems []json.RawMessage
+	if len(raw) > 0 && raw[0] == '[' {
+		if err := json.Unmarshal(raw, &elems); err != nil {
+			return nil, err
+		}
+	} else {
+		elems = []json.RawMessage{raw}
+	}
+
+	var values []jsonldValue
+	for _, elem := range elems {
+		var v jsonldValue
+		var s string
+		if err := json.Unmarshal(elem, &s); err == nil {
+			v.Value = s
+		} else if err := json.Unmarshal(elem, &v); err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
@@ -0,0 +1,62 @@
+package graph_test
+
+import (
+	"bytes"
+	"net"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+)
+
+func TestExportImport(t *testing.T) {
+	_, internet, _ := net.ParseCIDR("0.0.0.0/0")
+	g := graph.NewGraph()
+	vpc := resourcetest.VPC("vpc_1").Prop(properties.Name, `my "main" vpc`).Build()
+	sg := resourcetest.SecurityGroup("sg_1").Prop(properties.InboundRules, []*graph.FirewallRule{
+		{Protocol: "tcp", PortRange: graph.PortRange{FromPort: 22, ToPort: 22}, IPRanges: []*net.IPNet{internet}},
+	}).Build()
+	inst := resourcetest.Instance("inst_1").Prop(properties.Launched, time.Date(2017, 3, 10, 12, 0, 0, 0, time.UTC)).Prop(properties.Name, "web server").Build()
+	bucket := resourcetest.Bucket("my-bucket").Prop(properties.Grants, []*graph.Grant{
+		{Permission: "READ", Grantee: graph.Grantee{GranteeID: "http://acs.amazonaws.com/groups/global/AllUsers", GranteeType: "Group"}},
+	}).Build()
+	queue := resourcetest.Queue("https://sqs.eu-west-1.amazonaws.com/0123456789/jobs").Build()
+	g.AddResource(vpc, sg, inst, bucket, queue)
+	g.AddParentRelation(vpc, sg)
+	g.AddParentRelation(vpc, inst)
+	g.AddAppliesOnRelation(sg, inst)
+
+	for _, format := range graph.ExportFormats {
+		t.Run(format, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := g.Export(&buf, format); err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(buf.String(), "urn:awless:inst_1") || !strings.Contains(buf.String(), "https://awless.io/rdf/cloud#name") {
+				t.Fatalf("expected expanded IRIs in\n%s", buf.String())
+			}
+
+			imported := graph.NewGraph()
+			if err := imported.Import(&buf, format); err != nil {
+				t.Fatal(err)
+			}
+			if got, want := sortedLines(imported.MustMarshal()), sortedLines(g.MustMarshal()); got != want {
+				t.Fatalf("got\n%s\nwant\n%s", got, want)
+			}
+		})
+	}
+
+	if err := g.Export(new(bytes.Buffer), "turtle"); err == nil {
+		t.Fatal("expected error on unknown format")
+	}
+}
+
+func sortedLines(s string) string {
+	lines := strings.Split(strings.TrimSpace(s), "\n")
+	sort.Strings(lines)
+	return strings.Join(lines, "\n")
+}
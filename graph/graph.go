@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
 
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/cloud/rdf"
@@ -148,6 +149,33 @@ func (g *Graph) GetAllResources(typs ...string) ([]*Resource, error) {
 	return byTypes.Resolve(g.store.Snapshot())
 }
 
+// ResourceTypes returns the sorted types of the resources in the graph
+func (g *Graph) ResourceTypes() ([]string, error) {
+	snap := g.store.Snapshot()
+	// unlike blank nodes (ex: firewall rules), resources have an id
+	withID := make(map[string]bool)
+	for _, t := range snap.WithPredicate(rdf.ID) {
+		withID[t.Subject()] = true
+	}
+	unique := make(map[string]bool)
+	for _, t := range snap.WithPredicate(rdf.RdfType) {
+		if !withID[t.Subject()] {
+			continue
+		}
+		typ, err := unmarshalResourceType(t.Object())
+		if err != nil {
+			return nil, err
+		}
+		unique[typ] = true
+	}
+	var types []string
+	for typ := range unique {
+		types = append(types, typ)
+	}
+	sort.Strings(types)
+	return types, nil
+}
+
 func (g *Graph) ResolveResources(resolvers ...Resolver) ([]*Resource, error) {
 	var resources []*Resource
 	snap := g.store.Snapshot()
@@ -182,14 +210,16 @@ func (g *Graph) Find(q cloud.Query) ([]cloud.Resource, error) {
 	case 0:
 		return nil, fmt.Errorf("invalid query: need at least one resource type")
 	case 1:
-		var filtered cloud.GraphAPI
-		filtered, err = g.FilterGraph(q)
+		// matched directly, without building a filtered graph to read them back from
+		var all []*Resource
+		all, err = g.GetAllResources(q.ResourceType[0])
 		if err != nil {
 			return nil, err
 		}
-		resources, err = filtered.(*Graph).GetAllResources(q.ResourceType[0])
-		if err != nil {
-			return nil, err
+		for _, r := range all {
+			if q.Matcher == nil || q.Matcher.Match(r) {
+				resources = append(resources, r)
+			}
 		}
 	default:
 		if q.Matcher != nil {
@@ -252,6 +282,44 @@ func (g *Graph) Merge(mg cloud.GraphAPI) error {
 	return nil
 }
 
+// ReplaceResourcesOfType replaces all the resources of the given type with the ones of the other graph.
+// Relations of resources still present are kept. Removed resources are deleted along with their relations.
+func (g *Graph) ReplaceResourcesOfType(resourceType string, other cloud.GraphAPI) error {
+	fresh, ok := other.(*Graph)
+	if !ok {
+		return fmt.Errorf("can not replace resources, graph is not a *graph.Graph, but a %T", other)
+	}
+	typeObj := tstore.Resource(namespacedResourceType(resourceType))
+
+	freshIds := make(map[string]struct{})
+	for _, tri := range fresh.store.Snapshot().WithPredObj(rdf.RdfType, typeObj) {
+		freshIds[tri.Subject()] = struct{}{}
+	}
+
+	snap := g.store.Snapshot()
+	var toRemove []tstore.Triple
+	for _, tri := range snap.WithPredObj(rdf.RdfType, typeObj) {
+		id := tri.Subject()
+		_, stillExists := freshIds[id]
+		for _, t := range snap.WithSubject(id) {
+			if !stillExists || !isRelationPredicate(t.Predicate()) {
+				toRemove = append(toRemove, t)
+			}
+		}
+		if !stillExists {
+			toRemove = append(toRemove, snap.WithObject(tstore.Resource(id))...)
+		}
+	}
+	g.store.Remove(toRemove...)
+	g.AddGraph(fresh)
+
+	return nil
+}
+
+func isRelationPredicate(p string) bool {
+	return p == rdf.ParentOf || p == rdf.ApplyOn
+}
+
 func (g *Graph) ResourceRelations(from cloud.Resource, relation string, recursive bool) (collect []cloud.Resource, err error) {
 	collectFunc := func(r *Resource, depth int) error {
 		if depth == 1 || recursive {
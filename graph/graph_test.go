@@ -29,6 +29,21 @@ import (
 	tstore "github.com/wallix/triplestore"
 )
 
+func TestResourceTypes(t *testing.T) {
+	g := NewGraph()
+	sg := InitResource("securitygroup", "sg_1")
+	sg.SetProperty(properties.InboundRules, []*FirewallRule{{PortRange: PortRange{FromPort: 22, ToPort: 22}, Protocol: "tcp"}})
+	g.AddResource(InitResource("instance", "inst_1"), InitResource("subnet", "subnet_1"), sg)
+
+	types, err := g.ResourceTypes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := types, []string{"instance", "securitygroup", "subnet"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
 func TestFindAncestors(t *testing.T) {
 	g := NewGraph()
 	inst := InitResource("instance", "inst_1")
@@ -371,3 +386,57 @@ func TestResourceChildrenAndSiblings(t *testing.T) {
 		}
 	})
 }
+
+func TestReplaceResourcesOfType(t *testing.T) {
+	g := NewGraph()
+	sub := InitResource("subnet", "sub_1")
+	inst1 := InitResource("instance", "inst_1")
+	inst1.properties["State"] = "running"
+	inst2 := InitResource("instance", "inst_2")
+	g.AddResource(sub, inst1, inst2)
+	g.AddParentRelation(sub, inst1)
+	g.AddParentRelation(sub, inst2)
+
+	fresh := NewGraph()
+	freshInst1 := InitResource("instance", "inst_1")
+	freshInst1.properties["State"] = "stopped"
+	inst3 := InitResource("instance", "inst_3")
+	fresh.AddResource(freshInst1, inst3)
+
+	if err := g.ReplaceResourcesOfType("instance", fresh); err != nil {
+		t.Fatal(err)
+	}
+
+	instances, err := g.GetAllResources("instance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, i := range instances {
+		ids = append(ids, i.Id())
+	}
+	sort.Strings(ids)
+	if got, want := ids, []string{"inst_1", "inst_3"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	res, err := g.GetResource("instance", "inst_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := res.Properties()["State"], "stopped"; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	children, err := g.ResourceRelations(sub, rdf.ChildrenOfRel, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(children), 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := children[0].Id(), "inst_1"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := len(g.store.Snapshot().WithObject(tstore.Resource("inst_2"))), 0; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+}
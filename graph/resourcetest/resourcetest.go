@@ -38,6 +38,10 @@ func SecurityGroup(id string) *rBuilder {
 	return new("securitygroup", id)
 }
 
+func Volume(id string) *rBuilder {
+	return new("volume", id)
+}
+
 func KeyPair(id string) *rBuilder {
 	return new("keypair", id)
 }
@@ -82,6 +86,10 @@ func Role(id string) *rBuilder {
 	return new("role", id)
 }
 
+func InstanceProfile(id string) *rBuilder {
+	return new("instanceprofile", id)
+}
+
 func User(id string) *rBuilder {
 	return new("user", id)
 }
@@ -126,6 +134,10 @@ func Queue(id string) *rBuilder {
 	return new("queue", id)
 }
 
+func EmailIdentity(id string) *rBuilder {
+	return new("emailidentity", id)
+}
+
 func Function(id string) *rBuilder {
 	return new("function", id)
 }
@@ -158,6 +170,10 @@ func ContainerCluster(id string) *rBuilder {
 	return new("containercluster", id)
 }
 
+func ContainerService(id string) *rBuilder {
+	return new("containerservice", id)
+}
+
 func ContainerTask(id string) *rBuilder {
 	return new("containertask", id)
 }
@@ -178,6 +194,10 @@ func Certificate(id string) *rBuilder {
 	return new("certificate", id)
 }
 
+func ElasticsearchDomain(id string) *rBuilder {
+	return new("elasticsearchdomain", id)
+}
+
 func AccessKey(id string) *rBuilder {
 	return new("accesskey", id)
 }
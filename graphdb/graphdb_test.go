@@ -0,0 +1,99 @@
+package graphdb
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	p "github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+)
+
+func TestPush(t *testing.T) {
+	g := graph.NewGraph()
+	sub := resourcetest.Subnet("sub-1").Prop(p.Name, "web").Build()
+	inst := resourcetest.Instance("inst-1").Prop(p.Name, "front").Prop(p.Launched, time.Date(2017, 6, 1, 0, 0, 0, 0, time.UTC)).
+		Prop(p.SecurityGroups, []string{"sg-1", "sg-2"}).Build()
+	g.AddResource(sub, inst)
+	g.AddParentRelation(sub, inst)
+
+	var received struct {
+		Statements []*Statement
+	}
+	var user, passwd, path string
+	response := `{"results":[],"errors":[]}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, passwd, _ = r.BasicAuth()
+		path = r.URL.Path
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Fatal(err)
+		}
+		w.Write([]byte(response))
+	}))
+	defer server.Close()
+
+	sink, err := Neo4j(strings.Replace(server.URL, "http://", "http://neo4j:secret@", 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sink.Push(g, "default", "eu-west-1"); err != nil {
+		t.Fatal(err)
+	}
+	if user != "neo4j" || passwd != "secret" {
+		t.Fatalf("got credentials %s:%s", user, passwd)
+	}
+	if got, want := path, DefaultCommitPath; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	expected := []string{
+		"MATCH (n:AwlessResource {profile: $profile, region: $region}) DETACH DELETE n",
+		"UNWIND $nodes AS props CREATE (n:AwlessResource:Instance) SET n = props",
+		"UNWIND $nodes AS props CREATE (n:AwlessResource:Subnet) SET n = props",
+		"UNWIND $rels AS rel MATCH (a:AwlessResource {profile: $profile, region: $region, id: rel.from}), (b:AwlessResource {profile: $profile, region: $region, id: rel.to}) CREATE (a)-[:PARENT_OF]->(b)",
+	}
+	if got, want := len(received.Statements), len(expected); got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	for i, st := range received.Statements {
+		if got, want := st.Statement, expected[i]; got != want {
+			t.Fatalf("%d: got %s, want %s", i, got, want)
+		}
+	}
+
+	node := received.Statements[1].Parameters["nodes"].([]interface{})[0].(map[string]interface{})
+	for k, want := range map[string]interface{}{"id": "inst-1", "type": "instance", "profile": "default", "region": "eu-west-1", "Name": "front", "Launched": "2017-06-01T00:00:00Z"} {
+		if got := node[k]; got != want {
+			t.Fatalf("%s: got %v, want %v", k, got, want)
+		}
+	}
+	if got, want := len(node["SecurityGroups"].([]interface{})), 2; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	rel := received.Statements[3].Parameters["rels"].([]interface{})[0].(map[string]interface{})
+	if rel["from"] != "sub-1" || rel["to"] != "inst-1" {
+		t.Fatalf("got %v", rel)
+	}
+
+	response = `{"results":[],"errors":[{"code":"Neo.ClientError.Security.Unauthorized","message":"invalid credentials"}]}`
+	if err := sink.Push(g, "default", "eu-west-1"); err == nil || !strings.Contains(err.Error(), "invalid credentials") {
+		t.Fatalf("expected error, got %v", err)
+	}
+}
+
+func TestNeo4jURL(t *testing.T) {
+	if _, err := Neo4j("bolt://localhost:7687"); err == nil {
+		t.Fatal("expected error for bolt URL")
+	}
+	sink, err := Neo4j("https://db.example.com:7473/db/data/transaction/commit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := sink.(*neo4j).endpoint, "https://db.example.com:7473/db/data/transaction/commit"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
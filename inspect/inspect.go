@@ -17,29 +17,94 @@ limitations under the License.
 package inspect
 
 import (
+	"fmt"
 	"io"
+	"sort"
+	"sync"
 
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/inspect/inspectors"
 )
 
-var InspectorsRegister map[string]Inspector
+// Inspector analyzes a cloud graph and prints a report of its findings.
+// Add your own through Register (typically from an init func in a fork).
+type Inspector interface {
+	Name() string
+	Inspect(cloud.GraphAPI) error
+	Print(io.Writer)
+}
+
+var (
+	registerMu sync.RWMutex
+	register   = make(map[string]Inspector)
+
+	// names of the inspectors before their kebab case renaming
+	legacyNames = map[string]string{
+		"bucket_sizer":        "bucket-sizes",
+		"port_scanner":        "port-scan",
+		"open_buckets":        "open-buckets",
+		"unencrypted_buckets": "unencrypted-buckets",
+	}
+)
 
 func init() {
 	all := []Inspector{
 		&inspectors.Pricer{}, &inspectors.BucketSizer{},
 		&inspectors.PortScanner{}, &inspectors.OpenBuckets{},
+		&inspectors.UnencryptedBuckets{}, &inspectors.Exposure{},
+		&inspectors.UnusedIAM{},
 	}
 
-	InspectorsRegister = make(map[string]Inspector)
-
 	for _, i := range all {
-		InspectorsRegister[i.Name()] = i
+		MustRegister(i)
 	}
 }
 
-type Inspector interface {
-	Name() string
-	Inspect(cloud.GraphAPI) error
-	Print(io.Writer)
+// Register makes an inspector available by its name
+func Register(i Inspector) error {
+	if i == nil {
+		return fmt.Errorf("register inspector: nil inspector")
+	}
+	name := i.Name()
+	if name == "" {
+		return fmt.Errorf("register inspector: empty name")
+	}
+
+	registerMu.Lock()
+	defer registerMu.Unlock()
+	if _, exists := register[name]; exists {
+		return fmt.Errorf("register inspector: '%s' already registered", name)
+	}
+	register[name] = i
+	return nil
+}
+
+// MustRegister is like Register but panics if the inspector cannot be registered
+func MustRegister(i Inspector) {
+	if err := Register(i); err != nil {
+		panic(err)
+	}
+}
+
+// Get returns the registered inspector with the given name
+func Get(name string) (Inspector, bool) {
+	registerMu.RLock()
+	defer registerMu.RUnlock()
+	if i, ok := register[name]; ok {
+		return i, true
+	}
+	i, ok := register[legacyNames[name]]
+	return i, ok
+}
+
+// Names returns the sorted names of all registered inspectors
+func Names() []string {
+	registerMu.RLock()
+	defer registerMu.RUnlock()
+	var names []string
+	for name := range register {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
 }
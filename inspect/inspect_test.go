@@ -0,0 +1,52 @@
+package inspect
+
+import (
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/wallix/awless/cloud"
+)
+
+type testInspector struct{ name string }
+
+func (i *testInspector) Name() string                 { return i.name }
+func (i *testInspector) Inspect(cloud.GraphAPI) error { return nil }
+func (i *testInspector) Print(io.Writer)              {}
+
+func TestRegister(t *testing.T) {
+	defer func() { delete(register, "my-inspector") }()
+
+	custom := &testInspector{name: "my-inspector"}
+	if err := Register(custom); err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := Get("my-inspector"); !ok || got != custom {
+		t.Fatalf("got %v, %t", got, ok)
+	}
+	if err := Register(&testInspector{name: "my-inspector"}); err == nil {
+		t.Fatal("expected error on duplicate name")
+	}
+	if err := Register(&testInspector{}); err == nil {
+		t.Fatal("expected error on empty name")
+	}
+
+	if got, want := Names(), []string{"bucket-sizes", "exposure", "my-inspector", "open-buckets", "port-scan", "pricer", "unencrypted-buckets", "unused-iam"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestGetLegacyNames(t *testing.T) {
+	for legacy, name := range legacyNames {
+		i, ok := Get(legacy)
+		if !ok {
+			t.Fatalf("%s: not found", legacy)
+		}
+		if got, want := i.Name(), name; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	}
+	if _, ok := Get("unknown"); ok {
+		t.Fatal("expected unknown inspector not found")
+	}
+}
@@ -34,7 +34,7 @@ type bucket struct {
 }
 
 func (*BucketSizer) Name() string {
-	return "bucket_sizer"
+	return "bucket-sizes"
 }
 
 func (i *BucketSizer) Inspect(g cloud.GraphAPI) error {
@@ -0,0 +1,183 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inspectors
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/cloud/rdf"
+	"github.com/wallix/awless/graph"
+)
+
+const (
+	ProbeOpen      = "open"
+	ProbeClosed    = "closed"
+	ProbeNotProbed = "not probed"
+
+	// maxProbedPorts limits the number of ports probed for a single port range
+	maxProbedPorts = 32
+)
+
+// ExposedPort is a port range of a running instance with a public IP
+// that a security group opens to the whole internet
+type ExposedPort struct {
+	Instance, Name, PublicIP string
+	SecurityGroup            string
+	Protocol                 string
+	PortRange                graph.PortRange
+	Probe                    string
+}
+
+func (e *ExposedPort) Ports() string {
+	switch {
+	case e.PortRange.Any:
+		return "all"
+	case e.PortRange.FromPort == e.PortRange.ToPort:
+		return strconv.FormatInt(e.PortRange.FromPort, 10)
+	default:
+		return fmt.Sprintf("%d-%d", e.PortRange.FromPort, e.PortRange.ToPort)
+	}
+}
+
+type Exposure struct {
+	Exposed []*ExposedPort
+}
+
+func (*Exposure) Name() string {
+	return "exposure"
+}
+
+func (e *Exposure) Inspect(g cloud.GraphAPI) error {
+	sgroups, err := g.Find(cloud.NewQuery(cloud.SecurityGroup))
+	if err != nil {
+		return err
+	}
+
+	e.Exposed = nil
+	for _, sg := range sgroups {
+		rules, ok := sg.Properties()[properties.InboundRules].([]*graph.FirewallRule)
+		if !ok {
+			continue
+		}
+		var opened []*graph.FirewallRule
+		for _, rule := range rules {
+			if isOpenToInternet(rule) {
+				opened = append(opened, rule)
+			}
+		}
+		if len(opened) == 0 {
+			continue
+		}
+		targets, err := g.ResourceRelations(sg, rdf.ApplyOn, false)
+		if err != nil {
+			return err
+		}
+		for _, target := range targets {
+			if target.Type() != cloud.Instance {
+				continue
+			}
+			publicIP, _ := target.Properties()[properties.PublicIP].(string)
+			if publicIP == "" {
+				continue
+			}
+			if state, _ := target.Properties()[properties.State].(string); state != "" && state != "running" {
+				continue
+			}
+			name, _ := target.Properties()[properties.Name].(string)
+			for _, rule := range opened {
+				e.Exposed = append(e.Exposed, &ExposedPort{
+					Instance:      target.Id(),
+					Name:          name,
+					PublicIP:      publicIP,
+					SecurityGroup: sg.Id(),
+					Protocol:      rule.Protocol,
+					PortRange:     rule.PortRange,
+				})
+			}
+		}
+	}
+
+	sort.Slice(e.Exposed, func(i, j int) bool {
+		if e.Exposed[i].Instance != e.Exposed[j].Instance {
+			return e.Exposed[i].Instance < e.Exposed[j].Instance
+		}
+		return e.Exposed[i].PortRange.FromPort < e.Exposed[j].PortRange.FromPort
+	})
+
+	return nil
+}
+
+// Probe verifies the exposed TCP ports with an actual connection.
+// UDP ports and ranges wider than maxProbedPorts are not probed.
+func (e *Exposure) Probe(timeout time.Duration) {
+	var wg sync.WaitGroup
+	for _, exposed := range e.Exposed {
+		exposed.Probe = ProbeNotProbed
+		if exposed.Protocol != "tcp" || exposed.PortRange.Any || exposed.PortRange.ToPort-exposed.PortRange.FromPort >= maxProbedPorts {
+			continue
+		}
+		wg.Add(1)
+		go func(exposed *ExposedPort) {
+			defer wg.Done()
+			exposed.Probe = ProbeClosed
+			for port := exposed.PortRange.FromPort; port <= exposed.PortRange.ToPort; port++ {
+				conn, err := net.DialTimeout("tcp", net.JoinHostPort(exposed.PublicIP, strconv.FormatInt(port, 10)), timeout)
+				if err == nil {
+					conn.Close()
+					exposed.Probe = ProbeOpen
+					return
+				}
+			}
+		}(exposed)
+	}
+	wg.Wait()
+}
+
+func (e *Exposure) Print(w io.Writer) {
+	if len(e.Exposed) == 0 {
+		fmt.Fprintln(w, "no instance ports reachable from the internet")
+		return
+	}
+	for _, exposed := range e.Exposed {
+		instance := exposed.Instance
+		if exposed.Name != "" {
+			instance = fmt.Sprintf("%s (%s)", exposed.Instance, exposed.Name)
+		}
+		fmt.Fprintf(w, "%s %s: ports %s via %s opened by %s", instance, exposed.PublicIP, exposed.Ports(), exposed.Protocol, exposed.SecurityGroup)
+		if exposed.Probe != "" {
+			fmt.Fprintf(w, " [%s]", exposed.Probe)
+		}
+		fmt.Fprintln(w)
+	}
+}
+
+func isOpenToInternet(rule *graph.FirewallRule) bool {
+	for _, n := range rule.IPRanges {
+		if ones, _ := n.Mask.Size(); ones == 0 {
+			return true
+		}
+	}
+	return false
+}
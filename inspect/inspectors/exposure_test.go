@@ -0,0 +1,69 @@
+package inspectors
+
+import (
+	"bytes"
+	"net"
+	"testing"
+	"time"
+
+	p "github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+)
+
+func TestExposure(t *testing.T) {
+	_, internet, _ := net.ParseCIDR("0.0.0.0/0")
+	_, private, _ := net.ParseCIDR("10.0.0.0/16")
+
+	g := graph.NewGraph()
+	sshSg := resourcetest.SecurityGroup("sg-ssh").Prop(p.InboundRules, []*graph.FirewallRule{
+		{Protocol: "tcp", PortRange: graph.PortRange{FromPort: 22, ToPort: 22}, IPRanges: []*net.IPNet{internet}},
+		{Protocol: "tcp", PortRange: graph.PortRange{FromPort: 5432, ToPort: 5432}, IPRanges: []*net.IPNet{private}},
+	}).Build()
+	privateSg := resourcetest.SecurityGroup("sg-private").Prop(p.InboundRules, []*graph.FirewallRule{
+		{Protocol: "any", PortRange: graph.PortRange{Any: true}, IPRanges: []*net.IPNet{private}},
+	}).Build()
+	public := resourcetest.Instance("i-public").Prop(p.Name, "bastion").Prop(p.PublicIP, "1.2.3.4").Prop(p.State, "running").Build()
+	stopped := resourcetest.Instance("i-stopped").Prop(p.PublicIP, "1.2.3.5").Prop(p.State, "stopped").Build()
+	internal := resourcetest.Instance("i-internal").Prop(p.State, "running").Build()
+	g.AddResource(sshSg, privateSg, public, stopped, internal)
+	g.AddAppliesOnRelation(sshSg, public)
+	g.AddAppliesOnRelation(sshSg, stopped)
+	g.AddAppliesOnRelation(sshSg, internal)
+	g.AddAppliesOnRelation(privateSg, public)
+
+	exposure := &Exposure{}
+	if err := exposure.Inspect(g); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(exposure.Exposed), 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	var buf bytes.Buffer
+	exposure.Print(&buf)
+	if got, want := buf.String(), "i-public (bastion) 1.2.3.4: ports 22 via tcp opened by sg-ssh\n"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestExposureProbe(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	port := int64(l.Addr().(*net.TCPAddr).Port)
+
+	exposure := &Exposure{Exposed: []*ExposedPort{
+		{Instance: "i-1", PublicIP: "127.0.0.1", Protocol: "tcp", PortRange: graph.PortRange{FromPort: port, ToPort: port}},
+		{Instance: "i-2", PublicIP: "127.0.0.1", Protocol: "udp", PortRange: graph.PortRange{FromPort: 53, ToPort: 53}},
+		{Instance: "i-3", PublicIP: "127.0.0.1", Protocol: "tcp", PortRange: graph.PortRange{FromPort: 0, ToPort: 65535}},
+	}}
+	exposure.Probe(time.Second)
+
+	for i, exp := range []string{ProbeOpen, ProbeNotProbed, ProbeNotProbed} {
+		if got := exposure.Exposed[i].Probe; got != exp {
+			t.Fatalf("%d: got %s, want %s", i+1, got, exp)
+		}
+	}
+}
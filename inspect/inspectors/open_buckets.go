@@ -31,7 +31,7 @@ type OpenBuckets struct {
 }
 
 func (*OpenBuckets) Name() string {
-	return "open_buckets"
+	return "open-buckets"
 }
 
 func (a *OpenBuckets) Inspect(g cloud.GraphAPI) error {
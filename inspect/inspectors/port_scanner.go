@@ -33,7 +33,7 @@ type PortScanner struct {
 }
 
 func (p *PortScanner) Name() string {
-	return "port_scanner"
+	return "port-scan"
 }
 
 func (p *PortScanner) Inspect(g cloud.GraphAPI) error {
@@ -75,7 +75,7 @@ func (p *Pricer) Inspect(g cloud.GraphAPI) error {
 		wg.Add(1)
 		go func(t string) {
 			defer wg.Done()
-			price, err := fetchPrice(t, region)
+			price, err := FetchPrice(t, region)
 			if err != nil {
 				fmt.Printf("fetching price for '%s': %s\n", t, err)
 				return
@@ -116,7 +116,8 @@ func (p *Pricer) Print(w io.Writer) {
 	tabw.Flush()
 }
 
-func fetchPrice(instType, region string) (float64, error) {
+// FetchPrice returns the on-demand hourly price of the given instance type in a region
+func FetchPrice(instType, region string) (float64, error) {
 	resp, err := http.PostForm(
 		pricesURL,
 		url.Values{"instance_type": {instType}, "location": {region}},
@@ -0,0 +1,61 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inspectors
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+)
+
+type UnencryptedBuckets struct {
+	unencrypted []string
+}
+
+func (*UnencryptedBuckets) Name() string {
+	return "unencrypted-buckets"
+}
+
+func (a *UnencryptedBuckets) Inspect(g cloud.GraphAPI) error {
+	buckets, err := g.Find(cloud.NewQuery(cloud.Bucket))
+	if err != nil {
+		return err
+	}
+
+	a.unencrypted = nil
+	for _, buck := range buckets {
+		// buckets whose encryption could not be fetched have no property
+		if encrypted, ok := buck.Properties()[properties.Encrypted].(bool); ok && !encrypted {
+			a.unencrypted = append(a.unencrypted, buck.Id())
+		}
+	}
+	sort.Strings(a.unencrypted)
+
+	return nil
+}
+
+func (a *UnencryptedBuckets) Print(w io.Writer) {
+	if len(a.unencrypted) == 0 {
+		fmt.Fprintln(w, "none found")
+		return
+	}
+	fmt.Fprintf(w, "Buckets without default encryption: %s\n", strings.Join(a.unencrypted, ", "))
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inspectors
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+)
+
+const DefaultUnusedIAMDays = 90
+
+// UnusedIAMEntity is a user, role or customer managed policy not used for a while
+type UnusedIAMEntity struct {
+	Type, ID, Name string
+	// LastAccessed is zero when never used
+	LastAccessed time.Time
+	Created      time.Time
+}
+
+// UnusedIAM flags the IAM users, roles and customer managed policies that did
+// not access any AWS service for Days (default 90), from the last accessed dates
+// synced with Access Advisor (see `awless audit iam`)
+type UnusedIAM struct {
+	Days   int
+	Unused []*UnusedIAMEntity
+
+	now func() time.Time
+}
+
+func (*UnusedIAM) Name() string {
+	return "unused-iam"
+}
+
+func (u *UnusedIAM) Inspect(g cloud.GraphAPI) error {
+	now := time.Now()
+	if u.now != nil {
+		now = u.now()
+	}
+	days := u.Days
+	if days <= 0 {
+		days = DefaultUnusedIAMDays
+	}
+	cutoff := now.AddDate(0, 0, -days)
+
+	var entities []cloud.Resource
+	for _, typ := range []string{cloud.User, cloud.Role, cloud.Policy} {
+		res, err := g.Find(cloud.NewQuery(typ))
+		if err != nil {
+			return err
+		}
+		entities = append(entities, res...)
+	}
+
+	var synced bool
+	u.Unused = nil
+	for _, res := range entities {
+		props := res.Properties()
+		if props[properties.Type] == "AWS Managed" {
+			continue
+		}
+		entity := &UnusedIAMEntity{Type: res.Type(), ID: res.Id()}
+		entity.Name, _ = props[properties.Name].(string)
+		entity.Created, _ = props[properties.Created].(time.Time)
+		if last, ok := props[properties.LastAccessed].(time.Time); ok {
+			synced = true
+			entity.LastAccessed = last
+		}
+		// console sign-ins count as use, though Access Advisor ignores them
+		if signin, ok := props[properties.PasswordLastUsed].(time.Time); ok && signin.After(entity.LastAccessed) {
+			entity.LastAccessed = signin
+		}
+
+		switch {
+		case !entity.LastAccessed.IsZero() && entity.LastAccessed.Before(cutoff):
+		case entity.LastAccessed.IsZero() && entity.Created.Before(cutoff):
+		default:
+			continue
+		}
+		u.Unused = append(u.Unused, entity)
+	}
+
+	if !synced && len(u.Unused) > 0 {
+		u.Unused = nil
+		return errors.New("no last accessed dates of IAM entities in graph: run `awless audit iam` or enable config aws.access.lastaccessed.sync")
+	}
+
+	sort.Slice(u.Unused, func(i, j int) bool {
+		if u.Unused[i].Type != u.Unused[j].Type {
+			return u.Unused[i].Type < u.Unused[j].Type
+		}
+		return u.Unused[i].ID < u.Unused[j].ID
+	})
+	return nil
+}
+
+func (u *UnusedIAM) Print(w io.Writer) {
+	if len(u.Unused) == 0 {
+		fmt.Fprintln(w, "no unused users, roles or policies")
+		return
+	}
+	for _, entity := range u.Unused {
+		name := entity.ID
+		if entity.Name != "" && entity.Name != entity.ID {
+			name = fmt.Sprintf("%s (%s)", entity.Name, entity.ID)
+		}
+		if entity.LastAccessed.IsZero() {
+			fmt.Fprintf(w, "%s %s: never used", entity.Type, name)
+			if !entity.Created.IsZero() {
+				fmt.Fprintf(w, " (created %s)", entity.Created.Format("2006-01-02"))
+			}
+			fmt.Fprintln(w)
+		} else {
+			fmt.Fprintf(w, "%s %s: last used %s\n", entity.Type, name, entity.LastAccessed.Format("2006-01-02"))
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package inspectors
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	p "github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+)
+
+func TestUnusedIAM(t *testing.T) {
+	now := time.Date(2017, 6, 1, 0, 0, 0, 0, time.UTC)
+	longAgo := now.AddDate(0, 0, -200)
+	recently := now.AddDate(0, 0, -10)
+
+	g := graph.NewGraph()
+	g.AddResource(
+		resourcetest.Role("role-stale").Prop(p.Name, "deploy").Prop(p.Created, longAgo).Prop(p.LastAccessed, now.AddDate(0, 0, -120)).Build(),
+		resourcetest.Role("role-used").Prop(p.Name, "web").Prop(p.Created, longAgo).Prop(p.LastAccessed, recently).Build(),
+		resourcetest.Role("role-new").Prop(p.Name, "batch").Prop(p.Created, recently).Build(),
+		resourcetest.User("user-never").Prop(p.Name, "bob").Prop(p.Created, longAgo).Build(),
+		resourcetest.User("user-console").Prop(p.Name, "alice").Prop(p.Created, longAgo).Prop(p.PasswordLastUsed, recently).Build(),
+		resourcetest.Policy("policy-custom").Prop(p.Name, "s3-read").Prop(p.Type, "Customer Managed").Prop(p.Created, longAgo).Build(),
+		resourcetest.Policy("policy-aws").Prop(p.Name, "ReadOnlyAccess").Prop(p.Type, "AWS Managed").Prop(p.Created, longAgo).Build(),
+	)
+
+	unused := &UnusedIAM{now: func() time.Time { return now }}
+	if err := unused.Inspect(g); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	unused.Print(&buf)
+	expected := "policy s3-read (policy-custom): never used (created 2016-11-13)\n" +
+		"role deploy (role-stale): last used 2017-02-01\n" +
+		"user bob (user-never): never used (created 2016-11-13)\n"
+	if got, want := buf.String(), expected; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+
+	unused = &UnusedIAM{Days: 150, now: func() time.Time { return now }}
+	if err := unused.Inspect(g); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(unused.Unused), 2; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+
+	t.Run("not synced", func(t *testing.T) {
+		g := graph.NewGraph()
+		g.AddResource(resourcetest.Role("role-1").Prop(p.Created, longAgo).Build())
+		if err := (&UnusedIAM{}).Inspect(g); err == nil {
+			t.Fatal("expected error without last accessed dates")
+		}
+	})
+}
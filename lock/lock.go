@@ -0,0 +1,479 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package lock provides the locks of named environments held during template runs,
+// either local (lock file) or distributed (DynamoDB table or S3 bucket) to prevent concurrent runs
+package lock
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+)
+
+// Info describes the holder of a lock
+type Info struct {
+	// ID identifies the acquisition of the lock, for only its holder to release it
+	ID       string    `json:"id"`
+	Owner    string    `json:"owner"`
+	Host     string    `json:"host,omitempty"`
+	Acquired time.Time `json:"acquired"`
+	// Expires is the date after which the lock can be acquired by others (ex: holder crashed)
+	Expires time.Time `json:"expires"`
+}
+
+// HeldError is returned when acquiring a lock held by someone else
+type HeldError struct {
+	Name   string
+	Holder Info
+}
+
+func (e *HeldError) Error() string {
+	msg := fmt.Sprintf("'%s' is locked by %s", e.Name, e.Holder.Owner)
+	if e.Holder.Host != "" {
+		msg += " on " + e.Holder.Host
+	}
+	return fmt.Sprintf("%s since %s (expires %s)", msg, e.Holder.Acquired.Format(time.RFC3339), e.Holder.Expires.Format(time.RFC3339))
+}
+
+type Locker interface {
+	// Lock acquires the named lock, returning a HeldError if held and not expired
+	Lock(name string, info Info) error
+	// Renew extends the expiration of the named lock to the one of the given info, if still held with it
+	Renew(name string, info Info) error
+	// Unlock releases the named lock if still held with the given info
+	Unlock(name string, info Info) error
+}
+
+type fileLocker struct {
+	dir string
+}
+
+// NewFileLocker returns a locker holding locks as files in the given directory,
+// preventing concurrent runs from the same machine
+func NewFileLocker(dir string) Locker {
+	return &fileLocker{dir: dir}
+}
+
+func (l *fileLocker) path(name string) string {
+	return filepath.Join(l.dir, name+".lock")
+}
+
+// staleGuardAge is the age after which the guard of a lock file is considered left by a crashed process
+const staleGuardAge = 10 * time.Second
+
+// Lock files are only created complete (linked from a temporary file) so that they are never read empty.
+// Replacing or removing an existing lock file is done under a guard file and after re-reading its holder,
+// so that concurrent takeovers of an expired lock cannot remove a lock freshly acquired by someone else.
+func (l *fileLocker) Lock(name string, info Info) error {
+	if err := os.MkdirAll(l.dir, 0700); err != nil {
+		return err
+	}
+	b, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+	for {
+		err := l.write(name, b, os.Link)
+		if !os.IsExist(err) {
+			return err
+		}
+		holder, err := l.holder(name)
+		if os.IsNotExist(err) {
+			continue // released meanwhile
+		}
+		if err != nil {
+			return err
+		}
+		if holder.Expires.After(time.Now()) {
+			return &HeldError{Name: name, Holder: holder}
+		}
+		var released bool
+		err = l.guarded(name, func() error {
+			current, err := l.holder(name)
+			if os.IsNotExist(err) {
+				released = true
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if current.ID != holder.ID {
+				return &HeldError{Name: name, Holder: current}
+			}
+			return l.write(name, b, os.Rename)
+		})
+		if err != nil {
+			return err
+		}
+		if released {
+			continue
+		}
+		if current, err := l.holder(name); err != nil {
+			return err
+		} else if current.ID != info.ID {
+			return &HeldError{Name: name, Holder: current}
+		}
+		return nil
+	}
+}
+
+func (l *fileLocker) Renew(name string, info Info) error {
+	b, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+	return l.guarded(name, func() error {
+		holder, err := l.holder(name)
+		if err != nil {
+			return err
+		}
+		if holder.ID != info.ID {
+			return &HeldError{Name: name, Holder: holder}
+		}
+		return l.write(name, b, os.Rename)
+	})
+}
+
+func (l *fileLocker) Unlock(name string, info Info) error {
+	return l.guarded(name, func() error {
+		holder, err := l.holder(name)
+		if os.IsNotExist(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if holder.ID != info.ID {
+			return &HeldError{Name: name, Holder: holder}
+		}
+		return os.Remove(l.path(name))
+	})
+}
+
+// write puts the content in place of the lock file through a complete temporary file,
+// either linked (failing if the lock file exists) or renamed (replacing it)
+func (l *fileLocker) write(name string, content []byte, place func(string, string) error) error {
+	f, err := ioutil.TempFile(l.dir, name+".lock.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	_, err = f.Write(content)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+	return place(f.Name(), l.path(name))
+}
+
+// guarded runs fn while holding the guard of the named lock file
+func (l *fileLocker) guarded(name string, fn func() error) error {
+	guard := l.path(name) + ".guard"
+	for {
+		f, err := os.OpenFile(guard, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+		if err == nil {
+			f.Close()
+			break
+		}
+		if !os.IsExist(err) {
+			return err
+		}
+		if stat, err := os.Stat(guard); err == nil && time.Since(stat.ModTime()) > staleGuardAge {
+			os.Remove(guard)
+			continue
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	defer os.Remove(guard)
+	return fn()
+}
+
+func (l *fileLocker) holder(name string) (Info, error) {
+	var info Info
+	b, err := ioutil.ReadFile(l.path(name))
+	if err != nil {
+		return info, err
+	}
+	if err := json.Unmarshal(b, &info); err != nil {
+		return info, fmt.Errorf("invalid lock file %s: %s", l.path(name), err)
+	}
+	return info, nil
+}
+
+type dynamodbLocker struct {
+	api   dynamodbiface.DynamoDBAPI
+	table string
+}
+
+// NewDynamoDBLocker returns a locker holding locks as items of the given table
+// (with a string partition key named LockName), preventing concurrent runs from any machine
+func NewDynamoDBLocker(api dynamodbiface.DynamoDBAPI, table string) Locker {
+	return &dynamodbLocker{api: api, table: table}
+}
+
+func (l *dynamodbLocker) Lock(name string, info Info) error {
+	_, err := l.api.PutItem(&dynamodb.PutItemInput{
+		TableName: aws.String(l.table),
+		Item: map[string]*dynamodb.AttributeValue{
+			"LockName": {S: aws.String(name)},
+			"LockID":   {S: aws.String(info.ID)},
+			"Owner":    {S: aws.String(info.Owner)},
+			"Host":     {S: aws.String(info.Host)},
+			"Acquired": {N: aws.String(strconv.FormatInt(info.Acquired.Unix(), 10))},
+			"Expires":  {N: aws.String(strconv.FormatInt(info.Expires.Unix(), 10))},
+		},
+		ConditionExpression:       aws.String("attribute_not_exists(#name) OR #expires < :now"),
+		ExpressionAttributeNames:  map[string]*string{"#name": aws.String("LockName"), "#expires": aws.String("Expires")},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{":now": {N: aws.String(strconv.FormatInt(time.Now().Unix(), 10))}},
+	})
+	if isConditionFailed(err) {
+		holder, herr := l.holder(name)
+		if herr != nil {
+			return fmt.Errorf("'%s' is locked: %s", name, herr)
+		}
+		return &HeldError{Name: name, Holder: holder}
+	}
+	return err
+}
+
+func (l *dynamodbLocker) Renew(name string, info Info) error {
+	_, err := l.api.UpdateItem(&dynamodb.UpdateItemInput{
+		TableName:                 aws.String(l.table),
+		Key:                       map[string]*dynamodb.AttributeValue{"LockName": {S: aws.String(name)}},
+		UpdateExpression:          aws.String("SET #expires = :expires"),
+		ConditionExpression:       aws.String("#id = :id"),
+		ExpressionAttributeNames:  map[string]*string{"#id": aws.String("LockID"), "#expires": aws.String("Expires")},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{":id": {S: aws.String(info.ID)}, ":expires": {N: aws.String(strconv.FormatInt(info.Expires.Unix(), 10))}},
+	})
+	if isConditionFailed(err) {
+		holder, herr := l.holder(name)
+		if herr != nil {
+			return fmt.Errorf("'%s' is not locked anymore: %s", name, herr)
+		}
+		return &HeldError{Name: name, Holder: holder}
+	}
+	return err
+}
+
+func (l *dynamodbLocker) Unlock(name string, info Info) error {
+	_, err := l.api.DeleteItem(&dynamodb.DeleteItemInput{
+		TableName:                 aws.String(l.table),
+		Key:                       map[string]*dynamodb.AttributeValue{"LockName": {S: aws.String(name)}},
+		ConditionExpression:       aws.String("#id = :id"),
+		ExpressionAttributeNames:  map[string]*string{"#id": aws.String("LockID")},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{":id": {S: aws.String(info.ID)}},
+	})
+	if isConditionFailed(err) {
+		holder, herr := l.holder(name)
+		if herr != nil {
+			return nil // released meanwhile
+		}
+		return &HeldError{Name: name, Holder: holder}
+	}
+	return err
+}
+
+func (l *dynamodbLocker) holder(name string) (Info, error) {
+	var info Info
+	out, err := l.api.GetItem(&dynamodb.GetItemInput{
+		TableName:      aws.String(l.table),
+		Key:            map[string]*dynamodb.AttributeValue{"LockName": {S: aws.String(name)}},
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return info, err
+	}
+	if len(out.Item) == 0 {
+		return info, fmt.Errorf("no lock item '%s'", name)
+	}
+	info.ID = stringAttribute(out.Item["LockID"])
+	info.Owner = stringAttribute(out.Item["Owner"])
+	info.Host = stringAttribute(out.Item["Host"])
+	info.Acquired = unixAttribute(out.Item["Acquired"])
+	info.Expires = unixAttribute(out.Item["Expires"])
+	return info, nil
+}
+
+func stringAttribute(v *dynamodb.AttributeValue) string {
+	if v == nil {
+		return ""
+	}
+	return aws.StringValue(v.S)
+}
+
+func unixAttribute(v *dynamodb.AttributeValue) time.Time {
+	if v == nil {
+		return time.Time{}
+	}
+	sec, _ := strconv.ParseInt(aws.StringValue(v.N), 10, 64)
+	return time.Unix(sec, 0)
+}
+
+func isConditionFailed(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == dynamodb.ErrCodeConditionalCheckFailedException
+}
+
+type s3Locker struct {
+	api            s3iface.S3API
+	bucket, prefix string
+}
+
+// NewS3Locker returns a locker holding locks as objects of the given bucket under the given key prefix,
+// preventing concurrent runs from any machine. Objects are only written with S3 conditional writes
+// (created if absent, replaced if unchanged since read), so that concurrent acquisitions cannot both succeed.
+func NewS3Locker(api s3iface.S3API, bucket, prefix string) Locker {
+	return &s3Locker{api: api, bucket: bucket, prefix: prefix}
+}
+
+func (l *s3Locker) key(name string) string {
+	return path.Join(l.prefix, name+".lock")
+}
+
+func (l *s3Locker) Lock(name string, info Info) error {
+	b, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+	for {
+		err := l.put(name, b, withHeader("If-None-Match", "*"))
+		if !isPreconditionFailed(err) {
+			return err
+		}
+		holder, etag, err := l.holder(name)
+		if isNoSuchKey(err) {
+			continue // released meanwhile
+		}
+		if err != nil {
+			return err
+		}
+		if holder.Expires.After(time.Now()) {
+			return &HeldError{Name: name, Holder: holder}
+		}
+		err = l.put(name, b, withHeader("If-Match", etag))
+		if isPreconditionFailed(err) {
+			continue // taken over or released meanwhile
+		}
+		return err
+	}
+}
+
+func (l *s3Locker) Renew(name string, info Info) error {
+	b, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+	holder, etag, err := l.holder(name)
+	if err != nil {
+		return fmt.Errorf("'%s' is not locked anymore: %s", name, err)
+	}
+	if holder.ID != info.ID {
+		return &HeldError{Name: name, Holder: holder}
+	}
+	err = l.put(name, b, withHeader("If-Match", etag))
+	if isPreconditionFailed(err) {
+		if holder, _, herr := l.holder(name); herr == nil {
+			return &HeldError{Name: name, Holder: holder}
+		}
+		return fmt.Errorf("'%s' is not locked anymore", name)
+	}
+	return err
+}
+
+func (l *s3Locker) Unlock(name string, info Info) error {
+	holder, etag, err := l.holder(name)
+	if isNoSuchKey(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if holder.ID != info.ID {
+		return &HeldError{Name: name, Holder: holder}
+	}
+	_, err = l.api.DeleteObjectWithContext(aws.BackgroundContext(), &s3.DeleteObjectInput{
+		Bucket: aws.String(l.bucket),
+		Key:    aws.String(l.key(name)),
+	}, withHeader("If-Match", etag))
+	if isPreconditionFailed(err) {
+		if holder, _, herr := l.holder(name); herr == nil {
+			return &HeldError{Name: name, Holder: holder}
+		}
+		return nil // released meanwhile
+	}
+	return err
+}
+
+func (l *s3Locker) put(name string, content []byte, condition request.Option) error {
+	_, err := l.api.PutObjectWithContext(aws.BackgroundContext(), &s3.PutObjectInput{
+		Bucket:      aws.String(l.bucket),
+		Key:         aws.String(l.key(name)),
+		Body:        bytes.NewReader(content),
+		ContentType: aws.String("application/json"),
+	}, condition)
+	return err
+}
+
+// holder returns the holder of the named lock and the ETag of its object
+func (l *s3Locker) holder(name string) (Info, string, error) {
+	var info Info
+	out, err := l.api.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(l.bucket),
+		Key:    aws.String(l.key(name)),
+	})
+	if err != nil {
+		return info, "", err
+	}
+	defer out.Body.Close()
+	if err := json.NewDecoder(out.Body).Decode(&info); err != nil {
+		return info, "", fmt.Errorf("invalid lock object s3://%s/%s: %s", l.bucket, l.key(name), err)
+	}
+	return info, aws.StringValue(out.ETag), nil
+}
+
+// withHeader sets a header of the request, used for the S3 conditional requests missing from the SDK inputs
+func withHeader(key, value string) request.Option {
+	return func(r *request.Request) {
+		r.HTTPRequest.Header.Set(key, value)
+	}
+}
+
+func isPreconditionFailed(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && (awsErr.Code() == "PreconditionFailed" || awsErr.Code() == "ConditionalRequestConflict")
+}
+
+func isNoSuchKey(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && (awsErr.Code() == s3.ErrCodeNoSuchKey || awsErr.Code() == "NotFound")
+}
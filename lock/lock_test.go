@@ -0,0 +1,239 @@
+package lock
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+)
+
+func TestLockers(t *testing.T) {
+	dir, err := ioutil.TempDir("", "awless-locks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	lockers := map[string]Locker{
+		"file":     NewFileLocker(dir),
+		"dynamodb": NewDynamoDBLocker(&dynamodbMock{items: make(map[string]map[string]*dynamodb.AttributeValue)}, "locks"),
+		"s3":       NewS3Locker(&s3Mock{objects: make(map[string]s3Object)}, "bucket", "awless/locks"),
+	}
+	for name, locker := range lockers {
+		t.Run(name, func(t *testing.T) {
+			now := time.Now()
+			alice := Info{ID: "1", Owner: "alice", Host: "laptop", Acquired: now, Expires: now.Add(time.Hour)}
+			bob := Info{ID: "2", Owner: "bob", Acquired: now, Expires: now.Add(time.Hour)}
+
+			if err := locker.Lock("prod", alice); err != nil {
+				t.Fatal(err)
+			}
+			err := locker.Lock("prod", bob)
+			held, ok := err.(*HeldError)
+			if !ok {
+				t.Fatalf("got %v, want held error", err)
+			}
+			if got, want := held.Holder.Owner, "alice"; got != want {
+				t.Fatalf("got %s, want %s", got, want)
+			}
+			if err := locker.Lock("staging", bob); err != nil {
+				t.Fatalf("expected other environment lockable, got %s", err)
+			}
+			if err := locker.Unlock("prod", bob); err == nil {
+				t.Fatal("expected unlock by non holder to fail")
+			}
+			if err := locker.Unlock("prod", alice); err != nil {
+				t.Fatal(err)
+			}
+			if err := locker.Lock("prod", bob); err != nil {
+				t.Fatal(err)
+			}
+
+			expired := Info{ID: "3", Owner: "carol", Acquired: now.Add(-2 * time.Hour), Expires: now.Add(-time.Hour)}
+			if err := locker.Lock("dev", expired); err != nil {
+				t.Fatal(err)
+			}
+			if err := locker.Lock("dev", alice); err != nil {
+				t.Fatalf("expected expired lock to be taken over, got %s", err)
+			}
+
+			renewed := alice
+			renewed.Expires = now.Add(2 * time.Hour)
+			if err := locker.Renew("dev", renewed); err != nil {
+				t.Fatal(err)
+			}
+			if _, ok := locker.Renew("dev", expired).(*HeldError); !ok {
+				t.Fatal("expected renew by non holder to fail")
+			}
+			err = locker.Lock("dev", bob)
+			if held, ok := err.(*HeldError); !ok || held.Holder.Expires.Unix() != renewed.Expires.Unix() {
+				t.Fatalf("got %v, want held until renewed expiration", err)
+			}
+		})
+	}
+}
+
+func TestConcurrentLockTakeover(t *testing.T) {
+	dir, err := ioutil.TempDir("", "awless-locks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	lockers := map[string]Locker{
+		"file": NewFileLocker(dir),
+		"s3":   NewS3Locker(&s3Mock{objects: make(map[string]s3Object)}, "bucket", "locks"),
+	}
+	for name, locker := range lockers {
+		t.Run(name, func(t *testing.T) {
+			now := time.Now()
+			if err := locker.Lock("prod", Info{ID: "expired", Acquired: now.Add(-2 * time.Hour), Expires: now.Add(-time.Hour)}); err != nil {
+				t.Fatal(err)
+			}
+
+			var wg sync.WaitGroup
+			errs := make(chan error, 10)
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go func(id int) {
+					defer wg.Done()
+					errs <- locker.Lock("prod", Info{ID: strconv.Itoa(id), Acquired: now, Expires: now.Add(time.Hour)})
+				}(i)
+			}
+			wg.Wait()
+			close(errs)
+
+			var acquired int
+			for err := range errs {
+				if err == nil {
+					acquired++
+				} else if _, ok := err.(*HeldError); !ok {
+					t.Fatalf("got %v, want held error", err)
+				}
+			}
+			if got, want := acquired, 1; got != want {
+				t.Fatalf("got %d acquisitions, want %d", got, want)
+			}
+		})
+	}
+}
+
+type dynamodbMock struct {
+	dynamodbiface.DynamoDBAPI
+	items map[string]map[string]*dynamodb.AttributeValue
+}
+
+func (m *dynamodbMock) PutItem(input *dynamodb.PutItemInput) (*dynamodb.PutItemOutput, error) {
+	name := aws.StringValue(input.Item["LockName"].S)
+	if existing, ok := m.items[name]; ok {
+		expires, _ := strconv.ParseInt(aws.StringValue(existing["Expires"].N), 10, 64)
+		now, _ := strconv.ParseInt(aws.StringValue(input.ExpressionAttributeValues[":now"].N), 10, 64)
+		if expires >= now {
+			return nil, awserr.New(dynamodb.ErrCodeConditionalCheckFailedException, "conditional check failed", nil)
+		}
+	}
+	m.items[name] = input.Item
+	return &dynamodb.PutItemOutput{}, nil
+}
+
+func (m *dynamodbMock) DeleteItem(input *dynamodb.DeleteItemInput) (*dynamodb.DeleteItemOutput, error) {
+	name := aws.StringValue(input.Key["LockName"].S)
+	existing, ok := m.items[name]
+	if !ok || aws.StringValue(existing["LockID"].S) != aws.StringValue(input.ExpressionAttributeValues[":id"].S) {
+		return nil, awserr.New(dynamodb.ErrCodeConditionalCheckFailedException, "conditional check failed", nil)
+	}
+	delete(m.items, name)
+	return &dynamodb.DeleteItemOutput{}, nil
+}
+
+func (m *dynamodbMock) UpdateItem(input *dynamodb.UpdateItemInput) (*dynamodb.UpdateItemOutput, error) {
+	name := aws.StringValue(input.Key["LockName"].S)
+	existing, ok := m.items[name]
+	if !ok || aws.StringValue(existing["LockID"].S) != aws.StringValue(input.ExpressionAttributeValues[":id"].S) {
+		return nil, awserr.New(dynamodb.ErrCodeConditionalCheckFailedException, "conditional check failed", nil)
+	}
+	existing["Expires"] = input.ExpressionAttributeValues[":expires"]
+	return &dynamodb.UpdateItemOutput{}, nil
+}
+
+func (m *dynamodbMock) GetItem(input *dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error) {
+	return &dynamodb.GetItemOutput{Item: m.items[aws.StringValue(input.Key["LockName"].S)]}, nil
+}
+
+type s3Object struct {
+	content []byte
+	etag    string
+}
+
+// s3Mock honors the conditional writes headers (If-None-Match: * and If-Match: etag) as S3 does
+type s3Mock struct {
+	s3iface.S3API
+	mu      sync.Mutex
+	objects map[string]s3Object
+	version int
+}
+
+func (m *s3Mock) conditionFailed(key string, opts []request.Option) bool {
+	r := &request.Request{HTTPRequest: &http.Request{Header: http.Header{}}}
+	for _, opt := range opts {
+		opt(r)
+	}
+	obj, exists := m.objects[key]
+	if r.HTTPRequest.Header.Get("If-None-Match") == "*" && exists {
+		return true
+	}
+	if etag := r.HTTPRequest.Header.Get("If-Match"); etag != "" && (!exists || obj.etag != etag) {
+		return true
+	}
+	return false
+}
+
+func (m *s3Mock) PutObjectWithContext(_ aws.Context, input *s3.PutObjectInput, opts ...request.Option) (*s3.PutObjectOutput, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	key := aws.StringValue(input.Bucket) + "/" + aws.StringValue(input.Key)
+	if m.conditionFailed(key, opts) {
+		return nil, awserr.New("PreconditionFailed", "At least one of the pre-conditions you specified did not hold", nil)
+	}
+	content, err := ioutil.ReadAll(input.Body)
+	if err != nil {
+		return nil, err
+	}
+	m.version++
+	m.objects[key] = s3Object{content: content, etag: fmt.Sprintf(`"%d"`, m.version)}
+	return &s3.PutObjectOutput{}, nil
+}
+
+func (m *s3Mock) DeleteObjectWithContext(_ aws.Context, input *s3.DeleteObjectInput, opts ...request.Option) (*s3.DeleteObjectOutput, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	key := aws.StringValue(input.Bucket) + "/" + aws.StringValue(input.Key)
+	if m.conditionFailed(key, opts) {
+		return nil, awserr.New("PreconditionFailed", "At least one of the pre-conditions you specified did not hold", nil)
+	}
+	delete(m.objects, key)
+	return &s3.DeleteObjectOutput{}, nil
+}
+
+func (m *s3Mock) GetObject(input *s3.GetObjectInput) (*s3.GetObjectOutput, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	obj, ok := m.objects[aws.StringValue(input.Bucket)+"/"+aws.StringValue(input.Key)]
+	if !ok {
+		return nil, awserr.New(s3.ErrCodeNoSuchKey, "The specified key does not exist.", nil)
+	}
+	return &s3.GetObjectOutput{Body: ioutil.NopCloser(bytes.NewReader(obj.content)), ETag: aws.String(obj.etag)}, nil
+}
@@ -22,6 +22,7 @@ import (
 	"io/ioutil"
 	"log"
 	"os"
+	"regexp"
 	"strings"
 	"sync/atomic"
 
@@ -38,8 +39,10 @@ const (
 
 type Logger struct {
 	verbose uint32 // atomic
+	quiet   uint32 // atomic
 	out     *log.Logger
 	w       io.Writer
+	file    *log.Logger
 }
 
 var (
@@ -48,6 +51,8 @@ var (
 	warningPrefix      = color.YellowString("[warning]")
 	verbosePrefix      = color.CyanString("[verbose]")
 	extraVerbosePrefix = color.MagentaString("[extra]  ")
+
+	colorsRegexp = regexp.MustCompile(`\x1b\[[0-9;]*[a-zA-Z]`)
 )
 
 func New(prefix string, flag int, w ...io.Writer) *Logger {
@@ -59,77 +64,107 @@ func New(prefix string, flag int, w ...io.Writer) *Logger {
 }
 
 func (l *Logger) Verbosef(format string, v ...interface{}) {
-	if l.verbosity() > 0 {
-		l.out.Println(prepend(verbosePrefix, fmt.Sprintf(format, v...))...)
-	}
+	l.log(l.verbosity() > 0 && !l.isQuiet(), verbosePrefix, fmt.Sprintf(format, v...))
 }
 
 func (l *Logger) Verbose(v ...interface{}) {
-	if l.verbosity() > 0 {
-		l.out.Println(prepend(verbosePrefix, v...)...)
-	}
+	l.log(l.verbosity() > 0 && !l.isQuiet(), verbosePrefix, v...)
 }
 
 func (l *Logger) ExtraVerbosef(format string, v ...interface{}) {
-	if l.verbosity() > 1 {
-		l.out.Println(prepend(extraVerbosePrefix, fmt.Sprintf(format, v...))...)
-	}
+	l.log(l.verbosity() > 1 && !l.isQuiet(), extraVerbosePrefix, fmt.Sprintf(format, v...))
 }
 
 func (l *Logger) ExtraVerbose(v ...interface{}) {
-	if l.verbosity() > 1 {
-		l.out.Println(prepend(extraVerbosePrefix, v...)...)
-	}
+	l.log(l.verbosity() > 1 && !l.isQuiet(), extraVerbosePrefix, v...)
 }
 
 func (l *Logger) Info(v ...interface{}) {
-	l.out.Println(prepend(infoPrefix, v...)...)
+	l.log(!l.isQuiet(), infoPrefix, v...)
 }
 
 func (l *Logger) Infof(format string, v ...interface{}) {
-	l.out.Println(prepend(infoPrefix, fmt.Sprintf(format, v...))...)
+	l.log(!l.isQuiet(), infoPrefix, fmt.Sprintf(format, v...))
 }
 
 func (l *Logger) InteractiveInfof(format string, v ...interface{}) {
-	fmt.Fprint(l.w, prepend("\r\033[K"+infoPrefix, " ", fmt.Sprintf(format, v...))...)
+	l.logToFile(infoPrefix, fmt.Sprintf(format, v...))
+	if !l.isQuiet() {
+		fmt.Fprint(l.w, prepend("\r\033[K"+infoPrefix, " ", fmt.Sprintf(format, v...))...)
+	}
 }
 
 func (l *Logger) Error(v ...interface{}) {
-	l.out.Println(prepend(errorPrefix, v...)...)
+	l.log(true, errorPrefix, v...)
 }
 
 func (l *Logger) Errorf(format string, v ...interface{}) {
-	l.out.Println(prepend(errorPrefix, fmt.Sprintf(format, v...))...)
+	l.log(true, errorPrefix, fmt.Sprintf(format, v...))
 }
 
 func (l *Logger) MultiLineError(err error) {
 	if err != nil {
 		for _, msg := range formatMultiLineErrMsg(err.Error()) {
+			l.logToFile(msg)
 			l.out.Println(color.New(color.FgRed).Sprint(msg))
 		}
 	}
 }
 
 func (l *Logger) Warning(v ...interface{}) {
-	l.out.Println(prepend(warningPrefix, v...)...)
+	l.log(!l.isQuiet(), warningPrefix, v...)
 }
 
 func (l *Logger) Warningf(format string, v ...interface{}) {
-	l.out.Println(prepend(warningPrefix, fmt.Sprintf(format, v...))...)
+	l.log(!l.isQuiet(), warningPrefix, fmt.Sprintf(format, v...))
 }
 
 func (l *Logger) Println() {
-	l.out.Println()
+	if !l.isQuiet() {
+		l.out.Println()
+	}
 }
 
 func (l *Logger) SetVerbose(level int) {
 	atomic.StoreUint32(&l.verbose, uint32(level))
 }
 
+// SetQuiet only keeps errors on the logger output
+func (l *Logger) SetQuiet(quiet bool) {
+	var q uint32
+	if quiet {
+		q = 1
+	}
+	atomic.StoreUint32(&l.quiet, q)
+}
+
+// SetFile writes all the messages to w with timestamps and without colors, whatever
+// the verbosity or quiet mode of the logger. It is meant to be set once before logging.
+func (l *Logger) SetFile(w io.Writer) {
+	l.file = log.New(w, "", log.LstdFlags)
+}
+
 func (l *Logger) verbosity() uint32 {
 	return atomic.LoadUint32(&l.verbose)
 }
 
+func (l *Logger) isQuiet() bool {
+	return atomic.LoadUint32(&l.quiet) == 1
+}
+
+func (l *Logger) log(enabled bool, prefix string, v ...interface{}) {
+	l.logToFile(prepend(prefix, v...)...)
+	if enabled {
+		l.out.Println(prepend(prefix, v...)...)
+	}
+}
+
+func (l *Logger) logToFile(v ...interface{}) {
+	if l.file != nil {
+		l.file.Print(colorsRegexp.ReplaceAllString(fmt.Sprintln(v...), ""))
+	}
+}
+
 func Verbosef(format string, v ...interface{}) {
 	DefaultLogger.Verbosef(format, v...)
 }
@@ -0,0 +1,70 @@
+package logger
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestLogger(t *testing.T) {
+	t.Run("verbosity", func(t *testing.T) {
+		var out bytes.Buffer
+		l := New("", 0, &out)
+		l.Verbose("hidden")
+		l.SetVerbose(VerboseF)
+		l.Verbosef("shown %d", 1)
+		l.ExtraVerbose("hidden")
+		if got := out.String(); strings.Contains(got, "hidden") || !strings.Contains(got, "shown 1") {
+			t.Fatalf("got %q", got)
+		}
+	})
+
+	t.Run("quiet", func(t *testing.T) {
+		var out bytes.Buffer
+		l := New("", 0, &out)
+		l.SetVerbose(VerboseF | ExtraVerboseF)
+		l.SetQuiet(true)
+		l.Info("info")
+		l.Warningf("warning")
+		l.Verbose("verbose")
+		l.ExtraVerbose("extra")
+		l.InteractiveInfof("progress")
+		l.Println()
+		if out.Len() != 0 {
+			t.Fatalf("expected nothing logged, got %q", out.String())
+		}
+		l.Errorf("failed %s", "call")
+		l.MultiLineError(errors.New("first\nsecond"))
+		for _, exp := range []string{"failed call", "first", "second"} {
+			if !strings.Contains(out.String(), exp) {
+				t.Fatalf("expected %q in %q", exp, out.String())
+			}
+		}
+	})
+
+	t.Run("file", func(t *testing.T) {
+		var out, file bytes.Buffer
+		l := New("", 0, &out)
+		l.SetQuiet(true)
+		l.SetFile(&file)
+		l.Info("info")
+		l.ExtraVerbosef("extra %s", "details")
+		l.Error("\x1b[31mcolored\x1b[0m error")
+		if strings.Contains(out.String(), "info") || strings.Contains(out.String(), "extra") {
+			t.Fatalf("got %q", out.String())
+		}
+		lines := strings.Split(strings.TrimSpace(file.String()), "\n")
+		if got, want := len(lines), 3; got != want {
+			t.Fatalf("got %d, want %d: %q", got, want, file.String())
+		}
+		for i, exp := range []string{"info", "extra details", "colored error"} {
+			if !strings.HasSuffix(lines[i], exp) {
+				t.Fatalf("%d: got %q, want suffix %q", i+1, lines[i], exp)
+			}
+		}
+		if strings.Contains(file.String(), "\x1b") {
+			t.Fatalf("expected no colors in file, got %q", file.String())
+		}
+	})
+}
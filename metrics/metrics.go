@@ -0,0 +1,208 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metrics exposes in the Prometheus text format gauges derived from the synced
+// resources (instances by state and type, unattached volumes, etc.), syncs and API calls
+package metrics
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/wallix/awless/aws/services"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+)
+
+const namespace = "awless"
+
+type syncStat struct {
+	duration    time.Duration
+	failed      bool
+	lastSuccess time.Time
+}
+
+// Collector holds the last synced graph and syncs stats, served as metrics
+type Collector struct {
+	Profile, Region string
+	APIStats        *awsservices.APIStats
+
+	mu    sync.Mutex
+	graph cloud.GraphAPI
+	syncs map[string]*syncStat
+}
+
+func NewCollector(profile, region string, apiStats *awsservices.APIStats) *Collector {
+	return &Collector{Profile: profile, Region: region, APIStats: apiStats, syncs: make(map[string]*syncStat)}
+}
+
+// SetGraph sets the graph the resources metrics are derived from
+func (c *Collector) SetGraph(g cloud.GraphAPI) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.graph = g
+}
+
+// RecordSync records the duration and outcome of the sync of a service
+func (c *Collector) RecordSync(service string, duration time.Duration, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stat, ok := c.syncs[service]
+	if !ok {
+		stat = new(syncStat)
+		c.syncs[service] = stat
+	}
+	stat.duration, stat.failed = duration, err != nil
+	if err == nil {
+		stat.lastSuccess = time.Now()
+	}
+}
+
+func (c *Collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var buf bytes.Buffer
+	if err := c.Write(&buf); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	w.Write(buf.Bytes())
+}
+
+// Write writes all the metrics in the Prometheus text format
+func (c *Collector) Write(w io.Writer) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var families []*family
+	if c.graph != nil {
+		resources, err := c.resourcesFamilies()
+		if err != nil {
+			return err
+		}
+		families = append(families, resources...)
+	}
+	families = append(families, c.syncFamilies()...)
+	if c.APIStats != nil {
+		calls := &family{name: "api_calls_total", help: "AWS API calls made by operation", typ: "counter"}
+		for _, call := range c.APIStats.All() {
+			calls.add(float64(call.Count), "service", call.Service, "operation", call.Operation)
+		}
+		families = append(families, calls)
+	}
+
+	for _, f := range families {
+		f.write(w, "profile", c.Profile, "region", c.Region)
+	}
+	return nil
+}
+
+func (c *Collector) resourcesFamilies() ([]*family, error) {
+	instances := &family{name: "instances", help: "Instances by state and type", typ: "gauge"}
+	counts := make(map[[2]string]int)
+	all, err := c.graph.Find(cloud.NewQuery(cloud.Instance))
+	if err != nil {
+		return nil, err
+	}
+	for _, inst := range all {
+		counts[[2]string{fmt.Sprint(inst.Properties()[properties.State]), fmt.Sprint(inst.Properties()[properties.Type])}]++
+	}
+	for k, count := range counts {
+		instances.add(float64(count), "state", k[0], "type", k[1])
+	}
+
+	volumes, err := c.graph.Find(cloud.NewQuery(cloud.Volume))
+	if err != nil {
+		return nil, err
+	}
+	var unattached int
+	for _, vol := range volumes {
+		if vol.Properties()[properties.State] == "available" {
+			unattached++
+		}
+	}
+	unattachedVolumes := &family{name: "volumes_unattached", help: "Volumes not attached to any instance", typ: "gauge"}
+	unattachedVolumes.add(float64(unattached))
+
+	resources := &family{name: "resources", help: "Synced resources by type", typ: "gauge"}
+	for _, typ := range awsservices.ResourceTypes {
+		res, err := c.graph.Find(cloud.NewQuery(typ))
+		if err != nil {
+			return nil, err
+		}
+		if len(res) > 0 {
+			resources.add(float64(len(res)), "type", typ)
+		}
+	}
+
+	return []*family{instances, unattachedVolumes, resources}, nil
+}
+
+func (c *Collector) syncFamilies() []*family {
+	duration := &family{name: "sync_duration_seconds", help: "Duration of the last sync by service", typ: "gauge"}
+	failed := &family{name: "sync_failed", help: "Whether the last sync of the service failed", typ: "gauge"}
+	lastSuccess := &family{name: "sync_last_success_timestamp_seconds", help: "Time of the last successful sync by service", typ: "gauge"}
+	for service, stat := range c.syncs {
+		duration.add(stat.duration.Seconds(), "service", service)
+		var f float64
+		if stat.failed {
+			f = 1
+		}
+		failed.add(f, "service", service)
+		if !stat.lastSuccess.IsZero() {
+			lastSuccess.add(float64(stat.lastSuccess.Unix()), "service", service)
+		}
+	}
+	return []*family{duration, failed, lastSuccess}
+}
+
+type family struct {
+	name, help, typ string
+	samples         []sample
+}
+
+type sample struct {
+	labels []string
+	value  float64
+}
+
+func (f *family) add(value float64, labels ...string) {
+	f.samples = append(f.samples, sample{labels: labels, value: value})
+}
+
+func (f *family) write(w io.Writer, commonLabels ...string) {
+	name := namespace + "_" + f.name
+	fmt.Fprintf(w, "# HELP %s %s\n", name, f.help)
+	fmt.Fprintf(w, "# TYPE %s %s\n", name, f.typ)
+	var lines []string
+	for _, s := range f.samples {
+		labels := append(append([]string{}, commonLabels...), s.labels...)
+		var pairs []string
+		for i := 0; i+1 < len(labels); i += 2 {
+			pairs = append(pairs, fmt.Sprintf("%s=%q", labels[i], labels[i+1]))
+		}
+		lines = append(lines, fmt.Sprintf("%s{%s} %v\n", name, strings.Join(pairs, ","), s.value))
+	}
+	sort.Strings(lines)
+	for _, l := range lines {
+		io.WriteString(w, l)
+	}
+}
@@ -0,0 +1,52 @@
+package metrics
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	p "github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+)
+
+func TestCollector(t *testing.T) {
+	g := graph.NewGraph()
+	g.AddResource(
+		resourcetest.Instance("inst-1").Prop(p.State, "running").Prop(p.Type, "t2.micro").Build(),
+		resourcetest.Instance("inst-2").Prop(p.State, "running").Prop(p.Type, "t2.micro").Build(),
+		resourcetest.Instance("inst-3").Prop(p.State, "stopped").Prop(p.Type, "m4.large").Build(),
+		resourcetest.Volume("vol-1").Prop(p.State, "available").Build(),
+		resourcetest.Volume("vol-2").Prop(p.State, "in-use").Build(),
+	)
+
+	c := NewCollector("default", "eu-west-1", nil)
+	c.SetGraph(g)
+	c.RecordSync("infra", 1500*time.Millisecond, nil)
+	c.RecordSync("dns", time.Second, errors.New("throttled"))
+
+	var buf bytes.Buffer
+	if err := c.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, line := range []string{
+		"# TYPE awless_instances gauge\n",
+		`awless_instances{profile="default",region="eu-west-1",state="running",type="t2.micro"} 2` + "\n",
+		`awless_instances{profile="default",region="eu-west-1",state="stopped",type="m4.large"} 1` + "\n",
+		`awless_volumes_unattached{profile="default",region="eu-west-1"} 1` + "\n",
+		`awless_resources{profile="default",region="eu-west-1",type="instance"} 3` + "\n",
+		`awless_sync_duration_seconds{profile="default",region="eu-west-1",service="infra"} 1.5` + "\n",
+		`awless_sync_failed{profile="default",region="eu-west-1",service="dns"} 1` + "\n",
+		`awless_sync_failed{profile="default",region="eu-west-1",service="infra"} 0` + "\n",
+	} {
+		if !strings.Contains(out, line) {
+			t.Fatalf("missing %q in\n%s", line, out)
+		}
+	}
+	if strings.Contains(out, `awless_sync_last_success_timestamp_seconds{profile="default",region="eu-west-1",service="dns"}`) {
+		t.Fatalf("unexpected last success of failed sync in\n%s", out)
+	}
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package notify posts summaries of template runs to chat channels (Slack, Microsoft Teams)
+package notify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/wallix/awless/template"
+)
+
+var httpClient = &http.Client{Timeout: 10 * time.Second}
+
+type Notifier interface {
+	Notify(*Summary) error
+}
+
+type Summary struct {
+	ID, Author, Profile, Region string
+	Message                     string
+	Duration                    time.Duration
+	OKCount, KOCount            int
+	CreatedIDs                  []string
+	Failures                    []string
+}
+
+func NewSummary(tplExec *template.TemplateExecution, duration time.Duration) *Summary {
+	stats := tplExec.Stats()
+	s := &Summary{
+		ID:       tplExec.ID,
+		Author:   tplExec.Author,
+		Profile:  tplExec.Profile,
+		Region:   tplExec.Locale,
+		Message:  tplExec.Message,
+		Duration: duration,
+		OKCount:  stats.OKCount,
+		KOCount:  stats.KOCount,
+	}
+	if s.Author == "" {
+		s.Author = "unknown"
+	}
+	if s.Message == "" {
+		s.Message = tplExec.Template.String()
+	}
+	for _, cmd := range tplExec.CommandNodesIterator() {
+		if err := cmd.Err(); err != nil {
+			s.Failures = append(s.Failures, fmt.Sprintf("%s %s: %s", cmd.Action, cmd.Entity, err))
+			continue
+		}
+		if cmd.Action == "create" && cmd.Result() != nil {
+			s.CreatedIDs = append(s.CreatedIDs, fmt.Sprint(cmd.Result()))
+		}
+	}
+	return s
+}
+
+func (s *Summary) Title() string {
+	status := "succeeded"
+	if s.KOCount > 0 {
+		status = "failed"
+	}
+	return fmt.Sprintf("awless run %s %s (%s, %s)", s.ID, status, s.Profile, s.Region)
+}
+
+func (s *Summary) Text() string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "%s by %s in %s\n", s.Message, s.Author, s.Duration.Round(time.Millisecond))
+	fmt.Fprintf(&buf, "%d/%d commands succeeded", s.OKCount, s.OKCount+s.KOCount)
+	if len(s.CreatedIDs) > 0 {
+		fmt.Fprintf(&buf, "\nCreated: %s", strings.Join(s.CreatedIDs, ", "))
+	}
+	for _, f := range s.Failures {
+		fmt.Fprintf(&buf, "\nFailed: %s", f)
+	}
+	return buf.String()
+}
+
+type slack struct {
+	webhook string
+}
+
+// Slack returns a notifier posting to a Slack incoming webhook
+func Slack(webhook string) Notifier {
+	return &slack{webhook: webhook}
+}
+
+func (n *slack) Notify(s *Summary) error {
+	return postJSON(n.webhook, map[string]interface{}{
+		"text": fmt.Sprintf("*%s*\n%s", s.Title(), s.Text()),
+	})
+}
+
+type teams struct {
+	webhook string
+}
+
+// Teams returns a notifier posting to a Microsoft Teams incoming webhook
+func Teams(webhook string) Notifier {
+	return &teams{webhook: webhook}
+}
+
+func (n *teams) Notify(s *Summary) error {
+	color := "2EB886"
+	if s.KOCount > 0 {
+		color = "D50200"
+	}
+	return postJSON(n.webhook, map[string]interface{}{
+		"@type":      "MessageCard",
+		"@context":   "https://schema.org/extensions",
+		"summary":    s.Title(),
+		"title":      s.Title(),
+		"themeColor": color,
+		"text":       strings.Replace(s.Text(), "\n", "<br>", -1),
+	})
+}
+
+func postJSON(url string, payload interface{}) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	resp, err := httpClient.Post(url, "application/json", bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("notify: unexpected response status %s", resp.Status)
+	}
+	return nil
+}
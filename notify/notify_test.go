@@ -0,0 +1,77 @@
+package notify
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wallix/awless/template"
+)
+
+func TestNewSummary(t *testing.T) {
+	tpl := template.MustParse("create vpc cidr=10.0.0.0/16\ncreate subnet cidr=10.0.0.0/24\ndelete instance id=i-1")
+	cmds := tpl.CommandNodesIterator()
+	cmds[0].CmdResult = "vpc-1234"
+	cmds[1].CmdErr = errors.New("invalid cidr")
+	cmds[2].CmdResult = "i-1"
+
+	tplExec := &template.TemplateExecution{Template: tpl, Author: "john", Profile: "default", Locale: "eu-west-1", Message: "my message"}
+	s := NewSummary(tplExec, 2*time.Second)
+
+	if got, want := s.CreatedIDs, []string{"vpc-1234"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := s.Failures, []string{"create subnet: invalid cidr"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := s.OKCount, 2; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := s.Text(), "my message by john in 2s\n2/3 commands succeeded\nCreated: vpc-1234\nFailed: create subnet: invalid cidr"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	if got, want := s.Title(), "failed (default, eu-west-1)"; !strings.HasSuffix(got, want) {
+		t.Fatalf("%s should end with %s", got, want)
+	}
+}
+
+func TestNotifiers(t *testing.T) {
+	var received map[string]interface{}
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Fatal(err)
+		}
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	s := &Summary{ID: "01ABC", Author: "john", Message: "Run create vpc", OKCount: 1, CreatedIDs: []string{"vpc-1"}}
+
+	if err := Slack(server.URL).Notify(s); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := received["text"].(string), "Created: vpc-1"; !strings.Contains(got, want) {
+		t.Fatalf("%s should contain %s", got, want)
+	}
+
+	if err := Teams(server.URL).Notify(s); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := received["@type"], "MessageCard"; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := received["title"], s.Title(); got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	status = http.StatusNotFound
+	if err := Slack(server.URL).Notify(s); err == nil {
+		t.Fatal("expected error got none")
+	}
+}
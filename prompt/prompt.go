@@ -0,0 +1,100 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package prompt controls whether awless may interactively ask the user for input.
+package prompt
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+)
+
+// Disabled turns every interactive prompt into an error (see --no-input),
+// for a safe use of awless from non interactive contexts such as CI pipelines
+var Disabled bool
+
+// ExitCode is the exit code of awless when an input is required while prompts are disabled
+const ExitCode = 3
+
+// Reasons of the inputs required, reported in the errors
+const (
+	Confirmation   = "confirmation"
+	Credentials    = "credentials"
+	MFAToken       = "mfa-token"
+	Region         = "region"
+	TemplateParam  = "template-param"
+	KeyPassphrase  = "key-passphrase"
+	UnknownHostKey = "unknown-host-key"
+	ProfileSetup   = "profile-setup"
+)
+
+// InputRequiredError is returned when an input is required while prompts are disabled
+type InputRequiredError struct {
+	Reason, Detail string
+}
+
+func (e *InputRequiredError) Error() string {
+	return fmt.Sprintf("input required (reason=%s) but prompts are disabled by --no-input: %s", e.Reason, e.Detail)
+}
+
+// Check returns an InputRequiredError when prompts are disabled
+func Check(reason, detail string) error {
+	if !Disabled {
+		return nil
+	}
+	return &InputRequiredError{Reason: reason, Detail: detail}
+}
+
+var reasonRegex = regexp.MustCompile(`input required \(reason=([a-z-]+)\)`)
+
+// Reason returns the reason of an input required error, even when
+// the error has been wrapped in another error message
+func Reason(err error) (string, bool) {
+	if err == nil {
+		return "", false
+	}
+	if e, ok := err.(*InputRequiredError); ok {
+		return e.Reason, true
+	}
+	if matches := reasonRegex.FindStringSubmatch(err.Error()); len(matches) == 2 {
+		return matches[1], true
+	}
+	return "", false
+}
+
+// WriteError writes the machine readable form of an input required error
+// as a JSON line: {"error":"input-required","reason":"...","message":"..."}
+func WriteError(w io.Writer, reason string, err error) {
+	b, _ := json.Marshal(struct {
+		Error   string `json:"error"`
+		Reason  string `json:"reason"`
+		Message string `json:"message"`
+	}{"input-required", reason, err.Error()})
+	fmt.Fprintln(w, string(b))
+}
+
+// ExitIfDisabled exits with ExitCode when prompts are disabled.
+// To be used by the prompts that cannot report an error to their caller.
+func ExitIfDisabled(reason, detail string) {
+	if err := Check(reason, detail); err != nil {
+		fmt.Fprintf(os.Stderr, "[error]   %s\n", err)
+		WriteError(os.Stderr, reason, err)
+		os.Exit(ExitCode)
+	}
+}
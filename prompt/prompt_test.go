@@ -0,0 +1,57 @@
+package prompt
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestCheck(t *testing.T) {
+	defer func(disabled bool) { Disabled = disabled }(Disabled)
+
+	Disabled = false
+	if err := Check(Confirmation, "confirm run"); err != nil {
+		t.Fatal(err)
+	}
+
+	Disabled = true
+	err := Check(Confirmation, "confirm run")
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if reason, ok := Reason(err); !ok || reason != Confirmation {
+		t.Fatalf("got %s, %t", reason, ok)
+	}
+	wrapped := fmt.Errorf("before run: %s", err)
+	if reason, ok := Reason(wrapped); !ok || reason != Confirmation {
+		t.Fatalf("got %s, %t", reason, ok)
+	}
+	if _, ok := Reason(errors.New("other error")); ok {
+		t.Fatal("expected no reason")
+	}
+	if _, ok := Reason(nil); ok {
+		t.Fatal("expected no reason")
+	}
+}
+
+func TestWriteError(t *testing.T) {
+	var buf bytes.Buffer
+	err := &InputRequiredError{Reason: MFAToken, Detail: "token code needed"}
+	WriteError(&buf, MFAToken, err)
+
+	var line map[string]string
+	if err := json.Unmarshal(buf.Bytes(), &line); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := line["error"], "input-required"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := line["reason"], MFAToken; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := line["message"], err.Error(); got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
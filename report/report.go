@@ -0,0 +1,126 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package report renders an inventory report as a standalone HTML page
+// or as an Excel (xlsx) workbook with one sheet per section.
+package report
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"time"
+)
+
+const (
+	HTML = "html"
+	XLSX = "xlsx"
+)
+
+type Report struct {
+	Title    string
+	Profile  string
+	Region   string
+	Date     time.Time
+	Sections []*Section
+}
+
+// Section is a titled part of a report made of tables and/or free text lines
+type Section struct {
+	Title  string
+	Tables []*Table
+	Lines  []string
+}
+
+type Table struct {
+	Title   string
+	Headers []string
+	Rows    [][]string
+}
+
+func New(title, profile, region string) *Report {
+	return &Report{Title: title, Profile: profile, Region: region, Date: time.Now().UTC()}
+}
+
+func (r *Report) AddSection(title string) *Section {
+	s := &Section{Title: title}
+	r.Sections = append(r.Sections, s)
+	return s
+}
+
+func (s *Section) AddTable(title string, headers []string, rows [][]string) {
+	s.Tables = append(s.Tables, &Table{Title: title, Headers: headers, Rows: rows})
+}
+
+func (s *Section) AddLines(lines ...string) {
+	s.Lines = append(s.Lines, lines...)
+}
+
+// Write writes the report in the given format (html or xlsx)
+func (r *Report) Write(w io.Writer, format string) error {
+	switch format {
+	case HTML:
+		return r.WriteHTML(w)
+	case XLSX:
+		return r.WriteXLSX(w)
+	default:
+		return fmt.Errorf("unknown report format '%s': expecting %s or %s", format, HTML, XLSX)
+	}
+}
+
+func (r *Report) WriteHTML(w io.Writer) error {
+	return htmlTemplate.Execute(w, r)
+}
+
+var htmlTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"date": func(t time.Time) string { return t.Format("Mon, 02 Jan 2006 15:04 MST") },
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+  body { font-family: Helvetica, Arial, sans-serif; color: #333; margin: 2em; }
+  h1 { color: #1d4f91; margin-bottom: 0; }
+  .meta { color: #777; margin-bottom: 2em; }
+  h2 { border-bottom: 2px solid #1d4f91; padding-bottom: .2em; margin-top: 2em; }
+  h3 { color: #555; }
+  nav a { margin-right: 1em; }
+  table { border-collapse: collapse; margin-bottom: 1.5em; font-size: .9em; }
+  th { background: #1d4f91; color: #fff; text-align: left; }
+  th, td { padding: .3em .8em; border: 1px solid #ddd; }
+  tr:nth-child(even) td { background: #f5f7fa; }
+  pre { background: #f5f7fa; padding: 1em; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<div class="meta">Profile {{.Profile}} in {{.Region}} - generated on {{date .Date}}</div>
+<nav>{{range $i, $s := .Sections}}<a href="#section-{{$i}}">{{$s.Title}}</a>{{end}}</nav>
+{{range $i, $s := .Sections}}
+<h2 id="section-{{$i}}">{{$s.Title}}</h2>
+{{range $s.Tables}}
+<h3>{{.Title}} ({{len .Rows}})</h3>
+<table>
+<tr>{{range .Headers}}<th>{{.}}</th>{{end}}</tr>
+{{range .Rows}}<tr>{{range .}}<td>{{.}}</td>{{end}}</tr>
+{{end}}</table>
+{{end}}{{if $s.Lines}}<pre>{{range $s.Lines}}{{.}}
+{{end}}</pre>{{end}}
+{{end}}
+</body>
+</html>
+`))
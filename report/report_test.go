@@ -0,0 +1,98 @@
+package report
+
+import (
+	"archive/zip"
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func newTestReport() *Report {
+	r := New("Infra report", "default", "eu-west-1")
+	infra := r.AddSection("infra")
+	infra.AddTable("Instances", []string{"ID", "Name"}, [][]string{{"i-1", "<web>"}, {"i-2", "db"}})
+	findings := r.AddSection("Security findings")
+	findings.AddLines("Buckets open to anybody: pdf-bucket")
+	return r
+}
+
+func TestWriteHTML(t *testing.T) {
+	var buf bytes.Buffer
+	if err := newTestReport().Write(&buf, HTML); err != nil {
+		t.Fatal(err)
+	}
+	for _, exp := range []string{"<title>Infra report</title>", "<h3>Instances (2)</h3>", "<td>i-1</td><td>&lt;web&gt;</td>", "Buckets open to anybody: pdf-bucket"} {
+		if !strings.Contains(buf.String(), exp) {
+			t.Fatalf("expected %q in\n%s", exp, buf.String())
+		}
+	}
+
+	if err := newTestReport().Write(&buf, "pdf"); err == nil {
+		t.Fatal("expected error got none")
+	}
+}
+
+func TestWriteXLSX(t *testing.T) {
+	var buf bytes.Buffer
+	if err := newTestReport().Write(&buf, XLSX); err != nil {
+		t.Fatal(err)
+	}
+
+	z, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	contents := make(map[string]string)
+	for _, f := range z.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, _ := ioutil.ReadAll(rc)
+		rc.Close()
+		contents[f.Name] = string(b)
+	}
+
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/_rels/workbook.xml.rels", "xl/styles.xml", "xl/worksheets/sheet1.xml", "xl/worksheets/sheet2.xml"} {
+		if _, ok := contents[name]; !ok {
+			t.Fatalf("missing %s in workbook", name)
+		}
+	}
+	if exp := `<sheet name="Security findings" sheetId="2" r:id="rId2"/>`; !strings.Contains(contents["xl/workbook.xml"], exp) {
+		t.Fatalf("expected %q in %s", exp, contents["xl/workbook.xml"])
+	}
+	if exp := `<c r="B3" t="inlineStr" s="0"><is><t xml:space="preserve">&lt;web&gt;</t></is></c>`; !strings.Contains(contents["xl/worksheets/sheet1.xml"], exp) {
+		t.Fatalf("expected %q in %s", exp, contents["xl/worksheets/sheet1.xml"])
+	}
+}
+
+func TestColumnName(t *testing.T) {
+	for i, exp := range map[int]string{0: "A", 25: "Z", 26: "AA", 27: "AB", 701: "ZZ", 702: "AAA"} {
+		if got := columnName(i); got != exp {
+			t.Fatalf("%d: got %s, want %s", i, got, exp)
+		}
+	}
+}
+
+func TestUniqueSheetName(t *testing.T) {
+	taken := make(map[string]bool)
+	for _, tc := range []struct{ title, exp string }{
+		{"infra", "infra"},
+		{"Infra", "Infra 2"},
+		{"diff [last week]", "diff (last week)"},
+		{"a very long section title going beyond the limit", "a very long section title going"},
+		{"a very long section title going beyond", "a very long section title goi 2"},
+		{"sécurité des instances exposées à internet", "sécurité des instances exposées"},
+		{"sécurité des instances exposées à tous", "sécurité des instances exposé 2"},
+	} {
+		got := uniqueSheetName(tc.title, taken)
+		if got != tc.exp {
+			t.Fatalf("got %q, want %q", got, tc.exp)
+		}
+		if !utf8.ValidString(got) {
+			t.Fatalf("invalid UTF-8 sheet name %q", got)
+		}
+	}
+}
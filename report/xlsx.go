@@ -0,0 +1,165 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package report
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	normalStyle = iota
+	headerStyle
+	titleStyle
+)
+
+// WriteXLSX writes the report as a minimal Office Open XML workbook
+// with one worksheet per section
+func (r *Report) WriteXLSX(w io.Writer) error {
+	z := zip.NewWriter(w)
+
+	var sheets, rels, overrides bytes.Buffer
+	names := make(map[string]bool)
+	for i, s := range r.Sections {
+		name := uniqueSheetName(s.Title, names)
+		fmt.Fprintf(&sheets, `<sheet name="%s" sheetId="%d" r:id="rId%d"/>`, escape(name), i+1, i+1)
+		fmt.Fprintf(&rels, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet%d.xml"/>`, i+1, i+1)
+		fmt.Fprintf(&overrides, `<Override PartName="/xl/worksheets/sheet%d.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`, i+1)
+
+		if err := writeZipFile(z, fmt.Sprintf("xl/worksheets/sheet%d.xml", i+1), sheetXML(s)); err != nil {
+			return err
+		}
+	}
+	fmt.Fprintf(&rels, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>`, len(r.Sections)+1)
+
+	files := []struct{ name, content string }{
+		{"[Content_Types].xml", xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+			`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+			`<Default Extension="xml" ContentType="application/xml"/>` +
+			`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+			`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+			overrides.String() + `</Types>`},
+		{"_rels/.rels", xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/></Relationships>`},
+		{"xl/workbook.xml", xml.Header + `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+			`<sheets>` + sheets.String() + `</sheets></workbook>`},
+		{"xl/_rels/workbook.xml.rels", xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` + rels.String() + `</Relationships>`},
+		{"xl/styles.xml", stylesXML},
+	}
+	for _, f := range files {
+		if err := writeZipFile(z, f.name, f.content); err != nil {
+			return err
+		}
+	}
+
+	return z.Close()
+}
+
+func sheetXML(s *Section) string {
+	var rows [][]string
+	var styles []int
+	add := func(style int, cells ...string) {
+		rows = append(rows, cells)
+		styles = append(styles, style)
+	}
+
+	for _, t := range s.Tables {
+		add(titleStyle, t.Title)
+		add(headerStyle, t.Headers...)
+		for _, row := range t.Rows {
+			add(normalStyle, row...)
+		}
+		add(normalStyle)
+	}
+	for _, l := range s.Lines {
+		add(normalStyle, l)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header + `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	for i, row := range rows {
+		fmt.Fprintf(&buf, `<row r="%d">`, i+1)
+		for j, cell := range row {
+			fmt.Fprintf(&buf, `<c r="%s%d" t="inlineStr" s="%d"><is><t xml:space="preserve">%s</t></is></c>`, columnName(j), i+1, styles[i], escape(cell))
+		}
+		buf.WriteString(`</row>`)
+	}
+	buf.WriteString(`</sheetData></worksheet>`)
+	return buf.String()
+}
+
+const stylesXML = xml.Header + `<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+	`<fonts count="3"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><color rgb="FFFFFFFF"/><name val="Calibri"/></font><font><b/><sz val="13"/><color rgb="FF1D4F91"/><name val="Calibri"/></font></fonts>` +
+	`<fills count="3"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill><fill><patternFill patternType="solid"><fgColor rgb="FF1D4F91"/></patternFill></fill></fills>` +
+	`<borders count="1"><border/></borders>` +
+	`<cellStyleXfs count="1"><xf/></cellStyleXfs>` +
+	`<cellXfs count="3"><xf fontId="0" fillId="0"/><xf fontId="1" fillId="2" applyFont="1" applyFill="1"/><xf fontId="2" fillId="0" applyFont="1"/></cellXfs>` +
+	`</styleSheet>`
+
+func writeZipFile(z *zip.Writer, name, content string) error {
+	f, err := z.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(f, content)
+	return err
+}
+
+// columnName returns the spreadsheet column name of the given 0-based index (A, B, ..., Z, AA, ...)
+func columnName(i int) (name string) {
+	for i++; i > 0; i = (i - 1) / 26 {
+		name = string(rune('A'+(i-1)%26)) + name
+	}
+	return
+}
+
+var invalidSheetNameChars = strings.NewReplacer(":", " ", "\\", " ", "/", " ", "?", " ", "*", " ", "[", "(", "]", ")")
+
+// maxSheetNameLen is the maximum number of characters of a spreadsheet sheet name
+const maxSheetNameLen = 31
+
+func uniqueSheetName(title string, taken map[string]bool) string {
+	name := truncateRunes(invalidSheetNameChars.Replace(title), maxSheetNameLen)
+	if name == "" {
+		name = "Sheet"
+	}
+	candidate := name
+	for i := 2; taken[strings.ToLower(candidate)]; i++ {
+		suffix := fmt.Sprintf(" %d", i)
+		candidate = truncateRunes(name, maxSheetNameLen-len(suffix)) + suffix
+	}
+	taken[strings.ToLower(candidate)] = true
+	return candidate
+}
+
+// truncateRunes truncates s to its n first characters, without splitting multi-byte characters
+func truncateRunes(s string, n int) string {
+	if runes := []rune(s); len(runes) > n {
+		return string(runes[:n])
+	}
+	return s
+}
+
+func escape(s string) string {
+	var buf bytes.Buffer
+	xml.EscapeText(&buf, []byte(s))
+	return buf.String()
+}
@@ -7,41 +7,71 @@ import (
 	"strings"
 	"syscall"
 
+	"github.com/wallix/awless/prompt"
 	"golang.org/x/crypto/ssh"
 	"golang.org/x/crypto/ssh/agent"
 	"golang.org/x/crypto/ssh/terminal"
 )
 
-func agentAuth() (ssh.AuthMethod, error) {
+func agentSigners() ([]ssh.Signer, error) {
 	sock, err := net.Dial("unix", os.Getenv("SSH_AUTH_SOCK"))
 	if err != nil {
 		return nil, err
 	}
-	return ssh.PublicKeysCallback(agent.NewClient(sock).Signers), nil
+	return agent.NewClient(sock).Signers()
+}
+
+// forwardAgent forwards the running ssh-agent to the remote host
+// (the session still needs to request it through agent.RequestAgentForwarding)
+func forwardAgent(client *ssh.Client) error {
+	sock := os.Getenv("SSH_AUTH_SOCK")
+	if sock == "" {
+		return fmt.Errorf("no SSH_AUTH_SOCK env variable set")
+	}
+	return agent.ForwardToRemote(client, sock)
 }
 
 func privateKeyAuth(priv privateKey) (ssh.AuthMethod, error) {
+	signers, err := privateKeySigners(priv)
+	if err != nil {
+		return nil, err
+	}
+	return ssh.PublicKeys(signers...), nil
+}
+
+func privateKeySigners(priv privateKey) ([]ssh.Signer, error) {
 	signer, err := ssh.ParsePrivateKey(priv.body)
 	if err != nil {
 		if strings.Contains(err.Error(), "cannot decode encrypted private keys") {
-			return encryptedPrivKeyAuth(priv)
+			return encryptedPrivKeySigners(priv)
 		}
 		return nil, err
 	}
-	return ssh.PublicKeys(signer), nil
+	return []ssh.Signer{signer}, nil
 }
 
-func encryptedPrivKeyAuth(priv privateKey) (ssh.AuthMethod, error) {
-	fmt.Fprintf(os.Stderr, "This SSH key is encrypted. Please enter passphrase for key '%s':", priv.path)
-	passphrase, err := terminal.ReadPassword(int(syscall.Stdin))
+func encryptedPrivKeySigners(priv privateKey) ([]ssh.Signer, error) {
+	passphrase, err := askKeyPassphrase(priv.path, "add it to a running ssh-agent")
 	if err != nil {
 		return nil, err
 	}
-	fmt.Fprintln(os.Stderr)
 
 	signer, err := DecryptSSHKey(priv.body, passphrase)
 	if err != nil {
 		return nil, err
 	}
-	return ssh.PublicKeys(signer), nil
+	return []ssh.Signer{signer}, nil
+}
+
+func askKeyPassphrase(path, hint string) ([]byte, error) {
+	if err := prompt.Check(prompt.KeyPassphrase, fmt.Sprintf("SSH key '%s' is encrypted: %s", path, hint)); err != nil {
+		return nil, err
+	}
+	fmt.Fprintf(os.Stderr, "This SSH key is encrypted. Please enter passphrase for key '%s':", path)
+	passphrase, err := terminal.ReadPassword(int(syscall.Stdin))
+	if err != nil {
+		return nil, err
+	}
+	fmt.Fprintln(os.Stderr)
+	return passphrase, nil
 }
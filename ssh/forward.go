@@ -0,0 +1,252 @@
+package ssh
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+)
+
+const defaultBindAddress = "localhost"
+
+// LocalForward forwards a local port to a host and port reachable from the remote host (ssh -L)
+type LocalForward struct {
+	BindAddress string
+	Port        int
+	Host        string
+	HostPort    int
+}
+
+// ParseLocalForward parses a local forward as given to ssh -L: [bind_address:]port:host:hostport
+func ParseLocalForward(spec string) (LocalForward, error) {
+	var fwd LocalForward
+	var err error
+
+	parts := strings.Split(spec, ":")
+	switch len(parts) {
+	case 3:
+		fwd.BindAddress = defaultBindAddress
+	case 4:
+		fwd.BindAddress, parts = parts[0], parts[1:]
+	default:
+		return fwd, fmt.Errorf("invalid local forward '%s': expecting [bind_address:]port:host:hostport", spec)
+	}
+	if fwd.Port, err = parsePort(parts[0]); err != nil {
+		return fwd, fmt.Errorf("invalid local forward '%s': %s", spec, err)
+	}
+	if fwd.Host = parts[1]; fwd.Host == "" {
+		return fwd, fmt.Errorf("invalid local forward '%s': empty host", spec)
+	}
+	if fwd.HostPort, err = parsePort(parts[2]); err != nil {
+		return fwd, fmt.Errorf("invalid local forward '%s': %s", spec, err)
+	}
+	return fwd, nil
+}
+
+func (f LocalForward) String() string {
+	return fmt.Sprintf("%s:%d:%s:%d", f.BindAddress, f.Port, f.Host, f.HostPort)
+}
+
+func (f LocalForward) localAddress() string {
+	return net.JoinHostPort(f.BindAddress, strconv.Itoa(f.Port))
+}
+
+func (f LocalForward) remoteAddress() string {
+	return net.JoinHostPort(f.Host, strconv.Itoa(f.HostPort))
+}
+
+// DynamicForward runs a local SOCKS proxy forwarding connections through the remote host (ssh -D)
+type DynamicForward struct {
+	BindAddress string
+	Port        int
+}
+
+// ParseDynamicForward parses a dynamic forward as given to ssh -D: [bind_address:]port
+func ParseDynamicForward(spec string) (DynamicForward, error) {
+	fwd := DynamicForward{BindAddress: defaultBindAddress}
+	port := spec
+	if i := strings.LastIndex(spec, ":"); i >= 0 {
+		fwd.BindAddress, port = spec[:i], spec[i+1:]
+	}
+	var err error
+	if fwd.Port, err = parsePort(port); err != nil {
+		return fwd, fmt.Errorf("invalid dynamic forward '%s': %s", spec, err)
+	}
+	return fwd, nil
+}
+
+func (f DynamicForward) String() string {
+	return fmt.Sprintf("%s:%d", f.BindAddress, f.Port)
+}
+
+func (f DynamicForward) localAddress() string {
+	return net.JoinHostPort(f.BindAddress, strconv.Itoa(f.Port))
+}
+
+func parsePort(s string) (int, error) {
+	port, err := strconv.Atoi(s)
+	if err != nil || port < 1 || port > 65535 {
+		return 0, fmt.Errorf("invalid port '%s'", s)
+	}
+	return port, nil
+}
+
+// startForwards listens locally for the forwards of the client,
+// for the builtin client (the ssh binary handling them otherwise)
+func (c *Client) startForwards() error {
+	for _, fwd := range c.LocalForwards {
+		l, err := net.Listen("tcp", fwd.localAddress())
+		if err != nil {
+			return fmt.Errorf("local forward %s: %s", fwd, err)
+		}
+		remote := fwd.remoteAddress()
+		c.logger.Infof("forwarding %s to %s through %s", fwd.localAddress(), remote, c.IP)
+		go c.serveForward(l, func(net.Conn) (string, error) { return remote, nil }, nil)
+	}
+	for _, fwd := range c.DynamicForwards {
+		l, err := net.Listen("tcp", fwd.localAddress())
+		if err != nil {
+			return fmt.Errorf("dynamic forward %s: %s", fwd, err)
+		}
+		c.logger.Infof("SOCKS proxy listening on %s through %s", fwd.localAddress(), c.IP)
+		go c.serveForward(l, socks5Handshake, socks5Reply)
+	}
+	return nil
+}
+
+// serveForward forwards the accepted connections to the destination they resolve to.
+// When given, reply notifies the client of the connection to the destination (SOCKS).
+func (c *Client) serveForward(l net.Listener, destination func(net.Conn) (string, error), reply func(net.Conn, bool) error) {
+	defer l.Close()
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			c.logger.ExtraVerbosef("stop forwarding %s: %s", l.Addr(), err)
+			return
+		}
+		go func() {
+			defer conn.Close()
+			addr, err := destination(conn)
+			if err != nil {
+				c.logger.ExtraVerbosef("forward from %s: %s", conn.RemoteAddr(), err)
+				return
+			}
+			remote, err := c.Dial("tcp", addr)
+			if reply != nil {
+				if rerr := reply(conn, err == nil); rerr != nil {
+					return
+				}
+			}
+			if err != nil {
+				c.logger.Warningf("forward to %s: %s", addr, err)
+				return
+			}
+			defer remote.Close()
+			pipe(conn, remote)
+		}()
+	}
+}
+
+func pipe(a, b net.Conn) {
+	done := make(chan struct{}, 2)
+	go func() { io.Copy(a, b); done <- struct{}{} }()
+	go func() { io.Copy(b, a); done <- struct{}{} }()
+	<-done
+}
+
+const (
+	socks5Version     = 0x05
+	socks5NoAuth      = 0x00
+	socks5Connect     = 0x01
+	socks5IPv4        = 0x01
+	socks5DomainName  = 0x03
+	socks5IPv6        = 0x04
+	socks5Succeeded   = 0x00
+	socks5Failure     = 0x01
+	socks5CmdNotSupp  = 0x07
+	socks5AddrNotSupp = 0x08
+)
+
+// socks5Handshake negotiates a SOCKS5 CONNECT request (without authentication)
+// and returns the requested destination address
+func socks5Handshake(conn net.Conn) (string, error) {
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return "", err
+	}
+	if header[0] != socks5Version {
+		return "", fmt.Errorf("unsupported SOCKS version %d", header[0])
+	}
+	methods := make([]byte, header[1])
+	if _, err := io.ReadFull(conn, methods); err != nil {
+		return "", err
+	}
+	var noAuth bool
+	for _, m := range methods {
+		if m == socks5NoAuth {
+			noAuth = true
+		}
+	}
+	if !noAuth {
+		conn.Write([]byte{socks5Version, 0xff})
+		return "", errors.New("SOCKS client requires authentication")
+	}
+	if _, err := conn.Write([]byte{socks5Version, socks5NoAuth}); err != nil {
+		return "", err
+	}
+
+	request := make([]byte, 4)
+	if _, err := io.ReadFull(conn, request); err != nil {
+		return "", err
+	}
+	if request[1] != socks5Connect {
+		writeSocks5Reply(conn, socks5CmdNotSupp)
+		return "", fmt.Errorf("unsupported SOCKS command %d", request[1])
+	}
+
+	var host string
+	switch request[3] {
+	case socks5IPv4, socks5IPv6:
+		ip := make(net.IP, net.IPv4len)
+		if request[3] == socks5IPv6 {
+			ip = make(net.IP, net.IPv6len)
+		}
+		if _, err := io.ReadFull(conn, ip); err != nil {
+			return "", err
+		}
+		host = ip.String()
+	case socks5DomainName:
+		length := make([]byte, 1)
+		if _, err := io.ReadFull(conn, length); err != nil {
+			return "", err
+		}
+		name := make([]byte, length[0])
+		if _, err := io.ReadFull(conn, name); err != nil {
+			return "", err
+		}
+		host = string(name)
+	default:
+		writeSocks5Reply(conn, socks5AddrNotSupp)
+		return "", fmt.Errorf("unsupported SOCKS address type %d", request[3])
+	}
+	port := make([]byte, 2)
+	if _, err := io.ReadFull(conn, port); err != nil {
+		return "", err
+	}
+	return net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(port)))), nil
+}
+
+func socks5Reply(conn net.Conn, connected bool) error {
+	if connected {
+		return writeSocks5Reply(conn, socks5Succeeded)
+	}
+	return writeSocks5Reply(conn, socks5Failure)
+}
+
+func writeSocks5Reply(conn net.Conn, status byte) error {
+	_, err := conn.Write([]byte{socks5Version, status, 0x00, socks5IPv4, 0, 0, 0, 0, 0, 0})
+	return err
+}
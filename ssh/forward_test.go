@@ -0,0 +1,102 @@
+package ssh
+
+import (
+	"bytes"
+	"net"
+	"reflect"
+	"testing"
+)
+
+func TestParseForwards(t *testing.T) {
+	t.Run("local", func(t *testing.T) {
+		tcases := []struct {
+			spec   string
+			exp    LocalForward
+			expErr bool
+		}{
+			{spec: "8080:localhost:80", exp: LocalForward{"localhost", 8080, "localhost", 80}},
+			{spec: "0.0.0.0:5432:db.internal:5432", exp: LocalForward{"0.0.0.0", 5432, "db.internal", 5432}},
+			{spec: "8080:localhost", expErr: true},
+			{spec: "http:localhost:80", expErr: true},
+			{spec: "8080::80", expErr: true},
+			{spec: "8080:localhost:70000", expErr: true},
+		}
+		for _, tcase := range tcases {
+			fwd, err := ParseLocalForward(tcase.spec)
+			if tcase.expErr {
+				if err == nil {
+					t.Fatalf("%s: expected error", tcase.spec)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatalf("%s: %s", tcase.spec, err)
+			}
+			if got, want := fwd, tcase.exp; !reflect.DeepEqual(got, want) {
+				t.Fatalf("%s: got %#v, want %#v", tcase.spec, got, want)
+			}
+		}
+	})
+
+	t.Run("dynamic", func(t *testing.T) {
+		tcases := []struct {
+			spec   string
+			exp    DynamicForward
+			expErr bool
+		}{
+			{spec: "1080", exp: DynamicForward{"localhost", 1080}},
+			{spec: "0.0.0.0:1080", exp: DynamicForward{"0.0.0.0", 1080}},
+			{spec: "socks", expErr: true},
+		}
+		for _, tcase := range tcases {
+			fwd, err := ParseDynamicForward(tcase.spec)
+			if tcase.expErr {
+				if err == nil {
+					t.Fatalf("%s: expected error", tcase.spec)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatalf("%s: %s", tcase.spec, err)
+			}
+			if got, want := fwd, tcase.exp; !reflect.DeepEqual(got, want) {
+				t.Fatalf("%s: got %#v, want %#v", tcase.spec, got, want)
+			}
+		}
+	})
+}
+
+func TestSocks5Handshake(t *testing.T) {
+	tcases := []struct {
+		request []byte
+		expAddr string
+	}{
+		{[]byte{5, 1, 0, 5, 1, 0, 1, 10, 0, 1, 12, 0x1f, 0x90}, "10.0.1.12:8080"},
+		{append(append([]byte{5, 1, 0, 5, 1, 0, 3, 11}, []byte("db.internal")...), 0x15, 0x38), "db.internal:5432"},
+	}
+	for _, tcase := range tcases {
+		client, server := net.Pipe()
+		go func() {
+			client.Write(tcase.request)
+		}()
+		methodReply := make(chan []byte)
+		go func() {
+			b := make([]byte, 2)
+			client.Read(b)
+			methodReply <- b
+		}()
+
+		addr, err := socks5Handshake(server)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := addr, tcase.expAddr; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if got, want := <-methodReply, []byte{5, 0}; !bytes.Equal(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		client.Close()
+		server.Close()
+	}
+}
@@ -0,0 +1,172 @@
+package ssh
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"os/exec"
+	"os/user"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	gossh "golang.org/x/crypto/ssh"
+)
+
+// HomeDir returns the home directory of the current user: %USERPROFILE% on Windows and $HOME otherwise
+// when set, or else the home directory of the user account
+func HomeDir() string {
+	if runtime.GOOS == "windows" {
+		if home := os.Getenv("USERPROFILE"); home != "" {
+			return home
+		}
+		if drive, path := os.Getenv("HOMEDRIVE"), os.Getenv("HOMEPATH"); drive != "" && path != "" {
+			return drive + path
+		}
+	} else if home := os.Getenv("HOME"); home != "" {
+		return home
+	}
+	if u, err := user.Current(); err == nil {
+		return u.HomeDir
+	}
+	return ""
+}
+
+// UserSSHDir returns the directory of the OpenSSH files of the current user (~/.ssh)
+func UserSSHDir() string {
+	return filepath.Join(HomeDir(), ".ssh")
+}
+
+// SavePrivateKey writes a private key readable only by its owner. On Windows, where
+// file modes do not apply, the inherited ACLs of the file are replaced with a read
+// access for the current user, as OpenSSH for Windows rejects keys readable by others.
+func SavePrivateKey(path string, key []byte) error {
+	if err := ioutil.WriteFile(path, key, 0400); err != nil {
+		return err
+	}
+	if runtime.GOOS == "windows" {
+		return restrictToCurrentUser(path)
+	}
+	return nil
+}
+
+func restrictToCurrentUser(path string) error {
+	user := os.Getenv("USERNAME")
+	if user == "" {
+		return fmt.Errorf("restricting access to %s: empty env var 'USERNAME'", path)
+	}
+	if domain := os.Getenv("USERDOMAIN"); domain != "" {
+		user = domain + `\` + user
+	}
+	out, err := exec.Command("icacls", path, "/inheritance:r", "/grant:r", user+":(R)").CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("restricting access to %s: %s: %s", path, err, bytes.TrimSpace(out))
+	}
+	return nil
+}
+
+// LoadRSAPrivateKey reads the RSA private key of the given name or path, looked up in the key folders
+// as for SSH connections, prompting for its passphrase when it is encrypted
+func LoadRSAPrivateKey(keyname string, keyFolders ...string) (*rsa.PrivateKey, error) {
+	priv, ok := findPrivateKeyFromName(keyname, keyFolders...)
+	if !ok {
+		return nil, fmt.Errorf("cannot find key '%s' (looked into %s)", keyname, strings.Join(keyFolders, ", "))
+	}
+	block, _ := pem.Decode(priv.body)
+	if block == nil {
+		return nil, fmt.Errorf("invalid key '%s': no PEM block found", priv.path)
+	}
+	der := block.Bytes
+	if x509.IsEncryptedPEMBlock(block) {
+		passphrase, err := askKeyPassphrase(priv.path, "decrypt it first with `openssl rsa`")
+		if err != nil {
+			return nil, err
+		}
+		if der, err = x509.DecryptPEMBlock(block, passphrase); err != nil {
+			return nil, fmt.Errorf("invalid key '%s': %s", priv.path, err)
+		}
+	}
+	key, err := x509.ParsePKCS1PrivateKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key '%s': %s", priv.path, err)
+	}
+	return key, nil
+}
+
+// PuTTYKeyFromPEM converts an unencrypted PEM encoded RSA private key
+// into the PuTTY private key file format (.ppk version 2)
+func PuTTYKeyFromPEM(pemKey []byte, comment string) ([]byte, error) {
+	block, _ := pem.Decode(pemKey)
+	if block == nil {
+		return nil, errors.New("putty key: no PEM block found")
+	}
+	if x509.IsEncryptedPEMBlock(block) {
+		return nil, errors.New("putty key: encrypted keys are not supported, convert it with puttygen")
+	}
+	key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("putty key: %s", err)
+	}
+	return MarshalPuTTYKey(key, comment)
+}
+
+// MarshalPuTTYKey encodes an RSA private key in the unencrypted PuTTY private key file format (.ppk version 2)
+func MarshalPuTTYKey(key *rsa.PrivateKey, comment string) ([]byte, error) {
+	if len(key.Primes) != 2 {
+		return nil, errors.New("putty key: only RSA keys with 2 primes are supported")
+	}
+	pub, err := gossh.NewPublicKey(&key.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	const algo, encryption = "ssh-rsa", "none"
+	p, q := key.Primes[0], key.Primes[1]
+	pubBlob := pub.Marshal()
+	privBlob := gossh.Marshal(struct {
+		D, P, Q, Iqmp *big.Int
+	}{key.D, p, q, new(big.Int).ModInverse(q, p)})
+
+	var macData bytes.Buffer
+	for _, field := range [][]byte{[]byte(algo), []byte(encryption), []byte(comment), pubBlob, privBlob} {
+		binary.Write(&macData, binary.BigEndian, uint32(len(field)))
+		macData.Write(field)
+	}
+	macKey := sha1.Sum([]byte("putty-private-key-file-mac-key"))
+	mac := hmac.New(sha1.New, macKey[:])
+	mac.Write(macData.Bytes())
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "PuTTY-User-Key-File-2: %s\n", algo)
+	fmt.Fprintf(&buf, "Encryption: %s\n", encryption)
+	fmt.Fprintf(&buf, "Comment: %s\n", comment)
+	writePuTTYLines(&buf, "Public-Lines", pubBlob)
+	writePuTTYLines(&buf, "Private-Lines", privBlob)
+	fmt.Fprintf(&buf, "Private-MAC: %s\n", hex.EncodeToString(mac.Sum(nil)))
+	return buf.Bytes(), nil
+}
+
+func writePuTTYLines(buf *bytes.Buffer, header string, blob []byte) {
+	const lineLength = 64
+	encoded := base64.StdEncoding.EncodeToString(blob)
+	var lines []string
+	for len(encoded) > lineLength {
+		lines = append(lines, encoded[:lineLength])
+		encoded = encoded[lineLength:]
+	}
+	lines = append(lines, encoded)
+	fmt.Fprintf(buf, "%s: %d\n", header, len(lines))
+	for _, l := range lines {
+		fmt.Fprintln(buf, l)
+	}
+}
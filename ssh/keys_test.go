@@ -0,0 +1,126 @@
+package ssh
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	gossh "golang.org/x/crypto/ssh"
+)
+
+func TestPuTTYKey(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pemKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	ppk, err := PuTTYKeyFromPEM(pemKey, "my-key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(ppk)), "\n")
+	for i, exp := range []string{"PuTTY-User-Key-File-2: ssh-rsa", "Encryption: none", "Comment: my-key"} {
+		if got := lines[i]; got != exp {
+			t.Fatalf("line %d: got %q, want %q", i+1, got, exp)
+		}
+	}
+
+	readBlob := func(lines []string, header string) ([]byte, []string) {
+		if !strings.HasPrefix(lines[0], header+": ") {
+			t.Fatalf("got %q, want %s header", lines[0], header)
+		}
+		count, err := strconv.Atoi(strings.TrimPrefix(lines[0], header+": "))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, l := range lines[1 : count+1] {
+			if len(l) > 64 {
+				t.Fatalf("line longer than 64 chars: %q", l)
+			}
+		}
+		blob, err := base64.StdEncoding.DecodeString(strings.Join(lines[1:count+1], ""))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return blob, lines[count+1:]
+	}
+	pubBlob, rest := readBlob(lines[3:], "Public-Lines")
+	privBlob, rest := readBlob(rest, "Private-Lines")
+
+	pub, err := gossh.ParsePublicKey(pubBlob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expPub, _ := gossh.NewPublicKey(&key.PublicKey)
+	if got, want := string(pub.Marshal()), string(expPub.Marshal()); got != want {
+		t.Fatal("public key mismatch")
+	}
+
+	var priv struct {
+		D, P, Q, Iqmp *big.Int
+	}
+	if err = gossh.Unmarshal(privBlob, &priv); err != nil {
+		t.Fatal(err)
+	}
+	if priv.D.Cmp(key.D) != 0 || priv.P.Cmp(key.Primes[0]) != 0 || priv.Q.Cmp(key.Primes[1]) != 0 {
+		t.Fatal("private key mismatch")
+	}
+	if got := new(big.Int).Mod(new(big.Int).Mul(priv.Iqmp, priv.Q), priv.P); got.Cmp(big.NewInt(1)) != 0 {
+		t.Fatal("iqmp is not the inverse of q mod p")
+	}
+
+	if len(rest) != 1 || !strings.HasPrefix(rest[0], "Private-MAC: ") || len(strings.TrimPrefix(rest[0], "Private-MAC: ")) != 40 {
+		t.Fatalf("got %q, want a SHA1 Private-MAC", rest)
+	}
+
+	t.Run("encrypted keys unsupported", func(t *testing.T) {
+		block, err := x509.EncryptPEMBlock(rand.Reader, "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(key), []byte("pass"), x509.PEMCipherAES256)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = PuTTYKeyFromPEM(pem.EncodeToMemory(block), "my-key"); err == nil || !strings.Contains(err.Error(), "encrypted") {
+			t.Fatalf("expected encrypted key error, got %v", err)
+		}
+		if _, err = PuTTYKeyFromPEM([]byte("not a key"), "my-key"); err == nil {
+			t.Fatal("expected error")
+		}
+	})
+}
+
+func TestLoadRSAPrivateKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keys")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pemKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	if err = SavePrivateKey(filepath.Join(dir, "my-key.pem"), pemKey); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := LoadRSAPrivateKey("my-key", filepath.Join(dir, "unknown"), dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.D.Cmp(key.D) != 0 {
+		t.Fatal("loaded key mismatch")
+	}
+	if _, err = LoadRSAPrivateKey("other-key", dir); err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,93 @@
+package ssh
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+)
+
+// CopyFile uploads content to remotePath on the connected host using
+// the scp sink mode of the remote scp binary
+func (c *Client) CopyFile(content []byte, mode os.FileMode, remotePath string) error {
+	sess, err := c.NewSession()
+	if err != nil {
+		return fmt.Errorf("scp: new session: %s", err)
+	}
+	defer sess.Close()
+
+	stdin, err := sess.StdinPipe()
+	if err != nil {
+		return fmt.Errorf("scp: %s", err)
+	}
+	var stderr bytes.Buffer
+	sess.Stderr = &stderr
+
+	if err = sess.Start(fmt.Sprintf("scp -qt %s", path.Dir(remotePath))); err != nil {
+		return fmt.Errorf("scp: %s", err)
+	}
+
+	if err = writeSCPFile(stdin, path.Base(remotePath), mode, content); err != nil {
+		return fmt.Errorf("scp: %s", err)
+	}
+	stdin.Close()
+
+	if err = sess.Wait(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf("scp: %s: %s", err, msg)
+		}
+		return fmt.Errorf("scp: %s", err)
+	}
+	c.logger.ExtraVerbosef("copied %d bytes to %s:%s", len(content), c.IP, remotePath)
+	return nil
+}
+
+// Exec runs the command on the connected host streaming its output
+func (c *Client) Exec(command string, stdout, stderr io.Writer) error {
+	sess, err := c.NewSession()
+	if err != nil {
+		return err
+	}
+	defer sess.Close()
+
+	sess.Stdout = stdout
+	sess.Stderr = stderr
+
+	c.logger.ExtraVerbosef("running '%s' on %s", command, c.IP)
+	return sess.Run(command)
+}
+
+// RunScript copies the script on the remote host, executes it and removes it
+func (c *Client) RunScript(name string, script []byte, stdout, stderr io.Writer) error {
+	remotePath := path.Join("/tmp", fmt.Sprintf("awless-%s", scriptFilenameReplacer.Replace(path.Base(name))))
+	if err := c.CopyFile(script, 0700, remotePath); err != nil {
+		return err
+	}
+	return c.Exec(runScriptCommand(remotePath), stdout, stderr)
+}
+
+// scriptFilenameReplacer removes from script names the characters breaking the scp protocol header
+var scriptFilenameReplacer = strings.NewReplacer("\n", "_", "\r", "_")
+
+func runScriptCommand(remotePath string) string {
+	quoted := shellQuote(remotePath)
+	return fmt.Sprintf("%[1]s; rc=$?; rm -f %[1]s; exit $rc", quoted)
+}
+
+// shellQuote quotes s as a single word for POSIX shells
+func shellQuote(s string) string {
+	return "'" + strings.Replace(s, "'", `'\''`, -1) + "'"
+}
+
+func writeSCPFile(w io.Writer, name string, mode os.FileMode, content []byte) error {
+	if _, err := fmt.Fprintf(w, "C%04o %d %s\n", mode.Perm(), len(content), name); err != nil {
+		return err
+	}
+	if _, err := w.Write(content); err != nil {
+		return err
+	}
+	_, err := w.Write([]byte{0})
+	return err
+}
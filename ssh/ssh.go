@@ -11,6 +11,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"runtime"
 	"strconv"
 	"strings"
 	"syscall"
@@ -18,11 +19,51 @@ import (
 	"time"
 
 	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/prompt"
 
 	gossh "golang.org/x/crypto/ssh"
 	"golang.org/x/crypto/ssh/knownhosts"
 )
 
+var DefaultAMIUsers = []string{"ec2-user", "ubuntu", "centos", "core", "bitnami", "admin", "root"}
+
+// amiUsers maps keywords of AMI names or descriptions to the default login user of their distribution.
+// Keywords are matched in order, since derived AMIs mention their base distro (ex: bitnami on ubuntu)
+var amiUsers = []struct {
+	keyword, user string
+}{
+	{"bitnami", "bitnami"},
+	{"coreos", "core"},
+	{"ubuntu", "ubuntu"},
+	{"debian", "admin"},
+	{"centos", "centos"},
+	{"fedora", "fedora"},
+	{"amzn", "ec2-user"},
+	{"amazon linux", "ec2-user"},
+	{"rhel", "ec2-user"},
+	{"red hat", "ec2-user"},
+	{"suse", "ec2-user"},
+}
+
+// UsersForAMI returns the users to try when connecting to an instance started
+// from an AMI with the given name and description: the user of the detected distribution
+// first, then the other default AMI users
+func UsersForAMI(name, description string) []string {
+	text := strings.ToLower(name + " " + description)
+	for _, u := range amiUsers {
+		if strings.Contains(text, u.keyword) {
+			users := []string{u.user}
+			for _, d := range DefaultAMIUsers {
+				if d != u.user {
+					users = append(users, d)
+				}
+			}
+			return users
+		}
+	}
+	return DefaultAMIUsers
+}
+
 type Client struct {
 	*gossh.Client
 	Config                  *gossh.ClientConfig
@@ -32,38 +73,93 @@ type Client struct {
 	HostKeyCallback         gossh.HostKeyCallback
 	StrictHostKeyChecking   bool
 	InteractiveTerminalFunc func(*gossh.Client) error
+	ForwardAgent            bool
+	LocalForwards           []LocalForward
+	DynamicForwards         []DynamicForward
+	fallbackKey             *privateKey
 	logger                  *logger.Logger
 }
 
+// InitClient resolves the SSH authentication to connect to an instance
+// started with the given key pair name: keys of the running ssh-agent are used
+// when available, the key file (looked up in the key folders) being only
+// loaded when the agent has no key or its keys are refused by the host.
 func InitClient(keyname string, keyFolders ...string) (*Client, error) {
-	var auths []gossh.AuthMethod
+	c := newClient()
 
-	privkey, ok := findPrivateKeyFromName(keyname, keyFolders...)
-	if ok {
-		if a, err := privateKeyAuth(privkey); err == nil {
-			auths = append(auths, a)
+	privkey, hasKeyFile := findPrivateKeyFromName(keyname, keyFolders...)
+	if signers, err := agentSigners(); err == nil && len(signers) > 0 {
+		c.Config.Auth = []gossh.AuthMethod{gossh.PublicKeys(signers...)}
+		if hasKeyFile {
+			c.fallbackKey = &privkey
 		}
+		return c, nil
 	}
 
-	if a, err := agentAuth(); err == nil {
-		auths = append(auths, a)
+	if !hasKeyFile {
+		return nil, fmt.Errorf("No key provided and no SSH_AUTH_SOCK env variable set, unable to resolve auth")
 	}
+	auth, err := privateKeyAuth(privkey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SSH key '%s': %s", privkey.path, err)
+	}
+	c.Config.Auth = []gossh.AuthMethod{auth}
+	c.Keypath = privkey.path
+	return c, nil
+}
 
-	if len(auths) == 0 {
-		return nil, fmt.Errorf("No key provided and no SSH_AUTH_SOCK env variable set, unable to resolve auth")
+// InitClientWithIdentity is like InitClient but always authenticates
+// with the given identity (key file path or name looked up in the key folders),
+// the keys of the running ssh-agent being only tried after it.
+func InitClientWithIdentity(identity string, keyFolders ...string) (*Client, error) {
+	privkey, ok := findPrivateKeyFromName(identity, keyFolders...)
+	if !ok {
+		return nil, fmt.Errorf("cannot find SSH key '%s' (looked into %s)", identity, strings.Join(keyFolders, ", "))
+	}
+	signers, err := privateKeySigners(privkey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SSH key '%s': %s", privkey.path, err)
 	}
+	if agentKeys, err := agentSigners(); err == nil {
+		signers = append(signers, agentKeys...)
+	}
+
+	c := newClient()
+	c.Config.Auth = []gossh.AuthMethod{gossh.PublicKeys(signers...)}
+	c.Keypath = privkey.path
+	return c, nil
+}
 
+func newClient() *Client {
 	return &Client{
 		Config: &gossh.ClientConfig{
-			Auth:            auths,
 			Timeout:         2 * time.Second,
 			HostKeyCallback: checkHostKey,
 		},
-		Keypath:                 privkey.path,
 		logger:                  logger.DiscardLogger,
 		InteractiveTerminalFunc: func(*gossh.Client) error { return nil },
 		StrictHostKeyChecking:   true,
-	}, nil
+	}
+}
+
+// useFallbackKey adds the key file to the agent keys when the latter have been refused
+func (c *Client) useFallbackKey() bool {
+	if c.fallbackKey == nil {
+		return false
+	}
+	priv := *c.fallbackKey
+	c.fallbackKey = nil
+
+	keySigners, err := privateKeySigners(priv)
+	if err != nil {
+		c.logger.Warningf("cannot use SSH key '%s': %s", priv.path, err)
+		return false
+	}
+	agentKeys, _ := agentSigners()
+	c.logger.ExtraVerbosef("ssh-agent keys refused, falling back on key file %s", priv.path)
+	c.Config.Auth = []gossh.AuthMethod{gossh.PublicKeys(append(agentKeys, keySigners...)...)}
+	c.Keypath = priv.path
+	return true
 }
 
 func (c *Client) SetLogger(l *logger.Logger) {
@@ -75,6 +171,14 @@ func (c *Client) SetStrictHostKeyChecking(hostKeyChecking bool) {
 }
 
 func (c *Client) DialWithUsers(usernames ...string) error {
+	err := c.dialWithUsers(usernames...)
+	if isAuthenticationErr(err) && c.useFallbackKey() {
+		err = c.dialWithUsers(usernames...)
+	}
+	return err
+}
+
+func (c *Client) dialWithUsers(usernames ...string) error {
 	var err error
 	var client *gossh.Client
 
@@ -100,6 +204,25 @@ func (c *Client) DialWithUsers(usernames ...string) error {
 	return fmt.Errorf("unable to authenticate to %s for users %q. Last error: %s", hostport, usernames, err)
 }
 
+func isAuthenticationErr(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "unable to authenticate")
+}
+
+func (c *Client) WaitForDial(timeout, frequency time.Duration, usernames ...string) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		err := c.DialWithUsers(usernames...)
+		if err == nil {
+			return nil
+		}
+		if time.Now().Add(frequency).After(deadline) {
+			return fmt.Errorf("timeout of %s expired: %s", timeout, err)
+		}
+		c.logger.ExtraVerbosef("%s not reachable yet, retry in %s", c.IP, frequency)
+		time.Sleep(frequency)
+	}
+}
+
 func (c *Client) NewClientWithProxy(destinationHost string, destinationPort int, usernames ...string) (*Client, error) {
 	hostport := fmt.Sprintf("%s:%d", destinationHost, destinationPort)
 	for _, user := range usernames {
@@ -128,8 +251,9 @@ func (c *Client) NewClientWithProxy(destinationHost string, destinationPort int,
 			User:    user,
 			Keypath: c.Keypath,
 			Port:    destinationPort,
-			InteractiveTerminalFunc: func(*gossh.Client) error { return nil },
+			InteractiveTerminalFunc: c.InteractiveTerminalFunc,
 			StrictHostKeyChecking:   c.StrictHostKeyChecking,
+			ForwardAgent:            c.ForwardAgent,
 			logger:                  logger.DiscardLogger,
 		}, nil
 	}
@@ -157,6 +281,9 @@ func (c *Client) Connect() (err error) {
 		if err := c.CloseAll(); err != nil {
 			c.logger.Warning("could not close properly SSH awless client before delegating")
 		}
+		if runtime.GOOS == "windows" {
+			return runLocalClient(args)
+		}
 		if c.Proxy != nil {
 			return workaroundExeCVEThroughScript(args)
 		}
@@ -164,6 +291,14 @@ func (c *Client) Connect() (err error) {
 	}
 
 	c.logger.Infof("No SSH. Fallback on builtin client. Login as '%s' on '%s'", c.User, c.IP)
+	if c.ForwardAgent {
+		if err := forwardAgent(c.Client); err != nil {
+			return fmt.Errorf("agent forwarding: %s", err)
+		}
+	}
+	if err := c.startForwards(); err != nil {
+		return err
+	}
 	return c.InteractiveTerminalFunc(c.Client)
 }
 
@@ -177,6 +312,9 @@ func (c *Client) SSHConfigString(hostname string) string {
 	if !c.StrictHostKeyChecking {
 		extraOpts["StrictHostKeychecking"] = "no"
 	}
+	if c.ForwardAgent {
+		extraOpts["ForwardAgent"] = "yes"
+	}
 	if c.Port != 22 {
 		extraOpts["Port"] = strconv.Itoa(c.Port)
 	}
@@ -188,10 +326,19 @@ func (c *Client) SSHConfigString(hostname string) string {
 		extraOpts["ProxyCommand"] = fmt.Sprintf("ssh %s %s@%s -p %d -W %%h:%%p", keyArg, c.Proxy.User, c.Proxy.IP, c.Proxy.Port)
 	}
 
+	var forwards []string
+	for _, fwd := range c.LocalForwards {
+		forwards = append(forwards, fmt.Sprintf("LocalForward %s:%d %s:%d", fwd.BindAddress, fwd.Port, fwd.Host, fwd.HostPort))
+	}
+	for _, fwd := range c.DynamicForwards {
+		forwards = append(forwards, fmt.Sprintf("DynamicForward %s", fwd))
+	}
+
 	params := struct {
 		IP, User, Name string
 		Extra          map[string]string
-	}{c.IP, c.User, hostname, extraOpts}
+		Forwards       []string
+	}{c.IP, c.User, hostname, extraOpts, forwards}
 
 	template.Must(template.New("ssh_config").Parse(`
 Host {{ .Name }}
@@ -200,6 +347,9 @@ Host {{ .Name }}
 {{- range $key, $value := .Extra }}
   {{ $key }} {{ $value -}}
 {{ end -}}
+{{- range .Forwards }}
+  {{ . -}}
+{{ end -}}
 `)).Execute(&buf, params)
 
 	return buf.String()
@@ -227,6 +377,15 @@ func (c *Client) localExec() ([]string, bool) {
 	if !c.StrictHostKeyChecking {
 		args = append(args, "-o", "StrictHostKeychecking=no")
 	}
+	if c.ForwardAgent {
+		args = append(args, "-A")
+	}
+	for _, fwd := range c.LocalForwards {
+		args = append(args, "-L", fwd.String())
+	}
+	for _, fwd := range c.DynamicForwards {
+		args = append(args, "-D", fwd.String())
+	}
 
 	args = append(args, fmt.Sprintf("%s@%s", c.User, c.IP))
 
@@ -235,7 +394,11 @@ func (c *Client) localExec() ([]string, bool) {
 		if k := c.Proxy.Keypath; len(k) > 0 {
 			keyArg = fmt.Sprintf("-i %s", k)
 		}
-		args = append(args, "-o", fmt.Sprintf("ProxyCommand='ssh %s %s@%s -p %d -W %%h:%%p'", keyArg, c.Proxy.User, c.Proxy.IP, c.Proxy.Port))
+		quote := "'"
+		if runtime.GOOS == "windows" { // single quotes are not understood by the Windows command line
+			quote = `"`
+		}
+		args = append(args, "-o", fmt.Sprintf("ProxyCommand=%[1]sssh %[2]s %[3]s@%[4]s -p %[5]d -W %%h:%%p%[1]s", quote, keyArg, c.Proxy.User, c.Proxy.IP, c.Proxy.Port))
 	}
 
 	return args, exists
@@ -304,7 +467,7 @@ func checkHostKey(hostname string, remote net.Addr, key gossh.PublicKey) error {
 	var knownHostsFiles []string
 	var fileToAddKnownKey string
 
-	opensshFile := filepath.Join(os.Getenv("HOME"), ".ssh", "known_hosts")
+	opensshFile := filepath.Join(UserSSHDir(), "known_hosts")
 	if _, err := os.Stat(opensshFile); err == nil {
 		knownHostsFiles = append(knownHostsFiles, opensshFile)
 		fileToAddKnownKey = opensshFile
@@ -328,6 +491,9 @@ func checkHostKey(hostname string, remote net.Addr, key gossh.PublicKey) error {
 		return knownhostsErr
 	}
 	if len(keyError.Want) == 0 {
+		if err := prompt.Check(prompt.UnknownHostKey, fmt.Sprintf("'%s' is an unknown host: add its key to %s or disable strict host key checking", hostname, fileToAddKnownKey)); err != nil {
+			return err
+		}
 		if trustKeyFunc(hostname, remote, key, fileToAddKnownKey) {
 			f, err := os.OpenFile(fileToAddKnownKey, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
 			if err != nil {
@@ -376,6 +542,14 @@ var trustKeyFunc func(hostname string, remote net.Addr, key gossh.PublicKey, key
 
 const tmpProxyCommandScriptFilename = "awless-ssh-proxycommand"
 
+// runLocalClient runs the local SSH client as a child process attached to the terminal,
+// since execve(2) is not available on Windows
+func runLocalClient(args []string) error {
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	return cmd.Run()
+}
+
 // This hack is used to circumvent a bug i cannot yet figure out
 // Bug: when executing syscall.Exec(args[0], args, os.Environ()) and args contains
 // the proxy command (typically args := []string{"/usr/bin/ssh", "ec2-user@172.31.78.138", "-o", "StrictHostKeychecking=no", "-o", "ProxyCommand='ssh ec2-user@52.26.181.76 -W [%h]:%p'"}
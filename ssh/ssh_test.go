@@ -1,15 +1,20 @@
 package ssh
 
 import (
+	"bytes"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
 	"io/ioutil"
 	"net"
 	"os"
 	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
 
 	gossh "golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
 )
 
 func TestInitClient(t *testing.T) {
@@ -81,8 +86,83 @@ hTSx5geAH2W73IyiTK8zIdgPMJPh69//5OhFzhQ8Ug==
 		if got, want := client.StrictHostKeyChecking, true; got != want {
 			t.Fatalf("got %t, want %t", got, want)
 		}
+
+		client, err = InitClientWithIdentity(tcase.keyname, tcase.keyfolders...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := client.Keypath, tcase.expkeypath; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	}
+
+	if _, err := InitClientWithIdentity("unknownkey", sshPath, awlessKeysPath); err == nil || !strings.Contains(err.Error(), "cannot find SSH key 'unknownkey'") {
+		t.Fatalf("got %v", err)
 	}
 
+	t.Run("with ssh-agent", func(t *testing.T) {
+		priv, err := x509.ParsePKCS1PrivateKey(pemBlockBytes(t, rawkey))
+		if err != nil {
+			t.Fatal(err)
+		}
+		keyring := agent.NewKeyring()
+		if err = keyring.Add(agent.AddedKey{PrivateKey: priv}); err != nil {
+			t.Fatal(err)
+		}
+		sock := filepath.Join(f, "agent.sock")
+		l, err := net.Listen("unix", sock)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer l.Close()
+		go func() {
+			for {
+				conn, err := l.Accept()
+				if err != nil {
+					return
+				}
+				go agent.ServeAgent(keyring, conn)
+			}
+		}()
+		defer os.Setenv("SSH_AUTH_SOCK", os.Getenv("SSH_AUTH_SOCK"))
+		os.Setenv("SSH_AUTH_SOCK", sock)
+
+		client, err := InitClient("mykey", sshPath, awlessKeysPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := client.Keypath, ""; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if client.fallbackKey == nil || client.fallbackKey.path != keypath1 {
+			t.Fatalf("expected key file %s as fallback, got %v", keypath1, client.fallbackKey)
+		}
+		if !client.useFallbackKey() {
+			t.Fatal("expected fallback key used")
+		}
+		if got, want := client.Keypath, keypath1; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if client.useFallbackKey() {
+			t.Fatal("expected fallback key used only once")
+		}
+
+		client, err = InitClient("unknownkey", sshPath, awlessKeysPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if client.fallbackKey != nil {
+			t.Fatalf("got %v, want no fallback key", client.fallbackKey)
+		}
+	})
+}
+
+func pemBlockBytes(t *testing.T, raw string) []byte {
+	block, _ := pem.Decode([]byte(raw))
+	if block == nil {
+		t.Fatal("invalid pem")
+	}
+	return block.Bytes
 }
 
 func TestCheckHostKey(t *testing.T) {
@@ -207,6 +287,19 @@ func TestCLIAndConfig(t *testing.T) {
 			"/usr/bin/ssh -o StrictHostKeychecking=no ec2-user@1.2.3.4",
 			"\nHost TestHost\n  Hostname 1.2.3.4\n  User ec2-user\n  StrictHostKeychecking no",
 		},
+		{
+			&Client{Port: 22, IP: "1.2.3.4", User: "ec2-user", StrictHostKeyChecking: true, ForwardAgent: true},
+			"/usr/bin/ssh -A ec2-user@1.2.3.4",
+			"\nHost TestHost\n  Hostname 1.2.3.4\n  User ec2-user\n  ForwardAgent yes",
+		},
+		{
+			&Client{Port: 22, IP: "1.2.3.4", User: "ec2-user", StrictHostKeyChecking: true,
+				LocalForwards:   []LocalForward{{"localhost", 8080, "localhost", 80}, {"0.0.0.0", 5432, "db.internal", 5432}},
+				DynamicForwards: []DynamicForward{{"localhost", 1080}},
+			},
+			"/usr/bin/ssh -L localhost:8080:localhost:80 -L 0.0.0.0:5432:db.internal:5432 -D localhost:1080 ec2-user@1.2.3.4",
+			"\nHost TestHost\n  Hostname 1.2.3.4\n  User ec2-user\n  LocalForward localhost:8080 localhost:80\n  LocalForward 0.0.0.0:5432 db.internal:5432\n  DynamicForward localhost:1080",
+		},
 	}
 
 	var got string
@@ -223,3 +316,61 @@ func TestCLIAndConfig(t *testing.T) {
 		}
 	}
 }
+
+func TestWriteSCPFile(t *testing.T) {
+	var buf bytes.Buffer
+	if err := writeSCPFile(&buf, "setup.sh", 0700, []byte("#!/bin/sh\necho hello\n")); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := buf.String(), "C0700 21 setup.sh\n#!/bin/sh\necho hello\n\x00"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestRunScriptCommand(t *testing.T) {
+	tcases := []struct{ path, exp string }{
+		{"/tmp/awless-setup.sh", `'/tmp/awless-setup.sh'; rc=$?; rm -f '/tmp/awless-setup.sh'; exit $rc`},
+		{"/tmp/awless-my setup.sh", `'/tmp/awless-my setup.sh'; rc=$?; rm -f '/tmp/awless-my setup.sh'; exit $rc`},
+		{"/tmp/awless-x';reboot;'.sh", `'/tmp/awless-x'\'';reboot;'\''.sh'; rc=$?; rm -f '/tmp/awless-x'\'';reboot;'\''.sh'; exit $rc`},
+	}
+	for _, tcase := range tcases {
+		if got, want := runScriptCommand(tcase.path), tcase.exp; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	}
+}
+
+func TestUsersForAMI(t *testing.T) {
+	tcases := []struct {
+		name, description string
+		expFirst          string
+	}{
+		{name: "ubuntu/images/hvm-ssd/ubuntu-xenial-16.04-amd64-server-20171121", expFirst: "ubuntu"},
+		{name: "debian-stretch-hvm-x86_64-gp2-2017-10-08-48016", expFirst: "admin"},
+		{name: "CentOS Linux 7 x86_64 HVM EBS 1708_11.01", expFirst: "centos"},
+		{name: "amzn-ami-hvm-2017.09.1.20171120-x86_64-gp2", expFirst: "ec2-user"},
+		{name: "RHEL-7.4_HVM_GA-20170808-x86_64-2-Hourly2-GP2", expFirst: "ec2-user"},
+		{name: "bitnami-wordpress-4.9.1-0-linux-ubuntu-14.04.3-x86_64-hvm-ebs", expFirst: "bitnami"},
+		{name: "CoreOS-stable-1520.8.0-hvm", description: "CoreOS Container Linux stable", expFirst: "core"},
+		{name: "my-custom-image", description: "built from Fedora 27", expFirst: "fedora"},
+		{name: "my-custom-image", expFirst: "ec2-user"},
+	}
+	for i, tcase := range tcases {
+		users := UsersForAMI(tcase.name, tcase.description)
+		if got, want := users[0], tcase.expFirst; got != want {
+			t.Fatalf("%d: got %s, want %s", i+1, got, want)
+		}
+		seen := make(map[string]bool)
+		for _, u := range users {
+			if seen[u] {
+				t.Fatalf("%d: duplicated user %s in %v", i+1, u, users)
+			}
+			seen[u] = true
+		}
+		for _, u := range DefaultAMIUsers {
+			if !seen[u] {
+				t.Fatalf("%d: missing default user %s in %v", i+1, u, users)
+			}
+		}
+	}
+}
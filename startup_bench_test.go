@@ -0,0 +1,80 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+)
+
+const startupTarget = 100 * time.Millisecond
+
+func TestMain(m *testing.M) {
+	// run as the awless CLI when re-executed by the startup benchmark
+	if os.Getenv("__AWLESS_BENCH_CLI") != "" {
+		main()
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// BenchmarkListLocalStartup measures `awless list instances --local` in a new process,
+// i.e. the whole CLI startup (packages init, config, local data loading), with 1000 synced instances.
+func BenchmarkListLocalStartup(b *testing.B) {
+	home, err := ioutil.TempDir("", "awless-startup")
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer os.RemoveAll(home)
+
+	run := func(args ...string) string {
+		cmd := exec.Command(os.Args[0], args...)
+		cmd.Env = append(os.Environ(), "__AWLESS_BENCH_CLI=1", "HOME="+home, "USERPROFILE="+home, "AWS_DEFAULT_REGION=us-east-1", "AWS_PROFILE=", "AWS_DEFAULT_PROFILE=")
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			b.Fatalf("%s: %s\n%s", strings.Join(args, " "), err, out)
+		}
+		return string(out)
+	}
+	run("config", "list", "--no-input") // first install
+
+	g := graph.NewGraph()
+	for i := 0; i < 1000; i++ {
+		inst := graph.InitResource(cloud.Instance, fmt.Sprintf("inst_%d", i))
+		inst.Properties()[properties.Name] = fmt.Sprintf("web-%d", i)
+		inst.Properties()[properties.State] = "running"
+		g.AddResource(inst)
+	}
+	dir := filepath.Join(home, ".awless", "aws", "rdf", "default", "us-east-1")
+	if err = os.MkdirAll(dir, 0700); err != nil {
+		b.Fatal(err)
+	}
+	f, err := os.Create(filepath.Join(dir, "infra.nt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	if err = g.MarshalTo(f); err != nil {
+		b.Fatal(err)
+	}
+	f.Close()
+	if out := run("list", "instances", "--local", "--no-input"); !strings.Contains(out, "inst_999") {
+		b.Fatalf("expected listed instances, got %s", out)
+	}
+
+	b.ResetTimer()
+	start := time.Now()
+	for i := 0; i < b.N; i++ {
+		run("list", "instances", "--local", "--no-input")
+	}
+	if took := time.Since(start) / time.Duration(b.N); took > startupTarget {
+		b.Errorf("startup took %s, want under %s", took, startupTarget)
+	}
+}
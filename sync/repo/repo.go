@@ -48,15 +48,19 @@ type Repo interface {
 	Commit(files ...string) error
 	List() ([]*Rev, error)
 	LoadRev(version string) (*Rev, error)
+	PreviousContents(relativePath string) ([]byte, error)
+	Contents(version, relativePath string) ([]byte, error)
 	BaseDir() string
 }
 
 type NullRepo struct{}
 
-func (NullRepo) Commit(files ...string) error         { return nil }
-func (NullRepo) List() ([]*Rev, error)                { return nil, nil }
-func (NullRepo) LoadRev(version string) (*Rev, error) { return nil, nil }
-func (NullRepo) BaseDir() string                      { return "" }
+func (NullRepo) Commit(files ...string) error            { return nil }
+func (NullRepo) List() ([]*Rev, error)                   { return nil, nil }
+func (NullRepo) LoadRev(version string) (*Rev, error)    { return nil, nil }
+func (NullRepo) PreviousContents(string) ([]byte, error) { return nil, nil }
+func (NullRepo) Contents(string, string) ([]byte, error) { return nil, nil }
+func (NullRepo) BaseDir() string                         { return "" }
 
 type gitRepo struct {
 	repo    *git.Repository
@@ -154,6 +158,76 @@ func (r *gitRepo) LoadRev(version string) (*Rev, error) {
 	return rev, nil
 }
 
+// PreviousContents returns the content of the given file as it was before
+// the last commit changing it, walking back the history from the last commit.
+// It returns nil if there is no such content.
+func (r *gitRepo) PreviousContents(relativePath string) ([]byte, error) {
+	head, err := r.repo.Head()
+	if err == plumbing.ErrReferenceNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	commit, err := r.repo.CommitObject(head.Hash())
+	if err != nil {
+		return nil, err
+	}
+	current, err := fileHash(commit, relativePath)
+	if err != nil {
+		return nil, err
+	}
+
+	for commit.NumParents() > 0 {
+		parent, err := commit.Parents().Next()
+		if err != nil {
+			return nil, err
+		}
+		previous, err := fileHash(parent, relativePath)
+		if err != nil {
+			return nil, err
+		}
+		if previous != current {
+			return fileContents(parent, relativePath)
+		}
+		commit = parent
+	}
+
+	return nil, nil
+}
+
+// Contents returns the content of the given file at the given revision.
+// It returns nil if the file does not exist at this revision.
+func (r *gitRepo) Contents(version, relativePath string) ([]byte, error) {
+	commit, err := r.repo.CommitObject(plumbing.NewHash(version))
+	if err != nil {
+		return nil, err
+	}
+	return fileContents(commit, relativePath)
+}
+
+func fileContents(commit *object.Commit, relativePath string) ([]byte, error) {
+	f, err := commit.File(filepath.ToSlash(relativePath))
+	if err == object.ErrFileNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	contents, err := f.Contents()
+	return []byte(contents), err
+}
+
+// fileHash returns the hash of the given file at the given commit, or the zero hash if it does not exist
+func fileHash(commit *object.Commit, relativePath string) (plumbing.Hash, error) {
+	f, err := commit.File(filepath.ToSlash(relativePath))
+	if err == object.ErrFileNotFound {
+		return plumbing.ZeroHash, nil
+	} else if err != nil {
+		return plumbing.ZeroHash, err
+	}
+	return f.Hash, nil
+}
+
 func unmarshalIntoGraph(g *graph.Graph, commit *object.Commit, filename string) error {
 	f, err := commit.File(filename)
 	if err != nil && err != object.ErrFileNotFound {
@@ -17,6 +17,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
@@ -38,6 +39,7 @@ var DefaultSyncer Syncer
 type Syncer interface {
 	repo.Repo
 	Sync(...cloud.Service) (map[string]cloud.GraphAPI, error)
+	SyncResourceTypes(cloud.Service, ...string) (cloud.GraphAPI, error)
 }
 
 type noopsyncer struct {
@@ -50,9 +52,22 @@ func (s *noopsyncer) Sync(services ...cloud.Service) (map[string]cloud.GraphAPI,
 	return map[string]cloud.GraphAPI{}, nil
 }
 
+func (s *noopsyncer) SyncResourceTypes(cloud.Service, ...string) (cloud.GraphAPI, error) {
+	return graph.NewGraph(), nil
+}
+
 type syncer struct {
 	repo.Repo
-	logger *logger.Logger
+	logger   *logger.Logger
+	progress func(*ServiceSync)
+}
+
+// ServiceSync reports the fetch of a service during a sync
+type ServiceSync struct {
+	Service  cloud.Service
+	Graph    cloud.GraphAPI
+	Duration time.Duration
+	Err      error
 }
 
 func NewSyncer(l ...*logger.Logger) Syncer {
@@ -72,6 +87,13 @@ func NewSyncer(l ...*logger.Logger) Syncer {
 	return s
 }
 
+// NewSyncerWithProgress returns a syncer calling progress as soon as each service is fetched
+func NewSyncerWithProgress(progress func(*ServiceSync), l ...*logger.Logger) Syncer {
+	s := NewSyncer(l...).(*syncer)
+	s.progress = progress
+	return s
+}
+
 func (s *syncer) Sync(services ...cloud.Service) (map[string]cloud.GraphAPI, error) {
 	var workers gosync.WaitGroup
 
@@ -118,6 +140,9 @@ Loop:
 			} else {
 				s.logger.ExtraVerbosef("sync: fetched %s service took %s", res.service.Name(), time.Since(res.start))
 			}
+			if s.progress != nil {
+				s.progress(&ServiceSync{Service: res.service, Graph: res.gph, Duration: time.Since(res.start), Err: res.err})
+			}
 			if serv := res.service; serv != nil {
 				servicesByName[serv.Name()] = serv
 				if res.gph != nil {
@@ -130,36 +155,12 @@ Loop:
 	var filepaths []string
 
 	for name, g := range graphs {
-		serviceRegion := servicesByName[name].Region()
-		serviceProfile := servicesByName[name].Profile()
-		serviceDir := filepath.Join(s.BaseDir(), serviceProfile, serviceRegion)
-		os.MkdirAll(serviceDir, 0700)
-
-		fullpath := filepath.Join(serviceDir, fmt.Sprintf("%s%s", name, fileExt))
-		f, err := os.OpenFile(fullpath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
-		if err != nil {
-			allErrors = append(allErrors, fmt.Errorf("opening %s: %s", fullpath, err))
-			continue
-		}
-		closeFile := func() {
-			if err := f.Close(); err != nil {
-				allErrors = append(allErrors, fmt.Errorf("closing file %s: %s", fullpath, err))
-			}
-		}
-		if err := g.MarshalTo(f); err != nil {
-			allErrors = append(allErrors, fmt.Errorf("marshal to %s: %s", fullpath, err))
-			closeFile()
-			continue
-		}
-		relPath, err := filepath.Rel(s.BaseDir(), fullpath)
+		relPath, err := s.writeServiceGraph(servicesByName[name], g)
 		if err != nil {
 			allErrors = append(allErrors, err)
-			closeFile()
 			continue
 		}
-
 		filepaths = append(filepaths, relPath)
-		closeFile()
 	}
 
 	if runtime.GOOS != "windows" { // https://github.com/wallix/awless/issues/119
@@ -171,6 +172,60 @@ Loop:
 	return graphs, concatErrors(allErrors)
 }
 
+// SyncResourceTypes fetches only the given resource types of a service
+// and replaces them in the service local graph
+func (s *syncer) SyncResourceTypes(service cloud.Service, resourceTypes ...string) (cloud.GraphAPI, error) {
+	local, ok := LoadLocalGraphForService(service.Name(), service.Profile(), service.Region()).(*graph.Graph)
+	if !ok {
+		return nil, fmt.Errorf("cannot load local graph for service %s", service.Name())
+	}
+
+	for _, t := range resourceTypes {
+		start := time.Now()
+		fetched, err := service.FetchByType(context.WithValue(context.Background(), "force", true), t)
+		if err != nil {
+			return local, fmt.Errorf("syncing %s: %s", cloud.PluralizeResource(t), err)
+		}
+		s.logger.ExtraVerbosef("sync: fetched %s took %s", cloud.PluralizeResource(t), time.Since(start))
+		if err := local.ReplaceResourcesOfType(t, fetched); err != nil {
+			return local, err
+		}
+	}
+
+	relPath, err := s.writeServiceGraph(service, local)
+	if err != nil {
+		return local, err
+	}
+
+	if runtime.GOOS != "windows" { // https://github.com/wallix/awless/issues/119
+		if err := s.Commit(relPath); err != nil {
+			return local, fmt.Errorf("committing %s: %s", relPath, err)
+		}
+	}
+
+	return local, nil
+}
+
+func (s *syncer) writeServiceGraph(service cloud.Service, g cloud.GraphAPI) (string, error) {
+	serviceDir := filepath.Join(s.BaseDir(), service.Profile(), service.Region())
+	os.MkdirAll(serviceDir, 0700)
+
+	fullpath := filepath.Join(serviceDir, fmt.Sprintf("%s%s", service.Name(), fileExt))
+	f, err := os.OpenFile(fullpath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return "", fmt.Errorf("opening %s: %s", fullpath, err)
+	}
+	if err := g.MarshalTo(f); err != nil {
+		f.Close()
+		return "", fmt.Errorf("marshal to %s: %s", fullpath, err)
+	}
+	if err := f.Close(); err != nil {
+		return "", fmt.Errorf("closing file %s: %s", fullpath, err)
+	}
+
+	return filepath.Rel(s.BaseDir(), fullpath)
+}
+
 func concatErrors(errs []error) error {
 	if len(errs) == 0 {
 		return nil
@@ -185,16 +240,50 @@ func concatErrors(errs []error) error {
 }
 
 func LoadLocalGraphForService(serviceName, profile, region string) cloud.GraphAPI {
+	g, err := graph.NewGraphFromFiles(localGraphPath(serviceName, profile, region))
+	if err != nil {
+		return graph.NewGraph()
+	}
+	return g
+}
+
+// LocalGraphAgeForService returns the time elapsed since the last sync
+// of the given service. It errors if the service has never been synced.
+func LocalGraphAgeForService(serviceName, profile, region string) (time.Duration, error) {
+	info, err := os.Stat(localGraphPath(serviceName, profile, region))
+	if err != nil {
+		return 0, fmt.Errorf("no local data for service %s", serviceName)
+	}
+	return time.Since(info.ModTime()), nil
+}
+
+func localGraphPath(serviceName, profile, region string) string {
 	regionDir := region
 	if serviceName == "access" || serviceName == "dns" || serviceName == "cdn" {
 		regionDir = "global"
 	}
-	path := filepath.Join(repo.BaseDir(), profile, regionDir, fmt.Sprintf("%s%s", serviceName, fileExt))
-	g, err := graph.NewGraphFromFiles(path)
+	return filepath.Join(repo.BaseDir(), profile, regionDir, fmt.Sprintf("%s%s", serviceName, fileExt))
+}
+
+// ImportedGraphName names the local graph of the resources imported from an export (see graph.Import)
+const ImportedGraphName = "imported"
+
+// WriteLocalGraph writes the graph as the named local graph of the profile and region,
+// loaded along the synced ones
+func WriteLocalGraph(name, profile, region string, g *graph.Graph) (string, error) {
+	fullpath := localGraphPath(name, profile, region)
+	if err := os.MkdirAll(filepath.Dir(fullpath), 0700); err != nil {
+		return "", err
+	}
+	f, err := os.OpenFile(fullpath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
 	if err != nil {
-		return graph.NewGraph()
+		return "", fmt.Errorf("opening %s: %s", fullpath, err)
 	}
-	return g
+	if err := g.MarshalTo(f); err != nil {
+		f.Close()
+		return "", fmt.Errorf("marshal to %s: %s", fullpath, err)
+	}
+	return fullpath, f.Close()
 }
 
 func LoadLocalGraphs(profile, region string) (cloud.GraphAPI, error) {
@@ -214,3 +303,59 @@ func LoadAllLocalGraphs(profile string) (cloud.GraphAPI, error) {
 
 	return graph.NewGraphFromFiles(files...)
 }
+
+// LoadLocalGraphsPerRegion returns the local graphs of the given profile
+// indexed by region. Global services are indexed under "global".
+func LoadLocalGraphsPerRegion(profile string) (map[string]cloud.GraphAPI, error) {
+	return loadGraphsPerRegion(profile, func(file string) ([]byte, error) {
+		return ioutil.ReadFile(file)
+	})
+}
+
+// LoadPreviousLocalGraphsPerRegion returns the local graphs of the given profile
+// indexed by region, each service as it was before its last synced changes
+func LoadPreviousLocalGraphsPerRegion(r repo.Repo, profile string) (map[string]cloud.GraphAPI, error) {
+	return loadGraphsPerRegion(profile, func(file string) ([]byte, error) {
+		rel, err := filepath.Rel(repo.BaseDir(), file)
+		if err != nil {
+			return nil, err
+		}
+		return r.PreviousContents(rel)
+	})
+}
+
+// LoadLocalGraphsPerRegionAt returns the local graphs of the given profile
+// indexed by region, as they were at the given revision
+func LoadLocalGraphsPerRegionAt(r repo.Repo, profile, version string) (map[string]cloud.GraphAPI, error) {
+	return loadGraphsPerRegion(profile, func(file string) ([]byte, error) {
+		rel, err := filepath.Rel(repo.BaseDir(), file)
+		if err != nil {
+			return nil, err
+		}
+		return r.Contents(version, rel)
+	})
+}
+
+func loadGraphsPerRegion(profile string, readFn func(string) ([]byte, error)) (map[string]cloud.GraphAPI, error) {
+	files, _ := filepath.Glob(filepath.Join(repo.BaseDir(), profile, "*", fmt.Sprintf("*%s", fileExt)))
+
+	graphs := make(map[string]cloud.GraphAPI)
+	for _, file := range files {
+		region := filepath.Base(filepath.Dir(file))
+		if _, ok := graphs[region]; !ok {
+			graphs[region] = graph.NewGraph()
+		}
+		content, err := readFn(file)
+		if err != nil {
+			return graphs, fmt.Errorf("reading %s: %s", file, err)
+		}
+		if len(content) == 0 {
+			continue
+		}
+		if err := graphs[region].(*graph.Graph).Unmarshal(content); err != nil {
+			return graphs, fmt.Errorf("loading %s: %s", file, err)
+		}
+	}
+
+	return graphs, nil
+}
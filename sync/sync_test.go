@@ -3,9 +3,13 @@ package sync
 import (
 	"context"
 	"os"
+	"reflect"
+	"sort"
 	"testing"
+	"time"
 
 	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/match"
 
 	"io/ioutil"
 
@@ -62,12 +66,134 @@ func TestSyncTripleFiles(t *testing.T) {
 		if got, want := info.Name(), srv.Name()+fileExt; got != want {
 			t.Fatalf("got %s, want %s", got, want)
 		}
+		age, err := LocalGraphAgeForService(srv.Name(), srv.Profile(), srv.Region())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if age < 0 || age > time.Minute {
+			t.Fatalf("unexpected local graph age %s", age)
+		}
+	}
+
+	if _, err := LocalGraphAgeForService("unknown", profile1, region1); err == nil {
+		t.Fatal("expected error got none")
+	}
+}
+
+func TestSyncResourceTypes(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "awlessunittest_")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+	os.Setenv("__AWLESS_HOME", tmpDir)
+
+	g := graph.NewGraph()
+	g.AddResource(graph.InitResource("instance", "inst_1"), graph.InitResource("instance", "inst_2"), graph.InitResource("subnet", "sub_1"))
+	fresh := graph.NewGraph()
+	fresh.AddResource(graph.InitResource("instance", "inst_2"), graph.InitResource("instance", "inst_3"))
+	srv := &mockService{g: g, name: "infra", region: "paris", profile: "admin", byType: map[string]*graph.Graph{"instance": fresh}}
+
+	syncer := NewSyncer()
+	if _, err := syncer.Sync(srv); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := syncer.SyncResourceTypes(srv, "instance"); err != nil {
+		t.Fatal(err)
+	}
+
+	local := LoadLocalGraphForService("infra", "admin", "paris")
+	for typ, ids := range map[string][]string{"instance": {"inst_2", "inst_3"}, "subnet": {"sub_1"}} {
+		res, err := local.Find(cloud.NewQuery(typ))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, r := range res {
+			got = append(got, r.Id())
+		}
+		sort.Strings(got)
+		if want := ids; !reflect.DeepEqual(got, want) {
+			t.Fatalf("%s: got %v, want %v", typ, got, want)
+		}
+	}
+
+	previous, err := LoadPreviousLocalGraphsPerRegion(syncer, "admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := previous["paris"].FindOne(cloud.NewQuery("instance").Match(match.Property("ID", "inst_1"))); err != nil {
+		t.Fatalf("expected inst_1 in previous sync: %s", err)
+	}
+	current, err := LoadLocalGraphsPerRegion("admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res, _ := current["paris"].Find(cloud.NewQuery("instance")); len(res) != 2 {
+		t.Fatalf("got %d instances, want 2", len(res))
+	}
+
+	revs, err := syncer.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	first, err := LoadLocalGraphsPerRegionAt(syncer, "admin", revs[0].Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res, _ := first["paris"].Find(cloud.NewQuery("instance")); len(res) != 2 {
+		t.Fatalf("got %d instances, want 2", len(res))
+	}
+}
+
+func TestLoadPreviousLocalGraphsPerRegion(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "awlessunittest_")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+	os.Setenv("__AWLESS_HOME", tmpDir)
+
+	first, second := graph.NewGraph(), graph.NewGraph()
+	first.AddResource(graph.InitResource("instance", "inst_1"))
+	second.AddResource(graph.InitResource("instance", "inst_1"), graph.InitResource("instance", "inst_2"))
+	other := graph.NewGraph()
+	other.AddResource(graph.InitResource("instance", "inst_3"))
+
+	syncer := NewSyncer()
+	for _, srv := range []*mockService{
+		{g: first, name: "infra", region: "paris", profile: "admin"},
+		{g: second, name: "infra", region: "paris", profile: "admin"},
+		{g: other, name: "infra", region: "bali", profile: "admin"},
+	} {
+		if _, err := syncer.Sync(srv); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	previous, err := LoadPreviousLocalGraphsPerRegion(syncer, "admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for region, want := range map[string][]string{"paris": {"inst_1"}, "bali": nil} {
+		res, err := previous[region].Find(cloud.NewQuery("instance"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, r := range res {
+			got = append(got, r.Id())
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("%s: got %v, want %v", region, got, want)
+		}
 	}
 }
 
 type mockService struct {
 	name, region, profile string
 	g                     *graph.Graph
+	byType                map[string]*graph.Graph
 }
 
 func (s *mockService) Region() string                                { return s.region }
@@ -76,6 +202,9 @@ func (s *mockService) Name() string                                  { return s.
 func (s *mockService) ResourceTypes() []string                       { return []string{} }
 func (s *mockService) Fetch(context.Context) (cloud.GraphAPI, error) { return s.g, nil }
 func (s *mockService) IsSyncDisabled() bool                          { return false }
-func (s *mockService) FetchByType(context.Context, string) (cloud.GraphAPI, error) {
+func (s *mockService) FetchByType(_ context.Context, t string) (cloud.GraphAPI, error) {
+	if g, ok := s.byType[t]; ok {
+		return g, nil
+	}
 	return nil, nil
 }
@@ -0,0 +1,73 @@
+package template
+
+import (
+	"fmt"
+
+	"github.com/wallix/awless/template/env"
+)
+
+// ResourceCounter counts the existing resources of the given type matching all the conditions (key -> value)
+type ResourceCounter func(entity string, conditions map[string]string) (int, error)
+
+// AssertionError is returned by a template run stopped on a failed assertion
+type AssertionError struct {
+	Assertion string
+	Line      int
+	Count     int
+}
+
+func (e *AssertionError) Error() string {
+	if e.Line > 0 {
+		return fmt.Sprintf("assertion failed (line %d): %s (%d matching)", e.Line, e.Assertion, e.Count)
+	}
+	return fmt.Sprintf("assertion failed: %s (%d matching)", e.Assertion, e.Count)
+}
+
+// NewRunEnvWithResourceCounter returns a run env checking the template assertions
+// (ex: assert exists vpc id=$vpc) with the given counter
+func NewRunEnvWithResourceCounter(cenv env.Compiling, count ResourceCounter, context ...map[string]interface{}) env.Running {
+	renv := newRunEnv(cenv, context...)
+	renv.countResources = count
+	return renv
+}
+
+// checkAssertions checks the assertions placed right before the statement at the given index
+// (or the trailing ones, with an index past the statements). Assertions are not checked in dry run
+// since the resources they refer to may not be created yet.
+func (s *Template) checkAssertions(renv env.Running, index int, vars map[string]interface{}) error {
+	if renv.IsDryRun() {
+		return nil
+	}
+	for _, as := range s.Assertions {
+		if as.Before != index && !(index == len(s.Statements) && as.Before > index) {
+			continue
+		}
+		var count ResourceCounter
+		if e, ok := renv.(*runEnv); ok {
+			count = e.countResources
+		}
+		if count == nil {
+			return fmt.Errorf("cannot check assertion '%s': no resource counter", as)
+		}
+		conditions := make(map[string]string)
+		for _, cond := range as.Conditions {
+			conditions[cond.Key] = cond.Value
+			if key, isRef := cond.RefKey(); isRef {
+				val, ok := vars[key]
+				if !ok || val == nil {
+					return fmt.Errorf("cannot check assertion '%s': '$%s' has no value", as, key)
+				}
+				conditions[cond.Key] = fmt.Sprint(val)
+			}
+		}
+		n, err := count(as.Entity, conditions)
+		if err != nil {
+			return fmt.Errorf("cannot check assertion '%s': %s", as, err)
+		}
+		if !as.Holds(n) {
+			return &AssertionError{Assertion: as.String(), Line: as.Line, Count: n}
+		}
+		renv.Log().Verbosef("assertion holds: %s", as)
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package template_test
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/template"
+	"github.com/wallix/awless/template/driver/fake"
+)
+
+func TestAssertions(t *testing.T) {
+	text := `env = prod
+assert count(instances where tag:env=$env and state=running) >= 2
+vpc = create vpc cidr=10.0.0.0/16 name=prod
+assert exists vpc id=$vpc
+create subnet cidr=10.0.0.0/24 vpc=$vpc
+assert not exists subnet vpc=$vpc cidr='10.0.1.0/24'`
+
+	t.Run("parsing", func(t *testing.T) {
+		tpl := template.MustParse(text)
+		if got, want := len(tpl.Assertions), 3; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+		exp := `env = prod
+assert count(instance where tag:env=$env and state=running) >= 2
+vpc = create vpc cidr=10.0.0.0/16 name=prod
+assert exists vpc id=$vpc
+create subnet cidr=10.0.0.0/24 vpc=$vpc
+assert not exists subnet vpc=$vpc cidr=10.0.1.0/24`
+		if got := tpl.String(); got != exp {
+			t.Fatalf("got\n%s\nwant\n%s", got, exp)
+		}
+		if _, err := template.Parse(tpl.String()); err != nil {
+			t.Fatal(err)
+		}
+
+		for _, invalid := range []string{
+			"assert instance\ncreate vpc cidr=10.0.0.0/16",
+			"assert count(instances) >== 2\ncreate vpc cidr=10.0.0.0/16",
+			"assert exists instance state\ncreate vpc cidr=10.0.0.0/16",
+		} {
+			if _, err := template.Parse(invalid); err == nil || !strings.Contains(err.Error(), "line 1: invalid assertion") {
+				t.Fatalf("%q: expected invalid assertion error, got %v", invalid, err)
+			}
+		}
+	})
+
+	run := func(counts map[string]int) ([]string, *template.Template, error) {
+		driver := fake.NewDriver()
+		compiled, cenv, err := template.Compile(template.MustParse(text), template.NewEnv().WithLookupCommandFunc(driver.Lookup).Build())
+		if err != nil {
+			t.Fatal(err)
+		}
+		var checked []string
+		count := func(entity string, conditions map[string]string) (int, error) {
+			var keys []string
+			for k, v := range conditions {
+				keys = append(keys, fmt.Sprintf("%s=%s", k, v))
+			}
+			checked = append(checked, fmt.Sprintf("%s %d", entity, len(keys)))
+			return counts[entity], nil
+		}
+		ran, err := compiled.Run(template.NewRunEnvWithResourceCounter(cenv, count))
+		return checked, ran, err
+	}
+
+	t.Run("holding", func(t *testing.T) {
+		checked, _, err := run(map[string]int{"instance": 2, "vpc": 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := checked, []string{"instance 2", "vpc 1", "subnet 2"}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("failing before changes", func(t *testing.T) {
+		_, ran, err := run(map[string]int{"instance": 1})
+		assertErr, ok := err.(*template.AssertionError)
+		if !ok {
+			t.Fatalf("expected assertion error, got %v", err)
+		}
+		if got, want := assertErr.Error(), "assertion failed (line 2): assert count(instance where tag:env=prod and state=running) >= 2 (1 matching)"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if got := len(ran.CommandNodesIterator()); got != 0 {
+			t.Fatalf("got %d commands run, want none", got)
+		}
+	})
+
+	t.Run("failing after changes", func(t *testing.T) {
+		_, ran, err := run(map[string]int{"instance": 3, "vpc": 1, "subnet": 1})
+		if _, ok := err.(*template.AssertionError); !ok {
+			t.Fatalf("expected assertion error, got %v", err)
+		}
+		if got, want := len(ran.CommandNodesIterator()), 2; got != want {
+			t.Fatalf("got %d commands run, want %d", got, want)
+		}
+	})
+
+	t.Run("not checked in dry run", func(t *testing.T) {
+		driver := fake.NewDriver()
+		compiled, cenv, err := template.Compile(template.MustParse(text), template.NewEnv().WithLookupCommandFunc(driver.Lookup).Build())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := compiled.DryRun(template.NewRunEnv(cenv)); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("with batched record changes", func(t *testing.T) {
+		driver := fake.NewDriver()
+		text := `create record zone=Z1 name=a.example.com type=A ttl=60 values=1.1.1.1
+create record zone=Z1 name=b.example.com type=A ttl=60 values=1.1.1.2
+assert exists record name=b.example.com
+create record zone=Z1 name=c.example.com type=A ttl=60 values=1.1.1.3
+create record zone=Z1 name=d.example.com type=A ttl=60 values=1.1.1.4 retry=2
+assert exists record name=d.example.com`
+		compiled, _, err := template.Compile(template.MustParse(text), template.NewEnv().WithLookupCommandFunc(driver.Lookup).Build())
+		if err != nil {
+			t.Fatal(err)
+		}
+		var entities []string
+		for _, cmd := range compiled.CommandNodesIterator() {
+			entities = append(entities, cmd.Entity)
+		}
+		if got, want := entities, []string{"records", "record", "record"}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		var befores []int
+		for _, as := range compiled.Assertions {
+			befores = append(befores, as.Before)
+		}
+		if got, want := befores, []int{1, 3}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("undefined reference", func(t *testing.T) {
+		driver := fake.NewDriver()
+		_, _, err := template.Compile(template.MustParse("assert exists vpc id=$vpc\nvpc = create vpc cidr=10.0.0.0/16"), template.NewEnv().WithLookupCommandFunc(driver.Lookup).Build())
+		if err == nil || !strings.Contains(err.Error(), "'$vpc' is not assigned before the assertion") {
+			t.Fatalf("expected reference error, got %v", err)
+		}
+	})
+}
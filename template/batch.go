@@ -0,0 +1,144 @@
+package template
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/internal/ast"
+)
+
+// batchRecordChangesPass coalesces consecutive record changes with the same action on the same zone
+// into a single plural command (ex: create records), so that they are applied atomically by AWS.
+// Changes assigned to a variable, referencing other commands, using failover routing or statement modifiers
+// are left untouched. Changes are never batched across a statement preceded by assertions, whose indexes are remapped.
+func batchRecordChangesPass(tpl *Template, cenv env.Compiling) (*Template, env.Compiling, error) {
+	if cenv.LookupCommandFunc() == nil {
+		return tpl, cenv, nil
+	}
+
+	asserted := make(map[int]bool)
+	for _, as := range tpl.Assertions {
+		asserted[as.Before] = true
+	}
+
+	var statements []*ast.Statement
+	var batch []*ast.Statement
+	seen := make(map[string]bool)
+	newIndexes := make([]int, len(tpl.Statements))
+
+	flush := func() error {
+		defer func() {
+			batch = nil
+			seen = make(map[string]bool)
+		}()
+		if len(batch) < 2 {
+			statements = append(statements, batch...)
+			return nil
+		}
+		first := batch[0].Node.(*ast.CommandNode)
+		var records []interface{}
+		for _, st := range batch {
+			records = append(records, recordLines(st.Node.(*ast.CommandNode))...)
+		}
+		node := &ast.CommandNode{
+			Action: first.Action, Entity: "records",
+			ParamNodes: map[string]interface{}{"zone": first.ParamNodes["zone"], "records": records},
+			Refs:       make(map[string]interface{}),
+		}
+		key := fmt.Sprintf("%s%s", node.Action, node.Entity)
+		cmd, ok := cenv.LookupCommandFunc()(key).(ast.Command)
+		if !ok || cmd == nil {
+			return fmt.Errorf("%s: cannot batch record changes: command not found", key)
+		}
+		node.Command = cmd
+		var comments []string
+		for _, st := range batch {
+			if st.Comment != "" {
+				comments = append(comments, st.Comment)
+			}
+		}
+		statements = append(statements, &ast.Statement{Node: node, Line: batch[0].Line, Comment: strings.Join(comments, "\n")})
+		return nil
+	}
+
+	for i, st := range tpl.Statements {
+		cmd, ok := st.Node.(*ast.CommandNode)
+		if !ok || !isBatchableRecordChange(cmd) {
+			if err := flush(); err != nil {
+				return tpl, cenv, err
+			}
+			newIndexes[i] = len(statements)
+			statements = append(statements, st)
+			continue
+		}
+		if len(batch) > 0 {
+			first := batch[0].Node.(*ast.CommandNode)
+			if asserted[i] || first.Action != cmd.Action || first.ParamNodes["zone"] != cmd.ParamNodes["zone"] || seen[recordSetKey(cmd)] {
+				if err := flush(); err != nil {
+					return tpl, cenv, err
+				}
+			}
+		}
+		newIndexes[i] = len(statements)
+		seen[recordSetKey(cmd)] = true
+		batch = append(batch, st)
+	}
+	if err := flush(); err != nil {
+		return tpl, cenv, err
+	}
+
+	for _, as := range tpl.Assertions {
+		if as.Before < len(tpl.Statements) {
+			as.Before = newIndexes[as.Before]
+		} else {
+			as.Before = len(statements)
+		}
+	}
+	tpl.Statements = statements
+	return tpl, cenv, nil
+}
+
+func isBatchableRecordChange(cmd *ast.CommandNode) bool {
+	if cmd.Entity != "record" || len(cmd.Refs) > 0 || cmd.Retry > 0 || cmd.Timeout > 0 {
+		return false
+	}
+	switch cmd.Action {
+	case "create", "update", "delete":
+	default:
+		return false
+	}
+	for k := range cmd.ParamNodes {
+		switch k {
+		case "zone", "name", "ttl", "type", "values":
+		default:
+			return false
+		}
+	}
+	_, isString := cmd.ParamNodes["zone"].(string)
+	return isString
+}
+
+// recordSetKey identifies the record set changed by a record command: a change batch cannot hold twice the same record set
+func recordSetKey(cmd *ast.CommandNode) string {
+	return fmt.Sprintf("%s %s", strings.ToLower(strings.TrimRight(fmt.Sprint(cmd.ParamNodes["name"]), ".")), strings.ToUpper(fmt.Sprint(cmd.ParamNodes["type"])))
+}
+
+// recordLines formats a record command as 'name ttl type value' lines, one per value
+func recordLines(cmd *ast.CommandNode) (lines []interface{}) {
+	var values []interface{}
+	switch v := cmd.ParamNodes["values"].(type) {
+	case []interface{}:
+		values = v
+	case []string:
+		for _, s := range v {
+			values = append(values, s)
+		}
+	default:
+		values = append(values, v)
+	}
+	for _, value := range values {
+		lines = append(lines, fmt.Sprintf("%v %v %v %v", cmd.ParamNodes["name"], cmd.ParamNodes["ttl"], cmd.ParamNodes["type"], value))
+	}
+	return
+}
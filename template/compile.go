@@ -15,6 +15,7 @@ type Mode []compileFunc
 var (
 	TestCompileMode = []compileFunc{
 		injectCommandsInNodesPass,
+		extractStatementModifiersPass,
 		failOnDeclarationWithNoResultPass,
 		processAndValidateParamsPass,
 		checkInvalidReferenceDeclarationsPass,
@@ -24,6 +25,7 @@ var (
 		resolveAliasPass,
 		inlineVariableValuePass,
 		resolveParamsAndExtractRefsPass,
+		markEventualConsistencyPass,
 	}
 
 	PreRevertCompileMode = []compileFunc{
@@ -32,6 +34,7 @@ var (
 
 	NewRunnerCompileMode = []compileFunc{
 		injectCommandsInNodesPass,
+		extractStatementModifiersPass,
 		failOnDeclarationWithNoResultPass,
 		processAndValidateParamsPass,
 		checkInvalidReferenceDeclarationsPass,
@@ -43,8 +46,10 @@ var (
 		failOnUnresolvedHolesPass,
 		failOnUnresolvedAliasPass,
 		resolveParamsAndExtractRefsPass,
+		markEventualConsistencyPass,
 		convertParamsPass,
 		validateCommandsPass,
+		batchRecordChangesPass,
 	}
 )
 
@@ -112,7 +117,7 @@ func failOnDeclarationWithNoResultPass(tpl *Template, cenv env.Compiling) (*Temp
 			ExtractResult(interface{}) string
 		}
 		if _, ok := cmdNode.Command.(ER); !ok {
-			return cmdErr(cmdNode, "command does not return a result, cannot assign to a variable")
+			return tpl.withLine(cmdNode, cmdErr(cmdNode, "command does not return a result, cannot assign to a variable"))
 		}
 		return nil
 	}
@@ -135,7 +140,7 @@ func processAndValidateParamsPass(tpl *Template, cenv env.Compiling) (*Template,
 			node.ParamNodes[e] = ast.NewHoleNode(normalized)
 		}
 		if err := params.Run(rule, node.Keys()); err != nil {
-			return cmdErr(node, err)
+			return tpl.withLine(node, cmdErr(node, err))
 		}
 
 		_, optionals, suggested := params.List(rule)
@@ -180,7 +185,12 @@ func resolveParamsAndExtractRefsPass(tpl *Template, cenv env.Compiling) (*Templa
 						hasRef = true
 						arr = append(arr, e)
 					case ast.ConcatenationNode:
-						arr = append(arr, e.Concat())
+						if e.HasRefs() {
+							hasRef = true
+							arr = append(arr, e)
+						} else {
+							arr = append(arr, e.Concat())
+						}
 					case ast.HoleNode, ast.AliasNode, ast.ListNode:
 						return tpl, cenv, fmt.Errorf("%s: unresolved value in list of type %T", k, e)
 					default:
@@ -194,7 +204,12 @@ func resolveParamsAndExtractRefsPass(tpl *Template, cenv env.Compiling) (*Templa
 					node.ParamNodes[k] = arr
 				}
 			case ast.ConcatenationNode:
-				node.ParamNodes[k] = paramNode.Concat()
+				if paramNode.HasRefs() {
+					node.Refs[k] = paramNode
+					delete(node.ParamNodes, k)
+				} else {
+					node.ParamNodes[k] = paramNode.Concat()
+				}
 			case ast.HoleNode, ast.AliasNode:
 				return tpl, cenv, fmt.Errorf("%s: unresolved value of type %T", k, paramNode)
 			}
@@ -216,7 +231,7 @@ func convertParamsPass(tpl *Template, cenv env.Compiling) (*Template, env.Compil
 
 			out, err := reducer.Reduce(params)
 			if err != nil {
-				return cmdErr(node, err)
+				return tpl.withLine(node, cmdErr(node, err))
 			}
 			for _, k := range reducer.Keys() {
 				delete(node.ParamNodes, k)
@@ -240,7 +255,7 @@ func convertParamsPass(tpl *Template, cenv env.Compiling) (*Template, env.Compil
 func validateCommandsPass(tpl *Template, cenv env.Compiling) (*Template, env.Compiling, error) {
 	collectValidationErrs := func(node *ast.CommandNode) error {
 		if err := params.Validate(node.ParamsSpec().Validators(), node.ParamNodes); err != nil {
-			return cmdErr(node, err)
+			return tpl.withLine(node, cmdErr(node, err))
 		}
 		return nil
 	}
@@ -267,6 +282,7 @@ func inlineVariableValuePass(tpl *Template, cenv env.Compiling) (*Template, env.
 					&ast.AST{Statements: tpl.Statements[i+1:]},
 					map[string]interface{}{decl.Ident: right.Node()},
 				)
+				inlineAssertionsRef(newTpl.Assertions, len(newTpl.Statements), decl.Ident, right.Result())
 				continue
 			}
 		}
@@ -276,6 +292,21 @@ func inlineVariableValuePass(tpl *Template, cenv env.Compiling) (*Template, env.
 	return newTpl, cenv, nil
 }
 
+// inlineAssertionsRef replaces in assertions the references to an inlined variable with its value,
+// and shifts the assertions following its removed declaration statement (at the given index)
+func inlineAssertionsRef(assertions []*ast.Assertion, index int, ident string, value interface{}) {
+	for _, as := range assertions {
+		if as.Before > index {
+			as.Before--
+		}
+		for _, cond := range as.Conditions {
+			if key, isRef := cond.RefKey(); isRef && key == ident && value != nil {
+				cond.Value = fmt.Sprint(value)
+			}
+		}
+	}
+}
+
 func resolveHolesPass(tpl *Template, cenv env.Compiling) (*Template, env.Compiling, error) {
 	processed := ast.ProcessHoles(tpl.AST, cenv.Get(env.FILLERS))
 	cenv.Push(env.PROCESSED_FILLERS, processed)
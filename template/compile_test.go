@@ -62,6 +62,28 @@ func TestParamsProcessing(t *testing.T) {
 			t.Fatalf("%s should contain %s", got, want)
 		}
 	})
+
+	t.Run("validation error with statement line", func(t *testing.T) {
+		tpl := template.MustParse("# a comment\ncreate vpc cidr=10.0.0.0/16\n\ncreate subnet vpc=vpc-1234 cidr=10.0.0.0/64")
+		_, _, err := template.Compile(tpl, env, template.NewRunnerCompileMode)
+		if err == nil {
+			t.Fatal("expected err got none")
+		}
+		if got, want := err.Error(), "line 4: create subnet: param validation:"; !strings.HasPrefix(got, want) {
+			t.Fatalf("%s should start with %s", got, want)
+		}
+	})
+
+	t.Run("cross params validation", func(t *testing.T) {
+		tpl := template.MustParse("start containertask cluster=c desired-count=1 name=n type=service")
+		_, _, err := template.Compile(tpl, env, template.NewRunnerCompileMode)
+		if err == nil {
+			t.Fatal("expected err got none")
+		}
+		if got, want := err.Error(), "missing required param 'deployment-name' when value is 'service'"; !strings.Contains(got, want) {
+			t.Fatalf("%s should contain %s", got, want)
+		}
+	})
 }
 
 func TestWholeCompilation(t *testing.T) {
@@ -153,6 +175,19 @@ create instance count=42 image=ami-1234 name='sub-2345sub-2345text-with $Special
 		},
 		{
 			tpl: `
+env = v{version}
+sub = create subnet cidr=10.0.2.0/24 vpc=@vpc name='${env}-subnet'
+create instance image=ami-1234 name='${env}-web-$${literal}' subnet=$sub
+create tag key=Name resource=$sub value='subnet ${sub} of ${ env }'
+`,
+			expect: `sub = create subnet cidr=10.0.2.0/24 name=v10-subnet vpc=vpc-1234
+create instance count=42 image=ami-1234 name='v10-web-$${literal}' subnet=$sub type=t2.micro
+create tag key=Name resource=$sub value='subnet ${sub} of v10'`,
+			expProcessedFillers:  map[string]interface{}{"version": 10, "instance.type": "t2.micro", "instance.count": 42},
+			expResolvedVariables: map[string]interface{}{"env": "v10"},
+		},
+		{
+			tpl: `
 create loadbalancer name=mylb subnets={private.subnets}
 `,
 			expect:               `create loadbalancer name=mylb subnets=[sub-1234,sub-2345]`,
@@ -0,0 +1,84 @@
+package template
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/oklog/ulid"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/internal/ast"
+)
+
+// RunConcurrently runs the commands of the template with at most the given number
+// of commands in flight. Only templates of independent commands (no declarations,
+// references or assertions) can be run concurrently, as for bulk deletions.
+// Contrary to Run, a failing command does not stop the others. With a ConcurrencyControl
+// (see NewRunEnvWithConcurrencyControl), commands are also bounded per group.
+// The commands keep their template order in the returned template.
+func (s *Template) RunConcurrently(renv env.Running, concurrency int) (*Template, error) {
+	if renv.IsDryRun() {
+		return s.Run(renv)
+	}
+	if err := s.checkConcurrentRun(); err != nil {
+		return nil, err
+	}
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	current := &Template{AST: &ast.AST{Outputs: s.Outputs}}
+	current.ID = ulid.MustNew(ulid.Timestamp(time.Now()), rand.Reader).String()
+
+	var nodes []*ast.CommandNode
+	var lines []int
+	for _, sts := range s.Statements {
+		clone := sts.Clone()
+		current.Statements = append(current.Statements, clone)
+		nodes = append(nodes, clone.Node.(*ast.CommandNode))
+		lines = append(lines, clone.Line)
+	}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency && w < len(nodes); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if result, ok := s.resumed[i]; ok {
+					nodes[i].CmdResult = result
+					continue
+				}
+				release := acquireConcurrencySlot(renv, nodes[i])
+				processCmdNode(renv, nodes[i], lines[i], current.ID)
+				release(nodes[i].CmdErr)
+			}
+		}()
+	}
+	for i := range nodes {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	return current, nil
+}
+
+func (s *Template) checkConcurrentRun() error {
+	if len(s.Assertions) > 0 {
+		return errors.New("concurrent run: assertions are not supported")
+	}
+	for _, sts := range s.Statements {
+		n, ok := sts.Node.(*ast.CommandNode)
+		if !ok {
+			return fmt.Errorf("concurrent run: only independent commands are supported, got %T at line %d", sts.Node, sts.Line)
+		}
+		if len(n.RefKeys()) > 0 {
+			return fmt.Errorf("concurrent run: references are not supported (line %d: %s)", sts.Line, n)
+		}
+	}
+	return nil
+}
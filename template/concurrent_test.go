@@ -0,0 +1,101 @@
+package template_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wallix/awless/template"
+	"github.com/wallix/awless/template/driver/fake"
+	"github.com/wallix/awless/template/env"
+)
+
+func TestRunConcurrently(t *testing.T) {
+	defer func(interval time.Duration) { template.StatementRetryInterval = interval }(template.StatementRetryInterval)
+	template.StatementRetryInterval = 0
+
+	driver := fake.NewDriver()
+	compile := func(text string) (*template.Template, env.Compiling) {
+		compiled, cenv, err := template.Compile(template.MustParse(text), template.NewEnv().WithLookupCommandFunc(driver.Lookup).Build())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return compiled, cenv
+	}
+
+	t.Run("all commands run in template order", func(t *testing.T) {
+		driver.Reset()
+		compiled, cenv := compile("delete instance id=i-1\ndelete instance id=i-2\ndelete instance id=i-3\ndelete instance id=i-4")
+		ran, err := compiled.RunConcurrently(template.NewRunEnv(cenv), 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := len(driver.CallsFor("delete", "instance")), 4; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+		cmds := ran.CommandNodesIterator()
+		if got, want := len(cmds), 4; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+		for i, cmd := range cmds {
+			if got, want := cmd.ToDriverParams()["id"], fmt.Sprintf("i-%d", i+1); got != want {
+				t.Fatalf("%d: got %v, want %v", i+1, got, want)
+			}
+			if cmd.Err() != nil {
+				t.Fatalf("%d: %s", i+1, cmd.Err())
+			}
+		}
+		if ran.ID == "" || ran.ID == compiled.ID {
+			t.Fatalf("expected new template ID, got %q", ran.ID)
+		}
+	})
+
+	t.Run("failures do not stop other commands", func(t *testing.T) {
+		driver.Reset()
+		driver.FailOn("delete", "volume", errors.New("volume in use"))
+		compiled, cenv := compile("delete volume id=vol-1\ndelete instance id=i-1\ndelete volume id=vol-2")
+		ran, err := compiled.RunConcurrently(template.NewRunEnv(cenv), 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := len(driver.CallsFor("delete", "instance")), 1; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+		var failed int
+		for _, cmd := range ran.CommandNodesIterator() {
+			if cmd.Err() != nil {
+				failed++
+			}
+		}
+		if got, want := failed, 2; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+	})
+
+	t.Run("retry", func(t *testing.T) {
+		driver.Reset()
+		driver.FailTimesOn("delete", "instance", 1, errors.New("throttled"))
+		compiled, cenv := compile("delete instance id=i-1 retry=1\ndelete instance id=i-2 retry=1")
+		ran, err := compiled.RunConcurrently(template.NewRunEnv(cenv), 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, cmd := range ran.CommandNodesIterator() {
+			if cmd.Err() != nil {
+				t.Fatal(cmd.Err())
+			}
+		}
+		if got, want := len(driver.CallsFor("delete", "instance")), 3; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+	})
+
+	t.Run("dependent commands unsupported", func(t *testing.T) {
+		compiled, cenv := compile("vpc = create vpc cidr=10.0.0.0/16\ncreate subnet cidr=10.0.0.0/24 vpc=$vpc")
+		if _, err := compiled.RunConcurrently(template.NewRunEnv(cenv), 2); err == nil || !strings.Contains(err.Error(), "concurrent run") {
+			t.Fatalf("expected concurrent run error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,74 @@
+package template
+
+import (
+	"strings"
+
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/internal/ast"
+)
+
+// IAM resources are eventually consistent: AWS often rejects their use right after their creation
+var eventuallyConsistentEntities = map[string]bool{
+	"role":            true,
+	"instanceprofile": true,
+	"policy":          true,
+	"user":            true,
+	"group":           true,
+	"accesskey":       true,
+}
+
+// Errors returned when using a resource not propagated yet
+var eventualConsistencyErrors = []string{"InvalidParameterValue", "NoSuchEntity", "NotFound", "Invalid principal", "cannot be assumed"}
+
+// EventualConsistencyRetries is the number of retries of a statement failing on an eventually
+// consistent resource created earlier in the template (with StatementRetryInterval backoff)
+var EventualConsistencyRetries = 5
+
+// markEventualConsistencyPass marks the commands referencing (by variable or name)
+// an eventually consistent resource created by a previous statement
+func markEventualConsistencyPass(tpl *Template, cenv env.Compiling) (*Template, env.Compiling, error) {
+	createdRefs := make(map[string]bool)
+	createdNames := make(map[string]bool)
+
+	for _, st := range tpl.Statements {
+		var ident string
+		node, ok := st.Node.(*ast.CommandNode)
+		if decl, isDecl := st.Node.(*ast.DeclarationNode); isDecl {
+			ident = decl.Ident
+			node, ok = decl.Expr.(*ast.CommandNode)
+		}
+		if !ok {
+			continue
+		}
+
+		for _, ref := range node.RefKeys() {
+			if createdRefs[ref] {
+				node.AwaitsEventualConsistency = true
+			}
+		}
+		for _, v := range node.ToDriverParams() {
+			if s, isStr := v.(string); isStr && createdNames[s] {
+				node.AwaitsEventualConsistency = true
+			}
+		}
+
+		if node.Action == "create" && eventuallyConsistentEntities[node.Entity] {
+			if ident != "" {
+				createdRefs[ident] = true
+			}
+			if name, isStr := node.ToDriverParams()["name"].(string); isStr && name != "" {
+				createdNames[name] = true
+			}
+		}
+	}
+	return tpl, cenv, nil
+}
+
+func isEventualConsistencyError(err error) bool {
+	for _, e := range eventualConsistencyErrors {
+		if strings.Contains(err.Error(), e) {
+			return true
+		}
+	}
+	return false
+}
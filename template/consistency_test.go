@@ -0,0 +1,60 @@
+package template_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/wallix/awless/template"
+	"github.com/wallix/awless/template/driver/fake"
+)
+
+func TestEventualConsistency(t *testing.T) {
+	defer func(interval time.Duration) { template.StatementRetryInterval = interval }(template.StatementRetryInterval)
+	template.StatementRetryInterval = 0
+
+	driver := fake.NewDriver()
+	text := "role = create role name=ops\ncreate instanceprofile name=ops-profile\nattach role instanceprofile=ops-profile name=$role\ndetach role instanceprofile=ops-profile name=other\ncreate vpc cidr=10.0.0.0/16"
+	compiled, cenv, err := template.Compile(template.MustParse(text), template.NewEnv().WithLookupCommandFunc(driver.Lookup).Build())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var awaiting []bool
+	for _, cmd := range compiled.CommandNodesIterator() {
+		awaiting = append(awaiting, cmd.AwaitsEventualConsistency)
+	}
+	if got, want := awaiting, []bool{false, false, true, true, false}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	driver.FailTimesOn("attach", "role", 2, errors.New("NoSuchEntity: The role with name ops cannot be found."))
+	ran, err := compiled.Run(template.NewRunEnv(cenv))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, cmd := range ran.CommandNodesIterator() {
+		if cmd.Err() != nil {
+			t.Fatal(cmd.Err())
+		}
+	}
+	if got, want := len(driver.CallsFor("attach", "role")), 3; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+
+	t.Run("other errors not retried", func(t *testing.T) {
+		driver.Reset()
+		compiled, cenv, err := template.Compile(template.MustParse(text), template.NewEnv().WithLookupCommandFunc(driver.Lookup).Build())
+		if err != nil {
+			t.Fatal(err)
+		}
+		driver.FailTimesOn("attach", "role", 1, errors.New("AccessDenied: not allowed"))
+		if _, err = compiled.Run(template.NewRunEnv(cenv)); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := len(driver.CallsFor("attach", "role")), 1; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+	})
+}
@@ -0,0 +1,186 @@
+package template
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/wallix/awless/template/internal/ast"
+)
+
+// Kinds of dependencies between statements
+const (
+	// ReferenceDependency: the statement uses a variable ($ref) declared by a previous statement
+	ReferenceDependency = "reference"
+	// NameDependency: the statement uses (by name or @alias) a resource created by a previous statement
+	NameDependency = "name"
+	// WaitDependency: the statement uses a resource checked (i.e. waited for) by a previous statement
+	WaitDependency = "wait"
+)
+
+// Dependency is an edge of the statements dependency graph:
+// the statement at index To can only run after the one at index From
+type Dependency struct {
+	From, To int
+	Kind     string
+	Labels   []string
+}
+
+// DependencyGraph is the DAG of the statements of a template (see Template.DependencyGraph)
+type DependencyGraph struct {
+	Statements   []string
+	Dependencies []*Dependency
+	// Undefined are the references used per statement index without any declaration (i.e. missing dependencies)
+	Undefined map[int][]string
+}
+
+// DependencyGraph returns the dependencies between the statements of the template: references
+// to declared variables, implicit ordering on names of created resources and waits on checks
+func (s *Template) DependencyGraph() *DependencyGraph {
+	g := &DependencyGraph{Undefined: make(map[int][]string)}
+	edges := make(map[[2]int]*Dependency)
+	addEdge := func(from, to int, kind, label string) {
+		key := [2]int{from, to}
+		dep, ok := edges[key]
+		if !ok {
+			dep = &Dependency{From: from, To: to, Kind: kind}
+			edges[key] = dep
+			g.Dependencies = append(g.Dependencies, dep)
+		} else if kind == WaitDependency {
+			dep.Kind = kind
+		}
+		for _, l := range dep.Labels {
+			if l == label {
+				return
+			}
+		}
+		dep.Labels = append(dep.Labels, label)
+	}
+
+	declared := make(map[string]int)
+	createdNames := make(map[string]int)
+	checked := make(map[string]int)
+
+	for i, st := range s.Statements {
+		g.Statements = append(g.Statements, st.String())
+
+		var ident string
+		cmd, isCmd := st.Node.(*ast.CommandNode)
+		if decl, ok := st.Node.(*ast.DeclarationNode); ok {
+			ident = decl.Ident
+			cmd, isCmd = decl.Expr.(*ast.CommandNode)
+		}
+
+		var usedRefs []string
+		for _, ref := range ast.CollectRefs(st) {
+			key, _, _ := ast.SplitPropertyRef(ref.Ref())
+			usedRefs = append(usedRefs, key)
+			if checkIndex, ok := checked[key]; ok {
+				addEdge(checkIndex, i, WaitDependency, "$"+key)
+			}
+			if from, ok := declared[key]; ok {
+				addEdge(from, i, ReferenceDependency, "$"+ref.Ref())
+			} else if !contains(g.Undefined[i], key) {
+				g.Undefined[i] = append(g.Undefined[i], key)
+			}
+		}
+		for _, alias := range ast.CollectAliases(st) {
+			if from, ok := createdNames[alias.Alias()]; ok {
+				addEdge(from, i, NameDependency, "@"+alias.Alias())
+			}
+		}
+		if isCmd {
+			for k, v := range cmd.ToDriverParams() {
+				if name, ok := v.(string); ok && k != "name" {
+					if from, ok := createdNames[name]; ok {
+						addEdge(from, i, NameDependency, name)
+					}
+				}
+			}
+			if cmd.Action == "check" {
+				for _, key := range usedRefs {
+					checked[key] = i
+				}
+			}
+			if cmd.Action == "create" {
+				if name, ok := cmd.ToDriverParams()["name"].(string); ok && name != "" {
+					createdNames[name] = i
+				}
+			}
+		}
+		if ident != "" {
+			declared[ident] = i
+		}
+	}
+	for i := range g.Undefined {
+		sort.Strings(g.Undefined[i])
+	}
+	for _, dep := range g.Dependencies {
+		sort.Strings(dep.Labels)
+	}
+	return g
+}
+
+// Stages groups the statement indexes per stage: the statements of a stage only depend on
+// statements of previous stages, and could therefore run in parallel
+func (g *DependencyGraph) Stages() (stages [][]int) {
+	levels := make([]int, len(g.Statements))
+	for i := range g.Statements {
+		for _, dep := range g.Dependencies {
+			if dep.To == i && levels[dep.From]+1 > levels[i] {
+				levels[i] = levels[dep.From] + 1
+			}
+		}
+		for len(stages) <= levels[i] {
+			stages = append(stages, nil)
+		}
+		stages[levels[i]] = append(stages[levels[i]], i)
+	}
+	return
+}
+
+// WriteDot writes the graph in the Graphviz DOT language (ex: render it with `dot -Tsvg`)
+func (g *DependencyGraph) WriteDot(w io.Writer) error {
+	lines := []string{"digraph template {", "\tnode [shape=box];"}
+	for i, st := range g.Statements {
+		lines = append(lines, fmt.Sprintf("\ts%d [label=\"%s\"];", i, dotEscape(st)))
+	}
+	for _, dep := range g.Dependencies {
+		attrs := fmt.Sprintf("label=\"%s\"", dotEscape(strings.Join(dep.Labels, ", ")))
+		switch dep.Kind {
+		case NameDependency:
+			attrs += " style=dotted"
+		case WaitDependency:
+			attrs += " style=dashed"
+		}
+		lines = append(lines, fmt.Sprintf("\ts%d -> s%d [%s];", dep.From, dep.To, attrs))
+	}
+	var missing []int
+	for i := range g.Undefined {
+		missing = append(missing, i)
+	}
+	sort.Ints(missing)
+	for _, i := range missing {
+		for _, ref := range g.Undefined[i] {
+			lines = append(lines, fmt.Sprintf("\tundefined_%s_%d [label=\"$%s undefined\" shape=ellipse color=red];", dotID(ref), i, dotEscape(ref)))
+			lines = append(lines, fmt.Sprintf("\tundefined_%s_%d -> s%d [color=red];", dotID(ref), i, i))
+		}
+	}
+	lines = append(lines, "}")
+	_, err := fmt.Fprintln(w, strings.Join(lines, "\n"))
+	return err
+}
+
+func dotEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s)
+}
+
+func dotID(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, s)
+}
@@ -0,0 +1,65 @@
+package template_test
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/wallix/awless/template"
+)
+
+func TestDependencyGraph(t *testing.T) {
+	tpl := template.MustParse(`env = prod
+vpc = create vpc cidr=10.0.0.0/16 name=$env
+subnet = create subnet cidr=10.0.0.0/24 vpc=$vpc
+create keypair name=mykey
+inst = create instance subnet=$subnet keypair=mykey image=ami-123 type=t2.micro count=1 name=web
+check instance id=$inst state=running timeout=180
+create elasticip domain=vpc instance=$inst
+attach securitygroup id=@mysg instance=$unknown`)
+
+	g := tpl.DependencyGraph()
+
+	var deps []string
+	for _, dep := range g.Dependencies {
+		deps = append(deps, dep.Kind+" "+g.Statements[dep.From][:3]+"->"+g.Statements[dep.To][:3])
+	}
+	if got, want := len(g.Dependencies), 7; got != want {
+		t.Fatalf("got %d dependencies, want %d: %v", got, want, deps)
+	}
+	if got, want := g.Undefined, map[int][]string{7: {"unknown"}}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := g.Stages(), [][]int{{0, 3, 7}, {1}, {2}, {4}, {5}, {6}}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	var buff bytes.Buffer
+	if err := g.WriteDot(&buff); err != nil {
+		t.Fatal(err)
+	}
+	expect := `digraph template {
+	node [shape=box];
+	s0 [label="env = prod"];
+	s1 [label="vpc = create vpc cidr=10.0.0.0/16 name=$env"];
+	s2 [label="subnet = create subnet cidr=10.0.0.0/24 vpc=$vpc"];
+	s3 [label="create keypair name=mykey"];
+	s4 [label="inst = create instance count=1 image=ami-123 keypair=mykey name=web subnet=$subnet type=t2.micro"];
+	s5 [label="check instance id=$inst state=running timeout=180"];
+	s6 [label="create elasticip domain=vpc instance=$inst"];
+	s7 [label="attach securitygroup id=@mysg instance=$unknown"];
+	s0 -> s1 [label="$env"];
+	s1 -> s2 [label="$vpc"];
+	s2 -> s4 [label="$subnet"];
+	s3 -> s4 [label="mykey" style=dotted];
+	s4 -> s5 [label="$inst"];
+	s5 -> s6 [label="$inst" style=dashed];
+	s4 -> s6 [label="$inst"];
+	undefined_unknown_7 [label="$unknown undefined" shape=ellipse color=red];
+	undefined_unknown_7 -> s7 [color=red];
+}
+`
+	if got := buff.String(); got != expect {
+		t.Fatalf("got\n%s\nwant\n%s", got, expect)
+	}
+}
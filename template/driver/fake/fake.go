@@ -0,0 +1,233 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fake provides an in-memory driver for awless templates.
+//
+// Every action supported by the AWS driver is available with the same params
+// specification but nothing is sent to the cloud: each run is recorded and
+// commands returning a result get a deterministic ID (i.e: i-1, i-2, subnet-1, ...).
+// It allows to unit test automation built on top of awless templates:
+//
+//	driver := fake.NewDriver()
+//	tpl := template.MustParse("sub = create subnet cidr=10.0.0.0/24 vpc=vpc-1 name=mysub")
+//	compiled, cenv, err := template.Compile(tpl, template.NewEnv().WithLookupCommandFunc(driver.Lookup).Build())
+//	...
+//	compiled.Run(template.NewRunEnv(cenv))
+//	driver.Calls() // [{create subnet map[cidr:10.0.0.0/24 name:mysub vpc:vpc-1] subnet-1 <nil>}]
+package fake
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/wallix/awless/aws/spec"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+var idPrefixes = map[string]string{
+	"instance":        "i",
+	"subnet":          "subnet",
+	"vpc":             "vpc",
+	"volume":          "vol",
+	"securitygroup":   "sg",
+	"internetgateway": "igw",
+	"natgateway":      "nat",
+	"routetable":      "rtb",
+	"image":           "ami",
+	"snapshot":        "snap",
+	"elasticip":       "eipalloc",
+	"keypair":         "key",
+}
+
+type Call struct {
+	Action, Entity string
+	Params         map[string]interface{}
+	Result         string
+	Err            error
+}
+
+func (c Call) String() string {
+	return fmt.Sprintf("%s %s", c.Action, c.Entity)
+}
+
+type Driver struct {
+	mu                sync.Mutex
+	calls             []Call
+	counters          map[string]int
+	failures          map[string]error
+	transientFailures map[string]*transientFailure
+}
+
+type transientFailure struct {
+	times int
+	err   error
+}
+
+func NewDriver() *Driver {
+	return &Driver{
+		counters:          make(map[string]int),
+		failures:          make(map[string]error),
+		transientFailures: make(map[string]*transientFailure),
+	}
+}
+
+// Lookup returns the fake command for the given action and entity tokens.
+// Its signature allows to use it as a template command lookuper.
+func (d *Driver) Lookup(tokens ...string) interface{} {
+	var key string
+	for _, t := range tokens {
+		key += t
+	}
+	def, ok := awsspec.AWSLookupDefinitions(key)
+	if !ok {
+		return nil
+	}
+
+	cmd := &command{driver: d, action: def.Action, entity: def.Entity, spec: params.NewSpec(def.Params)}
+	if real, ok := awsspec.MockAWSSessionFactory.Build(key)().(interface {
+		ParamsSpec() params.Spec
+	}); ok {
+		cmd.spec = params.NewSpec(def.Params, real.ParamsSpec().Validators())
+		if _, hasResult := real.(awsspec.ResultExtractor); hasResult {
+			return &commandWithResult{cmd}
+		}
+	}
+	return cmd
+}
+
+// FailOn makes all subsequent runs of the given action and entity return err
+func (d *Driver) FailOn(action, entity string, err error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.failures[action+entity] = err
+}
+
+// FailTimesOn makes the next given number of runs of the given action and entity return err
+func (d *Driver) FailTimesOn(action, entity string, times int, err error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.transientFailures[action+entity] = &transientFailure{times: times, err: err}
+}
+
+// Calls returns the recorded (non dry) runs in order of execution
+func (d *Driver) Calls() []Call {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	out := make([]Call, len(d.calls))
+	copy(out, d.calls)
+	return out
+}
+
+// CallsFor returns the recorded runs of the given action and entity
+func (d *Driver) CallsFor(action, entity string) (out []Call) {
+	for _, c := range d.Calls() {
+		if c.Action == action && c.Entity == entity {
+			out = append(out, c)
+		}
+	}
+	return
+}
+
+// Reset forgets all recorded runs, failures and restarts IDs generation
+func (d *Driver) Reset() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.calls = nil
+	d.counters = make(map[string]int)
+	d.failures = make(map[string]error)
+	d.transientFailures = make(map[string]*transientFailure)
+}
+
+func (d *Driver) run(action, entity string, in map[string]interface{}, withResult bool) (interface{}, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	call := Call{Action: action, Entity: entity, Params: copyParams(in)}
+	if err, ok := d.failures[action+entity]; ok {
+		call.Err = err
+		d.calls = append(d.calls, call)
+		return nil, err
+	}
+	if f, ok := d.transientFailures[action+entity]; ok && f.times > 0 {
+		f.times--
+		call.Err = f.err
+		d.calls = append(d.calls, call)
+		return nil, f.err
+	}
+	if withResult {
+		d.counters[entity]++
+		call.Result = fmt.Sprintf("%s-%d", idPrefix(entity), d.counters[entity])
+	}
+	d.calls = append(d.calls, call)
+
+	if call.Result == "" {
+		return nil, nil
+	}
+	return call.Result, nil
+}
+
+func idPrefix(entity string) string {
+	if p, ok := idPrefixes[entity]; ok {
+		return p
+	}
+	return entity
+}
+
+func copyParams(in map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{})
+	for k, v := range in {
+		out[k] = v
+	}
+	return out
+}
+
+type command struct {
+	driver         *Driver
+	action, entity string
+	spec           params.Spec
+}
+
+func (c *command) ParamsSpec() params.Spec {
+	return c.spec
+}
+
+func (c *command) Run(renv env.Running, in map[string]interface{}) (interface{}, error) {
+	return c.run(renv, in, false)
+}
+
+func (c *command) run(renv env.Running, in map[string]interface{}, withResult bool) (interface{}, error) {
+	if renv.IsDryRun() {
+		if withResult {
+			return fmt.Sprintf("%s-dryrun", idPrefix(c.entity)), nil
+		}
+		return nil, nil
+	}
+	return c.driver.run(c.action, c.entity, in, withResult)
+}
+
+type commandWithResult struct {
+	*command
+}
+
+func (c *commandWithResult) Run(renv env.Running, in map[string]interface{}) (interface{}, error) {
+	return c.run(renv, in, true)
+}
+
+func (c *commandWithResult) ExtractResult(i interface{}) string {
+	s, _ := i.(string)
+	return s
+}
@@ -0,0 +1,91 @@
+package fake_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/wallix/awless/template"
+	"github.com/wallix/awless/template/driver/fake"
+)
+
+func TestFakeDriver(t *testing.T) {
+	driver := fake.NewDriver()
+
+	run := func(text string) (*template.Template, error) {
+		tpl := template.MustParse(text)
+		compiled, cenv, err := template.Compile(tpl, template.NewEnv().WithLookupCommandFunc(driver.Lookup).Build())
+		if err != nil {
+			return nil, err
+		}
+		renv := template.NewRunEnv(cenv)
+		if _, err = compiled.DryRun(renv); err != nil {
+			return nil, err
+		}
+		return compiled.Run(renv)
+	}
+
+	t.Run("deterministic ids and recorded calls", func(t *testing.T) {
+		driver.Reset()
+		_, err := run(`vpc = create vpc cidr=10.0.0.0/16
+sub = create subnet cidr=10.0.0.0/24 vpc=$vpc
+create instance subnet=$sub image=ami-123 count=1 type=t2.micro name=inst1
+create instance subnet=$sub image=ami-123 count=1 type=t2.micro name=inst2
+update subnet id=$sub public=true`)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		calls := driver.Calls()
+		if got, want := len(calls), 5; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+		var results []string
+		for _, c := range calls {
+			results = append(results, c.Result)
+		}
+		if got, want := results, []string{"vpc-1", "subnet-1", "i-1", "i-2", ""}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		if got, want := calls[1].Params, map[string]interface{}{"cidr": "10.0.0.0/24", "vpc": "vpc-1"}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		if got, want := calls[4].Params, map[string]interface{}{"id": "subnet-1", "public": "true"}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		if got, want := len(driver.CallsFor("create", "instance")), 2; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+	})
+
+	t.Run("params validated against driver specs", func(t *testing.T) {
+		driver.Reset()
+		if _, err := run("create vpc cidr=10.0.0.0/16 unknown=any"); err == nil {
+			t.Fatal("expected error got none")
+		}
+		if _, err := run("start vpc id=any"); err == nil {
+			t.Fatal("expected error got none")
+		}
+	})
+
+	t.Run("forced failures", func(t *testing.T) {
+		driver.Reset()
+		driver.FailOn("create", "subnet", errors.New("quota exceeded"))
+		tpl, err := run(`vpc = create vpc cidr=10.0.0.0/16
+create subnet cidr=10.0.0.0/24 vpc=$vpc
+create vpc cidr=10.1.0.0/16`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !tpl.HasErrors() {
+			t.Fatal("expected template errors")
+		}
+		calls := driver.Calls()
+		if got, want := len(calls), 2; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+		if got, want := calls[1].Err.Error(), "quota exceeded"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	})
+}
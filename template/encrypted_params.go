@@ -0,0 +1,69 @@
+package template
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/internal/ast"
+)
+
+// EncryptedParamPrefix marks param values given as ciphertext (ex: password=kms:AQICAH...).
+// They are decrypted only when passed to the command, so that the template and
+// its run log never hold the value in clear.
+const EncryptedParamPrefix = "kms:"
+
+// ParamDecrypter returns the clear value of an encrypted param ciphertext (given without prefix)
+type ParamDecrypter func(ciphertext string) (string, error)
+
+// commandParams returns the params passed to the command of the node, with encrypted
+// values decrypted and generated secrets (see GeneratedSecretPattern) filled in.
+// Generated secrets are also returned per param key to be kept on the node once the command succeeded.
+func commandParams(renv env.Running, n *ast.CommandNode) (map[string]interface{}, map[string]string, error) {
+	params := n.ToDriverParams()
+	generated, err := generateSecretParams(params)
+	if err != nil {
+		return nil, nil, err
+	}
+	var decrypt ParamDecrypter
+	if e, ok := renv.(*runEnv); ok {
+		decrypt = e.decrypt
+	}
+
+	decryptValue := func(key string, v interface{}) (interface{}, error) {
+		s, ok := v.(string)
+		if !ok || !strings.HasPrefix(s, EncryptedParamPrefix) {
+			return v, nil
+		}
+		if decrypt == nil {
+			return nil, fmt.Errorf("%s: no decrypter available for encrypted value", key)
+		}
+		clear, err := decrypt(strings.TrimPrefix(s, EncryptedParamPrefix))
+		if err != nil {
+			return nil, fmt.Errorf("%s: cannot decrypt value: %s", key, err)
+		}
+		return clear, nil
+	}
+
+	for k, v := range params {
+		switch vv := v.(type) {
+		case []interface{}:
+			decrypted := make([]interface{}, len(vv))
+			for i, elem := range vv {
+				d, err := decryptValue(k, elem)
+				if err != nil {
+					return nil, nil, err
+				}
+				decrypted[i] = d
+			}
+			params[k] = decrypted
+		default:
+			d, err := decryptValue(k, v)
+			if err != nil {
+				return nil, nil, err
+			}
+			params[k] = d
+		}
+	}
+	return params, generated, nil
+}
@@ -0,0 +1,64 @@
+package template_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/template"
+	"github.com/wallix/awless/template/driver/fake"
+)
+
+func TestEncryptedParams(t *testing.T) {
+	driver := fake.NewDriver()
+	decrypt := func(ciphertext string) (string, error) {
+		if ciphertext == "invalid" {
+			return "", errors.New("invalid ciphertext")
+		}
+		return "clear(" + ciphertext + ")", nil
+	}
+	run := func(text string, decrypter template.ParamDecrypter) (*template.Template, error) {
+		driver.Reset()
+		compiled, cenv, err := template.Compile(template.MustParse(text), template.NewEnv().WithLookupCommandFunc(driver.Lookup).Build())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return compiled.Run(template.NewRunEnvWithParamDecrypter(cenv, decrypter))
+	}
+
+	t.Run("decrypted for command only", func(t *testing.T) {
+		ran, err := run("create loginprofile username=jdoe password='kms:AQICAH+ab/c==' password-reset=true", decrypt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		calls := driver.CallsFor("create", "loginprofile")
+		if len(calls) != 1 {
+			t.Fatalf("got %d calls, want 1", len(calls))
+		}
+		if got, want := calls[0].Params["password"], "clear(AQICAH+ab/c==)"; got != want {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		if got := ran.String(); !strings.Contains(got, "kms:AQICAH+ab/c==") || strings.Contains(got, "clear(") {
+			t.Fatalf("expected ciphertext only in template, got %s", got)
+		}
+	})
+
+	t.Run("decryption failure", func(t *testing.T) {
+		ran, _ := run("create loginprofile username=jdoe password=kms:invalid", decrypt)
+		cmd := ran.CommandNodesIterator()[0]
+		if cmd.CmdErr == nil || !strings.Contains(cmd.CmdErr.Error(), "password: cannot decrypt value: invalid ciphertext") {
+			t.Fatalf("got %v", cmd.CmdErr)
+		}
+		if len(driver.Calls()) != 0 {
+			t.Fatal("expected no command run")
+		}
+	})
+
+	t.Run("no decrypter", func(t *testing.T) {
+		ran, _ := run("create loginprofile username=jdoe password=kms:QUJD", nil)
+		cmd := ran.CommandNodesIterator()[0]
+		if cmd.CmdErr == nil || !strings.Contains(cmd.CmdErr.Error(), "no decrypter available") {
+			t.Fatalf("got %v", cmd.CmdErr)
+		}
+	})
+}
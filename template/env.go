@@ -16,9 +16,51 @@ type runEnv struct {
 	log    *logger.Logger
 	dryRun bool
 	ctx    map[string]interface{}
+	hooks  []StatementHook
+
+	fetchProperty  PropertyFetcher
+	decrypt        ParamDecrypter
+	findExisting   ExistingResourceFinder
+	countResources ResourceCounter
+	concurrency    *ConcurrencyControl
 }
 
 func NewRunEnv(cenv env.Compiling, context ...map[string]interface{}) env.Running {
+	return newRunEnv(cenv, context...)
+}
+
+// NewRunEnvWithHooks returns a run env calling the given hooks before and after each statement run
+func NewRunEnvWithHooks(cenv env.Compiling, hooks []StatementHook, context ...map[string]interface{}) env.Running {
+	renv := newRunEnv(cenv, context...)
+	renv.hooks = hooks
+	return renv
+}
+
+// NewRunEnvWithPropertyFetcher returns a run env resolving with the given fetcher the references
+// to properties of created resources (ex: $inst.publicip)
+func NewRunEnvWithPropertyFetcher(cenv env.Compiling, fetch PropertyFetcher, context ...map[string]interface{}) env.Running {
+	renv := newRunEnv(cenv, context...)
+	renv.fetchProperty = fetch
+	return renv
+}
+
+// NewRunEnvWithParamDecrypter returns a run env decrypting with the given decrypter
+// the encrypted param values (ex: password=kms:AQICAH...)
+func NewRunEnvWithParamDecrypter(cenv env.Compiling, decrypt ParamDecrypter, context ...map[string]interface{}) env.Running {
+	renv := newRunEnv(cenv, context...)
+	renv.decrypt = decrypt
+	return renv
+}
+
+// NewRunEnvWithConcurrencyControl returns a run env bounding with the given control
+// the commands in flight of concurrent runs (see Template.RunConcurrently)
+func NewRunEnvWithConcurrencyControl(cenv env.Compiling, control *ConcurrencyControl, context ...map[string]interface{}) env.Running {
+	renv := newRunEnv(cenv, context...)
+	renv.concurrency = control
+	return renv
+}
+
+func newRunEnv(cenv env.Compiling, context ...map[string]interface{}) *runEnv {
 	renv := new(runEnv)
 	renv.log = cenv.Log()
 	renv.ctx = make(map[string]interface{})
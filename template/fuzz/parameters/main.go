@@ -11,7 +11,7 @@ import (
 
 func Fuzz(data []byte) int {
 	var ok bool
-	for _, def := range awsspec.AWSTemplatesDefinitions {
+	for _, def := range awsspec.AWSTemplatesDefinitions() {
 		fillers := make(map[string]interface{})
 		for _, param := range def.Params.Required() {
 			fillers[param] = "default"
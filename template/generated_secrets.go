@@ -0,0 +1,91 @@
+package template
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// GeneratedSecretPattern matches password param values to generate at run time
+// with the given length (ex: password=auto(24)). The template and its run log
+// keep the pattern, the generated value being only set in the GeneratedSecrets
+// of the command node once the command succeeded.
+var GeneratedSecretPattern = regexp.MustCompile(`^auto\(([0-9]+)\)$`)
+
+const (
+	minGeneratedSecretLength = 8
+	maxGeneratedSecretLength = 128
+
+	secretLowers  = "abcdefghijklmnopqrstuvwxyz"
+	secretUppers  = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	secretDigits  = "0123456789"
+	secretSymbols = "!#%^*-_=+.:"
+)
+
+func isSecretParam(key string) bool {
+	return strings.Contains(strings.ToLower(key), "password")
+}
+
+func generateSecretParams(params map[string]interface{}) (map[string]string, error) {
+	generated := make(map[string]string)
+	for k, v := range params {
+		s, ok := v.(string)
+		if !ok || !isSecretParam(k) {
+			continue
+		}
+		matches := GeneratedSecretPattern.FindStringSubmatch(s)
+		if len(matches) != 2 {
+			continue
+		}
+		length, err := strconv.Atoi(matches[1])
+		if err != nil || length < minGeneratedSecretLength || length > maxGeneratedSecretLength {
+			return nil, fmt.Errorf("%s: generated secret length must be between %d and %d, got %s", k, minGeneratedSecretLength, maxGeneratedSecretLength, matches[1])
+		}
+		secret, err := generateSecret(length)
+		if err != nil {
+			return nil, fmt.Errorf("%s: cannot generate secret: %s", k, err)
+		}
+		params[k] = secret
+		generated[k] = secret
+	}
+	return generated, nil
+}
+
+// generateSecret returns a random secret containing at least
+// a lower case letter, an upper case letter, a digit and a symbol
+func generateSecret(length int) (string, error) {
+	classes := []string{secretLowers, secretUppers, secretDigits, secretSymbols}
+	all := strings.Join(classes, "")
+
+	secret := make([]byte, length)
+	for i := range secret {
+		charset := all
+		if i < len(classes) {
+			charset = classes[i]
+		}
+		c, err := randomChar(charset)
+		if err != nil {
+			return "", err
+		}
+		secret[i] = c
+	}
+	for i := len(secret) - 1; i > 0; i-- {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", err
+		}
+		secret[i], secret[j.Int64()] = secret[j.Int64()], secret[i]
+	}
+	return string(secret), nil
+}
+
+func randomChar(charset string) (byte, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(len(charset))))
+	if err != nil {
+		return 0, err
+	}
+	return charset[n.Int64()], nil
+}
@@ -0,0 +1,68 @@
+package template_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/template"
+	"github.com/wallix/awless/template/driver/fake"
+)
+
+func TestGeneratedSecrets(t *testing.T) {
+	driver := fake.NewDriver()
+	run := func(text string) *template.Template {
+		driver.Reset()
+		compiled, cenv, err := template.Compile(template.MustParse(text), template.NewEnv().WithLookupCommandFunc(driver.Lookup).Build())
+		if err != nil {
+			t.Fatal(err)
+		}
+		ran, _ := compiled.Run(template.NewRunEnv(cenv))
+		return ran
+	}
+
+	t.Run("generated and returned once", func(t *testing.T) {
+		ran := run("create loginprofile username=jdoe password=auto(24) password-reset=true")
+		calls := driver.CallsFor("create", "loginprofile")
+		if len(calls) != 1 {
+			t.Fatalf("got %d calls, want 1", len(calls))
+		}
+		password, _ := calls[0].Params["password"].(string)
+		if len(password) != 24 {
+			t.Fatalf("got password %q, want 24 chars", password)
+		}
+		for _, class := range []string{"abcdefghijklmnopqrstuvwxyz", "ABCDEFGHIJKLMNOPQRSTUVWXYZ", "0123456789"} {
+			if !strings.ContainsAny(password, class) {
+				t.Fatalf("password %q: missing char of %s", password, class)
+			}
+		}
+		if got, want := ran.CommandNodesIterator()[0].GeneratedSecrets, map[string]string{"password": password}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		if got := ran.String(); !strings.Contains(got, "password=auto(24)") || strings.Contains(got, password) {
+			t.Fatalf("expected generated password not in template, got %s", got)
+		}
+	})
+
+	t.Run("invalid length", func(t *testing.T) {
+		ran := run("create loginprofile username=jdoe password=auto(4)")
+		cmd := ran.CommandNodesIterator()[0]
+		if cmd.CmdErr == nil || !strings.Contains(cmd.CmdErr.Error(), "generated secret length must be between 8 and 128") {
+			t.Fatalf("got %v", cmd.CmdErr)
+		}
+		if len(cmd.GeneratedSecrets) != 0 {
+			t.Fatalf("expected no generated secrets, got %v", cmd.GeneratedSecrets)
+		}
+	})
+
+	t.Run("only for password params", func(t *testing.T) {
+		run("create tag resource=i-1 key=Name value=auto(12)")
+		calls := driver.CallsFor("create", "tag")
+		if len(calls) != 1 {
+			t.Fatalf("got %d calls, want 1", len(calls))
+		}
+		if got, want := calls[0].Params["value"], "auto(12)"; got != want {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
+}
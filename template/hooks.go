@@ -0,0 +1,60 @@
+package template
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+const (
+	BeforeStatement = "before"
+	AfterStatement  = "after"
+)
+
+type StatementEvent struct {
+	Stage      string                 `json:"stage"`
+	TemplateID string                 `json:"templateId"`
+	Statement  string                 `json:"statement"`
+	Action     string                 `json:"action"`
+	Entity     string                 `json:"entity"`
+	Params     map[string]interface{} `json:"params"`
+	Result     interface{}            `json:"result,omitempty"`
+	Error      string                 `json:"error,omitempty"`
+}
+
+// StatementHook is called before and after each statement of a template run (not dry run).
+// An error returned by a hook at the "before" stage prevents the statement from running.
+type StatementHook func(*StatementEvent) error
+
+// ExecStatementHook returns a hook running the given executable with
+// the JSON statement event on its standard input
+func ExecStatementHook(path string, args ...string) StatementHook {
+	return func(event *StatementEvent) error {
+		payload, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		var stderr bytes.Buffer
+		cmd := exec.Command(path, args...)
+		cmd.Stdin = bytes.NewReader(payload)
+		cmd.Stderr = &stderr
+		if err := cmd.Run(); err != nil {
+			if msg := strings.TrimSpace(stderr.String()); msg != "" {
+				return fmt.Errorf("%s: %s: %s", path, err, msg)
+			}
+			return fmt.Errorf("%s: %s", path, err)
+		}
+		return nil
+	}
+}
+
+func runStatementHooks(hooks []StatementHook, event *StatementEvent) error {
+	for _, hook := range hooks {
+		if err := hook(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
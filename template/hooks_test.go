@@ -0,0 +1,95 @@
+package template_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/template"
+	"github.com/wallix/awless/template/driver/fake"
+)
+
+func TestStatementHooks(t *testing.T) {
+	driver := fake.NewDriver()
+	runWithHooks := func(text string, hooks ...template.StatementHook) *template.Template {
+		compiled, cenv, err := template.Compile(template.MustParse(text), template.NewEnv().WithLookupCommandFunc(driver.Lookup).Build())
+		if err != nil {
+			t.Fatal(err)
+		}
+		ran, err := compiled.Run(template.NewRunEnvWithHooks(cenv, hooks))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ran
+	}
+
+	t.Run("events", func(t *testing.T) {
+		var events []string
+		hook := func(e *template.StatementEvent) error {
+			events = append(events, strings.Join([]string{e.Stage, e.Action, e.Entity, e.Params["cidr"].(string), e.Error}, " "))
+			if e.Stage == template.AfterStatement && e.Result == nil {
+				t.Fatalf("expected result in after statement event")
+			}
+			return nil
+		}
+		runWithHooks("vpc = create vpc cidr=10.0.0.0/16\ncreate subnet vpc=$vpc cidr=10.0.0.0/24", hook)
+		exp := []string{
+			"before create vpc 10.0.0.0/16 ",
+			"after create vpc 10.0.0.0/16 ",
+			"before create subnet 10.0.0.0/24 ",
+			"after create subnet 10.0.0.0/24 ",
+		}
+		if got, want := events, exp; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %q, want %q", got, want)
+		}
+	})
+
+	t.Run("before hook error aborts statement", func(t *testing.T) {
+		driver.Reset()
+		deny := func(e *template.StatementEvent) error {
+			if e.Stage == template.BeforeStatement && e.Entity == "subnet" {
+				return errors.New("denied")
+			}
+			return nil
+		}
+		ran := runWithHooks("vpc = create vpc cidr=10.0.0.0/16\ncreate subnet vpc=$vpc cidr=10.0.0.0/24\ncreate vpc cidr=10.1.0.0/16", deny)
+		if got, want := len(driver.Calls()), 1; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+		if !ran.HasErrors() {
+			t.Fatal("expected errors")
+		}
+	})
+
+	t.Run("executable hook", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "hooks")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+		out := filepath.Join(dir, "out.json")
+		script := filepath.Join(dir, "hook.sh")
+		if err = ioutil.WriteFile(script, []byte("#!/bin/sh\ncat >> "+out+"\n"), 0700); err != nil {
+			t.Fatal(err)
+		}
+		runWithHooks("create vpc cidr=10.0.0.0/16", template.ExecStatementHook(script))
+		content, err := ioutil.ReadFile(out)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := string(content), `"stage":"after"`; !strings.Contains(got, want) {
+			t.Fatalf("%s should contain %s", got, want)
+		}
+		if got, want := string(content), `"params":{"cidr":"10.0.0.0/16"}`; !strings.Contains(got, want) {
+			t.Fatalf("%s should contain %s", got, want)
+		}
+
+		if err := template.ExecStatementHook("/bin/false")(&template.StatementEvent{}); err == nil {
+			t.Fatal("expected error got none")
+		}
+	})
+}
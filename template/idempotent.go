@@ -0,0 +1,26 @@
+package template
+
+import (
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/internal/ast"
+)
+
+// ExistingResourceFinder returns the ID of an existing resource equivalent to the one
+// a create statement would create given its entity and params, or an empty ID if none
+type ExistingResourceFinder func(entity string, params map[string]interface{}) (string, error)
+
+// NewRunEnvWithExistingResourceFinder returns a run env in idempotent mode: create statements
+// reuse the existing resource found with the given finder instead of creating a new one
+func NewRunEnvWithExistingResourceFinder(cenv env.Compiling, find ExistingResourceFinder, context ...map[string]interface{}) env.Running {
+	renv := newRunEnv(cenv, context...)
+	renv.findExisting = find
+	return renv
+}
+
+func findExistingResource(renv env.Running, n *ast.CommandNode) (string, error) {
+	e, ok := renv.(*runEnv)
+	if !ok || e.findExisting == nil || n.Action != "create" {
+		return "", nil
+	}
+	return e.findExisting(n.Entity, n.ToDriverParams())
+}
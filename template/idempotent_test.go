@@ -0,0 +1,54 @@
+package template_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/wallix/awless/template"
+	"github.com/wallix/awless/template/driver/fake"
+)
+
+func TestIdempotentRun(t *testing.T) {
+	driver := fake.NewDriver()
+	compiled, cenv, err := template.Compile(template.MustParse("vpc = create vpc cidr=10.0.0.0/16 name=prod\ncreate subnet cidr=10.0.0.0/24 vpc=$vpc name=web\ncreate subnet cidr=10.0.1.0/24 vpc=$vpc name=db"), template.NewEnv().WithLookupCommandFunc(driver.Lookup).Build())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var lookups []string
+	find := func(entity string, params map[string]interface{}) (string, error) {
+		lookups = append(lookups, entity)
+		switch params["name"] {
+		case "prod":
+			return "vpc-existing", nil
+		case "db":
+			return "", errors.New("ambiguous")
+		}
+		return "", nil
+	}
+
+	ran, err := compiled.Run(template.NewRunEnvWithExistingResourceFinder(cenv, find))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(lookups), 3; got != want {
+		t.Fatalf("got %d lookups, want %d", got, want)
+	}
+	if calls := driver.CallsFor("create", "vpc"); len(calls) != 0 {
+		t.Fatalf("expected existing vpc reused, got %v", calls)
+	}
+	subnets := driver.CallsFor("create", "subnet")
+	if len(subnets) != 1 {
+		t.Fatalf("got %d subnet creations, want 1", len(subnets))
+	}
+	if got, want := subnets[0].Params["vpc"], "vpc-existing"; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	cmds := ran.CommandNodesIterator()
+	if got, want := cmds[0].Result(), "vpc-existing"; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if cmds[2].Err() == nil {
+		t.Fatal("expected lookup error to fail the statement")
+	}
+}
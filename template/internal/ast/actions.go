@@ -23,6 +23,15 @@ const (
 
 	Import       Action = "import"
 	Authenticate Action = "authenticate"
+
+	Bootstrap Action = "bootstrap"
+
+	Backup  Action = "backup"
+	Restore Action = "restore"
+
+	Invoke Action = "invoke"
+
+	Verify Action = "verify"
 )
 
 var actions = map[Action]struct{}{
@@ -39,6 +48,11 @@ var actions = map[Action]struct{}{
 	Copy:         {},
 	Import:       {},
 	Authenticate: {},
+	Bootstrap:    {},
+	Backup:       {},
+	Restore:      {},
+	Invoke:       {},
+	Verify:       {},
 }
 
 func IsInvalidAction(s string) bool {
@@ -0,0 +1,88 @@
+package ast
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Assertion is a check of the existing resources declared with 'assert [not] exists entity key=value ...'
+// or 'assert count(entities where key=value and ...) >= N', evaluated when the run reaches it
+type Assertion struct {
+	// Before is the index of the statement the assertion precedes (the number of statements for trailing ones)
+	Before     int
+	Line       int
+	Count      bool
+	Negated    bool
+	Entity     string
+	Conditions []*Condition
+	Operator   string
+	Value      int
+}
+
+// Condition is a 'key=value' filter of an assertion. Values starting with '$' are references.
+type Condition struct {
+	Key, Value string
+}
+
+// RefKey returns the key of the template reference used as condition value, if any
+func (c *Condition) RefKey() (string, bool) {
+	if strings.HasPrefix(c.Value, "$") {
+		return strings.TrimPrefix(c.Value, "$"), true
+	}
+	return "", false
+}
+
+func (a *Assertion) Clone() *Assertion {
+	clone := *a
+	clone.Conditions = nil
+	for _, c := range a.Conditions {
+		cond := *c
+		clone.Conditions = append(clone.Conditions, &cond)
+	}
+	return &clone
+}
+
+// Holds returns whether the assertion is true given the count of resources matching its conditions
+func (a *Assertion) Holds(count int) bool {
+	if !a.Count {
+		return (count > 0) != a.Negated
+	}
+	switch a.Operator {
+	case "==":
+		return count == a.Value
+	case "!=":
+		return count != a.Value
+	case ">=":
+		return count >= a.Value
+	case "<=":
+		return count <= a.Value
+	case ">":
+		return count > a.Value
+	case "<":
+		return count < a.Value
+	}
+	return false
+}
+
+func (a *Assertion) String() string {
+	var conds []string
+	for _, c := range a.Conditions {
+		if _, isRef := c.RefKey(); isRef {
+			conds = append(conds, fmt.Sprintf("%s=%s", c.Key, c.Value))
+		} else {
+			conds = append(conds, fmt.Sprintf("%s=%s", c.Key, quoteStringIfNeeded(c.Value)))
+		}
+	}
+	if a.Count {
+		where := ""
+		if len(conds) > 0 {
+			where = " where " + strings.Join(conds, " and ")
+		}
+		return fmt.Sprintf("assert count(%s%s) %s %d", a.Entity, where, a.Operator, a.Value)
+	}
+	not := ""
+	if a.Negated {
+		not = "not "
+	}
+	return strings.TrimSpace(fmt.Sprintf("assert %sexists %s %s", not, a.Entity, strings.Join(conds, " ")))
+}
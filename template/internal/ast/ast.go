@@ -33,6 +33,8 @@ type Node interface {
 
 type AST struct {
 	Statements []*Statement
+	Outputs    []*Output
+	Assertions []*Assertion
 
 	// state to build the AST
 	stmtBuilder *statementBuilder
@@ -40,6 +42,11 @@ type AST struct {
 
 type Statement struct {
 	Node
+	// Line is the line number of the statement in the template text (0 when unknown)
+	Line int
+	// Comment is the text of the comment lines right above the statement,
+	// kept as the human rationale of the change
+	Comment string
 }
 
 type DeclarationNode struct {
@@ -96,6 +103,12 @@ func (c *CommandNode) String() string {
 	for k, v := range c.Refs {
 		all = append(all, fmt.Sprintf("%s=%v", k, v))
 	}
+	if c.Retry > 0 {
+		all = append(all, fmt.Sprintf("retry=%d", c.Retry))
+	}
+	if c.Timeout > 0 {
+		all = append(all, fmt.Sprintf("timeout=%d", c.Timeout))
+	}
 
 	sort.Strings(all)
 
@@ -114,10 +127,12 @@ func (c *CommandNode) clone() Node {
 	cmd := &CommandNode{
 		Command: c.Command,
 		Action:  c.Action, Entity: c.Entity,
+		Retry: c.Retry, Timeout: c.Timeout,
 		ParamNodes: make(map[string]interface{}),
 		Refs:       make(map[string]interface{}),
 	}
 
+	cmd.AwaitsEventualConsistency = c.AwaitsEventualConsistency
 	for k, v := range c.ParamNodes {
 		cmd.ParamNodes[k] = v
 	}
@@ -137,6 +152,12 @@ func (c *CommandNode) ProcessRefs(refs map[string]interface{}) {
 			}
 		}
 
+		if concat, ok := param.(ConcatenationNode); ok {
+			if str, resolved := concat.ResolveRefs(refs); resolved {
+				c.ParamNodes[paramKey] = str
+			}
+		}
+
 		if list, ok := param.(ListNode); ok {
 			var new []interface{}
 			for _, e := range list.arr {
@@ -148,6 +169,11 @@ func (c *CommandNode) ProcessRefs(refs map[string]interface{}) {
 						}
 					}
 				}
+				if concat, isConcat := e.(ConcatenationNode); isConcat {
+					if str, resolved := concat.ResolveRefs(refs); resolved {
+						newElem = str
+					}
+				}
 				new = append(new, newElem)
 			}
 			c.ParamNodes[paramKey] = new
@@ -155,6 +181,20 @@ func (c *CommandNode) ProcessRefs(refs map[string]interface{}) {
 	}
 }
 
+// RefKeys returns the keys of the references the command params use
+func (c *CommandNode) RefKeys() (keys []string) {
+	v := newVisitor()
+	v.onRefs = func(parent interface{}, n RefNode) {
+		keys = append(keys, n.key)
+	}
+	for _, ref := range c.Refs {
+		if n, ok := ref.(Node); ok {
+			v.visit(n)
+		}
+	}
+	return
+}
+
 func (c *CommandNode) ToDriverParams() map[string]interface{} {
 	params := make(map[string]interface{})
 	for k, v := range c.ParamNodes {
@@ -200,7 +240,7 @@ func (c *CommandNode) ToFillerParams() map[string]interface{} {
 }
 
 func (s *Statement) Clone() *Statement {
-	newStat := &Statement{}
+	newStat := &Statement{Line: s.Line, Comment: s.Comment}
 	newStat.Node = s.Node.clone()
 
 	return newStat
@@ -208,12 +248,43 @@ func (s *Statement) Clone() *Statement {
 
 func (a *AST) String() string {
 	var all []string
-	for _, stat := range a.Statements {
+	for i, stat := range a.Statements {
+		for _, as := range a.Assertions {
+			if as.Before == i {
+				all = append(all, as.String())
+			}
+		}
+		if stat.Comment != "" {
+			for _, line := range strings.Split(stat.Comment, "\n") {
+				all = append(all, "# "+line)
+			}
+		}
 		all = append(all, stat.String())
 	}
+	for _, as := range a.Assertions {
+		if as.Before >= len(a.Statements) {
+			all = append(all, as.String())
+		}
+	}
+	for _, out := range a.Outputs {
+		all = append(all, out.String())
+	}
 	return strings.Join(all, "\n")
 }
 
+// Output is a value declared with 'output name = $ref.property', resolved once the template has run
+// from the property of the resource created by the command assigned to 'ref' (or its result without property)
+type Output struct {
+	Name, Ref, Property string
+}
+
+func (o *Output) String() string {
+	if o.Property == "" {
+		return fmt.Sprintf("output %s = $%s", o.Name, o.Ref)
+	}
+	return fmt.Sprintf("output %s = $%s.%s", o.Name, o.Ref, o.Property)
+}
+
 func (n *DeclarationNode) clone() Node {
 	decl := &DeclarationNode{
 		Ident: n.Ident,
@@ -233,6 +304,13 @@ func (a *AST) Clone() *AST {
 	for _, stat := range a.Statements {
 		clone.Statements = append(clone.Statements, stat.Clone())
 	}
+	for _, out := range a.Outputs {
+		o := *out
+		clone.Outputs = append(clone.Outputs, &o)
+	}
+	for _, as := range a.Assertions {
+		clone.Assertions = append(clone.Assertions, as.Clone())
+	}
 	return clone
 }
 
@@ -1724,13 +1724,25 @@ func (p *Peg) Init() {
 			position, tokenIndex = position200, tokenIndex200
 			return false
 		},
-		/* 17 UnquotedParam <- <((&('*') '*') | (&('>') '>') | (&('<') '<') | (&('@') '@') | (&('~') '~') | (&(';') ';') | (&('+') '+') | (&('/') '/') | (&(':') ':') | (&('_') '_') | (&('.') '.') | (&('-') '-') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))+> */
+		/* 17 UnquotedParam <- <((&(')') ')') | (&('(') '(') | (&('*') '*') | (&('>') '>') | (&('<') '<') | (&('@') '@') | (&('~') '~') | (&(';') ';') | (&('+') '+') | (&('/') '/') | (&(':') ':') | (&('_') '_') | (&('.') '.') | (&('-') '-') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))+> */
 		func() bool {
 			position204, tokenIndex204 := position, tokenIndex
 			{
 				position205 := position
 				{
 					switch buffer[position] {
+					case ')':
+						if buffer[position] != rune(')') {
+							goto l204
+						}
+						position++
+						break
+					case '(':
+						if buffer[position] != rune('(') {
+							goto l204
+						}
+						position++
+						break
 					case '*':
 						if buffer[position] != rune('*') {
 							goto l204
@@ -1829,6 +1841,18 @@ func (p *Peg) Init() {
 					position207, tokenIndex207 := position, tokenIndex
 					{
 						switch buffer[position] {
+						case ')':
+							if buffer[position] != rune(')') {
+								goto l207
+							}
+							position++
+							break
+						case '(':
+							if buffer[position] != rune('(') {
+								goto l207
+							}
+							position++
+							break
 						case '*':
 							if buffer[position] != rune('*') {
 								goto l207
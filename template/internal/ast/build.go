@@ -162,7 +162,18 @@ func (a *AST) lastValueInConcatenation() {
 }
 
 func (a *AST) addStringValue(text string) {
-	a.stmtBuilder.addParamValue(InterfaceNode{i: text})
+	elems, hasRef := interpolate(text)
+	if !hasRef {
+		a.stmtBuilder.addParamValue(elems[0])
+		return
+	}
+	if a.stmtBuilder.concatenationBuilder != nil {
+		for _, e := range elems {
+			a.stmtBuilder.addParamValue(e)
+		}
+		return
+	}
+	a.stmtBuilder.addParamValue(NewConcatenationNode(elems))
 }
 
 func (a *AST) addParamRefValue(text string) {
@@ -5,58 +5,72 @@ type Entity string
 var entities = map[Entity]struct{}{
 	"none": {},
 
-	"accesskey":           {},
-	"alarm":               {},
-	"appscalingtarget":    {},
-	"appscalingpolicy":    {},
-	"scalinggroup":        {},
-	"bucket":              {},
-	"certificate":         {},
-	"classicloadbalancer": {},
-	"container":           {},
-	"containercluster":    {},
-	"containerservice":    {},
-	"containertask":       {},
-	"database":            {},
-	"distribution":        {},
-	"dbsubnetgroup":       {},
-	"elasticip":           {},
-	"function":            {},
-	"group":               {},
-	"instance":            {},
-	"image":               {},
-	"internetgateway":     {},
-	"mfadevice":           {},
-	"natgateway":          {},
-	"networkinterface":    {},
-	"instanceprofile":     {},
-	"keypair":             {},
-	"launchconfiguration": {},
-	"listener":            {},
-	"loadbalancer":        {},
-	"loginprofile":        {},
-	"policy":              {},
-	"queue":               {},
-	"record":              {},
-	"registry":            {},
-	"repository":          {},
-	"role":                {},
-	"route":               {},
-	"routetable":          {},
-	"s3object":            {},
-	"scalingpolicy":       {},
-	"securitygroup":       {},
-	"snapshot":            {},
-	"stack":               {},
-	"subnet":              {},
-	"subscription":        {},
-	"tag":                 {},
-	"targetgroup":         {},
-	"topic":               {},
-	"user":                {},
-	"volume":              {},
-	"vpc":                 {},
-	"zone":                {},
+	"accesskey":                 {},
+	"alarm":                     {},
+	"application":               {},
+	"appscalingtarget":          {},
+	"appscalingpolicy":          {},
+	"backup":                    {},
+	"scalinggroup":              {},
+	"bucket":                    {},
+	"certificate":               {},
+	"classicloadbalancer":       {},
+	"container":                 {},
+	"containercluster":          {},
+	"containerservice":          {},
+	"containertask":             {},
+	"database":                  {},
+	"distribution":              {},
+	"dbsubnetgroup":             {},
+	"dhcpoptions":               {},
+	"domain":                    {},
+	"egressonlyinternetgateway": {},
+	"elasticip":                 {},
+	"elasticsearchdomain":       {},
+	"environment":               {},
+	"email":                     {},
+	"failover":                  {},
+	"function":                  {},
+	"group":                     {},
+	"healthcheck":               {},
+	"instance":                  {},
+	"image":                     {},
+	"internetgateway":           {},
+	"mfadevice":                 {},
+	"natgateway":                {},
+	"networkinterface":          {},
+	"instanceprofile":           {},
+	"keypair":                   {},
+	"launchconfiguration":       {},
+	"listener":                  {},
+	"loadbalancer":              {},
+	"loginprofile":              {},
+	"policy":                    {},
+	"queue":                     {},
+	"queuepolicy":               {},
+	"record":                    {},
+	"records":                   {},
+	"registry":                  {},
+	"repository":                {},
+	"role":                      {},
+	"route":                     {},
+	"routetable":                {},
+	"s3object":                  {},
+	"scalingpolicy":             {},
+	"securitygroup":             {},
+	"snapshot":                  {},
+	"stack":                     {},
+	"subnet":                    {},
+	"subscription":              {},
+	"table":                     {},
+	"tag":                       {},
+	"targetgroup":               {},
+	"topic":                     {},
+	"user":                      {},
+	"volume":                    {},
+	"vpc":                       {},
+	"vpcendpoint":               {},
+	"zone":                      {},
 }
 
 func IsInvalidEntity(s string) bool {
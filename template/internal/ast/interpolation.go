@@ -0,0 +1,39 @@
+package ast
+
+import (
+	"regexp"
+)
+
+// interpolationRegex matches references interpolated in quoted strings (ex: 'web-${env}'),
+// '$${' being the escaped form of a literal '${'
+var interpolationRegex = regexp.MustCompile(`\$\$\{|\$\{\s*([a-zA-Z0-9-_.]+)\s*\}`)
+
+// interpolate splits a quoted string into its unescaped literal parts and its interpolated references
+func interpolate(text string) (elems []interface{}, hasRef bool) {
+	var literal string
+	last := 0
+	for _, match := range interpolationRegex.FindAllStringSubmatchIndex(text, -1) {
+		literal += text[last:match[0]]
+		last = match[1]
+		if match[2] < 0 {
+			literal += "${"
+			continue
+		}
+		if literal != "" {
+			elems = append(elems, InterfaceNode{i: literal})
+			literal = ""
+		}
+		elems = append(elems, RefNode{key: text[match[2]:match[3]]})
+		hasRef = true
+	}
+	literal += text[last:]
+	if literal != "" || len(elems) == 0 {
+		elems = append(elems, InterfaceNode{i: literal})
+	}
+	return
+}
+
+// escapeInterpolation escapes a literal string so that it is not interpolated once quoted in a template
+func escapeInterpolation(str string) string {
+	return interpolationRegex.ReplaceAllStringFunc(str, func(s string) string { return "$" + s })
+}
@@ -45,6 +45,9 @@ func (n *RightExpressionNode) Result() interface{} {
 		}
 		return arr
 	case ConcatenationNode:
+		if v.HasRefs() {
+			return nil
+		}
 		return v.Concat()
 	default:
 		return n.i
@@ -78,6 +81,18 @@ type CommandNode struct {
 	Action, Entity string
 	ParamNodes     map[string]interface{}
 	Refs           map[string]interface{}
+
+	// Retry and Timeout (in seconds) are the statement modifiers
+	// applied by the runner to the command run (0 when unset)
+	Retry, Timeout int
+
+	// AwaitsEventualConsistency is set when the command references a resource created
+	// earlier in the same template that AWS does not make available immediately (ex: IAM)
+	AwaitsEventualConsistency bool
+
+	// GeneratedSecrets are the secrets generated per param key by the successful run of the command.
+	// They are neither in the template text nor in its run log, their display being left to the caller.
+	GeneratedSecrets map[string]string
 }
 
 type RefNode struct {
@@ -117,7 +132,10 @@ func (n AliasNode) Alias() string {
 }
 
 func (n AliasNode) String() string {
-	return "@" + n.key
+	if SimpleStringValue.MatchString(n.key) {
+		return "@" + n.key
+	}
+	return "@" + Quote(n.key)
 }
 
 type HoleNode struct {
@@ -187,6 +205,8 @@ func (n ConcatenationNode) Concat() string {
 		switch ee := e.(type) {
 		case InterfaceNode:
 			arr = append(arr, fmt.Sprint(ee.i))
+		case ConcatenationNode:
+			arr = append(arr, ee.Concat())
 		default:
 			arr = append(arr, fmt.Sprint(ee))
 		}
@@ -194,33 +214,88 @@ func (n ConcatenationNode) Concat() string {
 	return strings.Join(arr, "")
 }
 
+// HasRefs returns true when references are interpolated in the concatenation (ex: 'web-${env}')
+func (n ConcatenationNode) HasRefs() bool {
+	for _, e := range n.arr {
+		if _, isRef := e.(RefNode); isRef {
+			return true
+		}
+	}
+	return false
+}
+
+// ResolveRefs returns the concatenated string with its references resolved,
+// or false if some of its references cannot be resolved
+func (n ConcatenationNode) ResolveRefs(refs map[string]interface{}) (string, bool) {
+	var arr []string
+	for _, e := range n.arr {
+		switch ee := e.(type) {
+		case RefNode:
+			v, ok := refs[ee.key]
+			if !ok {
+				return "", false
+			}
+			arr = append(arr, fmt.Sprint(v))
+		case InterfaceNode:
+			arr = append(arr, fmt.Sprint(ee.i))
+		default:
+			arr = append(arr, fmt.Sprint(ee))
+		}
+	}
+	return strings.Join(arr, ""), true
+}
+
 func (n ConcatenationNode) String() string {
 	var hasUnresolvedHole bool
-	var elems []string
 	for _, val := range n.arr {
 		if _, has := val.(HoleNode); has {
 			hasUnresolvedHole = true
 			break
 		}
 	}
+	hasRef := n.HasRefs()
+
+	var elems []string
+	var quoted string
+	var inQuote bool
+	flushQuote := func() {
+		if inQuote {
+			elems = append(elems, quote(quoted))
+			quoted, inQuote = "", false
+		}
+	}
 	for _, val := range n.arr {
 		switch node := val.(type) {
 		case InterfaceNode:
-			if str, isStr := node.i.(string); isStr {
-				if hasUnresolvedHole {
-					elems = append(elems, Quote(str))
-				} else {
-					elems = append(elems, str)
-				}
+			str, isStr := node.i.(string)
+			if !isStr {
+				str = fmt.Sprint(node.i)
+			}
+			if hasRef {
+				quoted, inQuote = quoted+escapeInterpolation(str), true
+			} else if hasUnresolvedHole {
+				elems = append(elems, Quote(str))
 			} else {
-				panic(fmt.Sprintf("concatenation node expects only strings and holes: got %T", node.i))
+				elems = append(elems, str)
 			}
-		default:
+		case RefNode:
+			quoted, inQuote = quoted+"${"+node.key+"}", true
+		case HoleNode:
+			flushQuote()
 			elems = append(elems, fmt.Sprint(val))
+		default:
+			if hasRef {
+				quoted, inQuote = quoted+escapeInterpolation(fmt.Sprint(val)), true
+			} else {
+				elems = append(elems, fmt.Sprint(val))
+			}
 		}
 	}
+	flushQuote()
 	if hasUnresolvedHole {
 		return strings.Join(elems, "+")
+	} else if hasRef {
+		return strings.Join(elems, "")
 	} else {
 		return quoteStringIfNeeded(strings.Join(elems, ""))
 	}
@@ -6,7 +6,7 @@ import (
 	"strings"
 )
 
-var SimpleStringValue = regexp.MustCompile("^[a-zA-Z0-9-._:/+;~@<>*]+$") // in sync with [a-zA-Z0-9-._:/+;~@<>]+ in PEG (with ^ and $ around)
+var SimpleStringValue = regexp.MustCompile("^[a-zA-Z0-9-._:/+;~@<>*()]+$") // in sync with [a-zA-Z0-9-._:/+;~@<>*()]+ in PEG (with ^ and $ around)
 
 func quoteStringIfNeeded(input string) string {
 	if _, err := strconv.Atoi(input); err == nil {
@@ -23,6 +23,10 @@ func quoteStringIfNeeded(input string) string {
 }
 
 func Quote(str string) string {
+	return quote(escapeInterpolation(str))
+}
+
+func quote(str string) string {
 	if strings.ContainsRune(str, '\'') {
 		return "\"" + str + "\""
 	} else {
@@ -14,9 +14,17 @@ func VerifyRefs(tree Node) error {
 
 	v := newVisitor()
 	v.onRefs = func(parent interface{}, node RefNode) {
-		if !contains(v.declaredVariables, node.key) {
-			addErr(fmt.Sprintf("using reference '$%s' but '%[1]s' is undefined in template", node.key))
+		if contains(v.declaredVariables, node.key) {
+			return
 		}
+		if ident, _, isProp := SplitPropertyRef(node.key); isProp && contains(v.declaredVariables, ident) {
+			if a, ok := tree.(*AST); !ok || isCommandDeclaration(a, ident) {
+				return
+			}
+			addErr(fmt.Sprintf("using property reference '$%s' but '%s' is not assigned the result of a command", node.key, ident))
+			return
+		}
+		addErr(fmt.Sprintf("using reference '$%s' but '%[1]s' is undefined in template", node.key))
 	}
 	v.visit(tree)
 
@@ -26,6 +34,26 @@ func VerifyRefs(tree Node) error {
 		}
 	}
 
+	if a, ok := tree.(*AST); ok {
+		var names []string
+		for _, out := range a.Outputs {
+			if contains(names, out.Name) {
+				addErr(fmt.Sprintf("output '%s' declared more than once in template", out.Name))
+			}
+			names = append(names, out.Name)
+			if !isCommandDeclaration(a, out.Ref) {
+				addErr(fmt.Sprintf("output '%s': '$%s' is not assigned the result of a command in template", out.Name, out.Ref))
+			}
+		}
+		for _, as := range a.Assertions {
+			for _, cond := range as.Conditions {
+				if key, isRef := cond.RefKey(); isRef && declarationIndex(a, key) >= as.Before {
+					addErr(fmt.Sprintf("assertion '%s': '$%s' is not assigned before the assertion in template", as, key))
+				}
+			}
+		}
+	}
+
 	if len(errs) > 0 {
 		return errors.New(strings.Join(errs, "; "))
 	}
@@ -49,6 +77,8 @@ func ProcessRefs(tree Node, fillers map[string]interface{}) {
 			switch p := parent.(type) {
 			case ListNode:
 				p.arr[v.listIndex] = val
+			case ConcatenationNode:
+				p.arr[v.concatItemIndex] = val
 			case *CommandNode:
 				p.ParamNodes[v.key] = val
 			case *RightExpressionNode:
@@ -155,6 +185,15 @@ func ProcessHoles(tree Node, fillers map[string]interface{}) map[string]interfac
 	return processed
 }
 
+func CollectRefs(tree Node) (refs []RefNode) {
+	v := newVisitor()
+	v.onRefs = func(parent interface{}, node RefNode) {
+		refs = append(refs, node)
+	}
+	v.visit(tree)
+	return
+}
+
 func CollectAliases(tree Node) (aliases []AliasNode) {
 	v := newVisitor()
 	v.onAliases = func(parent interface{}, node AliasNode) {
@@ -269,6 +308,35 @@ func (v *visitor) visit(tree Node) {
 	}
 }
 
+// SplitPropertyRef splits a reference to the property of a created resource (ex: inst.publicip)
+func SplitPropertyRef(key string) (ident, property string, ok bool) {
+	if i := strings.Index(key, "."); i > 0 && i < len(key)-1 {
+		return key[:i], key[i+1:], true
+	}
+	return key, "", false
+}
+
+func isCommandDeclaration(tree *AST, ident string) bool {
+	for _, st := range tree.Statements {
+		if decl, ok := st.Node.(*DeclarationNode); ok && decl.Ident == ident {
+			_, isCmd := decl.Expr.(*CommandNode)
+			return isCmd
+		}
+	}
+	return false
+}
+
+// declarationIndex returns the index of the statement declaring the given identifier,
+// or the number of statements if not declared
+func declarationIndex(tree *AST, ident string) int {
+	for i, st := range tree.Statements {
+		if decl, ok := st.Node.(*DeclarationNode); ok && decl.Ident == ident {
+			return i
+		}
+	}
+	return len(tree.Statements)
+}
+
 func contains(arr []string, s string) bool {
 	for _, v := range arr {
 		if v == s {
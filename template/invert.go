@@ -0,0 +1,155 @@
+package template
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/internal/ast"
+)
+
+// params of create commands whose value is the result of the command (ex: created bucket name)
+var createResultParams = map[string]string{
+	"bucket":              "name",
+	"launchconfiguration": "name",
+	"scalinggroup":        "name",
+	"alarm":               "name",
+	"dbsubnetgroup":       "name",
+	"keypair":             "name",
+	"s3object":            "name",
+	"database":            "id",
+}
+
+// Invert statically generates the template undoing this template (deletes, detaches, etc.
+// in reverse dependency order) without any of its executions, for instance when the run
+// to revert was not logged. Not knowing the IDs of the created resources, it refers to them
+// by their name as aliases (ex: @my-vpc) or else with holes to fill at run time (ex: {vpc.id}).
+func (temp *Template) Invert() (*Template, error) {
+	inv := &inverter{raw: make(map[string]string), holes: make(map[string]bool)}
+
+	tpl, _, err := Compile(&Template{AST: temp.AST.Clone()}, new(noopCompileEnv), []compileFunc{
+		inlineVariableValuePass,
+		inv.markUnresolvedPass,
+		resolveParamsAndExtractRefsPass,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invert: %s", err)
+	}
+
+	refs := make(map[string]interface{})
+	for _, st := range tpl.Statements {
+		var cmd *ast.CommandNode
+		var ident string
+		switch n := st.Node.(type) {
+		case *ast.CommandNode:
+			cmd = n
+		case *ast.DeclarationNode:
+			c, ok := n.Expr.(*ast.CommandNode)
+			if !ok {
+				continue
+			}
+			cmd, ident = c, n.Ident
+		default:
+			continue
+		}
+		cmd.ProcessRefs(refs)
+		cmd.CmdResult = inv.result(cmd, ident)
+		if ident != "" {
+			refs[ident] = cmd.CmdResult
+		}
+	}
+
+	reverted, err := tpl.Revert()
+	if err != nil {
+		return nil, fmt.Errorf("invert: %s", err)
+	}
+
+	text := reverted.String()
+	for marker, raw := range inv.raw {
+		text = strings.Replace(text, marker, raw, -1)
+	}
+	inverted, err := Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invert: \n%s\n%s", text, err)
+	}
+	return inverted, nil
+}
+
+// inverter stands for the values unknown statically (created IDs, holes, aliases) with
+// markers going through the revert as plain strings, replaced by their raw text at the end
+type inverter struct {
+	raw   map[string]string
+	holes map[string]bool
+}
+
+func (inv *inverter) marker(raw string) string {
+	m := fmt.Sprintf("invert-marker-%d-", len(inv.raw))
+	inv.raw[m] = raw
+	return m
+}
+
+func (inv *inverter) hole(key string) string {
+	unique := key
+	for i := 2; inv.holes[unique]; i++ {
+		unique = fmt.Sprintf("%s%d", key, i)
+	}
+	inv.holes[unique] = true
+	return inv.marker("{" + unique + "}")
+}
+
+func (inv *inverter) markUnresolvedPass(tpl *Template, cenv env.Compiling) (*Template, env.Compiling, error) {
+	mark := func(v interface{}) interface{} {
+		switch n := v.(type) {
+		case ast.HoleNode, ast.AliasNode:
+			return inv.marker(fmt.Sprint(n))
+		}
+		return v
+	}
+	for _, cmd := range tpl.CommandNodesIterator() {
+		for k, v := range cmd.ParamNodes {
+			if list, ok := v.(ast.ListNode); ok {
+				var arr []interface{}
+				for _, e := range list.Elems() {
+					arr = append(arr, mark(e))
+				}
+				cmd.ParamNodes[k] = ast.NewListNode(arr)
+				continue
+			}
+			cmd.ParamNodes[k] = mark(v)
+		}
+	}
+	return tpl, cenv, nil
+}
+
+// result returns what stands for the result of the command in the inverted template
+func (inv *inverter) result(cmd *ast.CommandNode, ident string) interface{} {
+	name := ident
+	if name == "" {
+		name = cmd.Entity
+	}
+	switch cmd.Action {
+	case "create", "copy":
+		if param, ok := createResultParams[cmd.Entity]; ok && cmd.Action == "create" {
+			if v, ok := cmd.ParamNodes[param].(string); ok {
+				return v
+			}
+			return inv.hole(name + "." + param)
+		}
+		if v, ok := cmd.ParamNodes["name"].(string); ok && cmd.Action == "create" && inv.raw[v] == "" {
+			return inv.marker("@" + quoteParamIfNeeded(v))
+		}
+		return inv.hole(name + ".id")
+	case "attach":
+		switch cmd.Entity {
+		case "routetable", "elasticip":
+			return inv.hole(name + ".association")
+		case "networkinterface":
+			return inv.hole(name + ".attachment")
+		}
+	case "start":
+		if cmd.Entity == "containertask" {
+			return inv.hole(name + ".run-arn")
+		}
+	}
+	return inv.hole(name + ".result")
+}
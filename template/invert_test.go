@@ -0,0 +1,53 @@
+package template
+
+import "testing"
+
+func TestInvertTemplate(t *testing.T) {
+	tcases := []struct {
+		name, template, expect string
+	}{
+		{
+			name: "created resources by name or hole",
+			template: `cidr = 10.0.0.0/16
+vpc = create vpc cidr=$cidr name=my-vpc
+gw = create internetgateway
+attach internetgateway id=$gw vpc=$vpc
+subnet = create subnet cidr=10.0.0.0/24 vpc=$vpc name='web subnet'
+rt = create routetable vpc=$vpc
+attach routetable id=$rt subnet=$subnet
+kp = create keypair name=mykey
+create instance subnet=$subnet image=ami-123 type=t2.micro count=1 name=web keypair=$kp`,
+			expect: `delete instance id=@web
+check instance id=@web state=terminated timeout=180
+delete keypair name=mykey
+detach routetable association={routetable.association}
+delete routetable id={rt.id}
+delete subnet id=@'web subnet'
+detach internetgateway id={gw.id} vpc=@my-vpc
+delete internetgateway id={gw.id}
+delete vpc id=@my-vpc`,
+		},
+		{
+			name:     "unnamed resources of the same type",
+			template: "create subnet cidr=10.0.0.0/24 vpc=@my-vpc\ncreate subnet cidr=10.0.1.0/24 vpc=@my-vpc",
+			expect:   "delete subnet id={subnet.id2}\ndelete subnet id={subnet.id}",
+		},
+		{
+			name:     "holes and aliases kept",
+			template: "create instance subnet={my.subnet} image=ami-123 type=t2.micro count=1 name={instance.name}\nattach volume id=@data instance=@web device=/dev/sdh",
+			expect:   "detach volume device=/dev/sdh id=@data instance=@web\ncheck volume id=@data state=available timeout=180\ndelete instance id={instance.id}",
+		},
+	}
+
+	for _, tcase := range tcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			inverted, err := MustParse(tcase.template).Invert()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, want := inverted.String(), tcase.expect; got != want {
+				t.Fatalf("got:\n%s\nwant:\n%s", got, want)
+			}
+		})
+	}
+}
@@ -17,7 +17,9 @@ type TemplateExecution struct {
 	*Template
 	Author, Source, Locale string
 	Profile, Path, Message string
+	Stack                  string
 	Fillers                map[string]interface{}
+	ResolvedOutputs        map[string]interface{}
 }
 
 // Date extract the date from the ulid template identifier
@@ -57,15 +59,18 @@ func (t *TemplateExecution) MarshalJSON() ([]byte, error) {
 	out.Profile = t.Profile
 	out.Message = t.Message
 	out.Path = t.Path
+	out.Stack = t.Stack
 	out.Fillers = t.Fillers
 	if out.Fillers == nil {
 		out.Fillers = make(map[string]interface{}, 0) // friendlier for json, avoiding "fillers": null,
 	}
+	out.Outputs = t.ResolvedOutputs
 	out.Commands = []command{}
 
 	for _, cmd := range t.CommandNodesIterator() {
 		newCmd := command{}
 		newCmd.Line = cmd.String()
+		newCmd.Comment = t.CommentOf(cmd)
 		if cmd.CmdErr != nil {
 			newCmd.Errors = append(newCmd.Errors, cmd.CmdErr.Error())
 		}
@@ -100,8 +105,10 @@ func (t *TemplateExecution) UnmarshalJSON(b []byte) error {
 	t.Profile = v.Profile
 	t.Message = v.Message
 	t.Path = v.Path
+	t.Stack = v.Stack
 	t.Author = v.Author
 	t.Fillers = v.Fillers
+	t.ResolvedOutputs = v.Outputs
 
 	tpl := &Template{ID: v.ID, AST: &ast.AST{
 		Statements: make([]*ast.Statement, 0),
@@ -122,7 +129,7 @@ func (t *TemplateExecution) UnmarshalJSON(b []byte) error {
 			if len(c.Errors) > 0 {
 				n.CmdErr = errors.New(c.Errors[0])
 			}
-			tpl.Statements = append(tpl.Statements, &ast.Statement{Node: n})
+			tpl.Statements = append(tpl.Statements, &ast.Statement{Node: n, Comment: c.Comment})
 		}
 	}
 
@@ -171,12 +178,15 @@ type toJSON struct {
 	Profile  string                 `json:"profile,omitempty"`
 	Message  string                 `json:"message,omitempty"`
 	Path     string                 `json:"path,omitempty"`
+	Stack    string                 `json:"stack,omitempty"`
 	Fillers  map[string]interface{} `json:"fillers"`
 	Commands []command              `json:"commands"`
+	Outputs  map[string]interface{} `json:"outputs,omitempty"`
 }
 
 type command struct {
 	Line    string   `json:"line"`
+	Comment string   `json:"comment,omitempty"`
 	Errors  []string `json:"errors,omitempty"`
 	Results []string `json:"results,omitempty"`
 }
@@ -185,6 +185,48 @@ func TestTemplateExecutionUnmarshalFromJSON(t *testing.T) {
 	}
 }
 
+func TestTemplateExecutionCommentsMarshaling(t *testing.T) {
+	tplExec := &TemplateExecution{Template: MustParse("# rationale\ncreate vpc\ncreate subnet")}
+	b, err := json.Marshal(tplExec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), `"comment":"rationale"`) {
+		t.Fatalf("expected comment in %s", b)
+	}
+	unmarshaled := &TemplateExecution{}
+	if err = json.Unmarshal(b, unmarshaled); err != nil {
+		t.Fatal(err)
+	}
+	cmds := unmarshaled.CommandNodesIterator()
+	if got, want := unmarshaled.CommentOf(cmds[0]), "rationale"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	if got, want := unmarshaled.CommentOf(cmds[1]), ""; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestTemplateExecutionStackMarshaling(t *testing.T) {
+	b, err := json.Marshal(&TemplateExecution{Template: MustParse("create vpc")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(b), `"stack"`) {
+		t.Fatalf("expected no stack in %s", b)
+	}
+	if b, err = json.Marshal(&TemplateExecution{Template: MustParse("create vpc"), Stack: "demo-42"}); err != nil {
+		t.Fatal(err)
+	}
+	unmarshaled := &TemplateExecution{}
+	if err = json.Unmarshal(b, unmarshaled); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := unmarshaled.Stack, "demo-42"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
 func TestTemplateExecutionMarshalToJSON(t *testing.T) {
 	tmplWithErrors := MustParse("create vpc\ncreate subnet\ncreate instance")
 	tmplWithErrors.ID = "12345"
@@ -0,0 +1,105 @@
+package template
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/internal/ast"
+	"github.com/wallix/awless/template/params"
+)
+
+// Statement modifiers are given as params (ex: `create role name=ops retry=3 timeout=120`)
+// to any command not declaring a param with the same name
+const (
+	RetryModifier   = "retry"
+	TimeoutModifier = "timeout"
+)
+
+// StatementRetryInterval is the delay before the first retry of a failed statement, doubled on each retry
+var StatementRetryInterval = 2 * time.Second
+
+func extractStatementModifiersPass(tpl *Template, cenv env.Compiling) (*Template, env.Compiling, error) {
+	extractModifiers := func(node *ast.CommandNode) error {
+		required, optionals, _ := params.List(node.ParamsSpec().Rule())
+		declared := make(map[string]bool)
+		for _, k := range append(required, optionals...) {
+			declared[k] = true
+		}
+		for _, modifier := range []string{RetryModifier, TimeoutModifier} {
+			v, ok := node.ParamNodes[modifier]
+			if !ok || declared[modifier] {
+				continue
+			}
+			var n int
+			if value, isValue := v.(ast.InterfaceNode); isValue {
+				n, ok = value.Value().(int)
+			}
+			if !ok || n < 0 {
+				return tpl.withLine(node, cmdErr(node, fmt.Errorf("invalid statement modifier %s=%v: expecting a positive integer", modifier, v)))
+			}
+			switch modifier {
+			case RetryModifier:
+				node.Retry = n
+			case TimeoutModifier:
+				node.Timeout = n
+			}
+			delete(node.ParamNodes, modifier)
+		}
+		return nil
+	}
+	err := tpl.visitCommandNodesE(extractModifiers)
+	return tpl, cenv, err
+}
+
+// runCommandNode runs the command of the node, applying its retry and timeout modifiers.
+// Commands awaiting eventually consistent resources are also retried on the related errors.
+func runCommandNode(renv env.Running, n *ast.CommandNode) (interface{}, error) {
+	params, generated, err := commandParams(renv, n)
+	if err != nil {
+		return nil, err
+	}
+	interval := StatementRetryInterval
+	for attempt := 0; ; attempt++ {
+		res, err := runCommandNodeWithTimeout(renv, n, params)
+		if err == nil {
+			if len(generated) > 0 {
+				n.GeneratedSecrets = generated
+			}
+			return res, err
+		}
+		retries := n.Retry
+		if n.AwaitsEventualConsistency && isEventualConsistencyError(err) && retries < EventualConsistencyRetries {
+			retries = EventualConsistencyRetries
+		}
+		if attempt >= retries {
+			return res, err
+		}
+		renv.Log().Warningf("%s %s: attempt %d/%d failed, retrying in %s: %s", n.Action, n.Entity, attempt+1, retries+1, interval, err)
+		time.Sleep(interval)
+		interval *= 2
+	}
+}
+
+// As commands cannot be cancelled, a timed out command keeps running in background
+// but its result is ignored
+func runCommandNodeWithTimeout(renv env.Running, n *ast.CommandNode, params map[string]interface{}) (interface{}, error) {
+	if n.Timeout <= 0 {
+		return n.Run(renv, params)
+	}
+	type result struct {
+		res interface{}
+		err error
+	}
+	done := make(chan result, 1)
+	go func() {
+		res, err := n.Run(renv, params)
+		done <- result{res, err}
+	}()
+	select {
+	case r := <-done:
+		return r.res, r.err
+	case <-time.After(time.Duration(n.Timeout) * time.Second):
+		return nil, fmt.Errorf("timed out after %ds", n.Timeout)
+	}
+}
@@ -0,0 +1,94 @@
+package template_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wallix/awless/template"
+	"github.com/wallix/awless/template/driver/fake"
+)
+
+func TestStatementModifiers(t *testing.T) {
+	defer func(interval time.Duration) { template.StatementRetryInterval = interval }(template.StatementRetryInterval)
+	template.StatementRetryInterval = 0
+
+	driver := fake.NewDriver()
+	compile := func(text string) (*template.Template, error) {
+		compiled, _, err := template.Compile(template.MustParse(text), template.NewEnv().WithLookupCommandFunc(driver.Lookup).Build())
+		return compiled, err
+	}
+	run := func(text string) *template.Template {
+		compiled, cenv, err := template.Compile(template.MustParse(text), template.NewEnv().WithLookupCommandFunc(driver.Lookup).Build())
+		if err != nil {
+			t.Fatal(err)
+		}
+		ran, err := compiled.Run(template.NewRunEnv(cenv))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ran
+	}
+
+	t.Run("extracted from params", func(t *testing.T) {
+		compiled, err := compile("create vpc cidr=10.0.0.0/16 retry=3 timeout=120")
+		if err != nil {
+			t.Fatal(err)
+		}
+		cmd := compiled.CommandNodesIterator()[0]
+		if cmd.Retry != 3 || cmd.Timeout != 120 {
+			t.Fatalf("got retry=%d timeout=%d", cmd.Retry, cmd.Timeout)
+		}
+		if _, ok := cmd.ParamNodes["retry"]; ok {
+			t.Fatal("expected retry removed from params")
+		}
+		if got, want := cmd.String(), "create vpc cidr=10.0.0.0/16 retry=3 timeout=120"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	})
+
+	t.Run("command declaring the param", func(t *testing.T) {
+		compiled, err := compile("check instance id=i-1 state=running timeout=10")
+		if err != nil {
+			t.Fatal(err)
+		}
+		cmd := compiled.CommandNodesIterator()[0]
+		if cmd.Timeout != 0 {
+			t.Fatalf("got timeout modifier %d, want none", cmd.Timeout)
+		}
+		if got, want := cmd.ToDriverParams()["timeout"], 10; got != want {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, text := range []string{"create vpc cidr=10.0.0.0/16 retry=many", "create vpc cidr=10.0.0.0/16 timeout=-1"} {
+			if _, err := compile(text); err == nil || !strings.Contains(err.Error(), "invalid statement modifier") {
+				t.Fatalf("%s: expected modifier error, got %v", text, err)
+			}
+		}
+	})
+
+	t.Run("retry", func(t *testing.T) {
+		driver.Reset()
+		driver.FailTimesOn("create", "vpc", 2, errors.New("eventual consistency"))
+		ran := run("create vpc cidr=10.0.0.0/16 retry=2")
+		if err := ran.CommandNodesIterator()[0].Err(); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := len(driver.CallsFor("create", "vpc")), 3; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+
+		driver.Reset()
+		driver.FailTimesOn("create", "vpc", 2, errors.New("eventual consistency"))
+		ran = run("create vpc cidr=10.0.0.0/16 retry=1")
+		if err := ran.CommandNodesIterator()[0].Err(); err == nil || !strings.Contains(err.Error(), "eventual consistency") {
+			t.Fatalf("expected error, got %v", err)
+		}
+		if got, want := len(driver.CallsFor("create", "vpc")), 2; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+	})
+}
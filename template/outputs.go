@@ -0,0 +1,119 @@
+package template
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/internal/ast"
+)
+
+// PropertyFetcher fetches the property of a resource given its type and id
+type PropertyFetcher func(resourceType, id, property string) (interface{}, error)
+
+// ResolveOutputs resolves the outputs declared in a template that has run, fetching the properties
+// of the resources created by the commands they reference. Outputs without property resolve to the command result.
+func (s *Template) ResolveOutputs(fetch PropertyFetcher) (map[string]interface{}, error) {
+	if len(s.Outputs) == 0 {
+		return nil, nil
+	}
+	commands := make(map[string]*ast.CommandNode)
+	for _, st := range s.Statements {
+		if decl, ok := st.Node.(*ast.DeclarationNode); ok {
+			if cmd, isCmd := decl.Expr.(*ast.CommandNode); isCmd {
+				commands[decl.Ident] = cmd
+			}
+		}
+	}
+
+	outputs := make(map[string]interface{})
+	for _, out := range s.Outputs {
+		cmd, ok := commands[out.Ref]
+		if !ok {
+			return outputs, fmt.Errorf("output %s: '$%s' is not assigned the result of a command", out.Name, out.Ref)
+		}
+		if cmd.Err() != nil || cmd.Result() == nil {
+			return outputs, fmt.Errorf("output %s: '%s %s' has no result", out.Name, cmd.Action, cmd.Entity)
+		}
+		id := fmt.Sprint(cmd.Result())
+		if out.Property == "" {
+			outputs[out.Name] = id
+			continue
+		}
+		val, err := fetch(cmd.Entity, id, out.Property)
+		if err != nil {
+			return outputs, fmt.Errorf("output %s: %s", out.Name, err)
+		}
+		outputs[out.Name] = val
+	}
+	return outputs, nil
+}
+
+var (
+	propertyWaitTimeout   = 5 * time.Minute
+	propertyWaitFrequency = 5 * time.Second
+)
+
+// resolvePropertyRefs resolves the references of a command to properties of created resources (ex: $inst.publicip),
+// waiting for the resources to be ready with these properties set. It returns true if the template run has to stop.
+func resolvePropertyRefs(renv env.Running, n *ast.CommandNode, vars map[string]interface{}, entities map[string]string, line int) bool {
+	for _, key := range n.RefKeys() {
+		if _, done := vars[key]; done {
+			continue
+		}
+		ident, property, isProp := ast.SplitPropertyRef(key)
+		entity, isCmd := entities[ident]
+		if !isProp || !isCmd || vars[ident] == nil {
+			continue
+		}
+		id := fmt.Sprint(vars[ident])
+		if renv.IsDryRun() {
+			vars[key] = fmt.Sprintf("%s.%s", id, property)
+			continue
+		}
+		var fetch PropertyFetcher
+		if e, ok := renv.(*runEnv); ok {
+			fetch = e.fetchProperty
+		}
+		if fetch == nil {
+			n.CmdErr = statementError(fmt.Errorf("cannot resolve '$%s': no property fetcher", key), n, line)
+			renv.Log().MultiLineError(n.CmdErr)
+			return true
+		}
+		val, err := waitForProperty(renv, fetch, entity, id, property)
+		if err != nil {
+			n.CmdErr = statementError(fmt.Errorf("cannot resolve '$%s': %s", key, err), n, line)
+			renv.Log().MultiLineError(n.CmdErr)
+			return true
+		}
+		vars[key] = val
+	}
+	return false
+}
+
+func waitForProperty(renv env.Running, fetch PropertyFetcher, resourceType, id, property string) (interface{}, error) {
+	deadline := time.Now().Add(propertyWaitTimeout)
+	for {
+		val, err := fetch(resourceType, id, property)
+		if err == nil && val != nil && fmt.Sprint(val) != "" {
+			return val, nil
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timeout of %s expired: %v", propertyWaitTimeout, err)
+		}
+		renv.Log().Infof("waiting for %s of %s %s", property, resourceType, id)
+		time.Sleep(propertyWaitFrequency)
+	}
+}
+
+// ReferencedResults returns the results (ex: created IDs) of the succeeded commands
+// assigned to a reference (ex: 'inst = create instance'), by reference name
+func (s *Template) ReferencedResults() map[string]interface{} {
+	results := make(map[string]interface{})
+	for _, decl := range s.declarationNodesIterator() {
+		if cmd, ok := decl.Expr.(*ast.CommandNode); ok && cmd.Err() == nil && cmd.Result() != nil {
+			results[decl.Ident] = cmd.Result()
+		}
+	}
+	return results
+}
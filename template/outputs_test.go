@@ -0,0 +1,68 @@
+package template
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/template/internal/ast"
+)
+
+func TestResolveOutputs(t *testing.T) {
+	tpl := MustParse("inst = create instance\nvpc = create vpc\noutput ip = $inst.publicip\noutput id = $inst")
+	for _, st := range tpl.Statements {
+		cmd := st.Node.(*ast.DeclarationNode).Expr.(*ast.CommandNode)
+		cmd.CmdResult = fmt.Sprintf("new-%s-id", cmd.Entity)
+	}
+
+	var fetched []string
+	fetch := func(resourceType, id, property string) (interface{}, error) {
+		fetched = append(fetched, fmt.Sprintf("%s %s %s", resourceType, id, property))
+		return "1.2.3.4", nil
+	}
+	outputs, err := tpl.ResolveOutputs(fetch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := outputs, map[string]interface{}{"ip": "1.2.3.4", "id": "new-instance-id"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %#v, want %#v", got, want)
+	}
+	if got, want := fetched, []string{"instance new-instance-id publicip"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	t.Run("failed command", func(t *testing.T) {
+		tpl := MustParse("inst = create instance\noutput ip = $inst.publicip")
+		tpl.Statements[0].Node.(*ast.DeclarationNode).Expr.(*ast.CommandNode).CmdErr = errors.New("failed")
+		_, err := tpl.ResolveOutputs(fetch)
+		if err == nil || !strings.Contains(err.Error(), "output ip: 'create instance' has no result") {
+			t.Fatalf("expected error with specific message, got: %s", err)
+		}
+	})
+
+	t.Run("fetch error", func(t *testing.T) {
+		_, err := tpl.ResolveOutputs(func(string, string, string) (interface{}, error) { return nil, errors.New("no value") })
+		if err == nil || err.Error() != "output ip: no value" {
+			t.Fatalf("expected error with specific message, got: %s", err)
+		}
+	})
+}
+
+func TestReferencedResults(t *testing.T) {
+	tpl := MustParse("inst = create instance\nsub = create subnet\ncreate vpc\nname = my-name")
+	for i, cmd := range tpl.CommandNodesIterator() {
+		switch i {
+		case 0:
+			cmd.CmdResult = "i-1234"
+		case 1:
+			cmd.CmdErr = errors.New("failed")
+		case 2:
+			cmd.CmdResult = "vpc-1234"
+		}
+	}
+	if got, want := tpl.ReferencedResults(), map[string]interface{}{"inst": "i-1234"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %#v, want %#v", got, want)
+	}
+}
@@ -6,15 +6,22 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"regexp"
+	"sort"
 	"strings"
 )
 
 func Validate(all Validators, paramValues map[string]interface{}) error {
 	msg := bytes.NewBufferString("param validation:")
 	var hasErr bool
-	for key, vFn := range all {
+	var keys []string
+	for key := range all {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
 		if val, ok := paramValues[key]; ok {
-			if err := vFn(val, paramValues); err != nil {
+			if err := all[key](val, paramValues); err != nil {
 				hasErr = true
 				msg.WriteString(fmt.Sprintf("\n\t\t- param '%s': %s", key, err))
 			}
@@ -116,6 +123,54 @@ func IsIP(i interface{}, others map[string]interface{}) (err error) {
 	return
 }
 
+// All returns a validator failing on the first failing given validator
+func All(validators ...validatorFunc) validatorFunc {
+	return func(i interface{}, others map[string]interface{}) error {
+		for _, v := range validators {
+			if err := v(i, others); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// RequiresWhen returns a cross-params validator failing when the param has the given value
+// and any of the given keys is missing (ex: type=service requires deployment-name)
+func RequiresWhen(value string, keys ...string) validatorFunc {
+	return func(i interface{}, others map[string]interface{}) error {
+		if fmt.Sprint(i) != value {
+			return nil
+		}
+		for _, k := range keys {
+			if _, ok := others[k]; !ok {
+				return fmt.Errorf("missing required param '%s' when value is '%s'", k, value)
+			}
+		}
+		return nil
+	}
+}
+
+// MatchRegex returns a validator failing when the param does not match the regex.
+// The description of the expected value is used in the error message.
+func MatchRegex(description string, r *regexp.Regexp) validatorFunc {
+	return func(i interface{}, others map[string]interface{}) error {
+		s, err := toString(i)
+		if err != nil {
+			return err
+		}
+		if !r.MatchString(s) {
+			return fmt.Errorf("expected %s but got '%s'", description, s)
+		}
+		return nil
+	}
+}
+
+var (
+	IsARN          = MatchRegex("an ARN (arn:...)", regexp.MustCompile(`^arn:\S+$`))
+	IsInstanceType = MatchRegex("an instance type (ex: t2.micro)", regexp.MustCompile(`^[a-z][a-z0-9-]*\.[a-z0-9]+$`))
+)
+
 func toString(i interface{}) (string, error) {
 	s, ok := i.(string)
 	if !ok {
@@ -28,3 +28,59 @@ func TestValidation(t *testing.T) {
 		t.Fatalf("expected '%s' to contains: %s", got, want)
 	}
 }
+
+func TestValidators(t *testing.T) {
+	tcases := []struct {
+		validator func(interface{}, map[string]interface{}) error
+		value     interface{}
+		others    map[string]interface{}
+		expErr    string
+	}{
+		{validator: params.IsARN, value: "arn:aws:iam::aws:policy/ReadOnlyAccess"},
+		{validator: params.IsARN, value: "ReadOnlyAccess", expErr: "expected an ARN (arn:...) but got 'ReadOnlyAccess'"},
+		{validator: params.IsInstanceType, value: "t2.micro"},
+		{validator: params.IsInstanceType, value: "m4.16xlarge"},
+		{validator: params.IsInstanceType, value: "t2micro", expErr: "expected an instance type (ex: t2.micro) but got 't2micro'"},
+		{validator: params.RequiresWhen("service", "deployment-name"), value: "task"},
+		{validator: params.RequiresWhen("service", "deployment-name"), value: "service", others: map[string]interface{}{"deployment-name": "dep"}},
+		{validator: params.RequiresWhen("service", "deployment-name"), value: "service", expErr: "missing required param 'deployment-name' when value is 'service'"},
+		{validator: params.All(params.IsInEnumIgnoreCase("task", "service"), params.MinLengthOf(5)), value: "service"},
+		{validator: params.All(params.IsInEnumIgnoreCase("task", "service"), params.MinLengthOf(5)), value: "other", expErr: "expected any of [task service] but got 'other'"},
+		{validator: params.All(params.IsInEnumIgnoreCase("task", "service"), params.MinLengthOf(5)), value: "task", expErr: "expected min length of 5 but got 4"},
+	}
+	for i, tcase := range tcases {
+		err := params.Validate(params.Validators{"key": tcase.validator}, merge(tcase.others, "key", tcase.value))
+		if tcase.expErr == "" {
+			if err != nil {
+				t.Fatalf("%d: %s", i+1, err)
+			}
+			continue
+		}
+		if err == nil {
+			t.Fatalf("%d: expected error got none", i+1)
+		}
+		if got, want := err.Error(), tcase.expErr; !strings.Contains(got, want) {
+			t.Fatalf("%d: expected '%s' to contains: %s", i+1, got, want)
+		}
+	}
+}
+
+func TestValidationErrorsAreSorted(t *testing.T) {
+	vals := params.Validators{"b": params.MinLengthOf(3), "a": params.MinLengthOf(3), "c": params.MinLengthOf(3)}
+	err := params.Validate(vals, map[string]interface{}{"a": "x", "b": "x", "c": "x"})
+	if err == nil {
+		t.Fatal("expected error got none")
+	}
+	msg := err.Error()
+	if a, b, c := strings.Index(msg, "'a'"), strings.Index(msg, "'b'"), strings.Index(msg, "'c'"); !(a < b && b < c) {
+		t.Fatalf("expected sorted errors, got %s", msg)
+	}
+}
+
+func merge(m map[string]interface{}, k string, v interface{}) map[string]interface{} {
+	all := map[string]interface{}{k: v}
+	for key, val := range m {
+		all[key] = val
+	}
+	return all
+}
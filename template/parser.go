@@ -24,10 +24,30 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/template/internal/ast"
 )
 
-func Parse(text string) (tmpl *Template, err error) {
+// Parse parses a template written with any supported grammar version,
+// migrating deprecated syntax of older versions
+func Parse(text string) (*Template, error) {
+	version, err := Version(text)
+	if err != nil {
+		return nil, err
+	}
+	tmpl, err := parse(text)
+	if err != nil {
+		return nil, err
+	}
+	if renames := renamesSince(version); len(renames) > 0 {
+		for _, node := range tmpl.CommandNodesIterator() {
+			migrateCommandNode(node, renames)
+		}
+	}
+	return tmpl, nil
+}
+
+func parse(text string) (tmpl *Template, err error) {
 	defer func() { // as peg lib does not allow errors in Execute, we use panic to build the AST
 		if rerr := recover(); rerr != nil {
 			switch rerr.(type) {
@@ -39,6 +59,19 @@ func Parse(text string) (tmpl *Template, err error) {
 		}
 	}()
 
+	text, outputs, err := extractOutputs(text)
+	if err != nil {
+		return nil, err
+	}
+	text, origins, err := expandMacros(text)
+	if err != nil {
+		return nil, err
+	}
+	text, assertions, err := extractAssertions(text)
+	if err != nil {
+		return nil, err
+	}
+
 	if clean := strings.TrimSpace(text); clean == "" {
 		return nil, errors.New("empty template")
 	}
@@ -55,10 +88,325 @@ func Parse(text string) (tmpl *Template, err error) {
 	p.Execute()
 
 	tmpl.AST = p.AST
+	tmpl.AST.Outputs = outputs
+	tmpl.AST.Assertions = assertions
+	setStatementsLines(tmpl.AST, text)
+	for _, st := range tmpl.Statements {
+		if st.Line > 0 && st.Line <= len(origins) {
+			st.Line = origins[st.Line-1]
+		}
+	}
 
 	return
 }
 
+var outputRegex = regexp.MustCompile(`^\s*output\s+([a-zA-Z0-9-_.]+)\s*=\s*\$([a-zA-Z0-9-_]+)(\.([a-zA-Z0-9-_]+))?\s*$`)
+
+// extractOutputs extracts the 'output name = $ref.property' declarations from the template text,
+// blanking their lines so that the remaining statements keep their line numbers
+func extractOutputs(text string) (string, []*ast.Output, error) {
+	var outputs []*ast.Output
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if fields := strings.Fields(line); len(fields) == 0 || fields[0] != "output" {
+			continue
+		}
+		matches := outputRegex.FindStringSubmatch(line)
+		if matches == nil {
+			return text, nil, fmt.Errorf("template parsing: line %d: invalid output '%s': expected 'output name = $variable.property'", i+1, strings.TrimSpace(line))
+		}
+		outputs = append(outputs, &ast.Output{Name: matches[1], Ref: matches[2], Property: matches[4]})
+		lines[i] = ""
+	}
+	return strings.Join(lines, "\n"), outputs, nil
+}
+
+var (
+	assertExistsRegex = regexp.MustCompile(`^\s*assert\s+(not\s+)?exists\s+([a-z0-9]+)((\s+\S+)*)\s*$`)
+	assertCountRegex  = regexp.MustCompile(`^\s*assert\s+count\(\s*([a-z0-9]+)(\s+where\s+([^)]*))?\s*\)\s*(==|!=|>=|<=|>|<)\s*([0-9]+)\s*$`)
+	assertCondRegex   = regexp.MustCompile(`([a-zA-Z0-9-_.:]+)=('[^']*'|"[^"]*"|[^'"\s]+)`)
+	assertAndRegex    = regexp.MustCompile(`\s+and\s+`)
+)
+
+// extractAssertions extracts the 'assert [not] exists entity key=value ...' and 'assert count(entities where
+// key=value and ...) >= N' declarations from the template text, blanking their lines as for outputs.
+// Each assertion is attached to the statement following it, to be checked right before it runs.
+func extractAssertions(text string) (string, []*ast.Assertion, error) {
+	var assertions []*ast.Assertion
+	var statements int
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") || strings.HasPrefix(fields[0], "//") {
+			continue
+		}
+		if fields[0] != "assert" {
+			statements++
+			continue
+		}
+		as, err := parseAssertion(line)
+		if err != nil {
+			return text, nil, fmt.Errorf("template parsing: line %d: invalid assertion '%s': %s", i+1, strings.TrimSpace(line), err)
+		}
+		as.Line, as.Before = i+1, statements
+		assertions = append(assertions, as)
+		lines[i] = ""
+	}
+	return strings.Join(lines, "\n"), assertions, nil
+}
+
+func parseAssertion(line string) (*ast.Assertion, error) {
+	as := &ast.Assertion{}
+	var conditions string
+	if matches := assertCountRegex.FindStringSubmatch(line); matches != nil {
+		as.Count, as.Entity, as.Operator = true, cloud.SingularizeResource(matches[1]), matches[4]
+		as.Value, _ = strconv.Atoi(matches[5])
+		conditions = assertAndRegex.ReplaceAllString(" "+matches[3]+" ", " ")
+	} else if matches := assertExistsRegex.FindStringSubmatch(line); matches != nil {
+		as.Negated, as.Entity = matches[1] != "", cloud.SingularizeResource(matches[2])
+		conditions = matches[3]
+	} else {
+		return nil, errors.New("expected 'assert [not] exists entity key=value ...' or 'assert count(entities where key=value and ...) >= N'")
+	}
+	for _, matches := range assertCondRegex.FindAllStringSubmatch(conditions, -1) {
+		as.Conditions = append(as.Conditions, &ast.Condition{Key: matches[1], Value: strings.Trim(matches[2], `'"`)})
+	}
+	if rest := strings.TrimSpace(assertCondRegex.ReplaceAllString(conditions, "")); rest != "" {
+		return nil, fmt.Errorf("invalid condition '%s': expected key=value", rest)
+	}
+	return as, nil
+}
+
+var (
+	macroDefRegex   = regexp.MustCompile(`^\s*def\s+([a-zA-Z][a-zA-Z0-9_-]*)\s*\(([^)]*)\)\s*=(.*)$`)
+	macroCallRegex  = regexp.MustCompile(`^\s*([a-zA-Z][a-zA-Z0-9_-]*)\s*\((.*)\)\s*$`)
+	macroParamRegex = regexp.MustCompile(`^[a-zA-Z0-9-_.]+$`)
+	macroHoleRegex  = regexp.MustCompile(`\{\s*([a-zA-Z0-9-_.]+)\s*\}`)
+	macroDeclRegex  = regexp.MustCompile(`^\s*([a-zA-Z0-9-_]+)\s*=`)
+	macroRefRegex   = regexp.MustCompile(`\$(\{\s*)?([a-zA-Z0-9-_]+)`)
+)
+
+type macro struct {
+	name       string
+	params     []string
+	statements []string
+}
+
+// expandMacros expands the macros defined in the template text with 'def name(param, ...) = statement ; statement ...'
+// (or with their statements on the following indented lines): each 'name(arg, ...)' line is replaced by the statements
+// of the macro, with the {param} holes filled with the arguments as written. Macros can use the ones defined before them.
+// The variables declared in a macro are local to each call: they are prefixed with the macro name and the call number
+// (ex: 'inst' becomes 'webserver_2_inst' on the second call of 'webserver'), so that calling a macro twice compiles.
+// It returns the expanded text with, for each of its lines, the number of the line of the template it comes from.
+func expandMacros(text string) (string, []int, error) {
+	macros := make(map[string]*macro)
+	calls := make(map[string]int)
+	lines := strings.Split(text, "\n")
+	var expanded []string
+	var origins []int
+	for i := 0; i < len(lines); i++ {
+		line, lineNum := lines[i], i+1
+		if fields := strings.Fields(line); len(fields) > 0 && fields[0] == "def" {
+			matches := macroDefRegex.FindStringSubmatch(line)
+			if matches == nil {
+				return text, nil, fmt.Errorf("template parsing: line %d: invalid macro '%s': expected 'def name(param, ...) = statement ; ...'", lineNum, strings.TrimSpace(line))
+			}
+			m := &macro{name: matches[1]}
+			if _, exists := macros[m.name]; exists {
+				return text, nil, fmt.Errorf("template parsing: line %d: macro '%s' already defined", lineNum, m.name)
+			}
+			for _, param := range splitMacroList(matches[2]) {
+				if !macroParamRegex.MatchString(param) {
+					return text, nil, fmt.Errorf("template parsing: line %d: macro '%s': invalid parameter '%s'", lineNum, m.name, param)
+				}
+				m.params = append(m.params, param)
+			}
+			body := []string{matches[3]}
+			expanded, origins = append(expanded, ""), append(origins, lineNum)
+			for strings.TrimSpace(matches[3]) == "" && i+1 < len(lines) && isIndentedLine(lines[i+1]) {
+				i++
+				body = append(body, lines[i])
+				expanded, origins = append(expanded, ""), append(origins, i+1)
+			}
+			for _, statement := range macroStatements(body) {
+				called, err := expandMacroCall(statement, macros, nil)
+				if err != nil {
+					return text, nil, fmt.Errorf("template parsing: line %d: macro '%s': %s", lineNum, m.name, err)
+				}
+				m.statements = append(m.statements, called...)
+			}
+			if len(m.statements) == 0 {
+				return text, nil, fmt.Errorf("template parsing: line %d: macro '%s' has no statement", lineNum, m.name)
+			}
+			macros[m.name] = m
+			continue
+		}
+		statements, err := expandMacroCall(line, macros, calls)
+		if err != nil {
+			return text, nil, fmt.Errorf("template parsing: line %d: %s", lineNum, err)
+		}
+		for _, statement := range statements {
+			expanded, origins = append(expanded, statement), append(origins, lineNum)
+		}
+	}
+	return strings.Join(expanded, "\n"), origins, nil
+}
+
+// expandMacroCall returns the statements of the macro called on the line, or the line itself if not a macro call.
+// With calls counting the macro calls of the template, the variables declared in the macro are made local to this call
+// (without, as when expanding a macro in the definition of another, they stay local to the enclosing macro call).
+func expandMacroCall(line string, macros map[string]*macro, calls map[string]int) ([]string, error) {
+	matches := macroCallRegex.FindStringSubmatch(line)
+	if matches == nil {
+		return []string{line}, nil
+	}
+	m, ok := macros[matches[1]]
+	if !ok {
+		return nil, fmt.Errorf("undefined macro '%s'", matches[1])
+	}
+	args := splitMacroList(matches[2])
+	if len(args) != len(m.params) {
+		return nil, fmt.Errorf("macro '%s' expects %d arguments (%s), got %d", m.name, len(m.params), strings.Join(m.params, ", "), len(args))
+	}
+	values := make(map[string]string)
+	for i, arg := range args {
+		if arg == "" {
+			return nil, fmt.Errorf("macro '%s': empty argument for '%s'", m.name, m.params[i])
+		}
+		values[m.params[i]] = arg
+	}
+	body := m.statements
+	if calls != nil {
+		calls[m.name]++
+		body = renameMacroDeclarations(body, fmt.Sprintf("%s_%d_", m.name, calls[m.name]))
+	}
+	var statements []string
+	for _, statement := range body {
+		statements = append(statements, macroHoleRegex.ReplaceAllStringFunc(statement, func(hole string) string {
+			if v, ok := values[macroHoleRegex.FindStringSubmatch(hole)[1]]; ok {
+				return v
+			}
+			return hole
+		}))
+	}
+	return statements, nil
+}
+
+// renameMacroDeclarations prefixes the variables declared in the statements, and their references ($inst or ${inst.publicip}).
+// The declarations built from holes (ex: '{name}_inst') are left as is since named after the call arguments.
+func renameMacroDeclarations(statements []string, prefix string) []string {
+	declared := make(map[string]bool)
+	for _, statement := range statements {
+		if matches := macroDeclRegex.FindStringSubmatch(statement); matches != nil {
+			declared[matches[1]] = true
+		}
+	}
+	if len(declared) == 0 {
+		return statements
+	}
+	var renamed []string
+	for _, statement := range statements {
+		if matches := macroDeclRegex.FindStringSubmatchIndex(statement); matches != nil {
+			statement = statement[:matches[2]] + prefix + statement[matches[2]:]
+		}
+		renamed = append(renamed, macroRefRegex.ReplaceAllStringFunc(statement, func(ref string) string {
+			matches := macroRefRegex.FindStringSubmatch(ref)
+			if declared[matches[2]] {
+				return "$" + matches[1] + prefix + matches[2]
+			}
+			return ref
+		}))
+	}
+	return renamed
+}
+
+// macroStatements returns the statements of a macro body, separated by new lines or ';' (outside quotes)
+func macroStatements(body []string) (statements []string) {
+	for _, line := range body {
+		for _, statement := range splitOutsideQuotes(line, ';') {
+			if statement = strings.TrimSpace(statement); statement != "" && !strings.HasPrefix(statement, "#") && !strings.HasPrefix(statement, "//") {
+				statements = append(statements, statement)
+			}
+		}
+	}
+	return
+}
+
+// splitMacroList splits comma separated parameters or arguments, empty for a blank list
+func splitMacroList(s string) (list []string) {
+	if strings.TrimSpace(s) == "" {
+		return nil
+	}
+	for _, e := range splitOutsideQuotes(s, ',') {
+		list = append(list, strings.TrimSpace(e))
+	}
+	return
+}
+
+func splitOutsideQuotes(s string, sep rune) (parts []string) {
+	var quote rune
+	var start int
+	for i, r := range s {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"':
+			quote = r
+		case r == sep:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+func isIndentedLine(line string) bool {
+	return strings.TrimSpace(line) != "" && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t"))
+}
+
+// setStatementsLines sets the line number of the statements, knowing that
+// each statement holds on its own line, and that blank and comment lines are not statements.
+// The comment lines right above a statement are attached to it (except the version header).
+func setStatementsLines(tree *ast.AST, text string) {
+	var lines []int
+	var comments []string
+	var pending []string
+	for i, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			pending = nil
+			continue
+		}
+		if strings.HasPrefix(line, "#") || strings.HasPrefix(line, "//") {
+			if text := commentText(line); text != "" && !versionHeaderRegex.MatchString(line) {
+				pending = append(pending, text)
+			}
+			continue
+		}
+		lines = append(lines, i+1)
+		comments = append(comments, strings.Join(pending, "\n"))
+		pending = nil
+	}
+	if len(lines) != len(tree.Statements) {
+		return
+	}
+	for i, st := range tree.Statements {
+		st.Line = lines[i]
+		st.Comment = comments[i]
+	}
+}
+
+func commentText(line string) string {
+	if strings.HasPrefix(line, "//") {
+		line = strings.TrimPrefix(line, "//")
+	} else {
+		line = strings.TrimLeft(line, "#")
+	}
+	return strings.TrimSpace(line)
+}
+
 func MustParse(text string) *Template {
 	t, err := Parse(text)
 	if err != nil {
@@ -23,6 +23,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/wallix/awless/template/driver/fake"
 	"github.com/wallix/awless/template/internal/ast"
 )
 
@@ -67,6 +68,7 @@ func TestParseVariousTemplatesCorrectly(t *testing.T) {
 		{"support wildcard in quote", "create policy action=\"ec2:Get*\"", "create policy action=ec2:Get*"},
 		{"support single wildcard", "create policy resource=*", ""},
 		{"support parameter value beginning with number", "create keypair name=123test", ""},
+		{"support parentheses in parameter value", "create loginprofile password=auto(24) username=jdoe", ""},
 		{"support prefix/suffixes around holes (values)", "name = prefix-{instance.name}-{instance.version}-suffix", "name = 'prefix-'+{instance.name}+'-'+{instance.version}+'-suffix'"},
 		{"support prefix/suffixes around holes (params)", "instance = create instance name=prefix-{instance.name}-{instance.version}-suffix", "instance = create instance name='prefix-'+{instance.name}+'-'+{instance.version}+'-suffix'"},
 		{"support suffix after holes", "instance = create instance name={instance.name}-suffix", "instance = create instance name={instance.name}+'-suffix'"},
@@ -74,6 +76,10 @@ func TestParseVariousTemplatesCorrectly(t *testing.T) {
 		{"support concatenation with '+' of quoted string and holes", "instance = create instance name='prefix-'+{instance.name}+{instance.version}+'-suffix'", ""},
 		{"support concatenation with '+' of quoted string and holes", "instance = create instance name='pre${}fix-' + {instance.name}+'middle-' +{instance.version}+ '-suffix'", "instance = create instance name='pre${}fix-'+{instance.name}+'middle-'+{instance.version}+'-suffix'"},
 		{"support concatenation with '+' of quoted string and holes with a hole as prefix", "instance = create instance name={instance.name}+'midl${}fix-'+'midle2${}fix-'+{instance.version}+'-suffix'", ""},
+		{"support references interpolated in quoted strings", "create tag key=Name resource=$inst value='${env}-web-${index}'", ""},
+		{"support references interpolated in quoted strings with holes", "create instance name='${env}-'+{instance.name}+'-web'", ""},
+		{"support escaped interpolation in quoted strings", "create instance name='cost $${amount}'", ""},
+		{"support escaped and interpolated references in quoted strings", "create instance name=\"a $${b} ${c}\"", "create instance name='a $${b} ${c}'"},
 	}
 
 	for _, tcase := range tcases {
@@ -231,6 +237,116 @@ func TestParsingEmptyTemplate(t *testing.T) {
 	}
 }
 
+func TestParsingOutputs(t *testing.T) {
+	tpl, err := Parse("inst = create instance\n\noutput publicip = $inst.PublicIP\ncreate tag key=Env resource=$inst value=test\n  output id=$inst  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(tpl.Statements), 2; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := tpl.Statements[1].Line, 4; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	exp := []*ast.Output{{Name: "publicip", Ref: "inst", Property: "PublicIP"}, {Name: "id", Ref: "inst"}}
+	if got, want := tpl.Outputs, exp; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %#v, want %#v", got, want)
+	}
+	if got, want := tpl.String(), "inst = create instance\ncreate tag key=Env resource=$inst value=test\noutput publicip = $inst.PublicIP\noutput id = $inst"; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+
+	_, err = Parse("inst = create instance\noutput publicip = inst.publicip")
+	if err == nil || !strings.Contains(err.Error(), "line 2: invalid output") {
+		t.Fatalf("expected error with specific message, got: %s", err)
+	}
+}
+
+func TestParsingMacros(t *testing.T) {
+	text := `def webserver(name, subnet) = inst = create instance name={name} subnet={subnet} keypair={keypair} ; create record zone=example.com name={name} value=$inst.publicip
+def tagged(name, env) =
+  # the instance then its tag
+  webserver({name}, @public)
+  create tag resource=$inst key=Env value={env}
+
+webserver(web-1, subnet-1234)
+tagged('web 2', prod)`
+	tpl, err := Parse(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := `webserver_1_inst = create instance keypair={keypair} name=web-1 subnet=subnet-1234
+create record name=web-1 value=$webserver_1_inst.publicip zone=example.com
+tagged_1_inst = create instance keypair={keypair} name='web 2' subnet=@public
+create record name='web 2' value=$tagged_1_inst.publicip zone=example.com
+create tag key=Env resource=$tagged_1_inst value=prod`
+	if got, want := tpl.String(), exp; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+	for i, want := range []int{7, 7, 8, 8, 8} {
+		if got := tpl.Statements[i].Line; got != want {
+			t.Fatalf("statement %d: got line %d, want %d", i+1, got, want)
+		}
+	}
+	cenv := NewEnv().WithLookupCommandFunc(fake.NewDriver().Lookup).
+		WithMissingHolesFunc(func(string, []string, bool) string { return "any" }).
+		WithAliasFunc(func(paramPath, alias string) string { return "subnet-5678" }).Build()
+	if _, _, err = Compile(tpl, cenv, TestCompileMode); err != nil {
+		t.Fatal(err)
+	}
+
+	tcases := []struct {
+		text, expErr string
+	}{
+		{text: "def webserver(name) create instance name={name}", expErr: "line 1: invalid macro"},
+		{text: "def webserver(name) = create instance name={name}\ndef webserver(name) = create user name={name}", expErr: "line 2: macro 'webserver' already defined"},
+		{text: "def webserver(name) = create instance name={name}\nwebserver(web-1, web-2)", expErr: "line 2: macro 'webserver' expects 1 arguments (name), got 2"},
+		{text: "create vpc\nwebserver(web-1)", expErr: "line 2: undefined macro 'webserver'"},
+		{text: "def loop(name) = loop({name})", expErr: "line 1: macro 'loop': undefined macro 'loop'"},
+		{text: "def empty(name) =\ncreate vpc", expErr: "line 1: macro 'empty' has no statement"},
+	}
+	for _, tcase := range tcases {
+		if _, err := Parse(tcase.text); err == nil || !strings.Contains(err.Error(), tcase.expErr) {
+			t.Fatalf("%q: expected error containing %q, got %v", tcase.text, tcase.expErr, err)
+		}
+	}
+}
+
+func TestParsingComments(t *testing.T) {
+	text := `# awless-template-version: 2
+# isolate the new service
+# (see change request #42)
+vpc = create vpc cidr=10.0.0.0/16
+
+# unrelated comment
+
+create subnet cidr=10.0.0.0/24 vpc=$vpc
+######
+// a public access
+create internetgateway`
+	tpl, err := Parse(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []string{"isolate the new service\n(see change request #42)", "", "a public access"}
+	if got, want := len(tpl.Statements), len(exp); got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	for i, st := range tpl.Statements {
+		if got, want := st.Comment, exp[i]; got != want {
+			t.Fatalf("%d: got %q, want %q", i+1, got, want)
+		}
+	}
+	expString := "# isolate the new service\n# (see change request #42)\nvpc = create vpc cidr=10.0.0.0/16\ncreate subnet cidr=10.0.0.0/24 vpc=$vpc\n# a public access\ncreate internetgateway"
+	if got, want := tpl.String(), expString; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+	reparsed := MustParse(tpl.String())
+	if got, want := reparsed.String(), expString; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
 func TestWrapPegParseError(t *testing.T) {
 	t.Run("Display better error message", func(t *testing.T) {
 		text := "create subnet\ncreate instance type= wrong=\ncreate vpc"
@@ -94,6 +94,14 @@ func TestCheckInvalidReferencesDeclarationPass(t *testing.T) {
 		{"new_inst = create instance autoref=$new_inst\n", "'new_inst' is undefined in template"},
 		{"a = $test", "'test' is undefined in template"},
 		{"b = [test1,$test2,{test4}]", "'test2' is undefined in template"},
+		{"inst = create instance\ncreate record values=$inst.publicip", ""},
+		{"inst = create instance\ncreate record values='${inst.publicip}'", ""},
+		{"create record values=$inst.publicip\ninst = create instance", "'inst.publicip' is undefined in template"},
+		{"ip = 127.0.0.1\ncreate record values=$ip.address", "'ip' is not assigned the result of a command"},
+		{"inst = create instance\noutput ip = $inst.publicip\noutput id = $inst", ""},
+		{"inst = create instance\noutput ip = $instance.publicip", "output 'ip': '$instance' is not assigned the result of a command"},
+		{"ip = 127.0.0.1\noutput myip = $ip", "output 'myip': '$ip' is not assigned the result of a command"},
+		{"inst = create instance\noutput ip = $inst.publicip\noutput ip = $inst.privateip", "output 'ip' declared more than once"},
 	}
 
 	for i, tcase := range tcases {
@@ -268,6 +276,8 @@ func TestResolveMissingSuggestedPass(t *testing.T) {
 		switch paramPaths[0] {
 		case "create.instance.ip":
 			return "1.2.3.4"
+		case "create.instance.ipv6-count":
+			return "1"
 		case "create.instance.keypair":
 			return "mykeypair"
 		case "create.instance.lock":
@@ -320,10 +330,10 @@ func TestResolveMissingSuggestedPass(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if got, want := count, 5; got != want {
+	if got, want := count, 6; got != want {
 		t.Fatalf("got %d, want %d", got, want)
 	}
-	if got, want := compiled.String(), "create instance count=1 image=ami-1a17137a ip=1.2.3.4 keypair=mykeypair lock=true name=my-instance role=arole securitygroup=@my-sec-group subnet=sub-1234 type=t2.nano userdata=/path/to/my/file"; got != want {
+	if got, want := compiled.String(), "create instance count=1 image=ami-1a17137a ip=1.2.3.4 ipv6-count=1 keypair=mykeypair lock=true name=my-instance role=arole securitygroup=@my-sec-group subnet=sub-1234 type=t2.nano userdata=/path/to/my/file"; got != want {
 		t.Fatalf("got \n%s, want \n%s", got, want)
 	}
 }
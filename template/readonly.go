@@ -0,0 +1,26 @@
+package template
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ReadOnlyActions are the template actions not modifying cloud resources
+var ReadOnlyActions = map[string]struct{}{
+	"check": {},
+}
+
+// EnsureReadOnly returns an error listing the statements of the template
+// that would modify cloud resources
+func EnsureReadOnly(tpl *Template) error {
+	var mutating []string
+	for _, cmd := range tpl.CommandNodesIterator() {
+		if _, ok := ReadOnlyActions[cmd.Action]; !ok {
+			mutating = append(mutating, fmt.Sprintf("%s %s", cmd.Action, cmd.Entity))
+		}
+	}
+	if len(mutating) > 0 {
+		return fmt.Errorf("read-only mode: refusing to run mutating statements: %s", strings.Join(mutating, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package template_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/template"
+)
+
+func TestEnsureReadOnly(t *testing.T) {
+	if err := template.EnsureReadOnly(template.MustParse("check instance id=i-1234 state=running timeout=10")); err != nil {
+		t.Fatal(err)
+	}
+
+	err := template.EnsureReadOnly(template.MustParse("check instance id=i-1234 state=running timeout=10\ncreate vpc cidr=10.0.0.0/16\ndelete subnet id=sub-1234"))
+	if err == nil {
+		t.Fatal("expected error got none")
+	}
+	if got, want := err.Error(), "create vpc, delete subnet"; !strings.HasSuffix(got, want) {
+		t.Fatalf("%s should end with %s", got, want)
+	}
+
+	runner := &template.Runner{Template: template.MustParse("create vpc cidr=10.0.0.0/16"), ReadOnly: true}
+	err = runner.Run()
+	if err == nil || !strings.Contains(err.Error(), "read-only mode") {
+		t.Fatalf("expected read-only error, got %v", err)
+	}
+	if runErr, ok := err.(*template.RunError); !ok || runErr.Failure != template.ValidationFailure {
+		t.Fatalf("expected validation failure, got %#v", err)
+	}
+}
@@ -0,0 +1,36 @@
+package template
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ResumeFrom marks the commands of the template that already succeeded in a previous
+// execution of the same template, up to its first failed command. Running the template
+// then skips those commands and reuses their results (ex: created IDs) for references,
+// retrying from the failed command. It returns the number of commands skipped.
+func (s *Template) ResumeFrom(previous *TemplateExecution) (int, error) {
+	if previous == nil || previous.Template == nil {
+		return 0, errors.New("resume: no previous execution")
+	}
+
+	previousCmds := previous.CommandNodesIterator()
+	if stats := previous.Stats(); stats.KOCount == 0 {
+		return 0, fmt.Errorf("resume: all commands of %s succeeded, nothing to resume", previous.ID)
+	}
+
+	cmds := s.CommandNodesIterator()
+	resumed := make(map[int]interface{})
+	for i, prev := range previousCmds {
+		if prev.Err() != nil {
+			break
+		}
+		if i >= len(cmds) || cmds[i].Action != prev.Action || cmds[i].Entity != prev.Entity {
+			return 0, fmt.Errorf("resume: template differs from execution %s at command '%s'", previous.ID, prev)
+		}
+		resumed[i] = prev.Result()
+	}
+
+	s.resumed = resumed
+	return len(resumed), nil
+}
@@ -0,0 +1,89 @@
+package template_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/template"
+	"github.com/wallix/awless/template/driver/fake"
+)
+
+func TestResumeTemplate(t *testing.T) {
+	source := "vpc = create vpc cidr=10.0.0.0/16\nsubnet = create subnet cidr=10.0.0.0/24 vpc=$vpc\ncreate instance subnet=$subnet image=ami-123 type=t2.micro count=1 name=web"
+
+	run := func(driver *fake.Driver, previous *template.TemplateExecution) *template.TemplateExecution {
+		compiled, cenv, err := template.Compile(template.MustParse(source), template.NewEnv().WithLookupCommandFunc(driver.Lookup).Build())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if previous != nil {
+			skipped, err := compiled.ResumeFrom(previous)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, want := skipped, 1; got != want {
+				t.Fatalf("got %d skipped, want %d", got, want)
+			}
+		}
+		ran, err := compiled.Run(template.NewRunEnv(cenv))
+		if err != nil {
+			t.Fatal(err)
+		}
+		// persisted and loaded back as for `awless run --resume`
+		b, err := json.Marshal(&template.TemplateExecution{Template: ran, Source: source})
+		if err != nil {
+			t.Fatal(err)
+		}
+		loaded := &template.TemplateExecution{}
+		if err := json.Unmarshal(b, loaded); err != nil {
+			t.Fatal(err)
+		}
+		return loaded
+	}
+
+	failing := fake.NewDriver()
+	failing.FailOn("create", "subnet", errors.New("subnet quota exceeded"))
+	failed := run(failing, nil)
+	if stats := failed.Stats(); stats.OKCount != 1 || stats.KOCount != 1 {
+		t.Fatalf("got %d OK and %d KO", stats.OKCount, stats.KOCount)
+	}
+
+	driver := fake.NewDriver()
+	resumed := run(driver, failed)
+
+	if calls := driver.CallsFor("create", "vpc"); len(calls) != 0 {
+		t.Fatalf("expected vpc not re-created, got %v", calls)
+	}
+	subnets := driver.CallsFor("create", "subnet")
+	if len(subnets) != 1 {
+		t.Fatalf("got %d subnet creations, want 1", len(subnets))
+	}
+	if got, want := subnets[0].Params["vpc"], "vpc-1"; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := driver.CallsFor("create", "instance")[0].Params["subnet"], "subnet-1"; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if stats := resumed.Stats(); stats.OKCount != 3 || stats.KOCount != 0 {
+		t.Fatalf("got %d OK and %d KO", stats.OKCount, stats.KOCount)
+	}
+	if got, want := resumed.CommandNodesIterator()[0].Result(), "vpc-1"; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	t.Run("nothing to resume", func(t *testing.T) {
+		compiled := template.MustParse(source)
+		if _, err := compiled.ResumeFrom(resumed); err == nil || !strings.Contains(err.Error(), "nothing to resume") {
+			t.Fatalf("got %v", err)
+		}
+	})
+
+	t.Run("different template", func(t *testing.T) {
+		compiled := template.MustParse("create keypair name=mykey\ncreate vpc cidr=10.0.0.0/16")
+		if _, err := compiled.ResumeFrom(failed); err == nil || !strings.Contains(err.Error(), "template differs") {
+			t.Fatalf("got %v", err)
+		}
+	})
+}
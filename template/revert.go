@@ -59,6 +59,9 @@ func (temp *Template) Revert() (*Template, error) {
 				case "mfadevice":
 					params = append(params, fmt.Sprintf("id=%s", printItem(cmd.ParamNodes["id"])))
 					params = append(params, fmt.Sprintf("user=%s", printItem(cmd.ParamNodes["user"])))
+				case "queuepolicy":
+					params = append(params, fmt.Sprintf("url=%s", printItem(cmd.ParamNodes["url"])))
+					params = append(params, fmt.Sprintf("policy=%s", printItem(cmd.ParamNodes["policy"])))
 				default:
 					for k, v := range cmd.ParamNodes {
 						params = append(params, fmt.Sprintf("%s=%v", k, v))
@@ -93,6 +96,9 @@ func (temp *Template) Revert() (*Template, error) {
 				}
 			case "create":
 				switch cmd.Entity {
+				case "records":
+					params = append(params, fmt.Sprintf("zone=%s", printItem(cmd.ParamNodes["zone"])))
+					params = append(params, fmt.Sprintf("records=%s", printItem(cmd.ParamNodes["records"])))
 				case "tag":
 					for k, v := range cmd.ParamNodes {
 						params = append(params, fmt.Sprintf("%s=%v", k, printItem(v)))
@@ -106,7 +112,7 @@ func (temp *Template) Revert() (*Template, error) {
 					}
 				case "route":
 					for k, v := range cmd.ParamNodes {
-						if k == "gateway" {
+						if k == "gateway" || k == "egressonlygateway" {
 							continue
 						}
 						params = append(params, fmt.Sprintf("%s=%v", k, printItem(v)))
@@ -140,7 +146,7 @@ func (temp *Template) Revert() (*Template, error) {
 					params = append(params, fmt.Sprintf("service-namespace=%s", printItem(cmd.ParamNodes["service-namespace"])))
 				case "loginprofile":
 					params = append(params, fmt.Sprintf("username=%s", printItem(cmd.ParamNodes["username"])))
-				case "bucket", "launchconfiguration", "scalinggroup", "alarm", "dbsubnetgroup", "keypair":
+				case "bucket", "launchconfiguration", "scalinggroup", "alarm", "dbsubnetgroup", "keypair", "elasticsearchdomain", "table", "application":
 					params = append(params, fmt.Sprintf("name=%s", quoteParamIfNeeded(cmd.CmdResult)))
 					if cmd.Entity == "scalinggroup" {
 						params = append(params, "force=true")
@@ -150,9 +156,12 @@ func (temp *Template) Revert() (*Template, error) {
 				}
 			case "delete":
 				switch cmd.Entity {
+				case "records":
+					params = append(params, fmt.Sprintf("zone=%s", printItem(cmd.ParamNodes["zone"])))
+					params = append(params, fmt.Sprintf("records=%s", printItem(cmd.ParamNodes["records"])))
 				case "record":
 					for k, v := range cmd.ParamNodes {
-						params = append(params, fmt.Sprintf("%s=%v", k, quoteParamIfNeeded(v)))
+						params = append(params, fmt.Sprintf("%s=%v", k, printItem(v)))
 					}
 				case "instanceprofile":
 					params = append(params, fmt.Sprintf("name=%s", printItem(cmd.ParamNodes["name"])))
@@ -237,6 +246,9 @@ func (temp *Template) Revert() (*Template, error) {
 				if cmd.Action == "create" && cmd.Entity == "natgateway" {
 					lines = append(lines, fmt.Sprintf("check natgateway id=%s state=deleted timeout=180", quoteParamIfNeeded(cmd.CmdResult)))
 				}
+				if cmd.Action == "create" && cmd.Entity == "environment" {
+					lines = append(lines, fmt.Sprintf("check environment id=%s state=Terminated timeout=900", quoteParamIfNeeded(cmd.CmdResult)))
+				}
 			}
 		}
 	}
@@ -273,7 +285,7 @@ func isRevertible(cmd *ast.CommandNode) bool {
 		return false
 	}
 
-	if cmd.Entity == "record" && (cmd.Action == "create" || cmd.Action == "delete") {
+	if (cmd.Entity == "record" || cmd.Entity == "records") && (cmd.Action == "create" || cmd.Action == "delete") {
 		return true
 	}
 
@@ -310,6 +322,11 @@ func isRevertible(cmd *ast.CommandNode) bool {
 		return true
 	}
 
+	if cmd.Entity == "queuepolicy" && cmd.Action == "detach" {
+		_, ok := cmd.ParamNodes["policy"]
+		return ok
+	}
+
 	if v, ok := cmd.CmdResult.(string); ok && v != "" {
 		if cmd.Action == "create" || cmd.Action == "start" || cmd.Action == "stop" || cmd.Action == "copy" {
 			return true
@@ -353,3 +370,8 @@ func quoteParamIfNeeded(param interface{}) string {
 		}
 	}
 }
+
+// QuoteParamIfNeeded returns the param value to write in a template text, quoted when not a simple string
+func QuoteParamIfNeeded(param interface{}) string {
+	return quoteParamIfNeeded(param)
+}
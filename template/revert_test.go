@@ -23,6 +23,7 @@ func TestRevertOneliner(t *testing.T) {
 		{in: "update securitygroup cidr=0.0.0.0/0 id=sg-12345 outbound=revoke portrange=443 protocol=tcp", exp: "update securitygroup cidr=0.0.0.0/0 id=sg-12345 outbound=authorize portrange=443 protocol=tcp"},
 		{in: "attach mfadevice id=my-mfa-device-id user=toto mfa-code-1=1234 mfa-code-2=2345", exp: "detach mfadevice id=my-mfa-device-id user=toto"},
 		{in: "detach mfadevice id=my-mfa-device-id user=toto", exp: "attach mfadevice id=my-mfa-device-id user=toto"},
+		{in: `attach queuepolicy url=https://sqs.eu-west-1.amazonaws.com/123456789012/my-queue policy='{"Statement":{"Sid":"FromTopic","Effect":"Allow"}}'`, exp: `detach queuepolicy policy='{"Statement":{"Sid":"FromTopic","Effect":"Allow"}}' url=https://sqs.eu-west-1.amazonaws.com/123456789012/my-queue`},
 
 		{in: "stop instance ids=inst-id-1", exp: "check instance id=inst-id-1 state=stopped timeout=180\nstart instance ids=inst-id-1", cmdResult: "inst-id-1"},
 		{in: "start instance ids=inst-id-1", exp: "check instance id=inst-id-1 state=running timeout=180\nstop instance ids=inst-id-1", cmdResult: "inst-id-1"},
@@ -96,7 +97,7 @@ func TestRevertTemplate(t *testing.T) {
 	})
 
 	t.Run("More advanced template", func(t *testing.T) {
-		compiled, _, err := Compile(MustParse("attach policy arn=stuff user=mrT\ncreate vpc cidr=10.0.0.0/16\ncreate subnet vpc=vpc-1234 cidr=10.0.0.0/24\nstart instance ids=i-54g3hj\ncreate tag key=Key resource=myinst value=Value\ncreate instance count=1 image=ami-1234 name=myinstance subnet=sub-1234 type=t2.nano"), env, NewRunnerCompileMode)
+		compiled, _, err := Compile(MustParse("attach policy arn=arn:aws:iam::aws:policy/stuff user=mrT\ncreate vpc cidr=10.0.0.0/16\ncreate subnet vpc=vpc-1234 cidr=10.0.0.0/24\nstart instance ids=i-54g3hj\ncreate tag key=Key resource=myinst value=Value\ncreate instance count=1 image=ami-1234 name=myinstance subnet=sub-1234 type=t2.nano"), env, NewRunnerCompileMode)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -120,7 +121,7 @@ func TestRevertTemplate(t *testing.T) {
 			t.Fatal(err)
 		}
 
-		exp := "delete tag key=Key resource=myinst value=Value\ncheck instance id=i-54g3hj state=running timeout=180\nstop instance ids=i-54g3hj\ndelete subnet id=sub-12345\ndelete vpc id=vpc-12345\ndetach policy arn=stuff user=mrT"
+		exp := "delete tag key=Key resource=myinst value=Value\ncheck instance id=i-54g3hj state=running timeout=180\nstop instance ids=i-54g3hj\ndelete subnet id=sub-12345\ndelete vpc id=vpc-12345\ndetach policy arn=arn:aws:iam::aws:policy/stuff user=mrT"
 		if got, want := reverted.String(), exp; got != want {
 			t.Fatalf("got: \n%s\n\nwant:\n%s\n", got, want)
 		}
@@ -180,6 +181,25 @@ delete securitygroup id=sg-54321`
 		}
 	})
 
+	t.Run("Terminate environments before deleting their application", func(t *testing.T) {
+		tpl := MustParse("create application name=my-app\ncreate environment application=my-app name=prod solution-stack=go")
+		results := []string{"my-app", "e-12345"}
+		for i, cmd := range tpl.CommandNodesIterator() {
+			cmd.CmdResult = results[i]
+		}
+		reverted, err := tpl.Revert()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		exp := `delete environment id=e-12345
+check environment id=e-12345 state=Terminated timeout=900
+delete application name=my-app`
+		if got, want := reverted.String(), exp; got != want {
+			t.Fatalf("got: %s\nwant: %s\n", got, want)
+		}
+	})
+
 	t.Run("Delete the copy of an image", func(t *testing.T) {
 		tpl := MustParse("copy image")
 		for _, cmd := range tpl.CommandNodesIterator() {
@@ -237,6 +257,19 @@ attach volume device=/dev/sdh id=vol-12345 instance=i-12345`
 		}
 	})
 
+	t.Run("Revert create IPv6 route", func(t *testing.T) {
+		tpl := MustParse("create route ipv6-cidr=::/0 egressonlygateway=eigw-12345 table=rtb-12345")
+		reverted, err := tpl.Revert()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		exp := `delete route ipv6-cidr=::/0 table=rtb-12345`
+		if got, want := reverted.String(), exp; got != want {
+			t.Fatalf("got: %s\nwant: %s\n", got, want)
+		}
+	})
+
 	t.Run("Revert attach instance", func(t *testing.T) {
 		tpl := MustParse("attach instance id=i-123456 port=80 targetgroup=mytargetgrouparn")
 		reverted, err := tpl.Revert()
@@ -433,6 +466,9 @@ func TestCmdNodeIsRevertible(t *testing.T) {
 		{line: "stop alarm", revertible: true},
 		{line: "start containertask", params: map[string]interface{}{"type": "service"}, revertible: true},
 		{line: "start containertask", params: map[string]interface{}{"type": "task"}, revertible: true},
+		{line: "attach queuepolicy", revertible: true},
+		{line: "detach queuepolicy", params: map[string]interface{}{"url": "my-url", "policy": "{}"}, revertible: true},
+		{line: "detach queuepolicy", params: map[string]interface{}{"url": "my-url"}, revertible: false},
 	}
 
 	for _, tc := range tcases {
@@ -1,7 +1,6 @@
 package template
 
 import (
-	"errors"
 	"fmt"
 	"os"
 
@@ -9,6 +8,34 @@ import (
 	"github.com/wallix/awless/template/env"
 )
 
+// RunFailure is the stage at which a template run failed
+type RunFailure int
+
+const (
+	// ValidationFailure means the template could not compile (ex: unknown command, invalid param)
+	ValidationFailure RunFailure = iota + 1
+	// DryRunFailure means the dry run of the template failed and nothing was run
+	DryRunFailure
+	// ExecutionFailure means all the commands run failed
+	ExecutionFailure
+	// PartialExecutionFailure means some commands succeeded before others failed
+	PartialExecutionFailure
+	// AssertionFailure means an assertion of the template did not hold, stopping the run
+	AssertionFailure
+)
+
+// RunError is returned by a runner when a template could not be run successfully
+type RunError struct {
+	Failure RunFailure
+	// Errs are the underlying errors: the compile error, or the errors of the failed commands
+	Errs []error
+	msg  string
+}
+
+func (e *RunError) Error() string {
+	return e.msg
+}
+
 type Runner struct {
 	Template                               *Template
 	Locale, Profile, Message, TemplatePath string
@@ -17,20 +44,43 @@ type Runner struct {
 	AliasFunc                              func(paramPath, alias string) string
 	MissingHolesFunc                       func(string, []string, bool) string
 	CmdLookuper                            func(tokens ...string) interface{}
+	PropertyFetcher                        PropertyFetcher
+	ParamDecrypter                         ParamDecrypter
+	ExistingResourceFinder                 ExistingResourceFinder
+	ResourceCounter                        ResourceCounter
 	Validators                             []Validator
 	ParamsSuggested                        int
+	ReadOnly                               bool
+	// Stack labels the execution, grouping it with the other runs of the stack in the logs
+	Stack string
+	// Resume is a previous failed execution of the template to resume from its failed command
+	Resume *TemplateExecution
+	// Concurrency runs the commands of a template of independent commands
+	// with this number of commands in flight (see Template.RunConcurrently)
+	Concurrency int
+	// ConcurrencyControl bounds per group (ex: per AWS service) the commands
+	// of a concurrent run in flight, slowing down on throttling
+	ConcurrencyControl *ConcurrencyControl
 
-	BeforeRun func(*TemplateExecution) (bool, error)
-	AfterRun  func(*TemplateExecution) error
+	BeforeRun      func(*TemplateExecution) (bool, error)
+	AfterRun       func(*TemplateExecution) error
+	StatementHooks []StatementHook
 }
 
 func (ru *Runner) Run() error {
+	if ru.ReadOnly {
+		if err := EnsureReadOnly(ru.Template); err != nil {
+			return &RunError{Failure: ValidationFailure, Errs: []error{err}, msg: err.Error()}
+		}
+	}
+
 	tplExec := &TemplateExecution{
 		Template: ru.Template,
 		Path:     ru.TemplatePath,
 		Locale:   ru.Locale,
 		Profile:  ru.Profile,
 		Source:   ru.Template.String(),
+		Stack:    ru.Stack,
 	}
 	tplExec.SetMessage(ru.Message)
 
@@ -41,11 +91,25 @@ func (ru *Runner) Run() error {
 	var err error
 	tplExec.Template, cenv, err = Compile(tplExec.Template, cenv, NewRunnerCompileMode)
 	if err != nil {
-		return err
+		return &RunError{Failure: ValidationFailure, Errs: []error{err}, msg: err.Error()}
 	}
 
 	tplExec.Fillers = cenv.Get(env.PROCESSED_FILLERS)
 
+	if ru.Concurrency > 1 {
+		if err = tplExec.Template.checkConcurrentRun(); err != nil {
+			return &RunError{Failure: ValidationFailure, Errs: []error{err}, msg: err.Error()}
+		}
+	}
+
+	if ru.Resume != nil {
+		skipped, err := tplExec.Template.ResumeFrom(ru.Resume)
+		if err != nil {
+			return &RunError{Failure: ValidationFailure, Errs: []error{err}, msg: err.Error()}
+		}
+		logger.Infof("Resuming %s: skipping %d already succeeded command(s)", ru.Resume.ID, skipped)
+	}
+
 	errs := tplExec.Template.Validate(ru.Validators...)
 	if len(errs) > 0 {
 		for _, err := range errs {
@@ -60,18 +124,19 @@ func (ru *Runner) Run() error {
 		logger.Info("Dry running template ...")
 	}
 
-	renv := NewRunEnv(cenv)
+	renv := newRunEnv(cenv)
+	renv.hooks, renv.fetchProperty, renv.decrypt = ru.StatementHooks, ru.PropertyFetcher, ru.ParamDecrypter
+	renv.findExisting, renv.countResources = ru.ExistingResourceFinder, ru.ResourceCounter
+	renv.concurrency = ru.ConcurrencyControl
 	if _, err = tplExec.Template.DryRun(renv); err != nil {
-		switch t := err.(type) {
-		case *Errors:
-			errs, _ := t.Errors()
-			for _, e := range errs {
-				logger.Errorf(e.Error())
-			}
-		default:
-			logger.Error(err)
+		dryRunErrs := []error{err}
+		if t, ok := err.(*Errors); ok {
+			dryRunErrs, _ = t.Errors()
 		}
-		return errors.New("Dry run failed")
+		for _, e := range dryRunErrs {
+			logger.Errorf(e.Error())
+		}
+		return &RunError{Failure: DryRunFailure, Errs: dryRunErrs, msg: "Dry run failed"}
 	}
 
 	ok, err := ru.BeforeRun(tplExec)
@@ -79,18 +144,45 @@ func (ru *Runner) Run() error {
 		return err
 	}
 
+	var assertErr *AssertionError
 	if ok {
-		tplExec.Template, err = tplExec.Template.Run(renv)
-		if err != nil {
+		if ru.Concurrency > 1 {
+			tplExec.Template, err = tplExec.Template.RunConcurrently(renv, ru.Concurrency)
+		} else {
+			tplExec.Template, err = tplExec.Template.Run(renv)
+		}
+		if e, isAssert := err.(*AssertionError); isAssert {
+			assertErr = e
+			logger.Error(e.Error())
+		} else if err != nil {
 			logger.Errorf("Running template error: %s", err)
 		}
+		if ru.PropertyFetcher != nil {
+			if tplExec.ResolvedOutputs, err = tplExec.Template.ResolveOutputs(ru.PropertyFetcher); err != nil {
+				logger.Errorf("Resolving template outputs: %s", err)
+			}
+		}
 		if err := ru.AfterRun(tplExec); err != nil {
 			return err
 		}
 	}
 
-	if tplExec.Stats().KOCount > 0 {
-		os.Exit(1)
+	if stats := tplExec.Stats(); stats.KOCount > 0 {
+		runErr := &RunError{Failure: ExecutionFailure}
+		for _, cmd := range tplExec.CommandNodesIterator() {
+			if cmd.Err() != nil {
+				runErr.Errs = append(runErr.Errs, cmd.Err())
+			}
+		}
+		if stats.OKCount > 0 {
+			runErr.Failure = PartialExecutionFailure
+		}
+		runErr.msg = fmt.Sprintf("%d of %d commands failed", stats.KOCount, stats.CmdCount)
+		return runErr
+	}
+
+	if assertErr != nil {
+		return &RunError{Failure: AssertionFailure, Errs: []error{assertErr}, msg: assertErr.Error()}
 	}
 
 	return nil
@@ -0,0 +1,200 @@
+package template
+
+import (
+	"fmt"
+
+	"github.com/wallix/awless/template/internal/ast"
+)
+
+// teardownBefore lists per entity the entities which can only be deleted once
+// its own resources are deleted (ex: instances before their subnets)
+var teardownBefore = map[string][]string{
+	"listener":                  {"loadbalancer", "targetgroup", "certificate"},
+	"scalinggroup":              {"launchconfiguration", "targetgroup", "subnet", "securitygroup"},
+	"instance":                  {"subnet", "securitygroup", "vpc", "networkinterface"},
+	"loadbalancer":              {"subnet", "securitygroup", "vpc"},
+	"classicloadbalancer":       {"subnet", "securitygroup", "vpc"},
+	"natgateway":                {"subnet", "elasticip", "vpc"},
+	"networkinterface":          {"subnet", "securitygroup", "vpc"},
+	"database":                  {"dbsubnetgroup", "securitygroup"},
+	"dbsubnetgroup":             {"subnet"},
+	"vpcendpoint":               {"subnet", "securitygroup", "routetable", "vpc"},
+	"route":                     {"routetable"},
+	"routetable":                {"vpc"},
+	"subnet":                    {"vpc"},
+	"securitygroup":             {"vpc"},
+	"egressonlyinternetgateway": {"vpc"},
+	"environment":               {"application"},
+}
+
+// teardownWaiters are the checks waiting for the deletion of a resource
+// to be effective, before deleting the resources it depends on
+var teardownWaiters = map[string]string{
+	"instance":     "check instance id=%s state=terminated timeout=180",
+	"database":     "check database id=%s state=not-found timeout=900",
+	"loadbalancer": "check loadbalancer id=%s state=not-found timeout=180",
+	"natgateway":   "check natgateway id=%s state=deleted timeout=180",
+	"environment":  "check environment id=%s state=Terminated timeout=900",
+}
+
+var teardownTargetParams = []string{"id", "name", "arn", "url", "association", "attachment"}
+
+// DependsOnFunc tells whether a resource depends on (i.e. has to be deleted before) another one, given their IDs
+type DependsOnFunc func(id, otherID string) bool
+
+// teardownUnit is a statement tearing down a resource, with its checks
+type teardownUnit struct {
+	statements   []*ast.Statement
+	main         *ast.CommandNode
+	target       string
+	hasPostCheck bool
+}
+
+// OrderTeardown reorders the statements of a teardown template (ex: a reverted template) so that resources
+// are deleted after the ones depending on them: statements referencing a deleted resource (ex: detach)
+// run before its deletion, child entities are deleted before their parents (ex: instances, subnets then vpc)
+// and dependsOn (if any) tells the dependencies known from the cloud graph. Deletions that other ones
+// depend on are followed by a check waiting for their effective removal. Otherwise the order is kept.
+func (s *Template) OrderTeardown(dependsOn DependsOnFunc) *Template {
+	units := teardownUnits(s.Statements)
+	if len(units) < 2 {
+		return s
+	}
+
+	after := make([][]bool, len(units))
+	for i := range units {
+		after[i] = make([]bool, len(units))
+	}
+	for i, a := range units {
+		for j, b := range units {
+			if i != j && mustTeardownBefore(a, b, dependsOn) && !mustTeardownBefore(b, a, dependsOn) {
+				after[j][i] = true
+			}
+		}
+	}
+
+	var ordered []*teardownUnit
+	done := make([]bool, len(units))
+	for len(ordered) < len(units) {
+		next := -1
+		for i := range units {
+			if done[i] {
+				continue
+			}
+			ready := true
+			for j := range units {
+				if after[i][j] && !done[j] {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				next = i
+				break
+			}
+		}
+		if next < 0 { // cyclic dependencies: keep the remaining statements in their order
+			for i := range units {
+				if !done[i] {
+					done[i] = true
+					ordered = append(ordered, units[i])
+				}
+			}
+			break
+		}
+		done[next] = true
+		ordered = append(ordered, units[next])
+	}
+
+	var statements []*ast.Statement
+	for i, u := range ordered {
+		statements = append(statements, u.statements...)
+		waiter, hasWaiter := teardownWaiters[u.main.Entity]
+		if !hasWaiter || u.main.Action != "delete" || u.hasPostCheck || u.target == "" {
+			continue
+		}
+		for _, other := range ordered[i+1:] {
+			if mustTeardownBefore(u, other, dependsOn) {
+				if check, err := Parse(fmt.Sprintf(waiter, quoteParamIfNeeded(u.target))); err == nil {
+					statements = append(statements, check.Statements...)
+				}
+				break
+			}
+		}
+	}
+	return &Template{ID: s.ID, AST: &ast.AST{Statements: statements}}
+}
+
+// teardownUnits groups the consecutive statements on the same resource (ex: update, check and delete scalinggroup)
+func teardownUnits(statements []*ast.Statement) (units []*teardownUnit) {
+	var current *teardownUnit
+	for _, st := range statements {
+		cmd, ok := st.Node.(*ast.CommandNode)
+		if !ok {
+			return []*teardownUnit{{statements: statements}}
+		}
+		target := teardownTarget(cmd)
+		closed := current != nil && current.main.Action == "delete" && cmd.Action != "check"
+		if current == nil || closed || target == "" || current.target != target || current.main.Entity != cmd.Entity {
+			current = &teardownUnit{target: target}
+			units = append(units, current)
+		}
+		current.statements = append(current.statements, st)
+		if cmd.Action == "check" {
+			current.hasPostCheck = current.main != nil && current.main.Action != "check"
+			if current.main == nil {
+				current.main = cmd
+			}
+		} else {
+			current.main, current.hasPostCheck = cmd, false
+		}
+	}
+	return
+}
+
+func teardownTarget(cmd *ast.CommandNode) string {
+	params := cmd.ToDriverParams()
+	for _, k := range teardownTargetParams {
+		if v, ok := params[k].(string); ok {
+			return v
+		}
+	}
+	return ""
+}
+
+// mustTeardownBefore returns true when the statements of a have to run before the deletion of b
+func mustTeardownBefore(a, b *teardownUnit, dependsOn DependsOnFunc) bool {
+	if a.main == nil || b.main == nil || b.main.Action != "delete" || b.target == "" {
+		return false
+	}
+	if a.main.Action != "delete" || a.target != b.target {
+		for _, v := range a.main.ToDriverParams() {
+			if referencesTarget(v, b.target) {
+				return true
+			}
+		}
+	}
+	if a.main.Action != "delete" || a.target == "" {
+		return false
+	}
+	for _, parent := range teardownBefore[a.main.Entity] {
+		if parent == b.main.Entity {
+			return true
+		}
+	}
+	return dependsOn != nil && dependsOn(a.target, b.target)
+}
+
+func referencesTarget(v interface{}, target string) bool {
+	switch vv := v.(type) {
+	case string:
+		return vv == target
+	case []interface{}:
+		for _, e := range vv {
+			if referencesTarget(e, target) {
+				return true
+			}
+		}
+	}
+	return false
+}
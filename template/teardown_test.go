@@ -0,0 +1,57 @@
+package template
+
+import "testing"
+
+func TestOrderTeardown(t *testing.T) {
+	tcases := []struct {
+		name      string
+		in        string
+		dependsOn DependsOnFunc
+		exp       string
+	}{
+		{
+			name: "children entities first",
+			in:   "delete subnet id=subnet-1\ndelete vpc id=vpc-1\ndelete instance id=i-1",
+			exp:  "delete instance id=i-1\ncheck instance id=i-1 state=terminated timeout=180\ndelete subnet id=subnet-1\ndelete vpc id=vpc-1",
+		},
+		{
+			name: "detach before delete",
+			in:   "delete vpc id=vpc-1\ndelete internetgateway id=igw-1\ndetach internetgateway id=igw-1 vpc=vpc-1",
+			exp:  "detach internetgateway id=igw-1 vpc=vpc-1\ndelete vpc id=vpc-1\ndelete internetgateway id=igw-1",
+		},
+		{
+			name: "checks kept with their statement",
+			in:   "delete vpc id=vpc-1\ncheck securitygroup id=sg-1 state=unused timeout=300\ndelete securitygroup id=sg-1\ndelete instance id=i-1\ncheck instance id=i-1 state=terminated timeout=180",
+			exp:  "delete instance id=i-1\ncheck instance id=i-1 state=terminated timeout=180\ncheck securitygroup id=sg-1 state=unused timeout=300\ndelete securitygroup id=sg-1\ndelete vpc id=vpc-1",
+		},
+		{
+			name:      "graph dependencies",
+			in:        "delete keypair name=my-key\ndelete bucket name=my-bucket\ndelete function id=my-func",
+			dependsOn: func(id, other string) bool { return id == "my-func" && other == "my-bucket" },
+			exp:       "delete keypair name=my-key\ndelete function id=my-func\ndelete bucket name=my-bucket",
+		},
+		{
+			name: "unrelated kept in order",
+			in:   "delete keypair name=my-key\ndelete bucket name=my-bucket\nstop instance ids=i-1",
+			exp:  "delete keypair name=my-key\ndelete bucket name=my-bucket\nstop instance ids=i-1",
+		},
+		{
+			name: "declarations kept in order",
+			in:   "subnet = create subnet cidr=10.0.0.0/24\ndelete vpc id=vpc-1\ndelete subnet id=subnet-1",
+			exp:  "subnet = create subnet cidr=10.0.0.0/24\ndelete vpc id=vpc-1\ndelete subnet id=subnet-1",
+		},
+	}
+	for _, tcase := range tcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			tpl := MustParse(tcase.in)
+			tpl.ID = "my-id"
+			ordered := tpl.OrderTeardown(tcase.dependsOn)
+			if got, want := ordered.String(), tcase.exp; got != want {
+				t.Fatalf("got\n%s\nwant\n%s", got, want)
+			}
+			if got, want := ordered.ID, "my-id"; got != want {
+				t.Fatalf("got %s, want %s", got, want)
+			}
+		})
+	}
+}
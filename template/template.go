@@ -31,6 +31,9 @@ import (
 type Template struct {
 	ID string
 	*ast.AST
+
+	// results of the commands already succeeded in a previous execution, per command index (see ResumeFrom)
+	resumed map[int]interface{}
 }
 
 func (s *Template) DryRun(renv env.Running) (tpl *Template, err error) {
@@ -58,17 +61,31 @@ func (s *Template) DryRun(renv env.Running) (tpl *Template, err error) {
 
 func (s *Template) Run(renv env.Running) (*Template, error) {
 	vars := map[string]interface{}{}
+	entities := map[string]string{}
 
-	current := &Template{AST: &ast.AST{}}
+	current := &Template{AST: &ast.AST{Outputs: s.Outputs, Assertions: s.Assertions}}
 	current.ID = ulid.MustNew(ulid.Timestamp(time.Now()), rand.Reader).String()
 
-	for _, sts := range s.Statements {
+	cmdIndex := -1
+	for i, sts := range s.Statements {
+		if err := s.checkAssertions(renv, i, vars); err != nil {
+			return current, err
+		}
 		clone := sts.Clone()
 		current.Statements = append(current.Statements, clone)
 		switch n := clone.Node.(type) {
 		case *ast.CommandNode:
+			cmdIndex++
+			if result, ok := s.resumed[cmdIndex]; ok {
+				n.ProcessRefs(vars)
+				n.CmdResult = result
+				continue
+			}
+			if stop := resolvePropertyRefs(renv, n, vars, entities, clone.Line); stop {
+				return current, nil
+			}
 			n.ProcessRefs(vars)
-			if stop := processCmdNode(renv, n); stop {
+			if stop := processCmdNode(renv, n, clone.Line, current.ID); stop {
 				return current, nil
 			}
 		case *ast.DeclarationNode:
@@ -76,11 +93,23 @@ func (s *Template) Run(renv env.Running) (*Template, error) {
 			expr := n.Expr
 			switch n := expr.(type) {
 			case *ast.CommandNode:
+				cmdIndex++
+				if result, ok := s.resumed[cmdIndex]; ok {
+					n.ProcessRefs(vars)
+					n.CmdResult = result
+					vars[ident] = result
+					entities[ident] = n.Entity
+					continue
+				}
+				if stop := resolvePropertyRefs(renv, n, vars, entities, clone.Line); stop {
+					return current, nil
+				}
 				n.ProcessRefs(vars)
-				if stop := processCmdNode(renv, n); stop {
+				if stop := processCmdNode(renv, n, clone.Line, current.ID); stop {
 					return current, nil
 				}
 				vars[ident] = n.Result()
+				entities[ident] = n.Entity
 			default:
 				return current, fmt.Errorf("unknown type of node: %T", expr)
 			}
@@ -89,15 +118,45 @@ func (s *Template) Run(renv env.Running) (*Template, error) {
 		}
 	}
 
-	return current, nil
+	return current, s.checkAssertions(renv, len(s.Statements), vars)
 }
 
-func processCmdNode(renv env.Running, n *ast.CommandNode) bool {
+func processCmdNode(renv env.Running, n *ast.CommandNode, line int, templateID string) bool {
 	if renv.IsDryRun() {
-		n.CmdResult, n.CmdErr = n.Command.Run(renv, n.ToDriverParams())
-		n.CmdErr = prefixError(n.CmdErr, fmt.Sprintf("dry run: %s %s", n.Action, n.Entity))
+		if params, _, err := commandParams(renv, n); err != nil {
+			n.CmdErr = err
+		} else {
+			n.CmdResult, n.CmdErr = n.Command.Run(renv, params)
+		}
+		n.CmdErr = statementError(prefixError(n.CmdErr, fmt.Sprintf("dry run: %s %s", n.Action, n.Entity)), n, line)
 	} else {
-		n.CmdResult, n.CmdErr = n.Run(renv, n.ToDriverParams())
+		var hooks []StatementHook
+		if e, ok := renv.(*runEnv); ok {
+			hooks = e.hooks
+		}
+		event := &StatementEvent{TemplateID: templateID, Statement: n.String(), Action: n.Action, Entity: n.Entity, Params: n.ToDriverParams()}
+
+		event.Stage = BeforeStatement
+		if id, err := findExistingResource(renv, n); err != nil {
+			n.CmdErr = statementError(prefixError(err, "looking up existing resource"), n, line)
+		} else if id != "" {
+			n.CmdResult = id
+			renv.Log().Infof("%s %s already exists, reusing it", n.Entity, id)
+		} else if err := runStatementHooks(hooks, event); err != nil {
+			n.CmdErr = prefixError(err, "before statement hook")
+		} else {
+			n.CmdResult, n.CmdErr = runCommandNode(renv, n)
+			n.CmdErr = statementError(n.CmdErr, n, line)
+		}
+
+		event.Stage, event.Result = AfterStatement, n.CmdResult
+		if n.CmdErr != nil {
+			event.Error = n.CmdErr.Error()
+		}
+		if err := runStatementHooks(hooks, event); err != nil {
+			renv.Log().Warningf("after statement hook: %s", err)
+		}
+
 		var res, status string
 		if n.CmdResult != nil {
 			res = " (" + color.New(color.FgCyan).Sprint(n.CmdResult) + ") "
@@ -122,6 +181,17 @@ func prefixError(err error, prefix string) error {
 	return fmt.Errorf("%s: %s", prefix, err.Error())
 }
 
+// statementError appends to the error the failing statement with its resolved params
+func statementError(err error, n *ast.CommandNode, line int) error {
+	if err == nil {
+		return err
+	}
+	if line > 0 {
+		return fmt.Errorf("%s\n\tfailing statement (line %d): %s", err, line, n)
+	}
+	return fmt.Errorf("%s\n\tfailing statement: %s", err, n)
+}
+
 func (s *Template) Validate(rules ...Validator) (all []error) {
 	for _, rule := range rules {
 		errs := rule.Execute(s)
@@ -172,6 +242,23 @@ func (s *Template) visitCommandNodesE(fn func(n *ast.CommandNode) error) error {
 	return nil
 }
 
+// withLine prefixes the error with the template line number of the statement holding the given command, when known
+func (s *Template) withLine(cmd *ast.CommandNode, err error) error {
+	if err == nil {
+		return nil
+	}
+	for _, st := range s.Statements {
+		node := st.Node
+		if decl, ok := node.(*ast.DeclarationNode); ok {
+			node = decl.Expr
+		}
+		if node == cmd && st.Line > 0 {
+			return fmt.Errorf("line %d: %s", st.Line, err)
+		}
+	}
+	return err
+}
+
 func (s *Template) CommandNodesIterator() (nodes []*ast.CommandNode) {
 	for _, sts := range s.Statements {
 		switch nn := sts.Node.(type) {
@@ -215,6 +302,16 @@ func (s *Template) declarationNodesIterator() (nodes []*ast.DeclarationNode) {
 	return
 }
 
+// CommentOf returns the comment attached to the statement of the given command node
+func (s *Template) CommentOf(cmd *ast.CommandNode) string {
+	for _, st := range s.Statements {
+		if node, ok := extractExpressionNode(st).(*ast.CommandNode); ok && node == cmd {
+			return st.Comment
+		}
+	}
+	return ""
+}
+
 func (s *Template) expressionNodesIterator() (nodes []ast.ExpressionNode) {
 	for _, st := range s.Statements {
 		if expr := extractExpressionNode(st); expr != nil {
@@ -0,0 +1,103 @@
+package template
+
+import (
+	"sync"
+
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/internal/ast"
+)
+
+// ConcurrencyControl bounds per group (ex: per AWS service) the commands of concurrent runs
+// in flight at once. The bound of a group is halved when one of its commands fails on throttling,
+// then raised back by one on each success up to its maximum, so that bulk operations slow down
+// instead of tripping account-level rate limits. A control can be shared by several runs.
+type ConcurrencyControl struct {
+	group        func(action, entity string) string
+	isThrottling func(error) bool
+	maxima       map[string]int
+	defaultMax   int
+
+	mu     sync.Mutex
+	cond   *sync.Cond
+	groups map[string]*concurrencyGroup
+}
+
+type concurrencyGroup struct {
+	max, limit, inFlight int
+}
+
+// NewConcurrencyControl returns a control bounding the commands of each group to its maximum,
+// or to defaultMax for the groups without one (0: unbounded, still slowing down on throttling)
+func NewConcurrencyControl(maxima map[string]int, defaultMax int, group func(action, entity string) string, isThrottling func(error) bool) *ConcurrencyControl {
+	c := &ConcurrencyControl{
+		group:        group,
+		isThrottling: isThrottling,
+		maxima:       maxima,
+		defaultMax:   defaultMax,
+		groups:       make(map[string]*concurrencyGroup),
+	}
+	c.cond = sync.NewCond(&c.mu)
+	return c
+}
+
+// Acquire waits for a slot in the group of the command and returns
+// the func releasing it given the command error
+func (c *ConcurrencyControl) Acquire(action, entity string) (release func(error)) {
+	name := c.group(action, entity)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	g, ok := c.groups[name]
+	if !ok {
+		max, ok := c.maxima[name]
+		if !ok {
+			max = c.defaultMax
+		}
+		g = &concurrencyGroup{max: max, limit: max}
+		c.groups[name] = g
+	}
+	for g.limit > 0 && g.inFlight >= g.limit {
+		c.cond.Wait()
+	}
+	g.inFlight++
+
+	return func(err error) {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		g.inFlight--
+		switch {
+		case err != nil && c.isThrottling != nil && c.isThrottling(err):
+			if g.limit <= 0 || g.limit > g.inFlight+1 { // halving the commands actually in flight
+				g.limit = g.inFlight + 1
+			}
+			if g.limit = g.limit / 2; g.limit < 1 {
+				g.limit = 1
+			}
+			logger.Warningf("%s throttled: running at most %d concurrent %s commands", name, g.limit, name)
+		case err == nil && g.limit > 0 && (g.max <= 0 || g.limit < g.max):
+			g.limit++
+		}
+		c.cond.Broadcast()
+	}
+}
+
+// Limit returns the current bound of the group (0: unbounded)
+func (c *ConcurrencyControl) Limit(group string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if g, ok := c.groups[group]; ok {
+		return g.limit
+	}
+	if max, ok := c.maxima[group]; ok {
+		return max
+	}
+	return c.defaultMax
+}
+
+func acquireConcurrencySlot(renv env.Running, n *ast.CommandNode) func(error) {
+	if e, ok := renv.(*runEnv); ok && e.concurrency != nil {
+		return e.concurrency.Acquire(n.Action, n.Entity)
+	}
+	return func(error) {}
+}
@@ -0,0 +1,97 @@
+package template_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wallix/awless/template"
+	"github.com/wallix/awless/template/driver/fake"
+)
+
+func TestConcurrencyControl(t *testing.T) {
+	groupByEntity := func(action, entity string) string { return entity }
+	isThrottling := func(err error) bool { return strings.Contains(err.Error(), "Throttling") }
+
+	t.Run("bounds per group", func(t *testing.T) {
+		control := template.NewConcurrencyControl(map[string]int{"instance": 2}, 0, groupByEntity, isThrottling)
+		first := control.Acquire("delete", "instance")
+		control.Acquire("delete", "instance")
+		control.Acquire("delete", "user")
+		control.Acquire("delete", "user")
+		control.Acquire("delete", "user")
+
+		acquired := make(chan struct{})
+		go func() {
+			control.Acquire("delete", "instance")
+			close(acquired)
+		}()
+		select {
+		case <-acquired:
+			t.Fatal("expected to wait for a slot")
+		case <-time.After(50 * time.Millisecond):
+		}
+		first(nil)
+		select {
+		case <-acquired:
+		case <-time.After(time.Second):
+			t.Fatal("expected a slot once released")
+		}
+	})
+
+	t.Run("lowered on throttling then raised back", func(t *testing.T) {
+		control := template.NewConcurrencyControl(map[string]int{"instance": 4}, 0, groupByEntity, isThrottling)
+		var releases []func(error)
+		for i := 0; i < 4; i++ {
+			releases = append(releases, control.Acquire("delete", "instance"))
+		}
+		releases[0](errors.New("Throttling: Rate exceeded"))
+		if got, want := control.Limit("instance"), 2; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+		releases[1](errors.New("InvalidVolume.NotFound: not found"))
+		if got, want := control.Limit("instance"), 2; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+		releases[2](nil)
+		releases[3](nil)
+		if got, want := control.Limit("instance"), 4; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+		control.Acquire("delete", "instance")(nil)
+		if got, want := control.Limit("instance"), 4; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+	})
+
+	t.Run("default bound", func(t *testing.T) {
+		control := template.NewConcurrencyControl(nil, 3, groupByEntity, isThrottling)
+		if got, want := control.Limit("instance"), 3; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+		control.Acquire("delete", "instance")(errors.New("Throttling: Rate exceeded"))
+		if got, want := control.Limit("instance"), 1; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+	})
+
+	t.Run("used by concurrent runs", func(t *testing.T) {
+		driver := fake.NewDriver()
+		driver.FailOn("delete", "instance", errors.New("Throttling: Rate exceeded"))
+		compiled, cenv, err := template.Compile(template.MustParse("delete instance id=i-1\ndelete user name=john"), template.NewEnv().WithLookupCommandFunc(driver.Lookup).Build())
+		if err != nil {
+			t.Fatal(err)
+		}
+		control := template.NewConcurrencyControl(nil, 4, groupByEntity, isThrottling)
+		if _, err := compiled.RunConcurrently(template.NewRunEnvWithConcurrencyControl(cenv, control), 2); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := control.Limit("instance"), 1; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+		if got, want := control.Limit("user"), 4; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+	})
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/wallix/awless/template/internal/ast"
+)
+
+// Templates declare the version of the grammar they are written with
+// in a header comment (ex: "# awless-template-version: 2").
+// Templates without header are considered written with LegacyVersion.
+const (
+	LegacyVersion  = 1
+	CurrentVersion = 2
+)
+
+var versionHeaderRegex = regexp.MustCompile(`^\s*#\s*awless-template-version\s*:\s*(\d+)\s*$`)
+
+func VersionHeader(version int) string {
+	return fmt.Sprintf("# awless-template-version: %d", version)
+}
+
+// Version returns the grammar version declared in the template header
+func Version(text string) (int, error) {
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if matches := versionHeaderRegex.FindStringSubmatch(line); len(matches) == 2 {
+			version, _ := strconv.Atoi(matches[1])
+			if version < LegacyVersion || version > CurrentVersion {
+				return 0, fmt.Errorf("unsupported template version %d (this awless supports up to version %d, you may need to upgrade awless)", version, CurrentVersion)
+			}
+			return version, nil
+		}
+		if !strings.HasPrefix(line, "#") && !strings.HasPrefix(line, "//") {
+			break
+		}
+	}
+	return LegacyVersion, nil
+}
+
+type paramRename struct {
+	action, entity, from, to string
+}
+
+// migrations lists per version the deprecated params renamed in the next version
+var migrations = map[int][]paramRename{
+	LegacyVersion: {
+		{"create", "instance", "key", "keypair"},
+		{"create", "instance", "group", "securitygroup"},
+		{"create", "launchconfiguration", "key", "keypair"},
+		{"create", "launchconfiguration", "groups", "securitygroups"},
+		{"create", "loadbalancer", "groups", "securitygroups"},
+	},
+}
+
+func renamesSince(version int) (renames []paramRename) {
+	for v := version; v < CurrentVersion; v++ {
+		renames = append(renames, migrations[v]...)
+	}
+	return
+}
+
+func migrateCommandNode(node *ast.CommandNode, renames []paramRename) (applied []paramRename) {
+	for _, r := range renames {
+		if node.Action != r.action || node.Entity != r.entity {
+			continue
+		}
+		if val, ok := node.ParamNodes[r.from]; ok {
+			delete(node.ParamNodes, r.from)
+			node.ParamNodes[r.to] = val
+			applied = append(applied, r)
+		}
+	}
+	return
+}
+
+// Migrate rewrites a template written with an older grammar version to the current one,
+// adding the version header. Comments and layout are kept.
+func Migrate(text string) (string, error) {
+	version, err := Version(text)
+	if err != nil {
+		return "", err
+	}
+	if version == CurrentVersion {
+		return text, nil
+	}
+	renames := renamesSince(version)
+
+	var buff bytes.Buffer
+	buff.WriteString(VersionHeader(CurrentVersion))
+	buff.WriteByte('\n')
+	for i, line := range strings.Split(text, "\n") {
+		if versionHeaderRegex.MatchString(line) {
+			continue
+		}
+		if strings.TrimSpace(line) != "" {
+			tpl, err := parse(line)
+			if err != nil {
+				return "", fmt.Errorf("line %d: %s", i+1, err)
+			}
+			for _, node := range tpl.CommandNodesIterator() {
+				for _, r := range migrateCommandNode(node, renames) {
+					line = renameParamInLine(line, r.from, r.to)
+				}
+			}
+		}
+		buff.WriteString(line)
+		buff.WriteByte('\n')
+	}
+	return strings.TrimSuffix(buff.String(), "\n"), nil
+}
+
+// renameParamInLine renames the param key in a template line, ignoring quoted values
+func renameParamInLine(line, from, to string) string {
+	var buff bytes.Buffer
+	var quote rune
+	for i := 0; i < len(line); i++ {
+		c := rune(line[i])
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case (i == 0 || line[i-1] == ' ' || line[i-1] == '\t') && strings.HasPrefix(line[i:], from):
+			if rest := strings.TrimLeft(line[i+len(from):], " \t"); strings.HasPrefix(rest, "=") {
+				buff.WriteString(to)
+				i += len(from) - 1
+				continue
+			}
+		}
+		buff.WriteByte(line[i])
+	}
+	return buff.String()
+}
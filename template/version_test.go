@@ -0,0 +1,100 @@
+package template
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTemplateVersion(t *testing.T) {
+	tcases := []struct {
+		text   string
+		exp    int
+		errMsg string
+	}{
+		{"create instance name=web", LegacyVersion, ""},
+		{"# awless-template-version: 2\ncreate instance name=web", 2, ""},
+		{"# Create my infra\n\n#awless-template-version:1\ncreate instance name=web", 1, ""},
+		{"create instance name=web\n# awless-template-version: 2", LegacyVersion, ""},
+		{"# awless-template-version: 3\ncreate instance name=web", 0, "unsupported template version 3"},
+	}
+	for i, tcase := range tcases {
+		version, err := Version(tcase.text)
+		if tcase.errMsg != "" {
+			if err == nil || !strings.Contains(err.Error(), tcase.errMsg) {
+				t.Fatalf("%d: expected error containing %q, got %v", i+1, tcase.errMsg, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%d: %s", i+1, err)
+		}
+		if got, want := version, tcase.exp; got != want {
+			t.Fatalf("%d: got %d, want %d", i+1, got, want)
+		}
+	}
+}
+
+func TestParseLegacyVersion(t *testing.T) {
+	tpl, err := Parse("inst = create instance name=web key=mykey group=@mysg\ncreate loadbalancer groups=[sg-1,sg-2]")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmds := tpl.CommandNodesIterator()
+	for _, key := range []string{"keypair", "securitygroup", "name"} {
+		if _, ok := cmds[0].ParamNodes[key]; !ok {
+			t.Fatalf("missing param %s in %v", key, cmds[0].ParamNodes)
+		}
+	}
+	if _, ok := cmds[1].ParamNodes["securitygroups"]; !ok {
+		t.Fatalf("missing param securitygroups in %v", cmds[1].ParamNodes)
+	}
+
+	tpl, err = Parse("# awless-template-version: 2\ncreate instance key=mykey")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := tpl.CommandNodesIterator()[0].ParamNodes["key"]; !ok {
+		t.Fatal("expected params of current version templates to be left untouched")
+	}
+
+	if _, err = Parse("# awless-template-version: 42\ncreate instance name=web"); err == nil {
+		t.Fatal("expected error got none")
+	}
+}
+
+func TestMigrateTemplate(t *testing.T) {
+	text := `# My infra
+subnet = create subnet cidr=10.0.0.0/24 vpc=@myvpc
+
+// web server
+create instance name=web subnet=$subnet key = mykey group=sg-1 userdata='echo group=1'
+create keypair name=key
+`
+	expected := `# awless-template-version: 2
+# My infra
+subnet = create subnet cidr=10.0.0.0/24 vpc=@myvpc
+
+// web server
+create instance name=web subnet=$subnet keypair = mykey securitygroup=sg-1 userdata='echo group=1'
+create keypair name=key
+`
+	migrated, err := Migrate(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := migrated, expected; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+
+	again, err := Migrate(migrated)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := again, migrated; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+
+	if _, err = Migrate("create instance name=web\ncreate instance name="); err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Fatalf("expected error on line 2, got %v", err)
+	}
+}